@@ -548,14 +548,16 @@ func NewExocoreApp(
 	// asset and client chain registry.
 	app.AssetsKeeper = assetsKeeper.NewKeeper(
 		keys[assetsTypes.StoreKey], appCodec, &app.OracleKeeper,
-		app.BankKeeper, &app.DelegationKeeper, authAddrString,
+		app.BankKeeper, &app.DelegationKeeper,
+		// intentionally a pointer since it is not yet initialized
+		&app.ExoSlashKeeper, authAddrString,
 	)
 
 	// handles delegations by stakers, and must know if the delegatee operator is registered.
 	app.DelegationKeeper = delegationKeeper.NewKeeper(
 		keys[delegationTypes.StoreKey], appCodec,
 		app.AssetsKeeper,
-		delegationTypes.VirtualSlashKeeper{},
+		&app.ExoSlashKeeper, // intentionally a pointer, since not yet initialized.
 		&app.OperatorKeeper,
 		app.AccountKeeper,
 		app.BankKeeper,
@@ -577,17 +579,25 @@ func NewExocoreApp(
 	// these two modules aren't finalized yet.
 	app.RewardKeeper = rewardKeeper.NewKeeper(
 		appCodec, keys[rewardTypes.StoreKey], app.AssetsKeeper,
-		app.AVSManagerKeeper, authAddrString,
+		// intentionally a pointer since it is not yet initialized
+		&app.AVSManagerKeeper,
+		&app.DelegationKeeper, authAddrString,
 	)
 	app.ExoSlashKeeper = slashKeeper.NewKeeper(
-		appCodec, keys[exoslashTypes.StoreKey], app.AssetsKeeper, authAddrString,
+		appCodec, keys[exoslashTypes.StoreKey], app.AssetsKeeper,
+		&app.DelegationKeeper,
+		// intentionally a pointer since it is not yet initialized
+		&app.OperatorKeeper, &app.AVSManagerKeeper,
+		authAddrString,
 	)
 
 	// x/oracle is not fully integrated (or enabled) but allows for exchange rates to be added.
 	app.OracleKeeper = oracleKeeper.NewKeeper(
 		appCodec, keys[oracleTypes.StoreKey], memKeys[oracleTypes.MemStoreKey],
 		app.GetSubspace(oracleTypes.ModuleName), app.StakingKeeper,
-		&app.DelegationKeeper, &app.AssetsKeeper, authAddrString,
+		&app.DelegationKeeper, &app.AssetsKeeper,
+		// intentionally a pointer since it is not yet initialized
+		&app.OperatorKeeper, authAddrString,
 	)
 
 	// the SDK slashing module is used to slash validators in the case of downtime. it tracks
@@ -687,6 +697,7 @@ func NewExocoreApp(
 		app.AssetsKeeper,
 		app.EpochsKeeper,
 		app.EvmKeeper,
+		app.RewardKeeper,
 	)
 	// operator registry, which handles vote power (and this requires delegation keeper).
 	// this keeper is initialized after the avs keeper because it depends on the avs keeper
@@ -697,7 +708,8 @@ func NewExocoreApp(
 		&app.DelegationKeeper, // intentionally a pointer, since not yet initialized.
 		&app.OracleKeeper,
 		&app.AVSManagerKeeper,
-		delegationTypes.VirtualSlashKeeper{},
+		&app.ExoSlashKeeper,
+		authAddrString,
 	)
 	// the fee distribution keeper is used to allocate reward to exocore validators on epoch-basis,
 	// and it'll interact with other modules, like delegation for voting power, mint and inflation and etc.
@@ -711,6 +723,11 @@ func NewExocoreApp(
 		app.AccountKeeper,
 		app.StakingKeeper,
 		app.EpochsKeeper,
+		app.AssetsKeeper,
+		&app.DelegationKeeper,
+		&app.OracleKeeper,
+		&app.OperatorKeeper,
+		&app.AVSManagerKeeper,
 	)
 
 	app.EvmKeeper.WithPrecompiles(
@@ -723,6 +740,7 @@ func NewExocoreApp(
 			app.ExoSlashKeeper,
 			app.RewardKeeper,
 			app.AVSManagerKeeper,
+			app.DistrKeeper,
 		),
 	)
 
@@ -793,7 +811,12 @@ func NewExocoreApp(
 	)
 
 	(&app.DelegationKeeper).SetHooks(
-		app.StakingKeeper.DelegationHooks(),
+		delegationTypes.NewMultiDelegationHooks(
+			app.StakingKeeper.DelegationHooks(),
+			app.OperatorKeeper.DelegationHooks(), // holds the unbonding for the opted-in AVSs
+			app.DistrKeeper.DelegationHooks(),    // settles the rewards of the stakers
+			app.RewardKeeper.DelegationHooks(),   // settles the AVS rewards of the stakers
+		),
 	)
 
 	(&app.EpochsKeeper).SetHooks(
@@ -803,6 +826,7 @@ func NewExocoreApp(
 			app.StakingKeeper.EpochsHooks(),    // at this point, the order is irrelevant.
 			app.ExomintKeeper.EpochsHooks(),    // however, this may change once we have distribution
 			app.AVSManagerKeeper.EpochsHooks(), // no-op for now
+			app.RewardKeeper.EpochsHooks(),     // credits the AVS rewards to the stakers
 		),
 	)
 
@@ -462,7 +462,8 @@ func getTestExocoreGenesis(
 			}, depositsByStaker, nil,
 		), operatortypes.NewGenesisState(
 			operatorInfos, nil, nil, nil, nil, nil, nil, nil,
-		), delegationtypes.NewGenesis(associations, delegationStates, stakersByOperator, nil), dogfoodtypes.NewGenesis(
+			operatortypes.DefaultParams(), nil, nil, nil, nil, nil,
+		), delegationtypes.NewGenesis(associations, delegationStates, stakersByOperator, nil, nil, nil), dogfoodtypes.NewGenesis(
 			dogfoodtypes.NewParams(
 				dogfoodtypes.DefaultEpochsUntilUnbonded,
 				dogfoodtypes.DefaultEpochIdentifier,
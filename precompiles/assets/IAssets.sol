@@ -118,6 +118,18 @@ interface IAssets {
         external
         returns (bool success);
 
+    /// @dev enables or disables the auto-delegation of the native staking token balance
+    /// increases of the staker, such as the consensus rewards on the client chain. When enabled,
+    /// the increases are proportionally delegated to the operators that the staker has already
+    /// delegated the native token to.
+    /// @param clientChainID is the layerZero chainID if it is supported.
+    /// @param stakerAddress The staker address
+    /// @param enabled true to enable the auto-delegation, false to disable it
+    /// @return success if the update is successful
+    function setNSTAutoDelegation(uint32 clientChainID, bytes calldata stakerAddress, bool enabled)
+        external
+        returns (bool success);
+
     /// QUERIES
     /// @dev Returns the chain indices of the client chains.
     function getClientChains() external view returns (bool, uint32[] memory);
@@ -128,4 +140,17 @@ interface IAssets {
     /// @return isRegistered true if the client chain is registered
     function isRegisteredClientChain(uint32 clientChainID) external view returns (bool success, bool isRegistered);
 
+    /// @dev Returns the total staking amount and the staking limits of the asset, so that the
+    /// deposits exceeding the limits can be rejected on the client chain.
+    /// @param clientChainID is the layerZero chainID if it is supported.
+    /// @param assetsAddress The client chain asset address
+    /// @return success true if the query is successful
+    /// @return stakingTotalAmount the total staking amount of the asset
+    /// @return maxTotalStaking the max total staking amount of the asset, 0 means no limit
+    /// @return maxPerStaker the max deposit amount of a single staker, 0 means no limit
+    function getStakingAssetInfo(uint32 clientChainID, bytes calldata assetsAddress)
+        external
+        view
+        returns (bool success, uint256 stakingTotalAmount, uint256 maxTotalStaking, uint256 maxPerStaker);
+
 }
@@ -121,6 +121,12 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 			ctx.Logger().Error("internal error when calling assets precompile", "module", "assets precompile", "method", method.Name, "err", err)
 			bz, err = method.Outputs.Pack(false)
 		}
+	case MethodSetNSTAutoDelegation:
+		bz, err = p.SetNSTAutoDelegation(ctx, contract, method, args)
+		if err != nil {
+			ctx.Logger().Error("internal error when calling assets precompile", "module", "assets precompile", "method", method.Name, "err", err)
+			bz, err = method.Outputs.Pack(false)
+		}
 	// queries
 	case MethodGetClientChains:
 		bz, err = p.GetClientChains(ctx, method, args)
@@ -134,6 +140,12 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 			ctx.Logger().Error("internal error when calling assets precompile", "module", "assets precompile", "method", method.Name, "err", err)
 			bz, err = method.Outputs.Pack(false, false)
 		}
+	case MethodGetStakingAssetInfo:
+		bz, err = p.GetStakingAssetInfo(ctx, method, args)
+		if err != nil {
+			ctx.Logger().Error("internal error when calling assets precompile", "module", "assets precompile", "method", method.Name, "err", err)
+			bz, err = method.Outputs.Pack(false, new(big.Int), new(big.Int), new(big.Int))
+		}
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
@@ -158,9 +170,10 @@ func (Precompile) IsTransaction(methodID string) bool {
 	case MethodDepositLST, MethodWithdrawLST,
 		MethodDepositNST, MethodWithdrawNST,
 		MethodRegisterOrUpdateClientChain,
-		MethodRegisterToken, MethodUpdateToken:
+		MethodRegisterToken, MethodUpdateToken,
+		MethodSetNSTAutoDelegation:
 		return true
-	case MethodGetClientChains, MethodIsRegisteredClientChain:
+	case MethodGetClientChains, MethodIsRegisteredClientChain, MethodGetStakingAssetInfo:
 		return false
 	default:
 		return false
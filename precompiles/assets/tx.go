@@ -3,8 +3,7 @@ package assets
 import (
 	"errors"
 	"fmt"
-
-	sdkmath "cosmossdk.io/math"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
@@ -26,6 +25,8 @@ const (
 	MethodRegisterToken               = "registerToken"
 	MethodUpdateToken                 = "updateToken"
 	MethodIsRegisteredClientChain     = "isRegisteredClientChain"
+	MethodGetStakingAssetInfo         = "getStakingAssetInfo"
+	MethodSetNSTAutoDelegation        = "setNSTAutoDelegation"
 )
 
 // DepositOrWithdraw deposit and withdraw the client chain assets for the staker,
@@ -147,24 +148,8 @@ func (p Precompile) RegisterToken(
 		return nil, err
 	}
 
-	_, assetID := assetstypes.GetStakerIDAndAssetIDFromStr(asset.LayerZeroChainID, "", asset.Address)
-	oInfo.AssetID = assetID
-
-	if p.assetsKeeper.IsStakingAsset(ctx, assetID) {
-		return nil, fmt.Errorf("asset %s already exists", assetID)
-	}
-
-	stakingAsset := &assetstypes.StakingAssetInfo{
-		AssetBasicInfo:     asset,
-		StakingTotalAmount: sdkmath.NewInt(0),
-	}
-
-	if err := p.assetsKeeper.RegisterNewTokenAndSetTokenFeeder(ctx, &oInfo); err != nil {
-		return nil, err
-	}
-
 	// this is where the magic happens
-	if err := p.assetsKeeper.SetStakingAssetInfo(ctx, stakingAsset); err != nil {
+	if _, err := p.assetsKeeper.RegisterNewToken(ctx, &asset, &oInfo); err != nil {
 		return nil, err
 	}
 
@@ -198,6 +183,30 @@ func (p Precompile) UpdateToken(
 	return method.Outputs.Pack(true)
 }
 
+// SetNSTAutoDelegation enables or disables the auto-delegation of the native staking token
+// balance increases for the staker.
+func (p Precompile) SetNSTAutoDelegation(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	// the caller must be the ExocoreGateway contract
+	err := p.assetsKeeper.CheckExocoreGatewayAddr(ctx, contract.CallerAddress)
+	if err != nil {
+		return nil, fmt.Errorf(exocmn.ErrContractCaller, err.Error())
+	}
+
+	clientChainID, stakerAddr, enabled, err := p.NSTAutoDelegationFromInputs(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.assetsKeeper.UpdateNSTAutoDelegation(ctx, uint64(clientChainID), stakerAddr, enabled); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
 func (p Precompile) IsRegisteredClientChain(
 	ctx sdk.Context,
 	method *abi.Method,
@@ -214,3 +223,26 @@ func (p Precompile) IsRegisteredClientChain(
 	exists := p.assetsKeeper.ClientChainExists(ctx, uint64(clientChainID))
 	return method.Outputs.Pack(true, exists)
 }
+
+func (p Precompile) GetStakingAssetInfo(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	assetID, err := p.AssetIDFromInputs(ctx, method, args)
+	if err != nil {
+		return nil, err
+	}
+	info, err := p.assetsKeeper.GetStakingAssetInfo(ctx, assetID)
+	if err != nil {
+		return nil, err
+	}
+	maxTotalStaking, maxPerStaker := new(big.Int), new(big.Int)
+	if assetstypes.IsStakingLimited(info.AssetBasicInfo.MaxTotalStaking) {
+		maxTotalStaking = info.AssetBasicInfo.MaxTotalStaking.BigInt()
+	}
+	if assetstypes.IsStakingLimited(info.AssetBasicInfo.MaxPerStaker) {
+		maxPerStaker = info.AssetBasicInfo.MaxPerStaker.BigInt()
+	}
+	return method.Outputs.Pack(true, info.StakingTotalAmount.BigInt(), maxTotalStaking, maxPerStaker)
+}
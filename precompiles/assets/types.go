@@ -4,8 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
-	"regexp"
-	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 
@@ -20,12 +18,6 @@ import (
 	cmn "github.com/evmos/evmos/v16/precompiles/common"
 )
 
-// oracleInfo: '[tokenName],[chainName],[tokenDecimal](,[interval],[contract](,[ChainDesc:{...}],[TokenDesc:{...}]))'
-var (
-	tokenDescMatcher = regexp.MustCompile(`TokenDesc:{(.+?)}`)
-	chainDescMatcher = regexp.MustCompile(`ChainDesc:{(.+?)}`)
-)
-
 func (p Precompile) DepositWithdrawParams(ctx sdk.Context, method *abi.Method, args []interface{}) (*assetskeeper.DepositWithdrawParams, error) {
 	inputsLen := len(p.ABI.Methods[method.Name].Inputs)
 	if len(args) != inputsLen {
@@ -154,7 +146,6 @@ func (p Precompile) TokenFromInputs(ctx sdk.Context, args []interface{}) (assets
 		return assetstypes.AssetInfo{}, oracletypes.OracleInfo{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, inputsLen, len(args))
 	}
 	asset := assetstypes.AssetInfo{}
-	oracleInfo := oracletypes.OracleInfo{}
 
 	clientChainID, ok := args[0].(uint32)
 	if !ok {
@@ -206,31 +197,8 @@ func (p Precompile) TokenFromInputs(ctx sdk.Context, args []interface{}) (assets
 	if !ok {
 		return assetstypes.AssetInfo{}, oracletypes.OracleInfo{}, fmt.Errorf(exocmn.ErrContractInputParaOrType, 5, "string", args[5])
 	}
-	parsed := strings.Split(oracleInfoStr, ",")
-	l := len(parsed)
-	switch {
-	case l > 5:
-		joined := strings.Join(parsed[5:], "")
-		tokenDesc := tokenDescMatcher.FindStringSubmatch(joined)
-		chainDesc := chainDescMatcher.FindStringSubmatch(joined)
-		if len(tokenDesc) == 2 {
-			oracleInfo.Token.Desc = tokenDesc[1]
-		}
-		if len(chainDesc) == 2 {
-			oracleInfo.Chain.Desc = chainDesc[1]
-		}
-		fallthrough
-	case l >= 5:
-		oracleInfo.Token.Contract = parsed[4]
-		fallthrough
-	case l >= 4:
-		oracleInfo.Feeder.Interval = parsed[3]
-		fallthrough
-	case l >= 3:
-		oracleInfo.Token.Name = parsed[0]
-		oracleInfo.Chain.Name = parsed[1]
-		oracleInfo.Token.Decimal = parsed[2]
-	default:
+	oracleInfo, err := oracletypes.ParseOracleInfo(oracleInfoStr)
+	if err != nil {
 		return assetstypes.AssetInfo{}, oracletypes.OracleInfo{}, errors.New(exocmn.ErrInvalidOracleInfo)
 	}
 
@@ -276,6 +244,41 @@ func (p Precompile) UpdateTokenFromInputs(
 	return clientChainID, hexAssetAddr, metadata, nil
 }
 
+// NSTAutoDelegationFromInputs parses the client chain ID, the staker address and the flag of the
+// auto-delegation from the inputs.
+func (p Precompile) NSTAutoDelegationFromInputs(
+	ctx sdk.Context, args []interface{},
+) (clientChainID uint32, stakerAddr []byte, enabled bool, err error) {
+	inputsLen := len(p.ABI.Methods[MethodSetNSTAutoDelegation].Inputs)
+	if len(args) != inputsLen {
+		return 0, nil, false, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, inputsLen, len(args))
+	}
+
+	clientChainID, ok := args[0].(uint32)
+	if !ok {
+		return 0, nil, false, fmt.Errorf(exocmn.ErrContractInputParaOrType, 0, "uint32", args[0])
+	}
+	info, err := p.assetsKeeper.GetClientChainInfoByIndex(ctx, uint64(clientChainID))
+	if err != nil {
+		return 0, nil, false, err
+	}
+	clientChainAddrLength := info.AddressLength
+	stakerAddr, ok = args[1].([]byte)
+	if !ok || len(stakerAddr) == 0 {
+		return 0, nil, false, fmt.Errorf(exocmn.ErrContractInputParaOrType, 1, "[]byte", args[1])
+	}
+	// #nosec G115
+	if uint32(len(stakerAddr)) < clientChainAddrLength {
+		return 0, nil, false, fmt.Errorf(exocmn.ErrInvalidAddrLength, len(stakerAddr), clientChainAddrLength)
+	}
+	enabled, ok = args[2].(bool)
+	if !ok {
+		return 0, nil, false, fmt.Errorf(exocmn.ErrContractInputParaOrType, 2, "bool", args[2])
+	}
+
+	return clientChainID, stakerAddr[:clientChainAddrLength], enabled, nil
+}
+
 func (p Precompile) ClientChainIDFromInputs(_ sdk.Context, args []interface{}) (uint32, error) {
 	inputsLen := len(p.ABI.Methods[MethodIsRegisteredClientChain].Inputs)
 	if len(args) != inputsLen {
@@ -287,3 +290,31 @@ func (p Precompile) ClientChainIDFromInputs(_ sdk.Context, args []interface{}) (
 	}
 	return clientChainID, nil
 }
+
+// AssetIDFromInputs parses the client chain ID and the asset address from the inputs, and
+// returns the assetID.
+func (p Precompile) AssetIDFromInputs(ctx sdk.Context, method *abi.Method, args []interface{}) (string, error) {
+	inputsLen := len(method.Inputs)
+	if len(args) != inputsLen {
+		return "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, inputsLen, len(args))
+	}
+	clientChainID, ok := args[0].(uint32)
+	if !ok {
+		return "", fmt.Errorf(exocmn.ErrContractInputParaOrType, 0, "uint32", args[0])
+	}
+	info, err := p.assetsKeeper.GetClientChainInfoByIndex(ctx, uint64(clientChainID))
+	if err != nil {
+		return "", err
+	}
+	clientChainAddrLength := info.AddressLength
+	assetAddr, ok := args[1].([]byte)
+	if !ok || assetAddr == nil {
+		return "", fmt.Errorf(exocmn.ErrContractInputParaOrType, 1, "[]byte", args[1])
+	}
+	// #nosec G115
+	if uint32(len(assetAddr)) < clientChainAddrLength {
+		return "", fmt.Errorf(exocmn.ErrInvalidAddrLength, len(assetAddr), clientChainAddrLength)
+	}
+	_, assetID := assetstypes.GetStakerIDAndAssetIDFromStr(uint64(clientChainID), "", hexutil.Encode(assetAddr[:clientChainAddrLength]))
+	return assetID, nil
+}
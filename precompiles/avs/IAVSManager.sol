@@ -83,6 +83,15 @@ interface IAVSManager {
         string memory avsName
     ) external returns (bool success);
 
+    /// @dev SetVetoCommittee sets the veto committee of the calling avs, which can veto its
+    /// pending slashes. The owners of the avs can't be members of the committee.
+    /// @param sender The external address for calling this method.
+    /// @param vetoCommittee The bech32 addresses of the committee members.
+    function setVetoCommittee(
+        address sender,
+        string[] memory vetoCommittee
+    ) external returns (bool success);
+
     /// @dev RegisterOperatorToAVS operator opt in current avs
     /// @param sender The external address for calling this method.
     function registerOperatorToAVS(
@@ -107,6 +107,12 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 			ctx.Logger().Error("internal error when calling avs precompile", "module", "avs precompile", "method", method.Name, "err", err)
 			bz, err = method.Outputs.Pack(false)
 		}
+	case MethodSetVetoCommittee:
+		bz, err = p.SetVetoCommittee(ctx, evm.Origin, contract, stateDB, method, args)
+		if err != nil {
+			ctx.Logger().Error("internal error when calling avs precompile", "module", "avs precompile", "method", method.Name, "err", err)
+			bz, err = method.Outputs.Pack(false)
+		}
 	case MethodRegisterOperatorToAVS:
 		bz, err = p.BindOperatorToAVS(ctx, evm.Origin, contract, stateDB, method, args)
 		if err != nil {
@@ -174,7 +180,7 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 //   - AVSRegister
 func (Precompile) IsTransaction(methodID string) bool {
 	switch methodID {
-	case MethodRegisterAVS, MethodDeregisterAVS, MethodUpdateAVS, MethodRegisterOperatorToAVS,
+	case MethodRegisterAVS, MethodDeregisterAVS, MethodUpdateAVS, MethodSetVetoCommittee, MethodRegisterOperatorToAVS,
 		MethodDeregisterOperatorFromAVS, MethodCreateAVSTask, MethodRegisterBLSPublicKey, MethodChallenge:
 		return true
 	case MethodGetRegisteredPubkey, MethodGetOptinOperators, MethodGetAVSUSDValue, MethodGetOperatorOptedUSDValue:
@@ -26,6 +26,7 @@ const (
 	MethodCreateAVSTask             = "createTask"
 	MethodRegisterBLSPublicKey      = "registerBLSPublicKey"
 	MethodChallenge                 = "challenge"
+	MethodSetVetoCommittee          = "setVetoCommittee"
 )
 
 // AVSInfoRegister register the avs related information and change the state in avs keeper module.
@@ -125,6 +126,37 @@ func (p Precompile) UpdateAVS(
 	return method.Outputs.Pack(true)
 }
 
+// SetVetoCommittee sets the veto committee of the calling AVS, the sender must be one of the
+// owners of the AVS.
+func (p Precompile) SetVetoCommittee(
+	ctx sdk.Context,
+	_ common.Address,
+	contract *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != len(p.ABI.Methods[MethodSetVetoCommittee].Inputs) {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, len(p.ABI.Methods[MethodSetVetoCommittee].Inputs), len(args))
+	}
+	callerAddress, ok := args[0].(common.Address)
+	if !ok || (callerAddress == common.Address{}) {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 0, "common.Address", callerAddress)
+	}
+	// bech32
+	vetoCommittee, ok := args[1].([]string)
+	if !ok {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 1, "[]string", vetoCommittee)
+	}
+	err := p.avsKeeper.SetAVSVetoCommittee(
+		ctx, contract.CallerAddress.String(), sdk.AccAddress(callerAddress[:]).String(), vetoCommittee,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
 func (p Precompile) BindOperatorToAVS(
 	ctx sdk.Context,
 	_ common.Address,
@@ -52,6 +52,41 @@ interface IDelegation {
         uint256 opAmount
     ) external returns (bool success);
 
+/// @dev redelegate the client chain assets from the source operator to the destination operator through client chain,
+/// the redelegated assets are still slashable by the source operator until the unbonding period elapses.
+/// Note that this address cannot be a module account.
+/// @param clientChainID is the layerZero chainID if it is supported.
+//  It might be allocated by Exocore when the client chain isn't supported
+//  by layerZero
+/// @param lzNonce The cross chain tx layerZero nonce
+/// @param assetsAddress The client chain asset Address
+/// @param stakerAddress The staker address
+/// @param srcOperatorAddr The operator address that the assets are moved from
+/// @param dstOperatorAddr The operator address that the assets are moved to
+/// @param opAmount The redelegation amount
+    function redelegate(
+        uint32 clientChainID,
+        uint64 lzNonce,
+        bytes calldata assetsAddress,
+        bytes calldata stakerAddress,
+        bytes calldata srcOperatorAddr,
+        bytes calldata dstOperatorAddr,
+        uint256 opAmount
+    ) external returns (bool success);
+
+/// @dev cancel an immature undelegation of the staker through client chain, the remaining amount of
+/// the undelegation after slashing is delegated back to the original operator.
+/// @param clientChainID is the layerZero chainID if it is supported.
+//  It might be allocated by Exocore when the client chain isn't supported
+//  by layerZero
+/// @param stakerAddress The staker address
+/// @param recordKey The key of the undelegation record to be canceled
+    function cancelUndelegation(
+        uint32 clientChainID,
+        bytes calldata stakerAddress,
+        string calldata recordKey
+    ) external returns (bool success);
+
 /// @dev associate the staker as being owned by the specified operator
 /// @param clientChainID is the layerZero chainID if it is supported.
 //  It might be allocated by Exocore when the client chain isn't supported
@@ -99,6 +99,10 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.Delegate(ctx, evm.Origin, contract, stateDB, method, args)
 	case MethodUndelegate:
 		bz, err = p.Undelegate(ctx, evm.Origin, contract, stateDB, method, args)
+	case MethodRedelegate:
+		bz, err = p.Redelegate(ctx, evm.Origin, contract, stateDB, method, args)
+	case MethodCancelUndelegation:
+		bz, err = p.CancelUndelegation(ctx, evm.Origin, contract, stateDB, method, args)
 	case MethodAssociateOperatorWithStaker:
 		bz, err = p.AssociateOperatorWithStaker(ctx, evm.Origin, contract, stateDB, method, args)
 	case MethodDissociateOperatorFromStaker:
@@ -133,12 +137,16 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 // Available delegation transactions are:
 //   - delegate
 //   - undelegate
+//   - redelegate
+//   - cancelUndelegation
 //   - associateOperatorWithStaker
 //   - dissociateOperatorFromStaker
 func (Precompile) IsTransaction(methodID string) bool {
 	switch methodID {
 	case MethodDelegate,
 		MethodUndelegate,
+		MethodRedelegate,
+		MethodCancelUndelegation,
 		MethodAssociateOperatorWithStaker,
 		MethodDissociateOperatorFromStaker:
 		return true
@@ -22,6 +22,14 @@ const (
 	// Undelegate transaction.
 	MethodUndelegate = "undelegate"
 
+	// MethodRedelegate defines the ABI method name for the
+	// Redelegate transaction.
+	MethodRedelegate = "redelegate"
+
+	// MethodCancelUndelegation defines the ABI method name for the
+	// CancelUndelegation transaction.
+	MethodCancelUndelegation = "cancelUndelegation"
+
 	// MethodAssociateOperatorWithStaker defines the ABI method name for the
 	// associateOperatorWithStaker transaction.
 	MethodAssociateOperatorWithStaker = "associateOperatorWithStaker"
@@ -93,6 +101,66 @@ func (p Precompile) Undelegate(
 	return method.Outputs.Pack(true)
 }
 
+// Redelegate moves the client chain assets from the source operator to the destination operator through client chain, that will change the states in delegation and assets module
+func (p Precompile) Redelegate(
+	ctx sdk.Context,
+	_ common.Address,
+	contract *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	// check the invalidation of caller contract
+	err := p.assetsKeeper.CheckExocoreGatewayAddr(ctx, contract.CallerAddress)
+	if err != nil {
+		return nil, fmt.Errorf(exocmn.ErrContractCaller, err.Error())
+	}
+
+	redelegationParams, err := p.GetRedelegationParamsFromInputs(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	txHash, ok := ctx.Value(CtxKeyTxHash).(common.Hash)
+	if !ok || txHash.Bytes() == nil {
+		return nil, fmt.Errorf(ErrCtxTxHash, reflect.TypeOf(ctx.Value(CtxKeyTxHash)), txHash)
+	}
+	redelegationParams.TxHash = txHash
+
+	err = p.delegationKeeper.Redelegate(ctx, redelegationParams)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// CancelUndelegation cancels an immature undelegation through client chain and delegates the remaining amount back to the operator, that will change the states in delegation and assets module
+func (p Precompile) CancelUndelegation(
+	ctx sdk.Context,
+	_ common.Address,
+	contract *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	// check the invalidation of caller contract
+	err := p.assetsKeeper.CheckExocoreGatewayAddr(ctx, contract.CallerAddress)
+	if err != nil {
+		return nil, fmt.Errorf(exocmn.ErrContractCaller, err.Error())
+	}
+
+	cancelParams, err := p.GetCancelUndelegationParamsFromInputs(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.delegationKeeper.CancelUndelegationRecord(ctx, cancelParams)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
 func (p Precompile) AssociateOperatorWithStaker(
 	ctx sdk.Context,
 	_ common.Address,
@@ -81,3 +81,115 @@ func (p Precompile) GetDelegationParamsFromInputs(ctx sdk.Context, args []interf
 	delegationParams.OpAmount = sdkmath.NewIntFromBigInt(opAmount)
 	return delegationParams, nil
 }
+
+func (p Precompile) GetRedelegationParamsFromInputs(ctx sdk.Context, args []interface{}) (*delegationtypes.RedelegationParams, error) {
+	inputsLen := len(p.ABI.Methods[MethodRedelegate].Inputs)
+	if len(args) != inputsLen {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, inputsLen, len(args))
+	}
+
+	redelegationParams := &delegationtypes.RedelegationParams{}
+	clientChainID, ok := args[0].(uint32)
+	if !ok {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 0, "uint32", args[0])
+	}
+	redelegationParams.ClientChainID = uint64(clientChainID)
+
+	info, err := p.assetsKeeper.GetClientChainInfoByIndex(ctx, redelegationParams.ClientChainID)
+	if err != nil {
+		return nil, err
+	}
+	clientChainAddrLength := info.AddressLength
+
+	txLzNonce, ok := args[1].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 1, "uint64", args[1])
+	}
+	redelegationParams.LzNonce = txLzNonce
+
+	assetAddr, ok := args[2].([]byte)
+	if !ok || assetAddr == nil {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 2, "[]byte", args[2])
+	}
+	// #nosec G115
+	if uint32(len(assetAddr)) < clientChainAddrLength {
+		return nil, fmt.Errorf(exocmn.ErrInvalidAddrLength, len(assetAddr), clientChainAddrLength)
+	}
+	redelegationParams.AssetsAddress = assetAddr[:clientChainAddrLength]
+
+	stakerAddr, ok := args[3].([]byte)
+	if !ok || stakerAddr == nil {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 3, "[]byte", args[3])
+	}
+	// #nosec G115
+	if uint32(len(stakerAddr)) < clientChainAddrLength {
+		return nil, fmt.Errorf(exocmn.ErrInvalidAddrLength, len(stakerAddr), clientChainAddrLength)
+	}
+	redelegationParams.StakerAddress = stakerAddr[:clientChainAddrLength]
+
+	// the input operator addresses are cosmos accAddress type, so we need to check the length and decode them through Bench32
+	operators := make([]sdk.AccAddress, 0, 2)
+	for i := 4; i <= 5; i++ {
+		operatorAddr, ok := args[i].([]byte)
+		if !ok || operatorAddr == nil {
+			return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, i, "[]byte", args[i])
+		}
+		if len(operatorAddr) != types.ExoCoreOperatorAddrLength {
+			return nil, fmt.Errorf(exocmn.ErrInputOperatorAddrLength, len(operatorAddr), types.ExoCoreOperatorAddrLength)
+		}
+		opAccAddr, err := sdk.AccAddressFromBech32(string(operatorAddr))
+		if err != nil {
+			return nil, fmt.Errorf("error occurred when parse acc address from Bech32,the addr is:%s, error:%s", string(operatorAddr), err.Error())
+		}
+		operators = append(operators, opAccAddr)
+	}
+	redelegationParams.SrcOperatorAddress = operators[0]
+	redelegationParams.DstOperatorAddress = operators[1]
+
+	opAmount, ok := args[6].(*big.Int)
+	if !ok || opAmount == nil || !(opAmount.Cmp(big.NewInt(0)) == 1) {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 6, "*big.Int", args[6])
+	}
+	redelegationParams.OpAmount = sdkmath.NewIntFromBigInt(opAmount)
+	return redelegationParams, nil
+}
+
+func (p Precompile) GetCancelUndelegationParamsFromInputs(ctx sdk.Context, args []interface{}) (*delegationtypes.CancelUndelegationParams, error) {
+	inputsLen := len(p.ABI.Methods[MethodCancelUndelegation].Inputs)
+	if len(args) != inputsLen {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, inputsLen, len(args))
+	}
+
+	cancelParams := &delegationtypes.CancelUndelegationParams{}
+	clientChainID, ok := args[0].(uint32)
+	if !ok {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 0, "uint32", args[0])
+	}
+	cancelParams.ClientChainID = uint64(clientChainID)
+
+	info, err := p.assetsKeeper.GetClientChainInfoByIndex(ctx, cancelParams.ClientChainID)
+	if err != nil {
+		return nil, err
+	}
+	clientChainAddrLength := info.AddressLength
+
+	stakerAddr, ok := args[1].([]byte)
+	if !ok || stakerAddr == nil {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 1, "[]byte", args[1])
+	}
+	// #nosec G115
+	if uint32(len(stakerAddr)) < clientChainAddrLength {
+		return nil, fmt.Errorf(exocmn.ErrInvalidAddrLength, len(stakerAddr), clientChainAddrLength)
+	}
+	cancelParams.StakerAddress = stakerAddr[:clientChainAddrLength]
+
+	recordKey, ok := args[2].(string)
+	if !ok || recordKey == "" {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 2, "string", args[2])
+	}
+	if _, err := delegationtypes.ParseUndelegationRecordKey([]byte(recordKey)); err != nil {
+		return nil, err
+	}
+	cancelParams.RecordKey = recordKey
+	return cancelParams, nil
+}
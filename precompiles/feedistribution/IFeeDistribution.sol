@@ -0,0 +1,43 @@
+pragma solidity >=0.8.17;
+
+/// @dev The fee distribution contract's address.
+address constant FEE_DISTRIBUTION_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080a;
+
+/// @dev The fee distribution contract's instance.
+IFeeDistribution constant FEE_DISTRIBUTION_CONTRACT = IFeeDistribution(
+    FEE_DISTRIBUTION_PRECOMPILE_ADDRESS
+);
+
+/// @author Exocore Team
+/// @title fee distribution Precompile Contract
+/// @dev The interface through which solidity contracts will interact with fee distribution
+/// @custom:address 0x000000000000000000000000000000000000080a
+interface IFeeDistribution {
+/// TRANSACTIONS
+/// @dev set the address to which the rewards and commission of the caller are withdrawn
+/// Note that this address cannot be a module account.
+/// @param withdrawAddress The address that receives the rewards and commission
+    function setWithdrawAddress(
+        address withdrawAddress
+    ) external returns (bool success);
+
+/// @dev withdraw the outstanding rewards of the caller as a staker to its withdraw address
+/// @param clientChainID is the layerZero chainID if it is supported.
+//  It might be allocated by Exocore when the client chain isn't supported
+//  by layerZero
+    function withdrawStakerReward(
+        uint32 clientChainID
+    ) external returns (bool success);
+
+/// @dev withdraw the accumulated commission of the caller as an operator to its withdraw address
+    function withdrawOperatorCommission() external returns (bool success);
+
+/// @dev deposit the EVM native token of the caller into the reward pool of the AVS, which is
+/// distributed to the operators opted into the AVS and their stakers every epoch
+/// @param avsAddress The address of the AVS
+/// @param amount The amount of the EVM native token to deposit
+    function depositAVSRewards(
+        address avsAddress,
+        uint256 amount
+    ) external returns (bool success);
+}
@@ -0,0 +1,300 @@
+package feedistribution_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/app"
+	"github.com/ExocoreNetwork/exocore/precompiles/feedistribution"
+	"github.com/ExocoreNetwork/exocore/utils"
+	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	exominttypes "github.com/ExocoreNetwork/exocore/x/exomint/types"
+	distrtypes "github.com/ExocoreNetwork/exocore/x/feedistribution/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v16/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+)
+
+func (s *FeeDistributionPrecompileSuite) TestIsTransaction() {
+	testCases := []struct {
+		name   string
+		method string
+		isTx   bool
+	}{
+		{
+			feedistribution.MethodSetWithdrawAddress,
+			s.precompile.Methods[feedistribution.MethodSetWithdrawAddress].Name,
+			true,
+		},
+		{
+			feedistribution.MethodWithdrawStakerReward,
+			s.precompile.Methods[feedistribution.MethodWithdrawStakerReward].Name,
+			true,
+		},
+		{
+			feedistribution.MethodWithdrawOperatorCommission,
+			s.precompile.Methods[feedistribution.MethodWithdrawOperatorCommission].Name,
+			true,
+		},
+		{
+			feedistribution.MethodDepositAVSRewards,
+			s.precompile.Methods[feedistribution.MethodDepositAVSRewards].Name,
+			true,
+		},
+		{
+			"invalid",
+			"invalid",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Require().Equal(s.precompile.IsTransaction(tc.method), tc.isTx)
+		})
+	}
+}
+
+// TestRunWithdrawStakerReward tests the precompiled Run method withdrawStakerReward.
+func (s *FeeDistributionPrecompileSuite) TestRunWithdrawStakerReward() {
+	clientChainID := uint32(assetstype.ExocoreChainLzID)
+	rewardAmount := sdkmath.NewInt(50)
+
+	commonMalleate := func() (common.Address, []byte) {
+		input, err := s.precompile.Pack(
+			feedistribution.MethodWithdrawStakerReward,
+			clientChainID,
+		)
+		s.Require().NoError(err, "failed to pack input")
+		return s.Address, input
+	}
+	successRet, err := s.precompile.Methods[feedistribution.MethodWithdrawStakerReward].Outputs.Pack(true)
+	s.Require().NoError(err)
+	failureRet, err := s.precompile.Methods[feedistribution.MethodWithdrawStakerReward].Outputs.Pack(false)
+	s.Require().NoError(err)
+
+	testcases := []struct {
+		name        string
+		malleate    func() (common.Address, []byte)
+		readOnly    bool
+		expPass     bool
+		returnBytes []byte
+		expBalance  sdkmath.Int
+	}{
+		{
+			name: "pass - withdraw staker reward via pre-compiles",
+			malleate: func() (common.Address, []byte) {
+				coins := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, rewardAmount))
+				err := s.App.BankKeeper.MintCoins(s.Ctx, exominttypes.ModuleName, coins)
+				s.Require().NoError(err)
+				err = s.App.BankKeeper.SendCoinsFromModuleToModule(s.Ctx, exominttypes.ModuleName, distrtypes.ModuleName, coins)
+				s.Require().NoError(err)
+				stakerID, _ := assetstype.GetStakerIDAndAssetID(uint64(clientChainID), s.Address.Bytes(), nil)
+				s.App.DistrKeeper.SetStakerRewards(s.Ctx, stakerID, distrtypes.StakerOutstandingRewards{
+					Rewards: sdk.NewDecCoinsFromCoins(coins...),
+				})
+				return commonMalleate()
+			},
+			returnBytes: successRet,
+			readOnly:    false,
+			expPass:     true,
+			expBalance:  rewardAmount,
+		},
+		{
+			name:        "fail - no outstanding rewards",
+			malleate:    commonMalleate,
+			returnBytes: failureRet,
+			readOnly:    false,
+			expPass:     true,
+			expBalance:  sdkmath.ZeroInt(),
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		s.Run(tc.name, func() {
+			// setup basic test suite
+			s.SetupTest()
+
+			baseFee := s.App.FeeMarketKeeper.GetBaseFee(s.Ctx)
+
+			// malleate testcase
+			caller, input := tc.malleate()
+			balanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, caller.Bytes(), utils.BaseDenom).Amount
+
+			contract := vm.NewPrecompile(vm.AccountRef(caller), s.precompile, big.NewInt(0), uint64(1e6))
+			contract.Input = input
+
+			contractAddr := contract.Address()
+			// Build and sign Ethereum transaction
+			txArgs := evmtypes.EvmTxArgs{
+				ChainID:   s.App.EvmKeeper.ChainID(),
+				Nonce:     0,
+				To:        &contractAddr,
+				Amount:    nil,
+				GasLimit:  100000,
+				GasPrice:  app.MainnetMinGasPrices.BigInt(),
+				GasFeeCap: baseFee,
+				GasTipCap: big.NewInt(1),
+				Accesses:  &ethtypes.AccessList{},
+			}
+			msgEthereumTx := evmtypes.NewTx(&txArgs)
+
+			msgEthereumTx.From = s.Address.String()
+			err := msgEthereumTx.Sign(s.EthSigner, s.Signer)
+			s.Require().NoError(err, "failed to sign Ethereum message")
+
+			// Instantiate config
+			proposerAddress := s.Ctx.BlockHeader().ProposerAddress
+			cfg, err := s.App.EvmKeeper.EVMConfig(s.Ctx, proposerAddress, s.App.EvmKeeper.ChainID())
+			s.Require().NoError(err, "failed to instantiate EVM config")
+
+			msg, err := msgEthereumTx.AsMessage(s.EthSigner, baseFee)
+			s.Require().NoError(err, "failed to instantiate Ethereum message")
+
+			// Create StateDB
+			s.StateDB = statedb.New(s.Ctx, s.App.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(s.Ctx.HeaderHash().Bytes())))
+			// Instantiate EVM
+			evm := s.App.EvmKeeper.NewEVM(
+				s.Ctx, msg, cfg, nil, s.StateDB,
+			)
+			params := s.App.EvmKeeper.GetParams(s.Ctx)
+			activePrecompiles := params.GetActivePrecompilesAddrs()
+			precompileMap := s.App.EvmKeeper.Precompiles(activePrecompiles...)
+			err = vm.ValidatePrecompiles(precompileMap, activePrecompiles)
+			s.Require().NoError(err, "invalid precompiles", activePrecompiles)
+			evm.WithPrecompiles(precompileMap, activePrecompiles)
+
+			// the transaction touches the state object of the caller before calling the precompile
+			s.StateDB.SetNonce(caller, s.StateDB.GetNonce(caller)+1)
+
+			// Run precompiled contract
+			bz, err := s.precompile.Run(evm, contract, tc.readOnly)
+
+			// for failed cases we expect it returns bool value instead of error
+			// this is a workaround because the error returned by precompile can not be caught in EVM
+			// see https://github.com/ExocoreNetwork/exocore/issues/70
+			// TODO: we should figure out root cause and fix this issue to make precompiles work normally
+			s.Require().NoError(err, "expected no error when running the precompile")
+			s.Require().Equal(tc.returnBytes, bz, "the return doesn't match the expected result")
+			balanceAfter := s.App.BankKeeper.GetBalance(s.Ctx, caller.Bytes(), utils.BaseDenom).Amount
+			s.Require().True(tc.expBalance.Equal(balanceAfter.Sub(balanceBefore)), "unexpected withdrawn amount")
+			s.Require().Equal(balanceAfter.BigInt(), s.StateDB.GetBalance(caller), "the stateDB doesn't mirror the withdrawal")
+
+			// committing the EVM state must not overwrite the withdrawn amount
+			s.Require().NoError(s.StateDB.Commit())
+			balanceAfter = s.App.BankKeeper.GetBalance(s.Ctx, caller.Bytes(), utils.BaseDenom).Amount
+			s.Require().True(tc.expBalance.Equal(balanceAfter.Sub(balanceBefore)), "the withdrawn amount is overwritten")
+		})
+	}
+}
+
+// TestRunDepositAVSRewards tests the precompiled Run method depositAVSRewards.
+func (s *FeeDistributionPrecompileSuite) TestRunDepositAVSRewards() {
+	amount := sdkmath.NewInt(50)
+	successRet, err := s.precompile.Methods[feedistribution.MethodDepositAVSRewards].Outputs.Pack(true)
+	s.Require().NoError(err)
+	failureRet, err := s.precompile.Methods[feedistribution.MethodDepositAVSRewards].Outputs.Pack(false)
+	s.Require().NoError(err)
+
+	testcases := []struct {
+		name        string
+		malleate    func(avsAddr common.Address) ([]byte, sdk.Coins)
+		returnBytes []byte
+	}{
+		{
+			name: "pass - deposit the EVM denom",
+			malleate: func(avsAddr common.Address) ([]byte, sdk.Coins) {
+				coins := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, amount))
+				err := s.App.BankKeeper.MintCoins(s.Ctx, exominttypes.ModuleName, coins)
+				s.Require().NoError(err)
+				err = s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, exominttypes.ModuleName, s.Address.Bytes(), coins)
+				s.Require().NoError(err)
+				input, err := s.precompile.Pack(feedistribution.MethodDepositAVSRewards, avsAddr, amount.BigInt())
+				s.Require().NoError(err, "failed to pack input")
+				return input, coins
+			},
+			returnBytes: successRet,
+		},
+		{
+			name: "fail - deposit for an unregistered AVS",
+			malleate: func(common.Address) ([]byte, sdk.Coins) {
+				input, err := s.precompile.Pack(feedistribution.MethodDepositAVSRewards, s.Address, amount.BigInt())
+				s.Require().NoError(err, "failed to pack input")
+				return input, nil
+			},
+			returnBytes: failureRet,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			avsAddr := common.HexToAddress(avstypes.GenerateAVSAddr(avstypes.ChainIDWithoutRevision(s.Ctx.ChainID())))
+			input, expRewards := tc.malleate(avsAddr)
+			balanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, s.Address.Bytes(), utils.BaseDenom).Amount
+
+			bz := s.runPrecompile(input)
+			s.Require().Equal(tc.returnBytes, bz, "the return doesn't match the expected result")
+			pool := s.App.DistrKeeper.GetAVSRewardPool(s.Ctx, avsAddr.Hex())
+			s.Require().True(sdk.NewDecCoinsFromCoins(expRewards...).IsEqual(pool.Rewards), "unexpected reward pool")
+
+			// committing the EVM state must not overwrite the deposited amount
+			s.Require().NoError(s.StateDB.Commit())
+			balanceAfter := s.App.BankKeeper.GetBalance(s.Ctx, s.Address.Bytes(), utils.BaseDenom).Amount
+			s.Require().True(
+				expRewards.AmountOf(utils.BaseDenom).Equal(balanceBefore.Sub(balanceAfter)),
+				"the deposited amount is overwritten",
+			)
+		})
+	}
+}
+
+// runPrecompile runs the precompile with the input as a transaction sent by the test account.
+func (s *FeeDistributionPrecompileSuite) runPrecompile(input []byte) []byte {
+	baseFee := s.App.FeeMarketKeeper.GetBaseFee(s.Ctx)
+	contract := vm.NewPrecompile(vm.AccountRef(s.Address), s.precompile, big.NewInt(0), uint64(1e6))
+	contract.Input = input
+
+	contractAddr := contract.Address()
+	txArgs := evmtypes.EvmTxArgs{
+		ChainID:   s.App.EvmKeeper.ChainID(),
+		Nonce:     0,
+		To:        &contractAddr,
+		Amount:    nil,
+		GasLimit:  100000,
+		GasPrice:  app.MainnetMinGasPrices.BigInt(),
+		GasFeeCap: baseFee,
+		GasTipCap: big.NewInt(1),
+		Accesses:  &ethtypes.AccessList{},
+	}
+	msgEthereumTx := evmtypes.NewTx(&txArgs)
+	msgEthereumTx.From = s.Address.String()
+	err := msgEthereumTx.Sign(s.EthSigner, s.Signer)
+	s.Require().NoError(err, "failed to sign Ethereum message")
+
+	proposerAddress := s.Ctx.BlockHeader().ProposerAddress
+	cfg, err := s.App.EvmKeeper.EVMConfig(s.Ctx, proposerAddress, s.App.EvmKeeper.ChainID())
+	s.Require().NoError(err, "failed to instantiate EVM config")
+	msg, err := msgEthereumTx.AsMessage(s.EthSigner, baseFee)
+	s.Require().NoError(err, "failed to instantiate Ethereum message")
+
+	s.StateDB = statedb.New(s.Ctx, s.App.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(s.Ctx.HeaderHash().Bytes())))
+	evm := s.App.EvmKeeper.NewEVM(s.Ctx, msg, cfg, nil, s.StateDB)
+	params := s.App.EvmKeeper.GetParams(s.Ctx)
+	activePrecompiles := params.GetActivePrecompilesAddrs()
+	precompileMap := s.App.EvmKeeper.Precompiles(activePrecompiles...)
+	err = vm.ValidatePrecompiles(precompileMap, activePrecompiles)
+	s.Require().NoError(err, "invalid precompiles", activePrecompiles)
+	evm.WithPrecompiles(precompileMap, activePrecompiles)
+
+	// the transaction touches the state object of the caller before calling the precompile
+	s.StateDB.SetNonce(s.Address, s.StateDB.GetNonce(s.Address)+1)
+
+	bz, err := s.precompile.Run(evm, contract, false)
+	s.Require().NoError(err, "expected no error when running the precompile")
+	return bz
+}
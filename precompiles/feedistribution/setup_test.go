@@ -0,0 +1,37 @@
+package feedistribution_test
+
+import (
+	"testing"
+
+	"github.com/ExocoreNetwork/exocore/precompiles/feedistribution"
+	"github.com/ExocoreNetwork/exocore/testutil"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/stretchr/testify/suite"
+)
+
+var s *FeeDistributionPrecompileSuite
+
+type FeeDistributionPrecompileSuite struct {
+	testutil.BaseTestSuite
+
+	precompile *feedistribution.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	s = new(FeeDistributionPrecompileSuite)
+	suite.Run(t, s)
+
+	// Run Ginkgo integration tests
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "fee distribution Precompile Suite")
+}
+
+func (s *FeeDistributionPrecompileSuite) SetupTest() {
+	s.DoSetupTest()
+	precompile, err := feedistribution.NewPrecompile(s.App.DistrKeeper, s.App.AuthzKeeper)
+	s.Require().NoError(err)
+	s.precompile = precompile
+}
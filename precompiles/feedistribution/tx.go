@@ -0,0 +1,158 @@
+package feedistribution
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	exocmn "github.com/ExocoreNetwork/exocore/precompiles/common"
+	"github.com/ExocoreNetwork/exocore/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	"github.com/evmos/evmos/v16/x/evm/statedb"
+)
+
+const (
+	// MethodSetWithdrawAddress defines the ABI method name for the
+	// SetWithdrawAddress transaction.
+	MethodSetWithdrawAddress = "setWithdrawAddress"
+
+	// MethodWithdrawStakerReward defines the ABI method name for the
+	// WithdrawStakerReward transaction.
+	MethodWithdrawStakerReward = "withdrawStakerReward"
+
+	// MethodWithdrawOperatorCommission defines the ABI method name for the
+	// WithdrawOperatorCommission transaction.
+	MethodWithdrawOperatorCommission = "withdrawOperatorCommission"
+
+	// MethodDepositAVSRewards defines the ABI method name for the
+	// DepositAVSRewards transaction.
+	MethodDepositAVSRewards = "depositAVSRewards"
+)
+
+// SetWithdrawAddress sets the address to which the rewards and commission of the caller are sent.
+func (p Precompile) SetWithdrawAddress(
+	ctx sdk.Context,
+	_ common.Address,
+	contract *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	inputsLen := len(p.ABI.Methods[MethodSetWithdrawAddress].Inputs)
+	if len(args) != inputsLen {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, inputsLen, len(args))
+	}
+	withdrawAddr, ok := args[0].(common.Address)
+	if !ok || withdrawAddr == (common.Address{}) {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 0, "common.Address", args[0])
+	}
+
+	err := p.distrKeeper.SetWithdrawAddr(ctx, contract.CallerAddress.Bytes(), withdrawAddr.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// WithdrawStakerReward withdraws the outstanding rewards of the caller as a staker, which
+// allows the EVM-native stakers to claim their rewards.
+func (p Precompile) WithdrawStakerReward(
+	ctx sdk.Context,
+	_ common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	inputsLen := len(p.ABI.Methods[MethodWithdrawStakerReward].Inputs)
+	if len(args) != inputsLen {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, inputsLen, len(args))
+	}
+	clientChainID, ok := args[0].(uint32)
+	if !ok {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 0, "uint32", args[0])
+	}
+
+	recipient := p.loadWithdrawAddr(ctx, contract.CallerAddress, stateDB)
+	rewards, err := p.distrKeeper.WithdrawStakerReward(ctx, contract.CallerAddress.Bytes(), uint64(clientChainID))
+	if err != nil {
+		return nil, err
+	}
+	mirrorWithdrawal(stateDB, recipient, rewards)
+	return method.Outputs.Pack(true)
+}
+
+// WithdrawOperatorCommission withdraws the accumulated commission of the caller as an operator.
+func (p Precompile) WithdrawOperatorCommission(
+	ctx sdk.Context,
+	_ common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	recipient := p.loadWithdrawAddr(ctx, contract.CallerAddress, stateDB)
+	commission, err := p.distrKeeper.WithdrawOperatorCommission(ctx, contract.CallerAddress.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	mirrorWithdrawal(stateDB, recipient, commission)
+	return method.Outputs.Pack(true)
+}
+
+// DepositAVSRewards deposits the EVM denom of the caller into the reward pool of the AVS.
+func (p Precompile) DepositAVSRewards(
+	ctx sdk.Context,
+	_ common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	inputsLen := len(p.ABI.Methods[MethodDepositAVSRewards].Inputs)
+	if len(args) != inputsLen {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, inputsLen, len(args))
+	}
+	avsAddr, ok := args[0].(common.Address)
+	if !ok || avsAddr == (common.Address{}) {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 0, "common.Address", args[0])
+	}
+	amount, ok := args[1].(*big.Int)
+	if !ok || amount == nil || amount.Sign() <= 0 {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 1, "*big.Int", args[1])
+	}
+
+	// load the caller before the bank transfer, see loadWithdrawAddr.
+	stateDB.GetBalance(contract.CallerAddress)
+	coins := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdkmath.NewIntFromBigInt(amount)))
+	if err := p.distrKeeper.DepositAVSRewards(ctx, contract.CallerAddress.Bytes(), avsAddr.Hex(), coins); err != nil {
+		return nil, err
+	}
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+	stateDB.(*statedb.StateDB).SubBalance(contract.CallerAddress, amount)
+	return method.Outputs.Pack(true)
+}
+
+// loadWithdrawAddr returns the address that receives the withdrawn coins of the caller and
+// loads it into the stateDB before the withdrawal. Otherwise, the state object would be
+// created from the balance after the bank transfer and the mirrored amount counted twice.
+func (p Precompile) loadWithdrawAddr(ctx sdk.Context, caller common.Address, stateDB vm.StateDB) common.Address {
+	recipient := common.BytesToAddress(p.distrKeeper.GetWithdrawAddr(ctx, caller.Bytes()))
+	stateDB.GetBalance(recipient)
+	return recipient
+}
+
+// mirrorWithdrawal adds the withdrawn EVM denom to the balance of the recipient in the stateDB.
+// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+func mirrorWithdrawal(stateDB vm.StateDB, recipient common.Address, coins sdk.Coins) {
+	amount := coins.AmountOf(utils.BaseDenom)
+	if amount.IsPositive() {
+		stateDB.(*statedb.StateDB).AddBalance(recipient, amount.BigInt())
+	}
+}
@@ -20,6 +20,8 @@ interface IClaimReward {
 /// @param assetsAddress The client chain asset Address
 /// @param withdrawRewardAddress The claim reward address
 /// @param opAmount The reward amount
+/// @return success Whether the claim succeeded
+/// @return latestAssetState The remaining claimable reward of the staker for the asset
     function claimReward(
     uint32 clientChainLzID,
     bytes memory assetsAddress,
@@ -41,11 +41,8 @@ func (p Precompile) Reward(
 	if err != nil {
 		return nil, err
 	}
-	// get the latest asset state of staker to return.
+	// get the remaining claimable reward of staker to return.
 	stakerID, assetID := types.GetStakerIDAndAssetID(rewardParam.ClientChainLzID, rewardParam.WithdrawRewardAddress, rewardParam.AssetsAddress)
-	info, err := p.assetsKeeper.GetStakerSpecifiedAssetInfo(ctx, stakerID, assetID)
-	if err != nil {
-		return nil, err
-	}
-	return method.Outputs.Pack(true, info.TotalDepositAmount.BigInt())
+	remaining := p.rewardKeeper.GetStakerReward(ctx, stakerID, assetID)
+	return method.Outputs.Pack(true, remaining.BigInt())
 }
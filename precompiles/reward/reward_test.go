@@ -9,6 +9,7 @@ import (
 	"github.com/ExocoreNetwork/exocore/app"
 	"github.com/ExocoreNetwork/exocore/precompiles/reward"
 	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
+	rewardtypes "github.com/ExocoreNetwork/exocore/x/reward/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -57,6 +58,7 @@ func (s *RewardPrecompileTestSuite) TestRunRewardThroughClientChain() {
 	clientChainLzID := 101
 	withdrawAmount := big.NewInt(10)
 	depositAmount := big.NewInt(100)
+	rewardAmount := big.NewInt(50)
 	assetAddr := paddingClientChainAddress(usdtAddress, assetstype.GeneralClientChainAddrLength)
 	depositAsset := func(staker []byte, depositAmount sdkmath.Int) {
 		// deposit asset for reward test
@@ -83,9 +85,9 @@ func (s *RewardPrecompileTestSuite) TestRunRewardThroughClientChain() {
 		s.Require().NoError(err, "failed to pack input")
 		return s.Address, input
 	}
-	// successRet, err := s.precompile.Methods[reward.MethodReward].Outputs.Pack(true, new(big.Int).Add(depositAmount, withdrawAmount))
-	// TODO: reward precompile is disabled, so it always errors and returns fail
-	successRet, err := s.precompile.Methods[reward.MethodReward].Outputs.Pack(false, new(big.Int))
+	successRet, err := s.precompile.Methods[reward.MethodReward].Outputs.Pack(true, new(big.Int).Sub(rewardAmount, withdrawAmount))
+	s.Require().NoError(err)
+	failureRet, err := s.precompile.Methods[reward.MethodReward].Outputs.Pack(false, new(big.Int))
 	s.Require().NoError(err)
 	testcases := []struct {
 		name        string
@@ -105,12 +107,33 @@ func (s *RewardPrecompileTestSuite) TestRunRewardThroughClientChain() {
 				err := s.App.AssetsKeeper.SetParams(s.Ctx, depositModuleParam)
 				s.Require().NoError(err)
 				depositAsset(s.Address.Bytes(), sdkmath.NewIntFromBigInt(depositAmount))
+				stakerID, assetID := assetstype.GetStakerIDAndAssetID(uint64(clientChainLzID), s.Address.Bytes(), usdtAddress)
+				err = s.App.RewardKeeper.AddStakerReward(s.Ctx, stakerID, assetID, sdkmath.NewIntFromBigInt(rewardAmount))
+				s.Require().NoError(err)
+				// the claimable reward is backed by the escrow of the distributed rewards
+				s.App.RewardKeeper.SetAllRewardEscrows(s.Ctx, []rewardtypes.RewardEscrow{{AssetID: assetID, Amount: sdkmath.NewIntFromBigInt(rewardAmount)}})
 				return commonMalleate()
 			},
 			returnBytes: successRet,
 			readOnly:    false,
 			expPass:     true,
 		},
+		{
+			name: "fail - insufficient reward",
+			malleate: func() (common.Address, []byte) {
+				depositModuleParam := &assetstype.Params{
+					ExocoreLzAppAddress:    s.Address.String(),
+					ExocoreLzAppEventTopic: exocoreLzAppEventTopic,
+				}
+				err := s.App.AssetsKeeper.SetParams(s.Ctx, depositModuleParam)
+				s.Require().NoError(err)
+				depositAsset(s.Address.Bytes(), sdkmath.NewIntFromBigInt(depositAmount))
+				return commonMalleate()
+			},
+			returnBytes: failureRet,
+			readOnly:    false,
+			expPass:     true,
+		},
 	}
 	for _, tc := range testcases {
 		tc := tc
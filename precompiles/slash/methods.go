@@ -14,6 +14,15 @@ const (
 	// MethodSlash defines the ABI method name for the slash
 	//  transaction.
 	MethodSlash = "submitSlash"
+	// MethodFreezeOperator defines the ABI method name for freezing an operator
+	// on behalf of the calling AVS.
+	MethodFreezeOperator = "freezeOperator"
+	// MethodUnfreezeOperator defines the ABI method name for unfreezing an operator
+	// on behalf of the calling AVS.
+	MethodUnfreezeOperator = "unfreezeOperator"
+	// MethodIsOperatorFrozen defines the ABI method name for querying the frozen status
+	// of an operator.
+	MethodIsOperatorFrozen = "isOperatorFrozen"
 )
 
 // SubmitSlash Slash assets to the staker, that will change the state in slash module.
@@ -42,3 +51,54 @@ func (p Precompile) SubmitSlash(
 	}
 	return method.Outputs.Pack(true)
 }
+
+// FreezeOperator freezes the operator on behalf of the calling AVS contract, the operator
+// should be opted into the AVS.
+func (p Precompile) FreezeOperator(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	operator, reason, err := p.GetFreezeParamsFromInputs(args)
+	if err != nil {
+		return nil, err
+	}
+	err = p.slashKeeper.FreezeOperatorByAVS(ctx, contract.CallerAddress, operator, reason)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// UnfreezeOperator unfreezes the operator on behalf of the calling AVS contract, only the
+// AVS which froze the operator is allowed to unfreeze it.
+func (p Precompile) UnfreezeOperator(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	operator, err := p.GetOperatorFromInputs(args)
+	if err != nil {
+		return nil, err
+	}
+	err = p.slashKeeper.UnfreezeOperatorByAVS(ctx, contract.CallerAddress, operator)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// IsOperatorFrozen returns whether the operator is frozen.
+func (p Precompile) IsOperatorFrozen(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	operator, err := p.GetOperatorFromInputs(args)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(p.slashKeeper.IsOperatorFrozen(ctx, operator))
+}
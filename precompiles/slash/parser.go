@@ -58,3 +58,40 @@ func (p Precompile) GetSlashParamsFromInputs(ctx sdk.Context, args []interface{}
 	slashParams.OpAmount = sdkmath.NewIntFromBigInt(opAmount)
 	return slashParams, nil
 }
+
+// GetOperatorFromInputs parses the operator address, which is the only input of the
+// unfreezeOperator and isOperatorFrozen methods.
+func (p Precompile) GetOperatorFromInputs(args []interface{}) (sdk.AccAddress, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+	return parseOperator(args[0], 0)
+}
+
+// GetFreezeParamsFromInputs parses the inputs of the freezeOperator method.
+func (p Precompile) GetFreezeParamsFromInputs(args []interface{}) (sdk.AccAddress, string, error) {
+	if len(args) != 2 {
+		return nil, "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+	operator, err := parseOperator(args[0], 0)
+	if err != nil {
+		return nil, "", err
+	}
+	reason, ok := args[1].(string)
+	if !ok {
+		return nil, "", fmt.Errorf(exocmn.ErrContractInputParaOrType, 1, "string", args[1])
+	}
+	return operator, reason, nil
+}
+
+func parseOperator(arg interface{}, index int) (sdk.AccAddress, error) {
+	operatorAddr, ok := arg.(string)
+	if !ok {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, index, "string", arg)
+	}
+	operator, err := sdk.AccAddressFromBech32(operatorAddr)
+	if err != nil {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, index, "bech32 address", operatorAddr)
+	}
+	return operator, nil
+}
@@ -95,8 +95,15 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		return nil, err
 	}
 
-	if method.Name == MethodSlash {
+	switch method.Name {
+	case MethodSlash:
 		bz, err = p.SubmitSlash(ctx, evm.Origin, contract, stateDB, method, args)
+	case MethodFreezeOperator:
+		bz, err = p.FreezeOperator(ctx, contract, method, args)
+	case MethodUnfreezeOperator:
+		bz, err = p.UnfreezeOperator(ctx, contract, method, args)
+	case MethodIsOperatorFrozen:
+		bz, err = p.IsOperatorFrozen(ctx, method, args)
 	}
 
 	if err != nil {
@@ -116,9 +123,11 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 //
 // Available slash transactions are:
 //   - slash
+//   - freezeOperator
+//   - unfreezeOperator
 func (Precompile) IsTransaction(methodID string) bool {
 	switch methodID {
-	case MethodSlash:
+	case MethodSlash, MethodFreezeOperator, MethodUnfreezeOperator:
 		return true
 	default:
 		return false
@@ -34,4 +34,27 @@ interface ISlash {
         string memory proportion,
         string memory proof
     ) external returns (bool success);
+
+/// @dev Freeze the operator on behalf of the calling AVS, the frozen operator
+/// can't be delegated to or undelegated from until it's unfrozen.
+/// Note that the caller should be a registered AVS and the operator should be opted into it.
+/// @param operatorAddress The bech32 address of the operator
+/// @param reason The reason to freeze the operator
+    function freezeOperator(
+        string memory operatorAddress,
+        string memory reason
+    ) external returns (bool success);
+
+/// @dev Unfreeze the operator, only the AVS which froze the operator can unfreeze it.
+/// @param operatorAddress The bech32 address of the operator
+    function unfreezeOperator(
+        string memory operatorAddress
+    ) external returns (bool success);
+
+/// QUERIES
+/// @dev Returns whether the operator is frozen
+/// @param operatorAddress The bech32 address of the operator
+    function isOperatorFrozen(
+        string memory operatorAddress
+    ) external view returns (bool frozen);
 }
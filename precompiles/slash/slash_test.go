@@ -27,6 +27,21 @@ func (s *SlashPrecompileTestSuite) TestIsTransaction() {
 			s.precompile.Methods[slash.MethodSlash].Name,
 			true,
 		},
+		{
+			slash.MethodFreezeOperator,
+			s.precompile.Methods[slash.MethodFreezeOperator].Name,
+			true,
+		},
+		{
+			slash.MethodUnfreezeOperator,
+			s.precompile.Methods[slash.MethodUnfreezeOperator].Name,
+			true,
+		},
+		{
+			slash.MethodIsOperatorFrozen,
+			s.precompile.Methods[slash.MethodIsOperatorFrozen].Name,
+			false,
+		},
 		{
 			"invalid",
 			"invalid",
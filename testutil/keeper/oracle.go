@@ -21,6 +21,7 @@ import (
 	assetskeeper "github.com/ExocoreNetwork/exocore/x/assets/keeper"
 	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
 	dogfoodkeeper "github.com/ExocoreNetwork/exocore/x/dogfood/keeper"
+	operatorkeeper "github.com/ExocoreNetwork/exocore/x/operator/keeper"
 	"github.com/stretchr/testify/require"
 )
 
@@ -51,6 +52,7 @@ func OracleKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		dogfoodkeeper.Keeper{},
 		delegationkeeper.Keeper{},
 		assetskeeper.Keeper{},
+		operatorkeeper.Keeper{},
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
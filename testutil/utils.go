@@ -302,7 +302,7 @@ func (suite *BaseTestSuite) SetupWithGenesisValSet(genAccs []authtypes.GenesisAc
 			},
 		},
 	}
-	operatorGenesis := operatortypes.NewGenesisState(operatorInfos, operatorConsKeys, optStates, operatorUSDValues, avsUSDValues, nil, nil, nil)
+	operatorGenesis := operatortypes.NewGenesisState(operatorInfos, operatorConsKeys, optStates, operatorUSDValues, avsUSDValues, nil, nil, nil, operatortypes.DefaultParams(), nil, nil, nil, nil, nil)
 	genesisState[operatortypes.ModuleName] = app.AppCodec().MustMarshalJSON(operatorGenesis)
 
 	// x/delegation
@@ -346,7 +346,7 @@ func (suite *BaseTestSuite) SetupWithGenesisValSet(genAccs []authtypes.GenesisAc
 			},
 		},
 	}
-	delegationGenesis := delegationtypes.NewGenesis(associations, delegationStates, stakersByOperator, nil)
+	delegationGenesis := delegationtypes.NewGenesis(associations, delegationStates, stakersByOperator, nil, nil, nil)
 	genesisState[delegationtypes.ModuleName] = app.AppCodec().MustMarshalJSON(delegationGenesis)
 
 	// create a dogfood genesis with just the validator set, that is, the bare
@@ -520,3 +520,40 @@ func (suite *BaseTestSuite) CommitAfter(d time.Duration) {
 	suite.Ctx, err = CommitAndCreateNewCtx(suite.Ctx, suite.App, d, nil, false)
 	suite.Require().NoError(err)
 }
+
+// ExportAndImport commits the current block, exports the state of the app and starts a new app
+// from the exported genesis. It returns the new app and a context in the first block after the
+// genesis, which can be used to compare the imported state with the original one.
+func (suite *BaseTestSuite) ExportAndImport() (*exocoreapp.ExocoreApp, sdk.Context) {
+	suite.Commit()
+	exported, err := suite.App.ExportAppStateAndValidators(false, nil, nil)
+	suite.Require().NoError(err)
+
+	pruneOpts := pruningtypes.NewPruningOptionsFromString(pruningtypes.PruningOptionDefault)
+	appI, _ := exocoreapp.SetupTestingApp(utils.DefaultChainID, &pruneOpts, false)()
+	app, ok := appI.(*exocoreapp.ExocoreApp)
+	suite.Require().True(ok)
+
+	// InitChain panics if any of the modules fails to import its state.
+	genesisTime := suite.Ctx.BlockTime()
+	suite.Require().NotPanics(func() {
+		app.InitChain(
+			abci.RequestInitChain{
+				Time:            genesisTime,
+				ChainId:         utils.DefaultChainID,
+				Validators:      []abci.ValidatorUpdate{},
+				ConsensusParams: exported.ConsensusParams,
+				AppStateBytes:   exported.AppState,
+				InitialHeight:   exported.Height,
+			},
+		)
+	})
+
+	header := suite.Ctx.BlockHeader()
+	header.Height = exported.Height
+	header.Time = genesisTime.Add(time.Second)
+	app.BeginBlock(abci.RequestBeginBlock{
+		Header: header,
+	})
+	return app, app.BaseApp.NewContext(false, header)
+}
@@ -1,6 +1,11 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -20,8 +25,10 @@ func NewTxCmd() *cobra.Command {
 	}
 
 	txCmd.AddCommand(
-		// RegisterClientChain(),
-		// RegisterAsset(),
+		RegisterClientChain(),
+		RegisterAsset(),
+		UpdateStakingAssetLimits(),
+		SetNSTAutoDelegation(),
 		UpdateParams(),
 	)
 	return txCmd
@@ -56,95 +63,173 @@ func UpdateParams() *cobra.Command {
 	return cmd
 }
 
-// // RegisterClientChain register client chain
-// // todo: this function should be controlled by governance in the future
-// func RegisterClientChain() *cobra.Command {
-// 	cmd := &cobra.Command{
-// 		Use:   "RegisterClientChain <Name> <MetaInfo> <clientChainID> <AddressLength>",
-// 		Short: "register client chain",
-// 		Args:  cobra.MinimumNArgs(4),
-// 		RunE: func(cmd *cobra.Command, args []string) error {
-// 			cliCtx, err := client.GetClientTxContext(cmd)
-// 			if err != nil {
-// 				return err
-// 			}
-
-// 			sender := cliCtx.GetFromAddress()
-// 			msg := &assetstype.RegisterClientChainReq{
-// 				FromAddress: sender.String(),
-// 				Info: &assetstype.ClientChainInfo{
-// 					Name:     args[0],
-// 					MetaInfo: args[1],
-// 				},
-// 			}
-// 			clientChainID, err := strconv.ParseUint(args[2], 10, 64)
-// 			if err != nil {
-// 				return errorsmod.Wrap(assetstype.ErrInvalidCliCmdArg, fmt.Sprintf("error arg is:%v", args[2]))
-// 			}
-// 			addressLength, err := strconv.ParseUint(args[3], 10, 32)
-// 			if err != nil {
-// 				return errorsmod.Wrap(assetstype.ErrInvalidCliCmdArg, fmt.Sprintf("error arg is:%v", args[3]))
-// 			}
-// 			msg.Info.LayerZeroChainID = clientChainID
-// 			msg.Info.AddressLength = uint32(addressLength)
-// 			if err := msg.ValidateBasic(); err != nil {
-// 				return err
-// 			}
-// 			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
-// 		},
-// 	}
-
-// 	flags.AddTxFlagsToCmd(cmd)
-// 	return cmd
-// }
-
-// // RegisterAsset register the asset on the client chain
-// // todo: this function should be controlled by governance in the future
-// func RegisterAsset() *cobra.Command {
-// 	cmd := &cobra.Command{
-// 		Use:   "RegisterAsset <Name> <Symbol> <Address> <MetaInfo> <TotalSupply> <clientChainID> <Decimals>",
-// 		Short: "register asset",
-// 		Args:  cobra.MinimumNArgs(7),
-// 		RunE: func(cmd *cobra.Command, args []string) error {
-// 			cliCtx, err := client.GetClientTxContext(cmd)
-// 			if err != nil {
-// 				return err
-// 			}
-
-// 			sender := cliCtx.GetFromAddress()
-// 			msg := &assetstype.RegisterAssetReq{
-// 				FromAddress: sender.String(),
-// 				Info: &assetstype.AssetInfo{
-// 					Name:     args[0],
-// 					Symbol:   args[1],
-// 					Address:  strings.ToLower(args[2]),
-// 					MetaInfo: args[3],
-// 				},
-// 			}
-// 			totalSupply, ok := sdkmath.NewIntFromString(args[4])
-// 			if !ok {
-// 				return errorsmod.Wrap(assetstype.ErrInvalidCliCmdArg, fmt.Sprintf("error arg is:%v", args[4]))
-// 			}
-
-// 			clientChainID, err := strconv.ParseUint(args[5], 10, 64)
-// 			if err != nil {
-// 				return errorsmod.Wrap(assetstype.ErrInvalidCliCmdArg, fmt.Sprintf("error arg is:%v", args[5]))
-// 			}
-// 			decimal, err := strconv.ParseUint(args[6], 10, 32)
-// 			if err != nil {
-// 				return errorsmod.Wrap(assetstype.ErrInvalidCliCmdArg, fmt.Sprintf("error arg is:%v", args[6]))
-// 			}
-
-// 			msg.Info.TotalSupply = totalSupply
-// 			msg.Info.LayerZeroChainID = clientChainID
-// 			msg.Info.Decimals = uint32(decimal)
-// 			if err := msg.ValidateBasic(); err != nil {
-// 				return err
-// 			}
-// 			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
-// 		},
-// 	}
-
-// 	flags.AddTxFlagsToCmd(cmd)
-// 	return cmd
-// }
+// RegisterClientChain registers a new client chain or updates an existing one. On the
+// mainnet, the message should be submitted via a gov proposal with the gov module account as
+// the sender.
+func RegisterClientChain() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "RegisterClientChain <Name> <MetaInfo> <clientChainID> <AddressLength> [SignatureType]",
+		Short: "register or update a client chain",
+		Args:  cobra.RangeArgs(4, 5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+			msg := &assetstype.RegisterClientChainReq{
+				FromAddress: sender.String(),
+				Info: &assetstype.ClientChainInfo{
+					Name:     args[0],
+					MetaInfo: args[1],
+				},
+			}
+			clientChainID, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(assetstype.ErrInvalidCliCmdArg, fmt.Sprintf("error arg is:%v", args[2]))
+			}
+			addressLength, err := strconv.ParseUint(args[3], 10, 32)
+			if err != nil {
+				return errorsmod.Wrap(assetstype.ErrInvalidCliCmdArg, fmt.Sprintf("error arg is:%v", args[3]))
+			}
+			msg.Info.LayerZeroChainID = clientChainID
+			msg.Info.AddressLength = uint32(addressLength)
+			if len(args) == 5 {
+				msg.Info.SignatureType = args[4]
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// RegisterAsset registers a new asset of the client chain, along with the token feeder in the
+// oracle module. The oracle info uses the same format as the ExocoreGateway:
+// '[tokenName],[chainName],[tokenDecimal](,[interval],[contract](,[ChainDesc:{...}],[TokenDesc:{...}]))'.
+// On the mainnet, the message should be submitted via a gov proposal with the gov module
+// account as the sender.
+func RegisterAsset() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "RegisterAsset <Name> <Symbol> <Address> <MetaInfo> <clientChainID> <Decimals> <OracleInfo>",
+		Short: "register an asset of the client chain",
+		Args:  cobra.ExactArgs(7),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+			msg := &assetstype.RegisterAssetReq{
+				FromAddress: sender.String(),
+				Info: &assetstype.AssetInfo{
+					Name:     args[0],
+					Symbol:   args[1],
+					Address:  args[2],
+					MetaInfo: args[3],
+				},
+				OracleInfo: args[6],
+			}
+			clientChainID, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(assetstype.ErrInvalidCliCmdArg, fmt.Sprintf("error arg is:%v", args[4]))
+			}
+			decimal, err := strconv.ParseUint(args[5], 10, 32)
+			if err != nil {
+				return errorsmod.Wrap(assetstype.ErrInvalidCliCmdArg, fmt.Sprintf("error arg is:%v", args[5]))
+			}
+			msg.Info.LayerZeroChainID = clientChainID
+			msg.Info.Decimals = uint32(decimal)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// UpdateStakingAssetLimits updates the max total staking and the max per staker of an asset,
+// a zero value removes the corresponding limit. On the mainnet, the message should be submitted
+// via a gov proposal with the gov module account as the sender.
+func UpdateStakingAssetLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "UpdateStakingAssetLimits <assetID> <MaxTotalStaking> <MaxPerStaker>",
+		Short: "update the staking limits of an asset, zero means no limit",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxTotalStaking, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return errorsmod.Wrap(assetstype.ErrInvalidCliCmdArg, fmt.Sprintf("error arg is:%v", args[1]))
+			}
+			maxPerStaker, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return errorsmod.Wrap(assetstype.ErrInvalidCliCmdArg, fmt.Sprintf("error arg is:%v", args[2]))
+			}
+			sender := cliCtx.GetFromAddress()
+			msg := &assetstype.MsgUpdateStakingAssetLimits{
+				Authority:       sender.String(),
+				AssetID:         args[0],
+				MaxTotalStaking: &maxTotalStaking,
+				MaxPerStaker:    &maxPerStaker,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// SetNSTAutoDelegation enables or disables the auto-delegation of the native token balance
+// increases of the sender, whose address is used as the staker address on the client chain.
+func SetNSTAutoDelegation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "SetNSTAutoDelegation <clientChainID> <enabled>",
+		Short: "enable or disable the auto-delegation of the native token balance increases",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientChainID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(assetstype.ErrInvalidCliCmdArg, fmt.Sprintf("error arg is:%v", args[0]))
+			}
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return errorsmod.Wrap(assetstype.ErrInvalidCliCmdArg, fmt.Sprintf("error arg is:%v", args[1]))
+			}
+			msg := &assetstype.MsgSetNSTAutoDelegation{
+				FromAddress:   cliCtx.GetFromAddress().String(),
+				ClientChainID: clientChainID,
+				Enabled:       enabled,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
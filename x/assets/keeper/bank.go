@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"errors"
+
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -35,7 +37,15 @@ func (k Keeper) PerformDepositOrWithdraw(ctx sdk.Context, params *DepositWithdra
 	actualOpAmount := params.OpAmount
 	switch params.Action {
 	case assetstypes.DepositLST, assetstypes.DepositNST:
+		// check the limits before any state change, since the error might not revert the state
+		// changes when it's called by the precompile.
+		if err := k.checkDepositLimits(ctx, stakerID, assetID, params.OpAmount); err != nil {
+			return err
+		}
 	case assetstypes.WithdrawLST, assetstypes.WithdrawNST:
+		if k.sk.IsStakerFrozen(ctx, stakerID) {
+			return errorsmod.Wrapf(assetstypes.ErrStakerIsFrozen, "stakerID:%s", stakerID)
+		}
 		actualOpAmount = actualOpAmount.Neg()
 	default:
 		return errorsmod.Wrapf(assetstypes.ErrInvalidOperationType, "the operation type is: %v", params.Action)
@@ -62,3 +72,41 @@ func (k Keeper) PerformDepositOrWithdraw(ctx sdk.Context, params *DepositWithdra
 	}
 	return nil
 }
+
+// checkDepositLimits checks that neither the total staking amount of the asset nor the total
+// deposit amount of the staker exceeds the corresponding limit of the asset after the deposit.
+func (k Keeper) checkDepositLimits(ctx sdk.Context, stakerID, assetID string, amount sdkmath.Int) error {
+	if assetID == assetstypes.ExocoreAssetID {
+		return nil
+	}
+	assetInfo, err := k.GetStakingAssetInfo(ctx, assetID)
+	if err != nil {
+		return err
+	}
+	maxTotalStaking := assetInfo.AssetBasicInfo.MaxTotalStaking
+	totalAmount := assetInfo.StakingTotalAmount.Add(amount)
+	if assetstypes.IsStakingLimited(maxTotalStaking) && totalAmount.GT(*maxTotalStaking) {
+		return assetstypes.ErrExceedStakingLimit.Wrapf(
+			"the total staking amount exceeds the limit, assetID:%s,totalAmount:%s,maxTotalStaking:%s",
+			assetID, totalAmount, maxTotalStaking,
+		)
+	}
+	maxPerStaker := assetInfo.AssetBasicInfo.MaxPerStaker
+	if !assetstypes.IsStakingLimited(maxPerStaker) {
+		return nil
+	}
+	depositAmount := amount
+	stakerInfo, err := k.GetStakerSpecifiedAssetInfo(ctx, stakerID, assetID)
+	if err == nil {
+		depositAmount = depositAmount.Add(stakerInfo.TotalDepositAmount)
+	} else if !errors.Is(err, assetstypes.ErrNoStakerAssetKey) {
+		return err
+	}
+	if depositAmount.GT(*maxPerStaker) {
+		return assetstypes.ErrExceedStakingLimit.Wrapf(
+			"the deposit amount of the staker exceeds the limit, stakerID:%s,assetID:%s,depositAmount:%s,maxPerStaker:%s",
+			stakerID, assetID, depositAmount, maxPerStaker,
+		)
+	}
+	return nil
+}
@@ -1,8 +1,14 @@
 package keeper_test
 
 import (
+	"strings"
+
 	"cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/x/assets/keeper"
 	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 func (suite *StakingAssetsTestSuite) TestGenesisClientChainAndAssetInfo() {
@@ -55,3 +61,143 @@ func (suite *StakingAssetsTestSuite) TestGenesisClientChainAndAssetInfo() {
 	suite.NoError(err)
 	suite.Equal(usdtAsset, assetInfo.AssetBasicInfo)
 }
+
+func (suite *StakingAssetsTestSuite) TestRegisterClientChainAndAsset() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	clientChainReq := &assetstype.RegisterClientChainReq{
+		FromAddress: suite.AccAddress.String(),
+		Info: &assetstype.ClientChainInfo{
+			Name:             "arbitrum",
+			LayerZeroChainID: 110,
+			AddressLength:    20,
+		},
+	}
+	_, err := suite.App.AssetsKeeper.RegisterClientChain(suite.Ctx, clientChainReq)
+	suite.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	clientChain := &assetstype.ClientChainInfo{
+		Name:             "arbitrum",
+		MetaInfo:         "arbitrum one",
+		LayerZeroChainID: 110,
+		AddressLength:    20,
+	}
+	_, err = suite.App.AssetsKeeper.RegisterClientChain(suite.Ctx, &assetstype.RegisterClientChainReq{
+		FromAddress: authority,
+		Info:        clientChain,
+	})
+	suite.NoError(err)
+	chainInfo, err := suite.App.AssetsKeeper.GetClientChainInfoByIndex(suite.Ctx, clientChain.LayerZeroChainID)
+	suite.NoError(err)
+	suite.Equal(*clientChain, *chainInfo)
+
+	// the registered client chain can't be overwritten
+	_, err = suite.App.AssetsKeeper.RegisterClientChain(suite.Ctx, &assetstype.RegisterClientChainReq{
+		FromAddress: authority,
+		Info: &assetstype.ClientChainInfo{
+			Name:             "arbitrum nova",
+			LayerZeroChainID: clientChain.LayerZeroChainID,
+			AddressLength:    20,
+		},
+	})
+	suite.ErrorIs(err, assetstype.ErrRegisterDuplicateClientChain)
+	chainInfo, err = suite.App.AssetsKeeper.GetClientChainInfoByIndex(suite.Ctx, clientChain.LayerZeroChainID)
+	suite.NoError(err)
+	suite.Equal(*clientChain, *chainInfo)
+
+	asset := &assetstype.AssetInfo{
+		Name:             "Wrapped liquid staked Ether",
+		Symbol:           "wstETH",
+		Address:          "0x5979D7b546E38E414F7E9822514be443A4800529",
+		Decimals:         18,
+		LayerZeroChainID: clientChain.LayerZeroChainID,
+		MetaInfo:         "wstETH on arbitrum",
+	}
+	req := &assetstype.RegisterAssetReq{
+		FromAddress: authority,
+		Info:        asset,
+		OracleInfo:  "wstETH,Arbitrum,18",
+	}
+	suite.NoError(req.ValidateBasic())
+	unauthorizedReq := *req
+	unauthorizedReq.FromAddress = suite.AccAddress.String()
+	_, err = suite.App.AssetsKeeper.RegisterAsset(suite.Ctx, &unauthorizedReq)
+	suite.ErrorIs(err, govtypes.ErrInvalidSigner)
+	_, err = suite.App.AssetsKeeper.RegisterAsset(suite.Ctx, req)
+	suite.NoError(err)
+
+	// the asset address is stored in lowercase
+	_, assetID := assetstype.GetStakerIDAndAssetIDFromStr(asset.LayerZeroChainID, "", asset.Address)
+	assetInfo, err := suite.App.AssetsKeeper.GetStakingAssetInfo(suite.Ctx, assetID)
+	suite.NoError(err)
+	suite.Equal(strings.ToLower(asset.Address), assetInfo.AssetBasicInfo.Address)
+	suite.True(assetInfo.StakingTotalAmount.IsZero())
+	oracleParams := suite.App.OracleKeeper.GetParams(suite.Ctx)
+	suite.Positive(oracleParams.GetTokenIDFromAssetID(assetID))
+
+	// the asset can't be registered twice
+	_, err = suite.App.AssetsKeeper.RegisterAsset(suite.Ctx, req)
+	suite.ErrorIs(err, assetstype.ErrRegisterDuplicateAssetID)
+
+	// the asset of an unknown client chain can't be registered
+	req.Info.LayerZeroChainID = 111
+	_, err = suite.App.AssetsKeeper.RegisterAsset(suite.Ctx, req)
+	suite.ErrorIs(err, assetstype.ErrNoClientChainKey)
+
+	// the oracle info should have at least three fields
+	req.OracleInfo = "wstETH,Arbitrum"
+	suite.Error(req.ValidateBasic())
+}
+
+func (suite *StakingAssetsTestSuite) TestStakingAssetLimits() {
+	assetID := suite.AssetIDs[0]
+	maxTotalStaking := math.NewIntWithDecimal(202, 6)
+	maxPerStaker := math.NewIntWithDecimal(102, 6)
+	msg := &assetstype.MsgUpdateStakingAssetLimits{
+		Authority:       authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		AssetID:         assetID,
+		MaxTotalStaking: &maxTotalStaking,
+		MaxPerStaker:    &maxPerStaker,
+	}
+	suite.NoError(msg.ValidateBasic())
+	unauthorizedMsg := *msg
+	unauthorizedMsg.Authority = suite.AccAddress.String()
+	_, err := suite.App.AssetsKeeper.UpdateStakingAssetLimits(suite.Ctx, &unauthorizedMsg)
+	suite.ErrorIs(err, govtypes.ErrInvalidSigner)
+	_, err = suite.App.AssetsKeeper.UpdateStakingAssetLimits(suite.Ctx, msg)
+	suite.NoError(err)
+	assetInfo, err := suite.App.AssetsKeeper.QueStakingAssetInfo(suite.Ctx, &assetstype.QueryStakingAssetInfo{AssetID: assetID})
+	suite.NoError(err)
+	suite.Equal(maxTotalStaking, *assetInfo.AssetBasicInfo.MaxTotalStaking)
+	suite.Equal(maxPerStaker, *assetInfo.AssetBasicInfo.MaxPerStaker)
+
+	deposit := func(stakerAddress string, amount math.Int) error {
+		return suite.App.AssetsKeeper.PerformDepositOrWithdraw(suite.Ctx, &keeper.DepositWithdrawParams{
+			ClientChainLzID: suite.ClientChains[0].LayerZeroChainID,
+			Action:          assetstype.DepositLST,
+			StakerAddress:   common.HexToAddress(stakerAddress).Bytes(),
+			AssetsAddress:   common.HexToAddress(suite.Assets[0].Address).Bytes(),
+			OpAmount:        amount,
+		})
+	}
+	// the deposit exceeding the max per staker is rejected
+	err = deposit(suite.StakerAddr, math.NewIntWithDecimal(2, 6))
+	suite.ErrorIs(err, assetstype.ErrExceedStakingLimit)
+	suite.NoError(deposit(suite.StakerAddr, math.NewIntWithDecimal(1, 6)))
+
+	// the deposit exceeding the max total staking is rejected without any state change
+	newStaker := "0x3e108c058e8066DA635321Dc3018294cA82ddEdf"
+	err = deposit(newStaker, math.NewIntWithDecimal(1, 6))
+	suite.ErrorIs(err, assetstype.ErrExceedStakingLimit)
+	stakerID, _ := assetstype.GetStakerIDAndAssetIDFromStr(suite.ClientChains[0].LayerZeroChainID, strings.ToLower(newStaker), "")
+	_, err = suite.App.AssetsKeeper.GetStakerSpecifiedAssetInfo(suite.Ctx, stakerID, assetID)
+	suite.ErrorIs(err, assetstype.ErrNoStakerAssetKey)
+
+	// the limits are removed by zero values
+	msg.MaxTotalStaking, msg.MaxPerStaker = nil, nil
+	_, err = suite.App.AssetsKeeper.UpdateStakingAssetLimits(suite.Ctx, msg)
+	suite.NoError(err)
+	suite.NoError(deposit(newStaker, math.NewIntWithDecimal(1, 6)))
+	assetInfo, err = suite.App.AssetsKeeper.GetStakingAssetInfo(suite.Ctx, assetID)
+	suite.NoError(err)
+	suite.Equal(math.NewIntWithDecimal(203, 6), assetInfo.StakingTotalAmount)
+}
@@ -4,12 +4,15 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
+	oracletypes "github.com/ExocoreNetwork/exocore/x/oracle/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // UpdateStakingAssetTotalAmount updating the total deposited amount of a specified asset in exoCore chain
 // The function will be called when stakers deposit and withdraw their assets
+// An increase exceeding the max total staking of the asset is rejected, while a decrease is
+// always allowed even if the limit has been lowered below the current total amount.
 func (k Keeper) UpdateStakingAssetTotalAmount(ctx sdk.Context, assetID string, changeAmount sdkmath.Int) (err error) {
 	if changeAmount.IsNil() {
 		return assetstype.ErrInputPointerIsNil
@@ -29,6 +32,13 @@ func (k Keeper) UpdateStakingAssetTotalAmount(ctx sdk.Context, assetID string, c
 	if err != nil {
 		return err
 	}
+	maxTotalStaking := ret.AssetBasicInfo.MaxTotalStaking
+	if changeAmount.IsPositive() && assetstype.IsStakingLimited(maxTotalStaking) && ret.StakingTotalAmount.GT(*maxTotalStaking) {
+		return assetstype.ErrExceedStakingLimit.Wrapf(
+			"the total staking amount exceeds the limit, assetID:%s,totalAmount:%s,maxTotalStaking:%s",
+			assetID, ret.StakingTotalAmount, maxTotalStaking,
+		)
+	}
 	bz := k.cdc.MustMarshal(&ret)
 	store.Set(key, bz)
 	return nil
@@ -45,6 +55,9 @@ func (k Keeper) SetStakingAssetInfo(ctx sdk.Context, info *assetstype.StakingAss
 	if info.StakingTotalAmount.IsNegative() {
 		return errorsmod.Wrapf(assetstype.ErrInvalidInputParameter, "the total staking amount is negative, StakingTotalAmount:%v", info.StakingTotalAmount)
 	}
+	if err := assetstype.ValidateStakingLimits(info.AssetBasicInfo.MaxTotalStaking, info.AssetBasicInfo.MaxPerStaker); err != nil {
+		return err
+	}
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), assetstype.KeyPrefixReStakingAssetInfo)
 	_, assetID := assetstype.GetStakerIDAndAssetIDFromStr(info.AssetBasicInfo.LayerZeroChainID, "", info.AssetBasicInfo.Address)
 	if store.Has([]byte(assetID)) {
@@ -58,6 +71,30 @@ func (k Keeper) SetStakingAssetInfo(ctx sdk.Context, info *assetstype.StakingAss
 	return nil
 }
 
+// RegisterNewToken registers a new staking asset along with its token feeder in the oracle
+// module. It's used by both the ExocoreGateway precompile and the governance message.
+// The assetID is derived from the asset info and set into the oracle info.
+func (k Keeper) RegisterNewToken(ctx sdk.Context, asset *assetstype.AssetInfo, oInfo *oracletypes.OracleInfo) (string, error) {
+	_, assetID := assetstype.GetStakerIDAndAssetIDFromStr(asset.LayerZeroChainID, "", asset.Address)
+	oInfo.AssetID = assetID
+
+	if k.IsStakingAsset(ctx, assetID) {
+		return "", assetstype.ErrRegisterDuplicateAssetID.Wrapf("asset %s already exists", assetID)
+	}
+
+	if err := k.RegisterNewTokenAndSetTokenFeeder(ctx, oInfo); err != nil {
+		return "", err
+	}
+
+	if err := k.SetStakingAssetInfo(ctx, &assetstype.StakingAssetInfo{
+		AssetBasicInfo:     *asset,
+		StakingTotalAmount: sdkmath.NewInt(0),
+	}); err != nil {
+		return "", err
+	}
+	return assetID, nil
+}
+
 // IsStakingAsset checks if the assetID is a staking asset.
 func (k Keeper) IsStakingAsset(ctx sdk.Context, assetID string) bool {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), assetstype.KeyPrefixReStakingAssetInfo)
@@ -78,6 +115,24 @@ func (k Keeper) UpdateStakingAssetMetaInfo(ctx sdk.Context, assetID string, meta
 	return nil
 }
 
+// SetStakingAssetLimits sets the staking limits of the provided assetID, nil or zero
+// limits remove the corresponding limitation. If the assetID does not exist, it returns an error.
+func (k Keeper) SetStakingAssetLimits(ctx sdk.Context, assetID string, maxTotalStaking, maxPerStaker *sdkmath.Int) error {
+	if err := assetstype.ValidateStakingLimits(maxTotalStaking, maxPerStaker); err != nil {
+		return err
+	}
+	info, err := k.GetStakingAssetInfo(ctx, assetID)
+	if err != nil {
+		return err
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), assetstype.KeyPrefixReStakingAssetInfo)
+	info.AssetBasicInfo.MaxTotalStaking = maxTotalStaking
+	info.AssetBasicInfo.MaxPerStaker = maxPerStaker
+	bz := k.cdc.MustMarshal(info)
+	store.Set([]byte(assetID), bz)
+	return nil
+}
+
 // GetStakingAssetInfo returns the asset information stored against a provided assetID.
 func (k Keeper) GetStakingAssetInfo(ctx sdk.Context, assetID string) (info *assetstype.StakingAssetInfo, err error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), assetstype.KeyPrefixReStakingAssetInfo)
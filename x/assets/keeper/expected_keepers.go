@@ -9,3 +9,8 @@ import (
 type delegationKeeper interface {
 	GetDelegationInfo(ctx sdk.Context, stakerID, assetID string) (*delegationtype.QueryDelegationInfoResponse, error)
 }
+
+// this keeper interface is defined here to avoid a circular dependency
+type slashKeeper interface {
+	IsStakerFrozen(ctx sdk.Context, stakerID string) bool
+}
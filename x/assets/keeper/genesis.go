@@ -57,6 +57,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			}
 		}
 	}
+	for _, stakerID := range data.NSTAutoDelegationStakers {
+		k.setNSTAutoDelegation(ctx, stakerID, true)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -88,5 +91,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	if err != nil {
 		panic(errorsmod.Wrap(err, "failed to get all assets info for the operators").Error())
 	}
+
+	res.NSTAutoDelegationStakers = k.GetAllNSTAutoDelegationStakers(ctx)
 	return &res
 }
@@ -17,6 +17,7 @@ type Keeper struct {
 	assetstype.OracleKeeper
 	bk        assetstype.BankKeeper
 	dk        delegationKeeper
+	sk        slashKeeper
 	authority string
 }
 
@@ -26,6 +27,7 @@ func NewKeeper(
 	oracleKeeper assetstype.OracleKeeper,
 	bk assetstype.BankKeeper,
 	dk delegationKeeper,
+	sk slashKeeper,
 	authority string,
 ) Keeper {
 	// ensure authority is a valid bech32 address
@@ -38,6 +40,7 @@ func NewKeeper(
 		OracleKeeper: oracleKeeper,
 		bk:           bk,
 		dk:           dk,
+		sk:           sk,
 		authority:    authority,
 	}
 }
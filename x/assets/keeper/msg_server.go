@@ -2,11 +2,15 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/ExocoreNetwork/exocore/utils"
 	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
+	oracletypes "github.com/ExocoreNetwork/exocore/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 var _ assetstype.MsgServer = &Keeper{}
@@ -32,51 +36,114 @@ func (k Keeper) UpdateParams(ctx context.Context, params *assetstype.MsgUpdatePa
 	return nil, nil
 }
 
-// // SetStakerExoCoreAddr outdated, will be deprecated.
-// // don't check if the staker has existed temporarily,so users can set their ExoCoreAddr multiple times.
-// // It may be modified later to allow setting only once
-// func (k Keeper) SetStakerExoCoreAddr(ctx context.Context, addrInfo *assetstype.MsgSetExoCoreAddr) (*assetstype.MsgSetExoCoreAddrResponse, error) {
-// 	// todo: verify client chain signature according to the client chain signature algorithm type.
-
-// 	c := sdk.UnwrapSDKContext(ctx)
-
-// 	store := prefix.NewStore(c.KVStore(k.storeKey), assetstype.KeyPrefixReStakerExoCoreAddr)
-
-// 	bz := k.cdc.MustMarshal(addrInfo)
-
-// 	key := strings.Join([]string{addrInfo.ClientChainAddr, hexutil.EncodeUint64(addrInfo.ClientChainIndex)}, "_")
-// 	store.Set([]byte(key), bz)
-
-// 	// todo: save to KeyPrefixReStakerExoCoreAddrReverse
-
-// 	return &assetstype.MsgSetExoCoreAddrResponse{}, nil
-// }
-
-// func (k Keeper) RegisterClientChain(ctx context.Context, req *assetstype.RegisterClientChainReq) (*assetstype.RegisterClientChainResponse, error) {
-// 	c := sdk.UnwrapSDKContext(ctx)
-// 	err := k.SetClientChainInfo(c, req.Info)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-// 	return nil, nil
-// }
+// RegisterClientChain registers a new client chain, the registered client chain can't be
+// overwritten. It's expected to be executed via a governance proposal.
+func (k Keeper) RegisterClientChain(ctx context.Context, req *assetstype.RegisterClientChainReq) (*assetstype.RegisterClientChainResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	if k.authority != req.FromAddress {
+		return nil, govtypes.ErrInvalidSigner.Wrapf(
+			"invalid authority; expected %s, got %s",
+			k.authority, req.FromAddress,
+		)
+	}
+	if k.ClientChainExists(c, req.Info.LayerZeroChainID) {
+		return nil, errorsmod.Wrapf(
+			assetstype.ErrRegisterDuplicateClientChain,
+			"the client chain has been registered, clientChainID:%d", req.Info.LayerZeroChainID,
+		)
+	}
+	if err := k.SetClientChainInfo(c, req.Info); err != nil {
+		return nil, err
+	}
+	c.EventManager().EmitEvent(
+		sdk.NewEvent(
+			assetstype.EventTypeRegisterClientChain,
+			sdk.NewAttribute(assetstype.AttributeKeyClientChainID, strconv.FormatUint(req.Info.LayerZeroChainID, 10)),
+			sdk.NewAttribute(assetstype.AttributeKeyName, req.Info.Name),
+			sdk.NewAttribute(assetstype.AttributeKeyAuthority, req.FromAddress),
+		),
+	)
+	return &assetstype.RegisterClientChainResponse{}, nil
+}
 
-// func (k Keeper) RegisterAsset(ctx context.Context, req *assetstype.RegisterAssetReq) (*assetstype.RegisterAssetResponse, error) {
-// 	c := sdk.UnwrapSDKContext(ctx)
-// 	_, assetID := assetstype.GetStakerIDAndAssetIDFromStr(req.Info.LayerZeroChainID, "", req.Info.Address)
+// RegisterAsset registers a new staking asset of a registered client chain, the token feeder of
+// the asset is set in the oracle module according to the oracle info. It's expected to be
+// executed via a governance proposal.
+func (k Keeper) RegisterAsset(ctx context.Context, req *assetstype.RegisterAssetReq) (*assetstype.RegisterAssetResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	if k.authority != req.FromAddress {
+		return nil, govtypes.ErrInvalidSigner.Wrapf(
+			"invalid authority; expected %s, got %s",
+			k.authority, req.FromAddress,
+		)
+	}
+	clientChain, err := k.GetClientChainInfoByIndex(c, req.Info.LayerZeroChainID)
+	if err != nil {
+		return nil, err
+	}
+	// the address is checked to be hex encoded in the ValidateBasic
+	address := hexutil.MustDecode(req.Info.Address)
+	if len(address) > int(clientChain.AddressLength) {
+		return nil, errorsmod.Wrapf(
+			assetstype.ErrInvalidInputParameter,
+			"the asset address is longer than the client chain address length, address:%s, length:%d",
+			req.Info.Address, clientChain.AddressLength,
+		)
+	}
+	oInfo, err := oracletypes.ParseOracleInfo(req.OracleInfo)
+	if err != nil {
+		return nil, err
+	}
+	// keep the address lowercase to be consistent with the assets registered by the gateway
+	asset := *req.Info
+	asset.Address = hexutil.Encode(address)
+	assetID, err := k.RegisterNewToken(c, &asset, &oInfo)
+	if err != nil {
+		return nil, err
+	}
+	c.EventManager().EmitEvent(
+		sdk.NewEvent(
+			assetstype.EventTypeRegisterAsset,
+			sdk.NewAttribute(assetstype.AttributeKeyAssetID, assetID),
+			sdk.NewAttribute(assetstype.AttributeKeyName, req.Info.Name),
+			sdk.NewAttribute(assetstype.AttributeKeyAuthority, req.FromAddress),
+		),
+	)
+	return &assetstype.RegisterAssetResponse{}, nil
+}
 
-// 	// once an asset is registered, operator will start trying to update related power based on this asset's price, so we have to make sure this asset already has price updated by oracle-module
-// 	// TODO: there's no guarantee that the corresponding tokenfeeder is running, the latest price is possible to be some history price. But since currently there's no mechanism to remove an asset from assets module, so we just assume corresponding tokenfeeder will never set endblock for now.
-// 	if _, err := k.GetSpecifiedAssetsPrice(c, assetID); err != nil {
-// 		return nil, err
-// 	}
+// UpdateStakingAssetLimits updates the max total staking and the max per staker of an asset.
+func (k Keeper) UpdateStakingAssetLimits(ctx context.Context, req *assetstype.MsgUpdateStakingAssetLimits) (*assetstype.MsgUpdateStakingAssetLimitsResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	if k.authority != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf(
+			"invalid authority; expected %s, got %s",
+			k.authority, req.Authority,
+		)
+	}
+	if err := k.SetStakingAssetLimits(c, req.AssetID, req.MaxTotalStaking, req.MaxPerStaker); err != nil {
+		return nil, err
+	}
+	c.EventManager().EmitEvent(
+		sdk.NewEvent(
+			assetstype.EventTypeUpdateStakingAssetLimits,
+			sdk.NewAttribute(assetstype.AttributeKeyAssetID, req.AssetID),
+			sdk.NewAttribute(assetstype.AttributeKeyMaxTotalStaking, assetstype.StakingLimitString(req.MaxTotalStaking)),
+			sdk.NewAttribute(assetstype.AttributeKeyMaxPerStaker, assetstype.StakingLimitString(req.MaxPerStaker)),
+		),
+	)
+	return &assetstype.MsgUpdateStakingAssetLimitsResponse{}, nil
+}
 
-// 	err := k.SetStakingAssetInfo(c, &assetstype.StakingAssetInfo{
-// 		AssetBasicInfo:     req.Info,
-// 		StakingTotalAmount: math.NewInt(0),
-// 	})
-// 	if err != nil {
-// 		return nil, err
-// 	}
-// 	return nil, nil
-// }
+// SetNSTAutoDelegation enables or disables the auto-delegation of the native restaking token
+// balance increases of the sender, whose address is used as the staker address on the client
+// chain.
+func (k Keeper) SetNSTAutoDelegation(ctx context.Context, req *assetstype.MsgSetNSTAutoDelegation) (*assetstype.MsgSetNSTAutoDelegationResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	// can use `Must` since pre-validated
+	fromAddr := sdk.MustAccAddressFromBech32(req.FromAddress)
+	if err := k.UpdateNSTAutoDelegation(c, req.ClientChainID, fromAddr.Bytes(), req.Enabled); err != nil {
+		return nil, err
+	}
+	return &assetstype.MsgSetNSTAutoDelegationResponse{}, nil
+}
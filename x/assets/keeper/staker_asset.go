@@ -161,3 +161,60 @@ func (k Keeper) UpdateStakerAssetState(ctx sdk.Context, stakerID string, assetID
 
 	return nil
 }
+
+// UpdateNSTAutoDelegation enables or disables the auto-delegation of the native restaking token
+// balance increases for the staker. The client chain must be registered and the address must
+// match its address length.
+func (k Keeper) UpdateNSTAutoDelegation(ctx sdk.Context, clientChainID uint64, stakerAddress []byte, enabled bool) error {
+	info, err := k.GetClientChainInfoByIndex(ctx, clientChainID)
+	if err != nil {
+		return err
+	}
+	// #nosec G115
+	if uint32(len(stakerAddress)) != info.AddressLength {
+		return errorsmod.Wrapf(
+			assetstype.ErrInvalidInputParameter,
+			"invalid staker address length:%d, expected:%d", len(stakerAddress), info.AddressLength,
+		)
+	}
+	stakerID, _ := assetstype.GetStakerIDAndAssetID(clientChainID, stakerAddress, nil)
+	k.setNSTAutoDelegation(ctx, stakerID, enabled)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			assetstype.EventTypeSetNSTAutoDelegation,
+			sdk.NewAttribute(assetstype.AttributeKeyStakerID, stakerID),
+			sdk.NewAttribute(assetstype.AttributeKeyEnabled, fmt.Sprintf("%t", enabled)),
+		),
+	)
+	return nil
+}
+
+func (k Keeper) setNSTAutoDelegation(ctx sdk.Context, stakerID string, enabled bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), assetstype.KeyPrefixNSTAutoDelegation)
+	if enabled {
+		store.Set([]byte(stakerID), []byte{})
+	} else {
+		store.Delete([]byte(stakerID))
+	}
+}
+
+// IsNSTAutoDelegationEnabled returns true if the staker has enabled the auto-delegation of the
+// native restaking token balance increases.
+func (k Keeper) IsNSTAutoDelegationEnabled(ctx sdk.Context, stakerID string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), assetstype.KeyPrefixNSTAutoDelegation)
+	return store.Has([]byte(stakerID))
+}
+
+// GetAllNSTAutoDelegationStakers returns the IDs of all stakers that have enabled the
+// auto-delegation of the native restaking token balance increases.
+func (k Keeper) GetAllNSTAutoDelegationStakers(ctx sdk.Context) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), assetstype.KeyPrefixNSTAutoDelegation)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	ret := make([]string, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		ret = append(ret, string(iterator.Key()))
+	}
+	return ret
+}
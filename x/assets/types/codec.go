@@ -23,9 +23,11 @@ var (
 
 const (
 	// Amino names
-	setExoCoreAddrName  = "exocore/MsgSetExoCoreAddr"
-	registerClientChain = "exocore/RegisterClientChain"
-	registerAsset       = "exocore/RegisterAsset"
+	setExoCoreAddrName   = "exocore/MsgSetExoCoreAddr"
+	registerClientChain  = "exocore/RegisterClientChain"
+	registerAsset        = "exocore/RegisterAsset"
+	updateStakingLimits  = "exocore/MsgUpdateStakingAssetLimits"
+	setNSTAutoDelegation = "exocore/MsgSetNSTAutoDelegation"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -39,6 +41,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgSetExoCoreAddr{},
+		&RegisterClientChainReq{},
+		&RegisterAssetReq{},
+		&MsgUpdateStakingAssetLimits{},
+		&MsgSetNSTAutoDelegation{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -51,4 +57,6 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetExoCoreAddr{}, setExoCoreAddrName, nil)
 	cdc.RegisterConcrete(&RegisterClientChainReq{}, registerClientChain, nil)
 	cdc.RegisterConcrete(&RegisterAssetReq{}, registerAsset, nil)
+	cdc.RegisterConcrete(&MsgUpdateStakingAssetLimits{}, updateStakingLimits, nil)
+	cdc.RegisterConcrete(&MsgSetNSTAutoDelegation{}, setNSTAutoDelegation, nil)
 }
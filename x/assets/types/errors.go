@@ -97,4 +97,19 @@ var (
 		ModuleName, 20,
 		"the joined key can't be parsed",
 	)
+
+	ErrStakerIsFrozen = errorsmod.Register(
+		ModuleName, 21,
+		"the staker has been frozen",
+	)
+
+	ErrExceedStakingLimit = errorsmod.Register(
+		ModuleName, 22,
+		"the staking limit of the asset is exceeded",
+	)
+
+	ErrRegisterDuplicateClientChain = errorsmod.Register(
+		ModuleName, 23,
+		"register new client chain with an existing client chain ID",
+	)
 )
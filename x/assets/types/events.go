@@ -0,0 +1,22 @@
+package types
+
+// x/assets events
+const (
+	EventTypeRegisterClientChain = "register_client_chain"
+	EventTypeRegisterAsset       = "register_asset"
+
+	EventTypeUpdateStakingAssetLimits = "update_staking_asset_limits"
+
+	EventTypeSetNSTAutoDelegation = "set_nst_auto_delegation"
+
+	AttributeKeyClientChainID = "client_chain_id"
+	AttributeKeyName          = "name"
+	AttributeKeyAssetID       = "asset_id"
+	AttributeKeyAuthority     = "authority"
+
+	AttributeKeyMaxTotalStaking = "max_total_staking"
+	AttributeKeyMaxPerStaker    = "max_per_staker"
+
+	AttributeKeyStakerID = "staker_id"
+	AttributeKeyEnabled  = "enabled"
+)
@@ -48,6 +48,8 @@ const (
 	DelegateTo
 	UndelegateFrom
 	Slash
+	Redelegate
+	CancelUndelegation
 )
 
 type GeneralAssetsAddr [32]byte
@@ -184,6 +186,31 @@ func GenerateNSTAddr(clientChainAddrLength uint32) []byte {
 	return address
 }
 
+// IsStakingLimited returns whether the staking limit is effective, since there is no limit if
+// it's nil or zero.
+func IsStakingLimited(limit *math.Int) bool {
+	return limit != nil && !limit.IsNil() && limit.IsPositive()
+}
+
+// StakingLimitString returns the string of the staking limit, it's "0" if there is no limit.
+func StakingLimitString(limit *math.Int) string {
+	if !IsStakingLimited(limit) {
+		return "0"
+	}
+	return limit.String()
+}
+
+// ValidateStakingLimits checks that the staking limits of an asset aren't negative.
+func ValidateStakingLimits(maxTotalStaking, maxPerStaker *math.Int) error {
+	if maxTotalStaking != nil && !maxTotalStaking.IsNil() && maxTotalStaking.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidInputParameter, "negative max total staking:%s", maxTotalStaking)
+	}
+	if maxPerStaker != nil && !maxPerStaker.IsNil() && maxPerStaker.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidInputParameter, "negative max per staker:%s", maxPerStaker)
+	}
+	return nil
+}
+
 func IsNST(assetID string) bool {
 	assetAddr, _, err := ParseID(assetID)
 	if err != nil {
@@ -96,6 +96,16 @@ func (gs GenesisState) ValidateTokens(lzIDs map[uint64]struct{}) (map[string]mat
 			)
 		}
 
+		if err := ValidateStakingLimits(
+			info.AssetBasicInfo.MaxTotalStaking, info.AssetBasicInfo.MaxPerStaker,
+		); err != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid staking limits for token %s: %s",
+				info.AssetBasicInfo.Name, err,
+			)
+		}
+
 		// ensure there are no deposits for this asset already (since they are handled in the
 		// genesis exec). while it is possible to remove this field entirely (and assume 0),
 		// i did not do so in order to make the genesis state more explicit.
@@ -292,6 +302,37 @@ func (gs GenesisState) ValidateOperatorAssets(tokensTotalStaking map[string]math
 	return nil
 }
 
+// ValidateNSTAutoDelegationStakers performs basic validation of the stakers that have enabled
+// the auto-delegation of the native restaking token.
+func (gs GenesisState) ValidateNSTAutoDelegationStakers(lzIDs map[uint64]struct{}) error {
+	validationFunc := func(_ int, stakerID string) error {
+		_, stakerClientChainID, err := ValidateID(stakerID, true, true)
+		if err != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid stakerID: %s",
+				stakerID,
+			)
+		}
+		if _, ok := lzIDs[stakerClientChainID]; !ok {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"unknown LayerZeroChainID for staker %s: %d",
+				stakerID, stakerClientChainID,
+			)
+		}
+		return nil
+	}
+	seenFieldValueFunc := func(stakerID string) (string, struct{}) {
+		return stakerID, struct{}{}
+	}
+	_, err := utils.CommonValidation(gs.NSTAutoDelegationStakers, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+	}
+	return nil
+}
+
 // Validate performs basic genesis state validation returning an error
 // upon any failure.
 func (gs GenesisState) Validate() error {
@@ -311,5 +352,9 @@ func (gs GenesisState) Validate() error {
 	if err != nil {
 		return err
 	}
+	err = gs.ValidateNSTAutoDelegationStakers(lzIDs)
+	if err != nil {
+		return err
+	}
 	return gs.Params.Validate()
 }
@@ -46,6 +46,9 @@ type GenesisState struct {
 	// operator_assets is the list of all operator assets information, indexed
 	// by the operator address and the asset id. The struct is the `OperatorAssetInfo`
 	OperatorAssets []AssetsByOperator `protobuf:"bytes,5,rep,name=operator_assets,json=operatorAssets,proto3" json:"operator_assets"`
+	// nst_auto_delegation_stakers is the list of the staker IDs that have enabled the
+	// auto-delegation of the native restaking token balance increases.
+	NSTAutoDelegationStakers []string `protobuf:"bytes,6,rep,name=nst_auto_delegation_stakers,json=nstAutoDelegationStakers,proto3" json:"nst_auto_delegation_stakers,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -116,6 +119,13 @@ func (m *GenesisState) GetOperatorAssets() []AssetsByOperator {
 	return nil
 }
 
+func (m *GenesisState) GetNSTAutoDelegationStakers() []string {
+	if m != nil {
+		return m.NSTAutoDelegationStakers
+	}
+	return nil
+}
+
 // AssetsByOperator is a struct to be used in the genesis state.
 // It is used to store the operator and its assets state.
 type AssetsByOperator struct {
@@ -359,41 +369,44 @@ func init() {
 func init() { proto.RegisterFile("exocore/assets/v1/genesis.proto", fileDescriptor_caf4f124d39d82ce) }
 
 var fileDescriptor_caf4f124d39d82ce = []byte{
-	// 531 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0xd3, 0x4a,
-	0x14, 0xc6, 0xe3, 0x34, 0x37, 0x4d, 0x27, 0xb9, 0xa5, 0x8c, 0xba, 0x70, 0x23, 0xe4, 0x14, 0x53,
-	0xa1, 0x6e, 0xb0, 0x69, 0x41, 0x62, 0x83, 0x90, 0xe2, 0x24, 0x42, 0x41, 0x82, 0x22, 0x67, 0xc7,
-	0xc6, 0x72, 0xed, 0xa9, 0x6b, 0x85, 0x78, 0x2c, 0x9f, 0x21, 0xc4, 0x0b, 0x9e, 0x01, 0x1e, 0x86,
-	0x87, 0xe8, 0xb2, 0x62, 0xc5, 0x2a, 0x42, 0xce, 0x2b, 0xf0, 0x00, 0xc8, 0x33, 0xe3, 0x90, 0x26,
-	0xb1, 0xc4, 0xce, 0x9e, 0xef, 0x3b, 0xbf, 0x39, 0x7f, 0xe6, 0xa0, 0x0e, 0x99, 0x51, 0x8f, 0x26,
-	0xc4, 0x74, 0x01, 0x08, 0x03, 0x73, 0x7a, 0x66, 0x06, 0x24, 0x22, 0x10, 0x82, 0x11, 0x27, 0x94,
-	0x51, 0x7c, 0x5f, 0x1a, 0x0c, 0x61, 0x30, 0xa6, 0x67, 0xed, 0x23, 0x8f, 0xc2, 0x84, 0x82, 0xc3,
-	0x0d, 0xa6, 0xf8, 0x11, 0xee, 0xb6, 0xb6, 0x89, 0x8b, 0xdd, 0xc4, 0x9d, 0x14, 0x7a, 0x7b, 0x53,
-	0x67, 0x33, 0xa9, 0x1d, 0x06, 0x34, 0xa0, 0x82, 0x99, 0x7f, 0x89, 0x53, 0xfd, 0x77, 0x15, 0xb5,
-	0x5e, 0x8b, 0x8c, 0x46, 0xcc, 0x65, 0x04, 0xbf, 0x40, 0x75, 0x81, 0x54, 0x95, 0x63, 0xe5, 0xb4,
-	0x79, 0x7e, 0x64, 0x6c, 0x64, 0x68, 0xbc, 0xe7, 0x06, 0xab, 0x76, 0x33, 0xef, 0x54, 0x6c, 0x69,
-	0xc7, 0x6f, 0xd1, 0xff, 0xde, 0xc7, 0x90, 0x44, 0xcc, 0xf1, 0xae, 0xdd, 0x30, 0x02, 0xb5, 0x7a,
-	0xbc, 0x73, 0xda, 0x3c, 0xd7, 0xb7, 0xc4, 0xf7, 0xb8, 0xaf, 0x97, 0xdb, 0x86, 0xd1, 0x15, 0x95,
-	0xa0, 0x96, 0xf7, 0xf7, 0x18, 0x70, 0x17, 0xd5, 0x19, 0x1d, 0x93, 0x08, 0xd4, 0x1d, 0xce, 0x79,
-	0xb4, 0x85, 0x33, 0x62, 0xee, 0x38, 0x8c, 0x82, 0x6e, 0x7e, 0xb0, 0x02, 0x92, 0x81, 0x78, 0x80,
-	0x1a, 0x3e, 0x89, 0x29, 0x84, 0x0c, 0xd4, 0x5a, 0x29, 0xa4, 0x2f, 0x2d, 0x56, 0x9a, 0xe3, 0x48,
-	0x22, 0x21, 0xcb, 0x50, 0x6c, 0xa3, 0x7b, 0x34, 0x26, 0x89, 0xcb, 0x68, 0xe2, 0x88, 0x30, 0xf5,
-	0xbf, 0x52, 0x1a, 0xcf, 0x05, 0xac, 0xf4, 0x42, 0x46, 0x48, 0xda, 0x7e, 0x41, 0x10, 0xba, 0xfe,
-	0x55, 0x41, 0x07, 0xeb, 0x56, 0xfc, 0x1c, 0x35, 0x0a, 0x1b, 0x6f, 0xfe, 0x9e, 0xa5, 0xfe, 0xf8,
-	0xfe, 0xe4, 0x50, 0xbe, 0x80, 0xae, 0xef, 0x27, 0x04, 0x60, 0xc4, 0x92, 0x30, 0x0a, 0xec, 0xa5,
-	0x13, 0x0f, 0x50, 0x4b, 0x5c, 0xef, 0x40, 0x3e, 0x40, 0xd9, 0xf6, 0x07, 0x65, 0xb9, 0x59, 0xe9,
-	0xb0, 0x2f, 0x93, 0x6a, 0x0a, 0x89, 0xcf, 0x5d, 0x07, 0xb4, 0xb7, 0xd4, 0xf1, 0x63, 0xd4, 0xe0,
-	0x9a, 0x13, 0xfa, 0x32, 0x93, 0x66, 0x36, 0xef, 0xec, 0x8a, 0x46, 0xf7, 0xed, 0x5d, 0x2e, 0x0e,
-	0x7d, 0xfc, 0x0a, 0xd5, 0xc2, 0xe8, 0x8a, 0xaa, 0x55, 0xfe, 0x54, 0x4e, 0xb6, 0xdc, 0x79, 0xb1,
-	0x5a, 0xf7, 0xca, 0x8c, 0x78, 0x9c, 0xfe, 0x05, 0x1d, 0xac, 0xb7, 0x1f, 0x9f, 0xa0, 0x3a, 0xf0,
-	0x2f, 0x79, 0x73, 0x2b, 0x9b, 0x77, 0x1a, 0x42, 0x1b, 0xf6, 0x6d, 0xa9, 0xe1, 0xde, 0xca, 0x6c,
-	0x45, 0xc5, 0x0f, 0xcb, 0x67, 0x6b, 0xa5, 0xa2, 0xb4, 0xb5, 0xc9, 0xea, 0x53, 0xb4, 0x7f, 0xd7,
-	0xf1, 0xcf, 0x85, 0xbf, 0xbc, 0x53, 0xb8, 0x5e, 0xf2, 0x36, 0xc9, 0xf6, 0xb2, 0xad, 0x37, 0x37,
-	0x99, 0xa6, 0xdc, 0x66, 0x9a, 0xf2, 0x2b, 0xd3, 0x94, 0x6f, 0x0b, 0xad, 0x72, 0xbb, 0xd0, 0x2a,
-	0x3f, 0x17, 0x5a, 0xe5, 0xc3, 0xd3, 0x20, 0x64, 0xd7, 0x9f, 0x2e, 0x0d, 0x8f, 0x4e, 0xcc, 0x81,
-	0x60, 0xbe, 0x23, 0xec, 0x33, 0x4d, 0xc6, 0x66, 0xb1, 0xda, 0xb3, 0x62, 0xb9, 0x59, 0x1a, 0x13,
-	0xb8, 0xac, 0xf3, 0x3d, 0x7e, 0xf6, 0x27, 0x00, 0x00, 0xff, 0xff, 0x4d, 0x6e, 0x63, 0xe0, 0x6a,
-	0x04, 0x00, 0x00,
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4f, 0x6f, 0x12, 0x41,
+	0x1c, 0x65, 0x4b, 0xa5, 0x74, 0xc0, 0x5a, 0x27, 0x3d, 0x6c, 0xb1, 0x59, 0x70, 0x6d, 0x0c, 0x17,
+	0x77, 0x6d, 0x35, 0xf1, 0x62, 0x4c, 0x58, 0x20, 0x06, 0x13, 0x5b, 0xb3, 0x78, 0xd2, 0xc3, 0x66,
+	0xbb, 0x3b, 0xdd, 0x6e, 0x28, 0x33, 0x64, 0x7f, 0x03, 0xc2, 0xc1, 0xcf, 0xa0, 0x1f, 0xc6, 0x0f,
+	0xd1, 0x63, 0xf5, 0xe4, 0x89, 0x98, 0xe5, 0x8b, 0x18, 0x66, 0x06, 0xa4, 0xfc, 0x49, 0xbc, 0xc1,
+	0xbc, 0xf7, 0x7b, 0xfb, 0xde, 0xfc, 0x5e, 0x06, 0x95, 0xc9, 0x90, 0x05, 0x2c, 0x21, 0xb6, 0x0f,
+	0x40, 0x38, 0xd8, 0x83, 0x13, 0x3b, 0x22, 0x94, 0x40, 0x0c, 0x56, 0x2f, 0x61, 0x9c, 0xe1, 0x87,
+	0x8a, 0x60, 0x49, 0x82, 0x35, 0x38, 0x29, 0x1d, 0x06, 0x0c, 0xba, 0x0c, 0x3c, 0x41, 0xb0, 0xe5,
+	0x1f, 0xc9, 0x2e, 0x19, 0xab, 0x72, 0x3d, 0x3f, 0xf1, 0xbb, 0x33, 0xbc, 0xb4, 0x8a, 0xf3, 0xa1,
+	0xc2, 0x0e, 0x22, 0x16, 0x31, 0xa9, 0x39, 0xfd, 0x25, 0x4f, 0xcd, 0x9f, 0x59, 0x54, 0x7c, 0x2b,
+	0x1d, 0xb5, 0xb9, 0xcf, 0x09, 0x7e, 0x85, 0x72, 0x52, 0x52, 0xd7, 0x2a, 0x5a, 0xb5, 0x70, 0x7a,
+	0x68, 0xad, 0x38, 0xb4, 0x3e, 0x08, 0x82, 0xb3, 0x7d, 0x33, 0x2e, 0x67, 0x5c, 0x45, 0xc7, 0xef,
+	0xd1, 0xfd, 0xe0, 0x3a, 0x26, 0x94, 0x7b, 0xc1, 0x95, 0x1f, 0x53, 0xd0, 0xb7, 0x2a, 0xd9, 0x6a,
+	0xe1, 0xd4, 0x5c, 0x33, 0x5f, 0x17, 0xbc, 0xfa, 0x94, 0xd6, 0xa2, 0x97, 0x4c, 0x09, 0x15, 0x83,
+	0x7f, 0xc7, 0x80, 0x6b, 0x28, 0xc7, 0x59, 0x87, 0x50, 0xd0, 0xb3, 0x42, 0xe7, 0xc9, 0x1a, 0x9d,
+	0x36, 0xf7, 0x3b, 0x31, 0x8d, 0x6a, 0xd3, 0x83, 0x05, 0x21, 0x35, 0x88, 0x9b, 0x28, 0x1f, 0x92,
+	0x1e, 0x83, 0x98, 0x83, 0xbe, 0xbd, 0x51, 0xa4, 0xa1, 0x28, 0xce, 0x68, 0x2a, 0x47, 0x12, 0x25,
+	0x32, 0x1f, 0xc5, 0x2e, 0x7a, 0xc0, 0x7a, 0x24, 0xf1, 0x39, 0x4b, 0x3c, 0x39, 0xa6, 0xdf, 0xdb,
+	0xa8, 0x26, 0xbc, 0x80, 0x33, 0x3a, 0x57, 0x13, 0x4a, 0x6d, 0x6f, 0xa6, 0x20, 0x71, 0xfc, 0x19,
+	0x3d, 0xa2, 0xc0, 0x3d, 0xbf, 0xcf, 0x99, 0x17, 0x92, 0x6b, 0x12, 0xf9, 0x3c, 0x66, 0xd4, 0x03,
+	0xe1, 0x00, 0xf4, 0x5c, 0x25, 0x5b, 0xdd, 0x75, 0x8e, 0xd2, 0x71, 0x59, 0x3f, 0x6b, 0x7f, 0xac,
+	0xf5, 0x39, 0x6b, 0xcc, 0x49, 0xd2, 0x25, 0xb8, 0x3a, 0x05, 0xbe, 0x16, 0x31, 0xbf, 0x69, 0x68,
+	0x7f, 0xd9, 0x07, 0x7e, 0x89, 0xf2, 0x33, 0x0f, 0x62, 0xb3, 0xbb, 0x8e, 0xfe, 0xeb, 0xc7, 0xb3,
+	0x03, 0x55, 0xaf, 0x5a, 0x18, 0x26, 0x04, 0xa0, 0xcd, 0x93, 0x98, 0x46, 0xee, 0x9c, 0x89, 0x9b,
+	0xa8, 0x28, 0xb3, 0x4d, 0xad, 0x71, 0xa2, 0x76, 0x7a, 0xb4, 0x29, 0xb8, 0x33, 0x6a, 0x35, 0x54,
+	0xe2, 0x82, 0x84, 0x44, 0xa9, 0x4c, 0x40, 0xbb, 0x73, 0x1c, 0x3f, 0x45, 0x79, 0x81, 0x79, 0x71,
+	0xa8, 0x9c, 0x14, 0xd2, 0x71, 0x79, 0x47, 0x6e, 0xb1, 0xe1, 0xee, 0x08, 0xb0, 0x15, 0xe2, 0x37,
+	0x68, 0x3b, 0xa6, 0x97, 0x4c, 0xdf, 0x12, 0x3d, 0x3c, 0x5e, 0xf3, 0xcd, 0xf3, 0xc5, 0x4b, 0x5d,
+	0x28, 0x80, 0x98, 0x33, 0xbf, 0xa2, 0xfd, 0xe5, 0xdd, 0xe2, 0x63, 0x94, 0x93, 0x77, 0xac, 0xbe,
+	0x5c, 0x4c, 0xc7, 0xe5, 0xbc, 0xc4, 0x5a, 0x0d, 0x57, 0x61, 0xb8, 0xbe, 0x50, 0x1c, 0x99, 0xf8,
+	0xf1, 0xe6, 0xe2, 0x38, 0x23, 0x19, 0x6d, 0xa9, 0x36, 0xe6, 0x00, 0xed, 0xdd, 0x65, 0xfc, 0x77,
+	0xf0, 0xd7, 0x77, 0x82, 0x9b, 0x1b, 0x8a, 0x4f, 0xd6, 0xc7, 0x76, 0xde, 0xdd, 0xa4, 0x86, 0x76,
+	0x9b, 0x1a, 0xda, 0x9f, 0xd4, 0xd0, 0xbe, 0x4f, 0x8c, 0xcc, 0xed, 0xc4, 0xc8, 0xfc, 0x9e, 0x18,
+	0x99, 0x4f, 0xcf, 0xa3, 0x98, 0x5f, 0xf5, 0x2f, 0xac, 0x80, 0x75, 0xed, 0xa6, 0xd4, 0x3c, 0x23,
+	0xfc, 0x0b, 0x4b, 0x3a, 0xf6, 0xec, 0xdd, 0x18, 0xce, 0x5e, 0x0e, 0x3e, 0xea, 0x11, 0xb8, 0xc8,
+	0x89, 0x47, 0xe2, 0xc5, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x10, 0x31, 0x5e, 0x32, 0xc7, 0x04,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -416,6 +429,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NSTAutoDelegationStakers) > 0 {
+		for iNdEx := len(m.NSTAutoDelegationStakers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NSTAutoDelegationStakers[iNdEx])
+			copy(dAtA[i:], m.NSTAutoDelegationStakers[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.NSTAutoDelegationStakers[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.OperatorAssets) > 0 {
 		for iNdEx := len(m.OperatorAssets) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -696,6 +718,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.NSTAutoDelegationStakers) > 0 {
+		for _, s := range m.NSTAutoDelegationStakers {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -971,6 +999,38 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NSTAutoDelegationStakers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NSTAutoDelegationStakers = append(m.NSTAutoDelegationStakers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
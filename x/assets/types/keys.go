@@ -44,6 +44,8 @@ const (
 	prefixRestakerExocoreAddrReverse
 
 	prefixParams
+
+	prefixNSTAutoDelegation
 )
 
 // KVStore key prefixes
@@ -76,6 +78,11 @@ var (
 	// KeyPrefixParams This is a key prefix for module parameter
 	KeyPrefixParams = []byte{prefixParams}
 	ParamsKey       = []byte("Params")
+
+	// KeyPrefixNSTAutoDelegation key-value: stakerID -> nil
+	// the presence of the key means the staker has enabled the auto-delegation of the
+	// native restaking token balance increases.
+	KeyPrefixNSTAutoDelegation = []byte{prefixNSTAutoDelegation}
 )
 
 func GetJoinedStoreKey(keys ...string) []byte {
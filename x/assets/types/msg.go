@@ -2,7 +2,9 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	oracletypes "github.com/ExocoreNetwork/exocore/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 var (
@@ -10,6 +12,8 @@ var (
 	_ sdk.Msg = &MsgSetExoCoreAddr{}
 	_ sdk.Msg = &RegisterClientChainReq{}
 	_ sdk.Msg = &RegisterAssetReq{}
+	_ sdk.Msg = &MsgUpdateStakingAssetLimits{}
+	_ sdk.Msg = &MsgSetNSTAutoDelegation{}
 )
 
 // GetSigners returns the expected signers for a MsgUpdateParams message.
@@ -53,7 +57,7 @@ func (m *MsgSetExoCoreAddr) GetSignBytes() []byte {
 	return nil
 }
 
-// GetSigners returns the expected signers for a MsgUpdateParams message.
+// GetSigners returns the expected signers for a RegisterClientChainReq message.
 func (m *RegisterClientChainReq) GetSigners() []sdk.AccAddress {
 	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
 	return []sdk.AccAddress{addr}
@@ -64,6 +68,21 @@ func (m *RegisterClientChainReq) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
 		return errorsmod.Wrap(err, "invalid from address")
 	}
+	if m.Info == nil {
+		return errorsmod.Wrap(ErrInvalidInputParameter, "nil client chain info")
+	}
+	if m.Info.Name == "" || len(m.Info.Name) > MaxChainTokenNameLength {
+		return errorsmod.Wrapf(ErrInvalidInputParameter, "invalid client chain name length:%d, max:%d", len(m.Info.Name), MaxChainTokenNameLength)
+	}
+	if len(m.Info.MetaInfo) > MaxChainTokenMetaInfoLength {
+		return errorsmod.Wrapf(ErrInvalidInputParameter, "invalid client chain meta info length:%d, max:%d", len(m.Info.MetaInfo), MaxChainTokenMetaInfoLength)
+	}
+	if m.Info.LayerZeroChainID == ExocoreChainLzID {
+		return errorsmod.Wrapf(ErrInvalidInputParameter, "the client chain ID can't be the exocore chain ID:%d", ExocoreChainLzID)
+	}
+	if m.Info.AddressLength < MinClientChainAddrLength {
+		return errorsmod.Wrapf(ErrInvalidInputParameter, "the address length is less than the minimum:%d, min:%d", m.Info.AddressLength, MinClientChainAddrLength)
+	}
 	return nil
 }
 
@@ -72,7 +91,7 @@ func (m *RegisterClientChainReq) GetSignBytes() []byte {
 	return nil
 }
 
-// GetSigners returns the expected signers for a MsgUpdateParams message.
+// GetSigners returns the expected signers for a RegisterAssetReq message.
 func (m *RegisterAssetReq) GetSigners() []sdk.AccAddress {
 	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
 	return []sdk.AccAddress{addr}
@@ -83,6 +102,27 @@ func (m *RegisterAssetReq) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
 		return errorsmod.Wrap(err, "invalid from address")
 	}
+	if m.Info == nil {
+		return errorsmod.Wrap(ErrInvalidInputParameter, "nil asset info")
+	}
+	if m.Info.Name == "" || len(m.Info.Name) > MaxChainTokenNameLength {
+		return errorsmod.Wrapf(ErrInvalidInputParameter, "invalid asset name length:%d, max:%d", len(m.Info.Name), MaxChainTokenNameLength)
+	}
+	if len(m.Info.MetaInfo) > MaxChainTokenMetaInfoLength {
+		return errorsmod.Wrapf(ErrInvalidInputParameter, "invalid asset meta info length:%d, max:%d", len(m.Info.MetaInfo), MaxChainTokenMetaInfoLength)
+	}
+	if m.Info.Decimals > MaxDecimal {
+		return errorsmod.Wrapf(ErrInvalidInputParameter, "the decimal is greater than the MaxDecimal,decimal:%v,MaxDecimal:%v", m.Info.Decimals, MaxDecimal)
+	}
+	if _, err := hexutil.Decode(m.Info.Address); err != nil {
+		return errorsmod.Wrapf(ErrInvalidInputParameter, "invalid asset address:%s, error:%s", m.Info.Address, err)
+	}
+	if err := ValidateStakingLimits(m.Info.MaxTotalStaking, m.Info.MaxPerStaker); err != nil {
+		return err
+	}
+	if _, err := oracletypes.ParseOracleInfo(m.OracleInfo); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -90,3 +130,47 @@ func (m *RegisterAssetReq) ValidateBasic() error {
 func (m *RegisterAssetReq) GetSignBytes() []byte {
 	return nil
 }
+
+// GetSigners returns the expected signers for a MsgUpdateStakingAssetLimits message.
+func (m *MsgUpdateStakingAssetLimits) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateStakingAssetLimits) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if _, _, err := ParseID(m.AssetID); err != nil {
+		return errorsmod.Wrapf(ErrInvalidInputParameter, "invalid assetID:%s, error:%s", m.AssetID, err)
+	}
+	return ValidateStakingLimits(m.MaxTotalStaking, m.MaxPerStaker)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgUpdateStakingAssetLimits) GetSignBytes() []byte {
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgSetNSTAutoDelegation message.
+func (m *MsgSetNSTAutoDelegation) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetNSTAutoDelegation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	if m.ClientChainID == ExocoreChainLzID {
+		return errorsmod.Wrapf(ErrInvalidInputParameter, "the native token isn't supported on the exocore chain ID:%d", ExocoreChainLzID)
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgSetNSTAutoDelegation) GetSignBytes() []byte {
+	return nil
+}
@@ -198,6 +198,12 @@ type AssetInfo struct {
 	ExocoreChainIndex uint64 `protobuf:"varint,6,opt,name=exocore_chain_index,json=exocoreChainIndex,proto3" json:"exocore_chain_index,omitempty"`
 	// meta_info about the asset, like "Tether USD on Ethereum blockchain".
 	MetaInfo string `protobuf:"bytes,7,opt,name=meta_info,json=metaInfo,proto3" json:"meta_info,omitempty"`
+	// max_total_staking is the optional upper bound of the total staking amount of the asset.
+	// There is no limit if it's nil or zero.
+	MaxTotalStaking *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=max_total_staking,json=maxTotalStaking,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_total_staking,omitempty"`
+	// max_per_staker is the optional upper bound of the total deposit amount of a single staker.
+	// There is no limit if it's nil or zero.
+	MaxPerStaker *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=max_per_staker,json=maxPerStaker,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_per_staker,omitempty"`
 }
 
 func (m *AssetInfo) Reset()         { *m = AssetInfo{} }
@@ -647,6 +653,10 @@ type RegisterAssetReq struct {
 	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
 	// info is the information of the asset.
 	Info *AssetInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	// oracle_info is the information to register the token feeder of the asset in the oracle
+	// module, in the same format as the one used by the gateway:
+	// '[tokenName],[chainName],[tokenDecimal](,[interval],[contract](,[ChainDesc:{...}],[TokenDesc:{...}]))'
+	OracleInfo string `protobuf:"bytes,3,opt,name=oracle_info,json=oracleInfo,proto3" json:"oracle_info,omitempty"`
 }
 
 func (m *RegisterAssetReq) Reset()         { *m = RegisterAssetReq{} }
@@ -813,6 +823,187 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateStakingAssetLimits is the Msg to update the staking limits of an asset.
+type MsgUpdateStakingAssetLimits struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// asset_id is the ID of the asset to update.
+	AssetID string `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// max_total_staking is the new upper bound of the total staking amount, nil or zero to remove it.
+	MaxTotalStaking *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=max_total_staking,json=maxTotalStaking,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_total_staking,omitempty"`
+	// max_per_staker is the new upper bound of the deposit amount of a single staker, nil or zero to
+	// remove it.
+	MaxPerStaker *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_per_staker,json=maxPerStaker,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_per_staker,omitempty"`
+}
+
+func (m *MsgUpdateStakingAssetLimits) Reset()         { *m = MsgUpdateStakingAssetLimits{} }
+func (m *MsgUpdateStakingAssetLimits) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateStakingAssetLimits) ProtoMessage()    {}
+func (*MsgUpdateStakingAssetLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adb6ebd423a2c426, []int{15}
+}
+func (m *MsgUpdateStakingAssetLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateStakingAssetLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateStakingAssetLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateStakingAssetLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateStakingAssetLimits.Merge(m, src)
+}
+func (m *MsgUpdateStakingAssetLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateStakingAssetLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateStakingAssetLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateStakingAssetLimits proto.InternalMessageInfo
+
+func (m *MsgUpdateStakingAssetLimits) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateStakingAssetLimits) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+// MsgUpdateStakingAssetLimitsResponse is the response to MsgUpdateStakingAssetLimits.
+type MsgUpdateStakingAssetLimitsResponse struct {
+}
+
+func (m *MsgUpdateStakingAssetLimitsResponse) Reset()         { *m = MsgUpdateStakingAssetLimitsResponse{} }
+func (m *MsgUpdateStakingAssetLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateStakingAssetLimitsResponse) ProtoMessage()    {}
+func (*MsgUpdateStakingAssetLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adb6ebd423a2c426, []int{16}
+}
+func (m *MsgUpdateStakingAssetLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateStakingAssetLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateStakingAssetLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateStakingAssetLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateStakingAssetLimitsResponse.Merge(m, src)
+}
+func (m *MsgUpdateStakingAssetLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateStakingAssetLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateStakingAssetLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateStakingAssetLimitsResponse proto.InternalMessageInfo
+
+// MsgSetNSTAutoDelegation is the Msg to set the auto-delegation policy of the native restaking
+// token of a staker. When enabled, the balance increases of the native token, such as the
+// consensus rewards of the client chain, are proportionally delegated to the operators that
+// the staker has already delegated the native token to.
+type MsgSetNSTAutoDelegation struct {
+	// from_address is the address of the staker, which is used as the staker address
+	// on the client chain.
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// client_chain_id is the layer zero chain ID of the client chain.
+	ClientChainID uint64 `protobuf:"varint,2,opt,name=client_chain_id,json=clientChainId,proto3" json:"client_chain_id,omitempty"`
+	// enabled indicates whether the auto-delegation is enabled.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetNSTAutoDelegation) Reset()         { *m = MsgSetNSTAutoDelegation{} }
+func (m *MsgSetNSTAutoDelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgSetNSTAutoDelegation) ProtoMessage()    {}
+func (*MsgSetNSTAutoDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adb6ebd423a2c426, []int{17}
+}
+func (m *MsgSetNSTAutoDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetNSTAutoDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetNSTAutoDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetNSTAutoDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetNSTAutoDelegation.Merge(m, src)
+}
+func (m *MsgSetNSTAutoDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetNSTAutoDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetNSTAutoDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetNSTAutoDelegation proto.InternalMessageInfo
+
+// MsgSetNSTAutoDelegationResponse is the response to MsgSetNSTAutoDelegation.
+type MsgSetNSTAutoDelegationResponse struct {
+}
+
+func (m *MsgSetNSTAutoDelegationResponse) Reset()         { *m = MsgSetNSTAutoDelegationResponse{} }
+func (m *MsgSetNSTAutoDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetNSTAutoDelegationResponse) ProtoMessage()    {}
+func (*MsgSetNSTAutoDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adb6ebd423a2c426, []int{18}
+}
+func (m *MsgSetNSTAutoDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetNSTAutoDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetNSTAutoDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetNSTAutoDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetNSTAutoDelegationResponse.Merge(m, src)
+}
+func (m *MsgSetNSTAutoDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetNSTAutoDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetNSTAutoDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetNSTAutoDelegationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ValueField)(nil), "exocore.assets.v1.ValueField")
 	proto.RegisterType((*ClientChainInfo)(nil), "exocore.assets.v1.ClientChainInfo")
@@ -830,90 +1021,114 @@ func init() {
 	proto.RegisterType((*RegisterAssetResponse)(nil), "exocore.assets.v1.RegisterAssetResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "exocore.assets.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "exocore.assets.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateStakingAssetLimits)(nil), "exocore.assets.v1.MsgUpdateStakingAssetLimits")
+	proto.RegisterType((*MsgUpdateStakingAssetLimitsResponse)(nil), "exocore.assets.v1.MsgUpdateStakingAssetLimitsResponse")
+	proto.RegisterType((*MsgSetNSTAutoDelegation)(nil), "exocore.assets.v1.MsgSetNSTAutoDelegation")
+	proto.RegisterType((*MsgSetNSTAutoDelegationResponse)(nil), "exocore.assets.v1.MsgSetNSTAutoDelegationResponse")
 }
 
 func init() { proto.RegisterFile("exocore/assets/v1/tx.proto", fileDescriptor_adb6ebd423a2c426) }
 
 var fileDescriptor_adb6ebd423a2c426 = []byte{
-	// 1234 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x3a, 0xff, 0x5f, 0x9a, 0xc4, 0x99, 0x84, 0xd6, 0x76, 0x5a, 0xa7, 0x5a, 0xa9, 0x10,
-	0x45, 0xc4, 0xdb, 0x06, 0x51, 0x4a, 0xca, 0x25, 0x7f, 0x5a, 0x29, 0xa8, 0x05, 0xb4, 0x6e, 0x39,
-	0x54, 0x42, 0xab, 0xf1, 0xee, 0x64, 0xbd, 0xca, 0xee, 0x8c, 0xd9, 0x19, 0xb7, 0x76, 0xc5, 0x01,
-	0xf5, 0x84, 0x90, 0x90, 0x10, 0x5c, 0x39, 0xf4, 0x23, 0xf4, 0x50, 0x21, 0x3e, 0x42, 0xc5, 0xa9,
-	0x94, 0x0b, 0xe2, 0x50, 0x55, 0x29, 0x52, 0x38, 0xf1, 0x15, 0x40, 0x33, 0x3b, 0x6b, 0x7b, 0x13,
-	0xa7, 0x11, 0xd4, 0x5c, 0x92, 0x9d, 0x79, 0xef, 0xfd, 0xde, 0xfb, 0xfd, 0xe6, 0xcd, 0x1f, 0x43,
-	0x89, 0xb4, 0x98, 0xcb, 0x62, 0x62, 0x61, 0xce, 0x89, 0xe0, 0xd6, 0xdd, 0x4b, 0x96, 0x68, 0x55,
-	0x1a, 0x31, 0x13, 0x0c, 0xcd, 0x69, 0x5b, 0x25, 0xb1, 0x55, 0xee, 0x5e, 0x2a, 0xcd, 0xe1, 0x28,
-	0xa0, 0xcc, 0x52, 0x7f, 0x13, 0xaf, 0xd2, 0x19, 0x97, 0xf1, 0x88, 0x71, 0x2b, 0xe2, 0xbe, 0x8c,
-	0x8e, 0xb8, 0xaf, 0x0d, 0xc5, 0xc4, 0xe0, 0xa8, 0x91, 0x95, 0x0c, 0xb4, 0xa9, 0x7c, 0x34, 0x6b,
-	0x03, 0xc7, 0x38, 0x4a, 0xed, 0x0b, 0x3e, 0xf3, 0x59, 0x12, 0x27, 0xbf, 0xf4, 0xec, 0x59, 0x9f,
-	0x31, 0x3f, 0x24, 0x16, 0x6e, 0x04, 0x16, 0xa6, 0x94, 0x09, 0x2c, 0x02, 0x46, 0x75, 0x8c, 0x59,
-	0x03, 0xf8, 0x14, 0x87, 0x4d, 0x72, 0x3d, 0x20, 0xa1, 0x87, 0x6e, 0xc1, 0x18, 0x8e, 0x58, 0x93,
-	0x8a, 0x82, 0x71, 0xde, 0x58, 0x9e, 0xdc, 0xfc, 0xe0, 0xc9, 0xf3, 0xa5, 0xa1, 0xdf, 0x9f, 0x2f,
-	0xbd, 0xe9, 0x07, 0xa2, 0xde, 0xac, 0x55, 0x5c, 0x16, 0xe9, 0x92, 0xf4, 0xbf, 0x55, 0xee, 0xed,
-	0x59, 0xa2, 0xdd, 0x20, 0xbc, 0xb2, 0x43, 0xc5, 0xb3, 0xc7, 0xab, 0xa0, 0x2b, 0xde, 0xa1, 0xc2,
-	0xd6, 0x58, 0xe6, 0x2f, 0x39, 0x98, 0xdd, 0x0a, 0x03, 0x42, 0xc5, 0x56, 0x1d, 0x07, 0x74, 0x87,
-	0xee, 0x32, 0x84, 0x60, 0x84, 0xe2, 0x88, 0x24, 0x79, 0x6c, 0xf5, 0x8d, 0x16, 0x61, 0x32, 0x22,
-	0x02, 0x3b, 0x01, 0xdd, 0x65, 0x85, 0x9c, 0x32, 0x4c, 0xc8, 0x09, 0x15, 0x50, 0x84, 0x09, 0x57,
-	0x46, 0x3b, 0x81, 0x57, 0x18, 0x3e, 0x6f, 0x2c, 0x8f, 0xd8, 0xe3, 0x6a, 0xbc, 0xe3, 0xa1, 0x0a,
-	0xcc, 0x6b, 0x65, 0x1c, 0xed, 0x42, 0x3d, 0xd2, 0x2a, 0x8c, 0x28, 0xaf, 0x74, 0x39, 0x74, 0x6a,
-	0x8f, 0xb4, 0x90, 0x05, 0xf3, 0xbb, 0x01, 0xc5, 0x61, 0x70, 0x5f, 0x49, 0xe1, 0xd4, 0x42, 0xe6,
-	0xee, 0xf1, 0xc2, 0xa8, 0xf2, 0x47, 0xbd, 0xa6, 0x4d, 0x65, 0x41, 0x5b, 0x30, 0x1f, 0xe2, 0x36,
-	0x89, 0x9d, 0xfb, 0x24, 0x66, 0x4e, 0xa7, 0x8c, 0x31, 0x19, 0xb0, 0xb9, 0xb0, 0xff, 0x7c, 0x29,
-	0x7f, 0x43, 0x9a, 0xef, 0x90, 0x98, 0x25, 0x69, 0xb6, 0xed, 0x7c, 0x98, 0x9d, 0xf1, 0xd0, 0x05,
-	0x98, 0xe1, 0x81, 0x4f, 0xb1, 0x68, 0xc6, 0xc4, 0x91, 0x92, 0x15, 0xc6, 0x15, 0xc5, 0xe9, 0xce,
-	0xec, 0xad, 0x76, 0x83, 0x48, 0x37, 0xec, 0x79, 0x31, 0xe1, 0xdc, 0x09, 0x09, 0xf5, 0x45, 0xbd,
-	0x30, 0x71, 0xde, 0x58, 0x9e, 0xb6, 0xa7, 0xf5, 0xec, 0x0d, 0x35, 0x69, 0xfe, 0x6d, 0xc0, 0xe4,
-	0x86, 0x6c, 0x83, 0x63, 0xd5, 0x3c, 0x0d, 0x63, 0xbc, 0x1d, 0xd5, 0x58, 0xa8, 0xa5, 0xd4, 0x23,
-	0x54, 0x80, 0x71, 0x0d, 0xa5, 0x74, 0x9c, 0xb4, 0xd3, 0x21, 0x2a, 0xc1, 0x84, 0x47, 0xdc, 0x20,
-	0xc2, 0x21, 0x57, 0xe2, 0x4d, 0xdb, 0x9d, 0xf1, 0x71, 0x12, 0x8c, 0xfe, 0x2b, 0x09, 0x8e, 0x59,
-	0xa8, 0xb1, 0xe3, 0x16, 0x2a, 0xd3, 0x10, 0xe3, 0xd9, 0x86, 0x30, 0x9f, 0x1a, 0x90, 0xaf, 0x0a,
-	0xbc, 0x17, 0x50, 0xbf, 0x2b, 0xc4, 0x0d, 0xc8, 0xab, 0xcd, 0xe1, 0xd4, 0x30, 0x0f, 0xdc, 0x24,
-	0x50, 0x8a, 0x32, 0xb5, 0x76, 0xb6, 0x72, 0x64, 0x5f, 0x56, 0x3a, 0x71, 0x9b, 0x23, 0xb2, 0xd1,
-	0xed, 0x19, 0x65, 0xda, 0x94, 0xa1, 0x0a, 0x8d, 0xc2, 0x02, 0x4f, 0x32, 0x38, 0x82, 0x09, 0x1c,
-	0x3a, 0x7a, 0x73, 0xe4, 0x06, 0xb0, 0x39, 0x90, 0x46, 0xbe, 0x25, 0x81, 0x37, 0x92, 0x8d, 0xf2,
-	0x57, 0x0e, 0x66, 0x25, 0x25, 0x12, 0x77, 0x19, 0x51, 0x58, 0x48, 0x72, 0x7b, 0xa4, 0xc1, 0x78,
-	0x20, 0x9c, 0x01, 0x6e, 0x50, 0xa4, 0x90, 0xb7, 0x13, 0xe0, 0xa4, 0x06, 0x14, 0xc1, 0xfc, 0xbd,
-	0x40, 0xd4, 0xbd, 0x18, 0xdf, 0xc3, 0xb5, 0x90, 0x0c, 0x94, 0x72, 0x2f, 0xb0, 0x4e, 0xf7, 0x05,
-	0x2c, 0x36, 0x08, 0xf5, 0xa4, 0xc4, 0x4d, 0xea, 0x91, 0x90, 0xf8, 0xc9, 0x9e, 0xd4, 0x69, 0x87,
-	0x07, 0x90, 0xb6, 0xa8, 0x13, 0xdc, 0xee, 0xc1, 0xd7, 0x82, 0xef, 0x1b, 0x30, 0xaf, 0x05, 0x0f,
-	0x43, 0xa5, 0x39, 0x57, 0xa2, 0x7b, 0x90, 0xc7, 0x61, 0xe8, 0x24, 0x9d, 0xe2, 0x70, 0x81, 0x85,
-	0xdc, 0x5b, 0xc3, 0xcb, 0x53, 0x6b, 0xeb, 0x7d, 0xda, 0xa8, 0x0f, 0x42, 0xa5, 0x33, 0xaa, 0xca,
-	0xe0, 0x6b, 0x54, 0xc4, 0x6d, 0x7b, 0x06, 0x67, 0x26, 0x4b, 0x04, 0xe6, 0xfb, 0xb8, 0xa1, 0x3c,
-	0x0c, 0xef, 0x91, 0xb6, 0xde, 0xcb, 0xf2, 0x13, 0x5d, 0x81, 0xd1, 0xbb, 0xf2, 0x90, 0x56, 0xab,
-	0x30, 0xb5, 0x66, 0x1e, 0x5f, 0x43, 0xda, 0x36, 0x76, 0x12, 0xb0, 0x9e, 0xbb, 0x62, 0x98, 0x3f,
-	0x0e, 0xc3, 0xdc, 0xc7, 0x0d, 0x12, 0x63, 0xc1, 0x7a, 0xfa, 0xca, 0x81, 0x53, 0x99, 0x9e, 0x1e,
-	0x44, 0x3f, 0x4d, 0x89, 0x6e, 0x33, 0x9f, 0xb4, 0xb2, 0xb9, 0xff, 0x75, 0x65, 0xd1, 0x67, 0x90,
-	0x14, 0xe3, 0xf0, 0x3a, 0x8e, 0xc9, 0x7f, 0xe8, 0xa3, 0x6d, 0xe2, 0xf6, 0x64, 0xdb, 0x26, 0xae,
-	0x0d, 0x0a, 0xb0, 0x2a, 0xf1, 0x90, 0x0b, 0x33, 0x4c, 0x4b, 0xaa, 0x33, 0x8c, 0x0c, 0x20, 0xc3,
-	0x74, 0x8a, 0xa9, 0x92, 0x98, 0x2f, 0x72, 0x30, 0x77, 0x93, 0xfb, 0x55, 0x22, 0xae, 0xb5, 0xd8,
-	0x16, 0x8b, 0xc9, 0x86, 0xe7, 0xc5, 0xe8, 0x2a, 0x9c, 0xda, 0x8d, 0x59, 0xe4, 0xa4, 0x87, 0x78,
-	0xb2, 0x70, 0x85, 0x67, 0x8f, 0x57, 0x17, 0x34, 0xd4, 0x46, 0x62, 0xa9, 0x8a, 0x38, 0xa0, 0xbe,
-	0x3d, 0x25, 0xbd, 0xf5, 0x14, 0x7a, 0x1f, 0xa6, 0xe4, 0xe9, 0x98, 0xc6, 0xe6, 0x4e, 0x88, 0x05,
-	0x4e, 0x44, 0x1a, 0xba, 0x02, 0x73, 0xae, 0xba, 0xc4, 0xf5, 0xd9, 0x2d, 0x31, 0xf4, 0x0d, 0x32,
-	0xeb, 0x76, 0x6f, 0x77, 0x55, 0xe3, 0xdb, 0x80, 0x32, 0xbe, 0xbd, 0x17, 0x72, 0xde, 0xed, 0x7d,
-	0x0a, 0xc8, 0x63, 0x7e, 0x03, 0xce, 0x71, 0xd5, 0xbe, 0x4e, 0x26, 0xa8, 0x73, 0x2f, 0xaa, 0x5b,
-	0x66, 0xd2, 0x2e, 0x25, 0x4e, 0x3d, 0x2f, 0x89, 0x6a, 0xea, 0xb1, 0x7e, 0xf9, 0xab, 0x87, 0x4b,
-	0x43, 0x7f, 0x3e, 0x5c, 0x1a, 0x7a, 0x70, 0xf0, 0x68, 0xa5, 0x97, 0xf1, 0xd7, 0x07, 0x8f, 0x56,
-	0x8a, 0xe9, 0xbb, 0xe9, 0x88, 0x98, 0xe6, 0x22, 0x14, 0x8f, 0x4c, 0xda, 0x84, 0x37, 0x18, 0xe5,
-	0xc4, 0xfc, 0xd9, 0x80, 0xd3, 0x36, 0xf1, 0x03, 0x2e, 0x32, 0x59, 0x6d, 0xf2, 0xf9, 0xeb, 0x2d,
-	0xc2, 0x65, 0x18, 0xe9, 0x3c, 0x71, 0xfa, 0xef, 0xe6, 0x43, 0xaf, 0x25, 0x5b, 0xf9, 0xaf, 0x5f,
-	0xcd, 0x90, 0xbc, 0x9e, 0x25, 0x59, 0xee, 0xe9, 0xaf, 0x3e, 0x45, 0x9b, 0xe7, 0x60, 0xb1, 0x2f,
-	0x17, 0xcd, 0xf5, 0x27, 0x03, 0xf2, 0xa9, 0x5d, 0x1d, 0x12, 0xaf, 0xcd, 0xf2, 0x62, 0x86, 0xe5,
-	0x2b, 0xaf, 0x5f, 0xcd, 0xef, 0xdd, 0x57, 0xf1, 0x2b, 0xf4, 0xe1, 0xa7, 0x00, 0xcc, 0x33, 0xf0,
-	0xc6, 0xa1, 0xca, 0x35, 0xa7, 0xef, 0x0c, 0x98, 0xbd, 0xc9, 0xfd, 0xdb, 0x0d, 0x0f, 0x0b, 0xf2,
-	0x89, 0x7a, 0x29, 0xa3, 0xcb, 0x30, 0x89, 0x9b, 0xa2, 0xce, 0xe2, 0x40, 0xb4, 0x4f, 0xe4, 0xd3,
-	0x75, 0x45, 0xef, 0xc1, 0x58, 0xf2, 0xd6, 0xd6, 0x7c, 0x8a, 0x7d, 0xf8, 0x24, 0x29, 0xf4, 0x5b,
-	0x42, 0xbb, 0xaf, 0xcf, 0x48, 0x32, 0x5d, 0x20, 0xb3, 0x08, 0x67, 0x0e, 0xd5, 0x94, 0xd6, 0xbb,
-	0xf6, 0x83, 0x01, 0xc3, 0x37, 0xb9, 0x8f, 0xbe, 0x31, 0xe0, 0x54, 0xa6, 0xe8, 0x7e, 0x2d, 0x72,
-	0x08, 0xa4, 0xb4, 0x72, 0xb2, 0x4f, 0x47, 0x98, 0xd5, 0x07, 0xbf, 0xfe, 0xf1, 0x7d, 0xee, 0x2d,
-	0xf3, 0x82, 0xd5, 0xef, 0x77, 0x8c, 0x75, 0x18, 0x7a, 0xf4, 0xcb, 0x83, 0x47, 0x2b, 0xc6, 0xe6,
-	0x87, 0x4f, 0xf6, 0xcb, 0xc6, 0xd3, 0xfd, 0xb2, 0xf1, 0x62, 0xbf, 0x6c, 0x7c, 0xfb, 0xb2, 0x3c,
-	0xf4, 0xf4, 0x65, 0x79, 0xe8, 0xb7, 0x97, 0xe5, 0xa1, 0x3b, 0x17, 0x7b, 0x4e, 0xbb, 0x6b, 0x09,
-	0xe2, 0x47, 0x44, 0xdc, 0x63, 0xf1, 0x5e, 0x27, 0x41, 0x2b, 0x4d, 0xa1, 0xce, 0xbe, 0xda, 0x98,
-	0xfa, 0xf5, 0xf1, 0xce, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x24, 0xc2, 0xb0, 0x49, 0x0d,
-	0x00, 0x00,
+	// 1559 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4b, 0x6f, 0x1b, 0x47,
+	0x12, 0xd6, 0x90, 0xd4, 0xab, 0x28, 0x4a, 0xd4, 0x48, 0x6b, 0x53, 0x94, 0x4d, 0x7a, 0xc7, 0x90,
+	0x57, 0x96, 0x2d, 0xd2, 0xd6, 0x62, 0xb5, 0x5e, 0x79, 0xf7, 0xa0, 0x87, 0x0d, 0x68, 0x21, 0x7b,
+	0x8d, 0xa1, 0xbc, 0x07, 0x03, 0x8b, 0x41, 0x93, 0xd3, 0x1a, 0x0d, 0x34, 0x33, 0xcd, 0x9d, 0x69,
+	0xd9, 0x94, 0x91, 0x43, 0xe0, 0x53, 0x90, 0x20, 0x40, 0x90, 0xfc, 0x01, 0x03, 0xb9, 0xe5, 0xe4,
+	0x83, 0x91, 0x83, 0x7f, 0x81, 0x11, 0x20, 0x80, 0xe3, 0x5c, 0xf2, 0x00, 0x04, 0x43, 0x0e, 0xe0,
+	0x20, 0x87, 0xfc, 0x86, 0xa0, 0x1f, 0x1c, 0xcd, 0x48, 0x43, 0xc9, 0x0f, 0x26, 0x17, 0x9b, 0xdd,
+	0x55, 0x5d, 0x5f, 0xd5, 0x57, 0xd5, 0xd5, 0x35, 0x82, 0x22, 0x6e, 0x91, 0x06, 0xf1, 0x71, 0x15,
+	0x05, 0x01, 0xa6, 0x41, 0xf5, 0xee, 0xe5, 0x2a, 0x6d, 0x55, 0x9a, 0x3e, 0xa1, 0x44, 0x1d, 0x95,
+	0xb2, 0x8a, 0x90, 0x55, 0xee, 0x5e, 0x2e, 0x8e, 0x22, 0xd7, 0xf6, 0x48, 0x95, 0xff, 0x2b, 0xb4,
+	0x8a, 0x27, 0x1b, 0x24, 0x70, 0x49, 0x50, 0x75, 0x03, 0x8b, 0x9d, 0x76, 0x03, 0x4b, 0x0a, 0x26,
+	0x84, 0xc0, 0xe0, 0xab, 0xaa, 0x58, 0x48, 0x51, 0xe9, 0x30, 0x6a, 0x13, 0xf9, 0xc8, 0x6d, 0xcb,
+	0xc7, 0x2d, 0x62, 0x11, 0x71, 0x8e, 0xfd, 0x92, 0xbb, 0xa7, 0x2c, 0x42, 0x2c, 0x07, 0x57, 0x51,
+	0xd3, 0xae, 0x22, 0xcf, 0x23, 0x14, 0x51, 0x9b, 0x78, 0xf2, 0x8c, 0x56, 0x07, 0xf8, 0x2f, 0x72,
+	0xb6, 0xf1, 0x75, 0x1b, 0x3b, 0xa6, 0xba, 0x0e, 0x7d, 0xc8, 0x25, 0xdb, 0x1e, 0x2d, 0x28, 0x67,
+	0x94, 0xe9, 0xc1, 0xa5, 0x7f, 0x3e, 0xdd, 0x2d, 0xf7, 0xfc, 0xb0, 0x5b, 0x3e, 0x67, 0xd9, 0x74,
+	0x73, 0xbb, 0x5e, 0x69, 0x10, 0x57, 0xba, 0x24, 0xff, 0x9b, 0x0d, 0xcc, 0xad, 0x2a, 0xdd, 0x69,
+	0xe2, 0xa0, 0xb2, 0xea, 0xd1, 0xe7, 0x8f, 0x67, 0x41, 0x7a, 0xbc, 0xea, 0x51, 0x5d, 0xda, 0xd2,
+	0xbe, 0x49, 0xc1, 0xc8, 0xb2, 0x63, 0x63, 0x8f, 0x2e, 0x6f, 0x22, 0xdb, 0x5b, 0xf5, 0x36, 0x88,
+	0xaa, 0x42, 0xc6, 0x43, 0x2e, 0x16, 0x38, 0x3a, 0xff, 0xad, 0x4e, 0xc2, 0xa0, 0x8b, 0x29, 0x32,
+	0x6c, 0x6f, 0x83, 0x14, 0x52, 0x5c, 0x30, 0xc0, 0x36, 0xf8, 0x81, 0x09, 0x18, 0x68, 0xb0, 0xd3,
+	0x86, 0x6d, 0x16, 0xd2, 0x67, 0x94, 0xe9, 0x8c, 0xde, 0xcf, 0xd7, 0xab, 0xa6, 0x5a, 0x81, 0x31,
+	0xc9, 0x8c, 0x21, 0x55, 0x3c, 0x13, 0xb7, 0x0a, 0x19, 0xae, 0xd5, 0x4e, 0x87, 0x84, 0x36, 0x71,
+	0x4b, 0xad, 0xc2, 0xd8, 0x86, 0xed, 0x21, 0xc7, 0xbe, 0xcf, 0xa9, 0x30, 0xea, 0x0e, 0x69, 0x6c,
+	0x05, 0x85, 0x5e, 0xae, 0xaf, 0x46, 0x45, 0x4b, 0x5c, 0xa2, 0x2e, 0xc3, 0x98, 0x83, 0x76, 0xb0,
+	0x6f, 0xdc, 0xc7, 0x3e, 0x31, 0x42, 0x37, 0xfa, 0xd8, 0x81, 0xa5, 0xf1, 0xbd, 0xdd, 0x72, 0x7e,
+	0x8d, 0x89, 0xef, 0x60, 0x9f, 0x08, 0x98, 0x15, 0x3d, 0xef, 0xc4, 0x77, 0x4c, 0x75, 0x0a, 0x86,
+	0x03, 0xdb, 0xf2, 0x10, 0xdd, 0xf6, 0xb1, 0xc1, 0x28, 0x2b, 0xf4, 0xf3, 0x10, 0x73, 0xe1, 0xee,
+	0xfa, 0x4e, 0x13, 0x33, 0x35, 0x64, 0x9a, 0x3e, 0x0e, 0x02, 0xc3, 0xc1, 0x9e, 0x45, 0x37, 0x0b,
+	0x03, 0x67, 0x94, 0xe9, 0x9c, 0x9e, 0x93, 0xbb, 0x6b, 0x7c, 0x53, 0xfb, 0x3a, 0x0d, 0x83, 0x8b,
+	0xac, 0x0c, 0x3a, 0xb2, 0x79, 0x02, 0xfa, 0x82, 0x1d, 0xb7, 0x4e, 0x1c, 0x49, 0xa5, 0x5c, 0xa9,
+	0x05, 0xe8, 0x97, 0xa6, 0x38, 0x8f, 0x83, 0x7a, 0x7b, 0xa9, 0x16, 0x61, 0xc0, 0xc4, 0x0d, 0xdb,
+	0x45, 0x4e, 0xc0, 0xc9, 0xcb, 0xe9, 0xe1, 0xba, 0x13, 0x05, 0xbd, 0x6f, 0x44, 0x41, 0x87, 0x44,
+	0xf5, 0x75, 0x4a, 0x54, 0xac, 0x20, 0xfa, 0x0f, 0x14, 0xc4, 0x26, 0x8c, 0xba, 0xa8, 0x65, 0x50,
+	0x42, 0x91, 0x63, 0x04, 0x14, 0x6d, 0xd9, 0x9e, 0xc5, 0xb9, 0x12, 0x65, 0xab, 0xbc, 0x75, 0xd9,
+	0x8e, 0xb8, 0xa8, 0xb5, 0xce, 0xac, 0xd6, 0x84, 0x51, 0xb5, 0x0e, 0xc3, 0x0c, 0xa9, 0x89, 0x7d,
+	0x8e, 0x83, 0xfd, 0xc2, 0x60, 0x17, 0x60, 0x86, 0x5c, 0xd4, 0xba, 0x85, 0xfd, 0x1a, 0xb7, 0xa8,
+	0x3d, 0x53, 0x20, 0x2f, 0xf1, 0xf6, 0xd3, 0xba, 0x06, 0x79, 0x7e, 0xd5, 0x8d, 0x3a, 0x0a, 0xec,
+	0x86, 0xa0, 0x81, 0xa5, 0x38, 0x3b, 0x77, 0xaa, 0x72, 0xa8, 0xcb, 0x54, 0xc2, 0x73, 0x4b, 0x19,
+	0x76, 0x6d, 0xf5, 0x61, 0x2e, 0x5a, 0x62, 0x47, 0xb9, 0x35, 0x0f, 0xc6, 0x25, 0x4d, 0x92, 0x34,
+	0x79, 0xd5, 0x53, 0x5d, 0xb8, 0xea, 0xaa, 0xb4, 0xcc, 0x79, 0x5b, 0x14, 0xd7, 0xfe, 0xd7, 0x14,
+	0x8c, 0x88, 0xe8, 0xf6, 0x23, 0xf2, 0x60, 0x5c, 0x60, 0x9b, 0xb8, 0x49, 0x02, 0x9b, 0x1a, 0x5d,
+	0x6c, 0x37, 0x2a, 0xb7, 0xbc, 0x22, 0x0c, 0x0b, 0x1f, 0x54, 0x17, 0xc6, 0xee, 0xd9, 0x74, 0xd3,
+	0xf4, 0xd1, 0x3d, 0x54, 0x77, 0x70, 0x57, 0x43, 0x8e, 0x1a, 0x96, 0x70, 0xef, 0xc1, 0x64, 0x13,
+	0x7b, 0x26, 0xa3, 0x78, 0xdb, 0x33, 0xb1, 0x83, 0x2d, 0xd1, 0x61, 0x24, 0x6c, 0xba, 0x0b, 0xb0,
+	0x13, 0x12, 0xe0, 0x76, 0xc4, 0xbe, 0x24, 0x7c, 0x4f, 0x81, 0x31, 0x49, 0xb8, 0xe3, 0x70, 0xce,
+	0x03, 0x4e, 0xba, 0x09, 0x79, 0xe4, 0x38, 0x86, 0xa8, 0x14, 0x56, 0xc2, 0x94, 0x75, 0x8a, 0xf4,
+	0x74, 0x76, 0x6e, 0x21, 0xa1, 0x8c, 0x12, 0x2c, 0x54, 0xc2, 0x55, 0x8d, 0x1d, 0xbe, 0xe6, 0x51,
+	0x7f, 0x47, 0x1f, 0x46, 0xb1, 0xcd, 0x22, 0x86, 0xb1, 0x04, 0x35, 0x35, 0x0f, 0xe9, 0x2d, 0xbc,
+	0x23, 0x3b, 0x13, 0xfb, 0xa9, 0x5e, 0x81, 0xde, 0xbb, 0xec, 0xc9, 0xe1, 0x59, 0xc8, 0xce, 0x69,
+	0x9d, 0x7d, 0x68, 0x97, 0x8d, 0x2e, 0x0e, 0x2c, 0xa4, 0xae, 0x28, 0xda, 0x97, 0x69, 0x18, 0xfd,
+	0x4f, 0x13, 0xfb, 0x88, 0x92, 0x48, 0x5d, 0x19, 0x30, 0x14, 0xab, 0xe9, 0x6e, 0xd4, 0x53, 0x96,
+	0xee, 0x17, 0xf3, 0x71, 0x99, 0x4d, 0xfd, 0xae, 0x99, 0x55, 0xff, 0x07, 0x59, 0xd9, 0xe7, 0x36,
+	0x91, 0x8f, 0xdf, 0xa2, 0x8e, 0x56, 0x70, 0x23, 0x82, 0xb6, 0x82, 0x1b, 0x3a, 0x70, 0x83, 0x35,
+	0x66, 0x4f, 0x6d, 0xc0, 0x30, 0x91, 0x94, 0x4a, 0x84, 0x4c, 0x17, 0x10, 0x72, 0x6d, 0x9b, 0x1c,
+	0x44, 0x7b, 0x91, 0x82, 0xd1, 0x1b, 0x81, 0x55, 0xc3, 0xf4, 0x5a, 0x8b, 0x2c, 0x13, 0x1f, 0x2f,
+	0x9a, 0xa6, 0xaf, 0x5e, 0x85, 0xa1, 0x0d, 0x9f, 0xb8, 0x46, 0xfb, 0x49, 0x12, 0x89, 0x2b, 0x3c,
+	0x7f, 0x3c, 0x3b, 0x2e, 0x4d, 0x2d, 0x0a, 0x49, 0x8d, 0xfa, 0xb6, 0x67, 0xe9, 0x59, 0xa6, 0x2d,
+	0xb7, 0xd4, 0x7f, 0x40, 0x96, 0x75, 0xc7, 0xf6, 0xd9, 0xd4, 0x31, 0x67, 0x21, 0xc0, 0xb4, 0x7d,
+	0x74, 0x06, 0x46, 0x1b, 0x7c, 0x24, 0x91, 0x2f, 0x11, 0xb3, 0x21, 0xdf, 0xc3, 0x91, 0xc6, 0xfe,
+	0xac, 0xc2, 0x7d, 0xbc, 0x08, 0x6a, 0x4c, 0x37, 0x3a, 0x5e, 0xe4, 0x1b, 0xd1, 0xc1, 0x86, 0x3d,
+	0x5a, 0x8b, 0x70, 0x5a, 0xbc, 0x12, 0x46, 0xec, 0x50, 0xf8, 0xca, 0xf3, 0x37, 0x73, 0x50, 0x2f,
+	0x0a, 0xa5, 0xc8, 0x5c, 0x54, 0x6b, 0x6b, 0x2c, 0xcc, 0x7f, 0xf0, 0xb0, 0xdc, 0xf3, 0xf3, 0xc3,
+	0x72, 0xcf, 0x83, 0x57, 0x8f, 0x66, 0xa2, 0x11, 0x7f, 0xf8, 0xea, 0xd1, 0xcc, 0x44, 0x7b, 0x0a,
+	0x3c, 0x44, 0xa6, 0x36, 0x09, 0x13, 0x87, 0x36, 0x75, 0x1c, 0x34, 0x89, 0x17, 0x60, 0xed, 0x2b,
+	0x05, 0x4e, 0xe8, 0xd8, 0xb2, 0x03, 0x1a, 0x43, 0xd5, 0xf1, 0xff, 0xdf, 0x2d, 0x09, 0xf3, 0x90,
+	0x09, 0x07, 0xb6, 0xe4, 0xdb, 0x7c, 0x60, 0xf6, 0xd3, 0xb9, 0xfe, 0xc2, 0xd5, 0x58, 0x90, 0xd7,
+	0xe3, 0x41, 0x96, 0x22, 0xf5, 0x95, 0xe0, 0xb4, 0x76, 0x1a, 0x26, 0x13, 0x63, 0x91, 0xb1, 0x7e,
+	0xaf, 0x40, 0xbe, 0x2d, 0xe7, 0x4d, 0xe2, 0x9d, 0xa3, 0xbc, 0x14, 0x8b, 0xf2, 0xc8, 0xe7, 0x57,
+	0xc4, 0xa7, 0x96, 0x21, 0x4b, 0x7c, 0xd4, 0x70, 0xb0, 0x78, 0xb7, 0x45, 0x6d, 0x81, 0xd8, 0x62,
+	0x6a, 0x0b, 0x7f, 0x3b, 0x8a, 0x80, 0x42, 0x02, 0x01, 0x1c, 0x41, 0x3b, 0x09, 0x7f, 0x3a, 0x10,
+	0x9a, 0x0c, 0xfa, 0x53, 0x05, 0x46, 0x6e, 0x04, 0xd6, 0xed, 0xa6, 0x89, 0x28, 0xbe, 0xc5, 0x3f,
+	0x0c, 0xd4, 0x79, 0x18, 0x44, 0xdb, 0x74, 0x93, 0xf8, 0x36, 0xdd, 0x39, 0x36, 0xe0, 0x7d, 0x55,
+	0xf5, 0xef, 0xd0, 0x27, 0x3e, 0x2d, 0x64, 0xc0, 0x13, 0x09, 0x01, 0x0b, 0x08, 0x39, 0x6c, 0x48,
+	0xf5, 0x85, 0x61, 0x16, 0xcc, 0xbe, 0x21, 0x6d, 0x02, 0x4e, 0x1e, 0xf0, 0x29, 0xf4, 0xf7, 0xc7,
+	0x14, 0x4c, 0x86, 0xb2, 0xe8, 0xec, 0xb3, 0x66, 0xbb, 0x36, 0x7d, 0x7b, 0xdf, 0xcf, 0xc1, 0x80,
+	0x98, 0x9a, 0x6c, 0x53, 0xb6, 0x84, 0xec, 0xde, 0x6e, 0xb9, 0x5f, 0xe4, 0x67, 0x45, 0xef, 0xe7,
+	0xc2, 0x55, 0x33, 0x79, 0x80, 0x4c, 0xff, 0x31, 0x03, 0x64, 0xa6, 0xdb, 0x03, 0xe4, 0x21, 0xe2,
+	0xa7, 0xe0, 0xec, 0x11, 0xe4, 0x86, 0x49, 0xf8, 0x45, 0xe1, 0x09, 0xaa, 0x61, 0x7a, 0xb3, 0xb6,
+	0xbe, 0xb8, 0x4d, 0xc9, 0x4a, 0xf8, 0xf6, 0xbc, 0x6b, 0x6f, 0x1e, 0x89, 0x37, 0x4d, 0x91, 0x8c,
+	0xcc, 0xd2, 0xe8, 0xde, 0x6e, 0x39, 0x17, 0x6d, 0x09, 0x2b, 0x7a, 0x2e, 0xda, 0x44, 0x4d, 0xf6,
+	0x85, 0x82, 0x3d, 0x36, 0x55, 0x89, 0x2f, 0xbd, 0x01, 0xbd, 0xbd, 0x5c, 0xf8, 0x57, 0xf4, 0xca,
+	0xc4, 0x9c, 0x63, 0x77, 0xa6, 0x1c, 0xef, 0x8c, 0x87, 0x02, 0xd2, 0xfe, 0x0c, 0xe5, 0x0e, 0xa2,
+	0x36, 0x1f, 0x73, 0x4f, 0xfa, 0x20, 0x7d, 0x23, 0xb0, 0xd4, 0x8f, 0x15, 0x18, 0x8a, 0xdd, 0xa4,
+	0xa4, 0xc6, 0x76, 0xa0, 0xb2, 0x8b, 0x33, 0xc7, 0xeb, 0x84, 0xc4, 0xcf, 0x3e, 0xf8, 0xf6, 0xa7,
+	0xcf, 0x52, 0x7f, 0xd1, 0xa6, 0xaa, 0x49, 0x7f, 0x4b, 0xa8, 0x1e, 0xbc, 0xc8, 0x9f, 0x2b, 0x30,
+	0x96, 0xd0, 0xf1, 0xd4, 0xf3, 0x09, 0x90, 0xc9, 0x5d, 0xbe, 0x58, 0x79, 0x5d, 0x55, 0xe9, 0xe1,
+	0x65, 0xee, 0xe1, 0x05, 0xed, 0x7c, 0xb2, 0x87, 0x49, 0xde, 0x7c, 0xa4, 0x40, 0x2e, 0xd6, 0x9c,
+	0xd4, 0xb3, 0x47, 0x80, 0xb6, 0x3b, 0x73, 0x71, 0xfa, 0x78, 0x25, 0xe9, 0xd3, 0x05, 0xee, 0xd3,
+	0x94, 0x76, 0xf6, 0x68, 0x9f, 0x04, 0xf6, 0x13, 0x05, 0x0a, 0x1d, 0xbb, 0x4b, 0xe5, 0xa8, 0x5c,
+	0x1d, 0xd6, 0x2f, 0xce, 0xbf, 0x99, 0x7e, 0xe8, 0xf1, 0x3c, 0xf7, 0xf8, 0x92, 0x56, 0x49, 0xf6,
+	0xb8, 0xa3, 0x7f, 0x5f, 0x28, 0x30, 0x9e, 0x78, 0x2b, 0x3b, 0x14, 0x59, 0x92, 0x6e, 0x71, 0xee,
+	0xf5, 0x75, 0x43, 0x87, 0xe7, 0xb8, 0xc3, 0x17, 0xb5, 0x99, 0x64, 0x87, 0x13, 0x71, 0x7a, 0xdf,
+	0x7f, 0xf5, 0x68, 0x46, 0x59, 0xfa, 0xf7, 0xd3, 0xbd, 0x92, 0xf2, 0x6c, 0xaf, 0xa4, 0xbc, 0xd8,
+	0x2b, 0x29, 0x9f, 0xbc, 0x2c, 0xf5, 0x3c, 0x7b, 0x59, 0xea, 0xf9, 0xee, 0x65, 0xa9, 0xe7, 0xce,
+	0xa5, 0x48, 0x87, 0xbb, 0x26, 0xcc, 0xde, 0xc4, 0xf4, 0x1e, 0xf1, 0xb7, 0x42, 0x94, 0x56, 0x1b,
+	0x87, 0xf7, 0xbb, 0x7a, 0x1f, 0xff, 0xfb, 0xd4, 0x5f, 0x7f, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xd8,
+	0x1b, 0x5b, 0x5e, 0x6b, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -930,6 +1145,21 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// UpdateParams updates the parameters of the assets module.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// // SetStakerExoCoreAddr sets the exocore address of the staker
+	//
+	//	rpc SetStakerExoCoreAddr(MsgSetExoCoreAddr) returns (MsgSetExoCoreAddrResponse) {
+	//	  option (google.api.http).post = "/exocore/assets/v1/tx/SetStakerExoCoreAddr";
+	//	}
+	//
+	// RegisterClientChain registers or updates the client chain, it's gated by the authority.
+	RegisterClientChain(ctx context.Context, in *RegisterClientChainReq, opts ...grpc.CallOption) (*RegisterClientChainResponse, error)
+	// RegisterAsset registers the asset on the client chain, it's gated by the authority.
+	RegisterAsset(ctx context.Context, in *RegisterAssetReq, opts ...grpc.CallOption) (*RegisterAssetResponse, error)
+	// UpdateStakingAssetLimits updates the staking limits of the asset, it's gated by the authority.
+	UpdateStakingAssetLimits(ctx context.Context, in *MsgUpdateStakingAssetLimits, opts ...grpc.CallOption) (*MsgUpdateStakingAssetLimitsResponse, error)
+	// SetNSTAutoDelegation enables or disables the auto-delegation of the native token balance
+	// increases of the sender.
+	SetNSTAutoDelegation(ctx context.Context, in *MsgSetNSTAutoDelegation, opts ...grpc.CallOption) (*MsgSetNSTAutoDelegationResponse, error)
 }
 
 type msgClient struct {
@@ -949,10 +1179,61 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RegisterClientChain(ctx context.Context, in *RegisterClientChainReq, opts ...grpc.CallOption) (*RegisterClientChainResponse, error) {
+	out := new(RegisterClientChainResponse)
+	err := c.cc.Invoke(ctx, "/exocore.assets.v1.Msg/RegisterClientChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterAsset(ctx context.Context, in *RegisterAssetReq, opts ...grpc.CallOption) (*RegisterAssetResponse, error) {
+	out := new(RegisterAssetResponse)
+	err := c.cc.Invoke(ctx, "/exocore.assets.v1.Msg/RegisterAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateStakingAssetLimits(ctx context.Context, in *MsgUpdateStakingAssetLimits, opts ...grpc.CallOption) (*MsgUpdateStakingAssetLimitsResponse, error) {
+	out := new(MsgUpdateStakingAssetLimitsResponse)
+	err := c.cc.Invoke(ctx, "/exocore.assets.v1.Msg/UpdateStakingAssetLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetNSTAutoDelegation(ctx context.Context, in *MsgSetNSTAutoDelegation, opts ...grpc.CallOption) (*MsgSetNSTAutoDelegationResponse, error) {
+	out := new(MsgSetNSTAutoDelegationResponse)
+	err := c.cc.Invoke(ctx, "/exocore.assets.v1.Msg/SetNSTAutoDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams updates the parameters of the assets module.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// // SetStakerExoCoreAddr sets the exocore address of the staker
+	//
+	//	rpc SetStakerExoCoreAddr(MsgSetExoCoreAddr) returns (MsgSetExoCoreAddrResponse) {
+	//	  option (google.api.http).post = "/exocore/assets/v1/tx/SetStakerExoCoreAddr";
+	//	}
+	//
+	// RegisterClientChain registers or updates the client chain, it's gated by the authority.
+	RegisterClientChain(context.Context, *RegisterClientChainReq) (*RegisterClientChainResponse, error)
+	// RegisterAsset registers the asset on the client chain, it's gated by the authority.
+	RegisterAsset(context.Context, *RegisterAssetReq) (*RegisterAssetResponse, error)
+	// UpdateStakingAssetLimits updates the staking limits of the asset, it's gated by the authority.
+	UpdateStakingAssetLimits(context.Context, *MsgUpdateStakingAssetLimits) (*MsgUpdateStakingAssetLimitsResponse, error)
+	// SetNSTAutoDelegation enables or disables the auto-delegation of the native token balance
+	// increases of the sender.
+	SetNSTAutoDelegation(context.Context, *MsgSetNSTAutoDelegation) (*MsgSetNSTAutoDelegationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -962,6 +1243,18 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) RegisterClientChain(ctx context.Context, req *RegisterClientChainReq) (*RegisterClientChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterClientChain not implemented")
+}
+func (*UnimplementedMsgServer) RegisterAsset(ctx context.Context, req *RegisterAssetReq) (*RegisterAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAsset not implemented")
+}
+func (*UnimplementedMsgServer) UpdateStakingAssetLimits(ctx context.Context, req *MsgUpdateStakingAssetLimits) (*MsgUpdateStakingAssetLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStakingAssetLimits not implemented")
+}
+func (*UnimplementedMsgServer) SetNSTAutoDelegation(ctx context.Context, req *MsgSetNSTAutoDelegation) (*MsgSetNSTAutoDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNSTAutoDelegation not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -985,6 +1278,78 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterClientChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterClientChainReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterClientChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.assets.v1.Msg/RegisterClientChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterClientChain(ctx, req.(*RegisterClientChainReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterAssetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.assets.v1.Msg/RegisterAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterAsset(ctx, req.(*RegisterAssetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateStakingAssetLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateStakingAssetLimits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateStakingAssetLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.assets.v1.Msg/UpdateStakingAssetLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateStakingAssetLimits(ctx, req.(*MsgUpdateStakingAssetLimits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetNSTAutoDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetNSTAutoDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetNSTAutoDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.assets.v1.Msg/SetNSTAutoDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetNSTAutoDelegation(ctx, req.(*MsgSetNSTAutoDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "exocore.assets.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -993,6 +1358,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RegisterClientChain",
+			Handler:    _Msg_RegisterClientChain_Handler,
+		},
+		{
+			MethodName: "RegisterAsset",
+			Handler:    _Msg_RegisterAsset_Handler,
+		},
+		{
+			MethodName: "UpdateStakingAssetLimits",
+			Handler:    _Msg_UpdateStakingAssetLimits_Handler,
+		},
+		{
+			MethodName: "SetNSTAutoDelegation",
+			Handler:    _Msg_SetNSTAutoDelegation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "exocore/assets/v1/tx.proto",
@@ -1120,6 +1501,30 @@ func (m *AssetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPerStaker != nil {
+		{
+			size := m.MaxPerStaker.Size()
+			i -= size
+			if _, err := m.MaxPerStaker.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MaxTotalStaking != nil {
+		{
+			size := m.MaxTotalStaking.Size()
+			i -= size
+			if _, err := m.MaxTotalStaking.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.MetaInfo) > 0 {
 		i -= len(m.MetaInfo)
 		copy(dAtA[i:], m.MetaInfo)
@@ -1538,6 +1943,13 @@ func (m *RegisterAssetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OracleInfo) > 0 {
+		i -= len(m.OracleInfo)
+		copy(dAtA[i:], m.OracleInfo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OracleInfo)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Info != nil {
 		{
 			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
@@ -1646,47 +2058,199 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateStakingAssetLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ValueField) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+
+func (m *MsgUpdateStakingAssetLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClientChainInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateStakingAssetLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.MetaInfo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
-	}
-	if m.ExocoreChainIndex != 0 {
-		n += 1 + sovTx(uint64(m.ExocoreChainIndex))
+	if m.MaxPerStaker != nil {
+		{
+			size := m.MaxPerStaker.Size()
+			i -= size
+			if _, err := m.MaxPerStaker.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxTotalStaking != nil {
+		{
+			size := m.MaxTotalStaking.Size()
+			i -= size
+			if _, err := m.MaxTotalStaking.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateStakingAssetLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateStakingAssetLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateStakingAssetLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetNSTAutoDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetNSTAutoDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetNSTAutoDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ClientChainID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ClientChainID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetNSTAutoDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetNSTAutoDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetNSTAutoDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValueField) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *ClientChainInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MetaInfo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	if m.ExocoreChainIndex != 0 {
+		n += 1 + sovTx(uint64(m.ExocoreChainIndex))
 	}
 	if m.FinalizationBlocks != 0 {
 		n += 1 + sovTx(uint64(m.FinalizationBlocks))
@@ -1735,6 +2299,14 @@ func (m *AssetInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.MaxTotalStaking != nil {
+		l = m.MaxTotalStaking.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxPerStaker != nil {
+		l = m.MaxPerStaker.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1882,6 +2454,10 @@ func (m *RegisterAssetReq) Size() (n int) {
 		l = m.Info.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.OracleInfo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1918,6 +2494,68 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateStakingAssetLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxTotalStaking != nil {
+		l = m.MaxTotalStaking.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxPerStaker != nil {
+		l = m.MaxPerStaker.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateStakingAssetLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetNSTAutoDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ClientChainID != 0 {
+		n += 1 + sovTx(uint64(m.ClientChainID))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetNSTAutoDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2463,61 +3101,11 @@ func (m *AssetInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.MetaInfo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StakingAssetInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StakingAssetInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StakingAssetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetBasicInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalStaking", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2527,15 +3115,137 @@ func (m *StakingAssetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MaxTotalStaking = &v
+			if err := m.MaxTotalStaking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerStaker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MaxPerStaker = &v
+			if err := m.MaxPerStaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakingAssetInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakingAssetInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakingAssetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetBasicInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
@@ -3630,6 +4340,38 @@ func (m *RegisterAssetReq) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleInfo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleInfo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3866,6 +4608,413 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateStakingAssetLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateStakingAssetLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateStakingAssetLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalStaking", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MaxTotalStaking = &v
+			if err := m.MaxTotalStaking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerStaker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MaxPerStaker = &v
+			if err := m.MaxPerStaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateStakingAssetLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateStakingAssetLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateStakingAssetLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetNSTAutoDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetNSTAutoDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetNSTAutoDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientChainID", wireType)
+			}
+			m.ClientChainID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientChainID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetNSTAutoDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetNSTAutoDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetNSTAutoDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
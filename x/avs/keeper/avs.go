@@ -3,12 +3,14 @@ package keeper
 import (
 	"fmt"
 	"math/big"
+	"slices"
 	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
 	"github.com/ExocoreNetwork/exocore/x/avs/types"
+	epochstypes "github.com/ExocoreNetwork/exocore/x/epochs/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v16/x/evm/statedb"
 
@@ -50,6 +52,51 @@ func (k *Keeper) GetAVSSlashContract(ctx sdk.Context, avsAddr string) (string, e
 	return avsInfo.Info.SlashAddr, nil
 }
 
+// GetAVSEpochInfo returns the current epoch info of the epoch identifier used by the AVS.
+// The avsAddr supplied must be hex.
+func (k *Keeper) GetAVSEpochInfo(ctx sdk.Context, avsAddr string) (epochstypes.EpochInfo, error) {
+	avsInfo, err := k.GetAVSInfo(ctx, avsAddr)
+	if err != nil {
+		return epochstypes.EpochInfo{}, errorsmod.Wrap(err, fmt.Sprintf("GetAVSEpochInfo: key is %s", avsAddr))
+	}
+	epoch, found := k.epochsKeeper.GetEpochInfo(ctx, avsInfo.Info.EpochIdentifier)
+	if !found {
+		return epochstypes.EpochInfo{}, errorsmod.Wrap(types.ErrEpochNotFound, fmt.Sprintf("epoch info not found %s", avsInfo.Info.EpochIdentifier))
+	}
+	return epoch, nil
+}
+
+// IsAVSVetoCommitteeMember returns true if the bech32 address is a member of the veto committee
+// designated by the AVS. An owner of the AVS isn't regarded as a member, even if it has been
+// added to the committee before becoming an owner. The avsAddr supplied must be hex.
+func (k *Keeper) IsAVSVetoCommitteeMember(ctx sdk.Context, avsAddr, addr string) (bool, error) {
+	avsInfo, err := k.GetAVSInfo(ctx, avsAddr)
+	if err != nil {
+		return false, errorsmod.Wrap(err, fmt.Sprintf("IsAVSVetoCommitteeMember: key is %s", avsAddr))
+	}
+	return slices.Contains(avsInfo.Info.VetoCommittee, addr) &&
+		!slices.Contains(avsInfo.Info.AvsOwnerAddress, addr), nil
+}
+
+// SetAVSVetoCommittee replaces the veto committee of the AVS. The caller must be one of the
+// owners of the AVS, and the owners can't be members of the committee, so that the party
+// submitting the slashes isn't the one vetoing them. The avsAddr supplied must be hex.
+func (k *Keeper) SetAVSVetoCommittee(ctx sdk.Context, avsAddr, caller string, committee []string) error {
+	avsInfo, err := k.GetAVSInfo(ctx, avsAddr)
+	if err != nil {
+		return errorsmod.Wrap(err, fmt.Sprintf("SetAVSVetoCommittee: key is %s", avsAddr))
+	}
+	avs := avsInfo.Info
+	if !slices.Contains(avs.AvsOwnerAddress, caller) {
+		return errorsmod.Wrap(types.ErrCallerAddressUnauthorized, fmt.Sprintf("this caller not qualified to set the veto committee %s", caller))
+	}
+	if err := types.ValidateVetoCommittee(committee, avs.AvsOwnerAddress); err != nil {
+		return err
+	}
+	avs.VetoCommittee = committee
+	return k.SetAVSInfo(ctx, avs)
+}
+
 // GetAVSMinimumSelfDelegation returns the minimum self-delegation required for the AVS, on a per-operator basis.
 // The avsAddr supplied must be hex.
 func (k *Keeper) GetAVSMinimumSelfDelegation(ctx sdk.Context, avsAddr string) (sdkmath.LegacyDec, error) {
@@ -61,6 +108,26 @@ func (k *Keeper) GetAVSMinimumSelfDelegation(ctx sdk.Context, avsAddr string) (s
 	return sdkmath.LegacyNewDec(int64(avsInfo.Info.MinSelfDelegation)), nil
 }
 
+// GetAVSUnbondingPeriod returns the unbonding period of the AVS, which is counted in the
+// epochs of the AVS.
+func (k *Keeper) GetAVSUnbondingPeriod(ctx sdk.Context, avsAddr string) (uint64, error) {
+	avsInfo, err := k.GetAVSInfo(ctx, avsAddr)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, fmt.Sprintf("GetAVSUnbondingPeriod: key is %s", avsAddr))
+	}
+	return avsInfo.Info.AvsUnbondingPeriod, nil
+}
+
+// GetAVSMaxTaskChallengePeriod returns the longest period from the statistics of a task of the
+// AVS to its settlement, counted in the AVS epochs.
+func (k *Keeper) GetAVSMaxTaskChallengePeriod(ctx sdk.Context, avsAddr string) (uint64, error) {
+	avsInfo, err := k.GetAVSInfo(ctx, avsAddr)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, fmt.Sprintf("GetAVSMaxTaskChallengePeriod: key is %s", avsAddr))
+	}
+	return avsInfo.Info.MaxTaskChallengePeriod, nil
+}
+
 // GetEpochEndAVSs returns a list of hex AVS addresses for AVSs which are scheduled to start at the end of the
 // current epoch, or the beginning of the next one. The address format returned is hex.
 func (k *Keeper) GetEpochEndAVSs(ctx sdk.Context, epochIdentifier string, endingEpochNumber int64) []string {
@@ -187,3 +254,27 @@ func (k Keeper) GetChainIDByAVSAddr(ctx sdk.Context, avsAddr string) (string, bo
 	}
 	return string(bz), true
 }
+
+// SetAllChainIDInfos stores the reverse lookups from the AVS address to the chainID.
+func (k Keeper) SetAllChainIDInfos(ctx sdk.Context, infos []types.ChainIDInfo) error {
+	for _, info := range infos {
+		k.SetAVSAddrToChainID(ctx, common.HexToAddress(info.AvsAddress), info.ChainID)
+	}
+	return nil
+}
+
+// GetAllChainIDInfos returns all the reverse lookups from the AVS address to the chainID.
+func (k Keeper) GetAllChainIDInfos(ctx sdk.Context) ([]types.ChainIDInfo, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAVSAddressToChainID)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	ret := make([]types.ChainIDInfo, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		ret = append(ret, types.ChainIDInfo{
+			AvsAddress: common.BytesToAddress(iterator.Key()).String(),
+			ChainID:    string(iterator.Value()),
+		})
+	}
+	return ret, nil
+}
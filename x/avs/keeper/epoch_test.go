@@ -3,7 +3,10 @@ package keeper_test
 import (
 	"strconv"
 
+	sdkmath "cosmossdk.io/math"
 	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	epochstypes "github.com/ExocoreNetwork/exocore/x/epochs/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -38,11 +41,210 @@ func (suite *AVSTestSuite) TestEpochEnd_TaskCalculation() {
 		OptInOperators:        suite.operatorAddresses,
 		NoSignedOperators:     nil,
 		SignedOperators:       suite.operatorAddresses,
-		ActualThreshold:       7766279631452241920,
+		ActualThreshold:       100,
+		// the challenge period ends with the last epoch as well
+		Status: avstypes.TaskStatusSettled,
 	}
 	diff := avstypes.Difference(expectInfo.SignedOperators, info.SignedOperators)
 
 	suite.Equal(0, len(diff))
 	suite.Equal(expectInfo.NoSignedOperators, info.NoSignedOperators)
 	suite.Equal(expectInfo.ActualThreshold, info.ActualThreshold)
+	suite.Equal(expectInfo.Status, info.Status)
+}
+
+func (suite *AVSTestSuite) TestTaskStatisticsRetry() {
+	// the task isn't bound to any AVS, so its statistics always fail
+	taskAddr := common.HexToAddress("0x3e108c058e8066da635321dc3018294ca82ddedf").String()
+	err := suite.App.AVSManagerKeeper.SetTaskInfo(suite.Ctx, &avstypes.TaskInfo{
+		TaskContractAddress: taskAddr,
+		Name:                "test-retry",
+		TaskId:              1,
+		TaskTotalPower:      sdk.ZeroDec(),
+	})
+	suite.NoError(err)
+	suite.App.AVSManagerKeeper.SetAllTaskStatisticsRetries(suite.Ctx, []avstypes.TaskStatisticsRetry{
+		{TaskContractAddress: taskAddr, TaskID: 1},
+	})
+
+	// the failed retries are counted until the limit is reached
+	for i := uint32(1); i < avstypes.MaxTaskStatisticsRetries; i++ {
+		suite.App.AVSManagerKeeper.RetryFailedTasks(suite.Ctx, epochstypes.DayEpochID)
+		retry, found := suite.App.AVSManagerKeeper.GetTaskStatisticsRetry(suite.Ctx, taskAddr, 1)
+		suite.True(found)
+		suite.Equal(i, retry.RetryCount)
+		suite.NotEmpty(retry.LastError)
+	}
+	suite.App.AVSManagerKeeper.RetryFailedTasks(suite.Ctx, epochstypes.DayEpochID)
+	_, found := suite.App.AVSManagerKeeper.GetTaskStatisticsRetry(suite.Ctx, taskAddr, 1)
+	suite.False(found)
+	info, err := suite.App.AVSManagerKeeper.GetTaskInfo(suite.Ctx, "1", taskAddr)
+	suite.NoError(err)
+	suite.Equal(avstypes.TaskStatusFailed, info.Status)
+}
+
+func (suite *AVSTestSuite) TestTaskSettlementRetry() {
+	// the finalized task isn't bound to any AVS, so its settlement always fails
+	taskAddr := common.HexToAddress("0x3e108c058e8066da635321dc3018294ca82ddedf").String()
+	err := suite.App.AVSManagerKeeper.SetTaskInfo(suite.Ctx, &avstypes.TaskInfo{
+		TaskContractAddress: taskAddr,
+		Name:                "test-retry",
+		TaskId:              1,
+		TaskTotalPower:      sdk.ZeroDec(),
+		Status:              avstypes.TaskStatusFinalized,
+	})
+	suite.NoError(err)
+	suite.App.AVSManagerKeeper.SetAllTaskStatisticsRetries(suite.Ctx, []avstypes.TaskStatisticsRetry{
+		{TaskContractAddress: taskAddr, TaskID: 1},
+	})
+
+	// the finalized task is retried with the settlement rather than the statistics
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	suite.App.AVSManagerKeeper.RetryFailedTasks(suite.Ctx, epochstypes.DayEpochID)
+	retry, found := suite.App.AVSManagerKeeper.GetTaskStatisticsRetry(suite.Ctx, taskAddr, 1)
+	suite.True(found)
+	suite.Equal(uint32(1), retry.RetryCount)
+	suite.Len(suite.eventsOfType(avstypes.EventTypeTaskSettlementRetry), 1)
+	suite.Empty(suite.eventsOfType(avstypes.EventTypeTaskStatisticsRetry))
+
+	for i := uint32(2); i <= avstypes.MaxTaskStatisticsRetries; i++ {
+		suite.App.AVSManagerKeeper.RetryFailedTasks(suite.Ctx, epochstypes.DayEpochID)
+	}
+	_, found = suite.App.AVSManagerKeeper.GetTaskStatisticsRetry(suite.Ctx, taskAddr, 1)
+	suite.False(found)
+	info, err := suite.App.AVSManagerKeeper.GetTaskInfo(suite.Ctx, "1", taskAddr)
+	suite.NoError(err)
+	suite.Equal(avstypes.TaskStatusFailed, info.Status)
+	suite.Len(suite.eventsOfType(avstypes.EventTypeTaskSettlementFailed), 1)
+}
+
+func (suite *AVSTestSuite) TestTaskSettlementWithFailedSlash() {
+	suite.TestSubmitTask_OnlyPhaseTwo_Mul()
+	suite.CommitAfter(suite.EpochDuration)
+	suite.CommitAfter(suite.EpochDuration)
+	taskAddr := common.Address(suite.taskAddress.Bytes()).String()
+	info, err := suite.App.AVSManagerKeeper.GetTaskInfo(suite.Ctx, strconv.FormatUint(suite.taskId, 10), taskAddr)
+	suite.NoError(err)
+	suite.Equal(avstypes.TaskStatusFinalized, info.Status)
+	// the operator can't be slashed, since its address is invalid
+	info.NoSignedOperators = []string{"invalid-operator"}
+	err = suite.App.AVSManagerKeeper.SetTaskInfo(suite.Ctx, info)
+	suite.NoError(err)
+
+	// the failed slash is recorded without failing the settlement
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	err = suite.App.AVSManagerKeeper.SettleTask(suite.Ctx, taskAddr, suite.taskId)
+	suite.NoError(err)
+	info, err = suite.App.AVSManagerKeeper.GetTaskInfo(suite.Ctx, strconv.FormatUint(suite.taskId, 10), taskAddr)
+	suite.NoError(err)
+	suite.Equal(avstypes.TaskStatusSettled, info.Status)
+	events := suite.eventsOfType(avstypes.EventTypeTaskSlashFailed)
+	suite.Len(events, 1)
+	suite.Equal("invalid-operator", events[0].Attributes[2].Value)
+}
+
+// eventsOfType returns the events of the type emitted to the current context.
+func (suite *AVSTestSuite) eventsOfType(eventType string) []sdk.Event {
+	var ret []sdk.Event
+	for _, event := range suite.Ctx.EventManager().Events() {
+		if event.Type == eventType {
+			ret = append(ret, event)
+		}
+	}
+	return ret
+}
+
+func (suite *AVSTestSuite) TestEpochEnd_TaskSettlement() {
+	suite.TestSubmitTask_OnlyPhaseTwo_Mul()
+	// reward the task with the USDT
+	avsInfo, err := suite.App.AVSManagerKeeper.GetAVSInfo(suite.Ctx, suite.avsAddr)
+	suite.NoError(err)
+	avsInfo.Info.AssetRewardAmountEpochBasis = map[string]int64{suite.assetID: 1000}
+	avsInfo.Info.AssetRewardAmountTaskBasis = map[string]int64{suite.assetID: 100}
+	avsInfo.Info.AvsOwnerAddress = []string{sdk.AccAddress(suite.Address.Bytes()).String()}
+	err = suite.App.AVSManagerKeeper.SetAVSInfo(suite.Ctx, avsInfo.Info)
+	suite.NoError(err)
+	// the rewards are paid from the fund deposited by the owner
+	fundAmount := sdkmath.NewInt(1000000)
+	suite.prepareMulDeposit(suite.assetAddr, fundAmount)
+	err = suite.App.RewardKeeper.FundAVSReward(suite.Ctx, sdk.AccAddress(suite.Address.Bytes()), suite.avsAddr, suite.assetID, fundAmount)
+	suite.NoError(err)
+	// the rewards are accumulated in the reward pools until they're settled to the staker
+	claimable := func() sdkmath.Int {
+		return suite.App.RewardKeeper.GetStakerReward(suite.Ctx, suite.stakerID, suite.assetID).Add(
+			suite.App.RewardKeeper.GetPendingStakerReward(suite.Ctx, suite.stakerID, suite.assetID),
+		)
+	}
+
+	// the task is finalized at the end of the statistical period
+	suite.CommitAfter(suite.EpochDuration)
+	suite.CommitAfter(suite.EpochDuration)
+	taskAddr := common.Address(suite.taskAddress.Bytes()).String()
+	info, err := suite.App.AVSManagerKeeper.GetTaskInfo(suite.Ctx, strconv.FormatUint(suite.taskId, 10), taskAddr)
+	suite.NoError(err)
+	suite.Equal(avstypes.TaskStatusFinalized, info.Status)
+	statisticsHeight := info.StatisticsHeight
+	suite.True(statisticsHeight > 0)
+	// the finalized task is indexed by the end of its challenge period
+	challengeEndEpoch := int64(info.StartingEpoch + info.TaskResponsePeriod + info.TaskStatisticalPeriod + info.TaskChallengePeriod)
+	suite.Empty(suite.App.AVSManagerKeeper.GetTaskChallengeEpochEndTasks(suite.Ctx, epochstypes.HourEpochID, challengeEndEpoch-1))
+	suite.Len(suite.App.AVSManagerKeeper.GetTaskChallengeEpochEndTasks(suite.Ctx, epochstypes.HourEpochID, challengeEndEpoch), 1)
+	// the epoch reward is credited by the reward module as well
+	rewardBeforeSettlement := claimable()
+
+	// the task is settled at the end of the challenge period
+	suite.CommitAfter(suite.EpochDuration)
+	info, err = suite.App.AVSManagerKeeper.GetTaskInfo(suite.Ctx, strconv.FormatUint(suite.taskId, 10), taskAddr)
+	suite.NoError(err)
+	suite.Equal(avstypes.TaskStatusSettled, info.Status)
+	suite.Empty(info.ErrSignedOperators)
+	suite.Equal(statisticsHeight, info.StatisticsHeight)
+	suite.Empty(suite.App.AVSManagerKeeper.GetTaskChallengeEpochEndTasks(suite.Ctx, epochstypes.HourEpochID, challengeEndEpoch))
+
+	// all the operators signed, so the staker delegating to them shares the whole task reward,
+	// which is paid apart from the epoch reward.
+	epochReward := sdkmath.NewInt(1000)
+	taskReward := sdkmath.NewInt(100)
+	reward := claimable()
+	settledReward := reward.Sub(rewardBeforeSettlement).Sub(epochReward)
+	suite.True(settledReward.IsPositive())
+	suite.True(settledReward.LTE(taskReward))
+
+	// the settled task isn't settled again
+	suite.App.AVSManagerKeeper.SettleTasks(suite.Ctx, epochstypes.HourEpochID, int64(info.StartingEpoch+10))
+	suite.Equal(reward, claimable())
+}
+
+func (suite *AVSTestSuite) TestEpochEnd_TaskSettlementWithoutFund() {
+	suite.TestSubmitTask_OnlyPhaseTwo_Mul()
+	// the task reward is configured, but the AVS doesn't fund it
+	avsInfo, err := suite.App.AVSManagerKeeper.GetAVSInfo(suite.Ctx, suite.avsAddr)
+	suite.NoError(err)
+	avsInfo.Info.AssetRewardAmountTaskBasis = map[string]int64{suite.assetID: 1000}
+	err = suite.App.AVSManagerKeeper.SetAVSInfo(suite.Ctx, avsInfo.Info)
+	suite.NoError(err)
+
+	// the task is still settled, without any reward paid out of the unfunded AVS
+	suite.CommitAfter(suite.EpochDuration)
+	suite.CommitAfter(suite.EpochDuration)
+	suite.CommitAfter(suite.EpochDuration)
+	taskAddr := common.Address(suite.taskAddress.Bytes()).String()
+	info, err := suite.App.AVSManagerKeeper.GetTaskInfo(suite.Ctx, strconv.FormatUint(suite.taskId, 10), taskAddr)
+	suite.NoError(err)
+	suite.Equal(avstypes.TaskStatusSettled, info.Status)
+	suite.True(suite.App.RewardKeeper.GetStakerReward(suite.Ctx, suite.stakerID, suite.assetID).IsZero())
+	suite.True(suite.App.RewardKeeper.GetPendingStakerReward(suite.Ctx, suite.stakerID, suite.assetID).IsZero())
+}
+
+func (suite *AVSTestSuite) TestSettleTask_NotFinalized() {
+	taskAddr := common.HexToAddress("0x3e108c058e8066da635321dc3018294ca82ddedf").String()
+	err := suite.App.AVSManagerKeeper.SetTaskInfo(suite.Ctx, &avstypes.TaskInfo{
+		TaskContractAddress: taskAddr,
+		Name:                "test-settle",
+		TaskId:              1,
+		TaskTotalPower:      sdk.ZeroDec(),
+	})
+	suite.NoError(err)
+	err = suite.App.AVSManagerKeeper.SettleTask(suite.Ctx, taskAddr, 1)
+	suite.ErrorIs(err, avstypes.ErrParamError)
 }
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	"github.com/ExocoreNetwork/exocore/x/avs/types"
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,16 +11,86 @@ import (
 // Since this action typically occurs on chain starts, this function is allowed to panic.
 func (k Keeper) InitGenesis(
 	ctx sdk.Context,
-	_ types.GenesisState,
+	state types.GenesisState,
 ) []abci.ValidatorUpdate {
 	// Store a lookup from codeHash to code. Since these are static parameters,
 	// such a lookup is stored at genesis and never updated.
 	k.evmKeeper.SetCode(ctx, types.ChainIDCodeHash.Bytes(), types.ChainIDCode)
+	if err := k.SetParams(ctx, &state.Params); err != nil {
+		panic(errorsmod.Wrap(err, "failed to set avs params"))
+	}
+	if err := k.SetAllAVSInfos(ctx, state.AVSInfos); err != nil {
+		panic(errorsmod.Wrap(err, "failed to set all avs info"))
+	}
+	if err := k.SetAllTaskInfos(ctx, state.TaskInfos); err != nil {
+		panic(errorsmod.Wrap(err, "failed to set all task info"))
+	}
+	if err := k.SetAllBlsPubKeys(ctx, state.BlsPubKeys); err != nil {
+		panic(errorsmod.Wrap(err, "failed to set all bls public keys"))
+	}
+	if err := k.SetAllTaskResultInfos(ctx, state.TaskResultInfos); err != nil {
+		panic(errorsmod.Wrap(err, "failed to set all task result info"))
+	}
+	if err := k.SetAllChallengeInfos(ctx, state.ChallengeInfos); err != nil {
+		panic(errorsmod.Wrap(err, "failed to set all challenge info"))
+	}
+	if err := k.SetAllTaskNums(ctx, state.TaskNums); err != nil {
+		panic(errorsmod.Wrap(err, "failed to set all task nums"))
+	}
+	if err := k.SetAllChainIDInfos(ctx, state.ChainIDInfos); err != nil {
+		panic(errorsmod.Wrap(err, "failed to set all chainID info"))
+	}
+	k.SetAllTaskStatisticsRetries(ctx, state.TaskStatisticsRetries)
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis returns the module's exported genesis
-func (Keeper) ExportGenesis(sdk.Context) *types.GenesisState {
-	// TODO
-	return types.DefaultGenesis()
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	res := types.GenesisState{}
+	// the params are only stored once set, so fall back to the default ones.
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		res.Params = types.DefaultParams()
+	} else {
+		res.Params = *params
+	}
+
+	res.AVSInfos, err = k.GetAllAVSInfos(ctx)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "failed to get all avs info").Error())
+	}
+
+	res.TaskInfos, err = k.GetAllTaskInfos(ctx)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "failed to get all task info").Error())
+	}
+
+	res.BlsPubKeys, err = k.GetAllBlsPubKeys(ctx)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "failed to get all bls public keys").Error())
+	}
+
+	res.TaskResultInfos, err = k.GetAllTaskResultInfos(ctx)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "failed to get all task result info").Error())
+	}
+
+	res.ChallengeInfos, err = k.GetAllChallengeInfos(ctx)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "failed to get all challenge info").Error())
+	}
+
+	res.TaskNums, err = k.GetAllTaskNums(ctx)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "failed to get all task nums").Error())
+	}
+
+	res.ChainIDInfos, err = k.GetAllChainIDInfos(ctx)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "failed to get all chainID info").Error())
+	}
+
+	res.TaskStatisticsRetries = k.GetAllTaskStatisticsRetries(ctx)
+
+	return &res
 }
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"strconv"
+
+	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
+	"github.com/ExocoreNetwork/exocore/x/avs/types"
+	epochstypes "github.com/ExocoreNetwork/exocore/x/epochs/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
+)
+
+func (suite *AVSTestSuite) TestGenesisExportImport() {
+	operator := suite.operatorAddresses[0]
+	challenger := suite.operatorAddresses[1]
+	taskAddr := suite.taskAddress.String()
+	chainID := "appchain_1"
+
+	genesis := suite.App.AVSManagerKeeper.ExportGenesis(suite.Ctx)
+	// the dogfood AVS is registered at genesis
+	suite.NotEmpty(genesis.AVSInfos)
+	suite.NoError(genesis.Validate())
+
+	genesis.AVSInfos = append(genesis.AVSInfos, types.AVSInfo{
+		Name:            "avsTest",
+		AvsAddress:      suite.avsAddress.String(),
+		TaskAddr:        taskAddr,
+		SlashAddr:       utiltx.GenerateAddress().String(),
+		AvsOwnerAddress: []string{operator},
+		AssetIDs:        suite.AssetIDs,
+		EpochIdentifier: epochstypes.DayEpochID,
+		StartingEpoch:   1,
+		AvsSlash:        sdk.MustNewDecFromStr("0.01"),
+		AvsReward:       sdk.MustNewDecFromStr("0.02"),
+	})
+	genesis.TaskInfos = append(genesis.TaskInfos, types.TaskInfo{
+		TaskContractAddress: taskAddr,
+		Name:                "task",
+		Hash:                []byte("hash"),
+		TaskId:              3,
+		TaskResponsePeriod:  2,
+		OptInOperators:      []string{operator},
+		SignedOperators:     []string{operator},
+		TaskTotalPower:      sdk.MustNewDecFromStr("100"),
+	})
+	genesis.BlsPubKeys = append(genesis.BlsPubKeys, types.BlsPubKeyInfo{
+		Operator: operator,
+		Name:     "blsKey",
+		PubKey:   []byte("pubKey"),
+	})
+	genesis.TaskResultInfos = append(genesis.TaskResultInfos, types.TaskResultInfo{
+		OperatorAddress:     operator,
+		TaskResponseHash:    "0x01",
+		TaskResponse:        []byte("response"),
+		BlsSignature:        []byte("signature"),
+		TaskContractAddress: taskAddr,
+		TaskId:              3,
+		Stage:               types.TwoPhaseCommitTwo,
+	})
+	genesis.ChallengeInfos = append(genesis.ChallengeInfos, types.ChallengeInfo{
+		Key:           string(assetstypes.GetJoinedStoreKey(operator, taskAddr, strconv.FormatUint(3, 10))),
+		ChallengeAddr: challenger,
+	})
+	genesis.TaskNums = append(genesis.TaskNums, types.TaskID{TaskAddr: taskAddr, TaskID: 3})
+	genesis.ChainIDInfos = append(genesis.ChainIDInfos, types.ChainIDInfo{
+		AvsAddress: common.HexToAddress(types.GenerateAVSAddr(chainID)).String(),
+		ChainID:    chainID,
+	})
+	suite.NoError(genesis.Validate())
+
+	suite.App.AVSManagerKeeper.InitGenesis(suite.Ctx, *genesis)
+
+	// export the whole app and start a new one from it. the dogfood AVS is part of the
+	// exported avs state, so the dogfood module must not register it again.
+	app, ctx := suite.ExportAndImport()
+	exported := app.AVSManagerKeeper.ExportGenesis(ctx)
+	suite.NoError(exported.Validate())
+
+	suite.Equal(genesis.Params, exported.Params)
+	suite.ElementsMatch(genesis.AVSInfos, exported.AVSInfos)
+	suite.ElementsMatch(genesis.TaskInfos, exported.TaskInfos)
+	suite.ElementsMatch(genesis.BlsPubKeys, exported.BlsPubKeys)
+	suite.ElementsMatch(genesis.TaskResultInfos, exported.TaskResultInfos)
+	suite.ElementsMatch(genesis.ChallengeInfos, exported.ChallengeInfos)
+	suite.ElementsMatch(genesis.TaskNums, exported.TaskNums)
+	suite.ElementsMatch(genesis.ChainIDInfos, exported.ChainIDInfos)
+
+	// the imported state is usable by the new app
+	challengeAddr, err := app.AVSManagerKeeper.GetTaskChallengedInfo(ctx, operator, taskAddr, 3)
+	suite.NoError(err)
+	challengerAcc, _ := sdk.AccAddressFromBech32(challenger)
+	suite.Equal(common.Bytes2Hex(challengerAcc), challengeAddr)
+	suite.Equal(uint64(4), app.AVSManagerKeeper.GetTaskID(ctx, suite.taskAddress))
+	gotChainID, found := app.AVSManagerKeeper.GetChainIDByAVSAddr(ctx, types.GenerateAVSAddr(chainID))
+	suite.True(found)
+	suite.Equal(chainID, gotChainID)
+	found, _ = app.AVSManagerKeeper.IsAVSByChainID(ctx, types.ChainIDWithoutRevision(ctx.ChainID()))
+	suite.True(found)
+}
@@ -1,11 +1,10 @@
 package keeper
 
 import (
-	"strconv"
+	"sort"
 
 	"github.com/ExocoreNetwork/exocore/x/avs/types"
 
-	sdkmath "cosmossdk.io/math"
 	epochstypes "github.com/ExocoreNetwork/exocore/x/epochs/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -29,80 +28,35 @@ func (k *Keeper) EpochsHooks() EpochsHooksWrapper {
 func (wrapper EpochsHooksWrapper) AfterEpochEnd(
 	ctx sdk.Context, epochIdentifier string, epochNumber int64,
 ) {
+	// retry the task statistics and settlements that failed at the end of the previous epochs
+	// first, so that the ones failing in this epoch are retried from the next epoch.
+	wrapper.keeper.RetryFailedTasks(ctx, epochIdentifier)
+	// settle the finalized tasks whose challenge period ends with this epoch.
+	wrapper.keeper.SettleTasks(ctx, epochIdentifier, epochNumber)
+
 	// get all the task info bypass the epoch end
 	// threshold calculation, signature verification, nosig quantity statistics
 	taskResList := wrapper.keeper.GetTaskStatisticalEpochEndAVSs(ctx, epochIdentifier, epochNumber)
-	// TODO:There should be a retry mechanism or compensation mechanism to handle cases of failure
-	if len(taskResList) != 0 {
-		groupedTasks := wrapper.keeper.GroupTasksByIDAndAddress(taskResList)
-		for _, value := range groupedTasks {
-			var signedOperatorList []string
-			var taskID uint64
-			var taskAddr string
-			var avsAddr string
-			var operatorPowers []*types.OperatorActivePowerInfo
-			operatorPowerTotal := sdkmath.LegacyNewDec(0)
-			for _, res := range value {
-				// Find signed operators
-				if res.BlsSignature != nil {
-					signedOperatorList = append(signedOperatorList, res.OperatorAddress)
-					if avsAddr == "" {
-						avsInfo := wrapper.keeper.GetAVSInfoByTaskAddress(ctx, res.TaskContractAddress)
-						avsAddr = avsInfo.AvsAddress
-					}
-					if taskID == 0 {
-						taskID = res.TaskId
-					}
-					if taskAddr == "" {
-						taskAddr = res.TaskContractAddress
-					}
-					power, err := wrapper.keeper.operatorKeeper.GetOperatorOptedUSDValue(ctx, avsAddr, res.OperatorAddress)
-					if err != nil || power.ActiveUSDValue.IsNegative() {
-						ctx.Logger().Error("Failed to update task result statistics,GetOperatorOptedUSDValue call failed!", "task result", taskAddr, "error", err)
-						// Handle the error gracefully, continue to the next
-						// continue
-					}
-
-					operatorSelfPower := &types.OperatorActivePowerInfo{
-						OperatorAddr:    res.OperatorAddress,
-						SelfActivePower: power.ActiveUSDValue,
-					}
-					operatorPowers = append(operatorPowers, operatorSelfPower)
-					operatorPowerTotal = operatorPowerTotal.Add(power.ActiveUSDValue)
-				}
-			}
-			taskInfo, err := wrapper.keeper.GetTaskInfo(ctx, strconv.FormatUint(taskID, 10), taskAddr)
-			if err != nil {
-				ctx.Logger().Error("Failed to update task result statistics,GetTaskInfo call failed!", "task result", taskAddr, "error", err)
-				// Handle the error gracefully, continue to the next
-				// continue
-			}
-			diff := types.Difference(taskInfo.OptInOperators, signedOperatorList)
-			taskInfo.SignedOperators = signedOperatorList
-			taskInfo.NoSignedOperators = diff
-			taskInfo.OperatorActivePower = &types.OperatorActivePowerList{OperatorPowerList: operatorPowers}
-			// Calculate actual threshold
-			taskPowerTotal, err := wrapper.keeper.operatorKeeper.GetAVSUSDValue(ctx, avsAddr)
-
-			if err != nil || taskPowerTotal.IsZero() || operatorPowerTotal.IsZero() {
-				ctx.Logger().Error("Failed to update task result statistics,GetAVSUSDValue call failed!", "task result", taskAddr, "error", err)
-				// Handle the error gracefully, continue to the next
-				// continue
-			}
-			taskInfo.TaskTotalPower = taskPowerTotal
-
-			if !taskPowerTotal.IsZero() && !operatorPowerTotal.IsZero() {
-				actualThreshold := taskPowerTotal.Quo(operatorPowerTotal).Mul(sdk.NewDec(100))
-				taskInfo.ActualThreshold = actualThreshold.BigInt().Uint64()
-			}
-
-			// Update the taskInfo in the state
-			err = wrapper.keeper.SetTaskInfo(ctx, taskInfo)
-			if err != nil {
-				ctx.Logger().Error("Failed to update task result statistics,SetTaskInfo call failed!", "task result", taskAddr, "error", err)
-				// Handle the error gracefully, continue to the next
-				// continue
-			}
+	if len(taskResList) == 0 {
+		return
+	}
+	groupedTasks := wrapper.keeper.GroupTasksByIDAndAddress(taskResList)
+	// iterate the tasks in order to keep the execution deterministic
+	taskKeys := make([]string, 0, len(groupedTasks))
+	for key := range groupedTasks {
+		taskKeys = append(taskKeys, key)
+	}
+	sort.Strings(taskKeys)
+	for _, key := range taskKeys {
+		results := groupedTasks[key]
+		taskAddr, taskID := results[0].TaskContractAddress, results[0].TaskId
+		err := wrapper.keeper.ProcessTaskStatistics(ctx, taskAddr, taskID, results)
+		if err != nil {
+			// queue the task to retry the statistics at the end of the next epoch
+			wrapper.keeper.handleTaskFailure(ctx, &types.TaskStatisticsRetry{
+				TaskContractAddress: taskAddr,
+				TaskID:              taskID,
+			}, err)
 		}
 	}
 }
@@ -32,6 +32,7 @@ type (
 		assetsKeeper types.AssetsKeeper
 		epochsKeeper types.EpochsKeeper
 		evmKeeper    types.EVMKeeper
+		rewardKeeper types.RewardKeeper
 	}
 )
 
@@ -42,6 +43,7 @@ func NewKeeper(
 	assetKeeper types.AssetsKeeper,
 	epochsKeeper types.EpochsKeeper,
 	evmKeeper types.EVMKeeper,
+	rewardKeeper types.RewardKeeper,
 ) Keeper {
 	return Keeper{
 		cdc:            cdc,
@@ -50,6 +52,7 @@ func NewKeeper(
 		assetsKeeper:   assetKeeper,
 		epochsKeeper:   epochsKeeper,
 		evmKeeper:      evmKeeper,
+		rewardKeeper:   rewardKeeper,
 	}
 }
 
@@ -261,7 +264,15 @@ func (k Keeper) CreateAVSTask(ctx sdk.Context, params *TaskInfoParams) error {
 		ActualThreshold:       0,
 		OptInOperators:        operatorList,
 	}
-	return k.SetTaskInfo(ctx, task)
+	if err := k.SetTaskInfo(ctx, task); err != nil {
+		return err
+	}
+	// the voting power snapshots must be kept until the task slashes are executed.
+	if period := params.TaskStatisticalPeriod + params.TaskChallengePeriod; period > avsInfo.MaxTaskChallengePeriod {
+		avsInfo.MaxTaskChallengePeriod = period
+		return k.SetAVSInfo(ctx, &avsInfo)
+	}
+	return nil
 }
 
 func (k Keeper) RegisterBLSPublicKey(ctx sdk.Context, params *BlsParams) error {
@@ -386,6 +397,26 @@ func (k Keeper) IterateAVSInfo(ctx sdk.Context, fn func(index int64, avsInfo typ
 	}
 }
 
+// SetAllAVSInfos sets all the AVS infos.
+func (k Keeper) SetAllAVSInfos(ctx sdk.Context, avsInfos []types.AVSInfo) error {
+	for i := range avsInfos {
+		if err := k.SetAVSInfo(ctx, &avsInfos[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetAllAVSInfos returns all the registered AVS infos.
+func (k Keeper) GetAllAVSInfos(ctx sdk.Context) ([]types.AVSInfo, error) {
+	ret := make([]types.AVSInfo, 0)
+	k.IterateAVSInfo(ctx, func(_ int64, avsInfo types.AVSInfo) (stop bool) {
+		ret = append(ret, avsInfo)
+		return false
+	})
+	return ret, nil
+}
+
 func (k Keeper) RaiseAndResolveChallenge(ctx sdk.Context, params *ChallengeParams) error {
 	taskInfo, err := k.GetTaskInfo(ctx, strconv.FormatUint(params.TaskID, 10), params.TaskContractAddress.String())
 	if err != nil {
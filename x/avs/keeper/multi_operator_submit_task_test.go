@@ -236,3 +236,21 @@ func (suite *AVSTestSuite) TestSubmitTask_OnlyPhaseTwo_Mul() {
 		suite.NoError(err)
 	}
 }
+
+func (suite *AVSTestSuite) TestIterateTaskResultsByTask() {
+	suite.TestSubmitTask_OnlyPhaseTwo_Mul()
+	taskAddr := common.Address(suite.taskAddress.Bytes()).String()
+	var operators []string
+	suite.App.AVSManagerKeeper.IterateTaskResultsByTask(suite.Ctx, taskAddr, suite.taskId, func(info avstypes.TaskResultInfo) bool {
+		suite.Equal(taskAddr, info.TaskContractAddress)
+		suite.Equal(suite.taskId, info.TaskId)
+		operators = append(operators, info.OperatorAddress)
+		return false
+	})
+	suite.ElementsMatch(suite.operatorAddresses, operators)
+	// the results of the other tasks aren't included
+	suite.App.AVSManagerKeeper.IterateTaskResultsByTask(suite.Ctx, taskAddr, suite.taskId+1, func(avstypes.TaskResultInfo) bool {
+		suite.Fail("unexpected task result")
+		return true
+	})
+}
@@ -27,6 +27,9 @@ func (k Keeper) SetTaskInfo(ctx sdk.Context, task *types.TaskInfo) (err error) {
 	infoKey := assetstype.GetJoinedStoreKey(task.TaskContractAddress, strconv.FormatUint(task.TaskId, 10))
 	bz := k.cdc.MustMarshal(task)
 	store.Set(infoKey, bz)
+	if task.Status == types.TaskStatusFinalized {
+		k.setTaskChallengeEndIndex(ctx, task, infoKey)
+	}
 	return nil
 }
 
@@ -213,11 +216,7 @@ func (k *Keeper) SetTaskResultInfo(
 				fmt.Sprintf("SetTaskResultInfo:submit  too late, CurrentEpoch:%d", epoch.CurrentEpoch),
 			)
 		}
-		infoKey := assetstype.GetJoinedStoreKey(info.OperatorAddress, info.TaskContractAddress,
-			strconv.FormatUint(info.TaskId, 10))
-		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskResult)
-		bz := k.cdc.MustMarshal(info)
-		store.Set(infoKey, bz)
+		k.setTaskResultInfo(ctx, info)
 		return nil
 
 	case types.TwoPhaseCommitTwo:
@@ -274,11 +273,7 @@ func (k *Keeper) SetTaskResultInfo(
 			)
 		}
 
-		infoKey := assetstype.GetJoinedStoreKey(info.OperatorAddress, info.TaskContractAddress, strconv.FormatUint(info.TaskId, 10))
-
-		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskResult)
-		bz := k.cdc.MustMarshal(info)
-		store.Set(infoKey, bz)
+		k.setTaskResultInfo(ctx, info)
 		return nil
 	default:
 		return errorsmod.Wrap(
@@ -288,6 +283,38 @@ func (k *Keeper) SetTaskResultInfo(
 	}
 }
 
+// setTaskResultInfo stores the task result, and indexes it by the task.
+func (k *Keeper) setTaskResultInfo(ctx sdk.Context, info *types.TaskResultInfo) {
+	taskID := strconv.FormatUint(info.TaskId, 10)
+	infoKey := assetstype.GetJoinedStoreKey(info.OperatorAddress, info.TaskContractAddress, taskID)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskResult)
+	store.Set(infoKey, k.cdc.MustMarshal(info))
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskResultByTask)
+	indexStore.Set(assetstype.GetJoinedStoreKey(info.TaskContractAddress, taskID, info.OperatorAddress), infoKey)
+}
+
+// IterateTaskResultsByTask iterates through the results submitted for the task.
+func (k *Keeper) IterateTaskResultsByTask(
+	ctx sdk.Context, taskAddr string, taskID uint64, fn func(info types.TaskResultInfo) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskResult)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskResultByTask)
+	iterator := sdk.KVStorePrefixIterator(indexStore, assetstype.GetJoinedStoreKeyForPrefix(taskAddr, strconv.FormatUint(taskID, 10)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		value := store.Get(iterator.Value())
+		if value == nil {
+			continue
+		}
+		var info types.TaskResultInfo
+		k.cdc.MustUnmarshal(value, &info)
+		if fn(info) {
+			break
+		}
+	}
+}
+
 func (k *Keeper) IsExistTaskResultInfo(ctx sdk.Context, operatorAddress, taskContractAddress string, taskID uint64) bool {
 	infoKey := assetstype.GetJoinedStoreKey(operatorAddress, taskContractAddress,
 		strconv.FormatUint(taskID, 10))
@@ -394,3 +421,122 @@ func (k *Keeper) GetTaskChallengedInfo(ctx sdk.Context, operatorAddress, taskCon
 
 	return common.Bytes2Hex(value), nil
 }
+
+// SetAllTaskInfos sets all the task infos.
+func (k *Keeper) SetAllTaskInfos(ctx sdk.Context, tasks []types.TaskInfo) error {
+	for i := range tasks {
+		if err := k.SetTaskInfo(ctx, &tasks[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetAllTaskInfos returns all the task infos.
+func (k *Keeper) GetAllTaskInfos(ctx sdk.Context) ([]types.TaskInfo, error) {
+	ret := make([]types.TaskInfo, 0)
+	k.IterateTaskAVSInfo(ctx, func(_ int64, taskInfo types.TaskInfo) (stop bool) {
+		ret = append(ret, taskInfo)
+		return false
+	})
+	return ret, nil
+}
+
+// SetAllBlsPubKeys sets all the BLS public keys of the operators.
+func (k *Keeper) SetAllBlsPubKeys(ctx sdk.Context, pubKeys []types.BlsPubKeyInfo) error {
+	for i := range pubKeys {
+		if err := k.SetOperatorPubKey(ctx, &pubKeys[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetAllBlsPubKeys returns all the BLS public keys of the operators.
+func (k *Keeper) GetAllBlsPubKeys(ctx sdk.Context) ([]types.BlsPubKeyInfo, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatePub)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	ret := make([]types.BlsPubKeyInfo, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var pubKey types.BlsPubKeyInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &pubKey)
+		ret = append(ret, pubKey)
+	}
+	return ret, nil
+}
+
+// SetAllTaskResultInfos sets all the task results submitted by the operators. Unlike
+// SetTaskResultInfo, it doesn't check the signature and the submission period, since
+// the results have already been verified before being exported.
+func (k *Keeper) SetAllTaskResultInfos(ctx sdk.Context, results []types.TaskResultInfo) error {
+	for i := range results {
+		k.setTaskResultInfo(ctx, &results[i])
+	}
+	return nil
+}
+
+// GetAllTaskResultInfos returns all the task results submitted by the operators.
+func (k *Keeper) GetAllTaskResultInfos(ctx sdk.Context) ([]types.TaskResultInfo, error) {
+	ret := make([]types.TaskResultInfo, 0)
+	k.IterateResultInfo(ctx, func(_ int64, info types.TaskResultInfo) (stop bool) {
+		ret = append(ret, info)
+		return false
+	})
+	return ret, nil
+}
+
+// SetAllChallengeInfos sets all the challenges raised against the task results.
+func (k *Keeper) SetAllChallengeInfos(ctx sdk.Context, challenges []types.ChallengeInfo) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskChallengeResult)
+	for _, challenge := range challenges {
+		challengeAddr, err := sdk.AccAddressFromBech32(challenge.ChallengeAddr)
+		if err != nil {
+			return err
+		}
+		store.Set([]byte(challenge.Key), challengeAddr)
+	}
+	return nil
+}
+
+// GetAllChallengeInfos returns all the challenges raised against the task results.
+func (k *Keeper) GetAllChallengeInfos(ctx sdk.Context) ([]types.ChallengeInfo, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskChallengeResult)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	ret := make([]types.ChallengeInfo, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		ret = append(ret, types.ChallengeInfo{
+			Key:           string(iterator.Key()),
+			ChallengeAddr: sdk.AccAddress(iterator.Value()).String(),
+		})
+	}
+	return ret, nil
+}
+
+// SetAllTaskNums sets the latest task ID for each task contract.
+func (k *Keeper) SetAllTaskNums(ctx sdk.Context, taskNums []types.TaskID) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixLatestTaskNum)
+	for _, taskNum := range taskNums {
+		store.Set(common.HexToAddress(taskNum.TaskAddr).Bytes(), sdk.Uint64ToBigEndian(taskNum.TaskID))
+	}
+	return nil
+}
+
+// GetAllTaskNums returns the latest task ID for each task contract.
+func (k *Keeper) GetAllTaskNums(ctx sdk.Context) ([]types.TaskID, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixLatestTaskNum)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	ret := make([]types.TaskID, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		ret = append(ret, types.TaskID{
+			TaskAddr: common.BytesToAddress(iterator.Key()).String(),
+			TaskID:   sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+	return ret, nil
+}
@@ -0,0 +1,264 @@
+package keeper
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/utils"
+	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
+	"github.com/ExocoreNetwork/exocore/x/avs/types"
+	operatortypes "github.com/ExocoreNetwork/exocore/x/operator/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// GetSlashIDForTask uses taskContractAddress+'_'+'taskID' as the slashID of the slashes
+// executed by the task settlement.
+func GetSlashIDForTask(taskAddr string, taskID uint64) string {
+	return strings.Join([]string{taskAddr, hexutil.EncodeUint64(taskID)}, utils.DelimiterForID)
+}
+
+// setTaskChallengeEndIndex indexes the finalized task by the end of its challenge period, so
+// that the tasks to be settled can be found without iterating all the tasks. The task isn't
+// indexed if it isn't bound to any AVS, since it can't be settled.
+func (k *Keeper) setTaskChallengeEndIndex(ctx sdk.Context, taskInfo *types.TaskInfo, infoKey []byte) {
+	avsInfo := k.GetAVSInfoByTaskAddress(ctx, taskInfo.TaskContractAddress)
+	if avsInfo.AvsAddress == "" {
+		return
+	}
+	// #nosec G115
+	endEpoch := int64(taskInfo.StartingEpoch + taskInfo.TaskResponsePeriod +
+		taskInfo.TaskStatisticalPeriod + taskInfo.TaskChallengePeriod)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskChallengeEnd)
+	store.Set(types.GetTaskChallengeEndKey(avsInfo.EpochIdentifier, endEpoch, taskInfo.TaskContractAddress, taskInfo.TaskId), infoKey)
+}
+
+// GetTaskChallengeEpochEndTasks returns the finalized tasks whose challenge period has ended
+// at or before the epoch, they are ready to be settled.
+func (k *Keeper) GetTaskChallengeEpochEndTasks(ctx sdk.Context, epochIdentifier string, epochNumber int64) []types.TaskInfo {
+	var tasks []types.TaskInfo
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskChallengeEnd)
+	iterator := store.Iterator(
+		types.GetTaskChallengeEndPrefix(epochIdentifier, 0),
+		types.GetTaskChallengeEndPrefix(epochIdentifier, epochNumber+1),
+	)
+	defer iterator.Close()
+	taskStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAVSTaskInfo)
+	for ; iterator.Valid(); iterator.Next() {
+		value := taskStore.Get(iterator.Value())
+		if value == nil {
+			continue
+		}
+		var taskInfo types.TaskInfo
+		k.cdc.MustUnmarshal(value, &taskInfo)
+		if taskInfo.Status == types.TaskStatusFinalized {
+			tasks = append(tasks, taskInfo)
+		}
+	}
+	return tasks
+}
+
+// deleteTaskChallengeEndIndex removes the index of the tasks whose challenge period has ended
+// at or before the epoch.
+func (k *Keeper) deleteTaskChallengeEndIndex(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskChallengeEnd)
+	iterator := store.Iterator(
+		types.GetTaskChallengeEndPrefix(epochIdentifier, 0),
+		types.GetTaskChallengeEndPrefix(epochIdentifier, epochNumber+1),
+	)
+	// collect the keys first, since the store can't be modified during iteration.
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// SettleTask executes the rewards and slashes of a finalized task once its challenge period
+// ends. The signed operators which aren't challenged share the task reward of the AVS pro-rata
+// to their active power, which is paid apart from the epoch reward. The unsigned operators and
+// the challenged operators are slashed with the slash proportion of the AVS, and the failure to
+// slash an operator doesn't fail the settlement. The task is settled if the other steps
+// succeed, otherwise nothing is changed and the error is returned.
+func (k *Keeper) SettleTask(ctx sdk.Context, taskAddr string, taskID uint64) error {
+	// using cache context to ensure the atomicity of the settlement.
+	cc, writeFunc := ctx.CacheContext()
+	taskInfo, err := k.GetTaskInfo(cc, strconv.FormatUint(taskID, 10), taskAddr)
+	if err != nil {
+		return err
+	}
+	if taskInfo.Status != types.TaskStatusFinalized {
+		return errorsmod.Wrapf(
+			types.ErrParamError,
+			"SettleTask: the task isn't finalized, task address:%s task ID:%d status:%s", taskAddr, taskID, taskInfo.Status,
+		)
+	}
+	avsInfo := k.GetAVSInfoByTaskAddress(cc, taskAddr)
+	if avsInfo.AvsAddress == "" {
+		return errorsmod.Wrapf(types.ErrNoKeyInTheStore, "SettleTask: no AVS for the task address %s", taskAddr)
+	}
+
+	// the signed operators with a challenge raised during the challenge period are regarded
+	// as the operators with incorrect signatures.
+	var errSignedOperators []string
+	for _, operator := range taskInfo.SignedOperators {
+		if k.IsExistTaskChallengedInfo(cc, operator, taskAddr, taskID) {
+			errSignedOperators = append(errSignedOperators, operator)
+		}
+	}
+	taskInfo.ErrSignedOperators = errSignedOperators
+
+	rewardedOperators, err := k.distributeTaskReward(cc, &avsInfo, taskInfo)
+	if err != nil {
+		return err
+	}
+	slashedOperators, failedOperators := k.slashTaskOperators(cc, &avsInfo, taskInfo)
+
+	taskInfo.Status = types.TaskStatusSettled
+	if err := k.SetTaskInfo(cc, taskInfo); err != nil {
+		return err
+	}
+	writeFunc()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTaskSettled,
+			sdk.NewAttribute(types.AttributeKeyTaskContractAddress, taskAddr),
+			sdk.NewAttribute(types.AttributeKeyTaskID, strconv.FormatUint(taskID, 10)),
+			sdk.NewAttribute(types.AttributeKeyRewardedOperators, strings.Join(rewardedOperators, ",")),
+			sdk.NewAttribute(types.AttributeKeySlashedOperators, strings.Join(slashedOperators, ",")),
+			sdk.NewAttribute(types.AttributeKeyFailedOperators, strings.Join(failedOperators, ",")),
+		),
+	)
+	return nil
+}
+
+// distributeTaskReward distributes the reward of the task to the signed operators which
+// aren't challenged, and returns the rewarded operators. The reward of each asset is paid
+// from the reward fund of the AVS, so it's capped by the undistributed balance of the fund.
+func (k *Keeper) distributeTaskReward(ctx sdk.Context, avsInfo *types.AVSInfo, taskInfo *types.TaskInfo) ([]string, error) {
+	if len(avsInfo.AssetRewardAmountTaskBasis) == 0 || taskInfo.OperatorActivePower == nil {
+		return nil, nil
+	}
+	var rewardedOperators []string
+	powers := make(map[string]sdkmath.LegacyDec)
+	totalPower := sdkmath.LegacyZeroDec()
+	for _, power := range taskInfo.OperatorActivePower.OperatorPowerList {
+		if power == nil || power.SelfActivePower.IsNil() || !power.SelfActivePower.IsPositive() ||
+			slices.Contains(taskInfo.ErrSignedOperators, power.OperatorAddr) {
+			continue
+		}
+		rewardedOperators = append(rewardedOperators, power.OperatorAddr)
+		powers[power.OperatorAddr] = power.SelfActivePower
+		totalPower = totalPower.Add(power.SelfActivePower)
+	}
+	if !totalPower.IsPositive() {
+		return nil, nil
+	}
+	for _, assetID := range avsInfo.AssetIDs {
+		rewardAmount := sdkmath.MinInt(
+			sdkmath.NewInt(avsInfo.AssetRewardAmountTaskBasis[assetID]),
+			k.rewardKeeper.GetRewardFund(ctx, avsInfo.AvsAddress, assetID),
+		)
+		if !rewardAmount.IsPositive() {
+			continue
+		}
+		for _, operator := range rewardedOperators {
+			reward := powers[operator].MulInt(rewardAmount).Quo(totalPower).TruncateInt()
+			if err := k.rewardKeeper.DistributeOperatorReward(ctx, avsInfo.AvsAddress, operator, assetID, reward); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return rewardedOperators, nil
+}
+
+// slashTaskOperators slashes the unsigned operators and the operators with incorrect
+// signatures, and returns the slashed operators along with the ones failing to be slashed.
+// The slashes refer to the height at which the task results are counted, rather than the
+// height of the settlement. Each slash is executed in its own cache context, so a failed one
+// is skipped without affecting the others.
+func (k *Keeper) slashTaskOperators(ctx sdk.Context, avsInfo *types.AVSInfo, taskInfo *types.TaskInfo) (slashed, failed []string) {
+	if avsInfo.AvsSlash.IsNil() || !avsInfo.AvsSlash.IsPositive() {
+		return nil, nil
+	}
+	operators := make([]string, 0, len(taskInfo.NoSignedOperators)+len(taskInfo.ErrSignedOperators))
+	operators = append(operators, taskInfo.NoSignedOperators...)
+	operators = append(operators, taskInfo.ErrSignedOperators...)
+	for _, operator := range operators {
+		err := k.slashTaskOperator(ctx, avsInfo, taskInfo, operator)
+		if err == nil {
+			slashed = append(slashed, operator)
+			continue
+		}
+		ctx.Logger().Error(
+			"Failed to slash the task operator",
+			"task address", taskInfo.TaskContractAddress, "task ID", taskInfo.TaskId,
+			"operator", operator, "error", err,
+		)
+		failed = append(failed, operator)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTaskSlashFailed,
+				sdk.NewAttribute(types.AttributeKeyTaskContractAddress, taskInfo.TaskContractAddress),
+				sdk.NewAttribute(types.AttributeKeyTaskID, strconv.FormatUint(taskInfo.TaskId, 10)),
+				sdk.NewAttribute(types.AttributeKeyOperator, operator),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			),
+		)
+	}
+	return slashed, failed
+}
+
+// slashTaskOperator slashes the operator for the task, nothing is changed if the slash fails.
+func (k *Keeper) slashTaskOperator(ctx sdk.Context, avsInfo *types.AVSInfo, taskInfo *types.TaskInfo, operator string) error {
+	opAccAddr, err := sdk.AccAddressFromBech32(operator)
+	if err != nil {
+		return assetstype.ErrInvalidOperatorAddr
+	}
+	cc, writeFunc := ctx.CacheContext()
+	err = k.operatorKeeper.Slash(cc, &operatortypes.SlashInputInfo{
+		IsDogFood:        false,
+		SlashType:        uint32(operatortypes.SlashType_SLASH_TYPE_NO_INSTANTANEOUS_SLASH),
+		Operator:         opAccAddr,
+		AVSAddr:          avsInfo.AvsAddress,
+		SlashContract:    avsInfo.SlashAddr,
+		SlashID:          GetSlashIDForTask(taskInfo.TaskContractAddress, taskInfo.TaskId),
+		SlashEventHeight: taskInfo.StatisticsHeight,
+		SlashProportion:  avsInfo.AvsSlash,
+	})
+	if err != nil {
+		return err
+	}
+	writeFunc()
+	return nil
+}
+
+// SettleTasks settles the finalized tasks of the AVSs using the epoch identifier, whose
+// challenge period ends with the epoch. The task failing to be settled is queued to be
+// retried at the end of the next epoch, and the queued tasks are left to the retries, so the
+// index of the tasks is removed afterwards.
+func (k *Keeper) SettleTasks(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	tasks := k.GetTaskChallengeEpochEndTasks(ctx, epochIdentifier, epochNumber)
+	for i := range tasks {
+		task := tasks[i]
+		if _, queued := k.GetTaskStatisticsRetry(ctx, task.TaskContractAddress, task.TaskId); queued {
+			continue
+		}
+		err := k.SettleTask(ctx, task.TaskContractAddress, task.TaskId)
+		if err != nil {
+			k.handleTaskFailure(ctx, &types.TaskStatisticsRetry{
+				TaskContractAddress: task.TaskContractAddress,
+				TaskID:              task.TaskId,
+			}, err)
+		}
+	}
+	k.deleteTaskChallengeEndIndex(ctx, epochIdentifier, epochNumber)
+}
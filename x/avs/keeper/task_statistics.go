@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
+	"github.com/ExocoreNetwork/exocore/x/avs/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProcessTaskStatistics calculates the statistics of the task results at the end of the
+// statistical period, which include the signed and unsigned operators, the active power of
+// the signed operators and the actual threshold. The task is finalized if all the steps
+// succeed, otherwise nothing is changed and the error is returned.
+func (k *Keeper) ProcessTaskStatistics(
+	ctx sdk.Context, taskAddr string, taskID uint64, results []types.TaskResultInfo,
+) error {
+	// using cache context to ensure the atomicity of the statistics.
+	cc, writeFunc := ctx.CacheContext()
+	taskInfo, err := k.GetTaskInfo(cc, strconv.FormatUint(taskID, 10), taskAddr)
+	if err != nil {
+		return err
+	}
+	avsAddr := k.GetAVSInfoByTaskAddress(cc, taskAddr).AvsAddress
+	if avsAddr == "" {
+		return errorsmod.Wrapf(types.ErrNoKeyInTheStore, "ProcessTaskStatistics: no AVS for the task address %s", taskAddr)
+	}
+
+	var signedOperators []string
+	operatorPowers := make([]*types.OperatorActivePowerInfo, 0)
+	signedPowerTotal := sdkmath.LegacyNewDec(0)
+	for _, res := range results {
+		// Find signed operators
+		if res.BlsSignature == nil {
+			continue
+		}
+		power, err := k.operatorKeeper.GetOperatorOptedUSDValue(cc, avsAddr, res.OperatorAddress)
+		if err != nil {
+			return err
+		}
+		if power.ActiveUSDValue.IsNil() || power.ActiveUSDValue.IsNegative() {
+			return errorsmod.Wrapf(
+				types.ErrParamError,
+				"ProcessTaskStatistics: invalid active power of the operator %s", res.OperatorAddress,
+			)
+		}
+		activePower := power.ActiveUSDValue
+		operatorAddr, err := sdk.AccAddressFromBech32(res.OperatorAddress)
+		if err != nil {
+			return err
+		}
+		if !k.operatorKeeper.IsEligible(cc, operatorAddr, avsAddr) {
+			// the signature of an operator below the minimum self delegation carries no power
+			activePower = sdkmath.LegacyZeroDec()
+		}
+		signedOperators = append(signedOperators, res.OperatorAddress)
+		operatorPowers = append(operatorPowers, &types.OperatorActivePowerInfo{
+			OperatorAddr:    res.OperatorAddress,
+			SelfActivePower: activePower,
+		})
+		signedPowerTotal = signedPowerTotal.Add(activePower)
+	}
+	taskPowerTotal, err := k.operatorKeeper.GetAVSUSDValue(cc, avsAddr)
+	if err != nil {
+		return err
+	}
+
+	taskInfo.SignedOperators = signedOperators
+	taskInfo.NoSignedOperators = types.Difference(taskInfo.OptInOperators, signedOperators)
+	taskInfo.OperatorActivePower = &types.OperatorActivePowerList{OperatorPowerList: operatorPowers}
+	taskInfo.TaskTotalPower = taskPowerTotal
+	// the actual threshold is the percentage of the signed power in the total power
+	taskInfo.ActualThreshold = 0
+	if taskPowerTotal.IsPositive() {
+		taskInfo.ActualThreshold = signedPowerTotal.Quo(taskPowerTotal).MulInt64(100).TruncateInt().Uint64()
+	}
+	taskInfo.StatisticsHeight = ctx.BlockHeight()
+	taskInfo.Status = types.TaskStatusFinalized
+	if err := k.SetTaskInfo(cc, taskInfo); err != nil {
+		return err
+	}
+	writeFunc()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTaskStatisticsFinalized,
+			sdk.NewAttribute(types.AttributeKeyTaskContractAddress, taskAddr),
+			sdk.NewAttribute(types.AttributeKeyTaskID, strconv.FormatUint(taskID, 10)),
+			sdk.NewAttribute(types.AttributeKeyActualThreshold, strconv.FormatUint(taskInfo.ActualThreshold, 10)),
+		),
+	)
+	return nil
+}
+
+// handleTaskFailure records the failure of the task statistics, or the failure of the
+// settlement if the task has been finalized. The task is queued to be retried at the end of
+// the next epoch, and it's marked as failed once the retries reach the limit.
+func (k *Keeper) handleTaskFailure(ctx sdk.Context, retry *types.TaskStatisticsRetry, err error) {
+	taskInfo, getErr := k.GetTaskInfo(ctx, strconv.FormatUint(retry.TaskID, 10), retry.TaskContractAddress)
+	retryEvent, failedEvent := types.EventTypeTaskStatisticsRetry, types.EventTypeTaskStatisticsFailed
+	if getErr == nil && taskInfo.Status == types.TaskStatusFinalized {
+		retryEvent, failedEvent = types.EventTypeTaskSettlementRetry, types.EventTypeTaskSettlementFailed
+	}
+	ctx.Logger().Error(
+		"Failed to process the task",
+		"task address", retry.TaskContractAddress, "task ID", retry.TaskID,
+		"retry count", retry.RetryCount, "error", err,
+	)
+	retry.LastError = err.Error()
+	if retry.RetryCount >= types.MaxTaskStatisticsRetries {
+		k.deleteTaskStatisticsRetry(ctx, retry.TaskContractAddress, retry.TaskID)
+		if getErr == nil {
+			taskInfo.Status = types.TaskStatusFailed
+			if setErr := k.SetTaskInfo(ctx, taskInfo); setErr != nil {
+				ctx.Logger().Error("Failed to mark the task as failed", "task address", retry.TaskContractAddress, "error", setErr)
+			}
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				failedEvent,
+				sdk.NewAttribute(types.AttributeKeyTaskContractAddress, retry.TaskContractAddress),
+				sdk.NewAttribute(types.AttributeKeyTaskID, strconv.FormatUint(retry.TaskID, 10)),
+				sdk.NewAttribute(types.AttributeKeyError, retry.LastError),
+			),
+		)
+		return
+	}
+	k.setTaskStatisticsRetry(ctx, retry)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			retryEvent,
+			sdk.NewAttribute(types.AttributeKeyTaskContractAddress, retry.TaskContractAddress),
+			sdk.NewAttribute(types.AttributeKeyTaskID, strconv.FormatUint(retry.TaskID, 10)),
+			sdk.NewAttribute(types.AttributeKeyRetryCount, strconv.FormatUint(uint64(retry.RetryCount), 10)),
+			sdk.NewAttribute(types.AttributeKeyError, retry.LastError),
+		),
+	)
+}
+
+// getTaskResults returns all the results submitted for the task.
+func (k *Keeper) getTaskResults(ctx sdk.Context, taskAddr string, taskID uint64) []types.TaskResultInfo {
+	var results []types.TaskResultInfo
+	k.IterateTaskResultsByTask(ctx, taskAddr, taskID, func(info types.TaskResultInfo) (stop bool) {
+		results = append(results, info)
+		return false
+	})
+	return results
+}
+
+// RetryFailedTasks retries the failed task statistics and settlements of the AVSs using the
+// epoch identifier. The finalized tasks are retried with the settlement, and the others with
+// the statistics. The retry is removed once it succeeds.
+func (k *Keeper) RetryFailedTasks(ctx sdk.Context, epochIdentifier string) {
+	retries := k.GetAllTaskStatisticsRetries(ctx)
+	for i := range retries {
+		retry := retries[i]
+		avsInfo := k.GetAVSInfoByTaskAddress(ctx, retry.TaskContractAddress)
+		if avsInfo.AvsAddress != "" && avsInfo.EpochIdentifier != epochIdentifier {
+			continue
+		}
+		var err error
+		taskInfo, getErr := k.GetTaskInfo(ctx, strconv.FormatUint(retry.TaskID, 10), retry.TaskContractAddress)
+		if getErr == nil && taskInfo.Status == types.TaskStatusFinalized {
+			err = k.SettleTask(ctx, retry.TaskContractAddress, retry.TaskID)
+		} else {
+			results := k.getTaskResults(ctx, retry.TaskContractAddress, retry.TaskID)
+			err = k.ProcessTaskStatistics(ctx, retry.TaskContractAddress, retry.TaskID, results)
+		}
+		if err != nil {
+			retry.RetryCount++
+			k.handleTaskFailure(ctx, &retry, err)
+			continue
+		}
+		k.deleteTaskStatisticsRetry(ctx, retry.TaskContractAddress, retry.TaskID)
+	}
+}
+
+func (k *Keeper) setTaskStatisticsRetry(ctx sdk.Context, retry *types.TaskStatisticsRetry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskStatisticsRetry)
+	key := assetstype.GetJoinedStoreKey(retry.TaskContractAddress, strconv.FormatUint(retry.TaskID, 10))
+	store.Set(key, k.cdc.MustMarshal(retry))
+}
+
+func (k *Keeper) deleteTaskStatisticsRetry(ctx sdk.Context, taskAddr string, taskID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskStatisticsRetry)
+	store.Delete(assetstype.GetJoinedStoreKey(taskAddr, strconv.FormatUint(taskID, 10)))
+}
+
+// GetTaskStatisticsRetry returns the retry of the task statistics and whether it's found.
+func (k *Keeper) GetTaskStatisticsRetry(ctx sdk.Context, taskAddr string, taskID uint64) (*types.TaskStatisticsRetry, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskStatisticsRetry)
+	value := store.Get(assetstype.GetJoinedStoreKey(taskAddr, strconv.FormatUint(taskID, 10)))
+	if value == nil {
+		return nil, false
+	}
+	ret := types.TaskStatisticsRetry{}
+	k.cdc.MustUnmarshal(value, &ret)
+	return &ret, true
+}
+
+// SetAllTaskStatisticsRetries sets all the retries of the task statistics.
+func (k *Keeper) SetAllTaskStatisticsRetries(ctx sdk.Context, retries []types.TaskStatisticsRetry) {
+	for i := range retries {
+		k.setTaskStatisticsRetry(ctx, &retries[i])
+	}
+}
+
+// GetAllTaskStatisticsRetries returns all the retries of the task statistics.
+func (k *Keeper) GetAllTaskStatisticsRetries(ctx sdk.Context) []types.TaskStatisticsRetry {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskStatisticsRetry)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	ret := make([]types.TaskStatisticsRetry, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var retry types.TaskStatisticsRetry
+		k.cdc.MustUnmarshal(iterator.Value(), &retry)
+		ret = append(ret, retry)
+	}
+	return ret
+}
@@ -115,4 +115,12 @@ var (
 		ModuleName, 27,
 		" The response was submitted too soon.",
 	)
+	ErrInvalidGenesisData = errorsmod.Register(
+		ModuleName, 28,
+		"the genesis data supplied is invalid",
+	)
+	ErrInvalidVetoCommittee = errorsmod.Register(
+		ModuleName, 29,
+		"invalid veto committee",
+	)
 )
@@ -0,0 +1,22 @@
+package types
+
+// x/avs events
+const (
+	EventTypeTaskStatisticsFinalized = "task_statistics_finalized"
+	EventTypeTaskStatisticsRetry     = "task_statistics_retry"
+	EventTypeTaskStatisticsFailed    = "task_statistics_failed"
+	EventTypeTaskSettled             = "task_settled"
+	EventTypeTaskSettlementRetry     = "task_settlement_retry"
+	EventTypeTaskSettlementFailed    = "task_settlement_failed"
+	EventTypeTaskSlashFailed         = "task_slash_failed"
+
+	AttributeKeyTaskContractAddress = "task_contract_address"
+	AttributeKeyTaskID              = "task_id"
+	AttributeKeyActualThreshold     = "actual_threshold"
+	AttributeKeyRetryCount          = "retry_count"
+	AttributeKeyError               = "error"
+	AttributeKeyRewardedOperators   = "rewarded_operators"
+	AttributeKeySlashedOperators    = "slashed_operators"
+	AttributeKeyFailedOperators     = "failed_operators"
+	AttributeKeyOperator            = "operator"
+)
@@ -41,7 +41,15 @@ type OperatorKeeper interface {
 	OptOut(ctx sdk.Context, operatorAddress sdk.AccAddress, avsAddr string) (err error)
 	GetOptedInOperatorListByAVS(ctx sdk.Context, avsAddr string) ([]string, error)
 	GetOperatorOptedUSDValue(ctx sdk.Context, avsAddr, operatorAddr string) (operatortypes.OperatorOptedUSDValue, error)
+	IsEligible(ctx sdk.Context, operatorAddr sdk.AccAddress, avsAddr string) bool
 	GetAVSUSDValue(ctx sdk.Context, avsAddr string) (sdkmath.LegacyDec, error)
+	Slash(ctx sdk.Context, parameter *operatortypes.SlashInputInfo) error
+}
+
+// RewardKeeper represents the expected keeper interface for the reward module.
+type RewardKeeper interface {
+	DistributeOperatorReward(ctx sdk.Context, avsAddr, operator, assetID string, amount sdkmath.Int) error
+	GetRewardFund(ctx sdk.Context, avsAddr, assetID string) sdkmath.Int
 }
 
 // AssetsKeeper represents the expected keeper interface for the assets module.
@@ -1,21 +1,413 @@
 package types
 
+import (
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ExocoreNetwork/exocore/utils"
+	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
 const DefaultIndex uint64 = 1
 
+// NewGenesisState creates a new genesis state with the provided parameters and
+// data.
+func NewGenesisState(
+	params Params,
+	avsInfos []AVSInfo,
+	taskInfos []TaskInfo,
+	blsPubKeys []BlsPubKeyInfo,
+	taskResultInfos []TaskResultInfo,
+	challengeInfos []ChallengeInfo,
+	taskNums []TaskID,
+	chainIDInfos []ChainIDInfo,
+	taskStatisticsRetries []TaskStatisticsRetry,
+) *GenesisState {
+	return &GenesisState{
+		Params:                params,
+		AVSInfos:              avsInfos,
+		TaskInfos:             taskInfos,
+		BlsPubKeys:            blsPubKeys,
+		TaskResultInfos:       taskResultInfos,
+		ChallengeInfos:        challengeInfos,
+		TaskNums:              taskNums,
+		ChainIDInfos:          chainIDInfos,
+		TaskStatisticsRetries: taskStatisticsRetries,
+	}
+}
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
-	return &GenesisState{
-		Params: DefaultParams(),
+	return NewGenesisState(DefaultParams(), nil, nil, nil, nil, nil, nil, nil, nil)
+}
+
+// ValidateAVSInfos validates the AVS infos.
+func (gs GenesisState) ValidateAVSInfos() error {
+	taskAddrs := make(map[string]struct{}, len(gs.AVSInfos))
+	validationFunc := func(_ int, info AVSInfo) error {
+		if !common.IsHexAddress(info.AvsAddress) {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"the AVS address isn't an ethereum hex address, %s", info.AvsAddress,
+			)
+		}
+		if info.EpochIdentifier == "" {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"empty epoch identifier for the AVS %s", info.AvsAddress,
+			)
+		}
+		if info.TaskAddr != "" {
+			if !common.IsHexAddress(info.TaskAddr) {
+				return errorsmod.Wrapf(
+					ErrInvalidGenesisData,
+					"the task address isn't an ethereum hex address, AVS: %s, task address: %s",
+					info.AvsAddress, info.TaskAddr,
+				)
+			}
+			// a task contract address can only be used by one AVS
+			taskAddr := common.HexToAddress(info.TaskAddr).String()
+			if _, ok := taskAddrs[taskAddr]; ok {
+				return errorsmod.Wrapf(
+					ErrInvalidGenesisData,
+					"the task address %s is used by more than one AVS", info.TaskAddr,
+				)
+			}
+			taskAddrs[taskAddr] = struct{}{}
+		}
+		for _, owner := range info.AvsOwnerAddress {
+			if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+				return errorsmod.Wrapf(
+					ErrInvalidGenesisData,
+					"invalid owner address %s for the AVS %s: %s", owner, info.AvsAddress, err,
+				)
+			}
+		}
+		if err := ValidateVetoCommittee(info.VetoCommittee, info.AvsOwnerAddress); err != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid veto committee for the AVS %s: %s", info.AvsAddress, err,
+			)
+		}
+		if info.AvsSlash.IsNil() || info.AvsSlash.IsNegative() {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid slash proportion for the AVS %s: %s", info.AvsAddress, info.AvsSlash,
+			)
+		}
+		if info.AvsReward.IsNil() || info.AvsReward.IsNegative() {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid reward proportion for the AVS %s: %s", info.AvsAddress, info.AvsReward,
+			)
+		}
+		for assetID, amount := range info.AssetRewardAmountTaskBasis {
+			if amount < 0 {
+				return errorsmod.Wrapf(
+					ErrInvalidGenesisData,
+					"negative task reward of the asset %s for the AVS %s: %d", assetID, info.AvsAddress, amount,
+				)
+			}
+		}
+		return nil
+	}
+	seenFieldValueFunc := func(info AVSInfo) (string, struct{}) {
+		return common.HexToAddress(info.AvsAddress).String(), struct{}{}
 	}
+	_, err := utils.CommonValidation(gs.AVSInfos, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+	}
+	return nil
+}
+
+// ValidateTaskInfos validates the task infos and returns the set of joined task keys,
+// along with the largest task ID for each task contract address.
+func (gs GenesisState) ValidateTaskInfos() (map[string]struct{}, map[string]uint64, error) {
+	maxTaskIDs := make(map[string]uint64)
+	validationFunc := func(_ int, task TaskInfo) error {
+		if !common.IsHexAddress(task.TaskContractAddress) {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"the task contract address isn't an ethereum hex address, %s", task.TaskContractAddress,
+			)
+		}
+		if task.TaskTotalPower.IsNil() || task.TaskTotalPower.IsNegative() {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"the total power of task %d is nil or negative, task address: %s",
+				task.TaskId, task.TaskContractAddress,
+			)
+		}
+		taskAddr := common.HexToAddress(task.TaskContractAddress).String()
+		if task.TaskId > maxTaskIDs[taskAddr] {
+			maxTaskIDs[taskAddr] = task.TaskId
+		}
+		return nil
+	}
+	seenFieldValueFunc := func(task TaskInfo) (string, struct{}) {
+		key := assetstypes.GetJoinedStoreKey(task.TaskContractAddress, strconv.FormatUint(task.TaskId, 10))
+		return string(key), struct{}{}
+	}
+	tasks, err := utils.CommonValidation(gs.TaskInfos, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+	}
+	return tasks, maxTaskIDs, nil
+}
+
+// ValidateBlsPubKeys validates the BLS public keys of the operators.
+func (gs GenesisState) ValidateBlsPubKeys() error {
+	validationFunc := func(_ int, pubKey BlsPubKeyInfo) error {
+		if _, err := sdk.AccAddressFromBech32(pubKey.Operator); err != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid operator address for the bls public key %s: %s", pubKey.Operator, err,
+			)
+		}
+		if len(pubKey.PubKey) == 0 {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"empty bls public key for the operator %s", pubKey.Operator,
+			)
+		}
+		return nil
+	}
+	seenFieldValueFunc := func(pubKey BlsPubKeyInfo) (string, struct{}) {
+		return pubKey.Operator, struct{}{}
+	}
+	_, err := utils.CommonValidation(gs.BlsPubKeys, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+	}
+	return nil
+}
+
+// ValidateTaskResultInfos validates the task results submitted by the operators, and
+// returns the set of lower-cased joined keys for the results.
+func (gs GenesisState) ValidateTaskResultInfos(tasks map[string]struct{}) (map[string]struct{}, error) {
+	validationFunc := func(_ int, result TaskResultInfo) error {
+		if _, err := sdk.AccAddressFromBech32(result.OperatorAddress); err != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid operator address for the task result, %+v: %s", result, err,
+			)
+		}
+		taskKey := assetstypes.GetJoinedStoreKey(result.TaskContractAddress, strconv.FormatUint(result.TaskId, 10))
+		if _, ok := tasks[string(taskKey)]; !ok {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"unknown task for the task result, %+v", result,
+			)
+		}
+		if result.Stage != TwoPhaseCommitOne && result.Stage != TwoPhaseCommitTwo {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid stage for the task result, %+v", result,
+			)
+		}
+		return nil
+	}
+	seenFieldValueFunc := func(result TaskResultInfo) (string, struct{}) {
+		key := assetstypes.GetJoinedStoreKey(
+			result.OperatorAddress, result.TaskContractAddress, strconv.FormatUint(result.TaskId, 10),
+		)
+		return strings.ToLower(string(key)), struct{}{}
+	}
+	results, err := utils.CommonValidation(gs.TaskResultInfos, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+	}
+	return results, nil
+}
+
+// ValidateChallengeInfos validates the challenges raised against the task results.
+func (gs GenesisState) ValidateChallengeInfos(results map[string]struct{}) error {
+	validationFunc := func(_ int, challenge ChallengeInfo) error {
+		if _, err := assetstypes.ParseJoinedStoreKey([]byte(challenge.Key), 3); err != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"ValidateChallengeInfos can't parse the joined key: %s", err,
+			)
+		}
+		// a challenge can only be raised against an existing task result. the task address
+		// in the challenge key is checksummed, so the comparison is case-insensitive.
+		if _, ok := results[strings.ToLower(challenge.Key)]; !ok {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"unknown task result for the challenge, %+v", challenge,
+			)
+		}
+		if _, err := sdk.AccAddressFromBech32(challenge.ChallengeAddr); err != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid challenger address, %+v: %s", challenge, err,
+			)
+		}
+		return nil
+	}
+	seenFieldValueFunc := func(challenge ChallengeInfo) (string, struct{}) {
+		return challenge.Key, struct{}{}
+	}
+	_, err := utils.CommonValidation(gs.ChallengeInfos, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+	}
+	return nil
+}
+
+// ValidateTaskNums validates the latest task IDs, which must not be smaller than the
+// ID of any task created by the same task contract.
+func (gs GenesisState) ValidateTaskNums(maxTaskIDs map[string]uint64) error {
+	validationFunc := func(_ int, taskNum TaskID) error {
+		if !common.IsHexAddress(taskNum.TaskAddr) {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"the task address isn't an ethereum hex address, %+v", taskNum,
+			)
+		}
+		if taskNum.TaskID < maxTaskIDs[common.HexToAddress(taskNum.TaskAddr).String()] {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"the latest task ID is smaller than the ID of an existing task, %+v", taskNum,
+			)
+		}
+		return nil
+	}
+	seenFieldValueFunc := func(taskNum TaskID) (string, struct{}) {
+		return common.HexToAddress(taskNum.TaskAddr).String(), struct{}{}
+	}
+	taskNums, err := utils.CommonValidation(gs.TaskNums, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+	}
+	// every task contract with tasks must have its latest task ID recorded, otherwise
+	// the IDs would be reused after the import.
+	for taskAddr := range maxTaskIDs {
+		if _, ok := taskNums[taskAddr]; !ok {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"missing the latest task ID for the task address %s", taskAddr,
+			)
+		}
+	}
+	return nil
+}
+
+// ValidateChainIDInfos validates the reverse lookups from the AVS address to the chainID.
+// The AVS isn't required to be registered, since the lookup is retained after the AVS
+// is deregistered.
+func (gs GenesisState) ValidateChainIDInfos() error {
+	validationFunc := func(_ int, info ChainIDInfo) error {
+		if !common.IsHexAddress(info.AvsAddress) {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"the AVS address isn't an ethereum hex address, %+v", info,
+			)
+		}
+		if info.ChainID == "" || info.ChainID != ChainIDWithoutRevision(info.ChainID) {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"the chainID should be non-empty and without the revision, %+v", info,
+			)
+		}
+		avsAddr := common.HexToAddress(info.AvsAddress).String()
+		if common.HexToAddress(GenerateAVSAddr(info.ChainID)).String() != avsAddr {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"the AVS address doesn't match the one generated from the chainID, %+v", info,
+			)
+		}
+		return nil
+	}
+	seenFieldValueFunc := func(info ChainIDInfo) (string, struct{}) {
+		return common.HexToAddress(info.AvsAddress).String(), struct{}{}
+	}
+	_, err := utils.CommonValidation(gs.ChainIDInfos, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+	}
+	return nil
+}
+
+// ValidateTaskStatisticsRetries validates the tasks waiting for the retry of the result
+// statistics or the settlement, the tasks should exist and be either pending or finalized.
+func (gs GenesisState) ValidateTaskStatisticsRetries(tasks map[string]struct{}) error {
+	taskStatus := make(map[string]TaskStatus, len(gs.TaskInfos))
+	for _, task := range gs.TaskInfos {
+		key := assetstypes.GetJoinedStoreKey(task.TaskContractAddress, strconv.FormatUint(task.TaskId, 10))
+		taskStatus[string(key)] = task.Status
+	}
+	validationFunc := func(_ int, retry TaskStatisticsRetry) error {
+		key := string(assetstypes.GetJoinedStoreKey(retry.TaskContractAddress, strconv.FormatUint(retry.TaskID, 10)))
+		if _, ok := tasks[key]; !ok {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"the task of the statistics retry doesn't exist, task address: %s, task ID: %d",
+				retry.TaskContractAddress, retry.TaskID,
+			)
+		}
+		if taskStatus[key] != TaskStatusPending && taskStatus[key] != TaskStatusFinalized {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"the task of the retry is neither pending nor finalized, task address: %s, task ID: %d",
+				retry.TaskContractAddress, retry.TaskID,
+			)
+		}
+		if retry.RetryCount >= MaxTaskStatisticsRetries {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"the retry count %d of the task statistics exceeds the limit, task address: %s, task ID: %d",
+				retry.RetryCount, retry.TaskContractAddress, retry.TaskID,
+			)
+		}
+		return nil
+	}
+	seenFieldValueFunc := func(retry TaskStatisticsRetry) (string, struct{}) {
+		key := assetstypes.GetJoinedStoreKey(retry.TaskContractAddress, strconv.FormatUint(retry.TaskID, 10))
+		return string(key), struct{}{}
+	}
+	_, err := utils.CommonValidation(gs.TaskStatisticsRetries, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+	}
+	return nil
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	// this line is used by starport scaffolding # genesis/types/validate
-
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if err := gs.ValidateAVSInfos(); err != nil {
+		return err
+	}
+	tasks, maxTaskIDs, err := gs.ValidateTaskInfos()
+	if err != nil {
+		return err
+	}
+	if err := gs.ValidateBlsPubKeys(); err != nil {
+		return err
+	}
+	results, err := gs.ValidateTaskResultInfos(tasks)
+	if err != nil {
+		return err
+	}
+	if err := gs.ValidateChallengeInfos(results); err != nil {
+		return err
+	}
+	if err := gs.ValidateTaskNums(maxTaskIDs); err != nil {
+		return err
+	}
+	if err := gs.ValidateChainIDInfos(); err != nil {
+		return err
+	}
+	return gs.ValidateTaskStatisticsRetries(tasks)
 }
@@ -1,5 +1,5 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: exocore/avs/genesis.proto
+// source: exocore/avs/v1/genesis.proto
 
 package types
 
@@ -25,14 +25,40 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the avs module's genesis state.
 type GenesisState struct {
+	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// avs_infos is a list of all the registered AVSs.
+	// it's corresponding to the kvStore `KeyPrefixAVSInfo`
+	AVSInfos []AVSInfo `protobuf:"bytes,2,rep,name=avs_infos,json=avsInfos,proto3" json:"avs_infos"`
+	// task_infos is a list of all the tasks created by the AVSs.
+	// it's corresponding to the kvStore `KeyPrefixAVSTaskInfo`
+	TaskInfos []TaskInfo `protobuf:"bytes,3,rep,name=task_infos,json=taskInfos,proto3" json:"task_infos"`
+	// bls_pub_keys is a list of the BLS public keys registered by the operators.
+	// it's corresponding to the kvStore `KeyPrefixOperatePub`
+	BlsPubKeys []BlsPubKeyInfo `protobuf:"bytes,4,rep,name=bls_pub_keys,json=blsPubKeys,proto3" json:"bls_pub_keys"`
+	// task_result_infos is a list of the task results submitted by the operators.
+	// it's corresponding to the kvStore `KeyPrefixTaskResult`
+	TaskResultInfos []TaskResultInfo `protobuf:"bytes,5,rep,name=task_result_infos,json=taskResultInfos,proto3" json:"task_result_infos"`
+	// challenge_infos is a list of the challenges raised against the task results.
+	// it's corresponding to the kvStore `KeyPrefixTaskChallengeResult`
+	ChallengeInfos []ChallengeInfo `protobuf:"bytes,6,rep,name=challenge_infos,json=challengeInfos,proto3" json:"challenge_infos"`
+	// task_nums is a list of the latest task IDs for each task contract.
+	// it's corresponding to the kvStore `KeyPrefixLatestTaskNum`
+	TaskNums []TaskID `protobuf:"bytes,7,rep,name=task_nums,json=taskNums,proto3" json:"task_nums"`
+	// chain_id_infos is a list of the reverse lookups from AVS address to chainID.
+	// it's corresponding to the kvStore `KeyPrefixAVSAddressToChainID`
+	ChainIDInfos []ChainIDInfo `protobuf:"bytes,8,rep,name=chain_id_infos,json=chainIdInfos,proto3" json:"chain_id_infos"`
+	// task_statistics_retries is a list of the tasks whose result statistics or settlement
+	// are waiting for a retry.
+	// it's corresponding to the kvStore `KeyPrefixTaskStatisticsRetry`
+	TaskStatisticsRetries []TaskStatisticsRetry `protobuf:"bytes,9,rep,name=task_statistics_retries,json=taskStatisticsRetries,proto3" json:"task_statistics_retries"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6f7a9069172c0bef, []int{0}
+	return fileDescriptor_cc32a0542b70c3d1, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -68,26 +94,276 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetAVSInfos() []AVSInfo {
+	if m != nil {
+		return m.AVSInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTaskInfos() []TaskInfo {
+	if m != nil {
+		return m.TaskInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlsPubKeys() []BlsPubKeyInfo {
+	if m != nil {
+		return m.BlsPubKeys
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTaskResultInfos() []TaskResultInfo {
+	if m != nil {
+		return m.TaskResultInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChallengeInfos() []ChallengeInfo {
+	if m != nil {
+		return m.ChallengeInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTaskNums() []TaskID {
+	if m != nil {
+		return m.TaskNums
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChainIDInfos() []ChainIDInfo {
+	if m != nil {
+		return m.ChainIDInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTaskStatisticsRetries() []TaskStatisticsRetry {
+	if m != nil {
+		return m.TaskStatisticsRetries
+	}
+	return nil
+}
+
+// ChallengeInfo is helper structure to store the challenge information for the genesis state.
+type ChallengeInfo struct {
+	// key is used for storing the challenge information,
+	// which is the combination of the operator address, task contract address, and task ID.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// challenge_addr is the bech32 address of the challenger.
+	ChallengeAddr string `protobuf:"bytes,2,opt,name=challenge_addr,json=challengeAddr,proto3" json:"challenge_addr,omitempty"`
+}
+
+func (m *ChallengeInfo) Reset()         { *m = ChallengeInfo{} }
+func (m *ChallengeInfo) String() string { return proto.CompactTextString(m) }
+func (*ChallengeInfo) ProtoMessage()    {}
+func (*ChallengeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32a0542b70c3d1, []int{1}
+}
+func (m *ChallengeInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChallengeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChallengeInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChallengeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChallengeInfo.Merge(m, src)
+}
+func (m *ChallengeInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChallengeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChallengeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChallengeInfo proto.InternalMessageInfo
+
+func (m *ChallengeInfo) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ChallengeInfo) GetChallengeAddr() string {
+	if m != nil {
+		return m.ChallengeAddr
+	}
+	return ""
+}
+
+// TaskID is helper structure to store the latest task ID of a task contract for the genesis state.
+type TaskID struct {
+	// task_addr is the hex address of the task contract.
+	TaskAddr string `protobuf:"bytes,1,opt,name=task_addr,json=taskAddr,proto3" json:"task_addr,omitempty"`
+	// task_id is the latest task ID assigned to the task contract.
+	TaskID uint64 `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+}
+
+func (m *TaskID) Reset()         { *m = TaskID{} }
+func (m *TaskID) String() string { return proto.CompactTextString(m) }
+func (*TaskID) ProtoMessage()    {}
+func (*TaskID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32a0542b70c3d1, []int{2}
+}
+func (m *TaskID) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskID.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskID.Merge(m, src)
+}
+func (m *TaskID) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskID) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskID proto.InternalMessageInfo
+
+func (m *TaskID) GetTaskAddr() string {
+	if m != nil {
+		return m.TaskAddr
+	}
+	return ""
+}
+
+func (m *TaskID) GetTaskID() uint64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+// ChainIDInfo is helper structure to store the AVS address to chainID mapping for the genesis state.
+type ChainIDInfo struct {
+	// avs_address is the hex address of the AVS.
+	AvsAddress string `protobuf:"bytes,1,opt,name=avs_address,json=avsAddress,proto3" json:"avs_address,omitempty"`
+	// chain_id is the chainID (without the revision) of the AVS.
+	ChainID string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *ChainIDInfo) Reset()         { *m = ChainIDInfo{} }
+func (m *ChainIDInfo) String() string { return proto.CompactTextString(m) }
+func (*ChainIDInfo) ProtoMessage()    {}
+func (*ChainIDInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32a0542b70c3d1, []int{3}
+}
+func (m *ChainIDInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainIDInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainIDInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainIDInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainIDInfo.Merge(m, src)
+}
+func (m *ChainIDInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainIDInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainIDInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainIDInfo proto.InternalMessageInfo
+
+func (m *ChainIDInfo) GetAvsAddress() string {
+	if m != nil {
+		return m.AvsAddress
+	}
+	return ""
+}
+
+func (m *ChainIDInfo) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
 func init() {
-	proto.RegisterType((*GenesisState)(nil), "exocore.avs.GenesisState")
+	proto.RegisterType((*GenesisState)(nil), "exocore.avs.v1.GenesisState")
+	proto.RegisterType((*ChallengeInfo)(nil), "exocore.avs.v1.ChallengeInfo")
+	proto.RegisterType((*TaskID)(nil), "exocore.avs.v1.TaskID")
+	proto.RegisterType((*ChainIDInfo)(nil), "exocore.avs.v1.ChainIDInfo")
 }
 
-func init() { proto.RegisterFile("exocore/avs/genesis.proto", fileDescriptor_6f7a9069172c0bef) }
+func init() { proto.RegisterFile("exocore/avs/v1/genesis.proto", fileDescriptor_cc32a0542b70c3d1) }
 
-var fileDescriptor_6f7a9069172c0bef = []byte{
-	// 192 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4c, 0xad, 0xc8, 0x4f,
-	0xce, 0x2f, 0x4a, 0xd5, 0x4f, 0x2c, 0x2b, 0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x86, 0x4a, 0xe9, 0x25, 0x96, 0x15, 0x4b, 0x89, 0xa4,
-	0xe7, 0xa7, 0xe7, 0x83, 0xc5, 0xf5, 0x41, 0x2c, 0x88, 0x12, 0x29, 0x09, 0x64, 0xdd, 0x05, 0x89,
-	0x45, 0x89, 0xb9, 0x50, 0xcd, 0x4a, 0x8e, 0x5c, 0x3c, 0xee, 0x10, 0xd3, 0x82, 0x4b, 0x12, 0x4b,
-	0x52, 0x85, 0x0c, 0xb9, 0xd8, 0x20, 0xf2, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xc2, 0x7a,
-	0x48, 0xa6, 0xeb, 0x05, 0x80, 0xa5, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0x2a, 0x74,
-	0x72, 0x3f, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c,
-	0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xdd, 0xf4, 0xcc, 0x92,
-	0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x57, 0x88, 0x31, 0x7e, 0xa9, 0x25, 0xe5, 0xf9,
-	0x45, 0xd9, 0xfa, 0x30, 0x07, 0x55, 0x80, 0x9d, 0x54, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06,
-	0x76, 0x92, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x79, 0xb0, 0x7f, 0x59, 0xec, 0x00, 0x00, 0x00,
+var fileDescriptor_cc32a0542b70c3d1 = []byte{
+	// 587 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xcd, 0x6e, 0xda, 0x4e,
+	0x14, 0xc5, 0x21, 0x24, 0x7c, 0x0c, 0xe4, 0xe3, 0x3f, 0xca, 0xbf, 0xb1, 0x42, 0x6b, 0x10, 0x51,
+	0xab, 0x6c, 0x6a, 0x2b, 0x69, 0x37, 0x5d, 0x74, 0x81, 0x9b, 0x34, 0xa5, 0xad, 0x22, 0x64, 0xaa,
+	0x54, 0xea, 0xc6, 0x1a, 0xe3, 0x09, 0xb1, 0x00, 0x1b, 0xf9, 0x8e, 0x5d, 0x78, 0x8b, 0xbe, 0x46,
+	0xdf, 0x24, 0xcb, 0x2c, 0xbb, 0x42, 0x95, 0x79, 0x91, 0xca, 0xd7, 0x43, 0x6a, 0x28, 0xbb, 0xe1,
+	0xde, 0x73, 0x7f, 0x73, 0x38, 0xe3, 0x4b, 0x9e, 0xf2, 0xa9, 0xdf, 0xf7, 0x03, 0xae, 0xb3, 0x08,
+	0xf4, 0xe8, 0x4c, 0x1f, 0x70, 0x8f, 0x83, 0x0b, 0xda, 0x24, 0xf0, 0x85, 0x4f, 0xf7, 0x64, 0x57,
+	0x63, 0x11, 0x68, 0xd1, 0xd9, 0xf1, 0xe1, 0xc0, 0x1f, 0xf8, 0xd8, 0xd2, 0x93, 0x53, 0xaa, 0x3a,
+	0xae, 0xaf, 0x31, 0x26, 0x2c, 0x60, 0x63, 0x89, 0x38, 0x3e, 0x5a, 0x6b, 0x8a, 0x69, 0xda, 0x68,
+	0xfd, 0xdc, 0x21, 0xb5, 0xab, 0xf4, 0xb6, 0x9e, 0x60, 0x82, 0xd3, 0xd7, 0xa4, 0x98, 0x4e, 0x2a,
+	0xf9, 0x66, 0xfe, 0xb4, 0x7a, 0xfe, 0x44, 0x5b, 0xbd, 0x5d, 0xeb, 0x62, 0xd7, 0xd8, 0xbe, 0x9f,
+	0x37, 0x72, 0xa6, 0xd4, 0xd2, 0xf7, 0xa4, 0xc2, 0x22, 0xb0, 0x5c, 0xef, 0xd6, 0x07, 0x65, 0xab,
+	0x59, 0x38, 0xad, 0x9e, 0x1f, 0xad, 0x0f, 0xb6, 0x6f, 0x7a, 0x1d, 0xef, 0xd6, 0x37, 0x0e, 0x92,
+	0xc9, 0x78, 0xde, 0x28, 0xcb, 0x02, 0x98, 0x65, 0x16, 0x01, 0x9e, 0xe8, 0x5b, 0x42, 0x04, 0x83,
+	0xa1, 0x04, 0x15, 0x10, 0xa4, 0xac, 0x83, 0xbe, 0x30, 0x18, 0x22, 0x29, 0xf5, 0x50, 0x11, 0xf2,
+	0x37, 0xd0, 0x4b, 0x52, 0xb3, 0x47, 0x60, 0x4d, 0x42, 0xdb, 0x1a, 0xf2, 0x19, 0x28, 0xdb, 0x08,
+	0x78, 0xb6, 0x0e, 0x30, 0x46, 0xd0, 0x0d, 0xed, 0x4f, 0x7c, 0x96, 0xa1, 0x10, 0x7b, 0x59, 0x04,
+	0xda, 0x25, 0xff, 0xa1, 0x8b, 0x80, 0x43, 0x38, 0x12, 0xd2, 0xcc, 0x0e, 0xb2, 0xd4, 0x4d, 0x66,
+	0x4c, 0xd4, 0x65, 0x60, 0xfb, 0x62, 0xa5, 0x0a, 0xf4, 0x33, 0xd9, 0xef, 0xdf, 0xb1, 0xd1, 0x88,
+	0x7b, 0x03, 0x2e, 0x79, 0xc5, 0xcd, 0xde, 0xde, 0x2d, 0x65, 0x19, 0xdc, 0x5e, 0x3f, 0x5b, 0x04,
+	0xfa, 0x86, 0xe0, 0x7f, 0xb6, 0xbc, 0x70, 0x0c, 0x4a, 0xa9, 0x59, 0xd8, 0xf4, 0x4c, 0x18, 0xd2,
+	0x85, 0x04, 0x94, 0x13, 0xf9, 0x75, 0x38, 0x06, 0xfa, 0x95, 0x24, 0x30, 0xd7, 0xb3, 0x5c, 0x47,
+	0xfa, 0x28, 0xe3, 0x7c, 0x7d, 0x83, 0x0f, 0xd7, 0xeb, 0x5c, 0xa0, 0x8b, 0x43, 0xf9, 0x62, 0xb5,
+	0x4c, 0x11, 0xcc, 0x1a, 0x82, 0x3a, 0x4e, 0xea, 0x89, 0x91, 0x23, 0xf4, 0x04, 0x82, 0x09, 0x17,
+	0x84, 0xdb, 0x07, 0x2b, 0xe0, 0x22, 0x70, 0x39, 0x28, 0x15, 0xbc, 0xe1, 0x64, 0x93, 0xc3, 0xde,
+	0xa3, 0xda, 0xe4, 0x22, 0x98, 0x49, 0xbb, 0xff, 0x8b, 0x7f, 0x5a, 0x2e, 0x87, 0xd6, 0x07, 0xb2,
+	0xbb, 0x92, 0x0e, 0x3d, 0x20, 0x85, 0x21, 0x9f, 0xe1, 0x87, 0x5a, 0x31, 0x93, 0x23, 0x7d, 0x4e,
+	0xfe, 0x66, 0x65, 0x31, 0xc7, 0x09, 0x94, 0x2d, 0x6c, 0xee, 0x3e, 0x56, 0xdb, 0x8e, 0x13, 0xb4,
+	0x3e, 0x92, 0x62, 0x9a, 0x0f, 0xad, 0xcb, 0x28, 0x51, 0x9b, 0x82, 0x30, 0xac, 0x44, 0x46, 0x4f,
+	0x48, 0x09, 0x9b, 0xae, 0x83, 0x98, 0x6d, 0x83, 0xc4, 0xf3, 0x86, 0x9c, 0x34, 0x8b, 0xf8, 0xd9,
+	0x39, 0xad, 0x1b, 0x52, 0xcd, 0xc4, 0x42, 0x1b, 0xa4, 0x9a, 0x6c, 0x42, 0xc2, 0xe3, 0x00, 0x12,
+	0x49, 0x58, 0x04, 0xed, 0xb4, 0x42, 0x5f, 0x90, 0xf2, 0xf2, 0x05, 0x52, 0x73, 0x46, 0x35, 0x9e,
+	0x37, 0x4a, 0x92, 0x61, 0x96, 0x64, 0xaa, 0xc6, 0xd5, 0x7d, 0xac, 0xe6, 0x1f, 0x62, 0x35, 0xff,
+	0x3b, 0x56, 0xf3, 0x3f, 0x16, 0x6a, 0xee, 0x61, 0xa1, 0xe6, 0x7e, 0x2d, 0xd4, 0xdc, 0xb7, 0x97,
+	0x03, 0x57, 0xdc, 0x85, 0xb6, 0xd6, 0xf7, 0xc7, 0xfa, 0x65, 0x9a, 0xe9, 0x35, 0x17, 0xdf, 0xfd,
+	0x60, 0xa8, 0x2f, 0xd7, 0x7c, 0x8a, 0x8b, 0x2e, 0x66, 0x13, 0x0e, 0x76, 0x11, 0x37, 0xfd, 0xd5,
+	0x9f, 0x01, 0x00, 0x04, 0xf1, 0x99, 0x57, 0x65, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -110,6 +386,118 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TaskStatisticsRetries) > 0 {
+		for iNdEx := len(m.TaskStatisticsRetries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskStatisticsRetries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ChainIDInfos) > 0 {
+		for iNdEx := len(m.ChainIDInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainIDInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.TaskNums) > 0 {
+		for iNdEx := len(m.TaskNums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskNums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ChallengeInfos) > 0 {
+		for iNdEx := len(m.ChallengeInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChallengeInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TaskResultInfos) > 0 {
+		for iNdEx := len(m.TaskResultInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskResultInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BlsPubKeys) > 0 {
+		for iNdEx := len(m.BlsPubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlsPubKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TaskInfos) > 0 {
+		for iNdEx := len(m.TaskInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AVSInfos) > 0 {
+		for iNdEx := len(m.AVSInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AVSInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -123,6 +511,115 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChallengeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChallengeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChallengeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChallengeAddr) > 0 {
+		i -= len(m.ChallengeAddr)
+		copy(dAtA[i:], m.ChallengeAddr)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChallengeAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TaskID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TaskID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TaskAddr) > 0 {
+		i -= len(m.TaskAddr)
+		copy(dAtA[i:], m.TaskAddr)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TaskAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainIDInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainIDInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainIDInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AvsAddress) > 0 {
+		i -= len(m.AvsAddress)
+		copy(dAtA[i:], m.AvsAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AvsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -142,17 +639,115 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AVSInfos) > 0 {
+		for _, e := range m.AVSInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TaskInfos) > 0 {
+		for _, e := range m.TaskInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlsPubKeys) > 0 {
+		for _, e := range m.BlsPubKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TaskResultInfos) > 0 {
+		for _, e := range m.TaskResultInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChallengeInfos) > 0 {
+		for _, e := range m.ChallengeInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TaskNums) > 0 {
+		for _, e := range m.TaskNums {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChainIDInfos) > 0 {
+		for _, e := range m.ChainIDInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TaskStatisticsRetries) > 0 {
+		for _, e := range m.TaskStatisticsRetries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *ChallengeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChallengeAddr)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+
+func (m *TaskID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskAddr)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TaskID != 0 {
+		n += 1 + sovGenesis(uint64(m.TaskID))
+	}
+	return n
+}
+
+func (m *ChainIDInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AvsAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -213,6 +808,607 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AVSInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AVSInfos = append(m.AVSInfos, AVSInfo{})
+			if err := m.AVSInfos[len(m.AVSInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskInfos = append(m.TaskInfos, TaskInfo{})
+			if err := m.TaskInfos[len(m.TaskInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsPubKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsPubKeys = append(m.BlsPubKeys, BlsPubKeyInfo{})
+			if err := m.BlsPubKeys[len(m.BlsPubKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskResultInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskResultInfos = append(m.TaskResultInfos, TaskResultInfo{})
+			if err := m.TaskResultInfos[len(m.TaskResultInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengeInfos = append(m.ChallengeInfos, ChallengeInfo{})
+			if err := m.ChallengeInfos[len(m.ChallengeInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskNums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskNums = append(m.TaskNums, TaskID{})
+			if err := m.TaskNums[len(m.TaskNums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIDInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainIDInfos = append(m.ChainIDInfos, ChainIDInfo{})
+			if err := m.ChainIDInfos[len(m.ChainIDInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskStatisticsRetries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskStatisticsRetries = append(m.TaskStatisticsRetries, TaskStatisticsRetry{})
+			if err := m.TaskStatisticsRetries[len(m.TaskStatisticsRetries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChallengeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChallengeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChallengeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskID", wireType)
+			}
+			m.TaskID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainIDInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainIDInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainIDInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
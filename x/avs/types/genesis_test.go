@@ -1,16 +1,63 @@
 package types_test
 
 import (
+	"strconv"
 	"testing"
 
+	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
 	"github.com/ExocoreNetwork/exocore/x/avs/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	avsAddr := utiltx.GenerateAddress().String()
+	taskAddr := utiltx.GenerateAddress().String()
+	operator := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+	challenger := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+	chainID := "exocoretestnet_233"
+
+	avsInfo := types.AVSInfo{
+		Name:            "avs",
+		AvsAddress:      avsAddr,
+		TaskAddr:        taskAddr,
+		AvsOwnerAddress: []string{operator},
+		EpochIdentifier: "day",
+		AvsSlash:        sdk.MustNewDecFromStr("0.01"),
+		AvsReward:       sdk.MustNewDecFromStr("0.02"),
+	}
+	taskInfo := types.TaskInfo{
+		TaskContractAddress: taskAddr,
+		Name:                "task",
+		TaskId:              1,
+		TaskTotalPower:      sdk.ZeroDec(),
+	}
+	taskResult := types.TaskResultInfo{
+		OperatorAddress:     operator,
+		TaskContractAddress: taskAddr,
+		TaskId:              1,
+		Stage:               types.TwoPhaseCommitTwo,
+	}
+	challengeKey := string(assetstypes.GetJoinedStoreKey(operator, taskAddr, strconv.FormatUint(1, 10)))
+	validGenesis := func() *types.GenesisState {
+		return types.NewGenesisState(
+			types.DefaultParams(),
+			[]types.AVSInfo{avsInfo},
+			[]types.TaskInfo{taskInfo},
+			[]types.BlsPubKeyInfo{{Operator: operator, Name: "key", PubKey: []byte{1}}},
+			[]types.TaskResultInfo{taskResult},
+			[]types.ChallengeInfo{{Key: challengeKey, ChallengeAddr: challenger}},
+			[]types.TaskID{{TaskAddr: taskAddr, TaskID: 1}},
+			[]types.ChainIDInfo{{AvsAddress: types.GenerateAVSAddr(chainID), ChainID: chainID}},
+			[]types.TaskStatisticsRetry{{TaskContractAddress: taskAddr, TaskID: 1, RetryCount: 1}},
+		)
+	}
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
+		malleate func(*types.GenesisState)
 		valid    bool
 	}{
 		{
@@ -26,10 +73,140 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc:     "valid genesis state with all the stores",
+			genState: validGenesis(),
+			valid:    true,
+		},
+		{
+			desc:     "invalid AVS address",
+			genState: validGenesis(),
+			malleate: func(gs *types.GenesisState) {
+				gs.AVSInfos[0].AvsAddress = "invalid"
+			},
+			valid: false,
+		},
+		{
+			desc:     "duplicate AVS",
+			genState: validGenesis(),
+			malleate: func(gs *types.GenesisState) {
+				gs.AVSInfos = append(gs.AVSInfos, gs.AVSInfos[0])
+			},
+			valid: false,
+		},
+		{
+			desc:     "task address used by two AVSs",
+			genState: validGenesis(),
+			malleate: func(gs *types.GenesisState) {
+				other := avsInfo
+				other.AvsAddress = utiltx.GenerateAddress().String()
+				gs.AVSInfos = append(gs.AVSInfos, other)
+			},
+			valid: false,
+		},
+		{
+			desc:     "nil slash proportion",
+			genState: validGenesis(),
+			malleate: func(gs *types.GenesisState) {
+				gs.AVSInfos[0].AvsSlash = sdk.Dec{}
+			},
+			valid: false,
+		},
+		{
+			desc:     "duplicate task",
+			genState: validGenesis(),
+			malleate: func(gs *types.GenesisState) {
+				gs.TaskInfos = append(gs.TaskInfos, gs.TaskInfos[0])
+			},
+			valid: false,
+		},
+		{
+			desc:     "empty bls public key",
+			genState: validGenesis(),
+			malleate: func(gs *types.GenesisState) {
+				gs.BlsPubKeys[0].PubKey = nil
+			},
+			valid: false,
+		},
+		{
+			desc:     "task result for an unknown task",
+			genState: validGenesis(),
+			malleate: func(gs *types.GenesisState) {
+				gs.TaskResultInfos[0].TaskId = 2
+			},
+			valid: false,
+		},
+		{
+			desc:     "task result with an invalid stage",
+			genState: validGenesis(),
+			malleate: func(gs *types.GenesisState) {
+				gs.TaskResultInfos[0].Stage = "3"
+			},
+			valid: false,
+		},
+		{
+			desc:     "challenge for an unknown task result",
+			genState: validGenesis(),
+			malleate: func(gs *types.GenesisState) {
+				gs.ChallengeInfos[0].Key = string(assetstypes.GetJoinedStoreKey(challenger, taskAddr, "1"))
+			},
+			valid: false,
+		},
+		{
+			desc:     "latest task ID smaller than an existing task",
+			genState: validGenesis(),
+			malleate: func(gs *types.GenesisState) {
+				gs.TaskNums[0].TaskID = 0
+			},
+			valid: false,
+		},
+		{
+			desc:     "missing latest task ID",
+			genState: validGenesis(),
+			malleate: func(gs *types.GenesisState) {
+				gs.TaskNums = nil
+			},
+			valid: false,
+		},
+		{
+			desc:     "AVS address mismatched with the chainID",
+			genState: validGenesis(),
+			malleate: func(gs *types.GenesisState) {
+				gs.ChainIDInfos[0].AvsAddress = avsAddr
+			},
+			valid: false,
+		},
+		{
+			desc:     "statistics retry for an unknown task",
+			genState: validGenesis(),
+			malleate: func(gs *types.GenesisState) {
+				gs.TaskStatisticsRetries[0].TaskID = 2
+			},
+			valid: false,
+		},
+		{
+			desc:     "settlement retry for a finalized task",
+			genState: validGenesis(),
+			malleate: func(gs *types.GenesisState) {
+				gs.TaskInfos[0].Status = types.TaskStatusFinalized
+			},
+			valid: true,
+		},
+		{
+			desc:     "retry for a settled task",
+			genState: validGenesis(),
+			malleate: func(gs *types.GenesisState) {
+				gs.TaskInfos[0].Status = types.TaskStatusSettled
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
+			if tc.malleate != nil {
+				tc.malleate(tc.genState)
+			}
 			err := tc.genState.Validate()
 			if tc.valid {
 				require.NoError(t, err)
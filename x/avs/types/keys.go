@@ -1,8 +1,12 @@
 package types
 
 import (
+	"strconv"
+
 	"github.com/ethereum/go-ethereum/common"
 
+	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -30,6 +34,9 @@ const (
 	LatestTaskNum
 	TaskResult
 	TaskChallengeResult
+	prefixTaskStatisticsRetry
+	prefixTaskChallengeEnd
+	prefixTaskResultByTask
 )
 
 // ModuleAddress is the native module address for EVM
@@ -45,8 +52,34 @@ var (
 	KeyPrefixLatestTaskNum       = []byte{LatestTaskNum}
 	KeyPrefixTaskResult          = []byte{TaskResult}
 	KeyPrefixTaskChallengeResult = []byte{TaskChallengeResult}
+	// KeyPrefixTaskStatisticsRetry is used to store the tasks whose result statistics or
+	// settlement are waiting for a retry, the key is taskContractAddress + '/' + taskID.
+	KeyPrefixTaskStatisticsRetry = []byte{prefixTaskStatisticsRetry}
+	// KeyPrefixTaskChallengeEnd is used to index the finalized tasks by the end of their
+	// challenge period, the key is epochIdentifier + '/' + epochNumber + taskContractAddress +
+	// '/' + taskID, and the value is the key of the task info.
+	KeyPrefixTaskChallengeEnd = []byte{prefixTaskChallengeEnd}
+	// KeyPrefixTaskResultByTask is used to index the task results by the task, the key is
+	// taskContractAddress + '/' + taskID + '/' + operatorAddress, and the value is the key
+	// of the task result.
+	KeyPrefixTaskResultByTask = []byte{prefixTaskResultByTask}
 )
 
+// GetTaskChallengeEndPrefix returns the prefix of the tasks whose challenge period ends with
+// the epoch, the epoch number is big-endian encoded to keep the keys ordered by the epochs.
+func GetTaskChallengeEndPrefix(epochIdentifier string, epochNumber int64) []byte {
+	// #nosec G115
+	return append(assetstypes.GetJoinedStoreKeyForPrefix(epochIdentifier), sdk.Uint64ToBigEndian(uint64(epochNumber))...)
+}
+
+// GetTaskChallengeEndKey returns the key to index the task by the end of its challenge period.
+func GetTaskChallengeEndKey(epochIdentifier string, epochNumber int64, taskAddr string, taskID uint64) []byte {
+	return append(
+		GetTaskChallengeEndPrefix(epochIdentifier, epochNumber),
+		assetstypes.GetJoinedStoreKey(taskAddr, strconv.FormatUint(taskID, 10))...,
+	)
+}
+
 func init() {
 	ModuleAddress = common.BytesToAddress(authtypes.NewModuleAddress(ModuleName).Bytes())
 }
@@ -33,6 +33,44 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TaskStatus is the status of the task result statistics.
+type TaskStatus int32
+
+const (
+	// TASK_STATUS_PENDING means the statistics of the task results haven't been finalized,
+	// it's the default status of a task.
+	TaskStatusPending TaskStatus = 0
+	// TASK_STATUS_FINALIZED means the statistics of the task results have been finalized.
+	TaskStatusFinalized TaskStatus = 1
+	// TASK_STATUS_FAILED means the statistics of the task results failed after all retries.
+	TaskStatusFailed TaskStatus = 2
+	// TASK_STATUS_SETTLED means the rewards and slashes of the task have been executed at the
+	// end of the challenge period.
+	TaskStatusSettled TaskStatus = 3
+)
+
+var TaskStatus_name = map[int32]string{
+	0: "TASK_STATUS_PENDING",
+	1: "TASK_STATUS_FINALIZED",
+	2: "TASK_STATUS_FAILED",
+	3: "TASK_STATUS_SETTLED",
+}
+
+var TaskStatus_value = map[string]int32{
+	"TASK_STATUS_PENDING":   0,
+	"TASK_STATUS_FINALIZED": 1,
+	"TASK_STATUS_FAILED":    2,
+	"TASK_STATUS_SETTLED":   3,
+}
+
+func (x TaskStatus) String() string {
+	return proto.EnumName(TaskStatus_name, int32(x))
+}
+
+func (TaskStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ef1ed06249b07d86, []int{0}
+}
+
 // AVSinfo represent the information of avs
 type AVSInfo struct {
 	// name of avs as an arbitrary string
@@ -71,6 +109,16 @@ type AVSInfo struct {
 	AvsSlash github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=avs_slash,json=avsSlash,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"avs_slash"`
 	// asset_reward_commission_epoch_basis is the avs reward distribution based on asset per eopch end.
 	AssetRewardAmountEpochBasis map[string]int64 `protobuf:"bytes,18,rep,name=asset_reward_amount_epoch_basis,json=assetRewardAmountEpochBasis,proto3" json:"asset_reward_amount_epoch_basis,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// veto_committee is the list of bech32 addresses designated by the AVS to veto its pending
+	// slashes. The owners of the AVS can't act as the veto committee.
+	VetoCommittee []string `protobuf:"bytes,19,rep,name=veto_committee,json=vetoCommittee,proto3" json:"veto_committee,omitempty"`
+	// max_task_challenge_period is the longest period, counted in epochs, from the statistics of
+	// a task of the AVS to its settlement. It includes the statistical period of the task, since
+	// the statistics can be computed at any time within it.
+	MaxTaskChallengePeriod uint64 `protobuf:"varint,20,opt,name=max_task_challenge_period,json=maxTaskChallengePeriod,proto3" json:"max_task_challenge_period,omitempty"`
+	// asset_reward_amount_task_basis is the avs reward distribution based on asset per settled task.
+	// it's paid to the operators signing the task, apart from the epoch reward.
+	AssetRewardAmountTaskBasis map[string]int64 `protobuf:"bytes,21,rep,name=asset_reward_amount_task_basis,json=assetRewardAmountTaskBasis,proto3" json:"asset_reward_amount_task_basis,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
 func (m *AVSInfo) Reset()         { *m = AVSInfo{} }
@@ -218,11 +266,32 @@ func (m *AVSInfo) GetAssetRewardAmountEpochBasis() map[string]int64 {
 	return nil
 }
 
+func (m *AVSInfo) GetVetoCommittee() []string {
+	if m != nil {
+		return m.VetoCommittee
+	}
+	return nil
+}
+
+func (m *AVSInfo) GetMaxTaskChallengePeriod() uint64 {
+	if m != nil {
+		return m.MaxTaskChallengePeriod
+	}
+	return 0
+}
+
+func (m *AVSInfo) GetAssetRewardAmountTaskBasis() map[string]int64 {
+	if m != nil {
+		return m.AssetRewardAmountTaskBasis
+	}
+	return nil
+}
+
 // Status and proof of each operator
 type OperatorStatus struct {
 	// operator address
 	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
-	// Status of the operator,(slash,reward,no)
+	//Status of the operator,(slash,reward,no)
 	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	// proof data which is supplied by the contract, usually ABI-encoded
 	ProofData []byte `protobuf:"bytes,3,opt,name=proof_data,json=proofData,proto3" json:"proof_data,omitempty"`
@@ -288,7 +357,7 @@ type RewardSlashProof struct {
 	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	// contract address of avstask
 	TaskContractAddress string `protobuf:"bytes,2,opt,name=task_contract_address,json=taskContractAddress,proto3" json:"task_contract_address,omitempty"`
-	// aggregator  address
+	//aggregator  address
 	Aggregator string `protobuf:"bytes,3,opt,name=aggregator,proto3" json:"aggregator,omitempty"`
 	// address of avs
 	AvsAddress string `protobuf:"bytes,4,opt,name=avs_address,json=avsAddress,proto3" json:"avs_address,omitempty"`
@@ -379,9 +448,9 @@ type TaskInfo struct {
 	// Statistical period: threshold calculation, signature verification,
 	// nosig quantity statistics, operator submits messages corresponding to signatures
 	TaskStatisticalPeriod uint64 `protobuf:"varint,6,opt,name=task_statistical_period,json=taskStatisticalPeriod,proto3" json:"task_statistical_period,omitempty"`
-	// challenge period for  task
+	//challenge period for  task
 	TaskChallengePeriod uint64 `protobuf:"varint,7,opt,name=task_challenge_period,json=taskChallengePeriod,proto3" json:"task_challenge_period,omitempty"`
-	// Signature threshold percentage
+	//Signature threshold percentage
 	ThresholdPercentage uint64 `protobuf:"varint,8,opt,name=threshold_percentage,json=thresholdPercentage,proto3" json:"threshold_percentage,omitempty"`
 	// Effective current epoch, accounting for current_epoch + 1
 	// and current_epoch is the integer identifier of the epoch module
@@ -400,6 +469,11 @@ type TaskInfo struct {
 	TaskTotalPower github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=task_total_power,json=taskTotalPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"task_total_power"`
 	// operator_active_power_list is a power list of operators opt-in to the current task
 	OperatorActivePower *OperatorActivePowerList `protobuf:"bytes,16,opt,name=operator_active_power,json=operatorActivePower,proto3" json:"operator_active_power,omitempty"`
+	// status is the status of the task result statistics.
+	Status TaskStatus `protobuf:"varint,17,opt,name=status,proto3,enum=exocore.avs.v1.TaskStatus" json:"status,omitempty"`
+	// statistics_height is the block height at which the task results are counted and the
+	// active power of the operators is recorded. The slashes of the task refer to this height.
+	StatisticsHeight int64 `protobuf:"varint,18,opt,name=statistics_height,json=statisticsHeight,proto3" json:"statistics_height,omitempty"`
 }
 
 func (m *TaskInfo) Reset()         { *m = TaskInfo{} }
@@ -540,6 +614,95 @@ func (m *TaskInfo) GetOperatorActivePower() *OperatorActivePowerList {
 	return nil
 }
 
+func (m *TaskInfo) GetStatus() TaskStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TaskStatusPending
+}
+
+func (m *TaskInfo) GetStatisticsHeight() int64 {
+	if m != nil {
+		return m.StatisticsHeight
+	}
+	return 0
+}
+
+// TaskStatisticsRetry is a task whose result statistics failed at the end of its statistical
+// period, or whose settlement failed at the end of its challenge period. It's retried at the
+// end of the following epochs of the AVS.
+type TaskStatisticsRetry struct {
+	// task_contract_address is the hex address of the task contract.
+	TaskContractAddress string `protobuf:"bytes,1,opt,name=task_contract_address,json=taskContractAddress,proto3" json:"task_contract_address,omitempty"`
+	// task_id is the ID of the task.
+	TaskID uint64 `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// retry_count is the number of the failed retries.
+	RetryCount uint32 `protobuf:"varint,3,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	// last_error is the error message of the last failure.
+	LastError string `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (m *TaskStatisticsRetry) Reset()         { *m = TaskStatisticsRetry{} }
+func (m *TaskStatisticsRetry) String() string { return proto.CompactTextString(m) }
+func (*TaskStatisticsRetry) ProtoMessage()    {}
+func (*TaskStatisticsRetry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef1ed06249b07d86, []int{4}
+}
+func (m *TaskStatisticsRetry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskStatisticsRetry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskStatisticsRetry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskStatisticsRetry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskStatisticsRetry.Merge(m, src)
+}
+func (m *TaskStatisticsRetry) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskStatisticsRetry) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskStatisticsRetry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskStatisticsRetry proto.InternalMessageInfo
+
+func (m *TaskStatisticsRetry) GetTaskContractAddress() string {
+	if m != nil {
+		return m.TaskContractAddress
+	}
+	return ""
+}
+
+func (m *TaskStatisticsRetry) GetTaskID() uint64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+func (m *TaskStatisticsRetry) GetRetryCount() uint32 {
+	if m != nil {
+		return m.RetryCount
+	}
+	return 0
+}
+
+func (m *TaskStatisticsRetry) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
 // OperatorActivePowerList is the power list of operators opt-in to the current task.
 // Because power is always changing, record the power of all operators
 // who have completed tasks and submitted results by the task deadline
@@ -552,7 +715,7 @@ func (m *OperatorActivePowerList) Reset()         { *m = OperatorActivePowerList
 func (m *OperatorActivePowerList) String() string { return proto.CompactTextString(m) }
 func (*OperatorActivePowerList) ProtoMessage()    {}
 func (*OperatorActivePowerList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef1ed06249b07d86, []int{4}
+	return fileDescriptor_ef1ed06249b07d86, []int{5}
 }
 func (m *OperatorActivePowerList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -600,7 +763,7 @@ func (m *OperatorActivePowerInfo) Reset()         { *m = OperatorActivePowerInfo
 func (m *OperatorActivePowerInfo) String() string { return proto.CompactTextString(m) }
 func (*OperatorActivePowerInfo) ProtoMessage()    {}
 func (*OperatorActivePowerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef1ed06249b07d86, []int{5}
+	return fileDescriptor_ef1ed06249b07d86, []int{6}
 }
 func (m *OperatorActivePowerInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -640,7 +803,7 @@ func (m *OperatorActivePowerInfo) GetOperatorAddr() string {
 type BlsPubKeyInfo struct {
 	// operator address
 	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
-	// the name of public keys
+	//the name of public keys
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// the bls public keys of the operator
 	PubKey []byte `protobuf:"bytes,3,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
@@ -650,7 +813,7 @@ func (m *BlsPubKeyInfo) Reset()         { *m = BlsPubKeyInfo{} }
 func (m *BlsPubKeyInfo) String() string { return proto.CompactTextString(m) }
 func (*BlsPubKeyInfo) ProtoMessage()    {}
 func (*BlsPubKeyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef1ed06249b07d86, []int{6}
+	return fileDescriptor_ef1ed06249b07d86, []int{7}
 }
 func (m *BlsPubKeyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -712,7 +875,7 @@ func (m *RegisterAVSTaskReq) Reset()         { *m = RegisterAVSTaskReq{} }
 func (m *RegisterAVSTaskReq) String() string { return proto.CompactTextString(m) }
 func (*RegisterAVSTaskReq) ProtoMessage()    {}
 func (*RegisterAVSTaskReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef1ed06249b07d86, []int{7}
+	return fileDescriptor_ef1ed06249b07d86, []int{8}
 }
 func (m *RegisterAVSTaskReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -749,7 +912,7 @@ func (m *RegisterAVSTaskResponse) Reset()         { *m = RegisterAVSTaskResponse
 func (m *RegisterAVSTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterAVSTaskResponse) ProtoMessage()    {}
 func (*RegisterAVSTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef1ed06249b07d86, []int{8}
+	return fileDescriptor_ef1ed06249b07d86, []int{9}
 }
 func (m *RegisterAVSTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -790,7 +953,7 @@ func (m *RegisterAVSReq) Reset()         { *m = RegisterAVSReq{} }
 func (m *RegisterAVSReq) String() string { return proto.CompactTextString(m) }
 func (*RegisterAVSReq) ProtoMessage()    {}
 func (*RegisterAVSReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef1ed06249b07d86, []int{9}
+	return fileDescriptor_ef1ed06249b07d86, []int{10}
 }
 func (m *RegisterAVSReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -845,7 +1008,7 @@ func (m *RegisterAVSResponse) Reset()         { *m = RegisterAVSResponse{} }
 func (m *RegisterAVSResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterAVSResponse) ProtoMessage()    {}
 func (*RegisterAVSResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef1ed06249b07d86, []int{10}
+	return fileDescriptor_ef1ed06249b07d86, []int{11}
 }
 func (m *RegisterAVSResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -900,7 +1063,7 @@ func (m *DeRegisterAVSReq) Reset()         { *m = DeRegisterAVSReq{} }
 func (m *DeRegisterAVSReq) String() string { return proto.CompactTextString(m) }
 func (*DeRegisterAVSReq) ProtoMessage()    {}
 func (*DeRegisterAVSReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef1ed06249b07d86, []int{11}
+	return fileDescriptor_ef1ed06249b07d86, []int{12}
 }
 func (m *DeRegisterAVSReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -955,7 +1118,7 @@ func (m *DeRegisterAVSResponse) Reset()         { *m = DeRegisterAVSResponse{} }
 func (m *DeRegisterAVSResponse) String() string { return proto.CompactTextString(m) }
 func (*DeRegisterAVSResponse) ProtoMessage()    {}
 func (*DeRegisterAVSResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef1ed06249b07d86, []int{12}
+	return fileDescriptor_ef1ed06249b07d86, []int{13}
 }
 func (m *DeRegisterAVSResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1021,7 +1184,7 @@ func (m *TaskResultInfo) Reset()         { *m = TaskResultInfo{} }
 func (m *TaskResultInfo) String() string { return proto.CompactTextString(m) }
 func (*TaskResultInfo) ProtoMessage()    {}
 func (*TaskResultInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef1ed06249b07d86, []int{13}
+	return fileDescriptor_ef1ed06249b07d86, []int{14}
 }
 func (m *TaskResultInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1111,7 +1274,7 @@ func (m *SubmitTaskResultReq) Reset()         { *m = SubmitTaskResultReq{} }
 func (m *SubmitTaskResultReq) String() string { return proto.CompactTextString(m) }
 func (*SubmitTaskResultReq) ProtoMessage()    {}
 func (*SubmitTaskResultReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef1ed06249b07d86, []int{14}
+	return fileDescriptor_ef1ed06249b07d86, []int{15}
 }
 func (m *SubmitTaskResultReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1148,7 +1311,7 @@ func (m *SubmitTaskResultResponse) Reset()         { *m = SubmitTaskResultRespon
 func (m *SubmitTaskResultResponse) String() string { return proto.CompactTextString(m) }
 func (*SubmitTaskResultResponse) ProtoMessage()    {}
 func (*SubmitTaskResultResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef1ed06249b07d86, []int{15}
+	return fileDescriptor_ef1ed06249b07d86, []int{16}
 }
 func (m *SubmitTaskResultResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1178,11 +1341,14 @@ func (m *SubmitTaskResultResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_SubmitTaskResultResponse proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("exocore.avs.v1.TaskStatus", TaskStatus_name, TaskStatus_value)
 	proto.RegisterType((*AVSInfo)(nil), "exocore.avs.v1.AVSInfo")
 	proto.RegisterMapType((map[string]int64)(nil), "exocore.avs.v1.AVSInfo.AssetRewardAmountEpochBasisEntry")
+	proto.RegisterMapType((map[string]int64)(nil), "exocore.avs.v1.AVSInfo.AssetRewardAmountTaskBasisEntry")
 	proto.RegisterType((*OperatorStatus)(nil), "exocore.avs.v1.OperatorStatus")
 	proto.RegisterType((*RewardSlashProof)(nil), "exocore.avs.v1.RewardSlashProof")
 	proto.RegisterType((*TaskInfo)(nil), "exocore.avs.v1.TaskInfo")
+	proto.RegisterType((*TaskStatisticsRetry)(nil), "exocore.avs.v1.TaskStatisticsRetry")
 	proto.RegisterType((*OperatorActivePowerList)(nil), "exocore.avs.v1.OperatorActivePowerList")
 	proto.RegisterType((*OperatorActivePowerInfo)(nil), "exocore.avs.v1.OperatorActivePowerInfo")
 	proto.RegisterType((*BlsPubKeyInfo)(nil), "exocore.avs.v1.BlsPubKeyInfo")
@@ -1200,112 +1366,131 @@ func init() {
 func init() { proto.RegisterFile("exocore/avs/v1/tx.proto", fileDescriptor_ef1ed06249b07d86) }
 
 var fileDescriptor_ef1ed06249b07d86 = []byte{
-	// 1667 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0xc7,
-	0x15, 0xd6, 0x4a, 0x94, 0x44, 0x3e, 0x52, 0x14, 0x35, 0xa2, 0xc3, 0x35, 0xdd, 0x92, 0xc2, 0xba,
-	0x4e, 0x64, 0x25, 0x26, 0x6d, 0xa5, 0x28, 0x0c, 0xf7, 0x24, 0x55, 0x6e, 0x2a, 0xa4, 0xb1, 0x85,
-	0xa5, 0x9a, 0x16, 0xed, 0x61, 0x31, 0xe4, 0x8e, 0x56, 0x0b, 0x2d, 0x77, 0xd8, 0x9d, 0x21, 0x6d,
-	0xf7, 0x50, 0x14, 0x3e, 0x15, 0x41, 0x51, 0xb4, 0x08, 0xd0, 0x73, 0xee, 0xbd, 0x18, 0x45, 0x2e,
-	0x05, 0xda, 0x7b, 0x8e, 0x41, 0x7a, 0x29, 0x7a, 0x30, 0x0a, 0xb9, 0x80, 0xdb, 0xff, 0xa2, 0x98,
-	0x37, 0xb3, 0x14, 0x97, 0x94, 0xac, 0xba, 0x39, 0x24, 0x17, 0x73, 0xe7, 0xfd, 0x9a, 0xef, 0xbd,
-	0x79, 0xef, 0x9b, 0xb1, 0xa0, 0xc6, 0x1e, 0xf3, 0x1e, 0x4f, 0x58, 0x9b, 0x8e, 0x44, 0x7b, 0x74,
-	0xa7, 0x2d, 0x1f, 0xb7, 0x06, 0x09, 0x97, 0x9c, 0x94, 0x8d, 0xa2, 0x45, 0x47, 0xa2, 0x35, 0xba,
-	0x53, 0x5f, 0xa3, 0xfd, 0x30, 0xe6, 0x6d, 0xfc, 0x57, 0x9b, 0xd4, 0x6b, 0x3d, 0x2e, 0xfa, 0x5c,
-	0xb4, 0xfb, 0x22, 0x50, 0xae, 0x7d, 0x11, 0x18, 0xc5, 0x55, 0xad, 0xf0, 0x70, 0xd5, 0xd6, 0x0b,
-	0xa3, 0xaa, 0x06, 0x3c, 0xe0, 0x5a, 0xae, 0xbe, 0x8c, 0xf4, 0x1b, 0x01, 0xe7, 0x41, 0xc4, 0xda,
-	0x74, 0x10, 0xb6, 0x69, 0x1c, 0x73, 0x49, 0x65, 0xc8, 0x63, 0xe3, 0xe3, 0xfc, 0x75, 0x19, 0x96,
-	0x77, 0x3e, 0xec, 0xec, 0xc7, 0x47, 0x9c, 0x10, 0xc8, 0xc5, 0xb4, 0xcf, 0x6c, 0x6b, 0xc3, 0xda,
-	0x2c, 0xb8, 0xf8, 0x4d, 0x9a, 0x50, 0xa4, 0x23, 0xe1, 0x51, 0xdf, 0x4f, 0x98, 0x10, 0xf6, 0x3c,
-	0xaa, 0x80, 0x8e, 0xc4, 0x8e, 0x96, 0x90, 0x4d, 0xa8, 0xf4, 0xc3, 0xd8, 0x13, 0x92, 0x9e, 0x30,
-	0x8f, 0xf6, 0xf9, 0x30, 0x96, 0xf6, 0xc2, 0x86, 0xb5, 0x99, 0x73, 0xcb, 0xfd, 0x30, 0xee, 0x28,
-	0xf1, 0x0e, 0x4a, 0xc9, 0x35, 0x28, 0x48, 0x2a, 0x4e, 0x30, 0x96, 0x9d, 0xc3, 0x40, 0x79, 0x25,
-	0x50, 0x91, 0xc8, 0x37, 0x01, 0x44, 0x44, 0xc5, 0xb1, 0xd6, 0x2e, 0xa2, 0xb6, 0x80, 0x12, 0x54,
-	0x37, 0xa1, 0x98, 0xb0, 0x47, 0x34, 0xf1, 0xb5, 0x7e, 0x49, 0xc3, 0xd0, 0x22, 0x34, 0xd8, 0x82,
-	0x35, 0x85, 0x93, 0x3f, 0x8a, 0x59, 0x32, 0x46, 0xbb, 0xbc, 0xb1, 0xb0, 0x59, 0x70, 0x57, 0xe9,
-	0x48, 0x3c, 0x54, 0xf2, 0x14, 0xf2, 0x4d, 0x28, 0x50, 0x21, 0x98, 0xf4, 0x42, 0x5f, 0xd8, 0x79,
-	0x65, 0xb3, 0x5b, 0x3a, 0x7d, 0xde, 0xcc, 0xef, 0x28, 0xe1, 0xfe, 0x9e, 0x70, 0xf3, 0xa8, 0xde,
-	0xf7, 0x05, 0xb9, 0x0d, 0x55, 0x15, 0x76, 0x18, 0x77, 0x79, 0xec, 0x87, 0x71, 0xe0, 0x0d, 0x58,
-	0x12, 0x72, 0xdf, 0x2e, 0x60, 0x86, 0x84, 0x8e, 0xc4, 0x8f, 0x52, 0xd5, 0x01, 0x6a, 0x48, 0x0b,
-	0xd6, 0xb1, 0x1e, 0x2c, 0x3a, 0xf2, 0x7c, 0x16, 0xb1, 0x00, 0xcb, 0x6d, 0x03, 0x3a, 0xac, 0xa9,
-	0x92, 0xb0, 0xe8, 0x68, 0x6f, 0xac, 0x20, 0x37, 0xa1, 0xc2, 0x06, 0xbc, 0x77, 0xec, 0x85, 0x3e,
-	0x8b, 0x65, 0x78, 0x14, 0xb2, 0xc4, 0x2e, 0x62, 0x7a, 0xab, 0x28, 0xdf, 0x1f, 0x8b, 0x49, 0x1b,
-	0xaa, 0x2a, 0x34, 0x1f, 0x48, 0x0f, 0x7f, 0x58, 0x42, 0x25, 0x4f, 0x84, 0x5d, 0x1a, 0xc7, 0x7e,
-	0x38, 0x90, 0xfb, 0xf1, 0xc3, 0x54, 0x41, 0xde, 0x85, 0x37, 0x94, 0x83, 0xe4, 0x92, 0x46, 0xd9,
-	0x13, 0x5a, 0x41, 0x17, 0x85, 0xf4, 0x50, 0x29, 0x27, 0x8f, 0xe9, 0x06, 0x94, 0x85, 0xa4, 0x89,
-	0x54, 0xd9, 0x22, 0x02, 0xbb, 0x8c, 0xc6, 0x2b, 0xa9, 0xf4, 0xbe, 0x12, 0x92, 0xab, 0x90, 0xef,
-	0x1d, 0xd3, 0x30, 0xf6, 0x42, 0xdf, 0x5e, 0x45, 0xbc, 0xcb, 0xb8, 0xde, 0xf7, 0xc9, 0x07, 0xa0,
-	0x1a, 0xc4, 0xd3, 0xa7, 0x63, 0x57, 0x94, 0x72, 0xb7, 0xf5, 0xd9, 0xf3, 0xe6, 0xdc, 0x3f, 0x9e,
-	0x37, 0xdf, 0x0c, 0x42, 0x79, 0x3c, 0xec, 0xb6, 0x7a, 0xbc, 0x6f, 0x9a, 0xd7, 0xfc, 0xdc, 0x12,
-	0xfe, 0x49, 0x5b, 0x3e, 0x19, 0x30, 0xd1, 0xda, 0x63, 0x3d, 0xb7, 0x40, 0x47, 0xc2, 0xc5, 0x00,
-	0xe4, 0x7d, 0x50, 0x0b, 0x0f, 0x9b, 0xc1, 0x5e, 0xfb, 0xbf, 0xa2, 0xe5, 0xe9, 0x48, 0x74, 0x94,
-	0x3f, 0xf9, 0x25, 0x34, 0xf5, 0xd9, 0xa7, 0xed, 0x84, 0x49, 0xeb, 0x44, 0xbd, 0x2e, 0x15, 0xa1,
-	0xb0, 0xc9, 0xc6, 0xc2, 0x66, 0x71, 0xfb, 0x6e, 0x2b, 0x3b, 0xa4, 0x2d, 0x33, 0x25, 0x2d, 0xec,
-	0x12, 0x0d, 0x4d, 0x57, 0x0c, 0xeb, 0xb1, 0xab, 0x5c, 0xef, 0xc7, 0x32, 0x79, 0xe2, 0x5e, 0xa3,
-	0x17, 0x5b, 0xd4, 0x1f, 0xc0, 0xc6, 0x65, 0x01, 0x48, 0x05, 0x16, 0x4e, 0xd8, 0x13, 0x33, 0x86,
-	0xea, 0x93, 0x54, 0x61, 0x71, 0x44, 0xa3, 0x21, 0xc3, 0xf9, 0x5b, 0x70, 0xf5, 0xe2, 0xde, 0xfc,
-	0x5d, 0xcb, 0x49, 0xa0, 0x9c, 0x9e, 0x77, 0x47, 0x52, 0x39, 0x54, 0xdd, 0x5d, 0x49, 0x5b, 0x63,
-	0x3c, 0x08, 0x3a, 0xd4, 0x6a, 0x2a, 0x4f, 0x07, 0xe1, 0x0d, 0x58, 0x12, 0xe8, 0x64, 0xe6, 0xda,
-	0xac, 0xd4, 0x30, 0x0e, 0x12, 0xce, 0x8f, 0x3c, 0x9f, 0x4a, 0x8a, 0xd3, 0x5c, 0x72, 0x0b, 0x28,
-	0xd9, 0xa3, 0x92, 0x3a, 0xff, 0xb1, 0xa0, 0xa2, 0xf1, 0x63, 0x4d, 0x0f, 0x94, 0x82, 0xd4, 0x60,
-	0x19, 0xa7, 0x3b, 0xf4, 0xcd, 0x6e, 0x4b, 0x6a, 0xb9, 0xef, 0x93, 0x6d, 0xb8, 0x82, 0x8a, 0x1e,
-	0x8f, 0x65, 0x42, 0x7b, 0x72, 0x8a, 0x4b, 0xd6, 0x95, 0xf2, 0x7b, 0x46, 0x97, 0x02, 0x6b, 0x00,
-	0xd0, 0x20, 0x48, 0xd4, 0x8c, 0xf0, 0x04, 0x01, 0x28, 0xd2, 0x19, 0x4b, 0xa6, 0x59, 0x29, 0x37,
-	0xc3, 0x4a, 0xef, 0xc1, 0x38, 0x59, 0xcf, 0xa4, 0xb8, 0x88, 0xc7, 0xda, 0x98, 0x3e, 0xd6, 0x6c,
-	0xf5, 0xdc, 0x32, 0xcf, 0xac, 0x9d, 0x67, 0x4b, 0x90, 0x3f, 0x54, 0x89, 0x28, 0x82, 0xbc, 0x30,
-	0x15, 0xeb, 0xe2, 0x54, 0x52, 0x52, 0x9d, 0x9f, 0x20, 0x55, 0x02, 0xb9, 0x63, 0xd5, 0xcc, 0xba,
-	0xb2, 0xf8, 0x3d, 0x59, 0xbf, 0x1c, 0xce, 0x5b, 0x5a, 0xbf, 0xdb, 0x50, 0x45, 0x45, 0xc2, 0xc4,
-	0x80, 0xc7, 0x82, 0xa5, 0x14, 0xb4, 0xa8, 0x29, 0x48, 0xe9, 0x5c, 0xa3, 0x32, 0x14, 0xf4, 0x1d,
-	0xa8, 0xa1, 0x87, 0x4a, 0x3c, 0x14, 0x32, 0xec, 0xd1, 0x28, 0x75, 0x5a, 0x42, 0x27, 0xcc, 0xa2,
-	0x73, 0xa6, 0x35, 0x7e, 0xe3, 0xf4, 0x8e, 0x69, 0x14, 0xb1, 0x38, 0x18, 0x6f, 0xb5, 0xac, 0xd9,
-	0x02, 0xd3, 0x4b, 0x75, 0xc6, 0xe7, 0x0e, 0x54, 0xe5, 0x71, 0xc2, 0xc4, 0x31, 0x8f, 0x7c, 0x65,
-	0xde, 0x63, 0xb1, 0xa4, 0x01, 0xb3, 0xf3, 0xc6, 0x25, 0xd5, 0x1d, 0x8c, 0x55, 0xe7, 0x10, 0x4c,
-	0xe1, 0x3c, 0x82, 0xb9, 0x09, 0x15, 0xda, 0x93, 0x43, 0x1a, 0x79, 0xe3, 0x20, 0x86, 0x45, 0x57,
-	0xb5, 0xfc, 0x30, 0x15, 0xab, 0x3b, 0x68, 0x86, 0x14, 0x8b, 0xc8, 0xfd, 0x65, 0x9e, 0x65, 0xc4,
-	0x9b, 0x50, 0x11, 0x61, 0x10, 0x33, 0x3f, 0x43, 0x9f, 0x78, 0x4b, 0x68, 0xf9, 0x99, 0x69, 0x0b,
-	0xd6, 0x63, 0xee, 0xcd, 0x58, 0xaf, 0xa0, 0xf5, 0x5a, 0xcc, 0x3b, 0x53, 0xf6, 0xb7, 0xa1, 0xca,
-	0x92, 0x64, 0xd6, 0xa1, 0x8c, 0x0e, 0x84, 0x25, 0xc9, 0xb4, 0xc7, 0x63, 0xa8, 0x60, 0xbd, 0x35,
-	0x3f, 0x0f, 0xf8, 0x23, 0x96, 0x68, 0x2a, 0xdd, 0x7d, 0xf0, 0x7a, 0xfc, 0x76, 0xfa, 0xbc, 0x59,
-	0x56, 0x4d, 0x8a, 0x5c, 0x7e, 0xa0, 0xe2, 0x7c, 0xf1, 0xe9, 0x2d, 0x30, 0x6f, 0x03, 0xc5, 0x7f,
-	0x65, 0x99, 0xd1, 0x92, 0x9f, 0xc1, 0x95, 0x33, 0x8e, 0xe8, 0xc9, 0x70, 0xc4, 0xcc, 0xf6, 0x8a,
-	0xac, 0x8b, 0xdb, 0x6f, 0x5d, 0x34, 0x24, 0x3b, 0x68, 0x8b, 0x31, 0x7e, 0x18, 0x0a, 0xe9, 0xae,
-	0xf3, 0x59, 0x85, 0x93, 0x40, 0xed, 0x02, 0x7b, 0xf2, 0x63, 0x18, 0x7b, 0xe8, 0x0d, 0xbd, 0x28,
-	0x14, 0xd2, 0xb6, 0x70, 0x34, 0xff, 0x97, 0x5d, 0xd5, 0x18, 0xba, 0x6b, 0x69, 0x8c, 0x71, 0x60,
-	0xe7, 0x4f, 0xd6, 0xb9, 0x9b, 0xe2, 0xd4, 0x5e, 0x87, 0x95, 0x0c, 0x21, 0x9a, 0x69, 0x2d, 0x4d,
-	0xb2, 0x21, 0x49, 0xa0, 0x94, 0x29, 0x04, 0x8e, 0xeb, 0xee, 0xc3, 0xd7, 0x3e, 0x87, 0x55, 0x75,
-	0xbd, 0x4f, 0x20, 0x98, 0x3a, 0x88, 0x22, 0x9d, 0x28, 0xd4, 0x4f, 0x60, 0x65, 0x37, 0x12, 0x07,
-	0xc3, 0xee, 0xfb, 0xec, 0x09, 0x22, 0xad, 0x43, 0x3e, 0x05, 0x65, 0x40, 0x8e, 0xd7, 0xe7, 0xf2,
-	0x48, 0x0d, 0x96, 0x07, 0xc3, 0xae, 0xa7, 0x2e, 0x0b, 0x4d, 0x25, 0x4b, 0x03, 0x0c, 0xe6, 0xfc,
-	0xd9, 0x02, 0xe2, 0xb2, 0x20, 0x14, 0x92, 0x25, 0x3b, 0x1f, 0x76, 0x0e, 0x91, 0x23, 0x7e, 0x4e,
-	0xbe, 0x0b, 0xa5, 0xa3, 0x84, 0xf7, 0xb3, 0xb4, 0xb5, 0x6b, 0x7f, 0xf1, 0xe9, 0xad, 0xaa, 0xc1,
-	0x68, 0x58, 0xab, 0x23, 0x93, 0x30, 0x0e, 0xdc, 0xa2, 0xb2, 0x4e, 0x89, 0xec, 0x1d, 0xc8, 0xa9,
-	0x2e, 0x42, 0x00, 0xc5, 0x6d, 0x7b, 0xfa, 0xb0, 0x52, 0x92, 0x74, 0xd1, 0xea, 0xde, 0xdd, 0x5f,
-	0x7f, 0xd2, 0x9c, 0xfb, 0xf7, 0x27, 0xcd, 0xb9, 0xa7, 0x2f, 0x9f, 0x6d, 0x15, 0xbf, 0x7f, 0x16,
-	0xe7, 0xa3, 0x97, 0xcf, 0xb6, 0xae, 0x4d, 0x14, 0xef, 0x70, 0x82, 0x33, 0x95, 0xbf, 0x73, 0x15,
-	0x6a, 0x33, 0xd0, 0x35, 0xbd, 0x39, 0xbf, 0xb1, 0xa0, 0x3c, 0xa1, 0xfb, 0xd2, 0x29, 0xbd, 0x0d,
-	0xb9, 0x30, 0x3e, 0xe2, 0x26, 0xa5, 0xda, 0x05, 0x37, 0xbe, 0x8b, 0x46, 0xf7, 0x2a, 0xd3, 0x99,
-	0x38, 0xbf, 0xb7, 0x60, 0x3d, 0x03, 0x47, 0xc3, 0xfc, 0x4a, 0x31, 0xfd, 0xd6, 0x82, 0xca, 0x1e,
-	0xfb, 0x1a, 0x15, 0xe9, 0x63, 0x0b, 0xae, 0x4c, 0x01, 0xfa, 0x1a, 0x94, 0xe9, 0x0f, 0xf3, 0x50,
-	0x36, 0xad, 0x35, 0x8c, 0xb0, 0xef, 0x5e, 0xe7, 0xdd, 0xf4, 0x0e, 0x90, 0xec, 0x95, 0x8c, 0xb7,
-	0xb9, 0x9e, 0xcc, 0xca, 0xe4, 0x85, 0xfc, 0x03, 0x75, 0xb3, 0x5f, 0x87, 0x95, 0x8c, 0xb5, 0x99,
-	0xd5, 0xd2, 0xa4, 0xa1, 0x32, 0xea, 0x46, 0x02, 0x6f, 0x0f, 0x2a, 0x87, 0x09, 0xc3, 0x47, 0x40,
-	0xc9, 0x2d, 0x75, 0x23, 0xd1, 0x49, 0x65, 0x17, 0xbf, 0x3f, 0x16, 0x2f, 0x7e, 0x7f, 0x4c, 0xbc,
-	0x2b, 0x96, 0x32, 0xef, 0x8a, 0x2a, 0x2c, 0x0a, 0xbc, 0xaa, 0x97, 0xd1, 0x59, 0x2f, 0x9c, 0xbf,
-	0x58, 0xb0, 0xde, 0x19, 0x76, 0xfb, 0xa1, 0x3c, 0x2b, 0xcf, 0x97, 0x6e, 0xa1, 0xed, 0xcc, 0x61,
-	0x35, 0xce, 0xa3, 0x8e, 0xb3, 0x83, 0x30, 0x67, 0xf6, 0xed, 0x57, 0x11, 0x48, 0x6d, 0x82, 0x40,
-	0x52, 0xda, 0x47, 0xf2, 0xa8, 0x83, 0x3d, 0x8b, 0x5e, 0x97, 0x78, 0xfb, 0x8f, 0x39, 0x58, 0xf8,
-	0x40, 0x04, 0xe4, 0x17, 0x50, 0x9c, 0x68, 0x47, 0x32, 0x03, 0x27, 0x3b, 0x3c, 0xf5, 0xeb, 0xaf,
-	0xd4, 0x1b, 0x66, 0x7a, 0xf3, 0xe9, 0xdf, 0xfe, 0xf5, 0xf1, 0xfc, 0x86, 0xd3, 0x68, 0xcf, 0xfc,
-	0x9f, 0xbf, 0x3d, 0xb9, 0xd9, 0x53, 0x0b, 0x56, 0x32, 0xd3, 0x40, 0x36, 0xa6, 0xc3, 0x4f, 0x4f,
-	0x6f, 0xfd, 0xc6, 0x25, 0x16, 0x06, 0xc2, 0x26, 0x42, 0x70, 0x9c, 0x8d, 0x73, 0x20, 0x64, 0xb7,
-	0xfc, 0xc8, 0x82, 0xd5, 0x29, 0x8a, 0x25, 0xce, 0x2b, 0xb2, 0x34, 0xd7, 0x47, 0xfd, 0xad, 0x4b,
-	0x6d, 0x0c, 0x94, 0x2d, 0x84, 0xf2, 0x2d, 0xc7, 0x79, 0x75, 0x35, 0x70, 0x63, 0x45, 0x58, 0xd3,
-	0x47, 0x46, 0x66, 0x6a, 0x7e, 0x4e, 0x4b, 0xd6, 0x37, 0x2f, 0x37, 0x32, 0x78, 0xde, 0x46, 0x3c,
-	0x37, 0x9c, 0xeb, 0xe7, 0xe0, 0x99, 0x76, 0xaa, 0x2f, 0xfe, 0xea, 0xe5, 0xb3, 0x2d, 0x6b, 0xf7,
-	0xbd, 0xcf, 0x4e, 0x1b, 0xd6, 0xe7, 0xa7, 0x0d, 0xeb, 0x9f, 0xa7, 0x0d, 0xeb, 0x77, 0x2f, 0x1a,
-	0x73, 0x9f, 0xbf, 0x68, 0xcc, 0xfd, 0xfd, 0x45, 0x63, 0xee, 0xa7, 0xb7, 0x26, 0x1e, 0x03, 0xf7,
-	0x75, 0xbc, 0x07, 0x4c, 0x3e, 0xe2, 0xc9, 0xc9, 0x38, 0xfc, 0x63, 0xdc, 0x00, 0xdf, 0x05, 0xdd,
-	0x25, 0xfc, 0x43, 0xcb, 0xbb, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xe6, 0x35, 0x06, 0xa1, 0x0e,
-	0x12, 0x00, 0x00,
+	// 1969 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x4a, 0xd4, 0x07, 0x1f, 0x25, 0x8a, 0x1a, 0xd1, 0xe1, 0x9a, 0x6e, 0x49, 0x62, 0x5d,
+	0x27, 0xb2, 0x1c, 0x93, 0xb6, 0x52, 0xb4, 0xae, 0x7b, 0xa2, 0x4c, 0xd9, 0x21, 0x6c, 0xcb, 0x02,
+	0xc9, 0xa4, 0x45, 0x7a, 0x58, 0x8c, 0xb8, 0x23, 0x72, 0xa1, 0xe5, 0x0e, 0xbb, 0x33, 0xa4, 0xa5,
+	0x14, 0x28, 0x0a, 0x9f, 0x02, 0xa3, 0x28, 0x1a, 0x04, 0xe8, 0xcd, 0x40, 0x80, 0x1e, 0x0b, 0x14,
+	0x46, 0x91, 0x4b, 0x81, 0xfe, 0x01, 0x39, 0x06, 0x69, 0x0f, 0x45, 0x0f, 0x46, 0x21, 0x17, 0x70,
+	0x8b, 0xfe, 0x13, 0xc5, 0xbc, 0xfd, 0xe0, 0xb7, 0x1d, 0x27, 0x87, 0xe6, 0x22, 0xed, 0xbc, 0xaf,
+	0x79, 0xef, 0xcd, 0x7b, 0xbf, 0xf7, 0x40, 0xc8, 0xb0, 0x13, 0xde, 0xe4, 0x1e, 0x2b, 0xd1, 0xbe,
+	0x28, 0xf5, 0xaf, 0x97, 0xe4, 0x49, 0xb1, 0xeb, 0x71, 0xc9, 0x49, 0x32, 0x60, 0x14, 0x69, 0x5f,
+	0x14, 0xfb, 0xd7, 0xb3, 0x1b, 0xb4, 0x63, 0xbb, 0xbc, 0x84, 0x7f, 0x7d, 0x91, 0x6c, 0xa6, 0xc9,
+	0x45, 0x87, 0x8b, 0x52, 0x47, 0xb4, 0x94, 0x6a, 0x47, 0xb4, 0x02, 0xc6, 0x79, 0x9f, 0x61, 0xe2,
+	0xa9, 0xe4, 0x1f, 0x02, 0x56, 0xba, 0xc5, 0x5b, 0xdc, 0xa7, 0xab, 0xaf, 0x80, 0xfa, 0x9d, 0x16,
+	0xe7, 0x2d, 0x87, 0x95, 0x68, 0xd7, 0x2e, 0x51, 0xd7, 0xe5, 0x92, 0x4a, 0x9b, 0xbb, 0x81, 0x8e,
+	0xf1, 0xdf, 0x38, 0x2c, 0x97, 0xdf, 0xaf, 0x57, 0xdd, 0x23, 0x4e, 0x08, 0xc4, 0x5c, 0xda, 0x61,
+	0xba, 0x56, 0xd0, 0xb6, 0xe2, 0x35, 0xfc, 0x26, 0x79, 0x48, 0xd0, 0xbe, 0x30, 0xa9, 0x65, 0x79,
+	0x4c, 0x08, 0x7d, 0x1e, 0x59, 0x40, 0xfb, 0xa2, 0xec, 0x53, 0xc8, 0x16, 0xa4, 0x3a, 0xb6, 0x6b,
+	0x0a, 0x49, 0x8f, 0x99, 0x49, 0x3b, 0xbc, 0xe7, 0x4a, 0x7d, 0xa1, 0xa0, 0x6d, 0xc5, 0x6a, 0xc9,
+	0x8e, 0xed, 0xd6, 0x15, 0xb9, 0x8c, 0x54, 0x72, 0x01, 0xe2, 0x92, 0x8a, 0x63, 0xb4, 0xa5, 0xc7,
+	0xd0, 0xd0, 0x8a, 0x22, 0x28, 0x4b, 0xe4, 0xbb, 0x00, 0xc2, 0xa1, 0xa2, 0xed, 0x73, 0x17, 0x91,
+	0x1b, 0x47, 0x0a, 0xb2, 0xf3, 0x90, 0xf0, 0xd8, 0x43, 0xea, 0x59, 0x3e, 0x7f, 0xc9, 0x77, 0xc3,
+	0x27, 0xa1, 0xc0, 0x36, 0x6c, 0x28, 0x3f, 0xf9, 0x43, 0x97, 0x79, 0x91, 0xb7, 0xcb, 0x85, 0x85,
+	0xad, 0x78, 0x6d, 0x9d, 0xf6, 0xc5, 0x03, 0x45, 0x0f, 0x5d, 0xbe, 0x0c, 0x71, 0x2a, 0x04, 0x93,
+	0xa6, 0x6d, 0x09, 0x7d, 0x45, 0xc9, 0xec, 0xae, 0x9e, 0x3d, 0xcb, 0xaf, 0x94, 0x15, 0xb1, 0x5a,
+	0x11, 0xb5, 0x15, 0x64, 0x57, 0x2d, 0x41, 0xae, 0x41, 0x5a, 0x99, 0xed, 0xb9, 0x87, 0xdc, 0xb5,
+	0x6c, 0xb7, 0x65, 0x76, 0x99, 0x67, 0x73, 0x4b, 0x8f, 0x63, 0x84, 0x84, 0xf6, 0xc5, 0x7b, 0x21,
+	0xeb, 0x00, 0x39, 0xa4, 0x08, 0x9b, 0x98, 0x0f, 0xe6, 0x1c, 0x99, 0x16, 0x73, 0x58, 0x0b, 0xd3,
+	0xad, 0x03, 0x2a, 0x6c, 0xa8, 0x94, 0x30, 0xe7, 0xa8, 0x12, 0x31, 0xc8, 0x65, 0x48, 0xb1, 0x2e,
+	0x6f, 0xb6, 0x4d, 0xdb, 0x62, 0xae, 0xb4, 0x8f, 0x6c, 0xe6, 0xe9, 0x09, 0x0c, 0x6f, 0x1d, 0xe9,
+	0xd5, 0x88, 0x4c, 0x4a, 0x90, 0x56, 0xa6, 0x79, 0x57, 0x9a, 0xf8, 0x8f, 0x79, 0x54, 0x72, 0x4f,
+	0xe8, 0xab, 0x91, 0xed, 0x07, 0x5d, 0x59, 0x75, 0x1f, 0x84, 0x0c, 0xf2, 0x0e, 0xbc, 0xa1, 0x14,
+	0x24, 0x97, 0xd4, 0x19, 0x7d, 0xa1, 0x35, 0x54, 0x51, 0x9e, 0x36, 0x14, 0x73, 0xf8, 0x99, 0x2e,
+	0x41, 0x52, 0x48, 0xea, 0x49, 0x15, 0x2d, 0x7a, 0xa0, 0x27, 0x51, 0x78, 0x2d, 0xa4, 0xee, 0x29,
+	0x22, 0x39, 0x0f, 0x2b, 0xcd, 0x36, 0xb5, 0x5d, 0xd3, 0xb6, 0xf4, 0x75, 0xf4, 0x77, 0x19, 0xcf,
+	0x55, 0x8b, 0xdc, 0x07, 0x55, 0x20, 0xa6, 0xff, 0x3a, 0x7a, 0x4a, 0x31, 0x77, 0x8b, 0x9f, 0x3f,
+	0xcb, 0xcf, 0xfd, 0xe3, 0x59, 0xfe, 0xcd, 0x96, 0x2d, 0xdb, 0xbd, 0xc3, 0x62, 0x93, 0x77, 0x82,
+	0xe2, 0x0d, 0xfe, 0x5d, 0x15, 0xd6, 0x71, 0x49, 0x9e, 0x76, 0x99, 0x28, 0x56, 0x58, 0xb3, 0x16,
+	0xa7, 0x7d, 0x51, 0x43, 0x03, 0xe4, 0x2e, 0xa8, 0x83, 0x89, 0xc5, 0xa0, 0x6f, 0x7c, 0x2d, 0x6b,
+	0x2b, 0xb4, 0x2f, 0xea, 0x4a, 0x9f, 0xfc, 0x12, 0xf2, 0xfe, 0xdb, 0x87, 0xe5, 0x84, 0x41, 0xfb,
+	0x81, 0x9a, 0x87, 0x54, 0xd8, 0x42, 0x27, 0x85, 0x85, 0xad, 0xc4, 0xce, 0x8d, 0xe2, 0x68, 0x93,
+	0x16, 0x83, 0x2e, 0x29, 0x62, 0x95, 0xf8, 0xae, 0xf9, 0x19, 0xc3, 0x7c, 0xec, 0x2a, 0xd5, 0x3d,
+	0x57, 0x7a, 0xa7, 0xb5, 0x0b, 0x74, 0xb6, 0x84, 0xca, 0x6e, 0x9f, 0x49, 0x6e, 0x36, 0x79, 0xa7,
+	0x63, 0x4b, 0xc9, 0x98, 0xbe, 0x89, 0x45, 0xba, 0xa6, 0xa8, 0xb7, 0x42, 0x22, 0xf9, 0x11, 0x9c,
+	0xef, 0xd0, 0x13, 0x13, 0xfb, 0xa5, 0xd9, 0xa6, 0x8e, 0xc3, 0xdc, 0x16, 0x0b, 0x8b, 0x2f, 0x8d,
+	0xef, 0xf1, 0x46, 0x87, 0x9e, 0x34, 0xa8, 0x38, 0xbe, 0x15, 0xb2, 0x83, 0x02, 0xfc, 0x05, 0xe4,
+	0xa6, 0x45, 0x88, 0xa6, 0xfc, 0x00, 0xcf, 0x61, 0x80, 0x3f, 0xfc, 0xca, 0x01, 0xaa, 0x5b, 0x86,
+	0xe2, 0xcb, 0xd2, 0x99, 0x02, 0xd9, 0x7d, 0x28, 0xbc, 0x2a, 0x3f, 0x24, 0x05, 0x0b, 0xc7, 0xec,
+	0x34, 0x40, 0x19, 0xf5, 0x49, 0xd2, 0xb0, 0xd8, 0xa7, 0x4e, 0x8f, 0x21, 0xbc, 0x2c, 0xd4, 0xfc,
+	0xc3, 0xcd, 0xf9, 0x1b, 0x5a, 0xf6, 0x3e, 0xe4, 0x5f, 0xe1, 0xce, 0xeb, 0x98, 0x33, 0x3c, 0x48,
+	0x86, 0xdd, 0x51, 0x97, 0x54, 0xf6, 0x14, 0x16, 0xa4, 0xc2, 0x46, 0x8a, 0x60, 0xc3, 0x37, 0xb5,
+	0x1e, 0xd2, 0x43, 0xd8, 0x78, 0x03, 0x96, 0x04, 0x2a, 0x05, 0x28, 0x18, 0x9c, 0x14, 0x74, 0x75,
+	0x3d, 0xce, 0x8f, 0x4c, 0x8b, 0x4a, 0x8a, 0xd8, 0xb7, 0x5a, 0x8b, 0x23, 0xa5, 0x42, 0x25, 0x35,
+	0xfe, 0xa3, 0x41, 0xca, 0x77, 0x1f, 0x2b, 0xf0, 0x40, 0x31, 0x48, 0x06, 0x96, 0xf1, 0x41, 0x6c,
+	0x2b, 0xb8, 0x6d, 0x49, 0x1d, 0xab, 0x16, 0xd9, 0x81, 0x73, 0xfe, 0xa3, 0x73, 0x57, 0x7a, 0xb4,
+	0x29, 0xc7, 0x90, 0x77, 0x53, 0x31, 0x6f, 0x05, 0xbc, 0xd0, 0xb1, 0x1c, 0x00, 0x6d, 0xb5, 0x3c,
+	0x85, 0x28, 0xdc, 0xd3, 0x17, 0x02, 0x88, 0x8e, 0x28, 0xe3, 0x18, 0x1e, 0x9b, 0xc0, 0xf0, 0x3b,
+	0x10, 0x05, 0x6b, 0x06, 0x21, 0x2e, 0x62, 0x8d, 0xe4, 0xc6, 0x6b, 0x64, 0x34, 0x7b, 0xb5, 0x24,
+	0x1f, 0x39, 0x1b, 0x1f, 0x2f, 0xc3, 0x8a, 0x7a, 0x1e, 0x1c, 0x27, 0x33, 0x43, 0xd1, 0x66, 0x87,
+	0x12, 0x8e, 0xa0, 0xf9, 0xa1, 0x11, 0x44, 0x20, 0xd6, 0x56, 0xad, 0xef, 0x67, 0x16, 0xbf, 0x87,
+	0xf3, 0x17, 0xc3, 0x6e, 0x08, 0xf3, 0x77, 0x0d, 0xd2, 0xc8, 0xf0, 0x98, 0xe8, 0x72, 0x57, 0x44,
+	0x3d, 0xb3, 0xe8, 0x03, 0xb6, 0xe2, 0xd5, 0x02, 0x56, 0xd0, 0x2f, 0x3f, 0x80, 0x0c, 0x6a, 0xa8,
+	0xc0, 0x6d, 0x21, 0xed, 0x26, 0x75, 0x42, 0xa5, 0x25, 0x54, 0xc2, 0x28, 0xea, 0x03, 0x6e, 0xa0,
+	0x17, 0x85, 0x37, 0xde, 0x9e, 0xcb, 0x3e, 0xb6, 0xca, 0x29, 0xbd, 0x79, 0x1d, 0xd2, 0xb2, 0xed,
+	0x31, 0xd1, 0xe6, 0x8e, 0xa5, 0xc4, 0x9b, 0xcc, 0x95, 0xb4, 0xc5, 0xf4, 0x95, 0x40, 0x25, 0xe4,
+	0x1d, 0x44, 0xac, 0x29, 0x70, 0x1c, 0x9f, 0x06, 0xc7, 0x97, 0x21, 0x45, 0x9b, 0xb2, 0x47, 0x1d,
+	0x33, 0x32, 0x12, 0xcc, 0x9c, 0x75, 0x9f, 0xde, 0x08, 0xc9, 0x6a, 0x62, 0x4f, 0x8c, 0x90, 0x04,
+	0x82, 0x50, 0x92, 0x8f, 0xce, 0x8f, 0xcb, 0x90, 0x12, 0x76, 0xcb, 0x65, 0xd6, 0xc8, 0xb0, 0xc1,
+	0x99, 0xea, 0xd3, 0x07, 0xa2, 0x45, 0xd8, 0x74, 0xb9, 0x39, 0x21, 0xbd, 0x86, 0xd2, 0x1b, 0x2e,
+	0xaf, 0x8f, 0xc9, 0x5f, 0x83, 0x34, 0xf3, 0xbc, 0x49, 0x85, 0x24, 0x2a, 0x10, 0xe6, 0x79, 0xe3,
+	0x1a, 0x27, 0x90, 0xc2, 0x7c, 0xfb, 0xd3, 0xac, 0xcb, 0x1f, 0x32, 0xcf, 0x1f, 0x3c, 0xbb, 0xfb,
+	0xaf, 0x37, 0x0d, 0xce, 0x9e, 0xe5, 0x93, 0xaa, 0x48, 0x71, 0xf2, 0x1d, 0x28, 0x3b, 0x5f, 0x7e,
+	0x76, 0x15, 0x7c, 0x49, 0xc5, 0xaf, 0x25, 0xe5, 0x08, 0x97, 0xfc, 0x0c, 0xce, 0x0d, 0x30, 0xa2,
+	0x29, 0xed, 0x3e, 0x0b, 0xae, 0x57, 0xa3, 0x2d, 0xb1, 0xf3, 0xd6, 0xac, 0x26, 0x29, 0xa3, 0x2c,
+	0xda, 0xb8, 0x67, 0x0b, 0x59, 0xdb, 0xe4, 0x93, 0x0c, 0xb2, 0x13, 0xa1, 0x8a, 0x1a, 0x6d, 0xc9,
+	0x9d, 0xec, 0xb8, 0xb5, 0x46, 0x50, 0x7d, 0x3d, 0x11, 0x21, 0xce, 0x15, 0xd8, 0x88, 0xaa, 0x55,
+	0x98, 0x6d, 0x66, 0xb7, 0xda, 0x52, 0x27, 0x08, 0x76, 0xa9, 0x01, 0xe3, 0x5d, 0xa4, 0x1b, 0x7f,
+	0xd4, 0x60, 0xb3, 0x31, 0x5c, 0xc1, 0xa2, 0xc6, 0x14, 0x6e, 0x7e, 0x9d, 0xf6, 0xbc, 0x38, 0x68,
+	0x3b, 0xd5, 0xa1, 0xb1, 0x5d, 0x38, 0x7b, 0x96, 0x5f, 0xc2, 0x8e, 0xaf, 0x44, 0x2d, 0x88, 0xbb,
+	0x9a, 0xf4, 0x4e, 0xcd, 0x66, 0xb4, 0x0c, 0xae, 0xa9, 0x5d, 0x4d, 0x7a, 0xa7, 0xb7, 0x14, 0x45,
+	0x01, 0xa6, 0x43, 0x85, 0x34, 0x99, 0xe7, 0xf1, 0x70, 0x13, 0x8c, 0x2b, 0xca, 0x9e, 0x22, 0x18,
+	0x1e, 0x64, 0x66, 0x64, 0x90, 0xfc, 0x04, 0xa2, 0x1c, 0xfa, 0x4f, 0x60, 0x3a, 0xb6, 0x90, 0xba,
+	0x56, 0x58, 0xf8, 0x8a, 0xef, 0xa0, 0x80, 0xa9, 0xb6, 0x11, 0xda, 0x88, 0x0c, 0x1b, 0x7f, 0xd2,
+	0x20, 0x33, 0x43, 0x9c, 0x5c, 0x84, 0xb5, 0x91, 0x11, 0x11, 0x24, 0x68, 0x75, 0x78, 0x3e, 0x10,
+	0x0f, 0x56, 0x47, 0x4a, 0x03, 0x01, 0x6c, 0xf7, 0xc1, 0x6b, 0x57, 0xe6, 0xba, 0x5a, 0x0f, 0x87,
+	0x3c, 0x18, 0x2b, 0xcd, 0x04, 0x1d, 0xb0, 0x8c, 0x9f, 0xc2, 0xda, 0xae, 0x23, 0x0e, 0x7a, 0x87,
+	0x77, 0xd9, 0x29, 0x7a, 0x9a, 0x85, 0x95, 0xd0, 0xa9, 0xc0, 0xc9, 0xe8, 0x3c, 0x15, 0x59, 0x33,
+	0xb0, 0xdc, 0xed, 0x1d, 0x9a, 0x6a, 0x7c, 0xfa, 0xe0, 0xba, 0xd4, 0x45, 0x63, 0xc6, 0x9f, 0x35,
+	0x20, 0x35, 0xd6, 0xb2, 0x85, 0x64, 0x5e, 0xf9, 0xfd, 0x7a, 0x03, 0x51, 0xf3, 0xe7, 0xe4, 0xc7,
+	0xb0, 0x7a, 0xe4, 0xf1, 0xce, 0x68, 0xa5, 0xec, 0xea, 0x5f, 0x7e, 0x76, 0x35, 0x1d, 0xf8, 0x18,
+	0x14, 0x4a, 0x5d, 0x7a, 0xb6, 0xdb, 0xaa, 0x25, 0x94, 0x74, 0x58, 0x3b, 0x6f, 0x43, 0x4c, 0x15,
+	0x08, 0x3a, 0x90, 0xd8, 0xd1, 0xa7, 0x95, 0x39, 0xbe, 0x0e, 0x4a, 0xdd, 0xbc, 0xf1, 0xd1, 0xa7,
+	0xf9, 0xb9, 0x7f, 0x7f, 0x9a, 0x9f, 0x7b, 0xf4, 0xe2, 0xe9, 0x76, 0xe2, 0xf6, 0xc0, 0xce, 0xe3,
+	0x17, 0x4f, 0xb7, 0x2f, 0x0c, 0x25, 0xaf, 0x31, 0x54, 0xa6, 0x4a, 0xdf, 0x38, 0x0f, 0x99, 0x09,
+	0xd7, 0x7d, 0xc0, 0x37, 0x7e, 0xad, 0x41, 0x72, 0x88, 0xf7, 0x8d, 0x43, 0xba, 0x02, 0x31, 0xdb,
+	0x3d, 0xe2, 0x41, 0x48, 0x99, 0x19, 0x0b, 0x55, 0x0d, 0x85, 0x6e, 0xa6, 0xc6, 0x23, 0x31, 0x3e,
+	0xd6, 0x60, 0x73, 0xc4, 0x1d, 0xdf, 0xcd, 0xff, 0xab, 0x4f, 0xbf, 0xd1, 0x20, 0x55, 0x61, 0xdf,
+	0xa2, 0x24, 0x7d, 0xa2, 0xc1, 0xb9, 0x0a, 0xfb, 0xb6, 0xa5, 0xe9, 0x77, 0xf3, 0x90, 0x0c, 0x4a,
+	0xab, 0xe7, 0x60, 0xdd, 0xbd, 0xce, 0x26, 0xf9, 0x36, 0x90, 0xd1, 0x25, 0x05, 0xf7, 0x1b, 0xbf,
+	0x33, 0x53, 0xc3, 0x2b, 0xca, 0xbb, 0x6a, 0xd7, 0xb9, 0x08, 0x6b, 0x23, 0xd2, 0x41, 0xaf, 0xae,
+	0x0e, 0x0b, 0x2a, 0xa1, 0x43, 0x47, 0xe0, 0x3c, 0xa5, 0xb2, 0xe7, 0x31, 0x84, 0xd5, 0xd5, 0xda,
+	0xea, 0xa1, 0x23, 0xea, 0x21, 0x6d, 0x36, 0xe4, 0x2f, 0xce, 0x86, 0xfc, 0xa1, 0x4d, 0x6b, 0x69,
+	0x64, 0xd3, 0x4a, 0xc3, 0xa2, 0xc0, 0xe5, 0x65, 0x19, 0x95, 0xfd, 0x83, 0xf1, 0x17, 0x0d, 0x36,
+	0xeb, 0xbd, 0xc3, 0x8e, 0x2d, 0x07, 0xe9, 0xf9, 0xc6, 0x25, 0xb4, 0x33, 0xf2, 0x58, 0xb9, 0x69,
+	0xd0, 0x31, 0x78, 0x88, 0xe0, 0xcd, 0xbe, 0xff, 0x32, 0x00, 0xc9, 0x0c, 0x01, 0x48, 0x08, 0xfb,
+	0x08, 0x1e, 0x59, 0xd0, 0x27, 0xbd, 0xf7, 0x53, 0xbc, 0xfd, 0x37, 0x0d, 0x60, 0x30, 0x8c, 0xd5,
+	0xc6, 0xd3, 0x28, 0xd7, 0xef, 0x9a, 0xf5, 0x46, 0xb9, 0xf1, 0x5e, 0xdd, 0x3c, 0xd8, 0xdb, 0xaf,
+	0x54, 0xf7, 0xef, 0xa4, 0xe6, 0xb2, 0xe7, 0x1e, 0x3f, 0x29, 0x6c, 0x0c, 0x04, 0x0f, 0x18, 0xfe,
+	0x3c, 0xa0, 0x92, 0x3f, 0x2c, 0x7f, 0xbb, 0xba, 0x5f, 0xbe, 0x57, 0xfd, 0x60, 0xaf, 0x92, 0xd2,
+	0xb2, 0x99, 0xc7, 0x4f, 0x0a, 0x9b, 0x03, 0x8d, 0xdb, 0xb6, 0x4b, 0x1d, 0xfb, 0x43, 0x66, 0xa9,
+	0x42, 0x19, 0xd1, 0x29, 0x57, 0xef, 0xed, 0x55, 0x52, 0xf3, 0xd9, 0xf4, 0xe3, 0x27, 0x85, 0xd4,
+	0x90, 0x02, 0xb5, 0x1d, 0x66, 0x8d, 0x7b, 0x54, 0xdf, 0x6b, 0x34, 0x94, 0xf8, 0xc2, 0xb8, 0x47,
+	0x75, 0x26, 0xa5, 0xc3, 0xac, 0x6c, 0xec, 0xa3, 0xdf, 0xe7, 0xe6, 0x76, 0xfe, 0x10, 0x83, 0x85,
+	0xfb, 0xa2, 0x45, 0x3e, 0x84, 0xc4, 0x50, 0x97, 0x91, 0x89, 0x2c, 0x8f, 0x62, 0x42, 0xf6, 0xe2,
+	0x4b, 0xf9, 0x01, 0xe0, 0xbe, 0xf9, 0xe8, 0xaf, 0xff, 0xfa, 0x64, 0xbe, 0x60, 0xe4, 0x4a, 0x13,
+	0x3f, 0x85, 0x95, 0x86, 0x2f, 0x7b, 0xa4, 0xc1, 0xda, 0x48, 0x93, 0x93, 0xc2, 0xb8, 0xf9, 0x71,
+	0x50, 0xca, 0x5e, 0x7a, 0x85, 0x44, 0xe0, 0xc2, 0x16, 0xba, 0x60, 0x18, 0x85, 0x29, 0x2e, 0x8c,
+	0x5e, 0xf9, 0x58, 0x83, 0xf5, 0xb1, 0xc9, 0x41, 0x8c, 0x97, 0x44, 0x19, 0x4c, 0xc5, 0xec, 0x5b,
+	0xaf, 0x94, 0x09, 0x5c, 0xd9, 0x46, 0x57, 0xbe, 0x67, 0x18, 0x2f, 0xcf, 0x06, 0x5e, 0xac, 0x70,
+	0x78, 0xbc, 0x12, 0xc9, 0x44, 0xce, 0xa7, 0x74, 0x5a, 0x76, 0xeb, 0xd5, 0x42, 0x81, 0x3f, 0x57,
+	0xd0, 0x9f, 0x4b, 0xc6, 0xc5, 0x29, 0xfe, 0x8c, 0x2b, 0x65, 0x17, 0x7f, 0xf5, 0xe2, 0xe9, 0xb6,
+	0xb6, 0x7b, 0xe7, 0xf3, 0xb3, 0x9c, 0xf6, 0xc5, 0x59, 0x4e, 0xfb, 0xe7, 0x59, 0x4e, 0xfb, 0xed,
+	0xf3, 0xdc, 0xdc, 0x17, 0xcf, 0x73, 0x73, 0x7f, 0x7f, 0x9e, 0x9b, 0xfb, 0xe0, 0xea, 0xd0, 0x8e,
+	0xb3, 0xe7, 0xdb, 0xdb, 0x67, 0xf2, 0x21, 0xf7, 0x8e, 0x23, 0xf3, 0x27, 0x78, 0x01, 0xae, 0x3b,
+	0x87, 0x4b, 0xf8, 0xfb, 0xe3, 0x3b, 0xff, 0x1b, 0x00, 0x24, 0xd7, 0xdc, 0xe4, 0x25, 0x15, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1524,6 +1709,43 @@ func (m *AVSInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AssetRewardAmountTaskBasis) > 0 {
+		for k := range m.AssetRewardAmountTaskBasis {
+			v := m.AssetRewardAmountTaskBasis[k]
+			baseI := i
+			i = encodeVarintTx(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTx(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.MaxTaskChallengePeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxTaskChallengePeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.VetoCommittee) > 0 {
+		for iNdEx := len(m.VetoCommittee) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VetoCommittee[iNdEx])
+			copy(dAtA[i:], m.VetoCommittee[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.VetoCommittee[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
 	if len(m.AssetRewardAmountEpochBasis) > 0 {
 		for k := range m.AssetRewardAmountEpochBasis {
 			v := m.AssetRewardAmountEpochBasis[k]
@@ -1796,6 +2018,20 @@ func (m *TaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StatisticsHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StatisticsHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
 	if m.OperatorActivePower != nil {
 		{
 			size, err := m.OperatorActivePower.MarshalToSizedBuffer(dAtA[:i])
@@ -1915,6 +2151,53 @@ func (m *TaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TaskStatisticsRetry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskStatisticsRetry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskStatisticsRetry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RetryCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RetryCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TaskID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TaskContractAddress) > 0 {
+		i -= len(m.TaskContractAddress)
+		copy(dAtA[i:], m.TaskContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OperatorActivePowerList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2491,6 +2774,23 @@ func (m *AVSInfo) Size() (n int) {
 			n += mapEntrySize + 2 + sovTx(uint64(mapEntrySize))
 		}
 	}
+	if len(m.VetoCommittee) > 0 {
+		for _, s := range m.VetoCommittee {
+			l = len(s)
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if m.MaxTaskChallengePeriod != 0 {
+		n += 2 + sovTx(uint64(m.MaxTaskChallengePeriod))
+	}
+	if len(m.AssetRewardAmountTaskBasis) > 0 {
+		for k, v := range m.AssetRewardAmountTaskBasis {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTx(uint64(len(k))) + 1 + sovTx(uint64(v))
+			n += mapEntrySize + 2 + sovTx(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -2615,6 +2915,35 @@ func (m *TaskInfo) Size() (n int) {
 		l = m.OperatorActivePower.Size()
 		n += 2 + l + sovTx(uint64(l))
 	}
+	if m.Status != 0 {
+		n += 2 + sovTx(uint64(m.Status))
+	}
+	if m.StatisticsHeight != 0 {
+		n += 2 + sovTx(uint64(m.StatisticsHeight))
+	}
+	return n
+}
+
+func (m *TaskStatisticsRetry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskID != 0 {
+		n += 1 + sovTx(uint64(m.TaskID))
+	}
+	if m.RetryCount != 0 {
+		n += 1 + sovTx(uint64(m.RetryCount))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3443,59 +3772,9 @@ func (m *AVSInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.AssetRewardAmountEpochBasis[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OperatorStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OperatorStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperatorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoCommittee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3523,13 +3802,13 @@ func (m *OperatorStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.VetoCommittee = append(m.VetoCommittee, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTaskChallengePeriod", wireType)
 			}
-			var stringLen uint64
+			m.MaxTaskChallengePeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3539,7 +3818,221 @@ func (m *OperatorStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxTaskChallengePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetRewardAmountTaskBasis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AssetRewardAmountTaskBasis == nil {
+				m.AssetRewardAmountTaskBasis = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTx
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTx
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTx(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTx
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AssetRewardAmountTaskBasis[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4282,6 +4775,196 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TaskStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatisticsHeight", wireType)
+			}
+			m.StatisticsHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatisticsHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskStatisticsRetry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskStatisticsRetry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskStatisticsRetry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskID", wireType)
+			}
+			m.TaskID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
+			}
+			m.RetryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
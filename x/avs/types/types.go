@@ -8,6 +8,8 @@ import (
 	"sort"
 	"strings"
 
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 
 	ibcclienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
@@ -46,6 +48,10 @@ var (
 	ChainIDPrefix = []byte("chain-id-prefix")
 )
 
+// MaxTaskStatisticsRetries is the maximum number of retries for the failed task result
+// statistics or settlement, the task is marked as failed once it's reached.
+const MaxTaskStatisticsRetries uint32 = 3
+
 type AVSRegisterOrDeregisterParams struct {
 	// AvsName is the name of the AVS as an arbitrary string.
 	AvsName string
@@ -88,6 +94,27 @@ var (
 	}
 )
 
+// ValidateVetoCommittee checks that the members of the veto committee are unique bech32
+// addresses, and that none of them is an owner of the AVS.
+func ValidateVetoCommittee(committee, owners []string) error {
+	seen := make(map[string]struct{}, len(committee))
+	for _, member := range committee {
+		if _, err := sdk.AccAddressFromBech32(member); err != nil {
+			return errorsmod.Wrapf(ErrInvalidVetoCommittee, "invalid member address %s: %s", member, err)
+		}
+		if _, ok := seen[member]; ok {
+			return errorsmod.Wrapf(ErrInvalidVetoCommittee, "duplicate member %s", member)
+		}
+		seen[member] = struct{}{}
+		for _, owner := range owners {
+			if owner == member {
+				return errorsmod.Wrapf(ErrInvalidVetoCommittee, "the owner %s can't be a member", member)
+			}
+		}
+	}
+	return nil
+}
+
 // ChainIDWithoutRevision returns the chainID without the revision number.
 // For example, "exocoretestnet_233-1" returns "exocoretestnet_233".
 func ChainIDWithoutRevision(chainID string) string {
@@ -32,6 +32,9 @@ func GetQueryCmd() *cobra.Command {
 		QueryUndelegationsByHeight(),
 		QueryUndelegationHoldCount(),
 		QueryAssociatedOperatorByStaker(),
+		QueryDelegationsByOperator(),
+		QueryStakersByAsset(),
+		QueryNSTSlashRecords(),
 	)
 	return cmd
 }
@@ -244,3 +247,127 @@ func QueryAssociatedOperatorByStaker() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// QueryDelegationsByOperator queries the delegations to the operator, optionally filtered by
+// the asset.
+func QueryDelegationsByOperator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueryDelegationsByOperator <operatorAddr> [assetID]",
+		Short: "Get the delegations to the operator",
+		Long:  "Get the delegations to the operator, all the assets are included if the assetID isn't provided",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			accAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return errorsmod.Wrap(types.ErrInvalidCliCmdArg, err.Error())
+			}
+			assetID := ""
+			if len(args) == 2 {
+				assetID = strings.ToLower(args[1])
+				if _, _, err := types.ValidateID(assetID, false, false); err != nil {
+					return errorsmod.Wrap(types.ErrInvalidCliCmdArg, err.Error())
+				}
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := delegationtype.NewQueryClient(clientCtx)
+			req := &delegationtype.QueryDelegationsByOperatorReq{
+				Operator:   accAddr.String(),
+				AssetID:    assetID,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.QueryDelegationsByOperator(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delegations by operator")
+	return cmd
+}
+
+// QueryStakersByAsset queries the stakers delegating the asset to any operator.
+func QueryStakersByAsset() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueryStakersByAsset <assetID>",
+		Short: "Get the stakers delegating the asset",
+		Long:  "Get the stakers delegating the asset to any operator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			assetID := strings.ToLower(args[0])
+			if _, _, err := types.ValidateID(assetID, false, false); err != nil {
+				return errorsmod.Wrap(types.ErrInvalidCliCmdArg, err.Error())
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := delegationtype.NewQueryClient(clientCtx)
+			req := &delegationtype.QueryStakersByAssetReq{
+				AssetID:    assetID,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.QueryStakersByAsset(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "stakers by asset")
+	return cmd
+}
+
+// QueryNSTSlashRecords queries the execution records of the native token balance drops of the
+// staker.
+func QueryNSTSlashRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueryNSTSlashRecords <stakerID> <assetID>",
+		Short: "Get the execution records of the native token balance drops",
+		Long:  "Get the execution records of the native token balance drops of the staker",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := delegationtype.NewQueryClient(clientCtx)
+			_, _, err = types.ValidateID(args[0], false, false)
+			if err != nil {
+				return err
+			}
+			_, _, err = types.ValidateID(args[1], false, false)
+			if err != nil {
+				return err
+			}
+			req := &delegationtype.QueryNSTSlashRecordsReq{
+				StakerID: strings.ToLower(args[0]),
+				AssetID:  strings.ToLower(args[1]),
+			}
+			res, err := queryClient.QueryNSTSlashRecords(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
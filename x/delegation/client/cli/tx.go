@@ -21,6 +21,8 @@ func NewTxCmd() *cobra.Command {
 		// add tx commands
 		CmdDelegate(),
 		CmdUndelegate(),
+		CmdRedelegate(),
+		CmdCancelUndelegation(),
 	)
 	return txCmd
 }
@@ -65,6 +65,60 @@ func CmdUndelegate() *cobra.Command {
 	return cmd
 }
 
+func CmdRedelegate() *cobra.Command {
+	cmd := &cobra.Command{
+		// TODO: only support native token for now
+		Use:   "redelegate asset-id src-operator dst-operator amount",
+		Short: "Broadcast a transaction to move amount of native token from the source operator to the destination operator",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			assetID, srcOperatorAddrStr, amount, err := parseArgs([]string{args[0], args[1], args[3]})
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedelegate(assetID, clientCtx.GetFromAddress().String(), srcOperatorAddrStr, args[2], amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdCancelUndelegation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-undelegation record-key",
+		Short: "Broadcast a transaction to cancel an immature undelegation and delegate the remaining amount back to the operator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelUndelegation(clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func parseArgs(args []string) (string, string, sdkmath.Int, error) {
 	if len(args) != 3 {
 		return "", "", sdkmath.ZeroInt(), errors.New("3 arguments needed")
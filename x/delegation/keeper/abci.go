@@ -15,6 +15,7 @@ func (k *Keeper) EndBlock(
 	originalCtx sdk.Context, _ abci.RequestEndBlock,
 ) []abci.ValidatorUpdate {
 	logger := k.Logger(originalCtx)
+	k.completeRedelegations(originalCtx)
 	records, err := k.GetPendingUndelegationRecords(
 		originalCtx, uint64(originalCtx.BlockHeight()),
 	)
@@ -36,20 +37,8 @@ func (k *Keeper) EndBlock(
 			record.BlockNumber, record.LzTxNonce, record.TxHash, record.OperatorAddr,
 		)
 		if k.GetUndelegationHoldCount(cc, recordID) > 0 {
-			// delete from all 3 states
-			if err := k.DeleteUndelegationRecord(cc, record); err != nil {
-				logger.Error("failed to delete undelegation record", "error", err)
-				continue
-			}
-			// add back to all 3 states, with the new block height
-			// #nosec G701
-			record.CompleteBlockNumber = uint64(cc.BlockHeight()) + 1
-			if err := k.SetUndelegationRecords(
-				cc, []types.UndelegationRecord{*record},
-			); err != nil {
-				logger.Error("failed to set undelegation records", "error", err)
-				continue
-			}
+			// the record is completed once the last hold is released
+			k.holdUndelegationRecord(cc, record)
 			writeCache()
 			continue
 		}
@@ -127,3 +116,23 @@ func (k *Keeper) EndBlock(
 	}
 	return []abci.ValidatorUpdate{}
 }
+
+// completeRedelegations deletes the redelegation records that mature at the end of the current
+// block, after which the redelegated amount can't be slashed by the source operator anymore.
+func (k *Keeper) completeRedelegations(ctx sdk.Context) {
+	// #nosec G115
+	records, err := k.GetPendingRedelegationRecords(ctx, uint64(ctx.BlockHeight()))
+	if err != nil {
+		k.Logger(ctx).Error("Error in GetPendingRedelegationRecords during the delegation's EndBlock execution", "error", err)
+		return
+	}
+	for _, record := range records {
+		recordKey := types.GetRedelegationRecordKey(record.BlockNumber, record.LzTxNonce, record.TxHash, record.SrcOperatorAddr)
+		if k.GetRedelegationHoldCount(ctx, recordKey) > 0 {
+			// keep the held record slashable until the last hold is released
+			k.holdRedelegationRecord(ctx, record)
+			continue
+		}
+		k.DeleteRedelegationRecord(ctx, record)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
 	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -38,6 +39,9 @@ func (k *Keeper) delegateTo(
 	}
 
 	stakerID, assetID := assetstype.GetStakerIDAndAssetID(params.ClientChainID, params.StakerAddress, params.AssetsAddress)
+	if notGenesis && k.slashKeeper.IsStakerFrozen(ctx, stakerID) {
+		return errorsmod.Wrapf(delegationtype.ErrStakerIsFrozen, "stakerID:%s", stakerID)
+	}
 	if assetID != assetstype.ExocoreAssetID {
 		// check if the staker asset has been deposited and the canWithdraw amount is bigger than the delegation amount
 		info, err := k.assetsKeeper.GetStakerSpecifiedAssetInfo(ctx, stakerID, assetID)
@@ -63,14 +67,30 @@ func (k *Keeper) delegateTo(
 			return err
 		}
 	}
+	if err := k.addShare(ctx, params.OperatorAddress, stakerID, assetID, params.OpAmount); err != nil {
+		return err
+	}
+
+	if notGenesis {
+		// call the hooks registered by the other modules
+		return k.Hooks().AfterDelegation(ctx, params.OperatorAddress, stakerID, assetID)
+	}
+	return nil
+}
+
+// addShare converts the amount to the share of the operator, and updates the states of the
+// operator and the delegation accordingly.
+func (k *Keeper) addShare(
+	ctx sdk.Context, operatorAddr sdk.AccAddress, stakerID, assetID string, amount sdkmath.Int,
+) error {
 	// calculate the share from the delegation amount
-	share, err := k.CalculateShare(ctx, params.OperatorAddress, assetID, params.OpAmount)
+	share, err := k.CalculateShare(ctx, operatorAddr, assetID, amount)
 	if err != nil {
 		return err
 	}
 
 	deltaOperatorAsset := assetstype.DeltaOperatorSingleAsset{
-		TotalAmount: params.OpAmount,
+		TotalAmount: amount,
 		TotalShare:  share,
 	}
 	// Check if the staker belongs to the delegated operator. Increase the operator's share if yes.
@@ -78,11 +98,11 @@ func (k *Keeper) delegateTo(
 	if err != nil {
 		return err
 	}
-	if operator == params.OperatorAddress.String() {
+	if operator == operatorAddr.String() {
 		deltaOperatorAsset.OperatorShare = share
 	}
 
-	err = k.assetsKeeper.UpdateOperatorAssetState(ctx, params.OperatorAddress, assetID, deltaOperatorAsset)
+	err = k.assetsKeeper.UpdateOperatorAssetState(ctx, operatorAddr, assetID, deltaOperatorAsset)
 	if err != nil {
 		return err
 	}
@@ -90,20 +110,11 @@ func (k *Keeper) delegateTo(
 	deltaAmount := &delegationtype.DeltaDelegationAmounts{
 		UndelegatableShare: share,
 	}
-	_, err = k.UpdateDelegationState(ctx, stakerID, assetID, params.OperatorAddress.String(), deltaAmount)
-	if err != nil {
-		return err
-	}
-	err = k.AppendStakerForOperator(ctx, params.OperatorAddress.String(), assetID, stakerID)
+	_, err = k.UpdateDelegationState(ctx, stakerID, assetID, operatorAddr.String(), deltaAmount)
 	if err != nil {
 		return err
 	}
-
-	if notGenesis {
-		// call the hooks registered by the other modules
-		k.Hooks().AfterDelegation(ctx, params.OperatorAddress)
-	}
-	return nil
+	return k.AppendStakerForOperator(ctx, operatorAddr.String(), assetID, stakerID)
 }
 
 // UndelegateFrom: The undelegation needs to consider whether the operator's opted-in assets can exit from the AVS.
@@ -117,8 +128,15 @@ func (k *Keeper) UndelegateFrom(ctx sdk.Context, params *delegationtype.Delegati
 	if !k.operatorKeeper.IsOperator(ctx, params.OperatorAddress) {
 		return delegationtype.ErrOperatorNotExist
 	}
+	// the frozen operator can't be undelegated from until it's unfrozen
+	if k.slashKeeper.IsOperatorFrozen(ctx, params.OperatorAddress) {
+		return delegationtype.ErrOperatorIsFrozen
+	}
 	// get staker delegation state, then check the validation of Undelegation amount
 	stakerID, assetID := assetstype.GetStakerIDAndAssetID(params.ClientChainID, params.StakerAddress, params.AssetsAddress)
+	if k.slashKeeper.IsStakerFrozen(ctx, stakerID) {
+		return errorsmod.Wrapf(delegationtype.ErrStakerIsFrozen, "stakerID:%s", stakerID)
+	}
 
 	// verify the undelegation amount
 	share, err := k.ValidateUndelegationAmount(ctx, params.OperatorAddress, stakerID, assetID, params.OpAmount)
@@ -153,6 +171,209 @@ func (k *Keeper) UndelegateFrom(ctx sdk.Context, params *delegationtype.Delegati
 	return k.Hooks().AfterUndelegationStarted(ctx, params.OperatorAddress, delegationtype.GetUndelegationRecordKey(r.BlockNumber, r.LzTxNonce, r.TxHash, r.OperatorAddr))
 }
 
+// CancelUndelegationRecord cancels an immature undelegation of the staker. The remaining amount
+// of the undelegation, which is the ActualCompletedAmount after any slashing, is delegated back
+// to the original operator, and the record is removed from all the undelegation indexes. The
+// amount slashed during the unbonding period isn't restored. A record is deleted as soon as it
+// completes, so any record still in the store, including the one held beyond its completion
+// height by the AVSs, can be canceled. The holds of the record are released as well.
+func (k *Keeper) CancelUndelegationRecord(ctx sdk.Context, params *delegationtype.CancelUndelegationParams) error {
+	records, err := k.GetUndelegationRecords(ctx, []string{params.RecordKey})
+	if err != nil {
+		return err
+	}
+	record := records[0]
+	stakerID, _ := assetstype.GetStakerIDAndAssetID(params.ClientChainID, params.StakerAddress, nil)
+	if record.StakerID != stakerID {
+		return errorsmod.Wrapf(delegationtype.ErrUndelegationNotCancelable, "the record belongs to stakerID:%s, not %s", record.StakerID, stakerID)
+	}
+	if !record.ActualCompletedAmount.IsPositive() {
+		return errorsmod.Wrapf(delegationtype.ErrUndelegationNotCancelable, "the record has been fully slashed, amount:%s", record.Amount)
+	}
+	operatorAccAddr, err := sdk.AccAddressFromBech32(record.OperatorAddr)
+	if err != nil {
+		return err
+	}
+	if k.slashKeeper.IsOperatorFrozen(ctx, operatorAccAddr) {
+		return errorsmod.Wrapf(delegationtype.ErrOperatorIsFrozen, "operatorAddr:%s", record.OperatorAddr)
+	}
+	if k.slashKeeper.IsStakerFrozen(ctx, stakerID) {
+		return errorsmod.Wrapf(delegationtype.ErrStakerIsFrozen, "stakerID:%s", stakerID)
+	}
+
+	// revert the pending amounts added by the undelegation, the native token is still in the
+	// delegated pool so no transfer is needed.
+	recordAmountNeg := record.Amount.Neg()
+	_, err = k.UpdateDelegationState(ctx, record.StakerID, record.AssetID, record.OperatorAddr, &delegationtype.DeltaDelegationAmounts{
+		WaitUndelegationAmount: recordAmountNeg,
+	})
+	if err != nil {
+		return err
+	}
+	if record.AssetID != assetstype.ExocoreAssetID {
+		err = k.assetsKeeper.UpdateStakerAssetState(ctx, record.StakerID, record.AssetID, assetstype.DeltaStakerSingleAsset{
+			PendingUndelegationAmount: recordAmountNeg,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	err = k.assetsKeeper.UpdateOperatorAssetState(ctx, operatorAccAddr, record.AssetID, assetstype.DeltaOperatorSingleAsset{
+		PendingUndelegationAmount: recordAmountNeg,
+	})
+	if err != nil {
+		return err
+	}
+	if err := k.addShare(ctx, operatorAccAddr, record.StakerID, record.AssetID, record.ActualCompletedAmount); err != nil {
+		return err
+	}
+	// let the holders (e.g. operator and dogfood) drop their maturity entries, and then clear
+	// the hold count, so that nothing is released for the deleted record later.
+	recordKey := []byte(params.RecordKey)
+	if err := k.Hooks().AfterUndelegationCanceled(ctx, operatorAccAddr, recordKey); err != nil {
+		return err
+	}
+	k.clearUndelegationHoldCount(ctx, recordKey)
+	if err := k.DeleteUndelegationRecord(ctx, record); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			delegationtype.EventTypeCancelUndelegation,
+			sdk.NewAttribute(delegationtype.AttributeKeyRecordKey, params.RecordKey),
+			sdk.NewAttribute(delegationtype.AttributeKeyStakerID, record.StakerID),
+			sdk.NewAttribute(delegationtype.AttributeKeyAssetID, record.AssetID),
+			sdk.NewAttribute(delegationtype.AttributeKeyOperator, record.OperatorAddr),
+			sdk.NewAttribute(delegationtype.AttributeKeyAmount, record.Amount.String()),
+			sdk.NewAttribute(delegationtype.AttributeKeyRestoredAmount, record.ActualCompletedAmount.String()),
+		),
+	)
+
+	// call the hooks registered by the other modules
+	return k.Hooks().AfterDelegation(ctx, operatorAccAddr, record.StakerID, record.AssetID)
+}
+
+// Redelegate moves the delegated asset of a staker from the source operator to the destination
+// operator without passing through the unbonding queue. The moved amount is recorded, and it
+// remains slashable by the source operator until the unbonding period of the source operator
+// elapses. Like the SDK staking module, the asset received by an immature redelegation can't
+// be redelegated again, which prevents the staker from chaining redelegations to escape the
+// slashing.
+func (k *Keeper) Redelegate(ctx sdk.Context, params *delegationtype.RedelegationParams) error {
+	if !params.OpAmount.IsPositive() {
+		return delegationtype.ErrAmountIsNotPositive
+	}
+	if params.SrcOperatorAddress.Equals(params.DstOperatorAddress) {
+		return delegationtype.ErrSelfRedelegation
+	}
+	for _, operator := range []sdk.AccAddress{params.SrcOperatorAddress, params.DstOperatorAddress} {
+		if !k.operatorKeeper.IsOperator(ctx, operator) {
+			return errorsmod.Wrap(delegationtype.ErrOperatorNotExist, fmt.Sprintf("input operatorAddr is:%s", operator))
+		}
+		if k.slashKeeper.IsOperatorFrozen(ctx, operator) {
+			return errorsmod.Wrapf(delegationtype.ErrOperatorIsFrozen, "operatorAddr:%s", operator)
+		}
+	}
+	stakerID, assetID := assetstype.GetStakerIDAndAssetID(params.ClientChainID, params.StakerAddress, params.AssetsAddress)
+	if k.slashKeeper.IsStakerFrozen(ctx, stakerID) {
+		return errorsmod.Wrapf(delegationtype.ErrStakerIsFrozen, "stakerID:%s", stakerID)
+	}
+	srcOperator := params.SrcOperatorAddress.String()
+	if k.HasReceivingRedelegation(ctx, stakerID, assetID, srcOperator) {
+		return errorsmod.Wrapf(delegationtype.ErrTransitiveRedelegation, "stakerID:%s assetID:%s operator:%s", stakerID, assetID, srcOperator)
+	}
+
+	// remove the share from the source operator and add the removed token to the destination
+	// operator, the staker asset state doesn't change since the asset is still delegated.
+	share, err := k.ValidateUndelegationAmount(ctx, params.SrcOperatorAddress, stakerID, assetID, params.OpAmount)
+	if err != nil {
+		return err
+	}
+	removeToken, err := k.RemoveShare(ctx, false, params.SrcOperatorAddress, stakerID, assetID, share)
+	if err != nil {
+		return err
+	}
+	if !removeToken.IsPositive() {
+		return errorsmod.Wrapf(delegationtype.ErrAmountIsNotPositive, "the redelegated amount is:%s", removeToken)
+	}
+	if err := k.addShare(ctx, params.DstOperatorAddress, stakerID, assetID, removeToken); err != nil {
+		return err
+	}
+
+	// record the redelegation to keep it slashable by the source operator
+	r := delegationtype.RedelegationRecord{
+		StakerID:        stakerID,
+		AssetID:         assetID,
+		SrcOperatorAddr: srcOperator,
+		DstOperatorAddr: params.DstOperatorAddress.String(),
+		TxHash:          params.TxHash.String(),
+		LzTxNonce:       params.LzNonce,
+		// #nosec G115
+		BlockNumber:     uint64(ctx.BlockHeight()),
+		InitialAmount:   removeToken,
+		SlashableAmount: removeToken,
+	}
+	r.CompleteBlockNumber = k.operatorKeeper.GetUnbondingExpirationBlockNumber(ctx, params.SrcOperatorAddress, r.BlockNumber)
+	// the redelegations in the same tx share the nonce and the hash, so the hash is suffixed
+	// with the index of the redelegation in the tx to avoid overwriting the previous records.
+	for index := 1; k.hasRedelegationRecordKeys(ctx, &r); index++ {
+		r.TxHash = fmt.Sprintf("%s-%d", params.TxHash.String(), index)
+	}
+	err = k.SetRedelegationRecords(ctx, []delegationtype.RedelegationRecord{r})
+	if err != nil {
+		return err
+	}
+
+	// call the hooks registered by the other modules
+	if err := k.Hooks().AfterDelegation(ctx, params.DstOperatorAddress, stakerID, assetID); err != nil {
+		return err
+	}
+	return k.Hooks().AfterRedelegationStarted(ctx, params.SrcOperatorAddress, delegationtype.GetRedelegationRecordKey(r.BlockNumber, r.LzTxNonce, r.TxHash, r.SrcOperatorAddr))
+}
+
+// SlashRedelegation slashes the redelegated asset which is still slashable by the source
+// operator. The slashed amount is calculated from the initial amount of the redelegation,
+// and it's removed from the share of the staker on the destination operator. It returns
+// the actual amount removed from the destination operator.
+func (k *Keeper) SlashRedelegation(
+	ctx sdk.Context, record *delegationtype.RedelegationRecord, slashProportion sdkmath.LegacyDec,
+) (sdkmath.Int, error) {
+	slashAmount := slashProportion.MulInt(record.InitialAmount).TruncateInt()
+	if slashAmount.GT(record.SlashableAmount) {
+		slashAmount = record.SlashableAmount
+	}
+	if !slashAmount.IsPositive() {
+		return sdkmath.ZeroInt(), nil
+	}
+	// the staker might have undelegated from the destination operator, so only the
+	// remaining share can be slashed.
+	dstOperator := sdk.MustAccAddressFromBech32(record.DstOperatorAddr)
+	share, err := k.CalculateSlashShare(ctx, dstOperator, record.StakerID, record.AssetID, slashAmount)
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+	removedToken := sdkmath.ZeroInt()
+	if share.IsPositive() {
+		removedToken, err = k.RemoveShare(ctx, false, dstOperator, record.StakerID, record.AssetID, share)
+		if err != nil {
+			return sdkmath.ZeroInt(), err
+		}
+		// the slash is removed from the delegation to the destination operator, which isn't
+		// the one being slashed, so its hooks are notified as for the other slashes outside
+		// of its own slashing flow.
+		if err := k.Hooks().AfterDelegationSlashed(ctx, dstOperator, record.StakerID, record.AssetID, removedToken); err != nil {
+			return sdkmath.ZeroInt(), err
+		}
+	}
+	record.SlashableAmount = record.SlashableAmount.Sub(slashAmount)
+	err = k.SetRedelegationRecords(ctx, []delegationtype.RedelegationRecord{*record})
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+	return removedToken, nil
+}
+
 // AssociateOperatorWithStaker marks that a staker is claiming to be associated with an operator.
 // In other words, the staker's delegations will be marked as self-delegations for the operator.
 // Each stakerID can associate, at most, to one operator. To change that operator, the staker must
@@ -224,6 +445,11 @@ func (k *Keeper) DissociateOperatorFromStaker(
 	stakerAddress []byte,
 ) error {
 	stakerID, _ := assetstype.GetStakerIDAndAssetID(clientChainID, stakerAddress, nil)
+	// the staker associated with a frozen operator is frozen as well, so it can't escape
+	// the freezing by dissociating from the operator.
+	if k.slashKeeper.IsStakerFrozen(ctx, stakerID) {
+		return errorsmod.Wrapf(delegationtype.ErrStakerIsFrozen, "stakerID:%s", stakerID)
+	}
 	associatedOperator, err := k.GetAssociatedOperator(ctx, stakerID)
 	if err != nil {
 		return err
@@ -450,3 +450,79 @@ func (suite *DelegationTestSuite) TestCompleteUndelegation() {
 	suite.NoError(err)
 	suite.Equal(0, len(waitUndelegationRecords))
 }
+
+func (suite *DelegationTestSuite) TestCancelUndelegation() {
+	suite.basicPrepare()
+	suite.prepareDeposit(suite.depositAmount)
+	delegationEvent := suite.prepareDelegation(suite.delegationAmount, suite.opAccAddr)
+	delegationEvent.LzNonce = 1
+	delegationEvent.OpAmount = sdkmath.NewInt(20)
+	err := suite.App.DelegationKeeper.UndelegateFrom(suite.Ctx, delegationEvent)
+	suite.NoError(err)
+
+	stakerID, assetID := types.GetStakerIDAndAssetID(delegationEvent.ClientChainID, delegationEvent.StakerAddress, delegationEvent.AssetsAddress)
+	records, err := suite.App.DelegationKeeper.GetStakerUndelegationRecords(suite.Ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(1, len(records))
+	record := records[0]
+	recordKey := string(delegationtype.GetUndelegationRecordKey(record.BlockNumber, record.LzTxNonce, record.TxHash, record.OperatorAddr))
+	// simulate the slashing during the unbonding period
+	record.ActualCompletedAmount = sdkmath.NewInt(15)
+	err = suite.App.DelegationKeeper.SetUndelegationRecords(suite.Ctx, []delegationtype.UndelegationRecord{*record})
+	suite.NoError(err)
+
+	// only the staker of the record can cancel it
+	cancelParams := &delegationtype.CancelUndelegationParams{
+		ClientChainID: delegationEvent.ClientChainID,
+		StakerAddress: common.HexToAddress("0x3e108c058e8066DA635321Dc3018294cA82ddEdf").Bytes(),
+		RecordKey:     recordKey,
+	}
+	err = suite.App.DelegationKeeper.CancelUndelegationRecord(suite.Ctx, cancelParams)
+	suite.ErrorIs(err, delegationtype.ErrUndelegationNotCancelable)
+
+	// simulate the holds of the operator and dogfood modules
+	err = suite.App.OperatorKeeper.SetAllUnbondingMaturities(suite.Ctx, []operatortype.UnbondingMaturity{
+		{RecordKey: recordKey, OperatorAddress: suite.opAccAddr.String(), EpochIdentifier: "day", MaturityEpoch: 10},
+	})
+	suite.NoError(err)
+	suite.App.StakingKeeper.AppendUndelegationToMature(suite.Ctx, 10, []byte(recordKey))
+	suite.App.StakingKeeper.SetUndelegationMaturityEpoch(suite.Ctx, []byte(recordKey), 10)
+	err = suite.App.DelegationKeeper.IncrementUndelegationHoldCount(suite.Ctx, []byte(recordKey))
+	suite.NoError(err)
+
+	cancelParams.StakerAddress = delegationEvent.StakerAddress
+	err = suite.App.DelegationKeeper.CancelUndelegationRecord(suite.Ctx, cancelParams)
+	suite.NoError(err)
+
+	// the holds are released along with the maturity entries
+	suite.Equal(uint64(0), suite.App.DelegationKeeper.GetUndelegationHoldCount(suite.Ctx, []byte(recordKey)))
+	_, found := suite.App.OperatorKeeper.GetUnbondingMaturity(suite.Ctx, false, recordKey)
+	suite.False(found)
+	suite.Len(suite.App.StakingKeeper.GetUndelegationsToMature(suite.Ctx, 10), 0)
+	_, found = suite.App.StakingKeeper.GetUndelegationMaturityEpoch(suite.Ctx, []byte(recordKey))
+	suite.False(found)
+
+	// the remaining amount is delegated back to the operator
+	restakerState, err := suite.App.AssetsKeeper.GetStakerSpecifiedAssetInfo(suite.Ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(suite.depositAmount.Sub(suite.delegationAmount), restakerState.WithdrawableAmount)
+	suite.Equal(sdkmath.NewInt(0), restakerState.PendingUndelegationAmount)
+	operatorState, err := suite.App.AssetsKeeper.GetOperatorSpecifiedAssetInfo(suite.Ctx, suite.opAccAddr, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(45), operatorState.TotalAmount)
+	suite.Equal(sdkmath.NewInt(0), operatorState.PendingUndelegationAmount)
+	delegationInfo, err := suite.App.DelegationKeeper.GetSingleDelegationInfo(suite.Ctx, stakerID, assetID, suite.opAccAddr.String())
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(0), delegationInfo.WaitUndelegationAmount)
+	suite.Equal(sdkmath.LegacyNewDec(45), delegationInfo.UndelegatableShare)
+
+	// the record is removed from all the indexes
+	records, err = suite.App.DelegationKeeper.GetStakerUndelegationRecords(suite.Ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(0, len(records))
+	records, err = suite.App.DelegationKeeper.GetPendingUndelegationRecords(suite.Ctx, record.CompleteBlockNumber)
+	suite.NoError(err)
+	suite.Equal(0, len(records))
+	err = suite.App.DelegationKeeper.CancelUndelegationRecord(suite.Ctx, cancelParams)
+	suite.ErrorIs(err, delegationtype.ErrNoKeyInTheStore)
+}
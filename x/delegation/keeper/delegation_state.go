@@ -219,6 +219,7 @@ func (k *Keeper) AppendStakerForOperator(ctx sdk.Context, operator, assetID, sta
 	stakers.Stakers = append(stakers.Stakers, stakerID)
 	bz := k.cdc.MustMarshal(&stakers)
 	store.Set(Key, bz)
+	k.addStakerToIndexes(ctx, operator, assetID, stakerID)
 	return nil
 }
 
@@ -234,6 +235,7 @@ func (k *Keeper) DeleteStakerForOperator(ctx sdk.Context, operator, assetID, sta
 	for i, v := range stakers.Stakers {
 		if v == stakerID {
 			stakers.Stakers = append(stakers.Stakers[:i], stakers.Stakers[i+1:]...)
+			k.removeStakerFromIndexes(ctx, operator, assetID, stakerID)
 			break
 		}
 	}
@@ -245,13 +247,55 @@ func (k *Keeper) DeleteStakerForOperator(ctx sdk.Context, operator, assetID, sta
 func (k *Keeper) DeleteStakersListForOperator(ctx sdk.Context, operator, assetID string) error {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixStakersByOperator)
 	Key := assetstype.GetJoinedStoreKey(operator, assetID)
-	if !store.Has(Key) {
+	value := store.Get(Key)
+	if value == nil {
 		return delegationtype.ErrNoKeyInTheStore
 	}
+	stakers := delegationtype.StakerList{}
+	k.cdc.MustUnmarshal(value, &stakers)
+	for _, stakerID := range stakers.Stakers {
+		k.removeStakerFromIndexes(ctx, operator, assetID, stakerID)
+	}
 	store.Delete(Key)
 	return nil
 }
 
+// addStakerToIndexes adds the staker to the per-key indexes of the delegations to the operator
+// and of the stakers delegating the asset. The caller must ensure that the staker wasn't in the
+// staker list of the operator and asset before.
+func (k Keeper) addStakerToIndexes(ctx sdk.Context, operator, assetID, stakerID string) {
+	byOperator := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixDelegationsByOperator)
+	byOperator.Set(assetstype.GetJoinedStoreKey(operator, assetID, stakerID), []byte{})
+
+	byAsset := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixStakersByAsset)
+	key := assetstype.GetJoinedStoreKey(assetID, stakerID)
+	count := uint64(0)
+	if value := byAsset.Get(key); value != nil {
+		count = sdk.BigEndianToUint64(value)
+	}
+	byAsset.Set(key, sdk.Uint64ToBigEndian(count+1))
+}
+
+// removeStakerFromIndexes is the reverse of addStakerToIndexes. The staker is removed from the
+// stakers of the asset once it doesn't delegate the asset to any operator.
+func (k Keeper) removeStakerFromIndexes(ctx sdk.Context, operator, assetID, stakerID string) {
+	byOperator := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixDelegationsByOperator)
+	byOperator.Delete(assetstype.GetJoinedStoreKey(operator, assetID, stakerID))
+
+	byAsset := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixStakersByAsset)
+	key := assetstype.GetJoinedStoreKey(assetID, stakerID)
+	value := byAsset.Get(key)
+	if value == nil {
+		return
+	}
+	count := sdk.BigEndianToUint64(value)
+	if count <= 1 {
+		byAsset.Delete(key)
+		return
+	}
+	byAsset.Set(key, sdk.Uint64ToBigEndian(count-1))
+}
+
 func (k Keeper) HasStakerList(ctx sdk.Context, operator, assetID string) bool {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixStakersByOperator)
 	Key := assetstype.GetJoinedStoreKey(operator, assetID)
@@ -270,6 +314,50 @@ func (k Keeper) GetStakersByOperator(ctx sdk.Context, operator, assetID string)
 	return stakerList, nil
 }
 
+// IterateStakersByOperator iterates the staker lists of all the assets delegated to the
+// operator in the order of the assetID.
+func (k Keeper) IterateStakersByOperator(
+	ctx sdk.Context, operator string, opFunc func(assetID string, stakers []string) (bool, error),
+) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixStakersByOperator)
+	iterator := sdk.KVStorePrefixIterator(store, assetstype.GetJoinedStoreKeyForPrefix(operator))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		keys, err := assetstype.ParseJoinedStoreKey(iterator.Key(), 2)
+		if err != nil {
+			return err
+		}
+		var stakers delegationtype.StakerList
+		k.cdc.MustUnmarshal(iterator.Value(), &stakers)
+		isBreak, err := opFunc(keys[1], stakers.Stakers)
+		if err != nil {
+			return err
+		}
+		if isBreak {
+			break
+		}
+	}
+	return nil
+}
+
+// GetStakersByAsset returns the sorted stakers delegating the asset to any operator.
+func (k Keeper) GetStakersByAsset(ctx sdk.Context, assetID string) ([]string, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixStakersByAsset)
+	iterator := sdk.KVStorePrefixIterator(store, assetstype.GetJoinedStoreKeyForPrefix(assetID))
+	defer iterator.Close()
+
+	ret := make([]string, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys, err := assetstype.ParseJoinedStoreKey(iterator.Key(), 2)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, keys[1])
+	}
+	return ret, nil
+}
+
 func (k Keeper) AllStakerList(ctx sdk.Context) (stakerList []delegationtype.StakersByOperator, err error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixStakersByOperator)
 	iterator := sdk.KVStorePrefixIterator(store, []byte{})
@@ -291,13 +379,32 @@ func (k Keeper) SetAllStakerList(ctx sdk.Context, stakersByOperator []delegation
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixStakersByOperator)
 	for i := range stakersByOperator {
 		singleElement := stakersByOperator[i]
+		keys, err := assetstype.ParseJoinedStoreKey([]byte(singleElement.Key), 2)
+		if err != nil {
+			return err
+		}
 		bz := k.cdc.MustMarshal(&delegationtype.StakerList{Stakers: singleElement.Stakers})
 		store.Set([]byte(singleElement.Key), bz)
+		for _, stakerID := range singleElement.Stakers {
+			k.addStakerToIndexes(ctx, keys[0], keys[1], stakerID)
+		}
 	}
 	return nil
 }
 
+// SetStakerShareToZero clears the shares of the stakers delegating the asset to the operator,
+// which is used when the asset pool of the operator is slashed to zero. The whole amount
+// represented by the share of each staker is slashed, so the hooks are notified of it.
 func (k *Keeper) SetStakerShareToZero(ctx sdk.Context, operator, assetID string, stakerList delegationtype.StakerList) error {
+	opAccAddr, err := sdk.AccAddressFromBech32(operator)
+	if err != nil {
+		return err
+	}
+	// the asset pool hasn't been updated yet, so the amounts are calculated from it.
+	opAsset, err := k.assetsKeeper.GetOperatorSpecifiedAssetInfo(ctx, opAccAddr, assetID)
+	if err != nil {
+		return err
+	}
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixRestakerDelegationInfo)
 	for _, stakerID := range stakerList.Stakers {
 		singleStateKey := assetstype.GetJoinedStoreKey(stakerID, assetID, operator)
@@ -305,9 +412,16 @@ func (k *Keeper) SetStakerShareToZero(ctx sdk.Context, operator, assetID string,
 		if value != nil {
 			delegationState := delegationtype.DelegationAmounts{}
 			k.cdc.MustUnmarshal(value, &delegationState)
+			slashedAmount, err := TokensFromShares(delegationState.UndelegatableShare, opAsset.TotalShare, opAsset.TotalAmount)
+			if err != nil {
+				return err
+			}
 			delegationState.UndelegatableShare = sdkmath.LegacyNewDec(0)
 			bz := k.cdc.MustMarshal(&delegationState)
 			store.Set(singleStateKey, bz)
+			if err := k.Hooks().AfterDelegationSlashed(ctx, opAccAddr, stakerID, assetID, slashedAmount); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
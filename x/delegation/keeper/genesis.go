@@ -41,10 +41,30 @@ func (k Keeper) InitGenesis(
 	if err != nil {
 		panic(errorsmod.Wrap(err, "failed to set all staker list"))
 	}
+	// the records past their completion heights were held when exported, they're scheduled at
+	// the current height, and those still held are moved to the held indexes at the end of the
+	// block.
+	// #nosec G115
+	height := uint64(ctx.BlockHeight())
+	for i := range gs.Undelegations {
+		if gs.Undelegations[i].CompleteBlockNumber < height {
+			gs.Undelegations[i].CompleteBlockNumber = height
+		}
+	}
+	for i := range gs.Redelegations {
+		if gs.Redelegations[i].CompleteBlockNumber < height {
+			gs.Redelegations[i].CompleteBlockNumber = height
+		}
+	}
 	err = k.SetUndelegationRecords(ctx, gs.Undelegations)
 	if err != nil {
 		panic(errorsmod.Wrap(err, "failed to set all undelegation records"))
 	}
+	err = k.SetRedelegationRecords(ctx, gs.Redelegations)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "failed to set all redelegation records"))
+	}
+	k.SetAllNSTSlashRecords(ctx, gs.NSTSlashRecords)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -70,5 +90,12 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *delegationtype.GenesisState {
 	if err != nil {
 		panic(errorsmod.Wrap(err, "failed to get all undelegations").Error())
 	}
+
+	res.Redelegations, err = k.AllRedelegations(ctx)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "failed to get all redelegations").Error())
+	}
+
+	res.NSTSlashRecords = k.AllNSTSlashRecords(ctx)
 	return &res
 }
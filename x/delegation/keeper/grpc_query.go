@@ -3,8 +3,11 @@ package keeper
 import (
 	"context"
 
+	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
 	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var _ delegationtype.QueryServer = &Keeper{}
@@ -57,3 +60,78 @@ func (k Keeper) QueryAssociatedOperatorByStaker(ctx context.Context, req *delega
 		Operator: operator,
 	}, nil
 }
+
+// QueryNSTSlashRecords queries the execution records of the native token balance drops of the
+// staker.
+func (k Keeper) QueryNSTSlashRecords(ctx context.Context, req *delegationtype.QueryNSTSlashRecordsReq) (*delegationtype.QueryNSTSlashRecordsResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	return &delegationtype.QueryNSTSlashRecordsResponse{
+		Records: k.GetNSTSlashRecords(c, req.StakerID, req.AssetID),
+	}, nil
+}
+
+// QueryDelegationsByOperator queries the delegations to the operator with pagination. The
+// delegations are ordered by the assetID and then by the stakerID.
+func (k *Keeper) QueryDelegationsByOperator(ctx context.Context, req *delegationtype.QueryDelegationsByOperatorReq) (*delegationtype.QueryDelegationsByOperatorResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	if _, err := sdk.AccAddressFromBech32(req.Operator); err != nil {
+		return nil, delegationtype.ErrOperatorAddrIsNotAccAddr.Wrapf("operator:%s, err:%s", req.Operator, err)
+	}
+	storePrefix := assetstype.GetJoinedStoreKeyForPrefix(req.Operator)
+	if req.AssetID != "" {
+		storePrefix = assetstype.GetJoinedStoreKeyForPrefix(req.Operator, req.AssetID)
+	}
+	store := prefix.NewStore(
+		prefix.NewStore(c.KVStore(k.storeKey), delegationtype.KeyPrefixDelegationsByOperator),
+		storePrefix,
+	)
+	delegations := make([]delegationtype.DelegationByOperator, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		assetID, stakerID := req.AssetID, string(key)
+		if assetID == "" {
+			keys, err := assetstype.ParseJoinedStoreKey(key, 2)
+			if err != nil {
+				return err
+			}
+			assetID, stakerID = keys[0], keys[1]
+		}
+		amounts, err := k.GetSingleDelegationInfo(c, stakerID, assetID, req.Operator)
+		if err != nil {
+			return err
+		}
+		delegations = append(delegations, delegationtype.DelegationByOperator{
+			StakerID:   stakerID,
+			AssetID:    assetID,
+			Delegation: *amounts,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &delegationtype.QueryDelegationsByOperatorResponse{
+		Delegations: delegations,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// QueryStakersByAsset queries the sorted stakers delegating the asset with pagination.
+func (k *Keeper) QueryStakersByAsset(ctx context.Context, req *delegationtype.QueryStakersByAssetReq) (*delegationtype.QueryStakersByAssetResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	store := prefix.NewStore(
+		prefix.NewStore(c.KVStore(k.storeKey), delegationtype.KeyPrefixStakersByAsset),
+		assetstype.GetJoinedStoreKeyForPrefix(req.AssetID),
+	)
+	stakers := make([]string, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		stakers = append(stakers, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &delegationtype.QueryStakersByAssetResponse{
+		Stakers:    stakers,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (suite *DelegationTestSuite) TestQueryDelegationsByOperator() {
+	suite.basicPrepare()
+	suite.prepareDeposit(suite.depositAmount)
+	delegationEvent := suite.prepareDelegation(suite.delegationAmount, suite.opAccAddr)
+	nativeEvent := suite.prepareDelegationNativeToken()
+	stakerID, assetID := assetstypes.GetStakerIDAndAssetID(delegationEvent.ClientChainID, delegationEvent.StakerAddress, delegationEvent.AssetsAddress)
+	nativeStakerID, nativeAssetID := assetstypes.GetStakerIDAndAssetID(nativeEvent.ClientChainID, nativeEvent.StakerAddress, nativeEvent.AssetsAddress)
+
+	// the delegations are paged in the order of the assetID
+	req := &delegationtype.QueryDelegationsByOperatorReq{
+		Operator:   suite.opAccAddr.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	}
+	res, err := suite.App.DelegationKeeper.QueryDelegationsByOperator(suite.Ctx, req)
+	suite.NoError(err)
+	suite.Equal(uint64(2), res.Pagination.Total)
+	suite.NotNil(res.Pagination.NextKey)
+	suite.Len(res.Delegations, 1)
+	suite.Equal(nativeStakerID, res.Delegations[0].StakerID)
+	suite.Equal(nativeAssetID, res.Delegations[0].AssetID)
+
+	req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}
+	res, err = suite.App.DelegationKeeper.QueryDelegationsByOperator(suite.Ctx, req)
+	suite.NoError(err)
+	suite.Nil(res.Pagination.NextKey)
+	suite.Len(res.Delegations, 1)
+	suite.Equal(stakerID, res.Delegations[0].StakerID)
+	suite.Equal(assetID, res.Delegations[0].AssetID)
+	suite.Equal(suite.delegationAmount.ToLegacyDec(), res.Delegations[0].Delegation.UndelegatableShare)
+
+	// the delegations can be filtered by the asset
+	req.AssetID = nativeAssetID
+	req.Pagination = nil
+	res, err = suite.App.DelegationKeeper.QueryDelegationsByOperator(suite.Ctx, req)
+	suite.NoError(err)
+	suite.Len(res.Delegations, 1)
+	suite.Equal(nativeAssetID, res.Delegations[0].AssetID)
+
+	// the stakers delegating the asset in genesis are included as well
+	stakersRes, err := suite.App.DelegationKeeper.QueryStakersByAsset(suite.Ctx, &delegationtype.QueryStakersByAssetReq{AssetID: assetID})
+	suite.NoError(err)
+	suite.Contains(stakersRes.Stakers, stakerID)
+	suite.IsIncreasing(stakersRes.Stakers)
+
+	// the indexes follow the removal of the staker from the staker list
+	err = suite.App.DelegationKeeper.DeleteStakerForOperator(suite.Ctx, suite.opAccAddr.String(), assetID, stakerID)
+	suite.NoError(err)
+	req.AssetID = assetID
+	res, err = suite.App.DelegationKeeper.QueryDelegationsByOperator(suite.Ctx, req)
+	suite.NoError(err)
+	suite.Len(res.Delegations, 0)
+	stakersRes, err = suite.App.DelegationKeeper.QueryStakersByAsset(suite.Ctx, &delegationtype.QueryStakersByAssetReq{AssetID: assetID})
+	suite.NoError(err)
+	suite.NotContains(stakersRes.Stakers, stakerID)
+}
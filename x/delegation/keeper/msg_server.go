@@ -93,6 +93,68 @@ func (k *Keeper) UndelegateAssetFromOperator(
 	return &types.UndelegationResponse{}, nil
 }
 
+// RedelegateAssetBetweenOperators moves the delegated asset from the source operator to the
+// destination operator. Currently, it only supports native token.
+func (k *Keeper) RedelegateAssetBetweenOperators(
+	goCtx context.Context, msg *types.MsgRedelegate,
+) (*types.RedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := k.Logger(ctx)
+	logger.Info("RedelegateAssetBetweenOperators", "msg", msg)
+	// can use `Must` since pre-validated
+	fromAddr := sdk.MustAccAddressFromBech32(msg.FromAddress)
+	// create nonce and unique hash
+	nonce, err := k.accountKeeper.GetSequence(ctx, fromAddr)
+	if err != nil {
+		logger.Error("failed to get nonce", "error", err)
+		return nil, err
+	}
+	txBytes := ctx.TxBytes()
+	txHash := sha256.Sum256(txBytes)
+	combined := fmt.Sprintf("%s-%d", txHash, nonce)
+	uniqueHash := sha256.Sum256([]byte(combined))
+
+	redelegationParams := &types.RedelegationParams{
+		ClientChainID:      assetstypes.ExocoreChainLzID,
+		AssetsAddress:      common.HexToAddress(assetstypes.ExocoreAssetAddr).Bytes(),
+		StakerAddress:      fromAddr.Bytes(),
+		SrcOperatorAddress: sdk.MustAccAddressFromBech32(msg.SrcOperatorAddr),
+		DstOperatorAddress: sdk.MustAccAddressFromBech32(msg.DstOperatorAddr),
+		OpAmount:           msg.Amount,
+		LzNonce:            nonce,
+		TxHash:             uniqueHash,
+	}
+	cachedCtx, writeFunc := ctx.CacheContext()
+	if err := k.Redelegate(cachedCtx, redelegationParams); err != nil {
+		return nil, err
+	}
+	writeFunc()
+	return &types.RedelegateResponse{}, nil
+}
+
+// CancelUndelegation cancels an immature undelegation of the sender. Currently, it only
+// supports native token.
+func (k *Keeper) CancelUndelegation(
+	goCtx context.Context, msg *types.MsgCancelUndelegation,
+) (*types.CancelUndelegationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := k.Logger(ctx)
+	logger.Info("CancelUndelegation", "msg", msg)
+	// can use `Must` since pre-validated
+	fromAddr := sdk.MustAccAddressFromBech32(msg.FromAddress)
+	cancelParams := &types.CancelUndelegationParams{
+		ClientChainID: assetstypes.ExocoreChainLzID,
+		StakerAddress: fromAddr.Bytes(),
+		RecordKey:     msg.RecordKey,
+	}
+	cachedCtx, writeFunc := ctx.CacheContext()
+	if err := k.CancelUndelegationRecord(cachedCtx, cancelParams); err != nil {
+		return nil, err
+	}
+	writeFunc()
+	return &types.CancelUndelegationResponse{}, nil
+}
+
 // newDelegationParams creates delegation params from the given base info.
 func newDelegationParams(
 	baseInfo *types.DelegationIncOrDecInfo,
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AppendNSTSlashRecord stores the execution record of a native token balance drop. The index
+// of the record is set to the number of the records already stored for the same staker, asset
+// and height.
+func (k Keeper) AppendNSTSlashRecord(ctx sdk.Context, record *types.NSTSlashRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNSTSlashRecord)
+	iterator := sdk.KVStorePrefixIterator(
+		store, types.IteratorPrefixForNSTSlashRecordsAtHeight(record.StakerID, record.AssetID, record.Height),
+	)
+	index := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		index++
+	}
+	iterator.Close()
+	record.Index = index
+	k.setNSTSlashRecord(ctx, record)
+}
+
+func (k Keeper) setNSTSlashRecord(ctx sdk.Context, record *types.NSTSlashRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNSTSlashRecord)
+	key := types.GetNSTSlashRecordKey(record.StakerID, record.AssetID, record.Height, record.Index)
+	store.Set(key, k.cdc.MustMarshal(record))
+}
+
+// GetNSTSlashRecords returns the execution records of the native token balance drops of the
+// staker and asset, sorted by the height and the index.
+func (k Keeper) GetNSTSlashRecords(ctx sdk.Context, stakerID, assetID string) []types.NSTSlashRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNSTSlashRecord)
+	iterator := sdk.KVStorePrefixIterator(store, types.IteratorPrefixForStakerAsset(stakerID, assetID))
+	defer iterator.Close()
+
+	ret := make([]types.NSTSlashRecord, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.NSTSlashRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		ret = append(ret, record)
+	}
+	// the hex encoded height and index in the key aren't sorted lexicographically.
+	sort.SliceStable(ret, func(i, j int) bool {
+		if ret[i].Height != ret[j].Height {
+			return ret[i].Height < ret[j].Height
+		}
+		return ret[i].Index < ret[j].Index
+	})
+	return ret
+}
+
+// AllNSTSlashRecords returns all the execution records of the native token balance drops.
+// It is used during `ExportGenesis` to export the records.
+func (k Keeper) AllNSTSlashRecords(ctx sdk.Context) []types.NSTSlashRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNSTSlashRecord)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	ret := make([]types.NSTSlashRecord, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.NSTSlashRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		ret = append(ret, record)
+	}
+	return ret
+}
+
+// SetAllNSTSlashRecords stores the provided execution records of the native token balance
+// drops. It is used during `InitGenesis`.
+func (k Keeper) SetAllNSTSlashRecords(ctx sdk.Context, records []types.NSTSlashRecord) {
+	for i := range records {
+		k.setNSTSlashRecord(ctx, &records[i])
+	}
+}
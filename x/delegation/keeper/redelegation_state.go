@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// AllRedelegations returns all the redelegation records in the module.
+// It is used during `ExportGenesis` to export the redelegation records.
+func (k Keeper) AllRedelegations(ctx sdk.Context) (redelegations []types.RedelegationRecord, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRedelegationInfo)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	ret := make([]types.RedelegationRecord, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var redelegation types.RedelegationRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &redelegation)
+		ret = append(ret, redelegation)
+	}
+	return ret, nil
+}
+
+// SetRedelegationRecords stores the provided redelegation records.
+// Similar to the undelegation records, they are stored with 3 different keys:
+// (1) recordKey == srcOperatorAddress + blockNumber + lzNonce + txHash => record
+// (2) stakerID + assetID + dstOperatorAddress + lzNonce + txHash => recordKey
+// (3) completeBlockNumber + lzNonce + txHash => recordKey
+func (k *Keeper) SetRedelegationRecords(ctx sdk.Context, records []types.RedelegationRecord) error {
+	singleRecordStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRedelegationInfo)
+	stakerRedelegationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStakerRedelegationInfo)
+	pendingRedelegationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingRedelegations)
+	currentHeight := ctx.BlockHeight()
+	for i := range records {
+		record := records[i]
+		// #nosec G115
+		if record.CompleteBlockNumber < uint64(currentHeight) {
+			return errorsmod.Wrapf(types.ErrInvalidCompletedHeight, "currentHeight:%d,CompleteBlockNumber:%d", currentHeight, record.CompleteBlockNumber)
+		}
+		bz := k.cdc.MustMarshal(&record)
+		singleRecKey := types.GetRedelegationRecordKey(record.BlockNumber, record.LzTxNonce, record.TxHash, record.SrcOperatorAddr)
+		singleRecordStore.Set(singleRecKey, bz)
+
+		stakerKey := types.GetStakerRedelegationRecordKey(record.StakerID, record.AssetID, record.DstOperatorAddr, record.LzTxNonce, record.TxHash)
+		stakerRedelegationStore.Set(stakerKey, singleRecKey)
+
+		pendingKey := types.GetPendingRedelegationRecordKey(record.CompleteBlockNumber, record.LzTxNonce, record.TxHash)
+		pendingRedelegationStore.Set(pendingKey, singleRecKey)
+	}
+	return nil
+}
+
+// hasRedelegationRecordKeys returns true if any of the 3 keys of the redelegation record is
+// already used by another record.
+func (k *Keeper) hasRedelegationRecordKeys(ctx sdk.Context, record *types.RedelegationRecord) bool {
+	singleRecordStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRedelegationInfo)
+	stakerRedelegationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStakerRedelegationInfo)
+	pendingRedelegationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingRedelegations)
+
+	return singleRecordStore.Has(types.GetRedelegationRecordKey(record.BlockNumber, record.LzTxNonce, record.TxHash, record.SrcOperatorAddr)) ||
+		stakerRedelegationStore.Has(types.GetStakerRedelegationRecordKey(record.StakerID, record.AssetID, record.DstOperatorAddr, record.LzTxNonce, record.TxHash)) ||
+		pendingRedelegationStore.Has(types.GetPendingRedelegationRecordKey(record.CompleteBlockNumber, record.LzTxNonce, record.TxHash))
+}
+
+// DeleteRedelegationRecord deletes the redelegation record from all the 3 stores.
+func (k *Keeper) DeleteRedelegationRecord(ctx sdk.Context, record *types.RedelegationRecord) {
+	singleRecordStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRedelegationInfo)
+	stakerRedelegationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStakerRedelegationInfo)
+	pendingRedelegationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingRedelegations)
+
+	singleRecordStore.Delete(types.GetRedelegationRecordKey(record.BlockNumber, record.LzTxNonce, record.TxHash, record.SrcOperatorAddr))
+	stakerRedelegationStore.Delete(types.GetStakerRedelegationRecordKey(record.StakerID, record.AssetID, record.DstOperatorAddr, record.LzTxNonce, record.TxHash))
+	pendingRedelegationStore.Delete(types.GetPendingRedelegationRecordKey(record.CompleteBlockNumber, record.LzTxNonce, record.TxHash))
+
+	recordKey := types.GetRedelegationRecordKey(record.BlockNumber, record.LzTxNonce, record.TxHash, record.SrcOperatorAddr)
+	ctx.KVStore(k.storeKey).Delete(types.GetHeldRedelegationKey(recordKey))
+}
+
+// holdRedelegationRecord moves the redelegation record, which reaches its completion height
+// while being held, from the pending index to the held index. Similar to the undelegations,
+// the record is scheduled again once the last hold is released.
+func (k *Keeper) holdRedelegationRecord(ctx sdk.Context, record *types.RedelegationRecord) {
+	pendingRedelegationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingRedelegations)
+	pendingRedelegationStore.Delete(types.GetPendingRedelegationRecordKey(record.CompleteBlockNumber, record.LzTxNonce, record.TxHash))
+
+	recordKey := types.GetRedelegationRecordKey(record.BlockNumber, record.LzTxNonce, record.TxHash, record.SrcOperatorAddr)
+	ctx.KVStore(k.storeKey).Set(types.GetHeldRedelegationKey(recordKey), []byte{})
+}
+
+// releaseHeldRedelegationRecord schedules the held redelegation record to be completed at the
+// end of the current block, the completion height of the record is updated accordingly.
+func (k *Keeper) releaseHeldRedelegationRecord(ctx sdk.Context, recordKey []byte) error {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.GetHeldRedelegationKey(recordKey)) {
+		return nil
+	}
+	store.Delete(types.GetHeldRedelegationKey(recordKey))
+	record, err := k.GetRedelegationRecord(ctx, recordKey)
+	if err != nil {
+		return err
+	}
+	// #nosec G115
+	record.CompleteBlockNumber = uint64(ctx.BlockHeight())
+	return k.SetRedelegationRecords(ctx, []types.RedelegationRecord{*record})
+}
+
+// GetRedelegationsBySrcOperator returns the redelegation records from the provided source
+// operator. If the filter is non-nil, it only returns the records for which the block height
+// is greater than or equal to the filter.
+func (k *Keeper) GetRedelegationsBySrcOperator(
+	ctx sdk.Context, srcOperator string, heightFilter *uint64,
+) ([]types.RedelegationRecord, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRedelegationInfo)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(srcOperator+"/"))
+	defer iterator.Close()
+
+	ret := make([]types.RedelegationRecord, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var redelegation types.RedelegationRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &redelegation)
+		if heightFilter != nil && redelegation.BlockNumber < *heightFilter {
+			continue
+		}
+		ret = append(ret, redelegation)
+	}
+	return ret, nil
+}
+
+// GetRedelegationRecord returns the redelegation record with the provided record key.
+func (k *Keeper) GetRedelegationRecord(ctx sdk.Context, recordKey []byte) (*types.RedelegationRecord, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRedelegationInfo)
+	value := store.Get(recordKey)
+	if value == nil {
+		return nil, errorsmod.Wrap(types.ErrNoKeyInTheStore, fmt.Sprintf("redelegation record key doesn't exist: key is %s", string(recordKey)))
+	}
+	redelegation := types.RedelegationRecord{}
+	k.cdc.MustUnmarshal(value, &redelegation)
+	return &redelegation, nil
+}
+
+// HasReceivingRedelegation returns whether the staker has an immature redelegation of the
+// asset to the provided operator.
+func (k *Keeper) HasReceivingRedelegation(ctx sdk.Context, stakerID, assetID, dstOperator string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStakerRedelegationInfo)
+	iterator := sdk.KVStorePrefixIterator(store, types.IteratorPrefixForStakerRedelegationsTo(stakerID, assetID, dstOperator))
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+// GetPendingRedelegationRecords returns the redelegation records scheduled to mature at the
+// end of the block with the provided height.
+func (k *Keeper) GetPendingRedelegationRecords(ctx sdk.Context, height uint64) ([]*types.RedelegationRecord, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingRedelegations)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(hexutil.EncodeUint64(height)+"/"))
+	defer iterator.Close()
+
+	singleRecordStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRedelegationInfo)
+	ret := make([]*types.RedelegationRecord, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		value := singleRecordStore.Get(iterator.Value())
+		if value == nil {
+			return nil, errorsmod.Wrap(types.ErrNoKeyInTheStore, fmt.Sprintf("redelegation record key doesn't exist: key is %s", string(iterator.Value())))
+		}
+		redelegation := types.RedelegationRecord{}
+		k.cdc.MustUnmarshal(value, &redelegation)
+		ret = append(ret, &redelegation)
+	}
+	return ret, nil
+}
+
+// IncrementRedelegationHoldCount increments the hold count for the redelegation record key.
+// The redelegation record can't mature while it's held, which keeps the redelegated amount
+// slashable by the source operator.
+func (k Keeper) IncrementRedelegationHoldCount(ctx sdk.Context, recordKey []byte) error {
+	prev := k.GetRedelegationHoldCount(ctx, recordKey)
+	if prev == math.MaxUint64 {
+		return types.ErrCannotIncHoldCount
+	}
+	now := prev + 1
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRedelegationOnHoldKey(recordKey), sdk.Uint64ToBigEndian(now))
+	return nil
+}
+
+// GetRedelegationHoldCount returns the hold count for the redelegation record key.
+func (k *Keeper) GetRedelegationHoldCount(ctx sdk.Context, recordKey []byte) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRedelegationOnHoldKey(recordKey))
+	return sdk.BigEndianToUint64(bz)
+}
+
+// DecrementRedelegationHoldCount decrements the hold count for the redelegation record key.
+// If the last hold of a record past its completion height is released, the record is
+// completed at the end of the current block.
+func (k Keeper) DecrementRedelegationHoldCount(ctx sdk.Context, recordKey []byte) error {
+	prev := k.GetRedelegationHoldCount(ctx, recordKey)
+	if prev == 0 {
+		return types.ErrCannotDecHoldCount
+	}
+	now := prev - 1
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRedelegationOnHoldKey(recordKey), sdk.Uint64ToBigEndian(now))
+	if now == 0 {
+		return k.releaseHeldRedelegationRecord(ctx, recordKey)
+	}
+	return nil
+}
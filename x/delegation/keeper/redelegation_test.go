@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/x/assets/types"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	operatortype "github.com/ExocoreNetwork/exocore/x/operator/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (suite *DelegationTestSuite) TestRedelegate() {
+	suite.basicPrepare()
+	suite.prepareDeposit(suite.depositAmount)
+	delegationEvent := suite.prepareDelegation(suite.delegationAmount, suite.opAccAddr)
+	dstOperator := sdk.AccAddress(common.HexToAddress("0x3e108c058e8066DA635321Dc3018294cA82ddEdf").Bytes())
+	_, err := s.OperatorMsgServer.RegisterOperator(s.Ctx, &operatortype.RegisterOperatorReq{
+		FromAddress: dstOperator.String(),
+		Info: &operatortype.OperatorInfo{
+			EarningsAddr: dstOperator.String(),
+		},
+	})
+	suite.NoError(err)
+
+	redelegationParams := &delegationtype.RedelegationParams{
+		ClientChainID:      delegationEvent.ClientChainID,
+		AssetsAddress:      delegationEvent.AssetsAddress,
+		StakerAddress:      delegationEvent.StakerAddress,
+		SrcOperatorAddress: suite.opAccAddr,
+		DstOperatorAddress: suite.opAccAddr,
+		OpAmount:           sdkmath.NewInt(20),
+		LzNonce:            1,
+		TxHash:             common.HexToHash("0x24c4a315d757249c12a7a1d7b6fb96261d49deee26f06a3e1787d008b445c3ad"),
+	}
+	err = suite.App.DelegationKeeper.Redelegate(suite.Ctx, redelegationParams)
+	suite.ErrorIs(err, delegationtype.ErrSelfRedelegation)
+
+	redelegationParams.DstOperatorAddress = dstOperator
+	err = suite.App.DelegationKeeper.Redelegate(suite.Ctx, redelegationParams)
+	suite.NoError(err)
+
+	// the shares are moved without changing the staker asset state
+	stakerID, assetID := types.GetStakerIDAndAssetID(delegationEvent.ClientChainID, delegationEvent.StakerAddress, delegationEvent.AssetsAddress)
+	restakerState, err := suite.App.AssetsKeeper.GetStakerSpecifiedAssetInfo(suite.Ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(suite.depositAmount.Sub(suite.delegationAmount), restakerState.WithdrawableAmount)
+	suite.Equal(sdkmath.NewInt(0), restakerState.PendingUndelegationAmount)
+	srcState, err := suite.App.AssetsKeeper.GetOperatorSpecifiedAssetInfo(suite.Ctx, suite.opAccAddr, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(30), srcState.TotalAmount)
+	suite.Equal(sdkmath.NewInt(0), srcState.PendingUndelegationAmount)
+	dstState, err := suite.App.AssetsKeeper.GetOperatorSpecifiedAssetInfo(suite.Ctx, dstOperator, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(20), dstState.TotalAmount)
+	dstDelegation, err := suite.App.DelegationKeeper.GetSingleDelegationInfo(suite.Ctx, stakerID, assetID, dstOperator.String())
+	suite.NoError(err)
+	suite.Equal(sdkmath.LegacyNewDec(20), dstDelegation.UndelegatableShare)
+
+	// the redelegation is recorded and the chained redelegation isn't allowed
+	height := uint64(suite.Ctx.BlockHeight())
+	records, err := suite.App.DelegationKeeper.GetRedelegationsBySrcOperator(suite.Ctx, suite.opAccAddr.String(), &height)
+	suite.NoError(err)
+	suite.Equal([]delegationtype.RedelegationRecord{
+		{
+			StakerID:            stakerID,
+			AssetID:             assetID,
+			SrcOperatorAddr:     suite.opAccAddr.String(),
+			DstOperatorAddr:     dstOperator.String(),
+			TxHash:              redelegationParams.TxHash.String(),
+			BlockNumber:         height,
+			CompleteBlockNumber: height + operatortype.UnbondingExpiration,
+			LzTxNonce:           1,
+			InitialAmount:       sdkmath.NewInt(20),
+			SlashableAmount:     sdkmath.NewInt(20),
+		},
+	}, records)
+	chainedParams := *redelegationParams
+	chainedParams.SrcOperatorAddress, chainedParams.DstOperatorAddress = dstOperator, suite.opAccAddr
+	chainedParams.OpAmount = sdkmath.NewInt(10)
+	chainedParams.LzNonce = 2
+	err = suite.App.DelegationKeeper.Redelegate(suite.Ctx, &chainedParams)
+	suite.ErrorIs(err, delegationtype.ErrTransitiveRedelegation)
+
+	// the redelegated amount is slashed from the destination operator
+	slashAmount, err := suite.App.DelegationKeeper.SlashRedelegation(suite.Ctx, &records[0], sdkmath.LegacyNewDecWithPrec(5, 1))
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(10), slashAmount)
+	dstState, err = suite.App.AssetsKeeper.GetOperatorSpecifiedAssetInfo(suite.Ctx, dstOperator, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(10), dstState.TotalAmount)
+	records, err = suite.App.DelegationKeeper.GetRedelegationsBySrcOperator(suite.Ctx, suite.opAccAddr.String(), nil)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(10), records[0].SlashableAmount)
+
+	// the record is removed once it matures, then the asset can be redelegated again
+	suite.Ctx = suite.Ctx.WithBlockHeight(int64(records[0].CompleteBlockNumber))
+	suite.App.DelegationKeeper.EndBlock(suite.Ctx, abci.RequestEndBlock{})
+	records, err = suite.App.DelegationKeeper.AllRedelegations(suite.Ctx)
+	suite.NoError(err)
+	suite.Empty(records)
+	err = suite.App.DelegationKeeper.Redelegate(suite.Ctx, &chainedParams)
+	suite.NoError(err)
+}
+
+func (suite *DelegationTestSuite) TestRedelegateTwiceInTx() {
+	suite.basicPrepare()
+	suite.prepareDeposit(suite.depositAmount)
+	delegationEvent := suite.prepareDelegation(suite.delegationAmount, suite.opAccAddr)
+	dstOperator := sdk.AccAddress(common.HexToAddress("0x3e108c058e8066DA635321Dc3018294cA82ddEdf").Bytes())
+	_, err := s.OperatorMsgServer.RegisterOperator(s.Ctx, &operatortype.RegisterOperatorReq{
+		FromAddress: dstOperator.String(),
+		Info: &operatortype.OperatorInfo{
+			EarningsAddr: dstOperator.String(),
+		},
+	})
+	suite.NoError(err)
+
+	// the redelegations in the same tx share the nonce and the hash
+	redelegationParams := &delegationtype.RedelegationParams{
+		ClientChainID:      delegationEvent.ClientChainID,
+		AssetsAddress:      delegationEvent.AssetsAddress,
+		StakerAddress:      delegationEvent.StakerAddress,
+		SrcOperatorAddress: suite.opAccAddr,
+		DstOperatorAddress: dstOperator,
+		OpAmount:           sdkmath.NewInt(10),
+		LzNonce:            1,
+		TxHash:             common.HexToHash("0x24c4a315d757249c12a7a1d7b6fb96261d49deee26f06a3e1787d008b445c3ad"),
+	}
+	err = suite.App.DelegationKeeper.Redelegate(suite.Ctx, redelegationParams)
+	suite.NoError(err)
+	err = suite.App.DelegationKeeper.Redelegate(suite.Ctx, redelegationParams)
+	suite.NoError(err)
+
+	// both redelegations are recorded and remain slashable
+	records, err := suite.App.DelegationKeeper.AllRedelegations(suite.Ctx)
+	suite.NoError(err)
+	suite.Len(records, 2)
+	txHashes := []string{records[0].TxHash, records[1].TxHash}
+	suite.ElementsMatch([]string{
+		redelegationParams.TxHash.String(),
+		redelegationParams.TxHash.String() + "-1",
+	}, txHashes)
+	for _, record := range records {
+		suite.Equal(sdkmath.NewInt(10), record.SlashableAmount)
+	}
+	pendingRecords, err := suite.App.DelegationKeeper.GetPendingRedelegationRecords(suite.Ctx, records[0].CompleteBlockNumber)
+	suite.NoError(err)
+	suite.Len(pendingRecords, 2)
+}
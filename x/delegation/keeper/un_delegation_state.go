@@ -75,9 +75,41 @@ func (k *Keeper) DeleteUndelegationRecord(ctx sdk.Context, record *types.Undeleg
 
 	pendingUndelegationKey := types.GetPendingUndelegationRecordKey(record.CompleteBlockNumber, record.LzTxNonce)
 	pendingUndelegationStore.Delete(pendingUndelegationKey)
+
+	ctx.KVStore(k.storeKey).Delete(types.GetHeldUndelegationKey(singleRecKey))
 	return nil
 }
 
+// holdUndelegationRecord moves the undelegation record, which reaches its completion height
+// while being held, from the pending index to the held index. The record is scheduled again by
+// DecrementUndelegationHoldCount once the last hold is released, so the held records aren't
+// visited in every block.
+func (k *Keeper) holdUndelegationRecord(ctx sdk.Context, record *types.UndelegationRecord) {
+	pendingUndelegationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingUndelegations)
+	pendingUndelegationStore.Delete(types.GetPendingUndelegationRecordKey(record.CompleteBlockNumber, record.LzTxNonce))
+
+	singleRecKey := types.GetUndelegationRecordKey(record.BlockNumber, record.LzTxNonce, record.TxHash, record.OperatorAddr)
+	ctx.KVStore(k.storeKey).Set(types.GetHeldUndelegationKey(singleRecKey), []byte{})
+}
+
+// releaseHeldUndelegationRecord schedules the held undelegation record to be completed at the
+// end of the current block, the completion height of the record is updated accordingly.
+func (k *Keeper) releaseHeldUndelegationRecord(ctx sdk.Context, recordKey []byte) error {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.GetHeldUndelegationKey(recordKey)) {
+		return nil
+	}
+	store.Delete(types.GetHeldUndelegationKey(recordKey))
+	records, err := k.GetUndelegationRecords(ctx, []string{string(recordKey)})
+	if err != nil {
+		return err
+	}
+	record := records[0]
+	// #nosec G115
+	record.CompleteBlockNumber = uint64(ctx.BlockHeight())
+	return k.SetUndelegationRecords(ctx, []types.UndelegationRecord{*record})
+}
+
 // GetUndelegationRecords returns the undelegation records for the provided record keys.
 func (k *Keeper) GetUndelegationRecords(ctx sdk.Context, singleRecordKeys []string) (record []*types.UndelegationRecord, err error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUndelegationInfo)
@@ -236,7 +268,15 @@ func (k *Keeper) GetUndelegationHoldCount(ctx sdk.Context, recordKey []byte) uin
 	return sdk.BigEndianToUint64(bz)
 }
 
+// clearUndelegationHoldCount deletes the hold count for the undelegation record key.
+func (k Keeper) clearUndelegationHoldCount(ctx sdk.Context, recordKey []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetUndelegationOnHoldKey(recordKey))
+}
+
 // DecrementUndelegationHoldCount decrements the hold count for the undelegation record key.
+// If the last hold of a record past its completion height is released, the record is
+// completed at the end of the current block.
 func (k Keeper) DecrementUndelegationHoldCount(ctx sdk.Context, recordKey []byte) error {
 	prev := k.GetUndelegationHoldCount(ctx, recordKey)
 	if prev == 0 {
@@ -245,5 +285,8 @@ func (k Keeper) DecrementUndelegationHoldCount(ctx sdk.Context, recordKey []byte
 	now := prev - 1
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetUndelegationOnHoldKey(recordKey), sdk.Uint64ToBigEndian(now))
+	if now == 0 {
+		return k.releaseHeldUndelegationRecord(ctx, recordKey)
+	}
 	return nil
 }
@@ -16,12 +16,11 @@ func (k Keeper) UpdateNSTBalance(
 ) error {
 	if amount.IsPositive() {
 		// If the balance increases due to the client chain PoS staking reward, the increased
-		// amount can be considered a virtual deposit event. However, the increased amount needs
+		// amount is considered a virtual deposit event. By default, the increased amount needs
 		// to be manually delegated by the staker if they want it to contribute to voting power.
-		// Of course, we can also treat it as both a virtual deposit and delegation event if we
-		// think this approach is better. In that case, we would proportionally delegate the
-		// increased amount to all operators to whom the related staker has already delegated
-		// this native token.
+		// If the staker has enabled the auto-delegation, it's also treated as a delegation event,
+		// and the increased amount is proportionally delegated to all operators to whom the
+		// staker has already delegated this native token.
 		err := k.assetsKeeper.UpdateStakerAssetState(ctx, stakerID, assetID, types.DeltaStakerSingleAsset{
 			TotalDepositAmount: amount,
 			WithdrawableAmount: amount,
@@ -29,106 +28,304 @@ func (k Keeper) UpdateNSTBalance(
 		if err != nil {
 			return err
 		}
+		if k.assetsKeeper.IsNSTAutoDelegationEnabled(ctx, stakerID) {
+			return k.autoDelegateNST(ctx, stakerID, assetID, amount)
+		}
 	} else if amount.IsNegative() {
-		// If the balance decreases due to the client chain PoS slashing, the decreased amount
-		// will be slashed from the withdrawable amount first, the pending undelegation second,
-		// and the delegated share last if there is still a remaining amount that needs to be slashed.
-		// When slash from the delegated share, we can proportionally decrease from all operators
-		// to whom the related staker has already delegated.
+		return k.slashNST(ctx, stakerID, assetID, amount.Neg())
+	}
+	return nil
+}
+
+// slashNST executes the slash caused by a native token balance drop on the client chain.
+// The slash amount is slashed from the withdrawable amount first, the pending undelegations
+// second, and the delegated share last if there is still a remaining amount that needs to be
+// slashed. The delegated share is proportionally decreased from all operators to whom the
+// staker has already delegated. The execution details are stored as an `NSTSlashRecord`.
+func (k Keeper) slashNST(
+	ctx sdk.Context, stakerID, assetID string, slashAmount sdkmath.Int,
+) error {
+	record := delegationtypes.NSTSlashRecord{
+		StakerID:           stakerID,
+		AssetID:            assetID,
+		Height:             uint64(ctx.BlockHeight()),
+		SlashAmount:        slashAmount,
+		SlashUndelegations: make([]delegationtypes.NSTSlashFromUndelegation, 0),
+		SlashDelegations:   make([]delegationtypes.NSTSlashFromDelegation, 0),
+	}
+
+	// slash from the withdrawable amount
+	assetInfo, err := k.assetsKeeper.GetStakerSpecifiedAssetInfo(ctx, stakerID, assetID)
+	if err != nil {
+		return err
+	}
+	slashFromWithdrawable := slashAmount
+	pendingSlashAmount := slashFromWithdrawable.Sub(assetInfo.WithdrawableAmount)
+	if pendingSlashAmount.IsPositive() {
+		slashFromWithdrawable = assetInfo.WithdrawableAmount
+	}
+	err = k.assetsKeeper.UpdateStakerAssetState(ctx, stakerID, assetID, types.DeltaStakerSingleAsset{
+		TotalDepositAmount: slashFromWithdrawable.Neg(),
+		WithdrawableAmount: slashFromWithdrawable.Neg(),
+	})
+	if err != nil {
+		return err
+	}
+	record.SlashFromWithdrawable = slashFromWithdrawable
+	ctx.Logger().Info("UpdateNSTBalance slash from withdrawable amount", "stakerID", stakerID, "assetID", assetID, "slashFromWithdrawable", slashFromWithdrawable, "pendingSlashAmount", pendingSlashAmount)
 
-		// slash from the withdrawable amount
-		assetInfo, err := k.assetsKeeper.GetStakerSpecifiedAssetInfo(ctx, stakerID, assetID)
+	// slash from pending undelegations
+	if pendingSlashAmount.IsPositive() {
+		opFunc := func(undelegationKey string, undelegation *delegationtypes.UndelegationRecord) (bool, error) {
+			if !undelegation.ActualCompletedAmount.IsPositive() {
+				return false, nil
+			}
+			// slash from the single undelegation
+			slashAmount := pendingSlashAmount
+			pendingSlashAmount = slashAmount.Sub(undelegation.ActualCompletedAmount)
+			if pendingSlashAmount.IsPositive() {
+				slashAmount = undelegation.ActualCompletedAmount
+			}
+			undelegation.ActualCompletedAmount = undelegation.ActualCompletedAmount.Sub(slashAmount)
+			err = k.assetsKeeper.UpdateStakerAssetState(ctx, stakerID, assetID, types.DeltaStakerSingleAsset{
+				TotalDepositAmount: slashAmount.Neg(),
+			})
+			if err != nil {
+				return true, err
+			}
+			record.SlashUndelegations = append(record.SlashUndelegations, delegationtypes.NSTSlashFromUndelegation{
+				RecordKey:    undelegationKey,
+				OperatorAddr: undelegation.OperatorAddr,
+				Amount:       slashAmount,
+			})
+			ctx.Logger().Info("UpdateNSTBalance slash from undelegation", "stakerID", stakerID, "assetID", assetID, "operator", undelegation.OperatorAddr, "undelegationKey", undelegationKey, "slashAmount", slashAmount, "pendingSlashAmount", pendingSlashAmount)
+			if !pendingSlashAmount.IsPositive() {
+				// return true to break the iteration if there isn't remaining amount to be slashed
+				return true, nil
+			}
+			return false, nil
+		}
+		err = k.IterateUndelegationsByStakerAndAsset(ctx, stakerID, assetID, true, opFunc)
 		if err != nil {
 			return err
 		}
-		slashFromWithdrawable := amount.Neg()
-		pendingSlashAmount := slashFromWithdrawable.Sub(assetInfo.WithdrawableAmount)
-		if pendingSlashAmount.IsPositive() {
-			slashFromWithdrawable = assetInfo.WithdrawableAmount
-		}
-		err = k.assetsKeeper.UpdateStakerAssetState(ctx, stakerID, assetID, types.DeltaStakerSingleAsset{
-			TotalDepositAmount: slashFromWithdrawable.Neg(),
-			WithdrawableAmount: slashFromWithdrawable.Neg(),
-		})
+	}
+
+	// slash from the delegated share
+	if pendingSlashAmount.IsPositive() {
+		pendingSlashAmount, err = k.slashNSTFromDelegations(ctx, &record, pendingSlashAmount)
 		if err != nil {
 			return err
 		}
-		ctx.Logger().Info("UpdateNSTBalance slash from withdrawable amount", "stakerID", stakerID, "assetID", assetID, "slashFromWithdrawable", slashFromWithdrawable, "pendingSlashAmount", pendingSlashAmount)
+	}
+	// In this case, we only print a log as a reminder. This situation will only occur when the total slashing amount
+	// from the client chain and Exocore chain is greater than the total staking amount.
+	if pendingSlashAmount.IsPositive() {
+		ctx.Logger().Info("UpdateNSTBalance all staking funds has been slashed, the remaining amount is:", "stakerID", stakerID, "assetID", assetID, "pendingSlashAmount", pendingSlashAmount)
+	} else {
+		pendingSlashAmount = sdkmath.ZeroInt()
+	}
+	record.UnslashedAmount = pendingSlashAmount
+	k.AppendNSTSlashRecord(ctx, &record)
 
-		// slash from pending undelegations
-		if pendingSlashAmount.IsPositive() {
-			opFunc := func(undelegationKey string, undelegation *delegationtypes.UndelegationRecord) (bool, error) {
-				// slash from the single undelegation
-				slashAmount := pendingSlashAmount
-				pendingSlashAmount = slashAmount.Sub(undelegation.ActualCompletedAmount)
-				if pendingSlashAmount.IsPositive() {
-					slashAmount = undelegation.ActualCompletedAmount
-				}
-				undelegation.ActualCompletedAmount = undelegation.ActualCompletedAmount.Sub(slashAmount)
-				err = k.assetsKeeper.UpdateStakerAssetState(ctx, stakerID, assetID, types.DeltaStakerSingleAsset{
-					TotalDepositAmount: slashAmount.Neg(),
-				})
-				if err != nil {
-					return true, err
-				}
-				ctx.Logger().Info("UpdateNSTBalance slash from undelegation", "stakerID", stakerID, "assetID", assetID, "operator", undelegation.OperatorAddr, "undelegationKey", undelegationKey, "slashAmount", slashAmount, "pendingSlashAmount", pendingSlashAmount)
-				if !pendingSlashAmount.IsPositive() {
-					// return ture to break the iteration if there isn't remaining amount to be slashed
-					return true, nil
-				}
-				return false, nil
-			}
-			err = k.IterateUndelegationsByStakerAndAsset(ctx, stakerID, assetID, true, opFunc)
-			if err != nil {
-				return err
-			}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			delegationtypes.EventTypeNSTSlash,
+			sdk.NewAttribute(delegationtypes.AttributeKeyStakerID, stakerID),
+			sdk.NewAttribute(delegationtypes.AttributeKeyAssetID, assetID),
+			sdk.NewAttribute(delegationtypes.AttributeKeyAmount, slashAmount.String()),
+			sdk.NewAttribute(delegationtypes.AttributeKeyUnslashedAmount, pendingSlashAmount.String()),
+		),
+	)
+	return nil
+}
+
+// slashNSTFromDelegations proportionally slashes the pending slash amount from the delegated
+// shares of the staker. The share delegated to each operator is decreased by the same slash
+// proportion, and the rounding remainder caused by the share-to-token conversion is slashed
+// from the largest delegation, so the result doesn't depend on the iteration order. It returns
+// the amount that can't be slashed because the delegated tokens are insufficient.
+func (k Keeper) slashNSTFromDelegations(
+	ctx sdk.Context, record *delegationtypes.NSTSlashRecord, pendingSlashAmount sdkmath.Int,
+) (sdkmath.Int, error) {
+	stakerID, assetID := record.StakerID, record.AssetID
+	// collect the delegations first to avoid updating the delegation states during the iteration
+	operators := make([]sdk.AccAddress, 0)
+	delegatedShares := make([]sdkmath.LegacyDec, 0)
+	delegatedAmounts := make([]sdkmath.Int, 0)
+	totalDelegatedAmount := sdkmath.ZeroInt()
+	opFunc := func(keys *delegationtypes.SingleDelegationInfoReq, delegationAmount *delegationtypes.DelegationAmounts) (bool, error) {
+		if !delegationAmount.UndelegatableShare.IsPositive() {
+			return false, nil
 		}
+		opAccAddr, err := sdk.AccAddressFromBech32(keys.OperatorAddr)
+		if err != nil {
+			return true, err
+		}
+		operatorAsset, err := k.assetsKeeper.GetOperatorSpecifiedAssetInfo(ctx, opAccAddr, assetID)
+		if err != nil {
+			return true, err
+		}
+		delegatedAmount, err := TokensFromShares(delegationAmount.UndelegatableShare, operatorAsset.TotalShare, operatorAsset.TotalAmount)
+		if err != nil {
+			return true, err
+		}
+		if !delegatedAmount.IsPositive() {
+			return false, nil
+		}
+		operators = append(operators, opAccAddr)
+		delegatedShares = append(delegatedShares, delegationAmount.UndelegatableShare)
+		delegatedAmounts = append(delegatedAmounts, delegatedAmount)
+		totalDelegatedAmount = totalDelegatedAmount.Add(delegatedAmount)
+		return false, nil
+	}
+	if err := k.IterateDelegationsForStakerAndAsset(ctx, stakerID, assetID, opFunc); err != nil {
+		return pendingSlashAmount, err
+	}
+	if totalDelegatedAmount.IsZero() {
+		return pendingSlashAmount, nil
+	}
 
-		// slash from the delegated share
-		// the delegated share will be proportionally decreased from all operators to
-		// whom the related staker has already delegated
-		if pendingSlashAmount.IsPositive() {
-			// calculate the slash proportion
-			totalDelegatedAmount, err := k.TotalDelegatedAmountForStakerAsset(ctx, stakerID, assetID)
+	// calculate the slash proportion
+	slashProportion := sdkmath.LegacyNewDecFromBigInt(pendingSlashAmount.BigInt()).Quo(sdkmath.LegacyNewDecFromBigInt(totalDelegatedAmount.BigInt()))
+	if slashProportion.GT(sdkmath.LegacyNewDec(MaxSlashProportion)) {
+		slashProportion = sdkmath.LegacyNewDec(MaxSlashProportion)
+	}
+	toSlash := sdkmath.MinInt(pendingSlashAmount, totalDelegatedAmount)
+	slashShares := make([]sdkmath.LegacyDec, len(operators))
+	slashAmounts := make([]sdkmath.Int, len(operators))
+	largest := 0
+	for i, operator := range operators {
+		slashShares[i], slashAmounts[i] = sdkmath.LegacyZeroDec(), sdkmath.ZeroInt()
+		if delegatedAmounts[i].GT(delegatedAmounts[largest]) {
+			largest = i
+		}
+		slashShare := delegatedShares[i].Mul(slashProportion)
+		if !slashShare.IsPositive() {
+			continue
+		}
+		actualSlashAmount, err := k.removeNSTDelegatedShare(ctx, operator, stakerID, assetID, slashShare)
+		if err != nil {
+			return pendingSlashAmount, err
+		}
+		slashShares[i], slashAmounts[i] = slashShare, actualSlashAmount
+		toSlash = toSlash.Sub(actualSlashAmount)
+		pendingSlashAmount = pendingSlashAmount.Sub(actualSlashAmount)
+	}
+	// slash the rounding remainder from the largest delegation
+	if toSlash.IsPositive() {
+		slashShare, err := k.CalculateSlashShare(ctx, operators[largest], stakerID, assetID, toSlash)
+		if err != nil {
+			return pendingSlashAmount, err
+		}
+		if slashShare.IsPositive() {
+			actualSlashAmount, err := k.removeNSTDelegatedShare(ctx, operators[largest], stakerID, assetID, slashShare)
 			if err != nil {
-				return err
-			}
-			if !totalDelegatedAmount.IsZero() {
-				slashProportion := sdkmath.LegacyNewDecFromBigInt(pendingSlashAmount.BigInt()).Quo(sdkmath.LegacyNewDecFromBigInt(totalDelegatedAmount.BigInt()))
-				if slashProportion.GT(sdkmath.LegacyNewDec(MaxSlashProportion)) {
-					slashProportion = sdkmath.LegacyNewDec(MaxSlashProportion)
-				}
-				opFunc := func(keys *delegationtypes.SingleDelegationInfoReq, delegationAmount *delegationtypes.DelegationAmounts) (bool, error) {
-					opAccAddr, err := sdk.AccAddressFromBech32(keys.OperatorAddr)
-					if err != nil {
-						return true, err
-					}
-					slashShare := delegationAmount.UndelegatableShare.Mul(slashProportion)
-					actualSlashAmount, err := k.RemoveShare(ctx, false, opAccAddr, stakerID, assetID, slashShare)
-					if err != nil {
-						return true, err
-					}
-					err = k.assetsKeeper.UpdateStakerAssetState(ctx, stakerID, assetID, types.DeltaStakerSingleAsset{
-						TotalDepositAmount: actualSlashAmount.Neg(),
-					})
-					if err != nil {
-						return true, err
-					}
-					pendingSlashAmount = pendingSlashAmount.Sub(actualSlashAmount)
-					ctx.Logger().Info("UpdateNSTBalance slash from delegated share", "stakerID", stakerID, "assetID", assetID, "operator", keys.OperatorAddr, "slashProportion", slashProportion, "slashShare", slashShare, "actualSlashAmount", actualSlashAmount, "pendingSlashAmount", pendingSlashAmount)
-					return false, nil
-				}
-				err = k.IterateDelegationsForStakerAndAsset(ctx, stakerID, assetID, opFunc)
-				if err != nil {
-					return err
-				}
+				return pendingSlashAmount, err
 			}
+			slashShares[largest] = slashShares[largest].Add(slashShare)
+			slashAmounts[largest] = slashAmounts[largest].Add(actualSlashAmount)
+			pendingSlashAmount = pendingSlashAmount.Sub(actualSlashAmount)
 		}
-		// In this case, we only print a log as a reminder. This situation will only occur when the total slashing amount
-		// from the client chain and Exocore chain is greater than the total staking amount.
-		if pendingSlashAmount.IsPositive() {
-			ctx.Logger().Info("UpdateNSTBalance all staking funds has been slashed, the remaining amount is:", "stakerID", stakerID, "assetID", assetID, "pendingSlashAmount", pendingSlashAmount)
+	}
+
+	for i, operator := range operators {
+		if !slashShares[i].IsPositive() {
+			continue
+		}
+		record.SlashDelegations = append(record.SlashDelegations, delegationtypes.NSTSlashFromDelegation{
+			OperatorAddr: operator.String(),
+			Share:        slashShares[i],
+			Amount:       slashAmounts[i],
+		})
+		ctx.Logger().Info("UpdateNSTBalance slash from delegated share", "stakerID", stakerID, "assetID", assetID, "operator", operator.String(), "slashProportion", slashProportion, "slashShare", slashShares[i], "actualSlashAmount", slashAmounts[i], "pendingSlashAmount", pendingSlashAmount)
+		if err := k.Hooks().AfterDelegationSlashed(ctx, operator, stakerID, assetID, slashAmounts[i]); err != nil {
+			return pendingSlashAmount, err
 		}
 	}
-	return nil
+	return pendingSlashAmount, nil
+}
+
+// removeNSTDelegatedShare removes the slashed share delegated to the operator and decreases
+// the total deposit amount of the staker by the removed tokens.
+func (k Keeper) removeNSTDelegatedShare(
+	ctx sdk.Context, operator sdk.AccAddress, stakerID, assetID string, slashShare sdkmath.LegacyDec,
+) (sdkmath.Int, error) {
+	actualSlashAmount, err := k.RemoveShare(ctx, false, operator, stakerID, assetID, slashShare)
+	if err != nil {
+		return actualSlashAmount, err
+	}
+	err = k.assetsKeeper.UpdateStakerAssetState(ctx, stakerID, assetID, types.DeltaStakerSingleAsset{
+		TotalDepositAmount: actualSlashAmount.Neg(),
+	})
+	return actualSlashAmount, err
+}
+
+// autoDelegateNST proportionally delegates the increased amount of the native token to the
+// operators to whom the staker has already delegated it. The amounts that can't be delegated,
+// i.e. the rounding remainder and the parts for the frozen operators, stay withdrawable.
+func (k Keeper) autoDelegateNST(
+	ctx sdk.Context, stakerID, assetID string, amount sdkmath.Int,
+) error {
+	if k.slashKeeper.IsStakerFrozen(ctx, stakerID) {
+		return nil
+	}
+	totalDelegatedAmount, err := k.TotalDelegatedAmountForStakerAsset(ctx, stakerID, assetID)
+	if err != nil {
+		return err
+	}
+	if !totalDelegatedAmount.IsPositive() {
+		return nil
+	}
+	// collect the amounts first to avoid updating the delegation states during the iteration
+	operators := make([]sdk.AccAddress, 0)
+	delegatedAmounts := make([]sdkmath.Int, 0)
+	opFunc := func(keys *delegationtypes.SingleDelegationInfoReq, delegationAmount *delegationtypes.DelegationAmounts) (bool, error) {
+		if delegationAmount.UndelegatableShare.IsZero() {
+			return false, nil
+		}
+		opAccAddr, err := sdk.AccAddressFromBech32(keys.OperatorAddr)
+		if err != nil {
+			return true, err
+		}
+		operatorAsset, err := k.assetsKeeper.GetOperatorSpecifiedAssetInfo(ctx, opAccAddr, assetID)
+		if err != nil {
+			return true, err
+		}
+		delegatedAmount, err := TokensFromShares(delegationAmount.UndelegatableShare, operatorAsset.TotalShare, operatorAsset.TotalAmount)
+		if err != nil {
+			return true, err
+		}
+		operators = append(operators, opAccAddr)
+		delegatedAmounts = append(delegatedAmounts, delegatedAmount)
+		return false, nil
+	}
+	if err := k.IterateDelegationsForStakerAndAsset(ctx, stakerID, assetID, opFunc); err != nil {
+		return err
+	}
+
+	totalAutoDelegated := sdkmath.ZeroInt()
+	for i, operator := range operators {
+		if k.slashKeeper.IsOperatorFrozen(ctx, operator) {
+			continue
+		}
+		delegateAmount := amount.Mul(delegatedAmounts[i]).Quo(totalDelegatedAmount)
+		if !delegateAmount.IsPositive() {
+			continue
+		}
+		if err := k.addShare(ctx, operator, stakerID, assetID, delegateAmount); err != nil {
+			return err
+		}
+		totalAutoDelegated = totalAutoDelegated.Add(delegateAmount)
+		ctx.Logger().Info("UpdateNSTBalance auto-delegate to operator", "stakerID", stakerID, "assetID", assetID, "operator", operator.String(), "delegateAmount", delegateAmount)
+		if err := k.Hooks().AfterDelegation(ctx, operator, stakerID, assetID); err != nil {
+			return err
+		}
+	}
+	if totalAutoDelegated.IsZero() {
+		return nil
+	}
+	return k.assetsKeeper.UpdateStakerAssetState(ctx, stakerID, assetID, types.DeltaStakerSingleAsset{
+		WithdrawableAmount: totalAutoDelegated.Neg(),
+	})
 }
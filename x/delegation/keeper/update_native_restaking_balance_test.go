@@ -3,6 +3,8 @@ package keeper_test
 import (
 	sdkmath "cosmossdk.io/math"
 	assettypes "github.com/ExocoreNetwork/exocore/x/assets/types"
+	"github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -13,6 +15,7 @@ func (suite *DelegationTestSuite) TestUpdateNSTBalance() {
 	// delegated amount:
 	//	defaultOperator: 10 slash: 1/3*10 -> 2/3*10
 	//  anotherOperator: 20 slash: 1/3*20 -> 2/3*20
+	// the rounding remainder 1 is slashed from the largest delegation of anotherOperator
 	depositAmount := sdkmath.NewInt(100)
 	delegateAmountToDefaultOperator := sdkmath.NewInt(20)
 	undelegateAmountFromDefaultOperator := sdkmath.NewInt(10)
@@ -31,9 +34,7 @@ func (suite *DelegationTestSuite) TestUpdateNSTBalance() {
 	suite.prepareDelegation(delegateAmountToAnotherOperator, anotherOperatorAddr)
 
 	// update negative balance
-	// The actual slash amount is 79, not 80; this is due to precision loss.
 	slashAmount := sdkmath.NewInt(80)
-	actualSlashAmount := sdkmath.NewInt(79)
 	stakerID, assetID := assettypes.GetStakerIDAndAssetID(suite.clientChainLzID, suite.Address[:], suite.assetAddr.Bytes())
 	err = suite.App.DelegationKeeper.UpdateNSTBalance(suite.Ctx, stakerID, assetID, slashAmount.Neg())
 	suite.NoError(err)
@@ -42,7 +43,7 @@ func (suite *DelegationTestSuite) TestUpdateNSTBalance() {
 	stakerAssetInfo, err := suite.App.AssetsKeeper.GetStakerSpecifiedAssetInfo(suite.Ctx, stakerID, assetID)
 	suite.NoError(err)
 	expectAssetInfo := assettypes.StakerAssetInfo{
-		TotalDepositAmount: depositAmount.Sub(actualSlashAmount),
+		TotalDepositAmount: depositAmount.Sub(slashAmount),
 		WithdrawableAmount: sdkmath.NewInt(0),
 		// it will be decreased when the undelegation is completed.
 		PendingUndelegationAmount: undelegateAmountFromDefaultOperator,
@@ -64,6 +65,9 @@ func (suite *DelegationTestSuite) TestUpdateNSTBalance() {
 	delegationForAnotherOperator, err := suite.App.DelegationKeeper.GetSingleDelegationInfo(suite.Ctx, stakerID, assetID, anotherOperatorAddr.String())
 	suite.NoError(err)
 	expectedShareForAnotherOperator := sdkmath.LegacyNewDec(20).Mul(slashProportion)
+	remainderShare, err := keeper.SharesFromTokens(expectedShareForAnotherOperator, sdkmath.NewInt(1), sdkmath.NewInt(14))
+	suite.NoError(err)
+	expectedShareForAnotherOperator = expectedShareForAnotherOperator.Sub(remainderShare)
 	suite.Equal(expectedShareForAnotherOperator, delegationForAnotherOperator.UndelegatableShare)
 
 	// check the asset states of two operators
@@ -74,6 +78,79 @@ func (suite *DelegationTestSuite) TestUpdateNSTBalance() {
 
 	anotherOperatorAsset, err := suite.App.AssetsKeeper.GetOperatorSpecifiedAssetInfo(suite.Ctx, anotherOperatorAddr, assetID)
 	suite.NoError(err)
-	suite.Equal(sdkmath.NewInt(14), anotherOperatorAsset.TotalAmount)
+	suite.Equal(sdkmath.NewInt(13), anotherOperatorAsset.TotalAmount)
 	suite.Equal(expectedShareForAnotherOperator, anotherOperatorAsset.TotalShare)
+
+	// check the stored slash record
+	slashRecords, err := suite.App.DelegationKeeper.QueryNSTSlashRecords(
+		suite.Ctx, &delegationtype.QueryNSTSlashRecordsReq{StakerID: stakerID, AssetID: assetID},
+	)
+	suite.NoError(err)
+	suite.Len(slashRecords.Records, 1)
+	slashRecord := slashRecords.Records[0]
+	suite.Equal(uint64(suite.Ctx.BlockHeight()), slashRecord.Height)
+	suite.Equal(uint64(0), slashRecord.Index)
+	suite.Equal(slashAmount.String(), slashRecord.SlashAmount.String())
+	suite.Equal("60", slashRecord.SlashFromWithdrawable.String())
+	suite.Len(slashRecord.SlashUndelegations, 1)
+	suite.Equal(undelegateAmountFromDefaultOperator.String(), slashRecord.SlashUndelegations[0].Amount.String())
+	suite.Len(slashRecord.SlashDelegations, 2)
+	slashedFromDelegations := sdkmath.ZeroInt()
+	for _, slash := range slashRecord.SlashDelegations {
+		slashedFromDelegations = slashedFromDelegations.Add(slash.Amount)
+	}
+	suite.Equal("10", slashedFromDelegations.String())
+	suite.True(slashRecord.UnslashedAmount.IsZero())
+}
+
+func (suite *DelegationTestSuite) TestUpdateNSTBalanceWithAutoDelegation() {
+	// test case: increase 31 with the auto-delegation enabled
+	// delegated amount:
+	//	defaultOperator: 20 increase: 31*20/30 -> 20
+	//  anotherOperator: 10 increase: 31*10/30 -> 10
+	// the remainder 1 stays withdrawable
+	depositAmount := sdkmath.NewInt(100)
+	delegateAmountToDefaultOperator := sdkmath.NewInt(20)
+	delegateAmountToAnotherOperator := sdkmath.NewInt(10)
+	increaseAmount := sdkmath.NewInt(31)
+	anotherOperatorAddr, err := sdk.AccAddressFromBech32("exo18cggcpvwspnd5c6ny8wrqxpffj5zmhklprtnph")
+	suite.NoError(err)
+
+	suite.basicPrepare()
+	suite.prepareDeposit(depositAmount)
+	suite.prepareDelegation(delegateAmountToDefaultOperator, suite.opAccAddr)
+	suite.prepareDelegation(delegateAmountToAnotherOperator, anotherOperatorAddr)
+
+	stakerID, assetID := assettypes.GetStakerIDAndAssetID(suite.clientChainLzID, suite.Address[:], suite.assetAddr.Bytes())
+	suite.False(suite.App.AssetsKeeper.IsNSTAutoDelegationEnabled(suite.Ctx, stakerID))
+	err = suite.App.AssetsKeeper.UpdateNSTAutoDelegation(suite.Ctx, suite.clientChainLzID, suite.Address[:], true)
+	suite.NoError(err)
+	suite.True(suite.App.AssetsKeeper.IsNSTAutoDelegationEnabled(suite.Ctx, stakerID))
+
+	err = suite.App.DelegationKeeper.UpdateNSTBalance(suite.Ctx, stakerID, assetID, increaseAmount)
+	suite.NoError(err)
+
+	stakerAssetInfo, err := suite.App.AssetsKeeper.GetStakerSpecifiedAssetInfo(suite.Ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(depositAmount.Add(increaseAmount), stakerAssetInfo.TotalDepositAmount)
+	suite.Equal(sdkmath.NewInt(71), stakerAssetInfo.WithdrawableAmount)
+
+	defaultOperatorAsset, err := suite.App.AssetsKeeper.GetOperatorSpecifiedAssetInfo(suite.Ctx, suite.opAccAddr, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(40), defaultOperatorAsset.TotalAmount)
+	anotherOperatorAsset, err := suite.App.AssetsKeeper.GetOperatorSpecifiedAssetInfo(suite.Ctx, anotherOperatorAddr, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(20), anotherOperatorAsset.TotalAmount)
+
+	// the increase is only deposited after disabling the auto-delegation
+	err = suite.App.AssetsKeeper.UpdateNSTAutoDelegation(suite.Ctx, suite.clientChainLzID, suite.Address[:], false)
+	suite.NoError(err)
+	err = suite.App.DelegationKeeper.UpdateNSTBalance(suite.Ctx, stakerID, assetID, increaseAmount)
+	suite.NoError(err)
+	stakerAssetInfo, err = suite.App.AssetsKeeper.GetStakerSpecifiedAssetInfo(suite.Ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(102), stakerAssetInfo.WithdrawableAmount)
+	defaultOperatorAsset, err = suite.App.AssetsKeeper.GetOperatorSpecifiedAssetInfo(suite.Ctx, suite.opAccAddr, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(40), defaultOperatorAsset.TotalAmount)
 }
@@ -25,6 +25,8 @@ const (
 	// Amino names
 	delegateAssetToOperator     = "exocore/MsgDelegation"
 	UndelegateAssetFromOperator = "exocore/MsgUndelegation"
+	redelegate                  = "exocore/MsgRedelegate"
+	cancelUndelegation          = "exocore/MsgCancelUndelegation"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -39,6 +41,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgDelegation{},
 		&MsgUndelegation{},
+		&MsgRedelegate{},
+		&MsgCancelUndelegation{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -49,4 +53,6 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDelegation{}, delegateAssetToOperator, nil)
 	cdc.RegisterConcrete(&MsgUndelegation{}, UndelegateAssetFromOperator, nil)
+	cdc.RegisterConcrete(&MsgRedelegate{}, redelegate, nil)
+	cdc.RegisterConcrete(&MsgCancelUndelegation{}, cancelUndelegation, nil)
 }
@@ -40,3 +40,23 @@ func NewDelegationOrUndelegationParams(
 		TxHash:          txHash,
 	}
 }
+
+// RedelegationParams is the parameters to move the delegated asset of a staker from the
+// source operator to the destination operator.
+type RedelegationParams struct {
+	ClientChainID      uint64
+	AssetsAddress      []byte
+	StakerAddress      []byte
+	SrcOperatorAddress sdk.AccAddress
+	DstOperatorAddress sdk.AccAddress
+	OpAmount           sdkmath.Int
+	LzNonce            uint64
+	TxHash             common.Hash
+}
+
+// CancelUndelegationParams is the parameters to cancel an immature undelegation of a staker.
+type CancelUndelegationParams struct {
+	ClientChainID uint64
+	StakerAddress []byte
+	RecordKey     string
+}
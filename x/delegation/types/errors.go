@@ -87,4 +87,20 @@ var (
 		ModuleName, 23,
 		"the block height to complete the unelegation is invalid",
 	)
+	ErrStakerIsFrozen = errorsmod.Register(
+		ModuleName, 24,
+		"the staker has been frozen",
+	)
+	ErrSelfRedelegation = errorsmod.Register(
+		ModuleName, 25,
+		"the source and destination operators of the redelegation are the same",
+	)
+	ErrTransitiveRedelegation = errorsmod.Register(
+		ModuleName, 26,
+		"redelegation from the operator with an immature incoming redelegation is not allowed",
+	)
+	ErrUndelegationNotCancelable = errorsmod.Register(
+		ModuleName, 27,
+		"the undelegation record can't be canceled",
+	)
 )
@@ -0,0 +1,15 @@
+package types
+
+// x/delegation events
+const (
+	EventTypeCancelUndelegation = "cancel_undelegation"
+	EventTypeNSTSlash           = "nst_slash"
+
+	AttributeKeyRecordKey       = "record_key"
+	AttributeKeyStakerID        = "staker_id"
+	AttributeKeyAssetID         = "asset_id"
+	AttributeKeyOperator        = "operator"
+	AttributeKeyAmount          = "amount"
+	AttributeKeyRestoredAmount  = "restored_amount"
+	AttributeKeyUnslashedAmount = "unslashed_amount"
+)
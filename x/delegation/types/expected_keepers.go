@@ -12,7 +12,7 @@ var CanUndelegationDelayHeight = uint64(10)
 
 type SlashKeeper interface {
 	IsOperatorFrozen(ctx sdk.Context, opAddr sdk.AccAddress) bool
-	OperatorAssetSlashedProportion(ctx sdk.Context, opAddr sdk.AccAddress, assetID string, startHeight, endHeight uint64) sdkmath.LegacyDec
+	IsStakerFrozen(ctx sdk.Context, stakerID string) bool
 }
 
 // VirtualSlashKeeper todo: When the actual keeper functionality has not been implemented yet, temporarily use the virtual keeper.
@@ -22,19 +22,32 @@ func (VirtualSlashKeeper) IsOperatorFrozen(_ sdk.Context, _ sdk.AccAddress) bool
 	return false
 }
 
-func (VirtualSlashKeeper) OperatorAssetSlashedProportion(_ sdk.Context, _ sdk.AccAddress, _ string, _, _ uint64) sdkmath.LegacyDec {
-	return sdkmath.LegacyNewDec(0)
+func (VirtualSlashKeeper) IsStakerFrozen(_ sdk.Context, _ string) bool {
+	return false
 }
 
 // DelegationHooks are event hooks triggered by the delegation module
 type DelegationHooks interface {
-	// AfterDelegation we don't want the ability to cancel delegation or undelegation so no return type for
-	// either
-	// for delegation, we only care about the address of the operator to cache the event
-	AfterDelegation(ctx sdk.Context, operator sdk.AccAddress)
+	// AfterDelegation for delegation, the staker and the asset are provided to settle the
+	// rewards of the delegated share. an error aborts the delegation, so that the reward
+	// states of the other modules can't drift apart from the delegated share.
+	AfterDelegation(ctx sdk.Context, operator sdk.AccAddress, stakerID, assetID string) error
 	// AfterUndelegationStarted for undelegation, we use the address of the operator to figure out the list of impacted
 	// chains for that operator. and we need the identifier to hold it until confirmed by subscriber
 	AfterUndelegationStarted(ctx sdk.Context, addr sdk.AccAddress, recordKey []byte) error
+	// AfterRedelegationStarted for redelegation, we use the address of the source operator to
+	// figure out the AVSs that can still slash the redelegated amount, and the identifier to
+	// hold the redelegation record until their unbonding periods elapse.
+	AfterRedelegationStarted(ctx sdk.Context, srcOperator sdk.AccAddress, recordKey []byte) error
+	// AfterUndelegationCanceled is called before a canceled undelegation record is deleted, so
+	// that the modules holding the record can drop their maturity entries. The hold count of
+	// the record is cleared by the delegation module afterward.
+	AfterUndelegationCanceled(ctx sdk.Context, operator sdk.AccAddress, recordKey []byte) error
+	// AfterDelegationSlashed is called after the share of a staker is reduced by a slash, for
+	// example, by a native token balance drop on the client chain, by the slash of a
+	// redelegation, or by the clearing of the shares when the asset pool of the operator is
+	// slashed to zero. It allows the USD values of the operator to be decreased immediately.
+	AfterDelegationSlashed(ctx sdk.Context, operator sdk.AccAddress, stakerID, assetID string, amount sdkmath.Int) error
 }
 
 type OperatorKeeper interface {
@@ -52,6 +65,7 @@ type AssetsKeeper interface {
 	IsOperatorAssetExist(ctx sdk.Context, operatorAddr sdk.Address, assetID string) bool
 
 	ClientChainExists(ctx sdk.Context, index uint64) bool
+	IsNSTAutoDelegationEnabled(ctx sdk.Context, stakerID string) bool
 }
 
 type BankKeeper interface {
@@ -10,6 +10,7 @@ import (
 	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"golang.org/x/xerrors"
 )
 
@@ -19,18 +20,22 @@ func NewGenesis(
 	delegationStates []DelegationStates,
 	stakersByOperator []StakersByOperator,
 	undelegations []UndelegationRecord,
+	redelegations []RedelegationRecord,
+	nstSlashRecords []NSTSlashRecord,
 ) *GenesisState {
 	return &GenesisState{
 		Associations:      associations,
 		DelegationStates:  delegationStates,
 		StakersByOperator: stakersByOperator,
 		Undelegations:     undelegations,
+		Redelegations:     redelegations,
+		NSTSlashRecords:   nstSlashRecords,
 	}
 }
 
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
-	return NewGenesis(nil, nil, nil, nil)
+	return NewGenesis(nil, nil, nil, nil, nil, nil)
 }
 
 func ValidateIDAndOperator(stakerID, assetID, operator string) error {
@@ -245,6 +250,98 @@ func (gs GenesisState) ValidateUndelegations() error {
 	return nil
 }
 
+func (gs GenesisState) ValidateRedelegations() error {
+	validationFunc := func(_ int, redelegation RedelegationRecord) error {
+		err := ValidateIDAndOperator(redelegation.StakerID, redelegation.AssetID, redelegation.SrcOperatorAddr)
+		if err != nil {
+			return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+		}
+		err = ValidateIDAndOperator(redelegation.StakerID, redelegation.AssetID, redelegation.DstOperatorAddr)
+		if err != nil {
+			return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+		}
+		if redelegation.SrcOperatorAddr == redelegation.DstOperatorAddr {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData, "the source and destination operators are the same, redelegation:%v",
+				redelegation,
+			)
+		}
+		bytes, err := hexutil.Decode(redelegation.TxHash)
+		if err != nil || len(bytes) != common.HashLength {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData, "invalid TxHash:%s", redelegation.TxHash,
+			)
+		}
+		if redelegation.CompleteBlockNumber < redelegation.BlockNumber {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData, "the block number to complete shouldn't be less than the submitted, redelegation:%v",
+				redelegation,
+			)
+		}
+		if redelegation.InitialAmount.IsNil() || redelegation.SlashableAmount.IsNil() ||
+			redelegation.SlashableAmount.IsNegative() || redelegation.SlashableAmount.GT(redelegation.InitialAmount) {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData, "the slashable amount should be in the range of [0, initial amount], redelegation:%v",
+				redelegation,
+			)
+		}
+		return nil
+	}
+	seenFieldValueFunc := func(redelegation RedelegationRecord) (string, struct{}) {
+		key := GetRedelegationRecordKey(redelegation.BlockNumber, redelegation.LzTxNonce, redelegation.TxHash, redelegation.SrcOperatorAddr)
+		return string(key), struct{}{}
+	}
+	_, err := utils.CommonValidation(gs.Redelegations, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+	}
+	return nil
+}
+
+// ValidateNSTSlashRecords performs basic validation of the execution records of the native
+// token balance drops.
+func (gs GenesisState) ValidateNSTSlashRecords() error {
+	validationFunc := func(_ int, record NSTSlashRecord) error {
+		if _, _, err := assetstypes.ValidateID(record.StakerID, true, false); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "invalid stakerID: %s", record.StakerID)
+		}
+		if !assetstypes.IsNST(record.AssetID) {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "not a native token assetID: %s", record.AssetID)
+		}
+		if record.SlashAmount.IsNil() || !record.SlashAmount.IsPositive() ||
+			record.SlashFromWithdrawable.IsNil() || record.SlashFromWithdrawable.IsNegative() ||
+			record.UnslashedAmount.IsNil() || record.UnslashedAmount.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "invalid amounts in the record: %v", record)
+		}
+		for _, slash := range record.SlashUndelegations {
+			if _, err := ParseUndelegationRecordKey([]byte(slash.RecordKey)); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesisData, "invalid undelegation record key: %s", slash.RecordKey)
+			}
+			if slash.Amount.IsNil() || slash.Amount.IsNegative() {
+				return errorsmod.Wrapf(ErrInvalidGenesisData, "invalid amount slashed from the undelegation: %v", slash)
+			}
+		}
+		for _, slash := range record.SlashDelegations {
+			if _, err := sdk.AccAddressFromBech32(slash.OperatorAddr); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesisData, "invalid operator address: %s", slash.OperatorAddr)
+			}
+			if slash.Share.IsNil() || slash.Share.IsNegative() || slash.Amount.IsNil() || slash.Amount.IsNegative() {
+				return errorsmod.Wrapf(ErrInvalidGenesisData, "invalid share or amount slashed from the delegation: %v", slash)
+			}
+		}
+		return nil
+	}
+	seenFieldValueFunc := func(record NSTSlashRecord) (string, struct{}) {
+		key := GetNSTSlashRecordKey(record.StakerID, record.AssetID, record.Height, record.Index)
+		return string(key), struct{}{}
+	}
+	_, err := utils.CommonValidation(gs.NSTSlashRecords, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+	}
+	return nil
+}
+
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
@@ -264,5 +361,13 @@ func (gs GenesisState) Validate() error {
 	if err != nil {
 		return err
 	}
+	err = gs.ValidateRedelegations()
+	if err != nil {
+		return err
+	}
+	err = gs.ValidateNSTSlashRecords()
+	if err != nil {
+		return err
+	}
 	return nil
 }
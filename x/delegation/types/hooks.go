@@ -1,6 +1,7 @@
 package types
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -12,10 +13,14 @@ func NewMultiDelegationHooks(hooks ...DelegationHooks) MultiDelegationHooks {
 	return hooks
 }
 
-func (hooks MultiDelegationHooks) AfterDelegation(ctx sdk.Context, operator sdk.AccAddress) {
+func (hooks MultiDelegationHooks) AfterDelegation(ctx sdk.Context, operator sdk.AccAddress, stakerID, assetID string) error {
 	for _, hook := range hooks {
-		hook.AfterDelegation(ctx, operator)
+		err := hook.AfterDelegation(ctx, operator, stakerID, assetID)
+		if err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
 func (hooks MultiDelegationHooks) AfterUndelegationStarted(
@@ -31,3 +36,46 @@ func (hooks MultiDelegationHooks) AfterUndelegationStarted(
 	}
 	return nil
 }
+
+func (hooks MultiDelegationHooks) AfterRedelegationStarted(
+	ctx sdk.Context,
+	srcOperator sdk.AccAddress,
+	recordKey []byte,
+) error {
+	for _, hook := range hooks {
+		err := hook.AfterRedelegationStarted(ctx, srcOperator, recordKey)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (hooks MultiDelegationHooks) AfterUndelegationCanceled(
+	ctx sdk.Context,
+	operator sdk.AccAddress,
+	recordKey []byte,
+) error {
+	for _, hook := range hooks {
+		err := hook.AfterUndelegationCanceled(ctx, operator, recordKey)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (hooks MultiDelegationHooks) AfterDelegationSlashed(
+	ctx sdk.Context,
+	operator sdk.AccAddress,
+	stakerID, assetID string,
+	amount sdkmath.Int,
+) error {
+	for _, hook := range hooks {
+		err := hook.AfterDelegationSlashed(ctx, operator, stakerID, assetID, amount)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -42,6 +42,27 @@ const (
 	prefixUndelegationOnHold
 
 	prefixAssociatedOperatorByStaker
+
+	prefixRedelegationInfo
+
+	prefixStakerRedelegationInfo
+
+	prefixPendingRedelegations
+
+	// used to store the redelegation hold count
+	prefixRedelegationOnHold
+
+	prefixNSTSlashRecord
+
+	// used to index the stakers of an operator by asset as separate keys
+	prefixDelegationsByOperator
+
+	// used to index the stakers delegating an asset to any operator
+	prefixStakersByAsset
+
+	// used to store the records that reach the completion height while being held
+	prefixHeldUndelegations
+	prefixHeldRedelegations
 )
 
 var (
@@ -64,6 +85,22 @@ var (
 
 	// KeyPrefixAssociatedOperatorByStaker stakerID -> operator address
 	KeyPrefixAssociatedOperatorByStaker = []byte{prefixAssociatedOperatorByStaker}
+
+	// KeyPrefixRedelegationInfo singleRecordKey = srcOperatorAddr+'/'+BlockHeight+'/'+LzNonce+'/'+txHash
+	// singleRecordKey -> RedelegationRecord
+	KeyPrefixRedelegationInfo = []byte{prefixRedelegationInfo}
+	// KeyPrefixStakerRedelegationInfo restakerID+'/'+assetID+'/'+dstOperatorAddr+'/'+LzNonce+'/'+txHash -> singleRecordKey
+	KeyPrefixStakerRedelegationInfo = []byte{prefixStakerRedelegationInfo}
+	// KeyPrefixPendingRedelegations completeHeight +'/'+LzNonce+'/'+txHash -> singleRecordKey
+	KeyPrefixPendingRedelegations = []byte{prefixPendingRedelegations}
+
+	// KeyPrefixNSTSlashRecord restakerID+'/'+assetID+'/'+height+'/'+index -> NSTSlashRecord
+	KeyPrefixNSTSlashRecord = []byte{prefixNSTSlashRecord}
+
+	// KeyPrefixDelegationsByOperator operatorAddr+'/'+assetID+'/'+restakerID -> empty
+	KeyPrefixDelegationsByOperator = []byte{prefixDelegationsByOperator}
+	// KeyPrefixStakersByAsset assetID+'/'+restakerID -> number of the operators delegated to
+	KeyPrefixStakersByAsset = []byte{prefixStakersByAsset}
 )
 
 func IteratorPrefixForStakerAsset(stakerID, assetID string) []byte {
@@ -133,7 +170,59 @@ func GetPendingUndelegationRecordKey(height, lzNonce uint64) []byte {
 	return []byte(strings.Join([]string{hexutil.EncodeUint64(height), hexutil.EncodeUint64(lzNonce)}, "/"))
 }
 
+// GetHeldUndelegationKey returns the key for the undelegation record that reaches its
+// completion height while being held.
+func GetHeldUndelegationKey(recordKey []byte) []byte {
+	return append([]byte{prefixHeldUndelegations}, recordKey...)
+}
+
 // GetUndelegationOnHoldKey returns the key for the undelegation hold count
 func GetUndelegationOnHoldKey(recordKey []byte) []byte {
 	return append([]byte{prefixUndelegationOnHold}, recordKey...)
 }
+
+// GetRedelegationRecordKey returns the key for the redelegation record, which has the same
+// format as the undelegation record key but is prefixed by the source operator.
+func GetRedelegationRecordKey(blockHeight, lzNonce uint64, txHash string, srcOperatorAddr string) []byte {
+	return GetUndelegationRecordKey(blockHeight, lzNonce, txHash, srcOperatorAddr)
+}
+
+// IteratorPrefixForStakerRedelegationsTo returns the prefix to iterate over the redelegations
+// of the staker and asset to the destination operator.
+func IteratorPrefixForStakerRedelegationsTo(stakerID, assetID, dstOperatorAddr string) []byte {
+	tmp := []byte(strings.Join([]string{stakerID, assetID, dstOperatorAddr}, "/"))
+	tmp = append(tmp, '/')
+	return tmp
+}
+
+func GetStakerRedelegationRecordKey(stakerID, assetID, dstOperatorAddr string, lzNonce uint64, txHash string) []byte {
+	return []byte(strings.Join([]string{stakerID, assetID, dstOperatorAddr, hexutil.EncodeUint64(lzNonce), txHash}, "/"))
+}
+
+// GetHeldRedelegationKey returns the key for the redelegation record that reaches its
+// completion height while being held.
+func GetHeldRedelegationKey(recordKey []byte) []byte {
+	return append([]byte{prefixHeldRedelegations}, recordKey...)
+}
+
+// GetRedelegationOnHoldKey returns the key for the redelegation hold count
+func GetRedelegationOnHoldKey(recordKey []byte) []byte {
+	return append([]byte{prefixRedelegationOnHold}, recordKey...)
+}
+
+func GetPendingRedelegationRecordKey(height, lzNonce uint64, txHash string) []byte {
+	return []byte(strings.Join([]string{hexutil.EncodeUint64(height), hexutil.EncodeUint64(lzNonce), txHash}, "/"))
+}
+
+// GetNSTSlashRecordKey returns the key for the execution record of a native token balance drop.
+func GetNSTSlashRecordKey(stakerID, assetID string, height, index uint64) []byte {
+	return []byte(strings.Join([]string{stakerID, assetID, hexutil.EncodeUint64(height), hexutil.EncodeUint64(index)}, "/"))
+}
+
+// IteratorPrefixForNSTSlashRecordsAtHeight returns the prefix to iterate over the execution
+// records of the staker and asset at the height.
+func IteratorPrefixForNSTSlashRecordsAtHeight(stakerID, assetID string, height uint64) []byte {
+	tmp := []byte(strings.Join([]string{stakerID, assetID, hexutil.EncodeUint64(height)}, "/"))
+	tmp = append(tmp, '/')
+	return tmp
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -9,6 +10,8 @@ import (
 var (
 	_ sdk.Msg = &MsgDelegation{}
 	_ sdk.Msg = &MsgUndelegation{}
+	_ sdk.Msg = &MsgRedelegate{}
+	_ sdk.Msg = &MsgCancelUndelegation{}
 )
 
 // GetSigners returns the expected signers for a MsgUpdateParams message.
@@ -81,6 +84,87 @@ func NewMsgUndelegation(assetID, fromAddress string, amountPerOperator []KeyValu
 	}
 }
 
+// GetSigners returns the expected signers for a MsgRedelegate message.
+func (m *MsgRedelegate) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRedelegate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.SrcOperatorAddr); err != nil {
+		return errorsmod.Wrap(err, "invalid source operator address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.DstOperatorAddr); err != nil {
+		return errorsmod.Wrap(err, "invalid destination operator address")
+	}
+	if m.SrcOperatorAddr == m.DstOperatorAddr {
+		return ErrSelfRedelegation
+	}
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return ErrAmountIsNotPositive.Wrapf("amount should be positive, got %s", m.Amount)
+	}
+	if m.AssetID != assetstype.ExocoreAssetID {
+		return ErrInvalidAssetID.Wrapf(
+			"only nativeToken is support, expected:%s,got:%s", assetstype.ExocoreAssetID, m.AssetID,
+		)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgRedelegate) GetSignBytes() []byte {
+	return nil
+}
+
+// NewMsgRedelegate creates a new message to move the asset from the source operator
+// to the destination operator.
+func NewMsgRedelegate(
+	assetID, fromAddress, srcOperatorAddr, dstOperatorAddr string, amount sdkmath.Int,
+) *MsgRedelegate {
+	return &MsgRedelegate{
+		AssetID:         assetID,
+		FromAddress:     fromAddress,
+		SrcOperatorAddr: srcOperatorAddr,
+		DstOperatorAddr: dstOperatorAddr,
+		Amount:          amount,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgCancelUndelegation message.
+func (m *MsgCancelUndelegation) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgCancelUndelegation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	if _, err := ParseUndelegationRecordKey([]byte(m.RecordKey)); err != nil {
+		return errorsmod.Wrap(err, "invalid undelegation record key")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgCancelUndelegation) GetSignBytes() []byte {
+	return nil
+}
+
+// NewMsgCancelUndelegation creates a new message to cancel the undelegation with the
+// provided record key.
+func NewMsgCancelUndelegation(fromAddress, recordKey string) *MsgCancelUndelegation {
+	return &MsgCancelUndelegation{
+		FromAddress: fromAddress,
+		RecordKey:   recordKey,
+	}
+}
+
 // validateDelegationInfo validates the delegation or undelegation info.
 // (1) the operator amounts are positive, and the operator addresses are valid.
 // (2) the assetID is native only, since only native token is supported for this mechanism.
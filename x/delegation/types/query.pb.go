@@ -8,7 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -631,6 +631,401 @@ func (m *QueryAssociatedOperatorByStakerResponse) GetOperator() string {
 	return ""
 }
 
+// QueryDelegationsByOperatorReq is the request to obtain the delegations to an operator.
+type QueryDelegationsByOperatorReq struct {
+	// operator is the operator address.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// asset_id is the optional asset id, all the assets are included if it's empty.
+	AssetID string `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// pagination related options.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegationsByOperatorReq) Reset()         { *m = QueryDelegationsByOperatorReq{} }
+func (m *QueryDelegationsByOperatorReq) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationsByOperatorReq) ProtoMessage()    {}
+func (*QueryDelegationsByOperatorReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab345e1cf20490c, []int{12}
+}
+func (m *QueryDelegationsByOperatorReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationsByOperatorReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationsByOperatorReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationsByOperatorReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationsByOperatorReq.Merge(m, src)
+}
+func (m *QueryDelegationsByOperatorReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationsByOperatorReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationsByOperatorReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationsByOperatorReq proto.InternalMessageInfo
+
+func (m *QueryDelegationsByOperatorReq) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *QueryDelegationsByOperatorReq) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+func (m *QueryDelegationsByOperatorReq) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// DelegationByOperator is a single delegation to an operator.
+type DelegationByOperator struct {
+	// staker_id is the staker id.
+	StakerID string `protobuf:"bytes,1,opt,name=staker_id,json=stakerId,proto3" json:"staker_id,omitempty"`
+	// asset_id is the asset id.
+	AssetID string `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// delegation is the delegation amounts of the staker.
+	Delegation DelegationAmounts `protobuf:"bytes,3,opt,name=delegation,proto3" json:"delegation"`
+}
+
+func (m *DelegationByOperator) Reset()         { *m = DelegationByOperator{} }
+func (m *DelegationByOperator) String() string { return proto.CompactTextString(m) }
+func (*DelegationByOperator) ProtoMessage()    {}
+func (*DelegationByOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab345e1cf20490c, []int{13}
+}
+func (m *DelegationByOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationByOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationByOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationByOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationByOperator.Merge(m, src)
+}
+func (m *DelegationByOperator) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationByOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationByOperator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationByOperator proto.InternalMessageInfo
+
+func (m *DelegationByOperator) GetStakerID() string {
+	if m != nil {
+		return m.StakerID
+	}
+	return ""
+}
+
+func (m *DelegationByOperator) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+func (m *DelegationByOperator) GetDelegation() DelegationAmounts {
+	if m != nil {
+		return m.Delegation
+	}
+	return DelegationAmounts{}
+}
+
+// QueryDelegationsByOperatorResponse is the response to QueryDelegationsByOperatorReq.
+type QueryDelegationsByOperatorResponse struct {
+	// delegations is the list of the delegations to the operator.
+	Delegations []DelegationByOperator `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
+	// pagination related response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegationsByOperatorResponse) Reset()         { *m = QueryDelegationsByOperatorResponse{} }
+func (m *QueryDelegationsByOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationsByOperatorResponse) ProtoMessage()    {}
+func (*QueryDelegationsByOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab345e1cf20490c, []int{14}
+}
+func (m *QueryDelegationsByOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationsByOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationsByOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationsByOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationsByOperatorResponse.Merge(m, src)
+}
+func (m *QueryDelegationsByOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationsByOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationsByOperatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationsByOperatorResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationsByOperatorResponse) GetDelegations() []DelegationByOperator {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *QueryDelegationsByOperatorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryStakersByAssetReq is the request to obtain the stakers delegating an asset.
+type QueryStakersByAssetReq struct {
+	// asset_id is the asset id.
+	AssetID string `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// pagination related options.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStakersByAssetReq) Reset()         { *m = QueryStakersByAssetReq{} }
+func (m *QueryStakersByAssetReq) String() string { return proto.CompactTextString(m) }
+func (*QueryStakersByAssetReq) ProtoMessage()    {}
+func (*QueryStakersByAssetReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab345e1cf20490c, []int{15}
+}
+func (m *QueryStakersByAssetReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakersByAssetReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakersByAssetReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakersByAssetReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakersByAssetReq.Merge(m, src)
+}
+func (m *QueryStakersByAssetReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakersByAssetReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakersByAssetReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakersByAssetReq proto.InternalMessageInfo
+
+func (m *QueryStakersByAssetReq) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+func (m *QueryStakersByAssetReq) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryStakersByAssetResponse is the response to QueryStakersByAssetReq.
+type QueryStakersByAssetResponse struct {
+	// stakers is the sorted list of the staker ids.
+	Stakers []string `protobuf:"bytes,1,rep,name=stakers,proto3" json:"stakers,omitempty"`
+	// pagination related response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStakersByAssetResponse) Reset()         { *m = QueryStakersByAssetResponse{} }
+func (m *QueryStakersByAssetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakersByAssetResponse) ProtoMessage()    {}
+func (*QueryStakersByAssetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab345e1cf20490c, []int{16}
+}
+func (m *QueryStakersByAssetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakersByAssetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakersByAssetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakersByAssetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakersByAssetResponse.Merge(m, src)
+}
+func (m *QueryStakersByAssetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakersByAssetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakersByAssetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakersByAssetResponse proto.InternalMessageInfo
+
+func (m *QueryStakersByAssetResponse) GetStakers() []string {
+	if m != nil {
+		return m.Stakers
+	}
+	return nil
+}
+
+func (m *QueryStakersByAssetResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryNSTSlashRecordsReq is the request to obtain the execution records of the native token
+// balance drops of a staker.
+type QueryNSTSlashRecordsReq struct {
+	// staker_id is the staker id.
+	StakerID string `protobuf:"bytes,1,opt,name=staker_id,json=stakerId,proto3" json:"staker_id,omitempty"`
+	// asset_id is the asset id of the native token.
+	AssetID string `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+}
+
+func (m *QueryNSTSlashRecordsReq) Reset()         { *m = QueryNSTSlashRecordsReq{} }
+func (m *QueryNSTSlashRecordsReq) String() string { return proto.CompactTextString(m) }
+func (*QueryNSTSlashRecordsReq) ProtoMessage()    {}
+func (*QueryNSTSlashRecordsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab345e1cf20490c, []int{17}
+}
+func (m *QueryNSTSlashRecordsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNSTSlashRecordsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNSTSlashRecordsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNSTSlashRecordsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNSTSlashRecordsReq.Merge(m, src)
+}
+func (m *QueryNSTSlashRecordsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNSTSlashRecordsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNSTSlashRecordsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNSTSlashRecordsReq proto.InternalMessageInfo
+
+func (m *QueryNSTSlashRecordsReq) GetStakerID() string {
+	if m != nil {
+		return m.StakerID
+	}
+	return ""
+}
+
+func (m *QueryNSTSlashRecordsReq) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+// QueryNSTSlashRecordsResponse is the response to QueryNSTSlashRecordsReq.
+type QueryNSTSlashRecordsResponse struct {
+	// records is the list of the records, sorted by the height and the index.
+	Records []NSTSlashRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+}
+
+func (m *QueryNSTSlashRecordsResponse) Reset()         { *m = QueryNSTSlashRecordsResponse{} }
+func (m *QueryNSTSlashRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNSTSlashRecordsResponse) ProtoMessage()    {}
+func (*QueryNSTSlashRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab345e1cf20490c, []int{18}
+}
+func (m *QueryNSTSlashRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNSTSlashRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNSTSlashRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNSTSlashRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNSTSlashRecordsResponse.Merge(m, src)
+}
+func (m *QueryNSTSlashRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNSTSlashRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNSTSlashRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNSTSlashRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryNSTSlashRecordsResponse) GetRecords() []NSTSlashRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DelegationInfoReq)(nil), "exocore.delegation.v1.DelegationInfoReq")
 	proto.RegisterType((*StakerList)(nil), "exocore.delegation.v1.StakerList")
@@ -645,71 +1040,98 @@ func init() {
 	proto.RegisterType((*UndelegationRecordList)(nil), "exocore.delegation.v1.UndelegationRecordList")
 	proto.RegisterType((*QueryAssociatedOperatorByStakerReq)(nil), "exocore.delegation.v1.QueryAssociatedOperatorByStakerReq")
 	proto.RegisterType((*QueryAssociatedOperatorByStakerResponse)(nil), "exocore.delegation.v1.QueryAssociatedOperatorByStakerResponse")
+	proto.RegisterType((*QueryDelegationsByOperatorReq)(nil), "exocore.delegation.v1.QueryDelegationsByOperatorReq")
+	proto.RegisterType((*DelegationByOperator)(nil), "exocore.delegation.v1.DelegationByOperator")
+	proto.RegisterType((*QueryDelegationsByOperatorResponse)(nil), "exocore.delegation.v1.QueryDelegationsByOperatorResponse")
+	proto.RegisterType((*QueryStakersByAssetReq)(nil), "exocore.delegation.v1.QueryStakersByAssetReq")
+	proto.RegisterType((*QueryStakersByAssetResponse)(nil), "exocore.delegation.v1.QueryStakersByAssetResponse")
+	proto.RegisterType((*QueryNSTSlashRecordsReq)(nil), "exocore.delegation.v1.QueryNSTSlashRecordsReq")
+	proto.RegisterType((*QueryNSTSlashRecordsResponse)(nil), "exocore.delegation.v1.QueryNSTSlashRecordsResponse")
 }
 
 func init() { proto.RegisterFile("exocore/delegation/v1/query.proto", fileDescriptor_aab345e1cf20490c) }
 
 var fileDescriptor_aab345e1cf20490c = []byte{
-	// 934 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x24, 0x94, 0xc4, 0x2f, 0xa9, 0x08, 0xd3, 0x50, 0xdc, 0x2d, 0xb1, 0xdb, 0x3d, 0xa4,
-	0x49, 0x51, 0x76, 0x89, 0x5b, 0xaa, 0x16, 0x11, 0x8b, 0xa4, 0x8e, 0x5a, 0xab, 0xa8, 0x11, 0x1b,
-	0x71, 0xe1, 0xb2, 0xda, 0xec, 0x4e, 0xd6, 0x2b, 0x6f, 0x76, 0xdc, 0x9d, 0x71, 0x1a, 0x0b, 0x71,
-	0xe1, 0xd4, 0x23, 0x12, 0x5f, 0x81, 0x13, 0x5c, 0x38, 0xe4, 0xc8, 0x07, 0xe8, 0x31, 0x0a, 0x17,
-	0xc4, 0x21, 0x02, 0x07, 0x89, 0xef, 0xc0, 0x05, 0xb4, 0xb3, 0x63, 0xef, 0x3a, 0xd9, 0x75, 0x6c,
-	0x10, 0xa7, 0x78, 0xde, 0xbc, 0xf7, 0x9b, 0xdf, 0xfb, 0xf3, 0x7b, 0x59, 0xb8, 0x4d, 0x0e, 0xa9,
-	0x4d, 0x43, 0xa2, 0x3b, 0xc4, 0x27, 0xae, 0xc5, 0x3d, 0x1a, 0xe8, 0x07, 0x6b, 0xfa, 0x8b, 0x36,
-	0x09, 0x3b, 0x5a, 0x2b, 0xa4, 0x9c, 0xe2, 0x77, 0xa4, 0x8b, 0x96, 0xb8, 0x68, 0x07, 0x6b, 0xca,
-	0x4d, 0x9b, 0xb2, 0x7d, 0xca, 0x62, 0xd7, 0x73, 0x31, 0xca, 0x8d, 0xf8, 0xd2, 0x14, 0x27, 0x3d,
-	0x3e, 0xc8, 0xab, 0x52, 0xf6, 0x8b, 0xfc, 0x50, 0xde, 0x2f, 0xb8, 0xd4, 0xa5, 0x71, 0x5c, 0xf4,
-	0x4b, 0x5a, 0xdf, 0x73, 0x29, 0x75, 0x7d, 0xa2, 0x5b, 0x2d, 0x4f, 0xb7, 0x82, 0x80, 0x72, 0x11,
-	0x28, 0x31, 0xd5, 0x3d, 0x78, 0xbb, 0xd6, 0x47, 0xab, 0x07, 0x7b, 0xd4, 0x20, 0x2f, 0xf0, 0x0a,
-	0x14, 0x18, 0xb7, 0x9a, 0x24, 0x34, 0x3d, 0xa7, 0x88, 0x6e, 0xa1, 0xe5, 0xc2, 0xe6, 0x5c, 0xf7,
-	0xb4, 0x3c, 0xb3, 0x23, 0x8c, 0xf5, 0x9a, 0x31, 0x13, 0x5f, 0xd7, 0x1d, 0xbc, 0x04, 0x33, 0x16,
-	0x63, 0x84, 0x47, 0x9e, 0x93, 0xc2, 0x73, 0xb6, 0x7b, 0x5a, 0x9e, 0xde, 0x88, 0x6c, 0xf5, 0x9a,
-	0x31, 0x2d, 0x2e, 0xeb, 0x8e, 0xba, 0x04, 0x10, 0x47, 0x7f, 0xea, 0x31, 0x8e, 0x8b, 0x30, 0x1d,
-	0x23, 0xb0, 0x22, 0xba, 0x35, 0xb5, 0x5c, 0x30, 0x7a, 0x47, 0xf5, 0x2f, 0x94, 0x26, 0xb4, 0xb1,
-	0x4f, 0xdb, 0x01, 0x67, 0x78, 0x1f, 0xae, 0xb5, 0x03, 0x99, 0xb5, 0xb5, 0xeb, 0x13, 0x93, 0x35,
-	0xac, 0x90, 0x48, 0x6a, 0x1f, 0xbf, 0x3e, 0x2d, 0x4f, 0xfc, 0x7a, 0x5a, 0x5e, 0x72, 0x3d, 0xde,
-	0x68, 0xef, 0x6a, 0x36, 0xdd, 0x97, 0x75, 0x93, 0x7f, 0x56, 0x99, 0xd3, 0xd4, 0x79, 0xa7, 0x45,
-	0x98, 0x56, 0x23, 0xf6, 0xc9, 0xd1, 0x2a, 0xc8, 0xb2, 0xd6, 0x88, 0x6d, 0xe0, 0x01, 0xe0, 0x9d,
-	0x08, 0x17, 0x1f, 0x40, 0xf1, 0xa5, 0xe5, 0x71, 0xb3, 0x7f, 0xe5, 0xd1, 0xc0, 0xb4, 0x04, 0x17,
-	0x99, 0xe4, 0x38, 0x6f, 0xd6, 0x03, 0x9e, 0x7a, 0xb3, 0x1e, 0x70, 0xe3, 0x7a, 0x84, 0xfe, 0x79,
-	0x0a, 0x3c, 0xce, 0x53, 0xfd, 0x1b, 0xc1, 0xcd, 0xcf, 0xa2, 0x59, 0x38, 0xdf, 0x12, 0xd6, 0xa2,
-	0x01, 0x23, 0x38, 0x84, 0xf9, 0x14, 0x21, 0x2f, 0xd8, 0xa3, 0x71, 0xfd, 0x66, 0x2b, 0x4f, 0xb4,
-	0xcc, 0x51, 0xd3, 0x86, 0xa0, 0x69, 0x83, 0x66, 0xb6, 0x15, 0xf0, 0xb0, 0x63, 0xbc, 0xe5, 0x0c,
-	0x5a, 0x15, 0x1f, 0x16, 0xb2, 0x1c, 0xf1, 0x3c, 0x4c, 0x35, 0x49, 0x27, 0x6e, 0x81, 0x11, 0xfd,
-	0xc4, 0x55, 0xb8, 0x72, 0x60, 0xf9, 0x6d, 0x22, 0x4a, 0x34, 0x5b, 0x59, 0xce, 0xa1, 0x74, 0xa1,
-	0xbb, 0x46, 0x1c, 0xf6, 0xd1, 0xe4, 0x43, 0xa4, 0xfe, 0x80, 0xe0, 0xdd, 0x1d, 0x2f, 0x70, 0x7d,
-	0xf2, 0x9f, 0xa6, 0x72, 0x1d, 0xae, 0xd2, 0x16, 0x09, 0x2d, 0x4e, 0x43, 0xd3, 0x72, 0x9c, 0x50,
-	0x76, 0xad, 0x78, 0x72, 0xb4, 0xba, 0x20, 0xfb, 0xb0, 0xe1, 0x38, 0x21, 0x61, 0x6c, 0x87, 0x87,
-	0x5e, 0xe0, 0x1a, 0x73, 0x3d, 0xf7, 0xc8, 0x3c, 0x30, 0xd4, 0x53, 0x43, 0x86, 0xfa, 0x11, 0x14,
-	0xd3, 0x5d, 0x7c, 0x4a, 0x7d, 0xe7, 0x71, 0x94, 0x52, 0xc4, 0x76, 0x11, 0x20, 0x24, 0x36, 0x0d,
-	0x1d, 0x33, 0x29, 0x53, 0x21, 0xb6, 0x3c, 0x23, 0x1d, 0xb5, 0x0a, 0x8b, 0x39, 0xa1, 0xb2, 0xd7,
-	0x8b, 0x00, 0x0d, 0xea, 0x3b, 0xa6, 0x2d, 0xa6, 0x2e, 0x8a, 0x7f, 0xc3, 0x28, 0x34, 0x7a, 0x6e,
-	0x2a, 0x81, 0xf9, 0x74, 0x3c, 0xfb, 0x9f, 0x64, 0xbb, 0x3e, 0x98, 0x21, 0xdb, 0xec, 0x3c, 0x25,
-	0x9e, 0xdb, 0x10, 0x19, 0xde, 0x86, 0xb9, 0x5d, 0x9f, 0xda, 0x4d, 0xb3, 0x21, 0x4c, 0x92, 0xe3,
-	0xac, 0xb0, 0xc5, 0x5e, 0xaa, 0x07, 0xd7, 0xd3, 0xe1, 0x86, 0x48, 0x5f, 0x6c, 0x80, 0x6d, 0xb8,
-	0x9a, 0x56, 0x57, 0x6f, 0x8e, 0x57, 0x72, 0x86, 0xe6, 0x22, 0x8a, 0x31, 0x18, 0xaf, 0x6e, 0x83,
-	0x2a, 0x86, 0x7d, 0x83, 0x31, 0x6a, 0x7b, 0x16, 0x27, 0xce, 0xb6, 0x6c, 0xe9, 0x66, 0x27, 0x2e,
-	0xc0, 0x78, 0x25, 0x52, 0xb7, 0xe0, 0xce, 0xa5, 0x80, 0xb2, 0x57, 0x0a, 0xcc, 0xf4, 0xe6, 0x47,
-	0x76, 0xba, 0x7f, 0xae, 0xbc, 0x2a, 0xc0, 0x15, 0x81, 0x83, 0xbf, 0x47, 0x70, 0x2d, 0x43, 0x8f,
-	0xf8, 0x72, 0xa1, 0x48, 0x05, 0x28, 0x95, 0xf1, 0x55, 0xae, 0x7e, 0xf8, 0xea, 0xcf, 0x1f, 0xef,
-	0xa2, 0xaf, 0x7f, 0xfe, 0xe3, 0xdb, 0xc9, 0xbb, 0x78, 0x59, 0xcf, 0xfe, 0x17, 0xf2, 0x84, 0xf0,
-	0x73, 0xa4, 0x8e, 0x10, 0xdc, 0x10, 0xb0, 0x59, 0x6a, 0xc4, 0x5a, 0x0e, 0x91, 0x1c, 0xe9, 0x2a,
-	0x23, 0xef, 0x02, 0x75, 0x3d, 0xa1, 0x5b, 0xc1, 0x1f, 0xe4, 0xd0, 0xcd, 0x27, 0x76, 0x8c, 0x40,
-	0x11, 0xb7, 0x99, 0xe2, 0xc2, 0xfa, 0x08, 0xe3, 0x95, 0x56, 0xb1, 0x72, 0x7f, 0xbc, 0x00, 0x59,
-	0xf3, 0x67, 0x49, 0x12, 0x9f, 0xe0, 0xea, 0xb0, 0x24, 0x32, 0x71, 0xf4, 0x2f, 0x93, 0xcd, 0xf1,
-	0x15, 0xfe, 0x0e, 0x01, 0xbe, 0xe0, 0xcb, 0xf0, 0x9d, 0x11, 0x98, 0x45, 0x5b, 0x41, 0x59, 0x1d,
-	0x59, 0x52, 0x91, 0x30, 0xd5, 0x07, 0x09, 0xf7, 0xf7, 0xf1, 0xca, 0xa8, 0xdc, 0x19, 0xfe, 0x29,
-	0xab, 0xf2, 0xfd, 0x7d, 0x31, 0x52, 0xe5, 0xd3, 0xdb, 0x65, 0x5c, 0xda, 0xd5, 0x84, 0xf6, 0x3d,
-	0xbc, 0x36, 0x32, 0xed, 0x3e, 0xbf, 0xdf, 0x11, 0x94, 0x2f, 0x91, 0x3b, 0x7e, 0x34, 0x4c, 0x7e,
-	0x43, 0xf7, 0x8e, 0x52, 0xfd, 0xb7, 0xa1, 0x72, 0xa2, 0x1e, 0x27, 0xe9, 0x3d, 0xc4, 0x0f, 0x86,
-	0xa5, 0x97, 0x0f, 0xb6, 0xf9, 0xfc, 0x75, 0xb7, 0x84, 0x8e, 0xbb, 0x25, 0xf4, 0x5b, 0xb7, 0x84,
-	0xbe, 0x39, 0x2b, 0x4d, 0x1c, 0x9f, 0x95, 0x26, 0x7e, 0x39, 0x2b, 0x4d, 0x7c, 0x71, 0x3f, 0xf5,
-	0x19, 0xb3, 0x15, 0x63, 0x3f, 0x27, 0xfc, 0x25, 0x0d, 0x9b, 0xfd, 0xa7, 0x0e, 0xd3, 0x8f, 0x89,
-	0x0f, 0x9b, 0xdd, 0x37, 0xc5, 0x27, 0xe4, 0xbd, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa1, 0xe2,
-	0xcd, 0x70, 0x0a, 0x0b, 0x00, 0x00,
+	// 1262 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xc1, 0x6f, 0x1b, 0xc5,
+	0x17, 0xce, 0xa6, 0xed, 0x2f, 0xc9, 0x73, 0xab, 0x5f, 0x99, 0x86, 0xd6, 0xdd, 0x36, 0x4e, 0xbb,
+	0x12, 0x69, 0x9a, 0xe2, 0x5d, 0xe2, 0x84, 0x2a, 0x41, 0x8d, 0x45, 0x5c, 0x87, 0xd4, 0x2a, 0x4a,
+	0x60, 0x0d, 0x17, 0x2e, 0xd6, 0xda, 0x3b, 0xb1, 0x57, 0x71, 0x76, 0x9c, 0x9d, 0x75, 0x1a, 0xab,
+	0xaa, 0x84, 0x38, 0xf5, 0x88, 0xc4, 0xbf, 0xc0, 0x09, 0x38, 0x70, 0x08, 0x07, 0x24, 0x4e, 0x48,
+	0x95, 0x7a, 0x8c, 0xda, 0x0b, 0xe2, 0x10, 0x41, 0x82, 0xc4, 0xff, 0xc0, 0x05, 0xb4, 0xb3, 0xb3,
+	0xde, 0x5d, 0x67, 0x77, 0x63, 0x07, 0x72, 0x6a, 0x76, 0xe6, 0xbd, 0x6f, 0xbe, 0xf7, 0xde, 0x37,
+	0xef, 0x8d, 0x0b, 0xb7, 0xf1, 0x2e, 0xa9, 0x11, 0x0b, 0x2b, 0x3a, 0x6e, 0xe2, 0xba, 0x66, 0x1b,
+	0xc4, 0x54, 0x76, 0x66, 0x95, 0xed, 0x36, 0xb6, 0x3a, 0x72, 0xcb, 0x22, 0x36, 0x41, 0x6f, 0x72,
+	0x13, 0xd9, 0x37, 0x91, 0x77, 0x66, 0xc5, 0x99, 0x1a, 0xa1, 0x5b, 0x84, 0x2a, 0x55, 0x8d, 0x62,
+	0xd7, 0x5e, 0xd9, 0x99, 0xad, 0x62, 0x5b, 0x9b, 0x55, 0x5a, 0x5a, 0xdd, 0x30, 0x5d, 0x43, 0x06,
+	0x21, 0xde, 0xe0, 0xb6, 0x9e, 0x59, 0x10, 0x5f, 0xbc, 0xee, 0x6e, 0x56, 0xd8, 0x97, 0xe2, 0x7e,
+	0xf0, 0xad, 0x4c, 0x34, 0x3b, 0x7b, 0x97, 0xef, 0x8f, 0xd7, 0x49, 0x9d, 0xb8, 0x7e, 0xce, 0x5f,
+	0x7c, 0xf5, 0x66, 0x9d, 0x90, 0x7a, 0x13, 0x2b, 0x5a, 0xcb, 0x50, 0x34, 0xd3, 0x24, 0x36, 0x73,
+	0xe4, 0x98, 0xd2, 0x06, 0xbc, 0x51, 0xec, 0xa2, 0x95, 0xcc, 0x0d, 0xa2, 0xe2, 0x6d, 0x74, 0x17,
+	0xc6, 0xa8, 0xad, 0x6d, 0x62, 0xab, 0x62, 0xe8, 0x69, 0xe1, 0x96, 0x30, 0x3d, 0x56, 0xb8, 0x78,
+	0x78, 0x30, 0x39, 0x5a, 0x66, 0x8b, 0xa5, 0xa2, 0x3a, 0xea, 0x6e, 0x97, 0x74, 0x34, 0x05, 0xa3,
+	0x1a, 0xa5, 0xd8, 0x76, 0x2c, 0x87, 0x99, 0x65, 0xea, 0xf0, 0x60, 0x72, 0x64, 0xd9, 0x59, 0x2b,
+	0x15, 0xd5, 0x11, 0xb6, 0x59, 0xd2, 0xa5, 0x29, 0x00, 0xd7, 0xfb, 0x43, 0x83, 0xda, 0x28, 0x0d,
+	0x23, 0x2e, 0x02, 0x4d, 0x0b, 0xb7, 0xce, 0x4d, 0x8f, 0xa9, 0xde, 0xa7, 0xf4, 0x97, 0x10, 0x24,
+	0xb4, 0xbc, 0x45, 0xda, 0xa6, 0x4d, 0xd1, 0x16, 0x5c, 0x69, 0x9b, 0x3c, 0x6a, 0xad, 0xda, 0xc4,
+	0x15, 0xda, 0xd0, 0x2c, 0xcc, 0xa9, 0x3d, 0x78, 0x79, 0x30, 0x39, 0xf4, 0xeb, 0xc1, 0xe4, 0x54,
+	0xdd, 0xb0, 0x1b, 0xed, 0xaa, 0x5c, 0x23, 0x5b, 0x3c, 0x6f, 0xfc, 0x9f, 0x2c, 0xd5, 0x37, 0x15,
+	0xbb, 0xd3, 0xc2, 0x54, 0x2e, 0xe2, 0xda, 0xab, 0xbd, 0x2c, 0xf0, 0xb4, 0x16, 0x71, 0x4d, 0x45,
+	0x21, 0xe0, 0xb2, 0x83, 0x8b, 0x76, 0x20, 0xfd, 0x44, 0x33, 0xec, 0x4a, 0x77, 0xcb, 0x20, 0x66,
+	0x45, 0x63, 0x5c, 0x78, 0x90, 0x83, 0x9c, 0x59, 0x32, 0xed, 0xc0, 0x99, 0x25, 0xd3, 0x56, 0xaf,
+	0x3a, 0xe8, 0x9f, 0x06, 0xc0, 0xdd, 0x38, 0xa5, 0xbf, 0x05, 0xb8, 0xf1, 0xb1, 0xa3, 0x85, 0xde,
+	0x92, 0xd0, 0x16, 0x31, 0x29, 0x46, 0x16, 0x5c, 0x0e, 0x10, 0x32, 0xcc, 0x0d, 0xe2, 0xe6, 0x2f,
+	0x95, 0x5b, 0x95, 0x23, 0x65, 0x29, 0x27, 0xa0, 0xc9, 0xe1, 0x65, 0xba, 0x62, 0xda, 0x56, 0x47,
+	0xfd, 0xbf, 0x1e, 0x5e, 0x15, 0x9b, 0x30, 0x1e, 0x65, 0x88, 0x2e, 0xc3, 0xb9, 0x4d, 0xdc, 0x71,
+	0x4b, 0xa0, 0x3a, 0x7f, 0xa2, 0x3c, 0x5c, 0xd8, 0xd1, 0x9a, 0x6d, 0xcc, 0x52, 0x94, 0xca, 0x4d,
+	0xc7, 0x50, 0x3a, 0x56, 0x5d, 0xd5, 0x75, 0x7b, 0x6f, 0x78, 0x41, 0x90, 0xbe, 0x15, 0xe0, 0x5a,
+	0xd9, 0x30, 0xeb, 0x4d, 0xfc, 0xaf, 0x54, 0xb9, 0x04, 0x97, 0x48, 0x0b, 0x5b, 0x9a, 0x4d, 0xac,
+	0x8a, 0xa6, 0xeb, 0x16, 0xaf, 0x5a, 0xfa, 0xd5, 0x5e, 0x76, 0x9c, 0xd7, 0x61, 0x59, 0xd7, 0x2d,
+	0x4c, 0x69, 0xd9, 0xb6, 0x0c, 0xb3, 0xae, 0x5e, 0xf4, 0xcc, 0x9d, 0xe5, 0x90, 0xa8, 0xcf, 0x25,
+	0x88, 0x7a, 0x11, 0xd2, 0xc1, 0x2a, 0x3e, 0x22, 0x4d, 0xfd, 0xa1, 0x13, 0x92, 0xc3, 0x76, 0x02,
+	0xc0, 0xc2, 0x35, 0x62, 0xe9, 0x15, 0x3f, 0x4d, 0x63, 0xee, 0xca, 0x63, 0xdc, 0x91, 0xf2, 0x30,
+	0x11, 0xe3, 0xca, 0x6b, 0x3d, 0x01, 0xd0, 0x20, 0x4d, 0xbd, 0x52, 0x63, 0xaa, 0x73, 0xfc, 0xcf,
+	0xab, 0x63, 0x0d, 0xcf, 0x4c, 0xc2, 0x70, 0x39, 0xe8, 0x4f, 0xcf, 0xe8, 0xda, 0x2e, 0x85, 0x23,
+	0xa4, 0x85, 0xce, 0x23, 0x6c, 0xd4, 0x1b, 0x2c, 0xc2, 0xdb, 0x70, 0xb1, 0xda, 0x24, 0xb5, 0xcd,
+	0x4a, 0x83, 0x2d, 0x71, 0x8e, 0x29, 0xb6, 0xe6, 0x5a, 0x49, 0x06, 0x5c, 0x0d, 0xba, 0xab, 0x2c,
+	0x7c, 0xd6, 0x01, 0xd6, 0xe1, 0x52, 0xf0, 0x76, 0x79, 0x3a, 0xbe, 0x1b, 0x23, 0x9a, 0xe3, 0x28,
+	0x6a, 0xd8, 0x5f, 0x5a, 0x07, 0x89, 0x89, 0x7d, 0x99, 0x52, 0x52, 0x33, 0x34, 0x1b, 0xeb, 0xeb,
+	0xbc, 0xa4, 0x85, 0x8e, 0x9b, 0x80, 0xc1, 0x52, 0x24, 0xad, 0xc0, 0x9d, 0x13, 0x01, 0x79, 0xad,
+	0x44, 0x18, 0xf5, 0xf4, 0xc3, 0x2b, 0xdd, 0xfd, 0x96, 0x5e, 0x08, 0x30, 0xd1, 0x73, 0x0b, 0x69,
+	0xa1, 0xe3, 0x41, 0x39, 0x9c, 0xe6, 0x7b, 0xbd, 0x13, 0x74, 0xda, 0xb5, 0xec, 0xb7, 0x82, 0xe8,
+	0x03, 0x00, 0x7f, 0x00, 0x31, 0x35, 0xa7, 0x72, 0x53, 0x32, 0x07, 0x77, 0xa6, 0x95, 0xec, 0x4e,
+	0x1f, 0x3e, 0xad, 0xe4, 0x8f, 0xb4, 0x3a, 0x56, 0xf1, 0x76, 0x1b, 0x53, 0x5b, 0x0d, 0x78, 0x4a,
+	0x3f, 0x0a, 0xc1, 0x46, 0xe0, 0x47, 0x70, 0x06, 0xaa, 0x43, 0x6b, 0x00, 0x7e, 0x69, 0x39, 0xe7,
+	0xbe, 0xdb, 0x49, 0xe1, 0xbc, 0xd3, 0x9b, 0xd5, 0x00, 0x82, 0xf4, 0xb3, 0xc0, 0xc5, 0x11, 0x53,
+	0x03, 0x5e, 0xc6, 0x32, 0xa4, 0x8e, 0x2b, 0xf2, 0xde, 0x89, 0xe7, 0xfa, 0x48, 0xfc, 0xe8, 0x20,
+	0x0a, 0x5a, 0x0d, 0xe5, 0xdf, 0x6d, 0x8d, 0x77, 0x4e, 0xcc, 0xbf, 0xcb, 0x28, 0x54, 0x80, 0xe7,
+	0x02, 0x5c, 0x65, 0x41, 0xb8, 0x89, 0xa5, 0x85, 0x0e, 0xcb, 0x9b, 0xa3, 0xa0, 0x60, 0x5e, 0x85,
+	0xbe, 0xb5, 0x30, 0x7c, 0x6a, 0x2d, 0x7c, 0xee, 0xcd, 0xa9, 0x5e, 0x2a, 0x3c, 0x91, 0xb1, 0xe3,
+	0xfd, 0xbf, 0xcb, 0x46, 0x13, 0xae, 0x31, 0x06, 0x6b, 0xe5, 0x4f, 0xca, 0x4d, 0x8d, 0x36, 0xdc,
+	0xa6, 0x70, 0x56, 0x6d, 0x10, 0xc3, 0xcd, 0xe8, 0xd3, 0x78, 0xc0, 0x2b, 0x30, 0xe2, 0xb6, 0x76,
+	0x4f, 0x35, 0x6f, 0xc5, 0xa8, 0x26, 0x0c, 0xc0, 0xf5, 0xe2, 0xf9, 0xe6, 0x5e, 0x5c, 0x82, 0x0b,
+	0xec, 0x1c, 0xf4, 0x8d, 0x00, 0x57, 0x22, 0x66, 0x37, 0x3a, 0xf9, 0x16, 0xf0, 0x69, 0x29, 0xe6,
+	0x06, 0x7f, 0x11, 0x48, 0xef, 0x3e, 0xff, 0xf3, 0xfb, 0x19, 0xe1, 0x8b, 0xd7, 0x7f, 0x7c, 0x35,
+	0x3c, 0x83, 0xa6, 0x95, 0xe8, 0xe7, 0xe6, 0x2a, 0xb6, 0x7b, 0x48, 0xed, 0x09, 0x70, 0xdd, 0x95,
+	0x43, 0xc4, 0xe4, 0x46, 0x72, 0x0c, 0x91, 0x98, 0x31, 0x2f, 0xf6, 0x7d, 0xd1, 0xa5, 0x25, 0x9f,
+	0x6e, 0x0e, 0xbd, 0x13, 0x43, 0x37, 0x9e, 0xd8, 0xbe, 0x00, 0x22, 0xdb, 0x8d, 0x1c, 0xc4, 0x48,
+	0xe9, 0x63, 0x14, 0x05, 0x27, 0xbe, 0x38, 0x3f, 0x98, 0x03, 0xcf, 0xf9, 0x63, 0x3f, 0x88, 0xf7,
+	0x51, 0x3e, 0x29, 0x88, 0x48, 0x1c, 0xe5, 0xa9, 0xff, 0xca, 0x78, 0x86, 0xbe, 0x16, 0x00, 0x1d,
+	0xb3, 0xa5, 0xe8, 0x4e, 0x1f, 0xcc, 0x9c, 0xab, 0x23, 0x66, 0xfb, 0x1e, 0xbf, 0xce, 0x10, 0x97,
+	0xee, 0xfb, 0xdc, 0xef, 0xa1, 0xbb, 0xfd, 0x72, 0xa7, 0xe8, 0xa7, 0xa8, 0xcc, 0x77, 0xdf, 0x16,
+	0x7d, 0x65, 0x3e, 0xf8, 0x12, 0x19, 0x94, 0x76, 0xde, 0xa7, 0x3d, 0x87, 0x66, 0xfb, 0xa6, 0xdd,
+	0xe5, 0xf7, 0xbb, 0x00, 0x93, 0x27, 0x3c, 0x0d, 0xd0, 0x62, 0xd2, 0xf5, 0x4b, 0x7c, 0xa3, 0x88,
+	0xf9, 0xd3, 0xba, 0x72, 0x45, 0x3d, 0xf4, 0xc3, 0x5b, 0x40, 0xf7, 0x93, 0xc2, 0x4b, 0xe0, 0xff,
+	0xda, 0x2b, 0x51, 0xe4, 0xc8, 0x44, 0xf3, 0xfd, 0x75, 0x97, 0xf0, 0x4b, 0x47, 0x5c, 0x3c, 0x85,
+	0x17, 0x0f, 0xaa, 0xc8, 0xe2, 0xc9, 0xa3, 0x07, 0x49, 0xf1, 0x44, 0x42, 0x28, 0x4f, 0xbd, 0x37,
+	0xd3, 0x33, 0xf4, 0x9d, 0xd7, 0x56, 0xc3, 0x83, 0x0b, 0x65, 0x93, 0x88, 0x1d, 0x9b, 0xb7, 0xc9,
+	0xbd, 0x35, 0x7a, 0x26, 0x4a, 0x39, 0x16, 0xc0, 0xdb, 0x68, 0x26, 0xb1, 0x4f, 0x85, 0x69, 0xfd,
+	0x20, 0xc0, 0x78, 0xd4, 0xdc, 0x89, 0xed, 0xa9, 0x31, 0x23, 0x51, 0x9c, 0x1b, 0xc8, 0x9e, 0x33,
+	0x5e, 0xf0, 0x75, 0x94, 0x45, 0xf7, 0x92, 0x68, 0xf7, 0x20, 0x14, 0xd6, 0x5e, 0x1e, 0x66, 0x84,
+	0xfd, 0xc3, 0x8c, 0xf0, 0xdb, 0x61, 0x46, 0xf8, 0xf2, 0x28, 0x33, 0xb4, 0x7f, 0x94, 0x19, 0xfa,
+	0xe5, 0x28, 0x33, 0xf4, 0xd9, 0x7c, 0xe0, 0xf7, 0xf2, 0x8a, 0x0b, 0xb8, 0x86, 0xed, 0x27, 0xc4,
+	0xda, 0xec, 0xe2, 0xef, 0x06, 0x4f, 0x60, 0xbf, 0xa0, 0xab, 0xff, 0x63, 0xff, 0x57, 0x31, 0xf7,
+	0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa0, 0xfd, 0xe1, 0x20, 0x9f, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -739,6 +1161,14 @@ type QueryClient interface {
 	QueryUndelegationsByHeight(ctx context.Context, in *UndelegationsByHeightReq, opts ...grpc.CallOption) (*UndelegationRecordList, error)
 	// QueryAssociatedOperatorByStaker queries the associated operator for the specified staker
 	QueryAssociatedOperatorByStaker(ctx context.Context, in *QueryAssociatedOperatorByStakerReq, opts ...grpc.CallOption) (*QueryAssociatedOperatorByStakerResponse, error)
+	// QueryDelegationsByOperator queries the delegations to the operator, optionally filtered by
+	// the asset.
+	QueryDelegationsByOperator(ctx context.Context, in *QueryDelegationsByOperatorReq, opts ...grpc.CallOption) (*QueryDelegationsByOperatorResponse, error)
+	// QueryStakersByAsset queries the stakers delegating the asset to any operator.
+	QueryStakersByAsset(ctx context.Context, in *QueryStakersByAssetReq, opts ...grpc.CallOption) (*QueryStakersByAssetResponse, error)
+	// QueryNSTSlashRecords queries the execution records of the native token balance drops of
+	// the staker.
+	QueryNSTSlashRecords(ctx context.Context, in *QueryNSTSlashRecordsReq, opts ...grpc.CallOption) (*QueryNSTSlashRecordsResponse, error)
 }
 
 type queryClient struct {
@@ -803,23 +1233,58 @@ func (c *queryClient) QueryAssociatedOperatorByStaker(ctx context.Context, in *Q
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// DelegationInfo queries the delegation information for {stakerID, assetID}.
-	QueryDelegationInfo(context.Context, *DelegationInfoReq) (*QueryDelegationInfoResponse, error)
-	// SingleDelegationInfo queries the single delegation information for
-	// {chain, staker, asset, operator}.
-	QuerySingleDelegationInfo(context.Context, *SingleDelegationInfoReq) (*DelegationAmounts, error)
-	// QueryUndelegationHoldCount queries the undelegation hold count.
-	QueryUndelegationHoldCount(context.Context, *UndelegationHoldCountReq) (*UndelegationHoldCountResponse, error)
-	// QueryUndelegations queries all undelegations for
-	// {staker, asset}.
-	QueryUndelegations(context.Context, *UndelegationsReq) (*UndelegationRecordList, error)
-	// QueryUndelegationsByHeight queries all undelegations waiting to be completed by
-	// {height}.
-	QueryUndelegationsByHeight(context.Context, *UndelegationsByHeightReq) (*UndelegationRecordList, error)
-	// QueryAssociatedOperatorByStaker queries the associated operator for the specified staker
+func (c *queryClient) QueryDelegationsByOperator(ctx context.Context, in *QueryDelegationsByOperatorReq, opts ...grpc.CallOption) (*QueryDelegationsByOperatorResponse, error) {
+	out := new(QueryDelegationsByOperatorResponse)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Query/QueryDelegationsByOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryStakersByAsset(ctx context.Context, in *QueryStakersByAssetReq, opts ...grpc.CallOption) (*QueryStakersByAssetResponse, error) {
+	out := new(QueryStakersByAssetResponse)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Query/QueryStakersByAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryNSTSlashRecords(ctx context.Context, in *QueryNSTSlashRecordsReq, opts ...grpc.CallOption) (*QueryNSTSlashRecordsResponse, error) {
+	out := new(QueryNSTSlashRecordsResponse)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Query/QueryNSTSlashRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// DelegationInfo queries the delegation information for {stakerID, assetID}.
+	QueryDelegationInfo(context.Context, *DelegationInfoReq) (*QueryDelegationInfoResponse, error)
+	// SingleDelegationInfo queries the single delegation information for
+	// {chain, staker, asset, operator}.
+	QuerySingleDelegationInfo(context.Context, *SingleDelegationInfoReq) (*DelegationAmounts, error)
+	// QueryUndelegationHoldCount queries the undelegation hold count.
+	QueryUndelegationHoldCount(context.Context, *UndelegationHoldCountReq) (*UndelegationHoldCountResponse, error)
+	// QueryUndelegations queries all undelegations for
+	// {staker, asset}.
+	QueryUndelegations(context.Context, *UndelegationsReq) (*UndelegationRecordList, error)
+	// QueryUndelegationsByHeight queries all undelegations waiting to be completed by
+	// {height}.
+	QueryUndelegationsByHeight(context.Context, *UndelegationsByHeightReq) (*UndelegationRecordList, error)
+	// QueryAssociatedOperatorByStaker queries the associated operator for the specified staker
 	QueryAssociatedOperatorByStaker(context.Context, *QueryAssociatedOperatorByStakerReq) (*QueryAssociatedOperatorByStakerResponse, error)
+	// QueryDelegationsByOperator queries the delegations to the operator, optionally filtered by
+	// the asset.
+	QueryDelegationsByOperator(context.Context, *QueryDelegationsByOperatorReq) (*QueryDelegationsByOperatorResponse, error)
+	// QueryStakersByAsset queries the stakers delegating the asset to any operator.
+	QueryStakersByAsset(context.Context, *QueryStakersByAssetReq) (*QueryStakersByAssetResponse, error)
+	// QueryNSTSlashRecords queries the execution records of the native token balance drops of
+	// the staker.
+	QueryNSTSlashRecords(context.Context, *QueryNSTSlashRecordsReq) (*QueryNSTSlashRecordsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -844,6 +1309,15 @@ func (*UnimplementedQueryServer) QueryUndelegationsByHeight(ctx context.Context,
 func (*UnimplementedQueryServer) QueryAssociatedOperatorByStaker(ctx context.Context, req *QueryAssociatedOperatorByStakerReq) (*QueryAssociatedOperatorByStakerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryAssociatedOperatorByStaker not implemented")
 }
+func (*UnimplementedQueryServer) QueryDelegationsByOperator(ctx context.Context, req *QueryDelegationsByOperatorReq) (*QueryDelegationsByOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDelegationsByOperator not implemented")
+}
+func (*UnimplementedQueryServer) QueryStakersByAsset(ctx context.Context, req *QueryStakersByAssetReq) (*QueryStakersByAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryStakersByAsset not implemented")
+}
+func (*UnimplementedQueryServer) QueryNSTSlashRecords(ctx context.Context, req *QueryNSTSlashRecordsReq) (*QueryNSTSlashRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryNSTSlashRecords not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -957,6 +1431,60 @@ func _Query_QueryAssociatedOperatorByStaker_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueryDelegationsByOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationsByOperatorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDelegationsByOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Query/QueryDelegationsByOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDelegationsByOperator(ctx, req.(*QueryDelegationsByOperatorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryStakersByAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakersByAssetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryStakersByAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Query/QueryStakersByAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryStakersByAsset(ctx, req.(*QueryStakersByAssetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryNSTSlashRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNSTSlashRecordsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryNSTSlashRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Query/QueryNSTSlashRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryNSTSlashRecords(ctx, req.(*QueryNSTSlashRecordsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "exocore.delegation.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -985,6 +1513,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryAssociatedOperatorByStaker",
 			Handler:    _Query_QueryAssociatedOperatorByStaker_Handler,
 		},
+		{
+			MethodName: "QueryDelegationsByOperator",
+			Handler:    _Query_QueryDelegationsByOperator_Handler,
+		},
+		{
+			MethodName: "QueryStakersByAsset",
+			Handler:    _Query_QueryStakersByAsset_Handler,
+		},
+		{
+			MethodName: "QueryNSTSlashRecords",
+			Handler:    _Query_QueryNSTSlashRecords_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "exocore/delegation/v1/query.proto",
@@ -1415,138 +1955,330 @@ func (m *QueryAssociatedOperatorByStakerResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryDelegationsByOperatorReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *DelegationInfoReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.StakerID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.AssetID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryDelegationsByOperatorReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StakerList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDelegationsByOperatorReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Stakers) > 0 {
-		for _, s := range m.Stakers {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegationAmounts) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelegationByOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.UndelegatableShare.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.WaitUndelegationAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDelegationInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.DelegationInfos) > 0 {
-		for k, v := range m.DelegationInfos {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovQuery(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
-		}
-	}
-	return n
+func (m *DelegationByOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SingleDelegationInfoReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DelegationByOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.StakerID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.OperatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.AssetID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UndelegationHoldCountReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RecordKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryDelegationsByOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UndelegationHoldCountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.HoldCount != 0 {
-		n += 1 + sovQuery(uint64(m.HoldCount))
-	}
-	return n
+func (m *QueryDelegationsByOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UndelegationsReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDelegationsByOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.StakerID)
-	if l > 0 {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakersByAssetReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakersByAssetReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakersByAssetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakersByAssetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakersByAssetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakersByAssetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stakers) > 0 {
+		for iNdEx := len(m.Stakers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Stakers[iNdEx])
+			copy(dAtA[i:], m.Stakers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Stakers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNSTSlashRecordsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNSTSlashRecordsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNSTSlashRecordsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNSTSlashRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNSTSlashRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNSTSlashRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DelegationInfoReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	l = len(m.AssetID)
@@ -1556,34 +2288,57 @@ func (m *UndelegationsReq) Size() (n int) {
 	return n
 }
 
-func (m *UndelegationsByHeightReq) Size() (n int) {
+func (m *StakerList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
+	if len(m.Stakers) > 0 {
+		for _, s := range m.Stakers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *UndelegationRecordList) Size() (n int) {
+func (m *DelegationAmounts) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Undelegations) > 0 {
-		for _, e := range m.Undelegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	l = m.UndelegatableShare.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.WaitUndelegationAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelegationInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DelegationInfos) > 0 {
+		for k, v := range m.DelegationInfos {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovQuery(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
 		}
 	}
 	return n
 }
 
-func (m *QueryAssociatedOperatorByStakerReq) Size() (n int) {
+func (m *SingleDelegationInfoReq) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1593,29 +2348,1219 @@ func (m *QueryAssociatedOperatorByStakerReq) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryAssociatedOperatorByStakerResponse) Size() (n int) {
+func (m *UndelegationHoldCountReq) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Operator)
+	l = len(m.RecordKey)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *UndelegationHoldCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HoldCount != 0 {
+		n += 1 + sovQuery(uint64(m.HoldCount))
+	}
+	return n
 }
-func (m *DelegationInfoReq) Unmarshal(dAtA []byte) error {
+
+func (m *UndelegationsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UndelegationsByHeightReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *UndelegationRecordList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Undelegations) > 0 {
+		for _, e := range m.Undelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAssociatedOperatorByStakerReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAssociatedOperatorByStakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationsByOperatorReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegationByOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Delegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelegationsByOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakersByAssetReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakersByAssetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stakers) > 0 {
+		for _, s := range m.Stakers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNSTSlashRecordsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNSTSlashRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DelegationInfoReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationInfoReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationInfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakerList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakerList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakerList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stakers = append(m.Stakers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationAmounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationAmounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationAmounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UndelegatableShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UndelegatableShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitUndelegationAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WaitUndelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DelegationInfos == nil {
+				m.DelegationInfos = make(map[string]*DelegationAmounts)
+			}
+			var mapkey string
+			var mapvalue *DelegationAmounts
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &DelegationAmounts{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.DelegationInfos[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SingleDelegationInfoReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SingleDelegationInfoReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SingleDelegationInfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UndelegationHoldCountReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UndelegationHoldCountReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UndelegationHoldCountReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UndelegationHoldCountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UndelegationHoldCountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UndelegationHoldCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldCount", wireType)
+			}
+			m.HoldCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HoldCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UndelegationsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UndelegationsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UndelegationsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UndelegationsByHeightReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UndelegationsByHeightReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UndelegationsByHeightReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UndelegationRecordList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1638,17 +3583,17 @@ func (m *DelegationInfoReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationInfoReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: UndelegationRecordList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationInfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UndelegationRecordList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Undelegations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1658,27 +3603,79 @@ func (m *DelegationInfoReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakerID = string(dAtA[iNdEx:postIndex])
+			m.Undelegations = append(m.Undelegations, &UndelegationRecord{})
+			if err := m.Undelegations[len(m.Undelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssociatedOperatorByStakerReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssociatedOperatorByStakerReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssociatedOperatorByStakerReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1706,7 +3703,7 @@ func (m *DelegationInfoReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetID = string(dAtA[iNdEx:postIndex])
+			m.StakerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1729,7 +3726,7 @@ func (m *DelegationInfoReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StakerList) Unmarshal(dAtA []byte) error {
+func (m *QueryAssociatedOperatorByStakerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1752,15 +3749,15 @@ func (m *StakerList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StakerList: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAssociatedOperatorByStakerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StakerList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAssociatedOperatorByStakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stakers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1788,7 +3785,7 @@ func (m *StakerList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stakers = append(m.Stakers, string(dAtA[iNdEx:postIndex]))
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1811,7 +3808,7 @@ func (m *StakerList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationAmounts) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationsByOperatorReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1834,15 +3831,15 @@ func (m *DelegationAmounts) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationAmounts: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationsByOperatorReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationAmounts: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationsByOperatorReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UndelegatableShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1870,13 +3867,11 @@ func (m *DelegationAmounts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UndelegatableShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WaitUndelegationAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1904,63 +3899,11 @@ func (m *DelegationAmounts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WaitUndelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDelegationInfoResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationInfoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1984,108 +3927,15 @@ func (m *QueryDelegationInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DelegationInfos == nil {
-				m.DelegationInfos = make(map[string]*DelegationAmounts)
-			}
-			var mapkey string
-			var mapvalue *DelegationAmounts
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &DelegationAmounts{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.DelegationInfos[mapkey] = mapvalue
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2108,7 +3958,7 @@ func (m *QueryDelegationInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SingleDelegationInfoReq) Unmarshal(dAtA []byte) error {
+func (m *DelegationByOperator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2131,10 +3981,10 @@ func (m *SingleDelegationInfoReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SingleDelegationInfoReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationByOperator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SingleDelegationInfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationByOperator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2171,7 +4021,7 @@ func (m *SingleDelegationInfoReq) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2199,13 +4049,13 @@ func (m *SingleDelegationInfoReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			m.AssetID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2215,23 +4065,24 @@ func (m *SingleDelegationInfoReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetID = string(dAtA[iNdEx:postIndex])
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2254,7 +4105,7 @@ func (m *SingleDelegationInfoReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UndelegationHoldCountReq) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationsByOperatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2277,17 +4128,17 @@ func (m *UndelegationHoldCountReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UndelegationHoldCountReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationsByOperatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UndelegationHoldCountReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationsByOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2297,79 +4148,31 @@ func (m *UndelegationHoldCountReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Delegations = append(m.Delegations, DelegationByOperator{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UndelegationHoldCountResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UndelegationHoldCountResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UndelegationHoldCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HoldCount", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.HoldCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2379,11 +4182,28 @@ func (m *UndelegationHoldCountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HoldCount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2405,7 +4225,7 @@ func (m *UndelegationHoldCountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UndelegationsReq) Unmarshal(dAtA []byte) error {
+func (m *QueryStakersByAssetReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2428,15 +4248,15 @@ func (m *UndelegationsReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UndelegationsReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakersByAssetReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UndelegationsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakersByAssetReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2464,13 +4284,13 @@ func (m *UndelegationsReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakerID = string(dAtA[iNdEx:postIndex])
+			m.AssetID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2480,23 +4300,27 @@ func (m *UndelegationsReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetID = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2519,7 +4343,7 @@ func (m *UndelegationsReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UndelegationsByHeightReq) Unmarshal(dAtA []byte) error {
+func (m *QueryStakersByAssetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2542,17 +4366,17 @@ func (m *UndelegationsByHeightReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UndelegationsByHeightReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakersByAssetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UndelegationsByHeightReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakersByAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakers", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2562,64 +4386,27 @@ func (m *UndelegationsByHeightReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UndelegationRecordList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UndelegationRecordList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UndelegationRecordList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Stakers = append(m.Stakers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Undelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2646,8 +4433,10 @@ func (m *UndelegationRecordList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Undelegations = append(m.Undelegations, &UndelegationRecord{})
-			if err := m.Undelegations[len(m.Undelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2672,7 +4461,7 @@ func (m *UndelegationRecordList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAssociatedOperatorByStakerReq) Unmarshal(dAtA []byte) error {
+func (m *QueryNSTSlashRecordsReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2695,10 +4484,10 @@ func (m *QueryAssociatedOperatorByStakerReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssociatedOperatorByStakerReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNSTSlashRecordsReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssociatedOperatorByStakerReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNSTSlashRecordsReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2733,6 +4522,38 @@ func (m *QueryAssociatedOperatorByStakerReq) Unmarshal(dAtA []byte) error {
 			}
 			m.StakerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2754,7 +4575,7 @@ func (m *QueryAssociatedOperatorByStakerReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAssociatedOperatorByStakerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNSTSlashRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2777,17 +4598,17 @@ func (m *QueryAssociatedOperatorByStakerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssociatedOperatorByStakerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNSTSlashRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssociatedOperatorByStakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNSTSlashRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2797,23 +4618,25 @@ func (m *QueryAssociatedOperatorByStakerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Records = append(m.Records, NSTSlashRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
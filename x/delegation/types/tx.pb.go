@@ -570,842 +570,3680 @@ func (m *UndelegationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UndelegationResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*ValueField)(nil), "exocore.delegation.v1.ValueField")
-	proto.RegisterType((*DelegatedSingleAssetInfo)(nil), "exocore.delegation.v1.DelegatedSingleAssetInfo")
-	proto.RegisterType((*KeyValue)(nil), "exocore.delegation.v1.KeyValue")
-	proto.RegisterType((*DelegationIncOrDecInfo)(nil), "exocore.delegation.v1.DelegationIncOrDecInfo")
-	proto.RegisterType((*MsgDelegation)(nil), "exocore.delegation.v1.MsgDelegation")
-	proto.RegisterType((*UndelegationRecord)(nil), "exocore.delegation.v1.UndelegationRecord")
-	proto.RegisterType((*UndelegationRecordKeyList)(nil), "exocore.delegation.v1.UndelegationRecordKeyList")
-	proto.RegisterType((*DelegationResponse)(nil), "exocore.delegation.v1.DelegationResponse")
-	proto.RegisterType((*MsgUndelegation)(nil), "exocore.delegation.v1.MsgUndelegation")
-	proto.RegisterType((*UndelegationResponse)(nil), "exocore.delegation.v1.UndelegationResponse")
+// RedelegationRecord is the record of a redelegation from a source operator to a
+// destination operator, keyed by the source operator. It keeps the redelegated amount
+// slashable by the source operator until the record matures.
+type RedelegationRecord struct {
+	// staker_id is the staker id.
+	StakerID string `protobuf:"bytes,1,opt,name=staker_id,json=stakerId,proto3" json:"staker_id,omitempty"`
+	// asset_id is the asset id.
+	AssetID string `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// src_operator_addr is the address of the operator the shares are moved from.
+	SrcOperatorAddr string `protobuf:"bytes,3,opt,name=src_operator_addr,json=srcOperatorAddr,proto3" json:"src_operator_addr,omitempty"`
+	// dst_operator_addr is the address of the operator the shares are moved to.
+	DstOperatorAddr string `protobuf:"bytes,4,opt,name=dst_operator_addr,json=dstOperatorAddr,proto3" json:"dst_operator_addr,omitempty"`
+	// tx_hash is the transaction hash.
+	TxHash string `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// block_number is the block number on Exocore at which the redelegation is made.
+	BlockNumber uint64 `protobuf:"varint,6,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// complete_block_number is the block number on Exocore after which the redelegated
+	// amount can't be slashed by the source operator anymore.
+	CompleteBlockNumber uint64 `protobuf:"varint,7,opt,name=complete_block_number,json=completeBlockNumber,proto3" json:"complete_block_number,omitempty"`
+	// lz_tx_nonce is the nonce of the transaction.
+	LzTxNonce uint64 `protobuf:"varint,8,opt,name=lz_tx_nonce,json=lzTxNonce,proto3" json:"lz_tx_nonce,omitempty"`
+	// initial_amount is the amount of the asset moved from the source operator.
+	InitialAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=initial_amount,json=initialAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_amount"`
+	// slashable_amount is the remaining amount that can be slashed by the source operator.
+	// it may be lower than the initial amount in the case of slashing.
+	SlashableAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=slashable_amount,json=slashableAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"slashable_amount"`
 }
 
-func init() { proto.RegisterFile("exocore/delegation/v1/tx.proto", fileDescriptor_16596a15a828f109) }
-
-var fileDescriptor_16596a15a828f109 = []byte{
-	// 866 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x8f, 0x9b, 0xb6, 0x49, 0x5e, 0xba, 0x02, 0x86, 0x74, 0xeb, 0x06, 0x91, 0x64, 0x2d, 0xa8,
-	0xb2, 0x45, 0x8d, 0xb5, 0x05, 0x81, 0xc4, 0x9f, 0x43, 0x43, 0x58, 0x11, 0x96, 0x76, 0x91, 0xdb,
-	0x05, 0x89, 0x8b, 0xe5, 0x78, 0xa6, 0xae, 0x89, 0x3d, 0x63, 0xcd, 0x4c, 0x4a, 0xb2, 0x27, 0x04,
-	0x42, 0x42, 0x9c, 0xb8, 0x73, 0x59, 0xbe, 0x41, 0x0f, 0xfb, 0x21, 0xf6, 0xb8, 0xda, 0x13, 0xe2,
-	0x50, 0xa1, 0xf4, 0x50, 0x0e, 0x9c, 0xf8, 0x04, 0xc8, 0x1e, 0xbb, 0x71, 0x45, 0xc3, 0x22, 0xb1,
-	0xd2, 0x5e, 0x92, 0x99, 0xf7, 0xfb, 0xcd, 0x7b, 0xef, 0xf7, 0xe6, 0xf9, 0x0d, 0x34, 0xc8, 0x98,
-	0xb9, 0x8c, 0x13, 0x13, 0x93, 0x80, 0x78, 0x8e, 0xf4, 0x19, 0x35, 0x8f, 0x6f, 0x99, 0x72, 0xdc,
-	0x89, 0x38, 0x93, 0x0c, 0xad, 0xa6, 0x78, 0x67, 0x86, 0x77, 0x8e, 0x6f, 0xd5, 0x5f, 0x72, 0x42,
-	0x9f, 0x32, 0x33, 0xf9, 0x55, 0xcc, 0xfa, 0x9a, 0xcb, 0x44, 0xc8, 0x84, 0x19, 0x0a, 0x2f, 0xf6,
-	0x10, 0x0a, 0x2f, 0x05, 0xd6, 0x15, 0x60, 0x27, 0x3b, 0x53, 0x6d, 0x52, 0xa8, 0xe6, 0x31, 0x8f,
-	0x29, 0x7b, 0xbc, 0x52, 0x56, 0x63, 0x00, 0xf0, 0xb9, 0x13, 0x8c, 0xc8, 0x6d, 0x9f, 0x04, 0x18,
-	0x1d, 0xc0, 0xb2, 0x13, 0xb2, 0x11, 0x95, 0xba, 0xd6, 0xd2, 0xda, 0x95, 0xee, 0xfb, 0x8f, 0x4e,
-	0x9b, 0x85, 0xdf, 0x4e, 0x9b, 0x1b, 0x9e, 0x2f, 0x8f, 0x46, 0x83, 0x8e, 0xcb, 0xc2, 0xd4, 0x69,
-	0xfa, 0xb7, 0x25, 0xf0, 0xd0, 0x94, 0x93, 0x88, 0x88, 0x4e, 0x9f, 0xca, 0x27, 0x0f, 0xb7, 0x20,
-	0x8d, 0xd9, 0xa7, 0xd2, 0x4a, 0x7d, 0x19, 0x3f, 0x6b, 0xa0, 0xf7, 0x94, 0x24, 0x82, 0xf7, 0x7d,
-	0xea, 0x05, 0x64, 0x47, 0x08, 0x22, 0xfb, 0xf4, 0x90, 0xa1, 0x0d, 0x28, 0x3b, 0xf1, 0xc6, 0xf6,
-	0x71, 0x1a, 0xb4, 0x3a, 0x3d, 0x6d, 0x96, 0x14, 0xa1, 0x67, 0x95, 0x12, 0xb0, 0x8f, 0xd1, 0x17,
-	0x50, 0x8b, 0x08, 0xb7, 0x59, 0x44, 0xb8, 0x23, 0x19, 0xb7, 0x95, 0x6f, 0xa1, 0x17, 0x5b, 0xc5,
-	0x76, 0x75, 0xbb, 0xd9, 0xb9, 0xb2, 0x76, 0x9d, 0x3b, 0x64, 0x92, 0xc8, 0xeb, 0x2e, 0xc6, 0x4a,
-	0x2c, 0x14, 0x11, 0x7e, 0x37, 0xf5, 0xb0, 0xa3, 0x1c, 0x18, 0xf7, 0xa0, 0x9c, 0xb1, 0xd0, 0x8b,
-	0x50, 0x1c, 0x92, 0x89, 0xca, 0xc3, 0x8a, 0x97, 0xe8, 0x1d, 0x58, 0x3a, 0x8e, 0x21, 0x7d, 0xa1,
-	0xa5, 0xb5, 0xab, 0xdb, 0x37, 0xe6, 0xc4, 0x99, 0xd5, 0xd0, 0x52, 0x7c, 0xe3, 0x2f, 0x0d, 0xae,
-	0xf7, 0x2e, 0x38, 0x7d, 0xea, 0xde, 0xe5, 0x3d, 0xe2, 0x26, 0x92, 0xdf, 0x83, 0x95, 0x43, 0xce,
-	0x42, 0xdb, 0xc1, 0x98, 0x13, 0x21, 0x52, 0xd9, 0xfa, 0x93, 0x87, 0x5b, 0xb5, 0xb4, 0x7a, 0x3b,
-	0x0a, 0xd9, 0x97, 0xdc, 0xa7, 0x9e, 0x55, 0x8d, 0xd9, 0xa9, 0x69, 0x6e, 0x1d, 0x16, 0xfe, 0x67,
-	0x1d, 0xde, 0xed, 0xfe, 0xf0, 0xa0, 0x59, 0xf8, 0xe3, 0x41, 0xb3, 0xf0, 0xed, 0xf9, 0xc9, 0x66,
-	0x3e, 0xe4, 0x8f, 0xe7, 0x27, 0x9b, 0xaf, 0xe7, 0xae, 0x7b, 0x57, 0x78, 0x3b, 0x18, 0x27, 0x72,
-	0x38, 0x71, 0x04, 0x99, 0xa9, 0x34, 0xbe, 0xd3, 0xe0, 0xda, 0xae, 0xf0, 0x66, 0x96, 0xff, 0x7c,
-	0xbd, 0x9f, 0x40, 0x65, 0xe0, 0x08, 0x62, 0xfb, 0xf4, 0x90, 0xa5, 0xb5, 0xde, 0x9a, 0xa3, 0xe5,
-	0xea, 0xaa, 0x5a, 0xe5, 0xf8, 0x7c, 0xbc, 0x32, 0x7e, 0x59, 0x04, 0x74, 0x8f, 0xce, 0x0e, 0x59,
-	0xc4, 0x65, 0x1c, 0xa3, 0x9b, 0x50, 0x11, 0xd2, 0x19, 0x12, 0x3e, 0xcb, 0x65, 0x65, 0x7a, 0xda,
-	0x2c, 0xef, 0x27, 0xc6, 0x7e, 0xcf, 0x2a, 0x2b, 0xb8, 0x8f, 0x2f, 0x65, 0xbd, 0xf0, 0x2f, 0x59,
-	0x7f, 0x00, 0xd7, 0x66, 0x17, 0x81, 0x31, 0xd7, 0x8b, 0x4f, 0xb9, 0xca, 0x95, 0x8c, 0x1e, 0x9b,
-	0xd1, 0x1a, 0x94, 0xe4, 0xd8, 0x3e, 0x72, 0xc4, 0x91, 0xbe, 0x98, 0xb4, 0xdc, 0xb2, 0x1c, 0x7f,
-	0xec, 0x88, 0x23, 0xf4, 0x2a, 0x80, 0x2f, 0xec, 0x88, 0x50, 0xec, 0x53, 0x4f, 0x5f, 0x6a, 0x69,
-	0xed, 0xb2, 0x55, 0xf1, 0xc5, 0x67, 0xca, 0x80, 0x6e, 0xc0, 0xca, 0x20, 0x60, 0xee, 0xd0, 0xa6,
-	0xa3, 0x70, 0x40, 0xb8, 0xbe, 0xdc, 0xd2, 0xda, 0x8b, 0x56, 0x35, 0xb1, 0xed, 0x25, 0x26, 0xb4,
-	0x0d, 0xab, 0x2e, 0x0b, 0xa3, 0x80, 0x48, 0x62, 0x5f, 0xe2, 0x96, 0x12, 0xee, 0xcb, 0x19, 0xd8,
-	0xcd, 0x9d, 0x69, 0x40, 0x35, 0xb8, 0x6f, 0xcb, 0xb1, 0x4d, 0x19, 0x75, 0x89, 0x5e, 0x4e, 0x98,
-	0x95, 0xe0, 0xfe, 0xc1, 0x78, 0x2f, 0x36, 0xe4, 0xa6, 0x43, 0xe5, 0xd9, 0x4d, 0x07, 0x24, 0x61,
-	0xcd, 0x71, 0xe5, 0xc8, 0x09, 0xec, 0x2c, 0x27, 0x9c, 0x36, 0xb5, 0x0e, 0xcf, 0x20, 0xcc, 0xaa,
-	0x72, 0xfe, 0x61, 0xe6, 0x5b, 0xb5, 0xbb, 0xf1, 0x36, 0xac, 0xff, 0xb3, 0x45, 0xee, 0x90, 0xc9,
-	0xa7, 0xbe, 0x90, 0x68, 0x1d, 0xca, 0x43, 0x32, 0xb1, 0x03, 0x5f, 0xc4, 0x83, 0xb0, 0xd8, 0xae,
-	0x58, 0xa5, 0xa1, 0x82, 0x8c, 0x1a, 0xa0, 0x5e, 0xee, 0x94, 0x88, 0x18, 0x15, 0xc4, 0xf8, 0x5e,
-	0x83, 0x17, 0x76, 0x85, 0x97, 0xf7, 0xf8, 0x5c, 0x3a, 0xff, 0x3a, 0xd4, 0x2e, 0xab, 0x52, 0xf9,
-	0x6d, 0xff, 0xa9, 0x41, 0x71, 0x57, 0x78, 0xe8, 0x2b, 0x58, 0xcb, 0x06, 0x71, 0x92, 0xc7, 0x01,
-	0xcb, 0xa6, 0x00, 0x7a, 0x6d, 0x4e, 0xcc, 0x4b, 0x9f, 0x73, 0xfd, 0xe6, 0x53, 0x33, 0xcb, 0x62,
-	0x22, 0x0e, 0xaf, 0x5c, 0xe4, 0xa2, 0xa2, 0xdd, 0xe6, 0x2c, 0xbc, 0x88, 0xb7, 0x31, 0x3f, 0x5e,
-	0x5e, 0x42, 0xfd, 0x8d, 0x39, 0xbc, 0xab, 0x74, 0xd6, 0x97, 0xbe, 0x39, 0x3f, 0xd9, 0xd4, 0xba,
-	0x7b, 0x8f, 0xa6, 0x0d, 0xed, 0xf1, 0xb4, 0xa1, 0xfd, 0x3e, 0x6d, 0x68, 0x3f, 0x9d, 0x35, 0x0a,
-	0x8f, 0xcf, 0x1a, 0x85, 0x5f, 0xcf, 0x1a, 0x85, 0x2f, 0xdf, 0xca, 0xf5, 0xd0, 0x47, 0xca, 0xef,
-	0x1e, 0x91, 0x5f, 0x33, 0x3e, 0x34, 0xb3, 0xc7, 0x79, 0x9c, 0x7f, 0x9e, 0x93, 0xae, 0x1a, 0x2c,
-	0x27, 0x6f, 0xe5, 0x9b, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x32, 0x66, 0x81, 0xc1, 0x07,
-	0x00, 0x00,
+func (m *RedelegationRecord) Reset()         { *m = RedelegationRecord{} }
+func (m *RedelegationRecord) String() string { return proto.CompactTextString(m) }
+func (*RedelegationRecord) ProtoMessage()    {}
+func (*RedelegationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16596a15a828f109, []int{10}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// DelegateAssetToOperator delegates asset to operator.
-	DelegateAssetToOperator(ctx context.Context, in *MsgDelegation, opts ...grpc.CallOption) (*DelegationResponse, error)
-	// UndelegateAssetFromOperator undelegates asset from operator.
-	UndelegateAssetFromOperator(ctx context.Context, in *MsgUndelegation, opts ...grpc.CallOption) (*UndelegationResponse, error)
+func (m *RedelegationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *RedelegationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegationRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *RedelegationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationRecord.Merge(m, src)
+}
+func (m *RedelegationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationRecord.DiscardUnknown(m)
 }
 
-func (c *msgClient) DelegateAssetToOperator(ctx context.Context, in *MsgDelegation, opts ...grpc.CallOption) (*DelegationResponse, error) {
-	out := new(DelegationResponse)
-	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Msg/DelegateAssetToOperator", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RedelegationRecord proto.InternalMessageInfo
+
+func (m *RedelegationRecord) GetStakerID() string {
+	if m != nil {
+		return m.StakerID
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UndelegateAssetFromOperator(ctx context.Context, in *MsgUndelegation, opts ...grpc.CallOption) (*UndelegationResponse, error) {
-	out := new(UndelegationResponse)
-	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Msg/UndelegateAssetFromOperator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RedelegationRecord) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// DelegateAssetToOperator delegates asset to operator.
-	DelegateAssetToOperator(context.Context, *MsgDelegation) (*DelegationResponse, error)
-	// UndelegateAssetFromOperator undelegates asset from operator.
-	UndelegateAssetFromOperator(context.Context, *MsgUndelegation) (*UndelegationResponse, error)
+func (m *RedelegationRecord) GetSrcOperatorAddr() string {
+	if m != nil {
+		return m.SrcOperatorAddr
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *RedelegationRecord) GetDstOperatorAddr() string {
+	if m != nil {
+		return m.DstOperatorAddr
+	}
+	return ""
 }
 
-func (*UnimplementedMsgServer) DelegateAssetToOperator(ctx context.Context, req *MsgDelegation) (*DelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegateAssetToOperator not implemented")
-}
-func (*UnimplementedMsgServer) UndelegateAssetFromOperator(ctx context.Context, req *MsgUndelegation) (*UndelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UndelegateAssetFromOperator not implemented")
+func (m *RedelegationRecord) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *RedelegationRecord) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
 }
 
-func _Msg_DelegateAssetToOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDelegation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DelegateAssetToOperator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/exocore.delegation.v1.Msg/DelegateAssetToOperator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DelegateAssetToOperator(ctx, req.(*MsgDelegation))
+func (m *RedelegationRecord) GetCompleteBlockNumber() uint64 {
+	if m != nil {
+		return m.CompleteBlockNumber
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UndelegateAssetFromOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUndelegation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UndelegateAssetFromOperator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/exocore.delegation.v1.Msg/UndelegateAssetFromOperator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UndelegateAssetFromOperator(ctx, req.(*MsgUndelegation))
+func (m *RedelegationRecord) GetLzTxNonce() uint64 {
+	if m != nil {
+		return m.LzTxNonce
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "exocore.delegation.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "DelegateAssetToOperator",
-			Handler:    _Msg_DelegateAssetToOperator_Handler,
-		},
-		{
-			MethodName: "UndelegateAssetFromOperator",
-			Handler:    _Msg_UndelegateAssetFromOperator_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "exocore/delegation/v1/tx.proto",
+// NSTSlashFromUndelegation records the amount slashed from a pending undelegation when the
+// balance of a native restaking token drops.
+type NSTSlashFromUndelegation struct {
+	// record_key is the key of the undelegation record.
+	RecordKey string `protobuf:"bytes,1,opt,name=record_key,json=recordKey,proto3" json:"record_key,omitempty"`
+	// operator_addr is the operator of the undelegation.
+	OperatorAddr string `protobuf:"bytes,2,opt,name=operator_addr,json=operatorAddr,proto3" json:"operator_addr,omitempty"`
+	// amount is the amount slashed from the undelegation.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func (m *ValueField) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *NSTSlashFromUndelegation) Reset()         { *m = NSTSlashFromUndelegation{} }
+func (m *NSTSlashFromUndelegation) String() string { return proto.CompactTextString(m) }
+func (*NSTSlashFromUndelegation) ProtoMessage()    {}
+func (*NSTSlashFromUndelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16596a15a828f109, []int{11}
+}
+func (m *NSTSlashFromUndelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NSTSlashFromUndelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NSTSlashFromUndelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *ValueField) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *NSTSlashFromUndelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NSTSlashFromUndelegation.Merge(m, src)
+}
+func (m *NSTSlashFromUndelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *NSTSlashFromUndelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_NSTSlashFromUndelegation.DiscardUnknown(m)
 }
 
-func (m *ValueField) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+var xxx_messageInfo_NSTSlashFromUndelegation proto.InternalMessageInfo
+
+func (m *NSTSlashFromUndelegation) GetRecordKey() string {
+	if m != nil {
+		return m.RecordKey
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *DelegatedSingleAssetInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *NSTSlashFromUndelegation) GetOperatorAddr() string {
+	if m != nil {
+		return m.OperatorAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DelegatedSingleAssetInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// NSTSlashFromDelegation records the share and the amount slashed from the delegation to an
+// operator when the balance of a native restaking token drops.
+type NSTSlashFromDelegation struct {
+	// operator_addr is the operator the native token is delegated to.
+	OperatorAddr string `protobuf:"bytes,1,opt,name=operator_addr,json=operatorAddr,proto3" json:"operator_addr,omitempty"`
+	// share is the share removed from the delegation.
+	Share github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=share,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"share"`
+	// amount is the amount removed from the operator's assets pool.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func (m *DelegatedSingleAssetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PerOperatorAmounts) > 0 {
-		for iNdEx := len(m.PerOperatorAmounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PerOperatorAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *NSTSlashFromDelegation) Reset()         { *m = NSTSlashFromDelegation{} }
+func (m *NSTSlashFromDelegation) String() string { return proto.CompactTextString(m) }
+func (*NSTSlashFromDelegation) ProtoMessage()    {}
+func (*NSTSlashFromDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16596a15a828f109, []int{12}
+}
+func (m *NSTSlashFromDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NSTSlashFromDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NSTSlashFromDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.AssetID) > 0 {
-		i -= len(m.AssetID)
-		copy(dAtA[i:], m.AssetID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *NSTSlashFromDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NSTSlashFromDelegation.Merge(m, src)
+}
+func (m *NSTSlashFromDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *NSTSlashFromDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_NSTSlashFromDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NSTSlashFromDelegation proto.InternalMessageInfo
+
+func (m *NSTSlashFromDelegation) GetOperatorAddr() string {
+	if m != nil {
+		return m.OperatorAddr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *KeyValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// NSTSlashRecord is the execution record of a balance drop of a native restaking token, which
+// is slashed from the withdrawable amount first, the pending undelegations second, and the
+// delegated shares last.
+type NSTSlashRecord struct {
+	// staker_id is the staker id.
+	StakerID string `protobuf:"bytes,1,opt,name=staker_id,json=stakerId,proto3" json:"staker_id,omitempty"`
+	// asset_id is the asset id.
+	AssetID string `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// height is the block height at which the balance drop is executed.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// index is the index of the record among the records of the same staker, asset and height.
+	Index uint64 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	// slash_amount is the amount of the balance drop.
+	SlashAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=slash_amount,json=slashAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"slash_amount"`
+	// slash_from_withdrawable is the amount slashed from the withdrawable amount.
+	SlashFromWithdrawable github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=slash_from_withdrawable,json=slashFromWithdrawable,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"slash_from_withdrawable"`
+	// slash_undelegations records the amounts slashed from the pending undelegations.
+	SlashUndelegations []NSTSlashFromUndelegation `protobuf:"bytes,7,rep,name=slash_undelegations,json=slashUndelegations,proto3" json:"slash_undelegations"`
+	// slash_delegations records the amounts slashed from the delegated shares.
+	SlashDelegations []NSTSlashFromDelegation `protobuf:"bytes,8,rep,name=slash_delegations,json=slashDelegations,proto3" json:"slash_delegations"`
+	// unslashed_amount is the remaining amount which can't be slashed, because all staking
+	// funds of the staker have been slashed.
+	UnslashedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=unslashed_amount,json=unslashedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"unslashed_amount"`
 }
 
-func (m *KeyValue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *NSTSlashRecord) Reset()         { *m = NSTSlashRecord{} }
+func (m *NSTSlashRecord) String() string { return proto.CompactTextString(m) }
+func (*NSTSlashRecord) ProtoMessage()    {}
+func (*NSTSlashRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16596a15a828f109, []int{13}
 }
-
-func (m *KeyValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != nil {
-		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *NSTSlashRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NSTSlashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NSTSlashRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *NSTSlashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NSTSlashRecord.Merge(m, src)
+}
+func (m *NSTSlashRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *NSTSlashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_NSTSlashRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NSTSlashRecord proto.InternalMessageInfo
+
+func (m *NSTSlashRecord) GetStakerID() string {
+	if m != nil {
+		return m.StakerID
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *DelegationIncOrDecInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *NSTSlashRecord) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DelegationIncOrDecInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *NSTSlashRecord) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *DelegationIncOrDecInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PerOperatorAmounts) > 0 {
-		for iNdEx := len(m.PerOperatorAmounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PerOperatorAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *NSTSlashRecord) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *NSTSlashRecord) GetSlashUndelegations() []NSTSlashFromUndelegation {
+	if m != nil {
+		return m.SlashUndelegations
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgDelegation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *NSTSlashRecord) GetSlashDelegations() []NSTSlashFromDelegation {
+	if m != nil {
+		return m.SlashDelegations
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgDelegation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRedelegate is the Msg to move the delegated asset from one operator to another.
+type MsgRedelegate struct {
+	// asset_id is the identity of the asset.
+	AssetID string `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// from_address is the staker address
+	FromAddress string `protobuf:"bytes,2,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// src_operator_addr is the address of the operator the asset is moved from.
+	SrcOperatorAddr string `protobuf:"bytes,3,opt,name=src_operator_addr,json=srcOperatorAddr,proto3" json:"src_operator_addr,omitempty"`
+	// dst_operator_addr is the address of the operator the asset is moved to.
+	DstOperatorAddr string `protobuf:"bytes,4,opt,name=dst_operator_addr,json=dstOperatorAddr,proto3" json:"dst_operator_addr,omitempty"`
+	// amount is the amount of the asset to be redelegated.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func (m *MsgDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BaseInfo != nil {
-		{
-			size, err := m.BaseInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgRedelegate) Reset()         { *m = MsgRedelegate{} }
+func (m *MsgRedelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgRedelegate) ProtoMessage()    {}
+func (*MsgRedelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16596a15a828f109, []int{14}
+}
+func (m *MsgRedelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.AssetID) > 0 {
-		i -= len(m.AssetID)
-		copy(dAtA[i:], m.AssetID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRedelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedelegate.Merge(m, src)
+}
+func (m *MsgRedelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedelegate proto.InternalMessageInfo
+
+func (m *MsgRedelegate) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *UndelegationRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRedelegate) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UndelegationRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRedelegate) GetSrcOperatorAddr() string {
+	if m != nil {
+		return m.SrcOperatorAddr
+	}
+	return ""
 }
 
-func (m *UndelegationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ActualCompletedAmount.Size()
-		i -= size
-		if _, err := m.ActualCompletedAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgRedelegate) GetDstOperatorAddr() string {
+	if m != nil {
+		return m.DstOperatorAddr
+	}
+	return ""
+}
+
+// RedelegateResponse is the response to a redelegation request.
+type RedelegateResponse struct {
+}
+
+func (m *RedelegateResponse) Reset()         { *m = RedelegateResponse{} }
+func (m *RedelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*RedelegateResponse) ProtoMessage()    {}
+func (*RedelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16596a15a828f109, []int{15}
+}
+func (m *RedelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *RedelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegateResponse.Merge(m, src)
+}
+func (m *RedelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegateResponse proto.InternalMessageInfo
+
+// MsgCancelUndelegation is the Msg to cancel an immature undelegation, which delegates
+// the remaining amount of the undelegation back to the original operator.
+type MsgCancelUndelegation struct {
+	// from_address is the staker address
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// record_key is the key of the undelegation record to be canceled.
+	RecordKey string `protobuf:"bytes,2,opt,name=record_key,json=recordKey,proto3" json:"record_key,omitempty"`
+}
+
+func (m *MsgCancelUndelegation) Reset()         { *m = MsgCancelUndelegation{} }
+func (m *MsgCancelUndelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUndelegation) ProtoMessage()    {}
+func (*MsgCancelUndelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16596a15a828f109, []int{16}
+}
+func (m *MsgCancelUndelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUndelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUndelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x4a
-	if m.LzTxNonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LzTxNonce))
-		i--
-		dAtA[i] = 0x40
+}
+func (m *MsgCancelUndelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUndelegation.Merge(m, src)
+}
+func (m *MsgCancelUndelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUndelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUndelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelUndelegation proto.InternalMessageInfo
+
+func (m *MsgCancelUndelegation) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
 	}
-	if m.CompleteBlockNumber != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CompleteBlockNumber))
-		i--
-		dAtA[i] = 0x38
+	return ""
+}
+
+func (m *MsgCancelUndelegation) GetRecordKey() string {
+	if m != nil {
+		return m.RecordKey
 	}
-	if m.BlockNumber != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.BlockNumber))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.IsPending {
-		i--
-		if m.IsPending {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.OperatorAddr) > 0 {
-		i -= len(m.OperatorAddr)
-		copy(dAtA[i:], m.OperatorAddr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.AssetID) > 0 {
-		i -= len(m.AssetID)
-		copy(dAtA[i:], m.AssetID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.StakerID) > 0 {
-		i -= len(m.StakerID)
-		copy(dAtA[i:], m.StakerID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerID)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *UndelegationRecordKeyList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// CancelUndelegationResponse is the response to an undelegation cancellation request.
+type CancelUndelegationResponse struct {
 }
 
-func (m *UndelegationRecordKeyList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CancelUndelegationResponse) Reset()         { *m = CancelUndelegationResponse{} }
+func (m *CancelUndelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelUndelegationResponse) ProtoMessage()    {}
+func (*CancelUndelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16596a15a828f109, []int{17}
 }
-
-func (m *UndelegationRecordKeyList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.KeyList) > 0 {
-		for iNdEx := len(m.KeyList) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.KeyList[iNdEx])
-			copy(dAtA[i:], m.KeyList[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.KeyList[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *CancelUndelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelUndelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelUndelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CancelUndelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelUndelegationResponse.Merge(m, src)
+}
+func (m *CancelUndelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelUndelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelUndelegationResponse.DiscardUnknown(m)
 }
 
-func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_CancelUndelegationResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ValueField)(nil), "exocore.delegation.v1.ValueField")
+	proto.RegisterType((*DelegatedSingleAssetInfo)(nil), "exocore.delegation.v1.DelegatedSingleAssetInfo")
+	proto.RegisterType((*KeyValue)(nil), "exocore.delegation.v1.KeyValue")
+	proto.RegisterType((*DelegationIncOrDecInfo)(nil), "exocore.delegation.v1.DelegationIncOrDecInfo")
+	proto.RegisterType((*MsgDelegation)(nil), "exocore.delegation.v1.MsgDelegation")
+	proto.RegisterType((*UndelegationRecord)(nil), "exocore.delegation.v1.UndelegationRecord")
+	proto.RegisterType((*UndelegationRecordKeyList)(nil), "exocore.delegation.v1.UndelegationRecordKeyList")
+	proto.RegisterType((*DelegationResponse)(nil), "exocore.delegation.v1.DelegationResponse")
+	proto.RegisterType((*MsgUndelegation)(nil), "exocore.delegation.v1.MsgUndelegation")
+	proto.RegisterType((*UndelegationResponse)(nil), "exocore.delegation.v1.UndelegationResponse")
+	proto.RegisterType((*RedelegationRecord)(nil), "exocore.delegation.v1.RedelegationRecord")
+	proto.RegisterType((*NSTSlashFromUndelegation)(nil), "exocore.delegation.v1.NSTSlashFromUndelegation")
+	proto.RegisterType((*NSTSlashFromDelegation)(nil), "exocore.delegation.v1.NSTSlashFromDelegation")
+	proto.RegisterType((*NSTSlashRecord)(nil), "exocore.delegation.v1.NSTSlashRecord")
+	proto.RegisterType((*MsgRedelegate)(nil), "exocore.delegation.v1.MsgRedelegate")
+	proto.RegisterType((*RedelegateResponse)(nil), "exocore.delegation.v1.RedelegateResponse")
+	proto.RegisterType((*MsgCancelUndelegation)(nil), "exocore.delegation.v1.MsgCancelUndelegation")
+	proto.RegisterType((*CancelUndelegationResponse)(nil), "exocore.delegation.v1.CancelUndelegationResponse")
 }
 
-func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("exocore/delegation/v1/tx.proto", fileDescriptor_16596a15a828f109) }
+
+var fileDescriptor_16596a15a828f109 = []byte{
+	// 1303 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x73, 0xdb, 0xc4,
+	0x17, 0x8f, 0x62, 0x3b, 0xb1, 0x9f, 0xd3, 0xa6, 0xd9, 0xe6, 0x87, 0xea, 0xef, 0xb7, 0x76, 0xea,
+	0x81, 0x4e, 0x5a, 0x88, 0x3d, 0x0d, 0x4c, 0x99, 0x29, 0x70, 0x48, 0x6a, 0x3a, 0x98, 0x92, 0x84,
+	0x51, 0x52, 0x3a, 0xc3, 0x45, 0xc8, 0xd2, 0x46, 0x16, 0x96, 0xb5, 0x9e, 0xdd, 0x75, 0xe3, 0xf4,
+	0xc4, 0x8f, 0x81, 0x61, 0x38, 0x71, 0xef, 0xa5, 0x9c, 0xb9, 0xf4, 0xd0, 0x3f, 0xa2, 0x27, 0xa6,
+	0xf4, 0x02, 0xc3, 0x21, 0xc3, 0xa4, 0x87, 0x72, 0xe6, 0x2f, 0x60, 0xb4, 0x2b, 0x59, 0x72, 0x1c,
+	0xb5, 0xcd, 0xd4, 0xa5, 0x5c, 0x12, 0xef, 0x7b, 0x4f, 0xef, 0xf3, 0xde, 0xdb, 0xf7, 0x4b, 0x82,
+	0x22, 0xee, 0x11, 0x93, 0x50, 0x5c, 0xb5, 0xb0, 0x8b, 0x6d, 0x83, 0x3b, 0xc4, 0xab, 0xde, 0xba,
+	0x54, 0xe5, 0xbd, 0x4a, 0x87, 0x12, 0x4e, 0xd0, 0x5c, 0xc0, 0xaf, 0x44, 0xfc, 0xca, 0xad, 0x4b,
+	0x85, 0x19, 0xa3, 0xed, 0x78, 0xa4, 0x2a, 0xfe, 0x4a, 0xc9, 0xc2, 0x82, 0x49, 0x58, 0x9b, 0xb0,
+	0x6a, 0x9b, 0xd9, 0xbe, 0x86, 0x36, 0xb3, 0x03, 0xc6, 0x19, 0xc9, 0xd0, 0xc5, 0xa9, 0x2a, 0x0f,
+	0x01, 0x6b, 0xd6, 0x26, 0x36, 0x91, 0x74, 0xff, 0x97, 0xa4, 0x96, 0x1b, 0x00, 0x9f, 0x1a, 0x6e,
+	0x17, 0x5f, 0x73, 0xb0, 0x6b, 0xa1, 0x6d, 0x98, 0x30, 0xda, 0xa4, 0xeb, 0x71, 0x55, 0x59, 0x54,
+	0x96, 0x72, 0x6b, 0xef, 0x3d, 0xd8, 0x2f, 0x8d, 0xfd, 0xb1, 0x5f, 0x3a, 0x6f, 0x3b, 0xbc, 0xd9,
+	0x6d, 0x54, 0x4c, 0xd2, 0x0e, 0x94, 0x06, 0xff, 0x96, 0x99, 0xd5, 0xaa, 0xf2, 0xbd, 0x0e, 0x66,
+	0x95, 0xba, 0xc7, 0x1f, 0xdd, 0x5f, 0x86, 0x00, 0xb3, 0xee, 0x71, 0x2d, 0xd0, 0x55, 0xbe, 0xa3,
+	0x80, 0x5a, 0x93, 0x2e, 0x61, 0x6b, 0xcb, 0xf1, 0x6c, 0x17, 0xaf, 0x32, 0x86, 0x79, 0xdd, 0xdb,
+	0x21, 0xe8, 0x3c, 0x64, 0x0d, 0xff, 0xa0, 0x3b, 0x56, 0x00, 0x9a, 0x3f, 0xd8, 0x2f, 0x4d, 0x4a,
+	0x81, 0x9a, 0x36, 0x29, 0x98, 0x75, 0x0b, 0xdd, 0x84, 0xd9, 0x0e, 0xa6, 0x3a, 0xe9, 0x60, 0x6a,
+	0x70, 0x42, 0x75, 0xa9, 0x9b, 0xa9, 0xa9, 0xc5, 0xd4, 0x52, 0x7e, 0xa5, 0x54, 0x39, 0x32, 0x76,
+	0x95, 0xeb, 0x78, 0x4f, 0xb8, 0xb7, 0x96, 0xf6, 0x3d, 0xd1, 0x50, 0x07, 0xd3, 0xcd, 0x40, 0xc3,
+	0xaa, 0x54, 0x50, 0xbe, 0x01, 0xd9, 0x50, 0x0a, 0x9d, 0x82, 0x54, 0x0b, 0xef, 0x49, 0x3b, 0x34,
+	0xff, 0x27, 0x7a, 0x07, 0x32, 0xb7, 0x7c, 0x96, 0x3a, 0xbe, 0xa8, 0x2c, 0xe5, 0x57, 0xce, 0x25,
+	0xe0, 0x44, 0x31, 0xd4, 0xa4, 0x7c, 0xf9, 0x6f, 0x05, 0xe6, 0x6b, 0x7d, 0x99, 0xba, 0x67, 0x6e,
+	0xd2, 0x1a, 0x36, 0x85, 0xcb, 0xef, 0xc2, 0xd4, 0x0e, 0x25, 0x6d, 0xdd, 0xb0, 0x2c, 0x8a, 0x19,
+	0x0b, 0xdc, 0x56, 0x1f, 0xdd, 0x5f, 0x9e, 0x0d, 0xa2, 0xb7, 0x2a, 0x39, 0x5b, 0x9c, 0x3a, 0x9e,
+	0xad, 0xe5, 0x7d, 0xe9, 0x80, 0x94, 0x18, 0x87, 0xf1, 0x17, 0x8c, 0xc3, 0x95, 0xb5, 0xef, 0xef,
+	0x96, 0xc6, 0xfe, 0xba, 0x5b, 0x1a, 0xfb, 0xfa, 0xc9, 0xbd, 0x8b, 0x71, 0xc8, 0x1f, 0x9e, 0xdc,
+	0xbb, 0xf8, 0x7a, 0xec, 0xba, 0xd7, 0x99, 0xbd, 0x6a, 0x59, 0xc2, 0x1d, 0x8a, 0x0d, 0x86, 0x23,
+	0x2f, 0xcb, 0xdf, 0x28, 0x70, 0x62, 0x9d, 0xd9, 0x11, 0xe5, 0xb9, 0xaf, 0xf7, 0x23, 0xc8, 0x35,
+	0x0c, 0x86, 0x75, 0xc7, 0xdb, 0x21, 0x41, 0xac, 0x97, 0x13, 0x7c, 0x39, 0x3a, 0xaa, 0x5a, 0xd6,
+	0x7f, 0xde, 0xff, 0x55, 0xfe, 0x29, 0x0d, 0xe8, 0x86, 0x17, 0x3d, 0xa4, 0x61, 0x93, 0x50, 0x0b,
+	0x5d, 0x80, 0x1c, 0xe3, 0x46, 0x0b, 0xd3, 0xc8, 0x96, 0xa9, 0x83, 0xfd, 0x52, 0x76, 0x4b, 0x10,
+	0xeb, 0x35, 0x2d, 0x2b, 0xd9, 0x75, 0x6b, 0xc0, 0xea, 0xf1, 0xa7, 0x58, 0xfd, 0x3e, 0x9c, 0x88,
+	0x2e, 0xc2, 0xb2, 0xa8, 0x9a, 0x7a, 0xc6, 0x55, 0x4e, 0x85, 0xe2, 0x3e, 0x19, 0x2d, 0xc0, 0x24,
+	0xef, 0xe9, 0x4d, 0x83, 0x35, 0xd5, 0xb4, 0x48, 0xb9, 0x09, 0xde, 0xfb, 0xd0, 0x60, 0x4d, 0x74,
+	0x16, 0xc0, 0x61, 0x7a, 0x07, 0x7b, 0x96, 0xe3, 0xd9, 0x6a, 0x66, 0x51, 0x59, 0xca, 0x6a, 0x39,
+	0x87, 0x7d, 0x22, 0x09, 0xe8, 0x1c, 0x4c, 0x35, 0x5c, 0x62, 0xb6, 0x74, 0xaf, 0xdb, 0x6e, 0x60,
+	0xaa, 0x4e, 0x2c, 0x2a, 0x4b, 0x69, 0x2d, 0x2f, 0x68, 0x1b, 0x82, 0x84, 0x56, 0x60, 0xce, 0x24,
+	0xed, 0x8e, 0x8b, 0x39, 0xd6, 0x07, 0x64, 0x27, 0x85, 0xec, 0xe9, 0x90, 0xb9, 0x16, 0x7b, 0xa6,
+	0x08, 0x79, 0xf7, 0xb6, 0xce, 0x7b, 0xba, 0x47, 0x3c, 0x13, 0xab, 0x59, 0x21, 0x99, 0x73, 0x6f,
+	0x6f, 0xf7, 0x36, 0x7c, 0x42, 0xac, 0x3b, 0xe4, 0x46, 0xd7, 0x1d, 0x10, 0x87, 0x05, 0xc3, 0xe4,
+	0x5d, 0xc3, 0xd5, 0x43, 0x9b, 0xac, 0x20, 0xa9, 0x55, 0x18, 0x01, 0xcc, 0x9c, 0x54, 0x7e, 0x35,
+	0xd4, 0x2d, 0xd3, 0xbd, 0x7c, 0x19, 0xce, 0x0c, 0xa7, 0xc8, 0x75, 0xbc, 0xf7, 0xb1, 0xc3, 0x38,
+	0x3a, 0x03, 0xd9, 0x16, 0xde, 0xd3, 0x5d, 0x87, 0xf9, 0x8d, 0x30, 0xb5, 0x94, 0xd3, 0x26, 0x5b,
+	0x92, 0x55, 0x9e, 0x05, 0x54, 0x8b, 0x3d, 0xc5, 0x3a, 0xc4, 0x63, 0xb8, 0xfc, 0xad, 0x02, 0xd3,
+	0xeb, 0xcc, 0x8e, 0x6b, 0x7c, 0x25, 0x99, 0x3f, 0x0f, 0xb3, 0x83, 0x5e, 0x05, 0xf6, 0xfd, 0x9a,
+	0x06, 0xa4, 0xe1, 0x7f, 0xa3, 0x22, 0x6a, 0x30, 0xc3, 0xa8, 0xa9, 0x1f, 0xaf, 0x2a, 0xa6, 0x19,
+	0x35, 0x37, 0xe3, 0x85, 0x51, 0x83, 0x19, 0x8b, 0xf1, 0x43, 0x5a, 0xd2, 0xcf, 0xd2, 0x62, 0x31,
+	0xbe, 0x99, 0x50, 0x5e, 0x99, 0x81, 0xf2, 0x7a, 0x45, 0xf5, 0x63, 0xc2, 0x49, 0xc7, 0x73, 0xb8,
+	0x63, 0xb8, 0xfa, 0x08, 0xeb, 0xe8, 0x44, 0xa0, 0x53, 0x26, 0x36, 0xb2, 0xe1, 0x14, 0x73, 0x0d,
+	0xd6, 0x34, 0x1a, 0x2e, 0x1e, 0x65, 0x1d, 0x4d, 0xf7, 0xb5, 0x06, 0x15, 0xf4, 0x8b, 0x02, 0xea,
+	0xc6, 0xd6, 0xf6, 0x96, 0x4f, 0xbe, 0x46, 0x49, 0x7b, 0x20, 0xf9, 0xcf, 0x02, 0x50, 0x91, 0x63,
+	0x7a, 0x34, 0x4f, 0x73, 0x34, 0x2c, 0xb2, 0xe1, 0xbe, 0x39, 0x7e, 0xac, 0xbe, 0x19, 0x35, 0xa2,
+	0xd4, 0x08, 0xd7, 0x94, 0xaf, 0xc6, 0x61, 0x3e, 0xee, 0x50, 0x6c, 0x8a, 0x0d, 0xd9, 0xab, 0x1c,
+	0xcb, 0x5e, 0x0d, 0x32, 0xac, 0x69, 0x50, 0x1c, 0xb8, 0x79, 0x1c, 0x73, 0x6b, 0xd8, 0x8c, 0x99,
+	0x5b, 0xc3, 0xa6, 0x26, 0x55, 0xbd, 0xa4, 0x18, 0xdc, 0xc9, 0xc0, 0xc9, 0x30, 0x06, 0x2f, 0xaf,
+	0x49, 0xcc, 0xc3, 0x44, 0x13, 0x3b, 0x76, 0x53, 0xda, 0x9e, 0xd6, 0x82, 0x13, 0x9a, 0x85, 0x8c,
+	0xe3, 0x59, 0xb8, 0x27, 0x4a, 0x3d, 0xad, 0xc9, 0x03, 0xd2, 0x61, 0x4a, 0xe4, 0x5e, 0x98, 0xcd,
+	0x99, 0x11, 0xf8, 0x9b, 0x17, 0x1a, 0x57, 0xfb, 0x13, 0x48, 0x02, 0x88, 0xad, 0x6c, 0xd7, 0xe1,
+	0x4d, 0x8b, 0x1a, 0xbb, 0x7e, 0xaa, 0x8b, 0xce, 0xf0, 0xc2, 0x13, 0x88, 0x85, 0x19, 0x75, 0x33,
+	0xa6, 0x1a, 0xed, 0xc0, 0x69, 0x89, 0xda, 0x8d, 0x15, 0x0e, 0x53, 0x27, 0xc5, 0x1e, 0x57, 0x4d,
+	0x98, 0x00, 0x49, 0x05, 0x17, 0xee, 0x75, 0x42, 0x63, 0x9c, 0xc1, 0xd0, 0xe7, 0x30, 0x23, 0x71,
+	0xe2, 0x28, 0x59, 0x81, 0xb2, 0xfc, 0x1c, 0x28, 0xb5, 0xc3, 0x18, 0xb2, 0xbd, 0xd4, 0x62, 0x08,
+	0x36, 0x9c, 0xea, 0x7a, 0x82, 0x1a, 0x8d, 0xee, 0x51, 0x74, 0xb6, 0xe9, 0xbe, 0xd6, 0xa0, 0xe5,
+	0x7c, 0x97, 0x12, 0xeb, 0x65, 0x7f, 0x92, 0xe1, 0xe7, 0x1e, 0xb2, 0x87, 0x57, 0xee, 0xf1, 0xe3,
+	0xac, 0xdc, 0xff, 0xa5, 0x99, 0x16, 0x95, 0x7d, 0x66, 0x74, 0x65, 0x7f, 0xa5, 0xe2, 0xef, 0xfc,
+	0x03, 0x11, 0xf2, 0x97, 0x7e, 0x75, 0x70, 0xe9, 0x8f, 0xc2, 0xee, 0x6f, 0x41, 0xd1, 0xa9, 0xbf,
+	0x65, 0xfc, 0xac, 0xc0, 0xdc, 0x3a, 0xb3, 0xaf, 0x1a, 0x9e, 0x89, 0xdd, 0x81, 0x71, 0xf0, 0x42,
+	0x6f, 0x3c, 0x83, 0xb3, 0x64, 0xfc, 0xd0, 0x2c, 0xb9, 0x72, 0xf9, 0x48, 0xdb, 0x17, 0x07, 0x6d,
+	0x1f, 0xb6, 0xa9, 0xfc, 0x7f, 0x28, 0x0c, 0x53, 0x43, 0x5f, 0x56, 0x7e, 0x4b, 0x41, 0x6a, 0x9d,
+	0xd9, 0xe8, 0x0b, 0x58, 0x08, 0x5f, 0x5d, 0x45, 0x52, 0x6d, 0x93, 0xf0, 0x3a, 0xd0, 0x6b, 0x09,
+	0xd5, 0x33, 0xf0, 0x02, 0x54, 0xb8, 0xf0, 0xcc, 0x5d, 0x2e, 0xc4, 0x44, 0x14, 0xfe, 0xd7, 0xb7,
+	0x45, 0xa2, 0xf9, 0x05, 0xd8, 0xc7, 0x3b, 0x9f, 0x8c, 0x17, 0x77, 0xa1, 0xf0, 0x46, 0x82, 0xdc,
+	0x51, 0x7e, 0x22, 0x0a, 0xa5, 0xe8, 0x26, 0x05, 0xe6, 0x1a, 0xe6, 0xbb, 0x18, 0x7b, 0x21, 0x2c,
+	0x7b, 0x9a, 0x9f, 0xd1, 0xa3, 0x89, 0x7e, 0x0e, 0xe7, 0x09, 0xea, 0x02, 0x3a, 0x22, 0x47, 0xde,
+	0x4c, 0x86, 0x19, 0x96, 0x2e, 0x5c, 0x4a, 0x90, 0x4e, 0xbe, 0xd2, 0x42, 0xe6, 0xcb, 0x27, 0xf7,
+	0x2e, 0x2a, 0x6b, 0x1b, 0x0f, 0x0e, 0x8a, 0xca, 0xc3, 0x83, 0xa2, 0xf2, 0xe7, 0x41, 0x51, 0xf9,
+	0xf1, 0x71, 0x71, 0xec, 0xe1, 0xe3, 0xe2, 0xd8, 0xef, 0x8f, 0x8b, 0x63, 0x9f, 0xbd, 0x1d, 0xab,
+	0xa1, 0x0f, 0xa4, 0xf6, 0x0d, 0xcc, 0x77, 0x09, 0x6d, 0x55, 0xc3, 0x2f, 0x37, 0xbd, 0xf8, 0xb7,
+	0x1b, 0x51, 0x55, 0x8d, 0x09, 0xf1, 0x21, 0xe5, 0xad, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x20,
+	0x71, 0x67, 0x3d, 0xde, 0x11, 0x00, 0x00,
 }
 
-func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// DelegateAssetToOperator delegates asset to operator.
+	DelegateAssetToOperator(ctx context.Context, in *MsgDelegation, opts ...grpc.CallOption) (*DelegationResponse, error)
+	// UndelegateAssetFromOperator undelegates asset from operator.
+	UndelegateAssetFromOperator(ctx context.Context, in *MsgUndelegation, opts ...grpc.CallOption) (*UndelegationResponse, error)
+	// RedelegateAssetBetweenOperators moves the delegated asset from one operator to another.
+	RedelegateAssetBetweenOperators(ctx context.Context, in *MsgRedelegate, opts ...grpc.CallOption) (*RedelegateResponse, error)
+	// CancelUndelegation cancels an immature undelegation and delegates the asset back to the
+	// operator.
+	CancelUndelegation(ctx context.Context, in *MsgCancelUndelegation, opts ...grpc.CallOption) (*CancelUndelegationResponse, error)
 }
 
-func (m *MsgUndelegation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) DelegateAssetToOperator(ctx context.Context, in *MsgDelegation, opts ...grpc.CallOption) (*DelegationResponse, error) {
+	out := new(DelegationResponse)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Msg/DelegateAssetToOperator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUndelegation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UndelegateAssetFromOperator(ctx context.Context, in *MsgUndelegation, opts ...grpc.CallOption) (*UndelegationResponse, error) {
+	out := new(UndelegationResponse)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Msg/UndelegateAssetFromOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUndelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BaseInfo != nil {
-		{
-			size, err := m.BaseInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.AssetID) > 0 {
-		i -= len(m.AssetID)
-		copy(dAtA[i:], m.AssetID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) RedelegateAssetBetweenOperators(ctx context.Context, in *MsgRedelegate, opts ...grpc.CallOption) (*RedelegateResponse, error) {
+	out := new(RedelegateResponse)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Msg/RedelegateAssetBetweenOperators", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UndelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelUndelegation(ctx context.Context, in *MsgCancelUndelegation, opts ...grpc.CallOption) (*CancelUndelegationResponse, error) {
+	out := new(CancelUndelegationResponse)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Msg/CancelUndelegation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *UndelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// DelegateAssetToOperator delegates asset to operator.
+	DelegateAssetToOperator(context.Context, *MsgDelegation) (*DelegationResponse, error)
+	// UndelegateAssetFromOperator undelegates asset from operator.
+	UndelegateAssetFromOperator(context.Context, *MsgUndelegation) (*UndelegationResponse, error)
+	// RedelegateAssetBetweenOperators moves the delegated asset from one operator to another.
+	RedelegateAssetBetweenOperators(context.Context, *MsgRedelegate) (*RedelegateResponse, error)
+	// CancelUndelegation cancels an immature undelegation and delegates the asset back to the
+	// operator.
+	CancelUndelegation(context.Context, *MsgCancelUndelegation) (*CancelUndelegationResponse, error)
 }
 
-func (m *UndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedMsgServer) DelegateAssetToOperator(ctx context.Context, req *MsgDelegation) (*DelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateAssetToOperator not implemented")
 }
-func (m *ValueField) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (*UnimplementedMsgServer) UndelegateAssetFromOperator(ctx context.Context, req *MsgUndelegation) (*UndelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateAssetFromOperator not implemented")
+}
+func (*UnimplementedMsgServer) RedelegateAssetBetweenOperators(ctx context.Context, req *MsgRedelegate) (*RedelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegateAssetBetweenOperators not implemented")
+}
+func (*UnimplementedMsgServer) CancelUndelegation(ctx context.Context, req *MsgCancelUndelegation) (*CancelUndelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUndelegation not implemented")
 }
 
-func (m *DelegatedSingleAssetInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AssetID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.PerOperatorAmounts) > 0 {
-		for _, e := range m.PerOperatorAmounts {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *KeyValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_DelegateAssetToOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
-}
-
-func (m *DelegationIncOrDecInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateAssetToOperator(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Msg/DelegateAssetToOperator",
 	}
-	if len(m.PerOperatorAmounts) > 0 {
-		for _, e := range m.PerOperatorAmounts {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateAssetToOperator(ctx, req.(*MsgDelegation))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDelegation) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UndelegateAssetFromOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegation)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.AssetID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateAssetFromOperator(ctx, in)
 	}
-	if m.BaseInfo != nil {
-		l = m.BaseInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Msg/UndelegateAssetFromOperator",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateAssetFromOperator(ctx, req.(*MsgUndelegation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UndelegationRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.StakerID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_RedelegateAssetBetweenOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedelegate)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.AssetID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RedelegateAssetBetweenOperators(ctx, in)
 	}
-	l = len(m.OperatorAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Msg/RedelegateAssetBetweenOperators",
 	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedelegateAssetBetweenOperators(ctx, req.(*MsgRedelegate))
 	}
-	if m.IsPending {
-		n += 2
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelUndelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelUndelegation)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.BlockNumber != 0 {
-		n += 1 + sovTx(uint64(m.BlockNumber))
+	if interceptor == nil {
+		return srv.(MsgServer).CancelUndelegation(ctx, in)
 	}
-	if m.CompleteBlockNumber != 0 {
-		n += 1 + sovTx(uint64(m.CompleteBlockNumber))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Msg/CancelUndelegation",
 	}
-	if m.LzTxNonce != 0 {
-		n += 1 + sovTx(uint64(m.LzTxNonce))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelUndelegation(ctx, req.(*MsgCancelUndelegation))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ActualCompletedAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UndelegationRecordKeyList) Size() (n int) {
-	if m == nil {
-		return 0
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "exocore.delegation.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DelegateAssetToOperator",
+			Handler:    _Msg_DelegateAssetToOperator_Handler,
+		},
+		{
+			MethodName: "UndelegateAssetFromOperator",
+			Handler:    _Msg_UndelegateAssetFromOperator_Handler,
+		},
+		{
+			MethodName: "RedelegateAssetBetweenOperators",
+			Handler:    _Msg_RedelegateAssetBetweenOperators_Handler,
+		},
+		{
+			MethodName: "CancelUndelegation",
+			Handler:    _Msg_CancelUndelegation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "exocore/delegation/v1/tx.proto",
+}
+
+func (m *ValueField) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValueField) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValueField) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.KeyList) > 0 {
-		for _, s := range m.KeyList {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelegatedSingleAssetInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUndelegation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DelegatedSingleAssetInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatedSingleAssetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.AssetID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.PerOperatorAmounts) > 0 {
+		for iNdEx := len(m.PerOperatorAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PerOperatorAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.BaseInfo != nil {
-		l = m.BaseInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UndelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *KeyValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *KeyValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *ValueField) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *KeyValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationIncOrDecInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationIncOrDecInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationIncOrDecInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PerOperatorAmounts) > 0 {
+		for iNdEx := len(m.PerOperatorAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PerOperatorAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseInfo != nil {
+		{
+			size, err := m.BaseInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UndelegationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UndelegationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UndelegationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ActualCompletedAmount.Size()
+		i -= size
+		if _, err := m.ActualCompletedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.LzTxNonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LzTxNonce))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CompleteBlockNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompleteBlockNumber))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IsPending {
+		i--
+		if m.IsPending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UndelegationRecordKeyList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UndelegationRecordKeyList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UndelegationRecordKeyList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyList) > 0 {
+		for iNdEx := len(m.KeyList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.KeyList[iNdEx])
+			copy(dAtA[i:], m.KeyList[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.KeyList[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseInfo != nil {
+		{
+			size, err := m.BaseInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UndelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UndelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlashableAmount.Size()
+		i -= size
+		if _, err := m.SlashableAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.InitialAmount.Size()
+		i -= size
+		if _, err := m.InitialAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.LzTxNonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LzTxNonce))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CompleteBlockNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompleteBlockNumber))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DstOperatorAddr) > 0 {
+		i -= len(m.DstOperatorAddr)
+		copy(dAtA[i:], m.DstOperatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DstOperatorAddr)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SrcOperatorAddr) > 0 {
+		i -= len(m.SrcOperatorAddr)
+		copy(dAtA[i:], m.SrcOperatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SrcOperatorAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NSTSlashFromUndelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NSTSlashFromUndelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NSTSlashFromUndelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordKey) > 0 {
+		i -= len(m.RecordKey)
+		copy(dAtA[i:], m.RecordKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NSTSlashFromDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NSTSlashFromDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NSTSlashFromDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NSTSlashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NSTSlashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NSTSlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.UnslashedAmount.Size()
+		i -= size
+		if _, err := m.UnslashedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.SlashDelegations) > 0 {
+		for iNdEx := len(m.SlashDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.SlashUndelegations) > 0 {
+		for iNdEx := len(m.SlashUndelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashUndelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.SlashFromWithdrawable.Size()
+		i -= size
+		if _, err := m.SlashFromWithdrawable.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SlashAmount.Size()
+		i -= size
+		if _, err := m.SlashAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.DstOperatorAddr) > 0 {
+		i -= len(m.DstOperatorAddr)
+		copy(dAtA[i:], m.DstOperatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DstOperatorAddr)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SrcOperatorAddr) > 0 {
+		i -= len(m.SrcOperatorAddr)
+		copy(dAtA[i:], m.SrcOperatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SrcOperatorAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUndelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUndelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUndelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecordKey) > 0 {
+		i -= len(m.RecordKey)
+		copy(dAtA[i:], m.RecordKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelUndelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelUndelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelUndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValueField) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *DelegatedSingleAssetInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PerOperatorAmounts) > 0 {
+		for _, e := range m.PerOperatorAmounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KeyValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegationIncOrDecInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PerOperatorAmounts) > 0 {
+		for _, e := range m.PerOperatorAmounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BaseInfo != nil {
+		l = m.BaseInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *UndelegationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IsPending {
+		n += 2
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovTx(uint64(m.BlockNumber))
+	}
+	if m.CompleteBlockNumber != 0 {
+		n += 1 + sovTx(uint64(m.CompleteBlockNumber))
+	}
+	if m.LzTxNonce != 0 {
+		n += 1 + sovTx(uint64(m.LzTxNonce))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ActualCompletedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *UndelegationRecordKeyList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.KeyList) > 0 {
+		for _, s := range m.KeyList {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUndelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BaseInfo != nil {
+		l = m.BaseInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *UndelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RedelegationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SrcOperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DstOperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovTx(uint64(m.BlockNumber))
+	}
+	if m.CompleteBlockNumber != 0 {
+		n += 1 + sovTx(uint64(m.CompleteBlockNumber))
+	}
+	if m.LzTxNonce != 0 {
+		n += 1 + sovTx(uint64(m.LzTxNonce))
+	}
+	l = m.InitialAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.SlashableAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *NSTSlashFromUndelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *NSTSlashFromDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *NSTSlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	l = m.SlashAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.SlashFromWithdrawable.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.SlashUndelegations) > 0 {
+		for _, e := range m.SlashUndelegations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.SlashDelegations) > 0 {
+		for _, e := range m.SlashDelegations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.UnslashedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SrcOperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DstOperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *RedelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelUndelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecordKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *CancelUndelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValueField) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValueField: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValueField: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatedSingleAssetInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatedSingleAssetInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatedSingleAssetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerOperatorAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PerOperatorAmounts = append(m.PerOperatorAmounts, KeyValue{})
+			if err := m.PerOperatorAmounts[len(m.PerOperatorAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &ValueField{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationIncOrDecInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationIncOrDecInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationIncOrDecInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerOperatorAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PerOperatorAmounts = append(m.PerOperatorAmounts, KeyValue{})
+			if err := m.PerOperatorAmounts[len(m.PerOperatorAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseInfo == nil {
+				m.BaseInfo = &DelegationIncOrDecInfo{}
+			}
+			if err := m.BaseInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UndelegationRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UndelegationRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UndelegationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPending = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompleteBlockNumber", wireType)
+			}
+			m.CompleteBlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompleteBlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LzTxNonce", wireType)
+			}
+			m.LzTxNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LzTxNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualCompletedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActualCompletedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UndelegationRecordKeyList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UndelegationRecordKeyList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UndelegationRecordKeyList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyList = append(m.KeyList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseInfo == nil {
+				m.BaseInfo = &DelegationIncOrDecInfo{}
+			}
+			if err := m.BaseInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UndelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UndelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UndelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcOperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcOperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstOperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstOperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompleteBlockNumber", wireType)
+			}
+			m.CompleteBlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompleteBlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LzTxNonce", wireType)
+			}
+			m.LzTxNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LzTxNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashableAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NSTSlashFromUndelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValueField: wiretype end group for non-group")
+			return fmt.Errorf("proto: NSTSlashFromUndelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValueField: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NSTSlashFromUndelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -1460,7 +4298,7 @@ func (m *ValueField) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegatedSingleAssetInfo) Unmarshal(dAtA []byte) error {
+func (m *NSTSlashFromDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1483,15 +4321,15 @@ func (m *DelegatedSingleAssetInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatedSingleAssetInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: NSTSlashFromDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatedSingleAssetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NSTSlashFromDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1519,13 +4357,47 @@ func (m *DelegatedSingleAssetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetID = string(dAtA[iNdEx:postIndex])
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerOperatorAmounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1535,23 +4407,23 @@ func (m *DelegatedSingleAssetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PerOperatorAmounts = append(m.PerOperatorAmounts, KeyValue{})
-			if err := m.PerOperatorAmounts[len(m.PerOperatorAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1576,7 +4448,7 @@ func (m *DelegatedSingleAssetInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyValue) Unmarshal(dAtA []byte) error {
+func (m *NSTSlashRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1599,15 +4471,15 @@ func (m *KeyValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: NSTSlashRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NSTSlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1635,13 +4507,13 @@ func (m *KeyValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.StakerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1651,81 +4523,99 @@ func (m *KeyValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Value == nil {
-				m.Value = &ValueField{}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAmount", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DelegationIncOrDecInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.SlashAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationIncOrDecInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationIncOrDecInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFromWithdrawable", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1753,11 +4643,13 @@ func (m *DelegationIncOrDecInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.SlashFromWithdrawable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerOperatorAmounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashUndelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1784,66 +4676,16 @@ func (m *DelegationIncOrDecInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PerOperatorAmounts = append(m.PerOperatorAmounts, KeyValue{})
-			if err := m.PerOperatorAmounts[len(m.PerOperatorAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SlashUndelegations = append(m.SlashUndelegations, NSTSlashFromUndelegation{})
+			if err := m.SlashUndelegations[len(m.SlashUndelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDelegation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashDelegations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1853,29 +4695,31 @@ func (m *MsgDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetID = string(dAtA[iNdEx:postIndex])
+			m.SlashDelegations = append(m.SlashDelegations, NSTSlashFromDelegation{})
+			if err := m.SlashDelegations[len(m.SlashDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnslashedAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1885,25 +4729,23 @@ func (m *MsgDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseInfo == nil {
-				m.BaseInfo = &DelegationIncOrDecInfo{}
-			}
-			if err := m.BaseInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UnslashedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1928,7 +4770,7 @@ func (m *MsgDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UndelegationRecord) Unmarshal(dAtA []byte) error {
+func (m *MsgRedelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1951,15 +4793,15 @@ func (m *UndelegationRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UndelegationRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UndelegationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1987,11 +4829,11 @@ func (m *UndelegationRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakerID = string(dAtA[iNdEx:postIndex])
+			m.AssetID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2019,11 +4861,11 @@ func (m *UndelegationRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetID = string(dAtA[iNdEx:postIndex])
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcOperatorAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2051,11 +4893,11 @@ func (m *UndelegationRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			m.SrcOperatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstOperatorAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2083,86 +4925,9 @@ func (m *UndelegationRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
+			m.DstOperatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsPending", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsPending = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
-			}
-			m.BlockNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockNumber |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompleteBlockNumber", wireType)
-			}
-			m.CompleteBlockNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CompleteBlockNumber |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LzTxNonce", wireType)
-			}
-			m.LzTxNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LzTxNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -2196,122 +4961,6 @@ func (m *UndelegationRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActualCompletedAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ActualCompletedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UndelegationRecordKeyList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UndelegationRecordKeyList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UndelegationRecordKeyList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyList", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KeyList = append(m.KeyList, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2333,7 +4982,7 @@ func (m *UndelegationRecordKeyList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *RedelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2356,10 +5005,10 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2383,7 +5032,7 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegation) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelUndelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2406,15 +5055,15 @@ func (m *MsgUndelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelUndelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelUndelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2442,13 +5091,13 @@ func (m *MsgUndelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetID = string(dAtA[iNdEx:postIndex])
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2458,27 +5107,23 @@ func (m *MsgUndelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseInfo == nil {
-				m.BaseInfo = &DelegationIncOrDecInfo{}
-			}
-			if err := m.BaseInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RecordKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2501,7 +5146,7 @@ func (m *MsgUndelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UndelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *CancelUndelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2524,10 +5169,10 @@ func (m *UndelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UndelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelUndelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UndelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelUndelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -37,7 +37,11 @@ func (k Keeper) InitGenesis(
 	// the avs module will remove the revision by itself, but we do it here anyway because we need it
 	// to look up operator registration status after this - which is keyed by chainID without revision.
 	chainIDWithoutRevision := avstypes.ChainIDWithoutRevision(ctx.ChainID())
-	if avsAddr, err = k.avsKeeper.RegisterAVSWithChainID(ctx, &avstypes.AVSRegisterOrDeregisterParams{
+	// when starting from an exported genesis, the AVS is already imported by the avs module,
+	// which runs before this module. in that case, it must not be registered again.
+	if found, avsAddrStr := k.avsKeeper.IsAVSByChainID(ctx, chainIDWithoutRevision); found {
+		avsAddr = common.HexToAddress(avsAddrStr)
+	} else if avsAddr, err = k.avsKeeper.RegisterAVSWithChainID(ctx, &avstypes.AVSRegisterOrDeregisterParams{
 		AvsName:           chainIDWithoutRevision,
 		AssetID:           genState.Params.AssetIDs,
 		UnbondingPeriod:   uint64(genState.Params.EpochsUntilUnbonded),
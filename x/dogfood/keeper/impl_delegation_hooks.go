@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
 	keytypes "github.com/ExocoreNetwork/exocore/types/keys"
 	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
 	delegationtypes "github.com/ExocoreNetwork/exocore/x/delegation/types"
@@ -26,11 +27,12 @@ func (k *Keeper) DelegationHooks() DelegationHooksWrapper {
 
 // AfterDelegation is called after a delegation is made.
 func (wrapper DelegationHooksWrapper) AfterDelegation(
-	sdk.Context, sdk.AccAddress,
-) {
+	sdk.Context, sdk.AccAddress, string, string,
+) error {
 	// we do nothing here, since the vote power for all operators is calculated
 	// in the end separately. even if we knew the amount of the delegation, the
 	// exchange rate at the end of the epoch is unknown.
+	return nil
 }
 
 // AfterUndelegationStarted is called after an undelegation is started.
@@ -98,3 +100,36 @@ func (wrapper DelegationHooksWrapper) AfterUndelegationStarted(
 	wrapper.keeper.SetUndelegationMaturityEpoch(ctx, recordKey, unbondingCompletionEpoch)
 	return wrapper.keeper.delegationKeeper.IncrementUndelegationHoldCount(ctx, recordKey)
 }
+
+// AfterRedelegationStarted is called after a redelegation is started.
+func (wrapper DelegationHooksWrapper) AfterRedelegationStarted(
+	sdk.Context, sdk.AccAddress, []byte,
+) error {
+	// we do nothing here, since the redelegated vote power is moved to the destination
+	// operator at the end of the epoch, and the operator module holds the redelegation
+	// record until the unbonding period of the dogfood AVS elapses.
+	return nil
+}
+
+// AfterUndelegationCanceled is called before a canceled undelegation record is deleted.
+func (wrapper DelegationHooksWrapper) AfterUndelegationCanceled(
+	ctx sdk.Context, _ sdk.AccAddress, recordKey []byte,
+) error {
+	// the record is either scheduled to mature at the end of an epoch, or it is pending to be
+	// released at the end of this block if the epoch has just ended.
+	if epoch, found := wrapper.keeper.GetUndelegationMaturityEpoch(ctx, recordKey); found {
+		wrapper.keeper.RemoveUndelegationToMature(ctx, epoch, recordKey)
+		wrapper.keeper.RemovePendingUndelegation(ctx, recordKey)
+		wrapper.keeper.ClearUndelegationMaturityEpoch(ctx, recordKey)
+	}
+	return nil
+}
+
+// AfterDelegationSlashed is called after the delegated amount of a staker is slashed.
+func (wrapper DelegationHooksWrapper) AfterDelegationSlashed(
+	sdk.Context, sdk.AccAddress, string, string, sdkmath.Int,
+) error {
+	// we do nothing here, since the vote power is recalculated from the USD values at the
+	// end of the epoch.
+	return nil
+}
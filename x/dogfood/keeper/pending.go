@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+
 	"github.com/ExocoreNetwork/exocore/x/dogfood/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -88,6 +90,23 @@ func (k Keeper) GetPendingUndelegations(ctx sdk.Context) types.UndelegationRecor
 	return undelegations
 }
 
+// RemovePendingUndelegation removes the undelegation with recordKey from the pending
+// undelegations to be released at the end of the block.
+func (k Keeper) RemovePendingUndelegation(ctx sdk.Context, recordKey []byte) {
+	pending := k.GetPendingUndelegations(ctx)
+	prev := pending.GetList()
+	if len(prev) == 0 {
+		return
+	}
+	next := make([][]byte, 0, len(prev))
+	for _, key := range prev {
+		if !bytes.Equal(key, recordKey) {
+			next = append(next, key)
+		}
+	}
+	k.SetPendingUndelegations(ctx, types.UndelegationRecordKeys{List: next})
+}
+
 // ClearPendingUndelegations clears the pending undelegations to be released at the end of the
 // block.
 func (k Keeper) ClearPendingUndelegations(ctx sdk.Context) {
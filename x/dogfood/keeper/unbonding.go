@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+
 	"github.com/ExocoreNetwork/exocore/x/dogfood/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -34,6 +36,25 @@ func (k Keeper) AppendUndelegationToMature(
 	k.setUndelegationsToMature(ctx, epoch, next)
 }
 
+// RemoveUndelegationToMature removes the undelegation with recordKey from the entries to be
+// released at the end of the provided epoch. It is used when the undelegation is canceled.
+func (k Keeper) RemoveUndelegationToMature(
+	ctx sdk.Context, epoch int64, recordKey []byte,
+) {
+	prev := k.GetUndelegationsToMature(ctx, epoch)
+	next := make([][]byte, 0, len(prev))
+	for _, key := range prev {
+		if !bytes.Equal(key, recordKey) {
+			next = append(next, key)
+		}
+	}
+	if len(next) == 0 {
+		k.ClearUndelegationsToMature(ctx, epoch)
+		return
+	}
+	k.setUndelegationsToMature(ctx, epoch, types.UndelegationRecordKeys{List: next})
+}
+
 // GetUndelegationsToMature returns all undelegation entries that should be released
 // at the end of the provided epoch.
 func (k Keeper) GetUndelegationsToMature(
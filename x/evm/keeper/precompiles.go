@@ -9,10 +9,13 @@ import (
 	avsManagerPrecompile "github.com/ExocoreNetwork/exocore/precompiles/avs"
 	blsPrecompile "github.com/ExocoreNetwork/exocore/precompiles/bls"
 	delegationprecompile "github.com/ExocoreNetwork/exocore/precompiles/delegation"
+	feedistributionprecompile "github.com/ExocoreNetwork/exocore/precompiles/feedistribution"
 	rewardPrecompile "github.com/ExocoreNetwork/exocore/precompiles/reward"
+	slashPrecompile "github.com/ExocoreNetwork/exocore/precompiles/slash"
 	stakingStateKeeper "github.com/ExocoreNetwork/exocore/x/assets/keeper"
 	avsManagerKeeper "github.com/ExocoreNetwork/exocore/x/avs/keeper"
 	delegationKeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	distrKeeper "github.com/ExocoreNetwork/exocore/x/feedistribution/keeper"
 	rewardKeeper "github.com/ExocoreNetwork/exocore/x/reward/keeper"
 	exoslashKeeper "github.com/ExocoreNetwork/exocore/x/slash/keeper"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -36,9 +39,10 @@ func AvailablePrecompiles(
 	_ channelkeeper.Keeper,
 	delegationKeeper delegationKeeper.Keeper,
 	assetskeeper stakingStateKeeper.Keeper,
-	_ exoslashKeeper.Keeper,
+	slashKeeper exoslashKeeper.Keeper,
 	rewardKeeper rewardKeeper.Keeper,
 	avsManagerKeeper avsManagerKeeper.Keeper,
+	distrKeeper distrKeeper.Keeper,
 ) map[common.Address]vm.PrecompiledContract {
 	// Clone the mapping from the latest EVM fork.
 	precompiles := maps.Clone(vm.PrecompiledContractsBerlin)
@@ -68,12 +72,11 @@ func AvailablePrecompiles(
 		panic(fmt.Errorf("failed to load delegation precompile: %w", err))
 	}
 
-	// The slash precompile is deprecated, but I have only commented out this code to facilitate future refactoring.
-	/*	slashPrecompile, err := slashPrecompile.NewPrecompile(
+	slashPrecompile, err := slashPrecompile.NewPrecompile(
 		assetskeeper,
 		slashKeeper,
 		authzKeeper,
-	)*/
+	)
 	if err != nil {
 		panic(fmt.Errorf("failed to load slash precompile: %w", err))
 	}
@@ -89,15 +92,20 @@ func AvailablePrecompiles(
 	if err != nil {
 		panic(fmt.Errorf("failed to load avsManager precompile: %w", err))
 	}
+	feeDistributionPrecompile, err := feedistributionprecompile.NewPrecompile(distrKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to load fee distribution precompile: %w", err))
+	}
 	blsPrecompile, err := blsPrecompile.NewPrecompile(BaseGas)
 	if err != nil {
 		panic(fmt.Errorf("failed to load bls precompile: %v", err))
 	}
-	// precompiles[slashPrecompile.Address()] = slashPrecompile
+	precompiles[slashPrecompile.Address()] = slashPrecompile
 	precompiles[rewardPrecompile.Address()] = rewardPrecompile
 	precompiles[assetsPrecompile.Address()] = assetsPrecompile
 	precompiles[delegationPrecompile.Address()] = delegationPrecompile
 	precompiles[avsManagerPrecompile.Address()] = avsManagerPrecompile
+	precompiles[feeDistributionPrecompile.Address()] = feeDistributionPrecompile
 	// precompiles[ibcTransferPrecompile.Address()] = ibcTransferPrecompile
 	precompiles[blsPrecompile.Address()] = blsPrecompile
 	return precompiles
@@ -15,10 +15,11 @@ var (
 		"0x0000000000000000000000000000000000000804", // assets precompile
 		"0x0000000000000000000000000000000000000805", // delegation precompile
 		"0x0000000000000000000000000000000000000806", // reward precompile
-		// "0x0000000000000000000000000000000000000807", // slash precompile
+		"0x0000000000000000000000000000000000000807", // slash precompile
 		// 0x0000000000000000000000000000000000000808 withdraw precompile has been merged to assets.
 		// the function has been merged to the assets precompile
 		"0x0000000000000000000000000000000000000809", // bls precompile
+		"0x000000000000000000000000000000000000080a", // fee distribution precompile
 		"0x0000000000000000000000000000000000000901", // avs precompile
 	}
 )
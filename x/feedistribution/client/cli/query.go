@@ -2,10 +2,12 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/ExocoreNetwork/exocore/x/feedistribution/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 )
 
@@ -20,7 +22,15 @@ func GetQueryCmd(_ string) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(
+		CmdQueryParams(),
+		CmdQueryStakerRewards(),
+		CmdQueryAllStakerRewards(),
+		CmdQueryOperatorOutstandingRewards(),
+		CmdQueryOperatorCommission(),
+		CmdQueryCommunityPool(),
+		CmdQueryAVSRewardPool(),
+	)
 	return cmd
 }
 
@@ -46,3 +56,169 @@ func CmdQueryParams() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// CmdQueryStakerRewards queries the pending rewards of a staker.
+func CmdQueryStakerRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "staker-rewards <stakerID>",
+		Short: "show the pending rewards of a staker",
+		Long:  "show the pending rewards of a staker, the staker id is the joined staker address and client chain id, such as 0x..._0x65",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.StakerRewards(cmd.Context(), &types.QueryStakerRewardsRequest{
+				StakerId: strings.ToLower(args[0]),
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryAllStakerRewards queries the outstanding rewards of all the stakers.
+func CmdQueryAllStakerRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-staker-rewards",
+		Short: "show the outstanding rewards of all the stakers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AllStakerRewards(cmd.Context(), &types.QueryAllStakerRewardsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all-staker-rewards")
+	return cmd
+}
+
+// CmdQueryOperatorOutstandingRewards queries the outstanding rewards of an operator.
+func CmdQueryOperatorOutstandingRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "operator-outstanding-rewards <operatorAddr>",
+		Short: "show the outstanding rewards of an operator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.OperatorOutstandingRewards(cmd.Context(), &types.QueryOperatorOutstandingRewardsRequest{
+				OperatorAddr: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryOperatorCommission queries the accumulated commission of an operator.
+func CmdQueryOperatorCommission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "operator-commission <operatorAddr>",
+		Short: "show the accumulated commission of an operator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.OperatorCommission(cmd.Context(), &types.QueryOperatorCommissionRequest{
+				OperatorAddr: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryCommunityPool queries the coins of the community pool.
+func CmdQueryCommunityPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool",
+		Short: "show the coins of the community pool",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CommunityPool(cmd.Context(), &types.QueryCommunityPoolRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryAVSRewardPool queries the rewards of an AVS that haven't been distributed.
+func CmdQueryAVSRewardPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "avs-reward-pool <avsAddress>",
+		Short: "show the rewards deposited by an AVS that haven't been distributed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AVSRewardPool(cmd.Context(), &types.QueryAVSRewardPoolRequest{
+				AvsAddress: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -24,7 +24,14 @@ func GetTxCmd() *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
-	cmd.AddCommand(CmdUpdateParams())
+	cmd.AddCommand(
+		CmdUpdateParams(),
+		CmdSetWithdrawAddress(),
+		CmdWithdrawStakerReward(),
+		CmdWithdrawOperatorCommission(),
+		CmdFundCommunityPool(),
+		CmdDepositAVSRewards(),
+	)
 	return cmd
 }
 
@@ -69,3 +76,140 @@ func CmdUpdateParams() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// CmdSetWithdrawAddress sets the withdraw address of the rewards and commission
+func CmdSetWithdrawAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-withdraw-address <withdrawAddress>",
+		Short: "set the address to which the rewards and commission are withdrawn",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgSetWithdrawAddress{
+				DelegatorAddress: cliCtx.GetFromAddress().String(),
+				WithdrawAddress:  args[0],
+			}
+			// this calls ValidateBasic internally so we don't need to do that.
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	// transaction level flags from the SDK
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdWithdrawStakerReward withdraws the outstanding rewards of the staker
+func CmdWithdrawStakerReward() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-staker-reward <clientChainID>",
+		Short: "withdraw the outstanding rewards of the staker from the client chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			clientChainID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgWithdrawStakerReward{
+				StakerAddress: cliCtx.GetFromAddress().String(),
+				ClientChainID: clientChainID,
+			}
+			// this calls ValidateBasic internally so we don't need to do that.
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	// transaction level flags from the SDK
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdWithdrawOperatorCommission withdraws the accumulated commission of the operator
+func CmdWithdrawOperatorCommission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-operator-commission",
+		Short: "withdraw the accumulated commission of the operator",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgWithdrawOperatorCommission{
+				OperatorAddress: cliCtx.GetFromAddress().String(),
+			}
+			// this calls ValidateBasic internally so we don't need to do that.
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	// transaction level flags from the SDK
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdFundCommunityPool funds the community pool with the provided coins
+func CmdFundCommunityPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-community-pool <amount>",
+		Short: "fund the community pool with the provided coins, such as 100hua",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgFundCommunityPool{
+				Amount:    amount,
+				Depositor: cliCtx.GetFromAddress().String(),
+			}
+			// this calls ValidateBasic internally so we don't need to do that.
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	// transaction level flags from the SDK
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdDepositAVSRewards deposits the provided coins into the reward pool of the AVS
+func CmdDepositAVSRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-avs-rewards <avsAddress> <amount>",
+		Short: "deposit the provided coins into the reward pool of the AVS, which are distributed to its operators and their stakers",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgDepositAVSRewards{
+				Sender:     cliCtx.GetFromAddress().String(),
+				AvsAddress: args[0],
+				Amount:     amount,
+			}
+			// this calls ValidateBasic internally so we don't need to do that.
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	// transaction level flags from the SDK
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -1,11 +1,14 @@
 package keeper
 
 import (
+	"errors"
 	"sort"
 
 	"cosmossdk.io/math"
 	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
 	"github.com/ExocoreNetwork/exocore/x/feedistribution/types"
+	operatortypes "github.com/ExocoreNetwork/exocore/x/operator/types"
+	oracletypes "github.com/ExocoreNetwork/exocore/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -104,68 +107,92 @@ func (k Keeper) AllocateTokensToValidator(ctx sdk.Context, val stakingtypes.Vali
 		sdk.NewAttribute(types.AttributeKeyValidator, val.GetOperator().String()),
 	))
 
-	// ValidatorOutstandingRewards is the rewards of a validator address.
+	// ValidatorOutstandingRewards is the rewards of a validator address. The shared rewards
+	// are tracked by the reward pools and paid out to the stakers, so only the commission
+	// is credited to the operator.
 	outstanding := k.GetValidatorOutstandingRewards(ctx, valBz)
-	outstanding.Rewards = outstanding.Rewards.Add(tokens...)
+	outstanding.Rewards = outstanding.Rewards.Add(commission...)
 	k.SetValidatorOutstandingRewards(ctx, valBz, outstanding)
 	logger.Info("Allocate tokens to validator successfully", "allocated amount is", outstanding.Rewards.String())
 }
 
+// AllocateTokensToStakers allocates the rewards of the stakers of the operator to the reward
+// pools of the assets supported by the dogfood AVS. The rewards that can't be allocated are
+// sent to the community pool.
 func (k Keeper) AllocateTokensToStakers(ctx sdk.Context, operatorAddress sdk.AccAddress, rewardToAllStakers sdk.DecCoins, feePool *types.FeePool) {
+	avsAddr := avstypes.GenerateAVSAddr(avstypes.ChainIDWithoutRevision(ctx.ChainID()))
+	remaining := k.allocateTokensToRewardPools(ctx, avsAddr, operatorAddress, rewardToAllStakers)
+	feePool.CommunityPool = feePool.CommunityPool.Add(remaining...)
+}
+
+// allocateTokensToRewardPools allocates the rewards of the stakers of the operator to the
+// reward pools of the assets supported by the AVS, weighted by the USD value of the assets
+// delegated to the operator. Since the share of a staker in a pool is proportional to the USD
+// value of its delegated asset, it's the same as allocating the rewards by the USD value of
+// each staker, but without iterating over the stakers. The rewards are only added to the
+// current rewards of the pools, and computed for each staker when its rewards are settled.
+// It returns the rewards that can't be allocated.
+func (k Keeper) allocateTokensToRewardPools(
+	ctx sdk.Context, avsAddr string, operatorAddress sdk.AccAddress, rewardToAllStakers sdk.DecCoins,
+) sdk.DecCoins {
 	logger := k.Logger()
-	logger.Info("AllocateTokensToStakers", "operatorAddress", operatorAddress.String())
-	avsList, err := k.StakingKeeper.GetOptedInAVSForOperator(ctx, operatorAddress.String())
+	avsAssets, err := k.StakingKeeper.GetAVSSupportedAssets(ctx, avsAddr)
+	if err != nil || len(avsAssets) == 0 {
+		logger.Debug("avs supported assets not found; skipping", "avs", avsAddr)
+		return rewardToAllStakers
+	}
+	decimals, err := k.assetsKeeper.GetAssetsDecimal(ctx, avsAssets)
 	if err != nil {
-		logger.Debug("avs address lists not found; skipping")
-		return
+		logger.Error("failed to get the decimals of the assets", "error", err)
+		return rewardToAllStakers
+	}
+	prices, err := k.oracleKeeper.GetMultipleAssetsPrices(ctx, avsAssets)
+	// the default price is used for the assets whose price round isn't found
+	if err != nil && !errors.Is(err, oracletypes.ErrGetPriceRoundNotFound) {
+		logger.Error("failed to get the prices of the assets", "error", err)
+		return rewardToAllStakers
 	}
-	stakersPowerMap, curTotalStakersPowers := make(map[string]math.LegacyDec), math.LegacyNewDec(0)
-	globalStakerAddressList := make([]string, 0)
-	for _, avsAddress := range avsList {
-		avsAssets, err := k.StakingKeeper.GetAVSSupportedAssets(ctx, avsAddress)
+
+	// sort the assets to allocate the rewards in a deterministic order
+	assetIDs := make([]string, 0, len(avsAssets))
+	for assetID := range avsAssets {
+		assetIDs = append(assetIDs, assetID)
+	}
+	sort.Strings(assetIDs)
+	assetsPower, totalPower := make(map[string]math.LegacyDec), math.LegacyZeroDec()
+	for _, assetID := range assetIDs {
+		// the rewards can't be distributed if no share of the pool is tracked
+		if !k.GetOperatorAssetTotalStake(ctx, operatorAddress, assetID).IsPositive() {
+			continue
+		}
+		opAsset, err := k.assetsKeeper.GetOperatorSpecifiedAssetInfo(ctx, operatorAddress, assetID)
 		if err != nil {
-			logger.Debug("avs address lists not found; skipping")
 			continue
 		}
-		for assetID := range avsAssets {
-			stakerList, err := k.StakingKeeper.GetStakersByOperator(ctx, operatorAddress.String(), assetID)
-			if err != nil {
-				logger.Debug("staker lists not found; skipping")
-				continue
-			}
-			for _, staker := range stakerList.Stakers {
-				if curStakerPower, err := k.StakingKeeper.CalculateUSDValueForStaker(ctx, staker, avsAddress, operatorAddress.Bytes()); err != nil {
-					logger.Error("curStakerPower error", "error", err)
-				} else {
-					stakersPowerMap[staker] = curStakerPower
-					globalStakerAddressList = append(globalStakerAddressList, staker)
-					curTotalStakersPowers = curTotalStakersPowers.Add(curStakerPower)
-				}
-			}
+		price, ok := prices[assetID]
+		if !ok {
+			continue
+		}
+		power := operatortypes.CalculateUSDValue(opAsset.TotalAmount, price.Value, decimals[assetID], price.Decimal)
+		if power.IsPositive() {
+			assetsPower[assetID] = power
+			totalPower = totalPower.Add(power)
 		}
 	}
-	sort.Slice(globalStakerAddressList, func(i, j int) bool {
-		return stakersPowerMap[globalStakerAddressList[i]].GT(stakersPowerMap[globalStakerAddressList[j]])
-	})
+
 	remaining := rewardToAllStakers
-	// allocate to stakers in voting power descending order if the curTotalStakersPower is positive
-	if curTotalStakersPowers.IsPositive() {
-		for _, staker := range globalStakerAddressList {
-			stakerPower := stakersPowerMap[staker]
-			powerFraction := stakerPower.QuoTruncate(curTotalStakersPowers)
-			rewardToSingleStaker := rewardToAllStakers.MulDecTruncate(powerFraction)
-			k.AllocateTokensToSingleStaker(ctx, staker, rewardToSingleStaker)
-			remaining = remaining.Sub(rewardToSingleStaker)
+	if totalPower.IsPositive() {
+		for _, assetID := range assetIDs {
+			power, ok := assetsPower[assetID]
+			if !ok {
+				continue
+			}
+			powerFraction := power.QuoTruncate(totalPower)
+			reward := rewardToAllStakers.MulDecTruncate(powerFraction)
+			k.AddOperatorAssetRewards(ctx, operatorAddress, assetID, reward)
+			remaining = remaining.Sub(reward)
 		}
 	}
-	feePool.CommunityPool = feePool.CommunityPool.Add(rewardToAllStakers...)
-	logger.Info("allocate tokens to stakers successfully", "allocated amount is", rewardToAllStakers.String())
-}
-
-func (k Keeper) AllocateTokensToSingleStaker(ctx sdk.Context, stakerAddress string, reward sdk.DecCoins) {
-	logger := k.Logger()
-	currentStakerRewards := k.GetStakerRewards(ctx, stakerAddress)
-	currentStakerRewards.Rewards = currentStakerRewards.Rewards.Add(reward...)
-	k.SetStakerRewards(ctx, stakerAddress, currentStakerRewards)
-	logger.Info("allocate tokens to single staker successfully", "allocated amount is", currentStakerRewards.Rewards.String())
+	logger.Info("allocate tokens to stakers successfully", "allocated amount is", rewardToAllStakers.Sub(remaining).String())
+	return remaining
 }
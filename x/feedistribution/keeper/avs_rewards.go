@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/x/feedistribution/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// GetAVSRewardPool returns the rewards deposited by the AVS that haven't been distributed.
+func (k Keeper) GetAVSRewardPool(ctx sdk.Context, avsAddr string) (pool types.AVSRewardPool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetAVSRewardPoolKey(common.HexToAddress(avsAddr)))
+	if b == nil {
+		return types.AVSRewardPool{}
+	}
+	k.cdc.MustUnmarshal(b, &pool)
+	return pool
+}
+
+// SetAVSRewardPool sets the rewards deposited by the AVS that haven't been distributed.
+func (k Keeper) SetAVSRewardPool(ctx sdk.Context, avsAddr string, pool types.AVSRewardPool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetAVSRewardPoolKey(common.HexToAddress(avsAddr))
+	if pool.Rewards.IsZero() {
+		store.Delete(key)
+		return
+	}
+	b := k.cdc.MustMarshal(&pool)
+	store.Set(key, b)
+}
+
+// IterateAVSRewardPools iterates over the reward pools of the AVSs. The AVS addresses are
+// provided in lowercase, which is the format used by the AVS and operator modules.
+func (k Keeper) IterateAVSRewardPools(ctx sdk.Context, handler func(avsAddr string, pool types.AVSRewardPool) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AVSRewardPoolPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		avsAddr := strings.ToLower(common.BytesToAddress(iterator.Key()[len(types.AVSRewardPoolPrefix):]).String())
+		var pool types.AVSRewardPool
+		k.cdc.MustUnmarshal(iterator.Value(), &pool)
+		if handler(avsAddr, pool) {
+			break
+		}
+	}
+}
+
+// DepositAVSRewards sends the coins from the sender to the module account, and adds them to
+// the reward pool of the AVS.
+func (k Keeper) DepositAVSRewards(ctx sdk.Context, sender sdk.AccAddress, avsAddr string, amount sdk.Coins) error {
+	avsAddr = strings.ToLower(avsAddr)
+	if isAVS, _ := k.avsKeeper.IsAVS(ctx, avsAddr); !isAVS {
+		return errorsmod.Wrapf(types.ErrNoSuchAVS, "AVS not found %s", avsAddr)
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount); err != nil {
+		return err
+	}
+	pool := k.GetAVSRewardPool(ctx, avsAddr)
+	pool.Rewards = pool.Rewards.Add(sdk.NewDecCoinsFromCoins(amount...)...)
+	k.SetAVSRewardPool(ctx, avsAddr, pool)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDepositAVSRewards,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyAVSAddress, avsAddr),
+	))
+	return nil
+}
+
+// AllocateAVSRewards distributes the reward pools of all the AVSs to the operators opted into
+// them and their stakers. The rewards that can't be distributed are kept in the pools. Each AVS
+// is allocated in its own cache context, so a failed AVS doesn't affect the others.
+func (k Keeper) AllocateAVSRewards(ctx sdk.Context) {
+	avsAddrs := make([]string, 0)
+	k.IterateAVSRewardPools(ctx, func(avsAddr string, _ types.AVSRewardPool) bool {
+		avsAddrs = append(avsAddrs, avsAddr)
+		return false
+	})
+	for _, avsAddr := range avsAddrs {
+		cc, writeCache := ctx.CacheContext()
+		if err := k.AllocateTokensToAVSOperators(cc, avsAddr); err != nil {
+			k.Logger().Error("failed to allocate avs rewards", "avs", avsAddr, "error", err)
+			continue
+		}
+		writeCache()
+	}
+}
+
+// AllocateTokensToAVSOperators distributes the reward pool of the AVS to the active operators
+// opted into the AVS in proportion to their active USD values. The rewards of each operator
+// are split according to its commission, and the rest is allocated to its stakers.
+func (k Keeper) AllocateTokensToAVSOperators(ctx sdk.Context, avsAddr string) error {
+	logger := k.Logger()
+	pool := k.GetAVSRewardPool(ctx, avsAddr)
+	if pool.Rewards.IsZero() {
+		return nil
+	}
+	operators, err := k.operatorKeeper.GetOptedInOperatorListByAVS(ctx, avsAddr)
+	if err != nil {
+		return err
+	}
+	operatorsPower, totalPower := make(map[string]math.LegacyDec), math.LegacyZeroDec()
+	for _, operator := range operators {
+		operatorAddr, err := sdk.AccAddressFromBech32(operator)
+		if err != nil || !k.operatorKeeper.IsEligible(ctx, operatorAddr, avsAddr) {
+			continue
+		}
+		usdValues, err := k.operatorKeeper.GetOperatorOptedUSDValue(ctx, avsAddr, operator)
+		if err != nil || !usdValues.ActiveUSDValue.IsPositive() {
+			continue
+		}
+		operatorsPower[operator] = usdValues.ActiveUSDValue
+		totalPower = totalPower.Add(usdValues.ActiveUSDValue)
+	}
+	if !totalPower.IsPositive() {
+		logger.Debug("no active operator of the avs; skipping", "avs", avsAddr)
+		return nil
+	}
+
+	remaining := pool.Rewards
+	for _, operator := range operators {
+		power, ok := operatorsPower[operator]
+		if !ok {
+			continue
+		}
+		// #nosec G703 // already validated above
+		operatorAddr, _ := sdk.AccAddressFromBech32(operator)
+		info, err := k.StakingKeeper.OperatorInfo(ctx, operator)
+		if err != nil {
+			logger.Error("failed to get operator info; skipping", "operator", operator, "error", err)
+			continue
+		}
+		reward := pool.Rewards.MulDecTruncate(power.QuoTruncate(totalPower))
+		commission := reward.MulDec(info.GetCommission().Rate)
+		shared := reward.Sub(commission)
+		// the rewards that can't be allocated to the stakers are kept in the pool
+		unallocated := k.allocateTokensToRewardPools(ctx, avsAddr, operatorAddr, shared)
+		allocated := reward.Sub(unallocated)
+
+		valAddr := sdk.ValAddress(operatorAddr)
+		currentCommission := k.GetValidatorAccumulatedCommission(ctx, valAddr)
+		currentCommission.Commission = currentCommission.Commission.Add(commission...)
+		k.SetValidatorAccumulatedCommission(ctx, valAddr, currentCommission)
+		// the allocated rewards of the stakers are paid out from the reward pools
+		outstanding := k.GetValidatorOutstandingRewards(ctx, valAddr)
+		outstanding.Rewards = outstanding.Rewards.Add(commission...)
+		k.SetValidatorOutstandingRewards(ctx, valAddr, outstanding)
+		remaining = remaining.Sub(allocated)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeAVSRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, allocated.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, operator),
+			sdk.NewAttribute(types.AttributeKeyAVSAddress, avsAddr),
+		))
+	}
+	pool.Rewards = remaining
+	k.SetAVSRewardPool(ctx, avsAddr, pool)
+	logger.Info("allocate avs rewards successfully", "avs", avsAddr, "remaining amount is", remaining.String())
+	return nil
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/testutil"
+	utiltx "github.com/ExocoreNetwork/exocore/testutil/tx"
+	"github.com/ExocoreNetwork/exocore/utils"
+	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	exominttypes "github.com/ExocoreNetwork/exocore/x/exomint/types"
+	"github.com/ExocoreNetwork/exocore/x/feedistribution/keeper"
+	"github.com/ExocoreNetwork/exocore/x/feedistribution/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	erc20types "github.com/evmos/evmos/v16/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestAVSRewards() {
+	k := suite.App.DistrKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	sender := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	amount := sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 2010))
+	err := suite.App.BankKeeper.MintCoins(suite.Ctx, exominttypes.ModuleName, amount)
+	suite.NoError(err)
+	err = suite.App.BankKeeper.SendCoinsFromModuleToAccount(suite.Ctx, exominttypes.ModuleName, sender, amount)
+	suite.NoError(err)
+
+	// the rewards can only be deposited for a registered AVS
+	_, err = msgServer.DepositAVSRewards(suite.Ctx, &types.MsgDepositAVSRewards{
+		Sender:     sender.String(),
+		AvsAddress: utiltx.GenerateAddress().String(),
+		Amount:     amount,
+	})
+	suite.ErrorIs(err, types.ErrNoSuchAVS)
+
+	avsAddr := avstypes.GenerateAVSAddr(avstypes.ChainIDWithoutRevision(suite.Ctx.ChainID()))
+	_, err = msgServer.DepositAVSRewards(suite.Ctx, &types.MsgDepositAVSRewards{
+		Sender:     sender.String(),
+		AvsAddress: avsAddr,
+		Amount:     amount,
+	})
+	suite.NoError(err)
+	res, err := k.AVSRewardPool(suite.Ctx, &types.QueryAVSRewardPoolRequest{AvsAddress: avsAddr})
+	suite.NoError(err)
+	suite.Equal(sdk.NewDecCoinsFromCoins(amount...), res.Rewards)
+
+	// the rewards are distributed to the operators by their active USD values, which are 101
+	// and 100, and then to their stakers.
+	k.AllocateAVSRewards(suite.Ctx)
+	pool := k.GetAVSRewardPool(suite.Ctx, avsAddr)
+	suite.True(pool.Rewards.AmountOf(utils.BaseDenom).LT(sdk.OneDec()))
+	for i, expected := range []int64{1009, 999} {
+		stakerID, _ := assetstypes.GetStakerIDAndAssetID(suite.ClientChains[0].LayerZeroChainID, suite.Operators[i], nil)
+		rewards, err := k.StakerRewards(suite.Ctx, &types.QueryStakerRewardsRequest{StakerId: stakerID})
+		suite.NoError(err)
+		suite.Equal(expected, rewards.Rewards.AmountOf(utils.BaseDenom).TruncateInt64())
+		// only the commission is credited to the outstanding rewards of the operator
+		valAddr := sdk.ValAddress(suite.Operators[i])
+		outstanding := k.GetValidatorOutstandingRewards(suite.Ctx, valAddr)
+		commission := k.GetValidatorAccumulatedCommission(suite.Ctx, valAddr)
+		suite.True(commission.Commission.IsEqual(outstanding.Rewards))
+	}
+	_, broken := keeper.AllInvariants(k)(suite.Ctx)
+	suite.False(broken)
+}
+
+func (suite *KeeperTestSuite) TestDepositConvertedERC20AVSRewards() {
+	k := suite.App.DistrKeeper
+	amount := sdkmath.NewInt(50)
+	// the ERC-20 tokens are converted by the erc20 module before being deposited as coins
+	token, err := testutil.DeployContract(
+		suite.Ctx, suite.App, suite.PrivKey, suite.QueryClientEVM, evmtypes.ERC20Contract, suite.Address, amount.BigInt(),
+	)
+	suite.NoError(err)
+	pair := erc20types.NewTokenPair(token, erc20types.CreateDenom(token.String()), erc20types.OWNER_EXTERNAL)
+	suite.App.Erc20Keeper.SetTokenPair(suite.Ctx, pair)
+	suite.App.Erc20Keeper.SetDenomMap(suite.Ctx, pair.Denom, pair.GetID())
+	suite.App.Erc20Keeper.SetERC20Map(suite.Ctx, token, pair.GetID())
+	_, err = suite.App.Erc20Keeper.ConvertERC20(suite.Ctx, &erc20types.MsgConvertERC20{
+		ContractAddress: token.Hex(),
+		Amount:          amount,
+		Receiver:        suite.AccAddress.String(),
+		Sender:          suite.Address.Hex(),
+	})
+	suite.NoError(err)
+
+	avsAddr := avstypes.GenerateAVSAddr(avstypes.ChainIDWithoutRevision(suite.Ctx.ChainID()))
+	coins := sdk.NewCoins(sdk.NewCoin(pair.Denom, amount))
+	_, err = keeper.NewMsgServerImpl(k).DepositAVSRewards(suite.Ctx, &types.MsgDepositAVSRewards{
+		Sender:     suite.AccAddress.String(),
+		AvsAddress: avsAddr,
+		Amount:     coins,
+	})
+	suite.NoError(err)
+	suite.Equal(sdk.NewDecCoinsFromCoins(coins...), k.GetAVSRewardPool(suite.Ctx, avsAddr).Rewards)
+	// the converted tokens are neither kept by the sender nor restored to the ERC-20 balance
+	suite.True(suite.App.BankKeeper.GetBalance(suite.Ctx, suite.AccAddress, pair.Denom).IsZero())
+	balance := suite.App.Erc20Keeper.BalanceOf(suite.Ctx, evmtypes.ERC20Contract.ABI, token, suite.Address)
+	suite.Zero(balance.Sign())
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ExocoreNetwork/exocore/x/feedistribution/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FundCommunityPool sends the coins from the depositor to the module account, and adds them to
+// the community pool.
+func (k Keeper) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, depositor sdk.AccAddress) error {
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, amount); err != nil {
+		return err
+	}
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...)
+	k.SetFeePool(ctx, feePool)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFundCommunityPool,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+	))
+	return nil
+}
+
+// DistributeFromCommunityPool deducts the coins from the community pool, and sends them from
+// the module account to the recipient.
+func (k Keeper) DistributeFromCommunityPool(ctx sdk.Context, amount sdk.Coins, recipient sdk.AccAddress) error {
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return errorsmod.Wrapf(types.ErrBlockedWithdrawAddress, "address is %s", recipient)
+	}
+	feePool := k.GetFeePool(ctx)
+	remaining, hasNeg := feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(amount...))
+	if hasNeg {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientCommunityPool, "requested %s, available %s", amount, feePool.CommunityPool,
+		)
+	}
+	feePool.CommunityPool = remaining
+	k.SetFeePool(ctx, feePool)
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amount); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCommunityPoolSpend,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+	))
+	return nil
+}
@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	utiltx "github.com/ExocoreNetwork/exocore/testutil/tx"
+	"github.com/ExocoreNetwork/exocore/utils"
+	exominttypes "github.com/ExocoreNetwork/exocore/x/exomint/types"
+	"github.com/ExocoreNetwork/exocore/x/feedistribution/keeper"
+	"github.com/ExocoreNetwork/exocore/x/feedistribution/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (suite *KeeperTestSuite) TestCommunityPool() {
+	msgServer := keeper.NewMsgServerImpl(suite.App.DistrKeeper)
+	depositor := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	amount := sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1000))
+	err := suite.App.BankKeeper.MintCoins(suite.Ctx, exominttypes.ModuleName, amount)
+	suite.NoError(err)
+	err = suite.App.BankKeeper.SendCoinsFromModuleToAccount(suite.Ctx, exominttypes.ModuleName, depositor, amount)
+	suite.NoError(err)
+	poolBefore := suite.App.DistrKeeper.GetFeePool(suite.Ctx).CommunityPool
+
+	_, err = msgServer.FundCommunityPool(suite.Ctx, &types.MsgFundCommunityPool{
+		Amount:    amount,
+		Depositor: depositor.String(),
+	})
+	suite.NoError(err)
+	pool := suite.App.DistrKeeper.GetFeePool(suite.Ctx).CommunityPool
+	suite.Equal(poolBefore.Add(sdk.NewDecCoinsFromCoins(amount...)...), pool)
+	suite.True(suite.App.BankKeeper.GetBalance(suite.Ctx, depositor, utils.BaseDenom).IsZero())
+
+	// only the authority can spend the community pool
+	recipient := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	spend := sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 400))
+	_, err = msgServer.CommunityPoolSpend(suite.Ctx, &types.MsgCommunityPoolSpend{
+		Authority: depositor.String(),
+		Recipient: recipient.String(),
+		Amount:    spend,
+	})
+	suite.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.CommunityPoolSpend(suite.Ctx, &types.MsgCommunityPoolSpend{
+		Authority: suite.App.DistrKeeper.GetAuthority(),
+		Recipient: recipient.String(),
+		Amount:    spend,
+	})
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(400), suite.App.BankKeeper.GetBalance(suite.Ctx, recipient, utils.BaseDenom).Amount)
+	pool = suite.App.DistrKeeper.GetFeePool(suite.Ctx).CommunityPool
+	suite.Equal(poolBefore.Add(sdk.NewInt64DecCoin(utils.BaseDenom, 600)), pool)
+
+	// the community pool can't be overspent
+	_, err = msgServer.CommunityPoolSpend(suite.Ctx, &types.MsgCommunityPoolSpend{
+		Authority: suite.App.DistrKeeper.GetAuthority(),
+		Recipient: recipient.String(),
+		Amount:    sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, pool.AmountOf(utils.BaseDenom).TruncateInt().AddRaw(1))),
+	})
+	suite.ErrorIs(err, types.ErrInsufficientCommunityPool)
+}
+
+func (suite *KeeperTestSuite) TestModuleAccountInvariant() {
+	k := suite.App.DistrKeeper
+	_, broken := keeper.AllInvariants(k)(suite.Ctx)
+	suite.False(broken)
+
+	// the unsettled rewards of the stakers must be covered by the module account
+	operator := suite.Operators[0]
+	rewards := sdk.NewDecCoins(sdk.NewInt64DecCoin(utils.BaseDenom, 1010))
+	k.AddOperatorAssetRewards(suite.Ctx, operator, suite.AssetIDs[0], rewards)
+	_, broken = keeper.ModuleAccountInvariant(k)(suite.Ctx)
+	suite.True(broken)
+	suite.fundModule(sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1010)))
+	_, broken = keeper.ModuleAccountInvariant(k)(suite.Ctx)
+	suite.False(broken)
+
+	// the rewards moved to the historical rewards are still covered, which is done by
+	// settling a staker without delegation to end the current period of the pool
+	err := k.SettleStakerRewards(suite.Ctx, "0xa_0x65", operator, suite.AssetIDs[0])
+	suite.NoError(err)
+	current, _ := k.GetOperatorAssetCurrentRewards(suite.Ctx, operator, suite.AssetIDs[0])
+	suite.True(current.Rewards.IsZero())
+	_, broken = keeper.ModuleAccountInvariant(k)(suite.Ctx)
+	suite.False(broken)
+
+	// the community pool must be covered by the module account
+	k.SetFeePool(suite.Ctx, &types.FeePool{CommunityPool: rewards})
+	_, broken = keeper.ModuleAccountInvariant(k)(suite.Ctx)
+	suite.True(broken)
+}
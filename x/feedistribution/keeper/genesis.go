@@ -1,6 +1,10 @@
 package keeper
 
 import (
+	"sort"
+
+	"cosmossdk.io/math"
+	delegationtypes "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	"github.com/ExocoreNetwork/exocore/x/feedistribution/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -15,11 +19,209 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		// is not running. it means that the genesis file is malformed.
 		panic("not found the epoch info")
 	}
+	feePool := genState.FeePool
+	k.SetFeePool(ctx, &feePool)
+	store := ctx.KVStore(k.storeKey)
+	// the addresses have been validated by the genesis validation, so the errors are
+	// ignored below.
+	for _, info := range genState.WithdrawInfos {
+		addr, _ := sdk.AccAddressFromBech32(info.Address)
+		withdrawAddr, _ := sdk.AccAddressFromBech32(info.WithdrawAddress)
+		store.Set(types.GetWithdrawAddrKey(addr), withdrawAddr.Bytes())
+	}
+	for _, record := range genState.OperatorOutstandingRewards {
+		operator, _ := sdk.AccAddressFromBech32(record.OperatorAddress)
+		k.SetValidatorOutstandingRewards(ctx, sdk.ValAddress(operator), types.ValidatorOutstandingRewards{
+			Rewards: record.OutstandingRewards,
+		})
+	}
+	for _, record := range genState.OperatorAccumulatedCommissions {
+		operator, _ := sdk.AccAddressFromBech32(record.OperatorAddress)
+		k.SetValidatorAccumulatedCommission(ctx, sdk.ValAddress(operator), record.Accumulated)
+	}
+	for _, record := range genState.OperatorAssetHistoricalRewards {
+		operator, _ := sdk.AccAddressFromBech32(record.OperatorAddress)
+		k.SetOperatorAssetHistoricalRewards(ctx, operator, record.AssetID, record.Period, record.Rewards)
+	}
+	for _, record := range genState.OperatorAssetCurrentRewards {
+		operator, _ := sdk.AccAddressFromBech32(record.OperatorAddress)
+		k.SetOperatorAssetCurrentRewards(ctx, operator, record.AssetID, record.Rewards)
+	}
+	// the total stake of each reward pool is the sum of the stakes of its starting infos.
+	totalStakes := make(map[string]math.LegacyDec)
+	for _, record := range genState.StakerStartingInfos {
+		operator, _ := sdk.AccAddressFromBech32(record.OperatorAddress)
+		k.SetStakerStartingInfo(ctx, record.StakerID, operator, record.AssetID, record.StartingInfo)
+		key := string(types.GetOperatorAssetKey(operator, record.AssetID))
+		if total, ok := totalStakes[key]; ok {
+			totalStakes[key] = total.Add(record.StartingInfo.Stake)
+		} else {
+			totalStakes[key] = record.StartingInfo.Stake
+		}
+	}
+	keys := make([]string, 0, len(totalStakes))
+	for key := range totalStakes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		operator, assetID, err := types.ParseOperatorAssetKey([]byte(key))
+		if err != nil {
+			panic(err)
+		}
+		k.SetOperatorAssetTotalStake(ctx, operator, assetID, totalStakes[key])
+	}
+	for _, record := range genState.StakerOutstandingRewards {
+		k.SetStakerRewards(ctx, record.StakerID, types.StakerOutstandingRewards{
+			Rewards: record.OutstandingRewards,
+		})
+	}
+	for _, record := range genState.AVSRewardPools {
+		k.SetAVSRewardPool(ctx, record.AVSAddress, record.Pool)
+	}
+	// start tracking the rewards of the existing delegations that aren't tracked by the
+	// imported state, which requires the delegation module to be initialized first.
+	if k.delegationKeeper != nil {
+		err := k.delegationKeeper.IterateDelegations(ctx, nil,
+			func(keys *delegationtypes.SingleDelegationInfoReq, _ *delegationtypes.DelegationAmounts) (bool, error) {
+				operator, err := sdk.AccAddressFromBech32(keys.OperatorAddr)
+				if err != nil {
+					return true, err
+				}
+				if _, found := k.GetStakerStartingInfo(ctx, keys.StakerID, operator, keys.AssetID); found {
+					return false, nil
+				}
+				return false, k.SettleStakerRewards(ctx, keys.StakerID, operator, keys.AssetID)
+			})
+		if err != nil {
+			panic(err)
+		}
+	}
+	// the rewards can't be paid out if the balance of the module account doesn't cover them,
+	// which means that the genesis file is inconsistent with the bank module.
+	if msg, broken := ModuleAccountInvariant(k)(ctx); broken {
+		panic(msg)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.FeePool = *k.GetFeePool(ctx)
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.WithdrawAddrPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		addr := sdk.AccAddress(parseLengthPrefixed(iterator.Key()[len(types.WithdrawAddrPrefix):]))
+		genesis.WithdrawInfos = append(genesis.WithdrawInfos, types.WithdrawAddressInfo{
+			Address:         addr.String(),
+			WithdrawAddress: sdk.AccAddress(iterator.Value()).String(),
+		})
+	}
+	iterator.Close()
+
+	iterator = sdk.KVStorePrefixIterator(store, types.ValidatorOutstandingRewardsPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		operator := sdk.AccAddress(parseLengthPrefixed(iterator.Key()[len(types.ValidatorOutstandingRewardsPrefix):]))
+		var rewards types.ValidatorOutstandingRewards
+		k.cdc.MustUnmarshal(iterator.Value(), &rewards)
+		genesis.OperatorOutstandingRewards = append(genesis.OperatorOutstandingRewards, types.OperatorOutstandingRewardsRecord{
+			OperatorAddress:    operator.String(),
+			OutstandingRewards: rewards.Rewards,
+		})
+	}
+	iterator.Close()
+
+	iterator = sdk.KVStorePrefixIterator(store, types.ValidatorAccumulatedCommissionPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		operator := sdk.AccAddress(parseLengthPrefixed(iterator.Key()[len(types.ValidatorAccumulatedCommissionPrefix):]))
+		var commission types.ValidatorAccumulatedCommission
+		k.cdc.MustUnmarshal(iterator.Value(), &commission)
+		genesis.OperatorAccumulatedCommissions = append(genesis.OperatorAccumulatedCommissions, types.OperatorAccumulatedCommissionRecord{
+			OperatorAddress: operator.String(),
+			Accumulated:     commission,
+		})
+	}
+	iterator.Close()
+
+	iterator = sdk.KVStorePrefixIterator(store, types.OperatorAssetHistoricalRewardsPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.OperatorAssetHistoricalRewardsPrefix):]
+		// the period is appended to the key of the operator and asset
+		operator, assetID, err := types.ParseOperatorAssetKey(key[:len(key)-8])
+		if err != nil {
+			panic(err)
+		}
+		var rewards types.ValidatorHistoricalRewards
+		k.cdc.MustUnmarshal(iterator.Value(), &rewards)
+		genesis.OperatorAssetHistoricalRewards = append(genesis.OperatorAssetHistoricalRewards, types.OperatorAssetHistoricalRewardsRecord{
+			OperatorAddress: operator.String(),
+			AssetID:         assetID,
+			Period:          sdk.BigEndianToUint64(key[len(key)-8:]),
+			Rewards:         rewards,
+		})
+	}
+	iterator.Close()
+
+	iterator = sdk.KVStorePrefixIterator(store, types.OperatorAssetCurrentRewardsPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		operator, assetID, err := types.ParseOperatorAssetKey(iterator.Key()[len(types.OperatorAssetCurrentRewardsPrefix):])
+		if err != nil {
+			panic(err)
+		}
+		var rewards types.ValidatorCurrentRewards
+		k.cdc.MustUnmarshal(iterator.Value(), &rewards)
+		genesis.OperatorAssetCurrentRewards = append(genesis.OperatorAssetCurrentRewards, types.OperatorAssetCurrentRewardsRecord{
+			OperatorAddress: operator.String(),
+			AssetID:         assetID,
+			Rewards:         rewards,
+		})
+	}
+	iterator.Close()
+
+	iterator = sdk.KVStorePrefixIterator(store, types.StakerStartingInfoPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.StakerStartingInfoPrefix):]
+		stakerID := parseLengthPrefixed(key)
+		operator, assetID, err := types.ParseOperatorAssetKey(key[1+len(stakerID):])
+		if err != nil {
+			panic(err)
+		}
+		var info types.StakerStartingInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &info)
+		genesis.StakerStartingInfos = append(genesis.StakerStartingInfos, types.StakerStartingInfoRecord{
+			StakerID:        string(stakerID),
+			OperatorAddress: operator.String(),
+			AssetID:         assetID,
+			StartingInfo:    info,
+		})
+	}
+	iterator.Close()
+
+	iterator = sdk.KVStorePrefixIterator(store, types.StakerOutstandingRewardsPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		stakerID := parseLengthPrefixed(iterator.Key()[len(types.StakerOutstandingRewardsPrefix):])
+		var rewards types.StakerOutstandingRewards
+		k.cdc.MustUnmarshal(iterator.Value(), &rewards)
+		genesis.StakerOutstandingRewards = append(genesis.StakerOutstandingRewards, types.StakerOutstandingRewardsRecord{
+			StakerID:           string(stakerID),
+			OutstandingRewards: rewards.Rewards,
+		})
+	}
+	iterator.Close()
+
+	k.IterateAVSRewardPools(ctx, func(avsAddr string, pool types.AVSRewardPool) bool {
+		genesis.AVSRewardPools = append(genesis.AVSRewardPools, types.AVSRewardPoolRecord{
+			AVSAddress: avsAddr,
+			Pool:       pool,
+		})
+		return false
+	})
 	return genesis
 }
+
+// parseLengthPrefixed returns the bytes prefixed by their length at the start of the key.
+func parseLengthPrefixed(key []byte) []byte {
+	return key[1 : 1+int(key[0])]
+}
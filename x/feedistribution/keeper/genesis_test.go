@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	utiltx "github.com/ExocoreNetwork/exocore/testutil/tx"
+	"github.com/ExocoreNetwork/exocore/utils"
+	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
+	"github.com/ExocoreNetwork/exocore/x/feedistribution/keeper"
+	"github.com/ExocoreNetwork/exocore/x/feedistribution/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestExportImportGenesis() {
+	k := suite.App.DistrKeeper
+	operator := suite.Operators[0]
+	assetID := suite.AssetIDs[0]
+	lzID := suite.ClientChains[0].LayerZeroChainID
+	genesisStakerID, _ := assetstypes.GetStakerIDAndAssetID(lzID, sdk.AccAddress(operator.Bytes()), nil)
+	rewards := sdk.NewDecCoins(sdk.NewInt64DecCoin(utils.BaseDenom, 1000))
+	// the module account backs the community pool, the commission, the reward pools of the
+	// operator and the AVS, and the settled rewards of the staker.
+	suite.fundModule(sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 5000)))
+
+	// the settlement ends a period with rewards, and the rewards allocated afterwards are
+	// kept as the current rewards of the pool.
+	k.AddOperatorAssetRewards(suite.Ctx, operator, assetID, rewards)
+	suite.NoError(k.SettleStakerRewards(suite.Ctx, genesisStakerID, operator, assetID))
+	k.AddOperatorAssetRewards(suite.Ctx, operator, assetID, rewards)
+	k.SetValidatorOutstandingRewards(suite.Ctx, sdk.ValAddress(operator), types.ValidatorOutstandingRewards{Rewards: rewards})
+	k.SetValidatorAccumulatedCommission(suite.Ctx, sdk.ValAddress(operator), types.ValidatorAccumulatedCommission{Commission: rewards})
+	k.SetFeePool(suite.Ctx, &types.FeePool{CommunityPool: rewards})
+	withdrawAddr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	suite.NoError(k.SetWithdrawAddr(suite.Ctx, operator, withdrawAddr))
+	avsAddr := utiltx.GenerateAddress().Hex()
+	k.SetAVSRewardPool(suite.Ctx, avsAddr, types.AVSRewardPool{Rewards: rewards})
+	_, broken := keeper.AllInvariants(k)(suite.Ctx)
+	suite.False(broken)
+
+	totalStake := k.GetOperatorAssetTotalStake(suite.Ctx, operator, assetID)
+	genesis := k.ExportGenesis(suite.Ctx)
+	suite.NoError(genesis.Validate())
+	suite.NotEmpty(genesis.OperatorAssetHistoricalRewards)
+	suite.NotEmpty(genesis.OperatorAssetCurrentRewards)
+	suite.NotEmpty(genesis.StakerStartingInfos)
+	suite.NotEmpty(genesis.StakerOutstandingRewards)
+	suite.Len(genesis.AVSRewardPools, 1)
+	suite.Len(genesis.WithdrawInfos, 1)
+
+	// export the whole app and start a new one from it
+	app, ctx := suite.ExportAndImport()
+	suite.Equal(genesis, app.DistrKeeper.ExportGenesis(ctx))
+	msg, broken := keeper.AllInvariants(app.DistrKeeper)(ctx)
+	suite.False(broken, msg)
+	// the total stake of the pool is derived from the starting infos
+	suite.True(totalStake.Equal(app.DistrKeeper.GetOperatorAssetTotalStake(ctx, operator, assetID)))
+	suite.Equal(withdrawAddr, app.DistrKeeper.GetWithdrawAddr(ctx, operator))
+	suite.Equal(rewards, app.DistrKeeper.GetAVSRewardPool(ctx, avsAddr).Rewards)
+
+	// the invariant is broken instead of panicking if the referenced rewards are missing
+	info, found := app.DistrKeeper.GetStakerStartingInfo(ctx, genesisStakerID, operator, assetID)
+	suite.True(found)
+	app.DistrKeeper.DeleteOperatorAssetHistoricalRewards(ctx, operator, assetID, info.PreviousPeriod)
+	suite.NotPanics(func() {
+		_, broken = keeper.ModuleAccountInvariant(app.DistrKeeper)(ctx)
+	})
+	suite.True(broken)
+
+	// the imported state can't be paid out without the balance of the module account
+	moduleAddr := app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	balances := app.BankKeeper.GetAllBalances(ctx, moduleAddr)
+	suite.NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, balances))
+	suite.Panics(func() { app.DistrKeeper.InitGenesis(ctx, *genesis) })
+}
@@ -35,10 +35,14 @@ func (wrapper EpochsHooksWrapper) AfterEpochEnd(ctx sdk.Context, epochIdentifier
 		logger.Info(
 			"AfterEpochEnd of distribution",
 		)
-		err := wrapper.keeper.AllocateTokens(ctx, previousTotalPower.Int64())
-		if err != nil {
+		// the failed allocation is discarded without blocking the AVS rewards
+		cc, writeCache := ctx.CacheContext()
+		if err := wrapper.keeper.AllocateTokens(cc, previousTotalPower.Int64()); err != nil {
 			logger.Error("failed to allocate tokens", "err", err)
-			return
+		} else {
+			writeCache()
 		}
+		// the rewards deposited by the AVSs are distributed to their own operators
+		wrapper.keeper.AllocateAVSRewards(ctx)
 	}
 }
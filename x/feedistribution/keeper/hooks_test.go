@@ -14,7 +14,6 @@ import (
 	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
 	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
 	delegationtypes "github.com/ExocoreNetwork/exocore/x/delegation/types"
-	operatorkeeper "github.com/ExocoreNetwork/exocore/x/operator/keeper"
 	operatortypes "github.com/ExocoreNetwork/exocore/x/operator/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -48,6 +47,9 @@ func (suite *KeeperTestSuite) TestEpochHooks() {
 		valBz := validatorDetail.GetOperator()
 		currentRewards := suite.App.DistrKeeper.GetValidatorOutstandingRewards(suite.Ctx, valBz)
 		suite.Require().NotNil(currentRewards)
+		// only the commission is credited to the outstanding rewards of the operator
+		commission := suite.App.DistrKeeper.GetValidatorAccumulatedCommission(suite.Ctx, valBz)
+		suite.Require().True(commission.Commission.IsEqual(currentRewards.Rewards))
 	}
 }
 
@@ -151,7 +153,7 @@ func (suite *KeeperTestSuite) prepare() {
 	err = suite.App.DelegationKeeper.DelegateTo(suite.Ctx, delegationParams)
 	suite.NoError(err)
 	totalAmount := amount.Add(additionalAmount)
-	totalAmountInUSD := operatorkeeper.CalculateUSDValue(
+	totalAmountInUSD := operatortypes.CalculateUSDValue(
 		totalAmount,
 		sdkmath.NewInt(1), // asset price
 		assetDecimals,
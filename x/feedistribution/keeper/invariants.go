@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/ExocoreNetwork/exocore/x/feedistribution/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all the invariants of the fee distribution module.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "nonnegative-outstanding", NonNegativeOutstandingInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(k))
+}
+
+// AllInvariants runs all the invariants of the fee distribution module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := NonNegativeOutstandingInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return ModuleAccountInvariant(k)(ctx)
+	}
+}
+
+// NonNegativeOutstandingInvariant checks that the outstanding rewards of the operators and
+// the stakers, and the accumulated commission of the operators are never negative.
+func NonNegativeOutstandingInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+		store := ctx.KVStore(k.storeKey)
+
+		iterator := sdk.KVStorePrefixIterator(store, types.ValidatorOutstandingRewardsPrefix)
+		for ; iterator.Valid(); iterator.Next() {
+			var rewards types.ValidatorOutstandingRewards
+			k.cdc.MustUnmarshal(iterator.Value(), &rewards)
+			if rewards.Rewards.IsAnyNegative() {
+				count++
+				msg += fmt.Sprintf("\t%x has negative outstanding rewards: %v\n", iterator.Key(), rewards.Rewards)
+			}
+		}
+		iterator.Close()
+
+		iterator = sdk.KVStorePrefixIterator(store, types.ValidatorAccumulatedCommissionPrefix)
+		for ; iterator.Valid(); iterator.Next() {
+			var commission types.ValidatorAccumulatedCommission
+			k.cdc.MustUnmarshal(iterator.Value(), &commission)
+			if commission.Commission.IsAnyNegative() {
+				count++
+				msg += fmt.Sprintf("\t%x has negative accumulated commission: %v\n", iterator.Key(), commission.Commission)
+			}
+		}
+		iterator.Close()
+
+		iterator = sdk.KVStorePrefixIterator(store, types.StakerOutstandingRewardsPrefix)
+		for ; iterator.Valid(); iterator.Next() {
+			var rewards types.StakerOutstandingRewards
+			k.cdc.MustUnmarshal(iterator.Value(), &rewards)
+			if rewards.Rewards.IsAnyNegative() {
+				count++
+				msg += fmt.Sprintf("\t%x has negative outstanding rewards: %v\n", iterator.Key(), rewards.Rewards)
+			}
+		}
+		iterator.Close()
+
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "nonnegative outstanding",
+			fmt.Sprintf("found %d operators or stakers with negative rewards or commission\n%s", count, msg),
+		), broken
+	}
+}
+
+// ModuleAccountInvariant checks that the balance of the module account covers the community
+// pool, the reward pools of the AVSs, the accumulated commission of the operators and the
+// rewards of the stakers, including the ones that haven't been settled yet. The balance can exceed them by the truncated dust.
+func ModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := k.GetFeePool(ctx).CommunityPool
+		store := ctx.KVStore(k.storeKey)
+
+		iterator := sdk.KVStorePrefixIterator(store, types.ValidatorAccumulatedCommissionPrefix)
+		for ; iterator.Valid(); iterator.Next() {
+			var commission types.ValidatorAccumulatedCommission
+			k.cdc.MustUnmarshal(iterator.Value(), &commission)
+			expected = expected.Add(commission.Commission...)
+		}
+		iterator.Close()
+
+		iterator = sdk.KVStorePrefixIterator(store, types.StakerOutstandingRewardsPrefix)
+		for ; iterator.Valid(); iterator.Next() {
+			var rewards types.StakerOutstandingRewards
+			k.cdc.MustUnmarshal(iterator.Value(), &rewards)
+			expected = expected.Add(rewards.Rewards...)
+		}
+		iterator.Close()
+
+		iterator = sdk.KVStorePrefixIterator(store, types.AVSRewardPoolPrefix)
+		for ; iterator.Valid(); iterator.Next() {
+			var pool types.AVSRewardPool
+			k.cdc.MustUnmarshal(iterator.Value(), &pool)
+			expected = expected.Add(pool.Rewards...)
+		}
+		iterator.Close()
+
+		// the rewards of the reward pools that haven't been moved to the historical rewards
+		iterator = sdk.KVStorePrefixIterator(store, types.OperatorAssetCurrentRewardsPrefix)
+		for ; iterator.Valid(); iterator.Next() {
+			var current types.ValidatorCurrentRewards
+			k.cdc.MustUnmarshal(iterator.Value(), &current)
+			expected = expected.Add(current.Rewards...)
+		}
+		iterator.Close()
+
+		// the rewards of the stakers that have been moved to the historical rewards but
+		// haven't been settled
+		startingInfoStore := prefix.NewStore(store, types.StakerStartingInfoPrefix)
+		iterator = startingInfoStore.Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			key := iterator.Key()
+			stakerIDLen := int(key[0])
+			operator, assetID, err := types.ParseOperatorAssetKey(key[1+stakerIDLen:])
+			if err != nil {
+				iterator.Close()
+				return sdk.FormatInvariant(types.ModuleName, "module account coins", err.Error()), true
+			}
+			var info types.StakerStartingInfo
+			k.cdc.MustUnmarshal(iterator.Value(), &info)
+			// the rewards can't be calculated if the referenced records are missing, which
+			// might be caused by an inconsistent genesis state.
+			current, foundCurrent := k.GetOperatorAssetCurrentRewards(ctx, operator, assetID)
+			starting, foundStarting := k.GetOperatorAssetHistoricalRewards(ctx, operator, assetID, info.PreviousPeriod)
+			ending, foundEnding := k.GetOperatorAssetHistoricalRewards(ctx, operator, assetID, current.Period-1)
+			difference, hasNeg := ending.CumulativeRewardRatio.SafeSub(starting.CumulativeRewardRatio)
+			if !foundCurrent || !foundStarting || !foundEnding || hasNeg {
+				iterator.Close()
+				return sdk.FormatInvariant(
+					types.ModuleName, "module account coins",
+					fmt.Sprintf("\tinvalid rewards of the starting info %x\n", key),
+				), true
+			}
+			expected = expected.Add(difference.MulDecTruncate(info.Stake)...)
+		}
+		iterator.Close()
+
+		moduleAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
+		balance := sdk.NewDecCoinsFromCoins(k.bankKeeper.GetAllBalances(ctx, moduleAddr)...)
+		_, hasNeg := balance.SafeSub(expected)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "module account coins",
+			fmt.Sprintf("\texpected at least the coins: %s\n"+
+				"\tdistribution module account coins: %s\n",
+				expected, balance,
+			),
+		), hasNeg
+	}
+}
@@ -19,10 +19,15 @@ type (
 		logger   log.Logger
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
-		authority    string
-		authKeeper   types.AccountKeeper
-		bankKeeper   types.BankKeeper
-		epochsKeeper types.EpochsKeeper
+		authority        string
+		authKeeper       types.AccountKeeper
+		bankKeeper       types.BankKeeper
+		epochsKeeper     types.EpochsKeeper
+		assetsKeeper     types.AssetsKeeper
+		delegationKeeper types.DelegationKeeper
+		oracleKeeper     types.OracleKeeper
+		operatorKeeper   types.OperatorKeeper
+		avsKeeper        types.AVSKeeper
 
 		feeCollectorName string
 
@@ -39,6 +44,11 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	stakingkeeper stakingkeeper.Keeper,
 	epochKeeper types.EpochsKeeper,
+	assetsKeeper types.AssetsKeeper,
+	delegationKeeper types.DelegationKeeper,
+	oracleKeeper types.OracleKeeper,
+	operatorKeeper types.OperatorKeeper,
+	avsKeeper types.AVSKeeper,
 ) Keeper {
 	// ensure distribution module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -57,6 +67,11 @@ func NewKeeper(
 		authKeeper:       accountKeeper,
 		bankKeeper:       bankKeeper,
 		epochsKeeper:     epochKeeper,
+		assetsKeeper:     assetsKeeper,
+		delegationKeeper: delegationKeeper,
+		oracleKeeper:     oracleKeeper,
+		operatorKeeper:   operatorKeeper,
+		avsKeeper:        avsKeeper,
 		feeCollectorName: feeCollectorName,
 		StakingKeeper:    stakingkeeper,
 	}
@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ExocoreNetwork/exocore/x/feedistribution/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) DepositAVSRewards(goCtx context.Context, req *types.MsgDepositAVSRewards) (*types.MsgDepositAVSRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// #nosec G703 // already validated in ValidateBasic
+	sender, _ := sdk.AccAddressFromBech32(req.Sender)
+	if err := k.Keeper.DepositAVSRewards(ctx, sender, req.AvsAddress, req.Amount); err != nil {
+		return nil, err
+	}
+	return &types.MsgDepositAVSRewardsResponse{}, nil
+}
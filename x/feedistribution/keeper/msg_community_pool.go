@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ExocoreNetwork/exocore/x/feedistribution/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (k msgServer) FundCommunityPool(goCtx context.Context, req *types.MsgFundCommunityPool) (*types.MsgFundCommunityPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// #nosec G703 // already validated in ValidateBasic
+	depositor, _ := sdk.AccAddressFromBech32(req.Depositor)
+	if err := k.Keeper.FundCommunityPool(ctx, req.Amount, depositor); err != nil {
+		return nil, err
+	}
+	return &types.MsgFundCommunityPoolResponse{}, nil
+}
+
+func (k msgServer) CommunityPoolSpend(goCtx context.Context, req *types.MsgCommunityPoolSpend) (*types.MsgCommunityPoolSpendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// the coins of the community pool can only be spent by the authority on all chains
+	if k.authority != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf(
+			"invalid authority; expected %s, got %s",
+			k.authority, req.Authority,
+		)
+	}
+	// #nosec G703 // already validated in ValidateBasic
+	recipient, _ := sdk.AccAddressFromBech32(req.Recipient)
+	if err := k.DistributeFromCommunityPool(ctx, req.Amount, recipient); err != nil {
+		return nil, err
+	}
+	k.Logger().Info("transferred from the community pool", "amount", req.Amount.String(), "recipient", req.Recipient)
+	return &types.MsgCommunityPoolSpendResponse{}, nil
+}
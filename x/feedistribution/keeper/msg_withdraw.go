@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ExocoreNetwork/exocore/x/feedistribution/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SetWithdrawAddress(goCtx context.Context, req *types.MsgSetWithdrawAddress) (*types.MsgSetWithdrawAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// #nosec G703 // already validated in ValidateBasic
+	addr, _ := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	// #nosec G703 // already validated in ValidateBasic
+	withdrawAddr, _ := sdk.AccAddressFromBech32(req.WithdrawAddress)
+	if err := k.SetWithdrawAddr(ctx, addr, withdrawAddr); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetWithdrawAddressResponse{}, nil
+}
+
+func (k msgServer) WithdrawStakerReward(goCtx context.Context, req *types.MsgWithdrawStakerReward) (*types.MsgWithdrawStakerRewardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// #nosec G703 // already validated in ValidateBasic
+	stakerAddr, _ := sdk.AccAddressFromBech32(req.StakerAddress)
+	amount, err := k.Keeper.WithdrawStakerReward(ctx, stakerAddr, req.ClientChainID)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgWithdrawStakerRewardResponse{Amount: amount}, nil
+}
+
+func (k msgServer) WithdrawOperatorCommission(goCtx context.Context, req *types.MsgWithdrawOperatorCommission) (*types.MsgWithdrawOperatorCommissionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// #nosec G703 // already validated in ValidateBasic
+	operatorAddr, _ := sdk.AccAddressFromBech32(req.OperatorAddress)
+	amount, err := k.Keeper.WithdrawOperatorCommission(ctx, operatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgWithdrawOperatorCommissionResponse{Amount: amount}, nil
+}
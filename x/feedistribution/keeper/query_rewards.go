@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ExocoreNetwork/exocore/x/feedistribution/types"
+)
+
+// StakerRewards returns the pending rewards of the staker. The rewards of its delegations are
+// settled in a cached context, which is discarded, so that the unsettled rewards are included.
+func (k Keeper) StakerRewards(goCtx context.Context, req *types.QueryStakerRewardsRequest) (*types.QueryStakerRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.StakerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty staker id")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	cc, _ := ctx.CacheContext()
+	if err := k.SettleAllStakerRewards(cc, req.StakerId); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	rewards := k.GetStakerRewards(cc, req.StakerId)
+	return &types.QueryStakerRewardsResponse{Rewards: rewards.Rewards}, nil
+}
+
+// AllStakerRewards returns the outstanding rewards of all the stakers.
+func (k Keeper) AllStakerRewards(goCtx context.Context, req *types.QueryAllStakerRewardsRequest) (*types.QueryAllStakerRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	res := make([]types.StakerRewards, 0)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StakerOutstandingRewardsPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var rewards types.StakerOutstandingRewards
+		// don't use MustUnmarshal to not panic for queries
+		if err := k.cdc.Unmarshal(value, &rewards); err != nil {
+			return err
+		}
+		// the key is the length-prefixed staker id
+		res = append(res, types.StakerRewards{
+			StakerID: string(key[1:]),
+			Rewards:  rewards.Rewards,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAllStakerRewardsResponse{
+		StakerRewards: res,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// OperatorOutstandingRewards returns the outstanding rewards of the operator.
+func (k Keeper) OperatorOutstandingRewards(
+	goCtx context.Context, req *types.QueryOperatorOutstandingRewardsRequest,
+) (*types.QueryOperatorOutstandingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	operator, err := sdk.AccAddressFromBech32(req.OperatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rewards := k.GetValidatorOutstandingRewards(ctx, sdk.ValAddress(operator))
+	return &types.QueryOperatorOutstandingRewardsResponse{Rewards: rewards}, nil
+}
+
+// OperatorCommission returns the accumulated commission of the operator.
+func (k Keeper) OperatorCommission(
+	goCtx context.Context, req *types.QueryOperatorCommissionRequest,
+) (*types.QueryOperatorCommissionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	operator, err := sdk.AccAddressFromBech32(req.OperatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	commission := k.GetValidatorAccumulatedCommission(ctx, sdk.ValAddress(operator))
+	return &types.QueryOperatorCommissionResponse{Commission: commission}, nil
+}
+
+// CommunityPool returns the coins of the community pool.
+func (k Keeper) CommunityPool(goCtx context.Context, req *types.QueryCommunityPoolRequest) (*types.QueryCommunityPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryCommunityPoolResponse{Pool: k.GetFeePool(ctx).CommunityPool}, nil
+}
+
+// AVSRewardPool returns the rewards deposited by the AVS that haven't been distributed.
+func (k Keeper) AVSRewardPool(goCtx context.Context, req *types.QueryAVSRewardPoolRequest) (*types.QueryAVSRewardPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if !common.IsHexAddress(req.AvsAddress) {
+		return nil, status.Error(codes.InvalidArgument, "invalid avs address")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryAVSRewardPoolResponse{Rewards: k.GetAVSRewardPool(ctx, req.AvsAddress).Rewards}, nil
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/utils"
+	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
+	"github.com/ExocoreNetwork/exocore/x/feedistribution/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (suite *KeeperTestSuite) TestQueryStakerRewards() {
+	k := suite.App.DistrKeeper
+	operator := suite.Operators[0]
+	stakerID, _ := assetstypes.GetStakerIDAndAssetID(suite.ClientChains[0].LayerZeroChainID, operator, nil)
+	res, err := k.StakerRewards(suite.Ctx, &types.QueryStakerRewardsRequest{StakerId: stakerID})
+	suite.NoError(err)
+	suite.True(res.Rewards.IsZero())
+
+	// the unsettled rewards of the delegations are included without being settled
+	rewards := sdk.NewDecCoins(sdk.NewInt64DecCoin(utils.BaseDenom, 1010))
+	k.AddOperatorAssetRewards(suite.Ctx, operator, suite.AssetIDs[0], rewards)
+	res, err = k.StakerRewards(suite.Ctx, &types.QueryStakerRewardsRequest{StakerId: stakerID})
+	suite.NoError(err)
+	suite.Equal(rewards, res.Rewards)
+	suite.True(k.GetStakerRewards(suite.Ctx, stakerID).Rewards.IsZero())
+
+	_, err = k.StakerRewards(suite.Ctx, &types.QueryStakerRewardsRequest{})
+	suite.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryAllStakerRewards() {
+	k := suite.App.DistrKeeper
+	rewards := sdk.NewDecCoins(sdk.NewInt64DecCoin(utils.BaseDenom, 100))
+	stakerIDs := []string{"0xa_0x65", "0xb_0x65", "0xc_0x65"}
+	for _, stakerID := range stakerIDs {
+		k.SetStakerRewards(suite.Ctx, stakerID, types.StakerOutstandingRewards{Rewards: rewards})
+	}
+
+	res, err := k.AllStakerRewards(suite.Ctx, &types.QueryAllStakerRewardsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.NoError(err)
+	suite.Len(res.StakerRewards, 2)
+	suite.Equal(uint64(3), res.Pagination.Total)
+	suite.Equal(stakerIDs[0], res.StakerRewards[0].StakerID)
+	suite.Equal(rewards, res.StakerRewards[0].Rewards)
+
+	res, err = k.AllStakerRewards(suite.Ctx, &types.QueryAllStakerRewardsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.NoError(err)
+	suite.Len(res.StakerRewards, 1)
+	suite.Equal(stakerIDs[2], res.StakerRewards[0].StakerID)
+}
+
+func (suite *KeeperTestSuite) TestQueryOperatorRewards() {
+	k := suite.App.DistrKeeper
+	operator := suite.Operators[0]
+	valAddr := sdk.ValAddress(operator)
+	commission := sdk.NewDecCoins(sdk.NewDecCoinFromDec(utils.BaseDenom, sdkmath.LegacyNewDecWithPrec(505, 1)))
+	outstanding := sdk.NewDecCoins(sdk.NewInt64DecCoin(utils.BaseDenom, 100))
+	k.SetValidatorAccumulatedCommission(suite.Ctx, valAddr, types.ValidatorAccumulatedCommission{Commission: commission})
+	k.SetValidatorOutstandingRewards(suite.Ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: outstanding})
+
+	commissionRes, err := k.OperatorCommission(suite.Ctx, &types.QueryOperatorCommissionRequest{
+		OperatorAddr: operator.String(),
+	})
+	suite.NoError(err)
+	suite.Equal(commission, commissionRes.Commission.Commission)
+	outstandingRes, err := k.OperatorOutstandingRewards(suite.Ctx, &types.QueryOperatorOutstandingRewardsRequest{
+		OperatorAddr: operator.String(),
+	})
+	suite.NoError(err)
+	suite.Equal(outstanding, outstandingRes.Rewards.Rewards)
+
+	_, err = k.OperatorCommission(suite.Ctx, &types.QueryOperatorCommissionRequest{OperatorAddr: "invalid"})
+	suite.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryCommunityPool() {
+	k := suite.App.DistrKeeper
+	pool := sdk.NewDecCoins(sdk.NewInt64DecCoin(utils.BaseDenom, 100))
+	k.SetFeePool(suite.Ctx, &types.FeePool{CommunityPool: pool})
+
+	res, err := k.CommunityPool(suite.Ctx, &types.QueryCommunityPoolRequest{})
+	suite.NoError(err)
+	suite.Equal(pool, res.Pool)
+}
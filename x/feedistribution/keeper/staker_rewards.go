@@ -0,0 +1,344 @@
+package keeper
+
+import (
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	delegationtypes "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/ExocoreNetwork/exocore/x/feedistribution/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The rewards of the stakers are accounted with the F1 fee distribution scheme. Each pair of
+// operator and asset is a reward pool, whose current rewards are accumulated at the end of
+// each epoch. The cumulative reward ratio, i.e. the rewards per delegated share, is recorded
+// as historical rewards whenever the share of a staker in the pool changes, so that the
+// rewards of the staker are only computed when it is settled, without iterating over all of
+// the stakers during the allocation.
+
+// GetOperatorAssetHistoricalRewards returns the historical rewards of the operator and asset
+// in the provided period.
+func (k Keeper) GetOperatorAssetHistoricalRewards(
+	ctx sdk.Context, operator sdk.AccAddress, assetID string, period uint64,
+) (rewards types.ValidatorHistoricalRewards, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetOperatorAssetHistoricalRewardsKey(operator, assetID, period))
+	if b == nil {
+		return rewards, false
+	}
+	k.cdc.MustUnmarshal(b, &rewards)
+	return rewards, true
+}
+
+// SetOperatorAssetHistoricalRewards sets the historical rewards of the operator and asset in
+// the provided period.
+func (k Keeper) SetOperatorAssetHistoricalRewards(
+	ctx sdk.Context, operator sdk.AccAddress, assetID string, period uint64, rewards types.ValidatorHistoricalRewards,
+) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&rewards)
+	store.Set(types.GetOperatorAssetHistoricalRewardsKey(operator, assetID, period), b)
+}
+
+// DeleteOperatorAssetHistoricalRewards deletes the historical rewards of the operator and
+// asset in the provided period.
+func (k Keeper) DeleteOperatorAssetHistoricalRewards(ctx sdk.Context, operator sdk.AccAddress, assetID string, period uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetOperatorAssetHistoricalRewardsKey(operator, assetID, period))
+}
+
+// GetOperatorAssetCurrentRewards returns the current rewards of the operator and asset.
+func (k Keeper) GetOperatorAssetCurrentRewards(
+	ctx sdk.Context, operator sdk.AccAddress, assetID string,
+) (rewards types.ValidatorCurrentRewards, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetOperatorAssetCurrentRewardsKey(operator, assetID))
+	if b == nil {
+		return rewards, false
+	}
+	k.cdc.MustUnmarshal(b, &rewards)
+	return rewards, true
+}
+
+// SetOperatorAssetCurrentRewards sets the current rewards of the operator and asset.
+func (k Keeper) SetOperatorAssetCurrentRewards(
+	ctx sdk.Context, operator sdk.AccAddress, assetID string, rewards types.ValidatorCurrentRewards,
+) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&rewards)
+	store.Set(types.GetOperatorAssetCurrentRewardsKey(operator, assetID), b)
+}
+
+// GetOperatorAssetTotalStake returns the total share of the stakers tracked in the reward
+// pool of the operator and asset.
+func (k Keeper) GetOperatorAssetTotalStake(ctx sdk.Context, operator sdk.AccAddress, assetID string) math.LegacyDec {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetOperatorAssetTotalStakeKey(operator, assetID))
+	if b == nil {
+		return math.LegacyZeroDec()
+	}
+	var stake sdk.DecProto
+	k.cdc.MustUnmarshal(b, &stake)
+	return stake.Dec
+}
+
+// SetOperatorAssetTotalStake sets the total share of the stakers tracked in the reward pool
+// of the operator and asset.
+func (k Keeper) SetOperatorAssetTotalStake(ctx sdk.Context, operator sdk.AccAddress, assetID string, stake math.LegacyDec) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetOperatorAssetTotalStakeKey(operator, assetID)
+	if !stake.IsPositive() {
+		store.Delete(key)
+		return
+	}
+	b := k.cdc.MustMarshal(&sdk.DecProto{Dec: stake})
+	store.Set(key, b)
+}
+
+// GetStakerStartingInfo returns the starting info of the staker for the delegation of the
+// asset to the operator.
+func (k Keeper) GetStakerStartingInfo(
+	ctx sdk.Context, stakerID string, operator sdk.AccAddress, assetID string,
+) (info types.StakerStartingInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetStakerStartingInfoKey(stakerID, operator, assetID))
+	if b == nil {
+		return info, false
+	}
+	k.cdc.MustUnmarshal(b, &info)
+	return info, true
+}
+
+// SetStakerStartingInfo sets the starting info of the staker for the delegation of the asset
+// to the operator.
+func (k Keeper) SetStakerStartingInfo(
+	ctx sdk.Context, stakerID string, operator sdk.AccAddress, assetID string, info types.StakerStartingInfo,
+) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&info)
+	store.Set(types.GetStakerStartingInfoKey(stakerID, operator, assetID), b)
+}
+
+// DeleteStakerStartingInfo deletes the starting info of the staker for the delegation of the
+// asset to the operator.
+func (k Keeper) DeleteStakerStartingInfo(ctx sdk.Context, stakerID string, operator sdk.AccAddress, assetID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetStakerStartingInfoKey(stakerID, operator, assetID))
+}
+
+// IterateStakerStartingInfos iterates over the starting infos of the staker. The iteration
+// stops when the handler returns true.
+func (k Keeper) IterateStakerStartingInfos(
+	ctx sdk.Context, stakerID string,
+	handler func(operator sdk.AccAddress, assetID string, info types.StakerStartingInfo) (stop bool),
+) error {
+	prefix := types.GetStakerStartingInfoPrefix(stakerID)
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		operator, assetID, err := types.ParseOperatorAssetKey(iterator.Key()[len(prefix):])
+		if err != nil {
+			return err
+		}
+		var info types.StakerStartingInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &info)
+		if handler(operator, assetID, info) {
+			break
+		}
+	}
+	return nil
+}
+
+// initializeRewardPool initializes the reward pool of the operator and asset if it doesn't
+// exist, and returns its current rewards.
+func (k Keeper) initializeRewardPool(ctx sdk.Context, operator sdk.AccAddress, assetID string) types.ValidatorCurrentRewards {
+	current, found := k.GetOperatorAssetCurrentRewards(ctx, operator, assetID)
+	if found {
+		return current
+	}
+	// the period 0 is referenced by the current rewards until the first increment.
+	k.SetOperatorAssetHistoricalRewards(ctx, operator, assetID, 0, types.ValidatorHistoricalRewards{
+		CumulativeRewardRatio: sdk.DecCoins{},
+		ReferenceCount:        1,
+	})
+	current = types.ValidatorCurrentRewards{
+		Rewards: sdk.DecCoins{},
+		Period:  1,
+	}
+	k.SetOperatorAssetCurrentRewards(ctx, operator, assetID, current)
+	return current
+}
+
+// incrementReferenceCount increments the reference count of the historical rewards.
+func (k Keeper) incrementReferenceCount(ctx sdk.Context, operator sdk.AccAddress, assetID string, period uint64) error {
+	historical, found := k.GetOperatorAssetHistoricalRewards(ctx, operator, assetID, period)
+	if !found {
+		return errorsmod.Wrapf(types.ErrNotFoundHistoricalRewards, "operator %s asset %s period %d", operator, assetID, period)
+	}
+	historical.ReferenceCount++
+	k.SetOperatorAssetHistoricalRewards(ctx, operator, assetID, period, historical)
+	return nil
+}
+
+// decrementReferenceCount decrements the reference count of the historical rewards, which
+// are deleted once they aren't referenced anymore.
+func (k Keeper) decrementReferenceCount(ctx sdk.Context, operator sdk.AccAddress, assetID string, period uint64) error {
+	historical, found := k.GetOperatorAssetHistoricalRewards(ctx, operator, assetID, period)
+	if !found {
+		return errorsmod.Wrapf(types.ErrNotFoundHistoricalRewards, "operator %s asset %s period %d", operator, assetID, period)
+	}
+	if historical.ReferenceCount == 0 {
+		return errorsmod.Wrapf(types.ErrInvalidReferenceCount, "operator %s asset %s period %d", operator, assetID, period)
+	}
+	historical.ReferenceCount--
+	if historical.ReferenceCount == 0 {
+		k.DeleteOperatorAssetHistoricalRewards(ctx, operator, assetID, period)
+	} else {
+		k.SetOperatorAssetHistoricalRewards(ctx, operator, assetID, period, historical)
+	}
+	return nil
+}
+
+// incrementPeriod ends the current period of the reward pool by recording its cumulative
+// reward ratio, and returns the ended period. If no share is tracked in the pool, the
+// current rewards are sent to the community pool.
+func (k Keeper) incrementPeriod(ctx sdk.Context, operator sdk.AccAddress, assetID string) (uint64, error) {
+	current := k.initializeRewardPool(ctx, operator, assetID)
+	totalStake := k.GetOperatorAssetTotalStake(ctx, operator, assetID)
+
+	var current0 sdk.DecCoins
+	if totalStake.IsPositive() {
+		current0 = current.Rewards.QuoDecTruncate(totalStake)
+	} else {
+		if !current.Rewards.IsZero() {
+			feePool := k.GetFeePool(ctx)
+			feePool.CommunityPool = feePool.CommunityPool.Add(current.Rewards...)
+			k.SetFeePool(ctx, feePool)
+		}
+		current0 = sdk.DecCoins{}
+	}
+
+	previous, found := k.GetOperatorAssetHistoricalRewards(ctx, operator, assetID, current.Period-1)
+	if !found {
+		return 0, errorsmod.Wrapf(types.ErrNotFoundHistoricalRewards, "operator %s asset %s period %d", operator, assetID, current.Period-1)
+	}
+	if err := k.decrementReferenceCount(ctx, operator, assetID, current.Period-1); err != nil {
+		return 0, err
+	}
+	// the ended period is referenced by the next current rewards.
+	k.SetOperatorAssetHistoricalRewards(ctx, operator, assetID, current.Period, types.ValidatorHistoricalRewards{
+		CumulativeRewardRatio: previous.CumulativeRewardRatio.Add(current0...),
+		ReferenceCount:        1,
+	})
+	k.SetOperatorAssetCurrentRewards(ctx, operator, assetID, types.ValidatorCurrentRewards{
+		Rewards: sdk.DecCoins{},
+		Period:  current.Period + 1,
+	})
+	return current.Period, nil
+}
+
+// AddOperatorAssetRewards adds the rewards to the current rewards of the reward pool of the
+// operator and asset.
+func (k Keeper) AddOperatorAssetRewards(ctx sdk.Context, operator sdk.AccAddress, assetID string, rewards sdk.DecCoins) {
+	current := k.initializeRewardPool(ctx, operator, assetID)
+	current.Rewards = current.Rewards.Add(rewards...)
+	k.SetOperatorAssetCurrentRewards(ctx, operator, assetID, current)
+}
+
+// SettleStakerRewards moves the rewards accumulated by the delegation of the asset from the
+// staker to the operator into the outstanding rewards of the staker, and starts tracking the
+// current delegated share. It must be called whenever the share of the staker changes.
+func (k Keeper) SettleStakerRewards(ctx sdk.Context, stakerID string, operator sdk.AccAddress, assetID string) error {
+	endingPeriod, err := k.incrementPeriod(ctx, operator, assetID)
+	if err != nil {
+		return err
+	}
+	totalStake := k.GetOperatorAssetTotalStake(ctx, operator, assetID)
+
+	if info, found := k.GetStakerStartingInfo(ctx, stakerID, operator, assetID); found {
+		starting, found := k.GetOperatorAssetHistoricalRewards(ctx, operator, assetID, info.PreviousPeriod)
+		if !found {
+			return errorsmod.Wrapf(types.ErrNotFoundHistoricalRewards, "operator %s asset %s period %d", operator, assetID, info.PreviousPeriod)
+		}
+		ending, _ := k.GetOperatorAssetHistoricalRewards(ctx, operator, assetID, endingPeriod)
+		difference := ending.CumulativeRewardRatio.Sub(starting.CumulativeRewardRatio)
+		rewards := difference.MulDecTruncate(info.Stake)
+		if !rewards.IsZero() {
+			outstanding := k.GetStakerRewards(ctx, stakerID)
+			outstanding.Rewards = outstanding.Rewards.Add(rewards...)
+			k.SetStakerRewards(ctx, stakerID, outstanding)
+		}
+		if err := k.decrementReferenceCount(ctx, operator, assetID, info.PreviousPeriod); err != nil {
+			return err
+		}
+		totalStake = totalStake.Sub(info.Stake)
+		k.DeleteStakerStartingInfo(ctx, stakerID, operator, assetID)
+	}
+
+	share := math.LegacyZeroDec()
+	delegation, err := k.delegationKeeper.GetSingleDelegationInfo(ctx, stakerID, assetID, operator.String())
+	if err == nil {
+		share = delegation.UndelegatableShare
+	} else if !errorsmod.IsOf(err, delegationtypes.ErrNoKeyInTheStore) {
+		return err
+	}
+	if share.IsPositive() {
+		if err := k.incrementReferenceCount(ctx, operator, assetID, endingPeriod); err != nil {
+			return err
+		}
+		k.SetStakerStartingInfo(ctx, stakerID, operator, assetID, types.StakerStartingInfo{
+			PreviousPeriod: endingPeriod,
+			Stake:          share,
+			Height:         uint64(ctx.BlockHeight()), // #nosec G115
+		})
+		totalStake = totalStake.Add(share)
+	}
+	k.SetOperatorAssetTotalStake(ctx, operator, assetID, totalStake)
+	return nil
+}
+
+// SettleAllStakerRewards settles the rewards of all the delegations of the staker, including
+// the ones that have been tracked but have no delegated share anymore.
+func (k Keeper) SettleAllStakerRewards(ctx sdk.Context, stakerID string) error {
+	type pool struct {
+		operator sdk.AccAddress
+		assetID  string
+	}
+	pools := make(map[string]pool)
+	err := k.IterateStakerStartingInfos(ctx, stakerID, func(operator sdk.AccAddress, assetID string, _ types.StakerStartingInfo) bool {
+		pools[string(types.GetOperatorAssetKey(operator, assetID))] = pool{operator, assetID}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	err = k.delegationKeeper.IterateDelegationsForStaker(ctx, stakerID,
+		func(keys *delegationtypes.SingleDelegationInfoReq, _ *delegationtypes.DelegationAmounts) (bool, error) {
+			// the iteration is by prefix, which also matches the staker IDs extending this one
+			if keys.StakerID != stakerID {
+				return false, nil
+			}
+			operator, err := sdk.AccAddressFromBech32(keys.OperatorAddr)
+			if err != nil {
+				return true, err
+			}
+			pools[string(types.GetOperatorAssetKey(operator, keys.AssetID))] = pool{operator, keys.AssetID}
+			return false, nil
+		})
+	if err != nil {
+		return err
+	}
+	// sort the keys to settle the pools in a deterministic order
+	keys := make([]string, 0, len(pools))
+	for key := range pools {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := k.SettleStakerRewards(ctx, stakerID, pools[key].operator, pools[key].assetID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	utiltx "github.com/ExocoreNetwork/exocore/testutil/tx"
+	"github.com/ExocoreNetwork/exocore/utils"
+	assetskeeper "github.com/ExocoreNetwork/exocore/x/assets/keeper"
+	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
+	delegationtypes "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/ExocoreNetwork/exocore/x/feedistribution/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (suite *KeeperTestSuite) TestStakerRewards() {
+	suite.fundModule(sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 10000)))
+	k := suite.App.DistrKeeper
+	operator := suite.Operators[0]
+	assetID := suite.AssetIDs[0]
+	lzID := suite.ClientChains[0].LayerZeroChainID
+	// the genesis delegation of the operator is tracked at genesis
+	genesisStaker := sdk.AccAddress(operator.Bytes())
+	genesisStakerID, _ := assetstypes.GetStakerIDAndAssetID(lzID, genesisStaker, nil)
+	_, found := k.GetStakerStartingInfo(suite.Ctx, genesisStakerID, operator, assetID)
+	suite.True(found)
+
+	// a new staker delegates the same amount as the genesis delegation
+	staker := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	stakerID, _ := assetstypes.GetStakerIDAndAssetID(lzID, staker, nil)
+	amount := sdkmath.NewIntWithDecimal(suite.Powers[0], 6)
+	assetAddr := common.HexToAddress(suite.Assets[0].Address)
+	err := suite.App.AssetsKeeper.PerformDepositOrWithdraw(suite.Ctx, &assetskeeper.DepositWithdrawParams{
+		ClientChainLzID: lzID,
+		Action:          assetstypes.DepositLST,
+		StakerAddress:   staker,
+		OpAmount:        amount,
+		AssetsAddress:   assetAddr[:],
+	})
+	suite.NoError(err)
+	err = suite.App.DelegationKeeper.DelegateTo(suite.Ctx, &delegationtypes.DelegationOrUndelegationParams{
+		ClientChainID:   lzID,
+		AssetsAddress:   assetAddr[:],
+		OperatorAddress: operator,
+		StakerAddress:   staker,
+		OpAmount:        amount,
+		TxHash:          common.HexToHash("0x01"),
+	})
+	suite.NoError(err)
+	totalStake := k.GetOperatorAssetTotalStake(suite.Ctx, operator, assetID)
+	suite.True(sdkmath.LegacyNewDecFromInt(amount.MulRaw(2)).Equal(totalStake))
+
+	// the rewards allocated to the stakers are added to the reward pool
+	rewards := sdk.NewDecCoins(sdk.NewInt64DecCoin(utils.BaseDenom, 2020))
+	feePool := k.GetFeePool(suite.Ctx)
+	k.AllocateTokensToStakers(suite.Ctx, operator, rewards, feePool)
+	suite.True(feePool.CommunityPool.IsZero())
+	current, found := k.GetOperatorAssetCurrentRewards(suite.Ctx, operator, assetID)
+	suite.True(found)
+	suite.Equal(rewards, current.Rewards)
+
+	// the rewards are shared by the stakers in proportion to their shares, and the amount
+	// is chosen to be divisible by the total share to avoid the truncation
+	withdrawn, err := k.WithdrawStakerReward(suite.Ctx, staker, lzID)
+	suite.NoError(err)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1010)), withdrawn)
+	withdrawn, err = k.WithdrawStakerReward(suite.Ctx, genesisStaker, lzID)
+	suite.NoError(err)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1010)), withdrawn)
+	_, err = k.WithdrawStakerReward(suite.Ctx, staker, lzID)
+	suite.ErrorIs(err, types.ErrNoStakerRewards)
+
+	// the undelegated share doesn't earn the rewards allocated afterwards
+	err = suite.App.DelegationKeeper.UndelegateFrom(suite.Ctx, &delegationtypes.DelegationOrUndelegationParams{
+		ClientChainID:   lzID,
+		AssetsAddress:   assetAddr[:],
+		OperatorAddress: operator,
+		StakerAddress:   staker,
+		OpAmount:        amount,
+		TxHash:          common.HexToHash("0x02"),
+	})
+	suite.NoError(err)
+	_, found = k.GetStakerStartingInfo(suite.Ctx, stakerID, operator, assetID)
+	suite.False(found)
+	k.AddOperatorAssetRewards(suite.Ctx, operator, assetID, rewards)
+	_, err = k.WithdrawStakerReward(suite.Ctx, staker, lzID)
+	suite.ErrorIs(err, types.ErrNoStakerRewards)
+	withdrawn, err = k.WithdrawStakerReward(suite.Ctx, genesisStaker, lzID)
+	suite.NoError(err)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 2020)), withdrawn)
+}
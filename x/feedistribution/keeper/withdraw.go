@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
+	"github.com/ExocoreNetwork/exocore/x/feedistribution/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetWithdrawAddr sets the address to which the rewards and commission of the staker or
+// operator are sent.
+func (k Keeper) SetWithdrawAddr(ctx sdk.Context, addr, withdrawAddr sdk.AccAddress) error {
+	if k.bankKeeper.BlockedAddr(withdrawAddr) {
+		return errorsmod.Wrapf(types.ErrBlockedWithdrawAddress, "address is %s", withdrawAddr)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetWithdrawAddrKey(addr), withdrawAddr.Bytes())
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetWithdrawAddress,
+		sdk.NewAttribute(types.AttributeKeyDelegator, addr.String()),
+		sdk.NewAttribute(types.AttributeKeyWithdrawAddress, withdrawAddr.String()),
+	))
+	return nil
+}
+
+// GetWithdrawAddr returns the withdraw address of the staker or operator. If it isn't set,
+// the earnings address of the operator is used, and the address itself otherwise.
+func (k Keeper) GetWithdrawAddr(ctx sdk.Context, addr sdk.AccAddress) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetWithdrawAddrKey(addr))
+	if bz != nil {
+		return bz
+	}
+	info, err := k.StakingKeeper.OperatorInfo(ctx, addr.String())
+	if err == nil && info.EarningsAddr != "" {
+		if earningsAddr, err := sdk.AccAddressFromBech32(info.EarningsAddr); err == nil {
+			return earningsAddr
+		}
+	}
+	return addr
+}
+
+// WithdrawStakerReward settles the rewards of all the delegations of the staker, which is
+// identified by the staker address and the client chain id, and sends its outstanding rewards
+// to its withdraw address. The decimal remainder is kept as the outstanding rewards.
+func (k Keeper) WithdrawStakerReward(ctx sdk.Context, stakerAddr sdk.AccAddress, clientChainID uint64) (sdk.Coins, error) {
+	stakerID, _ := assetstypes.GetStakerIDAndAssetID(clientChainID, stakerAddr, nil)
+	// the rewards accumulated by the delegations are only computed when they are settled
+	if err := k.SettleAllStakerRewards(ctx, stakerID); err != nil {
+		return nil, err
+	}
+	outstanding := k.GetStakerRewards(ctx, stakerID)
+	if outstanding.Rewards.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrNoStakerRewards, "staker is %s", stakerID)
+	}
+	rewards, remainder := outstanding.Rewards.TruncateDecimal()
+	k.SetStakerRewards(ctx, stakerID, types.StakerOutstandingRewards{Rewards: remainder})
+
+	if !rewards.IsZero() {
+		withdrawAddr := k.GetWithdrawAddr(ctx, stakerAddr)
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, rewards)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeWithdrawRewards,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+		sdk.NewAttribute(types.AttributeKeyStakerID, stakerID),
+	))
+	return rewards, nil
+}
+
+// WithdrawOperatorCommission sends the accumulated commission of the operator to its
+// withdraw address and deducts it from the outstanding rewards of the operator. The
+// decimal remainder is kept as the accumulated commission.
+func (k Keeper) WithdrawOperatorCommission(ctx sdk.Context, operatorAddr sdk.AccAddress) (sdk.Coins, error) {
+	valAddr := sdk.ValAddress(operatorAddr)
+	accumulated := k.GetValidatorAccumulatedCommission(ctx, valAddr)
+	if accumulated.Commission.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrNoOperatorCommission, "operator is %s", operatorAddr)
+	}
+	commission, remainder := accumulated.Commission.TruncateDecimal()
+	k.SetValidatorAccumulatedCommission(ctx, valAddr, types.ValidatorAccumulatedCommission{Commission: remainder})
+
+	outstanding := k.GetValidatorOutstandingRewards(ctx, valAddr)
+	outstanding.Rewards = outstanding.Rewards.Sub(sdk.NewDecCoinsFromCoins(commission...))
+	k.SetValidatorOutstandingRewards(ctx, valAddr, outstanding)
+
+	if !commission.IsZero() {
+		withdrawAddr := k.GetWithdrawAddr(ctx, operatorAddr)
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, commission)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeWithdrawCommission,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, commission.String()),
+		sdk.NewAttribute(types.AttributeKeyValidator, operatorAddr.String()),
+	))
+	return commission, nil
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	utiltx "github.com/ExocoreNetwork/exocore/testutil/tx"
+	"github.com/ExocoreNetwork/exocore/utils"
+	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
+	exominttypes "github.com/ExocoreNetwork/exocore/x/exomint/types"
+	"github.com/ExocoreNetwork/exocore/x/feedistribution/keeper"
+	"github.com/ExocoreNetwork/exocore/x/feedistribution/types"
+	operatortypes "github.com/ExocoreNetwork/exocore/x/operator/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestWithdrawStakerReward() {
+	suite.fundModule(sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1000)))
+	msgServer := keeper.NewMsgServerImpl(suite.App.DistrKeeper)
+
+	staker := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	lzID := suite.ClientChains[0].LayerZeroChainID
+	stakerID, _ := assetstypes.GetStakerIDAndAssetID(lzID, staker, nil)
+	_, err := msgServer.WithdrawStakerReward(suite.Ctx, &types.MsgWithdrawStakerReward{
+		StakerAddress: staker.String(),
+		ClientChainID: lzID,
+	})
+	suite.ErrorIs(err, types.ErrNoStakerRewards)
+
+	rewards := sdk.NewDecCoins(sdk.NewDecCoinFromDec(utils.BaseDenom, sdkmath.LegacyNewDecWithPrec(1005, 1)))
+	suite.App.DistrKeeper.SetStakerRewards(suite.Ctx, stakerID, types.StakerOutstandingRewards{Rewards: rewards})
+	res, err := msgServer.WithdrawStakerReward(suite.Ctx, &types.MsgWithdrawStakerReward{
+		StakerAddress: staker.String(),
+		ClientChainID: lzID,
+	})
+	suite.NoError(err)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 100)), res.Amount)
+	suite.Equal(sdkmath.NewInt(100), suite.App.BankKeeper.GetBalance(suite.Ctx, staker, utils.BaseDenom).Amount)
+	// the decimal remainder is kept as the outstanding rewards
+	remaining := suite.App.DistrKeeper.GetStakerRewards(suite.Ctx, stakerID)
+	suite.Equal(sdkmath.LegacyNewDecWithPrec(5, 1), remaining.Rewards.AmountOf(utils.BaseDenom))
+
+	// the rewards are sent to the withdraw address once it's set
+	withdrawAddr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	_, err = msgServer.SetWithdrawAddress(suite.Ctx, &types.MsgSetWithdrawAddress{
+		DelegatorAddress: staker.String(),
+		WithdrawAddress:  withdrawAddr.String(),
+	})
+	suite.NoError(err)
+	suite.App.DistrKeeper.SetStakerRewards(suite.Ctx, stakerID, types.StakerOutstandingRewards{Rewards: rewards})
+	_, err = msgServer.WithdrawStakerReward(suite.Ctx, &types.MsgWithdrawStakerReward{
+		StakerAddress: staker.String(),
+		ClientChainID: lzID,
+	})
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(100), suite.App.BankKeeper.GetBalance(suite.Ctx, withdrawAddr, utils.BaseDenom).Amount)
+}
+
+func (suite *KeeperTestSuite) TestWithdrawOperatorCommission() {
+	suite.fundModule(sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1000)))
+	msgServer := keeper.NewMsgServerImpl(suite.App.DistrKeeper)
+
+	operator := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	earningsAddr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	_, err := suite.OperatorMsgServer.RegisterOperator(sdk.WrapSDKContext(suite.Ctx), &operatortypes.RegisterOperatorReq{
+		FromAddress: operator.String(),
+		Info: &operatortypes.OperatorInfo{
+			EarningsAddr: earningsAddr.String(),
+		},
+	})
+	suite.NoError(err)
+	_, err = msgServer.WithdrawOperatorCommission(suite.Ctx, &types.MsgWithdrawOperatorCommission{
+		OperatorAddress: operator.String(),
+	})
+	suite.ErrorIs(err, types.ErrNoOperatorCommission)
+
+	valAddr := sdk.ValAddress(operator)
+	commission := sdk.NewDecCoins(sdk.NewDecCoinFromDec(utils.BaseDenom, sdkmath.LegacyNewDecWithPrec(505, 1)))
+	outstanding := sdk.NewDecCoins(sdk.NewDecCoinFromDec(utils.BaseDenom, sdkmath.LegacyNewDec(100)))
+	suite.App.DistrKeeper.SetValidatorAccumulatedCommission(suite.Ctx, valAddr, types.ValidatorAccumulatedCommission{Commission: commission})
+	suite.App.DistrKeeper.SetValidatorOutstandingRewards(suite.Ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: outstanding})
+	res, err := msgServer.WithdrawOperatorCommission(suite.Ctx, &types.MsgWithdrawOperatorCommission{
+		OperatorAddress: operator.String(),
+	})
+	suite.NoError(err)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 50)), res.Amount)
+	// the commission is sent to the earnings address by default
+	suite.Equal(sdkmath.NewInt(50), suite.App.BankKeeper.GetBalance(suite.Ctx, earningsAddr, utils.BaseDenom).Amount)
+	accumulated := suite.App.DistrKeeper.GetValidatorAccumulatedCommission(suite.Ctx, valAddr)
+	suite.Equal(sdkmath.LegacyNewDecWithPrec(5, 1), accumulated.Commission.AmountOf(utils.BaseDenom))
+	remaining := suite.App.DistrKeeper.GetValidatorOutstandingRewards(suite.Ctx, valAddr)
+	suite.Equal(sdkmath.LegacyNewDec(50), remaining.Rewards.AmountOf(utils.BaseDenom))
+}
+
+// fundModule mints the coins and sends them to the fee distribution module account.
+func (suite *KeeperTestSuite) fundModule(amounts sdk.Coins) {
+	err := suite.App.BankKeeper.MintCoins(suite.Ctx, exominttypes.ModuleName, amounts)
+	suite.NoError(err)
+	err = suite.App.BankKeeper.SendCoinsFromModuleToModule(suite.Ctx, exominttypes.ModuleName, types.ModuleName, amounts)
+	suite.NoError(err)
+}
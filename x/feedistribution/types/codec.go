@@ -15,6 +15,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgSetWithdrawAddress{},
+		&MsgWithdrawStakerReward{},
+		&MsgWithdrawOperatorCommission{},
+		&MsgFundCommunityPool{},
+		&MsgCommunityPoolSpend{},
+		&MsgDepositAVSRewards{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -338,6 +340,113 @@ func (m *FeePool) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.DecCoins
 	return nil
 }
 
+// StakerStartingInfo represents the starting info of the rewards of a staker for the
+// delegation of an asset to an operator. The rewards of the staker are calculated lazily
+// from the cumulative reward ratio of the operator and asset since the previous period.
+type StakerStartingInfo struct {
+	// previous_period is the period of the operator and asset ended by the latest
+	// settlement of the staker's rewards.
+	PreviousPeriod uint64 `protobuf:"varint,1,opt,name=previous_period,json=previousPeriod,proto3" json:"previous_period,omitempty"`
+	// stake is the delegated share of the staker at the latest settlement.
+	Stake cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=stake,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"stake"`
+	// height is the block height of the latest settlement.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *StakerStartingInfo) Reset()         { *m = StakerStartingInfo{} }
+func (m *StakerStartingInfo) String() string { return proto.CompactTextString(m) }
+func (*StakerStartingInfo) ProtoMessage()    {}
+func (*StakerStartingInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41d79709579ecf3b, []int{6}
+}
+func (m *StakerStartingInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakerStartingInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakerStartingInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakerStartingInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakerStartingInfo.Merge(m, src)
+}
+func (m *StakerStartingInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakerStartingInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakerStartingInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakerStartingInfo proto.InternalMessageInfo
+
+func (m *StakerStartingInfo) GetPreviousPeriod() uint64 {
+	if m != nil {
+		return m.PreviousPeriod
+	}
+	return 0
+}
+
+func (m *StakerStartingInfo) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// AVSRewardPool represents the rewards deposited by an AVS, which are distributed to the
+// operators opted into the AVS and their stakers at the end of each epoch.
+type AVSRewardPool struct {
+	// rewards are the rewards that haven't been distributed yet.
+	Rewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
+}
+
+func (m *AVSRewardPool) Reset()         { *m = AVSRewardPool{} }
+func (m *AVSRewardPool) String() string { return proto.CompactTextString(m) }
+func (*AVSRewardPool) ProtoMessage()    {}
+func (*AVSRewardPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41d79709579ecf3b, []int{7}
+}
+func (m *AVSRewardPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AVSRewardPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AVSRewardPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AVSRewardPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AVSRewardPool.Merge(m, src)
+}
+func (m *AVSRewardPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *AVSRewardPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_AVSRewardPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AVSRewardPool proto.InternalMessageInfo
+
+func (m *AVSRewardPool) GetRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "exocore.feedistribution.v1.ValidatorHistoricalRewards")
 	proto.RegisterType((*ValidatorCurrentRewards)(nil), "exocore.feedistribution.v1.ValidatorCurrentRewards")
@@ -345,6 +454,8 @@ func init() {
 	proto.RegisterType((*ValidatorOutstandingRewards)(nil), "exocore.feedistribution.v1.ValidatorOutstandingRewards")
 	proto.RegisterType((*StakerOutstandingRewards)(nil), "exocore.feedistribution.v1.StakerOutstandingRewards")
 	proto.RegisterType((*FeePool)(nil), "exocore.feedistribution.v1.FeePool")
+	proto.RegisterType((*StakerStartingInfo)(nil), "exocore.feedistribution.v1.StakerStartingInfo")
+	proto.RegisterType((*AVSRewardPool)(nil), "exocore.feedistribution.v1.AVSRewardPool")
 }
 
 func init() {
@@ -352,37 +463,45 @@ func init() {
 }
 
 var fileDescriptor_41d79709579ecf3b = []byte{
-	// 474 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0xbf, 0x6f, 0xd4, 0x30,
-	0x14, 0xc7, 0xcf, 0x80, 0x5a, 0xc9, 0xa8, 0x45, 0x44, 0x40, 0x4f, 0x01, 0xb9, 0x55, 0x16, 0x2a,
-	0x50, 0x63, 0x1d, 0x2c, 0x30, 0xd2, 0x03, 0xc4, 0x04, 0x28, 0x48, 0x20, 0xb1, 0x9c, 0x1c, 0xe7,
-	0xf5, 0xb0, 0x2e, 0xf1, 0x8b, 0x6c, 0x27, 0x6d, 0x07, 0x76, 0x06, 0x04, 0x8c, 0xec, 0x2c, 0x15,
-	0x13, 0x7f, 0x46, 0xc7, 0x4e, 0x88, 0x09, 0xd0, 0xdd, 0xc0, 0xbf, 0x81, 0xf2, 0xe3, 0xc2, 0x09,
-	0xe6, 0x13, 0x5d, 0x12, 0xbf, 0xa7, 0x67, 0x7f, 0xbe, 0xdf, 0x37, 0x7c, 0xe9, 0x0e, 0x1c, 0xa0,
-	0x44, 0x03, 0x7c, 0x0f, 0x20, 0x51, 0xd6, 0x19, 0x15, 0x17, 0x4e, 0xa1, 0xe6, 0xe5, 0x80, 0x2f,
-	0xd6, 0x61, 0x6e, 0xd0, 0xa1, 0xe7, 0xb7, 0xe3, 0xe1, 0x5f, 0xe3, 0x61, 0x39, 0xf0, 0x2f, 0x8a,
-	0x4c, 0x69, 0xe4, 0xf5, 0xb7, 0x19, 0xf7, 0x99, 0x44, 0x9b, 0xa1, 0xe5, 0xb1, 0xb0, 0xc0, 0xcb,
-	0x41, 0x0c, 0x4e, 0x0c, 0xb8, 0x44, 0xd5, 0x3e, 0xe7, 0x5f, 0x1a, 0xe3, 0x18, 0xeb, 0x23, 0xaf,
-	0x4e, 0x4d, 0x37, 0xf8, 0x4a, 0xa8, 0xff, 0x5c, 0xa4, 0x2a, 0x11, 0x0e, 0xcd, 0x23, 0x65, 0x1d,
-	0x1a, 0x25, 0x45, 0x1a, 0xc1, 0xbe, 0x30, 0x89, 0xf5, 0xde, 0x11, 0xba, 0x21, 0x8b, 0xac, 0x48,
-	0x85, 0x53, 0x25, 0x8c, 0x4c, 0xdd, 0x1e, 0x19, 0xe1, 0x14, 0xf6, 0xc9, 0xd6, 0xd9, 0xed, 0xf3,
-	0xb7, 0xae, 0x85, 0x0d, 0x37, 0xac, 0xb8, 0x61, 0xcb, 0x0d, 0xef, 0x83, 0x1c, 0xa2, 0xd2, 0xbb,
-	0x77, 0x8e, 0xbf, 0x6f, 0xf6, 0x3e, 0xff, 0xd8, 0xbc, 0x39, 0x56, 0xee, 0x55, 0x11, 0x87, 0x12,
-	0x33, 0xde, 0xea, 0x6c, 0x7e, 0x3b, 0x36, 0x99, 0x70, 0x77, 0x98, 0x83, 0x9d, 0xdf, 0xb1, 0x47,
-	0xbf, 0xbe, 0xdc, 0x20, 0xd1, 0xe5, 0x3f, 0xd8, 0x46, 0x4c, 0x54, 0x41, 0xbd, 0xeb, 0xf4, 0x82,
-	0x81, 0x3d, 0x30, 0xa0, 0x25, 0x8c, 0x24, 0x16, 0xda, 0xf5, 0xcf, 0x6c, 0x91, 0xed, 0xb5, 0x68,
-	0xbd, 0x6b, 0x0f, 0xab, 0x6e, 0xf0, 0x89, 0xd0, 0x8d, 0xce, 0xd8, 0xb0, 0x30, 0x06, 0xb4, 0x9b,
-	0xbb, 0xca, 0xe9, 0x6a, 0xe3, 0xc4, 0x2e, 0xd9, 0xc4, 0x1c, 0xe3, 0x5d, 0xa1, 0x2b, 0x39, 0x18,
-	0x85, 0x49, 0xad, 0xf6, 0x5c, 0xd4, 0x56, 0xc1, 0x47, 0x42, 0x59, 0xa7, 0xf2, 0x9e, 0x6c, 0x3d,
-	0x43, 0x32, 0xc4, 0x2c, 0x53, 0xd6, 0x2a, 0xd4, 0x5e, 0x49, 0xa9, 0xec, 0xaa, 0x25, 0xeb, 0x5d,
-	0x20, 0x05, 0xef, 0x09, 0xbd, 0xda, 0x49, 0x7b, 0x52, 0x38, 0xeb, 0x84, 0x4e, 0x94, 0x1e, 0xff,
-	0xb7, 0x25, 0x06, 0x6f, 0x09, 0xed, 0x3f, 0x73, 0x62, 0x02, 0xa7, 0x43, 0xce, 0x1b, 0x42, 0x57,
-	0x1f, 0x02, 0x3c, 0x45, 0x4c, 0xbd, 0xd7, 0x74, 0xbd, 0x5a, 0x5d, 0xa1, 0x95, 0x3b, 0x1c, 0xe5,
-	0x88, 0xe9, 0x92, 0x45, 0xac, 0x75, 0xb4, 0x0a, 0xbf, 0xfb, 0xe2, 0x68, 0xca, 0xc8, 0xf1, 0x94,
-	0x91, 0x93, 0x29, 0x23, 0x3f, 0xa7, 0x8c, 0x7c, 0x98, 0xb1, 0xde, 0xc9, 0x8c, 0xf5, 0xbe, 0xcd,
-	0x58, 0xef, 0xe5, 0xdd, 0x85, 0xe7, 0x1f, 0x34, 0x99, 0xf2, 0x18, 0xdc, 0x3e, 0x9a, 0x09, 0x9f,
-	0x27, 0xd2, 0xc1, 0x3f, 0x99, 0x54, 0x53, 0xe3, 0x95, 0x3a, 0x25, 0x6e, 0xff, 0x0e, 0x00, 0x00,
-	0xff, 0xff, 0x8f, 0x04, 0x33, 0xdc, 0xbb, 0x04, 0x00, 0x00,
+	// 595 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xbf, 0x6f, 0xd4, 0x30,
+	0x14, 0x3e, 0x53, 0x68, 0x85, 0x51, 0x8b, 0x88, 0x80, 0x1e, 0x57, 0x94, 0x56, 0x59, 0xa8, 0x40,
+	0x4d, 0x74, 0xb0, 0xc0, 0xd8, 0x5e, 0xf9, 0x25, 0x21, 0xa8, 0xae, 0x52, 0x91, 0x58, 0x22, 0x9f,
+	0xf3, 0x9a, 0xb3, 0xee, 0xe2, 0x17, 0xd9, 0x4e, 0xda, 0x0e, 0x0c, 0x6c, 0x0c, 0x08, 0x18, 0x99,
+	0x58, 0x58, 0x2a, 0x26, 0x06, 0xfe, 0x88, 0x8e, 0x15, 0x03, 0x42, 0x0c, 0x05, 0x5d, 0x07, 0xfe,
+	0x0d, 0x94, 0x38, 0x17, 0x2a, 0x98, 0x0b, 0x2c, 0x49, 0xde, 0x67, 0x3f, 0x7f, 0xdf, 0xf7, 0xfc,
+	0xf2, 0xe8, 0x12, 0x6c, 0x23, 0x47, 0x05, 0xc1, 0x26, 0x40, 0x24, 0xb4, 0x51, 0xa2, 0x97, 0x19,
+	0x81, 0x32, 0xc8, 0xdb, 0xc1, 0xd1, 0xd8, 0x4f, 0x15, 0x1a, 0x74, 0x5a, 0xd5, 0x76, 0xff, 0xb7,
+	0xed, 0x7e, 0xde, 0x6e, 0x9d, 0x63, 0x89, 0x90, 0x18, 0x94, 0x4f, 0xbb, 0xbd, 0xe5, 0x72, 0xd4,
+	0x09, 0xea, 0xa0, 0xc7, 0x34, 0x04, 0x79, 0xbb, 0x07, 0x86, 0xb5, 0x03, 0x8e, 0xa2, 0x3a, 0xae,
+	0x75, 0xc9, 0xae, 0x87, 0x65, 0x14, 0xd8, 0xa0, 0x5a, 0x3a, 0x1f, 0x63, 0x8c, 0x16, 0x2f, 0xbe,
+	0x2c, 0xea, 0x7d, 0x26, 0xb4, 0xb5, 0xc1, 0x86, 0x22, 0x62, 0x06, 0xd5, 0x3d, 0xa1, 0x0d, 0x2a,
+	0xc1, 0xd9, 0xb0, 0x0b, 0x5b, 0x4c, 0x45, 0xda, 0x79, 0x49, 0xe8, 0x2c, 0xcf, 0x92, 0x6c, 0xc8,
+	0x8c, 0xc8, 0x21, 0x54, 0x25, 0x1c, 0x2a, 0x66, 0x04, 0x36, 0xc9, 0xc2, 0xc4, 0xe2, 0x99, 0xeb,
+	0x97, 0xfd, 0x8a, 0xa5, 0x90, 0xe4, 0x57, 0x92, 0xfc, 0x55, 0xe0, 0x1d, 0x14, 0x72, 0xe5, 0xe6,
+	0xde, 0xc1, 0x7c, 0xe3, 0xfd, 0xb7, 0xf9, 0x6b, 0xb1, 0x30, 0xfd, 0xac, 0xe7, 0x73, 0x4c, 0x2a,
+	0x55, 0xd5, 0x6b, 0x49, 0x47, 0x83, 0xc0, 0xec, 0xa4, 0xa0, 0xc7, 0x39, 0x7a, 0xf7, 0xc7, 0x87,
+	0xab, 0xa4, 0x7b, 0xe1, 0x17, 0xad, 0x15, 0xd3, 0x2d, 0x48, 0x9d, 0x2b, 0xf4, 0xac, 0x82, 0x4d,
+	0x50, 0x20, 0x39, 0x84, 0x1c, 0x33, 0x69, 0x9a, 0x27, 0x16, 0xc8, 0xe2, 0x74, 0x77, 0xa6, 0x86,
+	0x3b, 0x05, 0xea, 0xbd, 0x23, 0x74, 0xb6, 0x36, 0xd6, 0xc9, 0x94, 0x02, 0x69, 0xc6, 0xae, 0x52,
+	0x3a, 0x65, 0x9d, 0xe8, 0x63, 0x36, 0x31, 0xa6, 0x71, 0x2e, 0xd2, 0xc9, 0x14, 0x94, 0xc0, 0xa8,
+	0x54, 0x7b, 0xb2, 0x5b, 0x45, 0xde, 0x1b, 0x42, 0xdd, 0x5a, 0xe5, 0x32, 0xaf, 0x3c, 0x43, 0xd4,
+	0xc1, 0x24, 0x11, 0x5a, 0x0b, 0x94, 0x4e, 0x4e, 0x29, 0xaf, 0xa3, 0x63, 0xd6, 0x7b, 0x84, 0xc9,
+	0x7b, 0x45, 0xe8, 0x5c, 0x2d, 0xed, 0x51, 0x66, 0xb4, 0x61, 0x32, 0x12, 0x32, 0xfe, 0x67, 0x45,
+	0xf4, 0x5e, 0x10, 0xda, 0x5c, 0x37, 0x6c, 0x00, 0xff, 0x87, 0x9c, 0xe7, 0x84, 0x4e, 0xdd, 0x01,
+	0x58, 0x43, 0x1c, 0x3a, 0x4f, 0xe9, 0x4c, 0x51, 0xba, 0x4c, 0x0a, 0xb3, 0x13, 0xa6, 0x88, 0xc3,
+	0x63, 0x16, 0x31, 0x5d, 0xb3, 0x15, 0xf4, 0xde, 0x5b, 0x42, 0x1d, 0x5b, 0x99, 0x75, 0xc3, 0x94,
+	0x11, 0x32, 0xbe, 0x2f, 0x37, 0xcb, 0x9f, 0x25, 0x55, 0x90, 0x0b, 0xcc, 0x74, 0x58, 0xb5, 0x1f,
+	0x29, 0xdb, 0x6f, 0x66, 0x0c, 0xaf, 0x95, 0xa8, 0x73, 0x97, 0x9e, 0xd2, 0x45, 0x7a, 0xd9, 0x9d,
+	0xa7, 0x57, 0xda, 0x85, 0xae, 0xaf, 0x07, 0xf3, 0x73, 0x56, 0x85, 0x8e, 0x06, 0xbe, 0xc0, 0x20,
+	0x61, 0xa6, 0xef, 0x3f, 0x80, 0x98, 0xf1, 0x9d, 0x55, 0xe0, 0x9f, 0x3e, 0x2e, 0xd1, 0xca, 0xdb,
+	0x2a, 0xf0, 0xae, 0xcd, 0x2f, 0xfa, 0xbc, 0x0f, 0x22, 0xee, 0x9b, 0xe6, 0x84, 0xed, 0x73, 0x1b,
+	0x79, 0xcf, 0x08, 0x9d, 0x5e, 0xde, 0x58, 0xb7, 0x97, 0x55, 0x56, 0xec, 0xaf, 0xdf, 0xd7, 0xca,
+	0xe3, 0xdd, 0x91, 0x4b, 0xf6, 0x46, 0x2e, 0xd9, 0x1f, 0xb9, 0xe4, 0xfb, 0xc8, 0x25, 0xaf, 0x0f,
+	0xdd, 0xc6, 0xfe, 0xa1, 0xdb, 0xf8, 0x72, 0xe8, 0x36, 0x9e, 0xdc, 0x3a, 0x72, 0xf0, 0x6d, 0x3b,
+	0x93, 0x1f, 0x82, 0xd9, 0x42, 0x35, 0x08, 0xc6, 0x13, 0x7d, 0xfb, 0x8f, 0x99, 0x5e, 0xf2, 0xf5,
+	0x26, 0xcb, 0x51, 0x7a, 0xe3, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x5e, 0xeb, 0xcc, 0xfb,
+	0x05, 0x00, 0x00,
 }
 
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -565,6 +684,65 @@ func (this *FeePool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *StakerStartingInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StakerStartingInfo)
+	if !ok {
+		that2, ok := that.(StakerStartingInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PreviousPeriod != that1.PreviousPeriod {
+		return false
+	}
+	if !this.Stake.Equal(that1.Stake) {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+func (this *AVSRewardPool) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AVSRewardPool)
+	if !ok {
+		that2, ok := that.(AVSRewardPool)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Rewards) != len(that1.Rewards) {
+		return false
+	}
+	for i := range this.Rewards {
+		if !this.Rewards[i].Equal(&that1.Rewards[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (m *ValidatorHistoricalRewards) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -797,6 +975,86 @@ func (m *FeePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StakerStartingInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakerStartingInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakerStartingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Stake.Size()
+		i -= size
+		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PreviousPeriod != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.PreviousPeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AVSRewardPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AVSRewardPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AVSRewardPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDistribution(v)
 	base := offset
@@ -904,6 +1162,38 @@ func (m *FeePool) Size() (n int) {
 	return n
 }
 
+func (m *StakerStartingInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousPeriod != 0 {
+		n += 1 + sovDistribution(uint64(m.PreviousPeriod))
+	}
+	l = m.Stake.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovDistribution(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *AVSRewardPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovDistribution(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1452,6 +1742,212 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *StakerStartingInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakerStartingInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakerStartingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPeriod", wireType)
+			}
+			m.PreviousPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AVSRewardPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AVSRewardPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AVSRewardPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDistribution(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
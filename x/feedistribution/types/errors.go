@@ -12,4 +12,36 @@ var (
 		ModuleName, 1102,
 		"Error: epoch info not found",
 	)
+	ErrNoStakerRewards = sdkerrors.Register(
+		ModuleName, 1103,
+		"Error: no outstanding rewards for the staker",
+	)
+	ErrNoOperatorCommission = sdkerrors.Register(
+		ModuleName, 1104,
+		"Error: no accumulated commission for the operator",
+	)
+	ErrBlockedWithdrawAddress = sdkerrors.Register(
+		ModuleName, 1105,
+		"Error: the withdraw address is not allowed to receive funds",
+	)
+	ErrNotFoundHistoricalRewards = sdkerrors.Register(
+		ModuleName, 1106,
+		"Error: historical rewards of the operator and asset not found",
+	)
+	ErrInvalidReferenceCount = sdkerrors.Register(
+		ModuleName, 1107,
+		"Error: invalid reference count of the historical rewards",
+	)
+	ErrInsufficientCommunityPool = sdkerrors.Register(
+		ModuleName, 1108,
+		"Error: insufficient coins in the community pool",
+	)
+	ErrNoSuchAVS = sdkerrors.Register(
+		ModuleName, 1109,
+		"Error: the AVS is not registered",
+	)
+	ErrInvalidGenesisData = sdkerrors.Register(
+		ModuleName, 1110,
+		"Error: the genesis data supplied is invalid",
+	)
 )
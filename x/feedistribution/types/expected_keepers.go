@@ -3,7 +3,12 @@ package types
 import (
 	"context"
 
+	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
+	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtypes "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	epochsTypes "github.com/ExocoreNetwork/exocore/x/epochs/types"
+	operatortypes "github.com/ExocoreNetwork/exocore/x/operator/types"
+	oracletypes "github.com/ExocoreNetwork/exocore/x/oracle/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -16,6 +21,38 @@ type EpochsKeeper interface {
 
 type FeeDistributionHooks interface{}
 
+// AssetsKeeper represents the expected keeper interface for the assets module.
+type AssetsKeeper interface {
+	GetAssetsDecimal(ctx sdk.Context, assets map[string]interface{}) (decimals map[string]uint32, err error)
+	GetOperatorSpecifiedAssetInfo(ctx sdk.Context, operatorAddr sdk.Address, assetID string) (info *assetstypes.OperatorAssetInfo, err error)
+}
+
+// DelegationKeeper represents the expected keeper interface for the delegation module.
+type DelegationKeeper interface {
+	GetSingleDelegationInfo(ctx sdk.Context, stakerID, assetID, operatorAddr string) (*delegationtypes.DelegationAmounts, error)
+	IterateDelegations(ctx sdk.Context, iteratorPrefix []byte, opFunc delegationkeeper.DelegationOpFunc) error
+	IterateDelegationsForStaker(ctx sdk.Context, stakerID string, opFunc delegationkeeper.DelegationOpFunc) error
+	GetUndelegationRecords(ctx sdk.Context, singleRecordKeys []string) (record []*delegationtypes.UndelegationRecord, err error)
+	GetRedelegationRecord(ctx sdk.Context, recordKey []byte) (*delegationtypes.RedelegationRecord, error)
+}
+
+// OracleKeeper represents the expected keeper interface for the oracle module.
+type OracleKeeper interface {
+	GetMultipleAssetsPrices(ctx sdk.Context, assets map[string]interface{}) (map[string]oracletypes.Price, error)
+}
+
+// OperatorKeeper represents the expected keeper interface for the operator module.
+type OperatorKeeper interface {
+	GetOptedInOperatorListByAVS(ctx sdk.Context, avsAddr string) ([]string, error)
+	GetOperatorOptedUSDValue(ctx sdk.Context, avsAddr, operatorAddr string) (operatortypes.OperatorOptedUSDValue, error)
+	IsEligible(ctx sdk.Context, operatorAddr sdk.AccAddress, avsAddr string) bool
+}
+
+// AVSKeeper represents the expected keeper interface for the AVS module.
+type AVSKeeper interface {
+	IsAVS(ctx sdk.Context, addr string) (bool, error)
+}
+
 // AccountKeeper defines the expected interface for the Account module.
 type AccountKeeper interface {
 	GetAccount(sdk.Context, sdk.AccAddress) types.AccountI // only used for simulation
@@ -1,5 +1,13 @@
 package types
 
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -18,6 +26,197 @@ func NewGenesisState(p Params) *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if err := gs.FeePool.CommunityPool.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidGenesisData, "invalid community pool: %s", err)
+	}
+	if err := gs.ValidateWithdrawInfos(); err != nil {
+		return err
+	}
+	if err := gs.ValidateOperatorRecords(); err != nil {
+		return err
+	}
+	if err := gs.ValidateRewardPools(); err != nil {
+		return err
+	}
+	if err := gs.ValidateStakerRecords(); err != nil {
+		return err
+	}
+	return gs.ValidateAVSRewardPools()
+}
+
+// ValidateWithdrawInfos validates the withdraw addresses of the stakers and operators.
+func (gs GenesisState) ValidateWithdrawInfos() error {
+	seen := make(map[string]struct{}, len(gs.WithdrawInfos))
+	for _, info := range gs.WithdrawInfos {
+		if _, err := sdk.AccAddressFromBech32(info.Address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "invalid address %s", info.Address)
+		}
+		if _, err := sdk.AccAddressFromBech32(info.WithdrawAddress); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "invalid withdraw address %s", info.WithdrawAddress)
+		}
+		if _, ok := seen[info.Address]; ok {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "duplicate withdraw address for %s", info.Address)
+		}
+		seen[info.Address] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateOperatorRecords validates the outstanding rewards and the accumulated commission
+// of the operators.
+func (gs GenesisState) ValidateOperatorRecords() error {
+	seen := make(map[string]struct{}, len(gs.OperatorOutstandingRewards))
+	for _, record := range gs.OperatorOutstandingRewards {
+		if _, err := sdk.AccAddressFromBech32(record.OperatorAddress); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "invalid operator address %s", record.OperatorAddress)
+		}
+		if err := record.OutstandingRewards.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "invalid outstanding rewards of %s: %s", record.OperatorAddress, err)
+		}
+		if _, ok := seen[record.OperatorAddress]; ok {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "duplicate outstanding rewards for %s", record.OperatorAddress)
+		}
+		seen[record.OperatorAddress] = struct{}{}
+	}
+	seen = make(map[string]struct{}, len(gs.OperatorAccumulatedCommissions))
+	for _, record := range gs.OperatorAccumulatedCommissions {
+		if _, err := sdk.AccAddressFromBech32(record.OperatorAddress); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "invalid operator address %s", record.OperatorAddress)
+		}
+		if err := record.Accumulated.Commission.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "invalid commission of %s: %s", record.OperatorAddress, err)
+		}
+		if _, ok := seen[record.OperatorAddress]; ok {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "duplicate commission for %s", record.OperatorAddress)
+		}
+		seen[record.OperatorAddress] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateRewardPools validates the historical and current rewards of the reward pools of
+// the operators and assets, and that the reference count of each historical rewards equals
+// the number of the current rewards and starting infos referencing it.
+func (gs GenesisState) ValidateRewardPools() error {
+	references := make(map[string]uint32, len(gs.OperatorAssetHistoricalRewards))
+	for _, record := range gs.OperatorAssetHistoricalRewards {
+		if _, err := sdk.AccAddressFromBech32(record.OperatorAddress); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "invalid operator address %s", record.OperatorAddress)
+		}
+		if record.AssetID == "" {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "empty asset ID of %s", record.OperatorAddress)
+		}
+		if err := record.Rewards.CumulativeRewardRatio.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "invalid cumulative reward ratio: %s", err)
+		}
+		key := historicalRewardsKey(record.OperatorAddress, record.AssetID, record.Period)
+		if _, ok := references[key]; ok {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "duplicate historical rewards %s", key)
+		}
+		references[key] = 0
+	}
+	seen := make(map[string]struct{}, len(gs.OperatorAssetCurrentRewards))
+	for _, record := range gs.OperatorAssetCurrentRewards {
+		if _, err := sdk.AccAddressFromBech32(record.OperatorAddress); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "invalid operator address %s", record.OperatorAddress)
+		}
+		if err := record.Rewards.Rewards.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "invalid current rewards: %s", err)
+		}
+		poolKey := record.OperatorAddress + "/" + record.AssetID
+		if _, ok := seen[poolKey]; ok {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "duplicate current rewards %s", poolKey)
+		}
+		seen[poolKey] = struct{}{}
+		if record.Rewards.Period == 0 {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "zero period of the current rewards %s", poolKey)
+		}
+		// the current rewards reference the historical rewards of the previous period
+		key := historicalRewardsKey(record.OperatorAddress, record.AssetID, record.Rewards.Period-1)
+		if _, ok := references[key]; !ok {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "historical rewards %s not found", key)
+		}
+		references[key]++
+	}
+	for _, record := range gs.StakerStartingInfos {
+		key := historicalRewardsKey(record.OperatorAddress, record.AssetID, record.StartingInfo.PreviousPeriod)
+		if _, ok := references[key]; !ok {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "historical rewards %s not found", key)
+		}
+		references[key]++
+	}
+	for _, record := range gs.OperatorAssetHistoricalRewards {
+		key := historicalRewardsKey(record.OperatorAddress, record.AssetID, record.Period)
+		if references[key] != record.Rewards.ReferenceCount {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData, "reference count of the historical rewards %s is %d, expected %d",
+				key, record.Rewards.ReferenceCount, references[key],
+			)
+		}
+	}
+	return nil
+}
+
+// ValidateStakerRecords validates the starting infos and the outstanding rewards of the
+// stakers.
+func (gs GenesisState) ValidateStakerRecords() error {
+	seen := make(map[string]struct{}, len(gs.StakerStartingInfos))
+	for _, record := range gs.StakerStartingInfos {
+		if record.StakerID == "" {
+			return errorsmod.Wrap(ErrInvalidGenesisData, "empty staker ID")
+		}
+		if _, err := sdk.AccAddressFromBech32(record.OperatorAddress); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "invalid operator address %s", record.OperatorAddress)
+		}
+		if record.StartingInfo.Stake.IsNil() || !record.StartingInfo.Stake.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "non-positive stake of %s", record.StakerID)
+		}
+		key := record.StakerID + "/" + record.OperatorAddress + "/" + record.AssetID
+		if _, ok := seen[key]; ok {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "duplicate starting info %s", key)
+		}
+		seen[key] = struct{}{}
+	}
+	seen = make(map[string]struct{}, len(gs.StakerOutstandingRewards))
+	for _, record := range gs.StakerOutstandingRewards {
+		if record.StakerID == "" {
+			return errorsmod.Wrap(ErrInvalidGenesisData, "empty staker ID")
+		}
+		if err := record.OutstandingRewards.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "invalid outstanding rewards of %s: %s", record.StakerID, err)
+		}
+		if _, ok := seen[record.StakerID]; ok {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "duplicate outstanding rewards for %s", record.StakerID)
+		}
+		seen[record.StakerID] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateAVSRewardPools validates the reward pools deposited by the AVSs.
+func (gs GenesisState) ValidateAVSRewardPools() error {
+	seen := make(map[common.Address]struct{}, len(gs.AVSRewardPools))
+	for _, record := range gs.AVSRewardPools {
+		if !common.IsHexAddress(record.AVSAddress) {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "invalid AVS address %s", record.AVSAddress)
+		}
+		if err := record.Pool.Rewards.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "invalid rewards of the AVS %s: %s", record.AVSAddress, err)
+		}
+		avsAddr := common.HexToAddress(record.AVSAddress)
+		if _, ok := seen[avsAddr]; ok {
+			return errorsmod.Wrapf(ErrInvalidGenesisData, "duplicate reward pool for the AVS %s", record.AVSAddress)
+		}
+		seen[avsAddr] = struct{}{}
+	}
+	return nil
+}
 
-	return gs.Params.Validate()
+// historicalRewardsKey returns the key identifying the historical rewards of an operator and
+// an asset in a period within the genesis state.
+func historicalRewardsKey(operator, assetID string, period uint64) string {
+	return fmt.Sprintf("%s/%s/%d", operator, assetID, period)
 }
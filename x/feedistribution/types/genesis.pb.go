@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -28,6 +30,28 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// fee_pool is the fee pool, which holds the community pool.
+	FeePool FeePool `protobuf:"bytes,2,opt,name=fee_pool,json=feePool,proto3" json:"fee_pool"`
+	// withdraw_infos is the list of the withdraw addresses set by the stakers and operators.
+	WithdrawInfos []WithdrawAddressInfo `protobuf:"bytes,3,rep,name=withdraw_infos,json=withdrawInfos,proto3" json:"withdraw_infos"`
+	// operator_outstanding_rewards is the list of the outstanding rewards of the operators.
+	OperatorOutstandingRewards []OperatorOutstandingRewardsRecord `protobuf:"bytes,4,rep,name=operator_outstanding_rewards,json=operatorOutstandingRewards,proto3" json:"operator_outstanding_rewards"`
+	// operator_accumulated_commissions is the list of the accumulated commission of the
+	// operators.
+	OperatorAccumulatedCommissions []OperatorAccumulatedCommissionRecord `protobuf:"bytes,5,rep,name=operator_accumulated_commissions,json=operatorAccumulatedCommissions,proto3" json:"operator_accumulated_commissions"`
+	// operator_asset_historical_rewards is the list of the historical rewards of the reward
+	// pools of the operators and assets, including their reference counts.
+	OperatorAssetHistoricalRewards []OperatorAssetHistoricalRewardsRecord `protobuf:"bytes,6,rep,name=operator_asset_historical_rewards,json=operatorAssetHistoricalRewards,proto3" json:"operator_asset_historical_rewards"`
+	// operator_asset_current_rewards is the list of the current rewards of the reward pools
+	// of the operators and assets.
+	OperatorAssetCurrentRewards []OperatorAssetCurrentRewardsRecord `protobuf:"bytes,7,rep,name=operator_asset_current_rewards,json=operatorAssetCurrentRewards,proto3" json:"operator_asset_current_rewards"`
+	// staker_starting_infos is the list of the starting infos of the stakers in the reward
+	// pools. The total stake of each pool is derived from them.
+	StakerStartingInfos []StakerStartingInfoRecord `protobuf:"bytes,8,rep,name=staker_starting_infos,json=stakerStartingInfos,proto3" json:"staker_starting_infos"`
+	// staker_outstanding_rewards is the list of the outstanding rewards of the stakers.
+	StakerOutstandingRewards []StakerOutstandingRewardsRecord `protobuf:"bytes,9,rep,name=staker_outstanding_rewards,json=stakerOutstandingRewards,proto3" json:"staker_outstanding_rewards"`
+	// avs_reward_pools is the list of the reward pools deposited by the AVSs.
+	AVSRewardPools []AVSRewardPoolRecord `protobuf:"bytes,10,rep,name=avs_reward_pools,json=avsRewardPools,proto3" json:"avs_reward_pools"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,95 +94,2720 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*GenesisState)(nil), "exocore.feedistribution.v1.GenesisState")
+func (m *GenesisState) GetFeePool() FeePool {
+	if m != nil {
+		return m.FeePool
+	}
+	return FeePool{}
 }
 
-func init() {
-	proto.RegisterFile("exocore/feedistribution/v1/genesis.proto", fileDescriptor_ee00a1c72b4ca316)
+func (m *GenesisState) GetWithdrawInfos() []WithdrawAddressInfo {
+	if m != nil {
+		return m.WithdrawInfos
+	}
+	return nil
 }
 
-var fileDescriptor_ee00a1c72b4ca316 = []byte{
-	// 227 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x48, 0xad, 0xc8, 0x4f,
-	0xce, 0x2f, 0x4a, 0xd5, 0x4f, 0x4b, 0x4d, 0x4d, 0xc9, 0x2c, 0x2e, 0x29, 0xca, 0x4c, 0x2a, 0x2d,
-	0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x82, 0xaa, 0xd4, 0x43, 0x53, 0xa9, 0x57, 0x66, 0x28, 0x25,
-	0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0xca, 0xa5, 0xd4, 0xf1, 0x18, 0x5c, 0x90,
-	0x58, 0x94, 0x98, 0x0b, 0x35, 0x57, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1,
-	0x20, 0xa2, 0x4a, 0xa1, 0x5c, 0x3c, 0xee, 0x10, 0xeb, 0x83, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x5c,
-	0xb9, 0xd8, 0x20, 0xba, 0x24, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x94, 0xf4, 0x70, 0x3b, 0x47,
-	0x2f, 0x00, 0xac, 0xd2, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06,
-	0x41, 0x35, 0x3b, 0x05, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72,
-	0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x65,
-	0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0xbe, 0x2b, 0xc4, 0x68, 0xbf, 0xd4,
-	0x92, 0xf2, 0xfc, 0xa2, 0x6c, 0x7d, 0x98, 0x4f, 0x2a, 0x30, 0xfc, 0x52, 0x52, 0x59, 0x90, 0x5a,
-	0x9c, 0xc4, 0x06, 0x76, 0xb2, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x7b, 0xe3, 0xe2, 0x4c,
-	0x01, 0x00, 0x00,
+func (m *GenesisState) GetOperatorOutstandingRewards() []OperatorOutstandingRewardsRecord {
+	if m != nil {
+		return m.OperatorOutstandingRewards
+	}
+	return nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GenesisState) GetOperatorAccumulatedCommissions() []OperatorAccumulatedCommissionRecord {
+	if m != nil {
+		return m.OperatorAccumulatedCommissions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GenesisState) GetOperatorAssetHistoricalRewards() []OperatorAssetHistoricalRewardsRecord {
+	if m != nil {
+		return m.OperatorAssetHistoricalRewards
+	}
+	return nil
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *GenesisState) GetOperatorAssetCurrentRewards() []OperatorAssetCurrentRewardsRecord {
+	if m != nil {
+		return m.OperatorAssetCurrentRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStakerStartingInfos() []StakerStartingInfoRecord {
+	if m != nil {
+		return m.StakerStartingInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStakerOutstandingRewards() []StakerOutstandingRewardsRecord {
+	if m != nil {
+		return m.StakerOutstandingRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAVSRewardPools() []AVSRewardPoolRecord {
+	if m != nil {
+		return m.AVSRewardPools
+	}
+	return nil
+}
+
+// WithdrawAddressInfo is the withdraw address of a staker or an operator.
+type WithdrawAddressInfo struct {
+	// address is the bech32 address of the staker or the operator.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// withdraw_address is the bech32 address to which the rewards are sent.
+	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+}
+
+func (m *WithdrawAddressInfo) Reset()         { *m = WithdrawAddressInfo{} }
+func (m *WithdrawAddressInfo) String() string { return proto.CompactTextString(m) }
+func (*WithdrawAddressInfo) ProtoMessage()    {}
+func (*WithdrawAddressInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee00a1c72b4ca316, []int{1}
+}
+func (m *WithdrawAddressInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawAddressInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawAddressInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawAddressInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawAddressInfo.Merge(m, src)
+}
+func (m *WithdrawAddressInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawAddressInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawAddressInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawAddressInfo proto.InternalMessageInfo
+
+func (m *WithdrawAddressInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *WithdrawAddressInfo) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
+}
+
+// OperatorOutstandingRewardsRecord is the outstanding rewards of an operator.
+type OperatorOutstandingRewardsRecord struct {
+	// operator_address is the bech32 address of the operator.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// outstanding_rewards are the outstanding rewards of the operator.
+	OutstandingRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=outstanding_rewards,json=outstandingRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"outstanding_rewards"`
+}
+
+func (m *OperatorOutstandingRewardsRecord) Reset()         { *m = OperatorOutstandingRewardsRecord{} }
+func (m *OperatorOutstandingRewardsRecord) String() string { return proto.CompactTextString(m) }
+func (*OperatorOutstandingRewardsRecord) ProtoMessage()    {}
+func (*OperatorOutstandingRewardsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee00a1c72b4ca316, []int{2}
+}
+func (m *OperatorOutstandingRewardsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorOutstandingRewardsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorOutstandingRewardsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorOutstandingRewardsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorOutstandingRewardsRecord.Merge(m, src)
+}
+func (m *OperatorOutstandingRewardsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorOutstandingRewardsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorOutstandingRewardsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorOutstandingRewardsRecord proto.InternalMessageInfo
+
+func (m *OperatorOutstandingRewardsRecord) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *OperatorOutstandingRewardsRecord) GetOutstandingRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.OutstandingRewards
+	}
+	return nil
+}
+
+// OperatorAccumulatedCommissionRecord is the accumulated commission of an operator.
+type OperatorAccumulatedCommissionRecord struct {
+	// operator_address is the bech32 address of the operator.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// accumulated is the accumulated commission of the operator.
+	Accumulated ValidatorAccumulatedCommission `protobuf:"bytes,2,opt,name=accumulated,proto3" json:"accumulated"`
+}
+
+func (m *OperatorAccumulatedCommissionRecord) Reset()         { *m = OperatorAccumulatedCommissionRecord{} }
+func (m *OperatorAccumulatedCommissionRecord) String() string { return proto.CompactTextString(m) }
+func (*OperatorAccumulatedCommissionRecord) ProtoMessage()    {}
+func (*OperatorAccumulatedCommissionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee00a1c72b4ca316, []int{3}
+}
+func (m *OperatorAccumulatedCommissionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorAccumulatedCommissionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorAccumulatedCommissionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorAccumulatedCommissionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorAccumulatedCommissionRecord.Merge(m, src)
+}
+func (m *OperatorAccumulatedCommissionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorAccumulatedCommissionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorAccumulatedCommissionRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorAccumulatedCommissionRecord proto.InternalMessageInfo
+
+func (m *OperatorAccumulatedCommissionRecord) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *OperatorAccumulatedCommissionRecord) GetAccumulated() ValidatorAccumulatedCommission {
+	if m != nil {
+		return m.Accumulated
+	}
+	return ValidatorAccumulatedCommission{}
+}
+
+// OperatorAssetHistoricalRewardsRecord is the historical rewards of the reward pool of an
+// operator and an asset in a period.
+type OperatorAssetHistoricalRewardsRecord struct {
+	// operator_address is the bech32 address of the operator.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// asset_id is the id of the asset.
+	AssetID string `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// period is the period of the historical rewards.
+	Period uint64 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	// rewards are the historical rewards, including the reference count.
+	Rewards ValidatorHistoricalRewards `protobuf:"bytes,4,opt,name=rewards,proto3" json:"rewards"`
+}
+
+func (m *OperatorAssetHistoricalRewardsRecord) Reset()         { *m = OperatorAssetHistoricalRewardsRecord{} }
+func (m *OperatorAssetHistoricalRewardsRecord) String() string { return proto.CompactTextString(m) }
+func (*OperatorAssetHistoricalRewardsRecord) ProtoMessage()    {}
+func (*OperatorAssetHistoricalRewardsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee00a1c72b4ca316, []int{4}
+}
+func (m *OperatorAssetHistoricalRewardsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorAssetHistoricalRewardsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorAssetHistoricalRewardsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorAssetHistoricalRewardsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorAssetHistoricalRewardsRecord.Merge(m, src)
+}
+func (m *OperatorAssetHistoricalRewardsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorAssetHistoricalRewardsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorAssetHistoricalRewardsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorAssetHistoricalRewardsRecord proto.InternalMessageInfo
+
+func (m *OperatorAssetHistoricalRewardsRecord) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *OperatorAssetHistoricalRewardsRecord) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+func (m *OperatorAssetHistoricalRewardsRecord) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *OperatorAssetHistoricalRewardsRecord) GetRewards() ValidatorHistoricalRewards {
+	if m != nil {
+		return m.Rewards
+	}
+	return ValidatorHistoricalRewards{}
+}
+
+// OperatorAssetCurrentRewardsRecord is the current rewards of the reward pool of an operator
+// and an asset.
+type OperatorAssetCurrentRewardsRecord struct {
+	// operator_address is the bech32 address of the operator.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// asset_id is the id of the asset.
+	AssetID string `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// rewards are the current rewards and the current period of the pool.
+	Rewards ValidatorCurrentRewards `protobuf:"bytes,3,opt,name=rewards,proto3" json:"rewards"`
+}
+
+func (m *OperatorAssetCurrentRewardsRecord) Reset()         { *m = OperatorAssetCurrentRewardsRecord{} }
+func (m *OperatorAssetCurrentRewardsRecord) String() string { return proto.CompactTextString(m) }
+func (*OperatorAssetCurrentRewardsRecord) ProtoMessage()    {}
+func (*OperatorAssetCurrentRewardsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee00a1c72b4ca316, []int{5}
+}
+func (m *OperatorAssetCurrentRewardsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorAssetCurrentRewardsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorAssetCurrentRewardsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorAssetCurrentRewardsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorAssetCurrentRewardsRecord.Merge(m, src)
+}
+func (m *OperatorAssetCurrentRewardsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorAssetCurrentRewardsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorAssetCurrentRewardsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorAssetCurrentRewardsRecord proto.InternalMessageInfo
+
+func (m *OperatorAssetCurrentRewardsRecord) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *OperatorAssetCurrentRewardsRecord) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+func (m *OperatorAssetCurrentRewardsRecord) GetRewards() ValidatorCurrentRewards {
+	if m != nil {
+		return m.Rewards
+	}
+	return ValidatorCurrentRewards{}
+}
+
+// StakerStartingInfoRecord is the starting info of a staker in the reward pool of an
+// operator and an asset.
+type StakerStartingInfoRecord struct {
+	// staker_id is the id of the staker.
+	StakerID string `protobuf:"bytes,1,opt,name=staker_id,json=stakerId,proto3" json:"staker_id,omitempty"`
+	// operator_address is the bech32 address of the operator.
+	OperatorAddress string `protobuf:"bytes,2,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// asset_id is the id of the asset.
+	AssetID string `protobuf:"bytes,3,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// starting_info is the starting info of the staker.
+	StartingInfo StakerStartingInfo `protobuf:"bytes,4,opt,name=starting_info,json=startingInfo,proto3" json:"starting_info"`
+}
+
+func (m *StakerStartingInfoRecord) Reset()         { *m = StakerStartingInfoRecord{} }
+func (m *StakerStartingInfoRecord) String() string { return proto.CompactTextString(m) }
+func (*StakerStartingInfoRecord) ProtoMessage()    {}
+func (*StakerStartingInfoRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee00a1c72b4ca316, []int{6}
+}
+func (m *StakerStartingInfoRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakerStartingInfoRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakerStartingInfoRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakerStartingInfoRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakerStartingInfoRecord.Merge(m, src)
+}
+func (m *StakerStartingInfoRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakerStartingInfoRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakerStartingInfoRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakerStartingInfoRecord proto.InternalMessageInfo
+
+func (m *StakerStartingInfoRecord) GetStakerID() string {
+	if m != nil {
+		return m.StakerID
+	}
+	return ""
+}
+
+func (m *StakerStartingInfoRecord) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *StakerStartingInfoRecord) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+func (m *StakerStartingInfoRecord) GetStartingInfo() StakerStartingInfo {
+	if m != nil {
+		return m.StartingInfo
+	}
+	return StakerStartingInfo{}
+}
+
+// StakerOutstandingRewardsRecord is the outstanding rewards of a staker.
+type StakerOutstandingRewardsRecord struct {
+	// staker_id is the id of the staker.
+	StakerID string `protobuf:"bytes,1,opt,name=staker_id,json=stakerId,proto3" json:"staker_id,omitempty"`
+	// outstanding_rewards are the outstanding rewards of the staker.
+	OutstandingRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=outstanding_rewards,json=outstandingRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"outstanding_rewards"`
+}
+
+func (m *StakerOutstandingRewardsRecord) Reset()         { *m = StakerOutstandingRewardsRecord{} }
+func (m *StakerOutstandingRewardsRecord) String() string { return proto.CompactTextString(m) }
+func (*StakerOutstandingRewardsRecord) ProtoMessage()    {}
+func (*StakerOutstandingRewardsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee00a1c72b4ca316, []int{7}
+}
+func (m *StakerOutstandingRewardsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakerOutstandingRewardsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakerOutstandingRewardsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakerOutstandingRewardsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakerOutstandingRewardsRecord.Merge(m, src)
+}
+func (m *StakerOutstandingRewardsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakerOutstandingRewardsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakerOutstandingRewardsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakerOutstandingRewardsRecord proto.InternalMessageInfo
+
+func (m *StakerOutstandingRewardsRecord) GetStakerID() string {
+	if m != nil {
+		return m.StakerID
+	}
+	return ""
+}
+
+func (m *StakerOutstandingRewardsRecord) GetOutstandingRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.OutstandingRewards
+	}
+	return nil
+}
+
+// AVSRewardPoolRecord is the reward pool deposited by an AVS.
+type AVSRewardPoolRecord struct {
+	// avs_address is the hex address of the AVS.
+	AVSAddress string `protobuf:"bytes,1,opt,name=avs_address,json=avsAddress,proto3" json:"avs_address,omitempty"`
+	// pool is the reward pool of the AVS.
+	Pool AVSRewardPool `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool"`
+}
+
+func (m *AVSRewardPoolRecord) Reset()         { *m = AVSRewardPoolRecord{} }
+func (m *AVSRewardPoolRecord) String() string { return proto.CompactTextString(m) }
+func (*AVSRewardPoolRecord) ProtoMessage()    {}
+func (*AVSRewardPoolRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee00a1c72b4ca316, []int{8}
+}
+func (m *AVSRewardPoolRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AVSRewardPoolRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AVSRewardPoolRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AVSRewardPoolRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AVSRewardPoolRecord.Merge(m, src)
+}
+func (m *AVSRewardPoolRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AVSRewardPoolRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AVSRewardPoolRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AVSRewardPoolRecord proto.InternalMessageInfo
+
+func (m *AVSRewardPoolRecord) GetAVSAddress() string {
+	if m != nil {
+		return m.AVSAddress
+	}
+	return ""
+}
+
+func (m *AVSRewardPoolRecord) GetPool() AVSRewardPool {
+	if m != nil {
+		return m.Pool
+	}
+	return AVSRewardPool{}
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "exocore.feedistribution.v1.GenesisState")
+	proto.RegisterType((*WithdrawAddressInfo)(nil), "exocore.feedistribution.v1.WithdrawAddressInfo")
+	proto.RegisterType((*OperatorOutstandingRewardsRecord)(nil), "exocore.feedistribution.v1.OperatorOutstandingRewardsRecord")
+	proto.RegisterType((*OperatorAccumulatedCommissionRecord)(nil), "exocore.feedistribution.v1.OperatorAccumulatedCommissionRecord")
+	proto.RegisterType((*OperatorAssetHistoricalRewardsRecord)(nil), "exocore.feedistribution.v1.OperatorAssetHistoricalRewardsRecord")
+	proto.RegisterType((*OperatorAssetCurrentRewardsRecord)(nil), "exocore.feedistribution.v1.OperatorAssetCurrentRewardsRecord")
+	proto.RegisterType((*StakerStartingInfoRecord)(nil), "exocore.feedistribution.v1.StakerStartingInfoRecord")
+	proto.RegisterType((*StakerOutstandingRewardsRecord)(nil), "exocore.feedistribution.v1.StakerOutstandingRewardsRecord")
+	proto.RegisterType((*AVSRewardPoolRecord)(nil), "exocore.feedistribution.v1.AVSRewardPoolRecord")
+}
+
+func init() {
+	proto.RegisterFile("exocore/feedistribution/v1/genesis.proto", fileDescriptor_ee00a1c72b4ca316)
+}
+
+var fileDescriptor_ee00a1c72b4ca316 = []byte{
+	// 964 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x31, 0x8f, 0x1b, 0x45,
+	0x14, 0xbe, 0x39, 0x1f, 0x67, 0x7b, 0x7c, 0xb9, 0x84, 0x39, 0x40, 0x2b, 0x27, 0x5a, 0x3b, 0x0e,
+	0x82, 0x3b, 0x50, 0x76, 0x75, 0x09, 0x42, 0x80, 0x40, 0x70, 0xbe, 0x0b, 0xc4, 0x0d, 0x89, 0x6c,
+	0xe9, 0x40, 0x41, 0xc2, 0x1a, 0xef, 0x8e, 0x7d, 0xa3, 0xb3, 0x77, 0xac, 0x99, 0xb1, 0x1d, 0x6a,
+	0x0a, 0x44, 0x81, 0x94, 0x02, 0xf1, 0x1b, 0x10, 0x15, 0x2d, 0x2d, 0x55, 0xca, 0x6b, 0x10, 0x54,
+	0x06, 0xf9, 0x0a, 0x3a, 0x6a, 0x4a, 0xb4, 0x33, 0x63, 0x7b, 0x1d, 0xdb, 0xeb, 0x75, 0x81, 0x94,
+	0xc6, 0xde, 0xdd, 0x79, 0xef, 0x7b, 0xdf, 0xf7, 0xe6, 0xcd, 0x7b, 0x03, 0xf7, 0xc9, 0x63, 0xe6,
+	0x31, 0x4e, 0xdc, 0x26, 0x21, 0x3e, 0x15, 0x92, 0xd3, 0x46, 0x4f, 0x52, 0x16, 0xb8, 0xfd, 0x43,
+	0xb7, 0x45, 0x02, 0x22, 0xa8, 0x70, 0xba, 0x9c, 0x49, 0x86, 0xf2, 0xc6, 0xd2, 0x79, 0xc6, 0xd2,
+	0xe9, 0x1f, 0xe6, 0x5f, 0xc4, 0x1d, 0x1a, 0x30, 0x57, 0xfd, 0x6a, 0xf3, 0xbc, 0xed, 0x31, 0xd1,
+	0x61, 0xc2, 0x6d, 0x60, 0x41, 0xdc, 0xfe, 0x61, 0x83, 0x48, 0x7c, 0xe8, 0x7a, 0x8c, 0x06, 0x66,
+	0xfd, 0x76, 0x4c, 0xe0, 0x19, 0x78, 0x6d, 0xfe, 0x7a, 0x8c, 0x79, 0x17, 0x73, 0xdc, 0x31, 0x34,
+	0xf3, 0x2f, 0xb5, 0x58, 0x8b, 0xa9, 0x47, 0x37, 0x7c, 0xd2, 0x5f, 0x4b, 0xbf, 0x66, 0xe1, 0xce,
+	0x27, 0x5a, 0x4e, 0x4d, 0x62, 0x49, 0xd0, 0x3d, 0xb8, 0xad, 0xdd, 0x2c, 0x50, 0x04, 0xfb, 0xb9,
+	0x3b, 0x25, 0x67, 0xb9, 0x3c, 0xe7, 0xa1, 0xb2, 0x2c, 0x67, 0x9f, 0x0e, 0x0b, 0x1b, 0x3f, 0xfe,
+	0xfd, 0xf3, 0x1b, 0xa0, 0x6a, 0x9c, 0x51, 0x05, 0x66, 0x9a, 0x84, 0xd4, 0xbb, 0x8c, 0xb5, 0xad,
+	0x4d, 0x05, 0x74, 0x2b, 0x0e, 0xe8, 0x63, 0x42, 0x1e, 0x32, 0xd6, 0x8e, 0x22, 0xa5, 0x9b, 0xfa,
+	0x1b, 0xc2, 0x70, 0x77, 0x40, 0xe5, 0x99, 0xcf, 0xf1, 0xa0, 0x4e, 0x83, 0x26, 0x13, 0x56, 0xaa,
+	0x98, 0xda, 0xcf, 0xdd, 0x71, 0xe3, 0x00, 0x3f, 0x33, 0x1e, 0x47, 0xbe, 0xcf, 0x89, 0x10, 0x95,
+	0xa0, 0xc9, 0xa2, 0xe0, 0x57, 0xc6, 0x88, 0xe1, 0x82, 0x40, 0xdf, 0x02, 0x78, 0x83, 0x75, 0x09,
+	0xc7, 0x92, 0xf1, 0x3a, 0xeb, 0x49, 0x21, 0x71, 0xe0, 0xd3, 0xa0, 0x55, 0xe7, 0x64, 0x80, 0xb9,
+	0x2f, 0xac, 0x2d, 0x15, 0xf1, 0xfd, 0xb8, 0x88, 0x0f, 0x8c, 0xff, 0x83, 0xa9, 0x7b, 0x55, 0x7b,
+	0x57, 0x89, 0xc7, 0xb8, 0x1f, 0x0d, 0x9f, 0x67, 0x4b, 0x8d, 0xd1, 0xf7, 0x00, 0x16, 0x27, 0x5c,
+	0xb0, 0xe7, 0xf5, 0x3a, 0xbd, 0x36, 0x96, 0xc4, 0xaf, 0x7b, 0xac, 0xd3, 0xa1, 0x42, 0x50, 0x16,
+	0x08, 0xeb, 0x05, 0xc5, 0xe7, 0xc3, 0x24, 0x7c, 0x8e, 0xa6, 0x10, 0xc7, 0x13, 0x84, 0x79, 0x4a,
+	0x36, 0x8b, 0xb3, 0x17, 0xe8, 0x07, 0x00, 0x6f, 0x4e, 0x69, 0x09, 0x41, 0x64, 0xfd, 0x8c, 0x0a,
+	0xc9, 0x38, 0xf5, 0x70, 0x7b, 0x92, 0xa7, 0x6d, 0xc5, 0xeb, 0xa3, 0x44, 0xbc, 0x42, 0x8c, 0xfb,
+	0x13, 0x88, 0xa5, 0xb9, 0xb2, 0x59, 0xac, 0x03, 0xfa, 0x0e, 0x40, 0xfb, 0x19, 0x62, 0x5e, 0x8f,
+	0x73, 0x12, 0xc8, 0x09, 0xab, 0xb4, 0x62, 0xf5, 0x41, 0x62, 0x56, 0xc7, 0xda, 0x7f, 0x29, 0xa5,
+	0xeb, 0x6c, 0xb9, 0x35, 0x12, 0xf0, 0x65, 0x21, 0xf1, 0x39, 0xe1, 0x75, 0x21, 0x31, 0x97, 0x61,
+	0x15, 0xe9, 0xaa, 0xcd, 0x28, 0x16, 0x6f, 0xc5, 0xb1, 0xa8, 0x29, 0xc7, 0x9a, 0xf1, 0x0b, 0x6b,
+	0x73, 0x3e, 0xf8, 0x9e, 0x98, 0x33, 0x12, 0xe8, 0x6b, 0x00, 0xf3, 0x26, 0xea, 0xa2, 0xf2, 0xcd,
+	0xaa, 0xd0, 0xef, 0xad, 0x0e, 0x9d, 0xa4, 0x78, 0x2d, 0xb1, 0xc4, 0x14, 0xf5, 0xe1, 0x35, 0xdc,
+	0x17, 0x26, 0xaa, 0x3a, 0xfb, 0xc2, 0x82, 0xab, 0xcf, 0xea, 0xd1, 0x69, 0x4d, 0x23, 0x84, 0xc7,
+	0xdd, 0xc4, 0xbb, 0x1e, 0xc6, 0x1b, 0x0d, 0x0b, 0xbb, 0x33, 0x8b, 0x42, 0x33, 0xd8, 0xc5, 0x7d,
+	0x11, 0xf9, 0x58, 0x7a, 0x04, 0xf7, 0x16, 0x9c, 0x77, 0x64, 0xc1, 0x34, 0xd6, 0xaf, 0xaa, 0x97,
+	0x65, 0xab, 0xe3, 0x57, 0x74, 0x00, 0xaf, 0x4d, 0x5a, 0xca, 0xd8, 0x64, 0x53, 0x99, 0x5c, 0x1d,
+	0xcc, 0x02, 0x95, 0x7e, 0x07, 0xb0, 0xb8, 0xea, 0x68, 0x87, 0x78, 0xd3, 0x12, 0x9c, 0x09, 0x79,
+	0x75, 0x52, 0x2a, 0x26, 0xf4, 0x37, 0x00, 0xee, 0x2d, 0xda, 0xa2, 0x4d, 0x95, 0xa7, 0x1b, 0x8e,
+	0x9e, 0x0e, 0x4e, 0x38, 0x1d, 0x1c, 0x33, 0x1d, 0x9c, 0x13, 0xe2, 0x1d, 0x33, 0x1a, 0x94, 0xdf,
+	0x09, 0x93, 0xf2, 0xd3, 0x9f, 0x85, 0x37, 0x5b, 0x54, 0x9e, 0xf5, 0x1a, 0x8e, 0xc7, 0x3a, 0xae,
+	0x99, 0x26, 0xfa, 0xef, 0xb6, 0xf0, 0xcf, 0x5d, 0xf9, 0x55, 0x97, 0x88, 0xb1, 0x8f, 0xc9, 0x18,
+	0x62, 0x73, 0xd4, 0x4b, 0xbf, 0x00, 0x78, 0x2b, 0x41, 0x93, 0x58, 0x47, 0x5c, 0x0b, 0xe6, 0x22,
+	0x1d, 0xcb, 0x34, 0xfe, 0xd8, 0xb2, 0x3b, 0xc5, 0x6d, 0xea, 0x2f, 0x65, 0x10, 0x2d, 0xbb, 0x28,
+	0x72, 0xe9, 0x1f, 0x00, 0x5f, 0x4d, 0xd2, 0x48, 0xd6, 0x21, 0xff, 0x1a, 0xcc, 0xe8, 0xf6, 0x41,
+	0x35, 0xf3, 0x6c, 0x39, 0x37, 0x1a, 0x16, 0xd2, 0x0a, 0xbe, 0x72, 0x52, 0x4d, 0xab, 0xc5, 0x8a,
+	0x8f, 0x5e, 0x81, 0xdb, 0x5d, 0xc2, 0x29, 0xf3, 0xad, 0x54, 0x11, 0xec, 0x6f, 0x55, 0xcd, 0x1b,
+	0xfa, 0x02, 0xa6, 0xa7, 0xe3, 0x22, 0x14, 0xfe, 0x76, 0x22, 0xe1, 0x73, 0xcc, 0x67, 0x86, 0xa0,
+	0x41, 0x2c, 0x5d, 0x00, 0x78, 0x73, 0x65, 0x8f, 0xfa, 0x3f, 0xd4, 0x7e, 0x3e, 0x55, 0x95, 0x52,
+	0xaa, 0xee, 0x26, 0x52, 0x35, 0x4b, 0x6f, 0xa1, 0xa4, 0x7f, 0x01, 0xb4, 0x96, 0x35, 0x3c, 0x74,
+	0x00, 0xb3, 0xa6, 0x9f, 0x51, 0x5f, 0x4b, 0x28, 0xef, 0x8c, 0x86, 0x85, 0x8c, 0x76, 0xa8, 0x9c,
+	0x54, 0x33, 0x7a, 0xb9, 0xb2, 0x58, 0xf4, 0xe6, 0x6a, 0xd1, 0xa9, 0x18, 0xd1, 0x5f, 0xc2, 0x2b,
+	0x33, 0xcd, 0xdb, 0x6c, 0xa8, 0xb3, 0x5e, 0xef, 0x8e, 0xaa, 0xde, 0x11, 0x91, 0x85, 0xd2, 0x6f,
+	0x00, 0xda, 0xf1, 0x0d, 0x77, 0x9d, 0x04, 0x3c, 0x3f, 0x2d, 0xe5, 0x09, 0x80, 0x7b, 0x0b, 0xba,
+	0x39, 0x72, 0x61, 0x2e, 0x1c, 0x0c, 0x33, 0x25, 0x59, 0xde, 0x1d, 0x0d, 0x0b, 0xf0, 0xe8, 0xb4,
+	0x66, 0x36, 0xa7, 0x0a, 0x71, 0x5f, 0x8c, 0x37, 0xea, 0x3e, 0xdc, 0x8a, 0x5c, 0x1d, 0x0f, 0x12,
+	0x4f, 0x8f, 0x68, 0xca, 0x15, 0x42, 0xb9, 0xf6, 0x74, 0x64, 0x83, 0x8b, 0x91, 0x0d, 0xfe, 0x1a,
+	0xd9, 0xe0, 0xc9, 0xa5, 0xbd, 0x71, 0x71, 0x69, 0x6f, 0xfc, 0x71, 0x69, 0x6f, 0x3c, 0x7a, 0x37,
+	0x22, 0xf9, 0x9e, 0xc6, 0xff, 0x94, 0xc8, 0x01, 0xe3, 0xe7, 0xee, 0xf8, 0x4e, 0xfd, 0x78, 0xee,
+	0x56, 0xad, 0x32, 0xd1, 0xd8, 0x56, 0x97, 0xe7, 0xbb, 0xff, 0x0d, 0x00, 0xea, 0x65, 0x48, 0xaf,
+	0x25, 0x0c, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AVSRewardPools) > 0 {
+		for iNdEx := len(m.AVSRewardPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AVSRewardPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.StakerOutstandingRewards) > 0 {
+		for iNdEx := len(m.StakerOutstandingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakerOutstandingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.StakerStartingInfos) > 0 {
+		for iNdEx := len(m.StakerStartingInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakerStartingInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.OperatorAssetCurrentRewards) > 0 {
+		for iNdEx := len(m.OperatorAssetCurrentRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorAssetCurrentRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.OperatorAssetHistoricalRewards) > 0 {
+		for iNdEx := len(m.OperatorAssetHistoricalRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorAssetHistoricalRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.OperatorAccumulatedCommissions) > 0 {
+		for iNdEx := len(m.OperatorAccumulatedCommissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorAccumulatedCommissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.OperatorOutstandingRewards) > 0 {
+		for iNdEx := len(m.OperatorOutstandingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorOutstandingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.WithdrawInfos) > 0 {
+		for iNdEx := len(m.WithdrawInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.FeePool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawAddressInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawAddressInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawAddressInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorOutstandingRewardsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorOutstandingRewardsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorOutstandingRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OutstandingRewards) > 0 {
+		for iNdEx := len(m.OutstandingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutstandingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorAccumulatedCommissionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorAccumulatedCommissionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorAccumulatedCommissionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Accumulated.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorAssetHistoricalRewardsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorAssetHistoricalRewardsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorAssetHistoricalRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Period != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorAssetCurrentRewardsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorAssetCurrentRewardsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorAssetCurrentRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakerStartingInfoRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakerStartingInfoRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakerStartingInfoRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StartingInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakerOutstandingRewardsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakerOutstandingRewardsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakerOutstandingRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OutstandingRewards) > 0 {
+		for iNdEx := len(m.OutstandingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutstandingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AVSRewardPoolRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AVSRewardPoolRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AVSRewardPoolRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.AVSAddress) > 0 {
+		i -= len(m.AVSAddress)
+		copy(dAtA[i:], m.AVSAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AVSAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.FeePool.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.WithdrawInfos) > 0 {
+		for _, e := range m.WithdrawInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OperatorOutstandingRewards) > 0 {
+		for _, e := range m.OperatorOutstandingRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OperatorAccumulatedCommissions) > 0 {
+		for _, e := range m.OperatorAccumulatedCommissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OperatorAssetHistoricalRewards) > 0 {
+		for _, e := range m.OperatorAssetHistoricalRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OperatorAssetCurrentRewards) > 0 {
+		for _, e := range m.OperatorAssetCurrentRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StakerStartingInfos) > 0 {
+		for _, e := range m.StakerStartingInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StakerOutstandingRewards) > 0 {
+		for _, e := range m.StakerOutstandingRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AVSRewardPools) > 0 {
+		for _, e := range m.AVSRewardPools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WithdrawAddressInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *OperatorOutstandingRewardsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.OutstandingRewards) > 0 {
+		for _, e := range m.OutstandingRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OperatorAccumulatedCommissionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Accumulated.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *OperatorAssetHistoricalRewardsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Period != 0 {
+		n += 1 + sovGenesis(uint64(m.Period))
+	}
+	l = m.Rewards.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *OperatorAssetCurrentRewardsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Rewards.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *StakerStartingInfoRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.StartingInfo.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *StakerOutstandingRewardsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.OutstandingRewards) > 0 {
+		for _, e := range m.OutstandingRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AVSRewardPoolRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AVSAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Pool.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawInfos = append(m.WithdrawInfos, WithdrawAddressInfo{})
+			if err := m.WithdrawInfos[len(m.WithdrawInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorOutstandingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorOutstandingRewards = append(m.OperatorOutstandingRewards, OperatorOutstandingRewardsRecord{})
+			if err := m.OperatorOutstandingRewards[len(m.OperatorOutstandingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAccumulatedCommissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAccumulatedCommissions = append(m.OperatorAccumulatedCommissions, OperatorAccumulatedCommissionRecord{})
+			if err := m.OperatorAccumulatedCommissions[len(m.OperatorAccumulatedCommissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAssetHistoricalRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAssetHistoricalRewards = append(m.OperatorAssetHistoricalRewards, OperatorAssetHistoricalRewardsRecord{})
+			if err := m.OperatorAssetHistoricalRewards[len(m.OperatorAssetHistoricalRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAssetCurrentRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAssetCurrentRewards = append(m.OperatorAssetCurrentRewards, OperatorAssetCurrentRewardsRecord{})
+			if err := m.OperatorAssetCurrentRewards[len(m.OperatorAssetCurrentRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerStartingInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerStartingInfos = append(m.StakerStartingInfos, StakerStartingInfoRecord{})
+			if err := m.StakerStartingInfos[len(m.StakerStartingInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerOutstandingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerOutstandingRewards = append(m.StakerOutstandingRewards, StakerOutstandingRewardsRecord{})
+			if err := m.StakerOutstandingRewards[len(m.StakerOutstandingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AVSRewardPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AVSRewardPools = append(m.AVSRewardPools, AVSRewardPoolRecord{})
+			if err := m.AVSRewardPools[len(m.AVSRewardPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawAddressInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawAddressInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawAddressInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorOutstandingRewardsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorOutstandingRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutstandingRewards = append(m.OutstandingRewards, types.DecCoin{})
+			if err := m.OutstandingRewards[len(m.OutstandingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorAccumulatedCommissionRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorAccumulatedCommissionRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorAccumulatedCommissionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accumulated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Accumulated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorAssetHistoricalRewardsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorAssetHistoricalRewardsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorAssetHistoricalRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorAssetCurrentRewardsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorAssetCurrentRewardsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorAssetCurrentRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakerStartingInfoRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakerStartingInfoRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakerStartingInfoRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StakerOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakerOutstandingRewardsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakerOutstandingRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutstandingRewards = append(m.OutstandingRewards, types.DecCoin{})
+			if err := m.OutstandingRewards[len(m.OutstandingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *AVSRewardPoolRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -181,15 +2830,47 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: AVSRewardPoolRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AVSRewardPoolRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AVSAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AVSAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -216,7 +2897,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
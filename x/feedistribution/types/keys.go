@@ -1,6 +1,9 @@
 package types
 
 import (
+	"encoding/binary"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -44,6 +47,12 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x01} // key for current validator rewards
 	ValidatorOutstandingRewardsPrefix    = []byte{0x02} // key for outstanding rewards
 	StakerOutstandingRewardsPrefix       = []byte{0x03} // key for outstanding rewards of staker
+	WithdrawAddrPrefix                   = []byte{0x04} // key for withdraw address of staker or operator
+	OperatorAssetHistoricalRewardsPrefix = []byte{0x05} // key for historical rewards of operator and asset
+	OperatorAssetCurrentRewardsPrefix    = []byte{0x06} // key for current rewards of operator and asset
+	OperatorAssetTotalStakePrefix        = []byte{0x07} // key for total stake of operator and asset
+	StakerStartingInfoPrefix             = []byte{0x08} // key for starting info of staker rewards
+	AVSRewardPoolPrefix                  = []byte{0x09} // key for reward pool of AVS
 )
 
 var (
@@ -53,10 +62,18 @@ var (
 	EventTypeWithdrawRewards    = "withdraw_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeFundCommunityPool  = "fund_community_pool"
+	EventTypeCommunityPoolSpend = "community_pool_spend"
+	EventTypeDepositAVSRewards  = "deposit_avs_rewards"
+	EventTypeAVSRewards         = "avs_rewards"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
 	AttributeKeyDelegator       = "delegator"
+	AttributeKeyStakerID        = "staker_id"
+	AttributeKeyDepositor       = "depositor"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyAVSAddress      = "avs_address"
 )
 
 func KeyPrefix(p string) []byte {
@@ -82,3 +99,69 @@ func GetValidatorOutstandingRewardsKey(valAddr sdk.ValAddress) []byte {
 func GetStakerOutstandingRewardsKey(staker string) []byte {
 	return append(StakerOutstandingRewardsPrefix, address.MustLengthPrefix([]byte(staker))...)
 }
+
+// GetWithdrawAddrKey creates the key for the withdraw address of a staker or an operator.
+func GetWithdrawAddrKey(addr sdk.AccAddress) []byte {
+	return append(WithdrawAddrPrefix, address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// GetOperatorAssetKey creates the key for an operator and an asset, which is shared by the
+// current rewards and the total stake of the operator and asset.
+func GetOperatorAssetKey(operator sdk.AccAddress, assetID string) []byte {
+	return append(address.MustLengthPrefix(operator.Bytes()), address.MustLengthPrefix([]byte(assetID))...)
+}
+
+// GetOperatorAssetHistoricalRewardsKey creates the key for the historical rewards of an
+// operator and an asset in a period.
+func GetOperatorAssetHistoricalRewardsKey(operator sdk.AccAddress, assetID string, period uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, period)
+	key := append(OperatorAssetHistoricalRewardsPrefix, GetOperatorAssetKey(operator, assetID)...)
+	return append(key, b...)
+}
+
+// GetOperatorAssetCurrentRewardsKey creates the key for the current rewards of an operator
+// and an asset.
+func GetOperatorAssetCurrentRewardsKey(operator sdk.AccAddress, assetID string) []byte {
+	return append(OperatorAssetCurrentRewardsPrefix, GetOperatorAssetKey(operator, assetID)...)
+}
+
+// GetOperatorAssetTotalStakeKey creates the key for the total stake of an operator and an
+// asset.
+func GetOperatorAssetTotalStakeKey(operator sdk.AccAddress, assetID string) []byte {
+	return append(OperatorAssetTotalStakePrefix, GetOperatorAssetKey(operator, assetID)...)
+}
+
+// GetStakerStartingInfoPrefix creates the prefix of the starting infos of a staker.
+func GetStakerStartingInfoPrefix(stakerID string) []byte {
+	return append(StakerStartingInfoPrefix, address.MustLengthPrefix([]byte(stakerID))...)
+}
+
+// GetStakerStartingInfoKey creates the key for the starting info of a staker for the
+// delegation of an asset to an operator.
+func GetStakerStartingInfoKey(stakerID string, operator sdk.AccAddress, assetID string) []byte {
+	return append(GetStakerStartingInfoPrefix(stakerID), GetOperatorAssetKey(operator, assetID)...)
+}
+
+// GetAVSRewardPoolKey creates the key for the reward pool of an AVS.
+func GetAVSRewardPoolKey(avsAddr common.Address) []byte {
+	return append(AVSRewardPoolPrefix, avsAddr.Bytes()...)
+}
+
+// ParseOperatorAssetKey parses the operator and the asset from the key created by
+// GetOperatorAssetKey.
+func ParseOperatorAssetKey(key []byte) (operator sdk.AccAddress, assetID string, err error) {
+	if len(key) == 0 {
+		return nil, "", fmt.Errorf("empty operator and asset key")
+	}
+	operatorLen := int(key[0])
+	if len(key) < 1+operatorLen+1 {
+		return nil, "", fmt.Errorf("invalid operator and asset key: %x", key)
+	}
+	operator = key[1 : 1+operatorLen]
+	assetLen := int(key[1+operatorLen])
+	if len(key) != 2+operatorLen+assetLen {
+		return nil, "", fmt.Errorf("invalid operator and asset key: %x", key)
+	}
+	return operator, string(key[2+operatorLen:]), nil
+}
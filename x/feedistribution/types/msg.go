@@ -0,0 +1,119 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	_ sdk.Msg = &MsgSetWithdrawAddress{}
+	_ sdk.Msg = &MsgWithdrawStakerReward{}
+	_ sdk.Msg = &MsgWithdrawOperatorCommission{}
+	_ sdk.Msg = &MsgFundCommunityPool{}
+	_ sdk.Msg = &MsgCommunityPoolSpend{}
+	_ sdk.Msg = &MsgDepositAVSRewards{}
+)
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetWithdrawAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.DelegatorAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid delegator address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.WithdrawAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid withdraw address")
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgSetWithdrawAddress message.
+func (m *MsgSetWithdrawAddress) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.DelegatorAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgWithdrawStakerReward) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.StakerAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid staker address")
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgWithdrawStakerReward message.
+func (m *MsgWithdrawStakerReward) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.StakerAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgWithdrawOperatorCommission) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.OperatorAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid operator address")
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgWithdrawOperatorCommission message.
+func (m *MsgWithdrawOperatorCommission) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.OperatorAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgFundCommunityPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Depositor); err != nil {
+		return errorsmod.Wrap(err, "invalid depositor address")
+	}
+	if !m.Amount.IsValid() || !m.Amount.IsAllPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgFundCommunityPool message.
+func (m *MsgFundCommunityPool) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Depositor)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgCommunityPoolSpend) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return errorsmod.Wrap(err, "invalid recipient address")
+	}
+	if !m.Amount.IsValid() || !m.Amount.IsAllPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgCommunityPoolSpend message.
+func (m *MsgCommunityPoolSpend) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgDepositAVSRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if !common.IsHexAddress(m.AvsAddress) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid AVS address %s", m.AvsAddress)
+	}
+	if !m.Amount.IsValid() || !m.Amount.IsAllPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgDepositAVSRewards message.
+func (m *MsgDepositAVSRewards) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
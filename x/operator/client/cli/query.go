@@ -63,6 +63,11 @@ func GetQueryCmd() *cobra.Command {
 		QueryAllOperatorsWithOptInAVS(),
 		QueryAllAVSsByOperator(),
 		GetOptInfo(),
+		QuerySelfDelegationStatus(),
+		QueryPendingSlashes(),
+		QueryPriceBreakers(),
+		QueryUnbondingMaturity(),
+		QueryParams(),
 	)
 	return cmd
 }
@@ -456,3 +461,154 @@ func GetOptInfo() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// QuerySelfDelegationStatus queries the self delegation status of the operator for the AVS
+func QuerySelfDelegationStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "self-delegation-status <operatorAddr> <avsAddr>",
+		Short:   "Get the self delegation status",
+		Long:    "Get whether the self delegation of the operator meets the minimum self delegation of the AVS",
+		Example: "exocored query operator self-delegation-status exo1c5x7mxphvgavjhu0au9jjqnfqcyspevtyy27mz 0xaa089ba103f765fcea44808bd3d4073523254c57",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			validOperatorAVSAddr, genericQueryParams, err := ValidOperatorAVSAddr(cmd, args[0], args[1])
+			if err != nil {
+				return err
+			}
+			res, err := genericQueryParams.queryClient.QuerySelfDelegationStatus(context.Background(), &operatortypes.QuerySelfDelegationStatusRequest{
+				OperatorAVSAddress: validOperatorAVSAddr,
+			})
+			if err != nil {
+				return err
+			}
+			return genericQueryParams.clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryPendingSlashes queries the slashes waiting for the end of the veto window
+func QueryPendingSlashes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-slashes [avsAddr]",
+		Short:   "Get the pending slashes",
+		Long:    "Get the slashes waiting for the end of the veto window, optionally filtered by the AVS",
+		Example: "exocored query operator pending-slashes 0xaa089ba103f765fcea44808bd3d4073523254c57",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &operatortypes.QueryPendingSlashesRequest{}
+			if len(args) == 1 {
+				if !common.IsHexAddress(args[0]) {
+					return xerrors.Errorf("invalid avs address,err:%s", types.ErrInvalidAddr)
+				}
+				req.AvsAddress = strings.ToLower(args[0])
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req.Pagination = pageReq
+			queryClient := operatortypes.NewQueryClient(clientCtx)
+			res, err := queryClient.QueryPendingSlashes(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-slashes")
+	return cmd
+}
+
+// QueryPriceBreakers queries the states of the price breakers
+func QueryPriceBreakers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "price-breakers [assetID]",
+		Short:   "Get the states of the price breakers",
+		Long:    "Get the states of the price breakers of the guarded assets, optionally filtered by the asset",
+		Example: "exocored query operator price-breakers 0xdac17f958d2ee523a2206206994597c13d831ec7_0x65",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &operatortypes.QueryPriceBreakersRequest{}
+			if len(args) == 1 {
+				req.AssetID = strings.ToLower(args[0])
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := operatortypes.NewQueryClient(clientCtx)
+			res, err := queryClient.QueryPriceBreakers(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryParams queries the parameters of the operator module
+func QueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Get the parameters of the operator module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := operatortypes.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(context.Background(), &operatortypes.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryUnbondingMaturity queries the maturity of an undelegation
+func QueryUnbondingMaturity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-maturity <operatorAddr> [recordKey]",
+		Short: "Get the maturity of an undelegation",
+		Long: "Get the maturity of the undelegation with the record key, or the maturity of an undelegation " +
+			"started from the operator at the current block if the record key isn't provided",
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return xerrors.Errorf("invalid operator address,err:%s", err.Error())
+			}
+			req := &operatortypes.QueryUnbondingMaturityRequest{
+				Operator: args[0],
+			}
+			if len(args) == 2 {
+				req.RecordKey = args[1]
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := operatortypes.NewQueryClient(clientCtx)
+			res, err := queryClient.QueryUnbondingMaturity(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
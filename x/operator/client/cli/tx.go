@@ -43,6 +43,9 @@ func NewTxCmd() *cobra.Command {
 		// are they really a property of the operator or of the respective AVS?
 		// operator vs dogfood vs appchain coordinator
 		CmdSetConsKey(),
+		CmdVetoSlash(),
+		CmdUpdateOperatorCommission(),
+		CmdEditOperator(),
 	)
 	return txCmd
 }
@@ -129,9 +132,32 @@ func newBuildRegisterOperatorMsg(
 			OperatorMetaInfo: metaInfo,
 		},
 	}
-	clientChainEarningAddress := &types.ClientChainEarningAddrList{}
 	// #nosec G703
 	ccData, _ := fs.GetStringArray(FlagClientChainData)
+	clientChainEarningAddress, err := parseClientChainEarningAddrs(ccData)
+	if err != nil {
+		return nil, err
+	}
+	msg.Info.ClientChainEarningsAddr = clientChainEarningAddress
+	// get the initial commission parameters
+	// #nosec G703
+	rateStr, _ := fs.GetString(stakingcli.FlagCommissionRate)
+	// #nosec G703
+	maxRateStr, _ := fs.GetString(stakingcli.FlagCommissionMaxRate)
+	// #nosec G703
+	maxChangeRateStr, _ := fs.GetString(stakingcli.FlagCommissionMaxChangeRate)
+	commission, err := buildCommission(rateStr, maxRateStr, maxChangeRateStr)
+	if err != nil {
+		return nil, err
+	}
+	msg.Info.Commission = commission
+	return msg, nil
+}
+
+// parseClientChainEarningAddrs parses the client chain earnings addresses in the format of
+// <client-chain-id>:<client-chain-earnings-addr>.
+func parseClientChainEarningAddrs(ccData []string) (*types.ClientChainEarningAddrList, error) {
+	clientChainEarningAddress := &types.ClientChainEarningAddrList{}
 	clientChainEarningAddress.EarningInfoList = make(
 		[]*types.ClientChainEarningAddrInfo, len(ccData),
 	)
@@ -153,20 +179,7 @@ func newBuildRegisterOperatorMsg(
 			LzClientChainID: clientChainLzID, ClientChainEarningAddr: strList[1],
 		}
 	}
-	msg.Info.ClientChainEarningsAddr = clientChainEarningAddress
-	// get the initial commission parameters
-	// #nosec G703
-	rateStr, _ := fs.GetString(stakingcli.FlagCommissionRate)
-	// #nosec G703
-	maxRateStr, _ := fs.GetString(stakingcli.FlagCommissionMaxRate)
-	// #nosec G703
-	maxChangeRateStr, _ := fs.GetString(stakingcli.FlagCommissionMaxChangeRate)
-	commission, err := buildCommission(rateStr, maxRateStr, maxChangeRateStr)
-	if err != nil {
-		return nil, err
-	}
-	msg.Info.Commission = commission
-	return msg, nil
+	return clientChainEarningAddress, nil
 }
 
 func buildCommission(rateStr, maxRateStr, maxChangeRateStr string) (
@@ -266,3 +279,103 @@ func CmdSetConsKey() *cobra.Command {
 	}
 	return cmd
 }
+
+// CmdVetoSlash returns a CLI command handler for creating a MsgVetoSlash transaction.
+func CmdVetoSlash() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "veto-slash <operator-address> <avs-address> <slash-id>",
+		Short:   "veto a pending slash, which can only be done by the AVS owners or the authority",
+		Example: "exocored tx operator veto-slash exo1c5x7mxphvgavjhu0au9jjqnfqcyspevtyy27mz 0xaa089ba103f765fcea44808bd3d4073523254c57 0x01",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgVetoSlash{
+				FromAddress:     clientCtx.GetFromAddress().String(),
+				OperatorAddress: args[0],
+				AVSAddress:      strings.ToLower(args[1]),
+				SlashID:         args[2],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	return cmd
+}
+
+// CmdUpdateOperatorCommission returns a CLI command handler for creating a
+// MsgUpdateOperatorCommission transaction.
+func CmdUpdateOperatorCommission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-commission <commission-rate>",
+		Short:   "update the commission rate of the operator, which takes effect at the end of the epoch",
+		Example: "exocored tx operator update-commission 0.1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			rate, err := sdk.NewDecFromStr(args[0])
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgUpdateOperatorCommission{
+				FromAddress:    clientCtx.GetFromAddress().String(),
+				CommissionRate: rate,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdEditOperator returns a CLI command handler for creating a MsgEditOperator transaction.
+func CmdEditOperator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit-operator",
+		Short: "edit the meta info and the earnings addresses of the operator",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			fs := cmd.Flags()
+			// #nosec G703 // this only errors if the flag isn't defined.
+			earningAddr, _ := fs.GetString(FlagEarningAddr)
+			// #nosec G703 // this only errors if the flag isn't defined.
+			metaInfo, _ := fs.GetString(FlagMetaInfo)
+			msg := &types.MsgEditOperator{
+				FromAddress:      clientCtx.GetFromAddress().String(),
+				EarningsAddr:     earningAddr,
+				OperatorMetaInfo: metaInfo,
+			}
+			// #nosec G703
+			ccData, _ := fs.GetStringArray(FlagClientChainData)
+			if len(ccData) > 0 {
+				msg.ClientChainEarningsAddr, err = parseClientChainEarningAddrs(ccData)
+				if err != nil {
+					return err
+				}
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, fs, msg)
+		},
+	}
+
+	f := cmd.Flags()
+	f.String(
+		FlagEarningAddr, "", "The new address which is used to receive the earning reward in the Exocore chain",
+	)
+	f.String(
+		FlagMetaInfo, "", "The operator's new meta info (like name)",
+	)
+	f.StringArray(
+		FlagClientChainData, []string{}, "The client chain's address to receive earnings; "+
+			"can be supplied multiple times. "+
+			"Format: <client-chain-id>:<client-chain-earnings-addr>",
+	)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"errors"
+	"strings"
 
 	sdkmath "cosmossdk.io/math"
 	operatortypes "github.com/ExocoreNetwork/exocore/x/operator/types"
@@ -32,6 +33,20 @@ func (k *Keeper) UpdateVotingPower(ctx sdk.Context, avsAddr string) error {
 		return nil
 	}
 
+	// freeze the voting power at the last good value if the price of any supported asset is
+	// stale or deviates too much, so that a bad price can't reshuffle the operators.
+	if trippedAssets := k.GetTrippedAssets(ctx, assets); len(trippedAssets) > 0 {
+		ctx.Logger().Info("UpdateVotingPower the voting power is frozen by the price breakers", "avs", avsAddr, "assets", trippedAssets)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				operatortypes.EventTypeVotingPowerFrozen,
+				sdk.NewAttribute(operatortypes.AttributeKeyAVSAddress, avsAddr),
+				sdk.NewAttribute(operatortypes.AttributeKeyAssetIDs, strings.Join(trippedAssets, ",")),
+			),
+		)
+		return nil
+	}
+
 	// get the prices and decimals of assets
 	decimals, err := k.assetsKeeper.GetAssetsDecimal(ctx, assets)
 	if err != nil {
@@ -57,6 +72,11 @@ func (k *Keeper) UpdateVotingPower(ctx sdk.Context, avsAddr string) error {
 		return err
 	}
 
+	// record whether the self delegation of each operator is below the minimum, the opted
+	// info is updated after the iteration to avoid modifying the store during the iteration.
+	belowMinSelfDelegation := make(map[string]bool)
+	selfUSDValues := make(map[string]sdkmath.LegacyDec)
+	operators := make([]string, 0)
 	opFunc := func(operator string, optedUSDValues *operatortypes.OperatorOptedUSDValue) error {
 		// clear the old voting power for the operator
 		*optedUSDValues = operatortypes.OperatorOptedUSDValue{
@@ -74,6 +94,9 @@ func (k *Keeper) UpdateVotingPower(ctx sdk.Context, avsAddr string) error {
 			optedUSDValues.ActiveUSDValue = stakingInfo.Staking
 			avsVotingPower = avsVotingPower.Add(optedUSDValues.TotalUSDValue)
 		}
+		operators = append(operators, operator)
+		belowMinSelfDelegation[operator] = stakingInfo.SelfStaking.LT(minimumSelfDelegation)
+		selfUSDValues[operator] = stakingInfo.SelfStaking
 		return nil
 	}
 
@@ -90,11 +113,57 @@ func (k *Keeper) UpdateVotingPower(ctx sdk.Context, avsAddr string) error {
 	if err != nil {
 		return err
 	}
+	for _, operator := range operators {
+		err = k.updateSelfDelegationStatus(
+			cc, operator, avsAddr, belowMinSelfDelegation[operator], selfUSDValues[operator], minimumSelfDelegation,
+		)
+		if err != nil {
+			return err
+		}
+	}
 	writeFunc()
 	return nil
 }
 
-// EndBlock : update the assets' share when their prices change
-func (k *Keeper) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+// updateSelfDelegationStatus makes the operator ineligible for the AVS when its self-delegated
+// USD value drops below the minimum self delegation of the AVS, and eligible again once the
+// self delegation is restored, see IsEligible. An event is emitted when the status changes.
+func (k *Keeper) updateSelfDelegationStatus(
+	ctx sdk.Context, operator, avsAddr string, belowMin bool, selfUSDValue, minSelfDelegation sdkmath.LegacyDec,
+) error {
+	optedInfo, err := k.GetOptedInfo(ctx, operator, avsAddr)
+	if err != nil {
+		if errors.Is(err, operatortypes.ErrNoKeyInTheStore) {
+			return nil
+		}
+		return err
+	}
+	if optedInfo.BelowMinSelfDelegation == belowMin {
+		return nil
+	}
+	optedInfo.BelowMinSelfDelegation = belowMin
+	err = k.SetOptedInfo(ctx, operator, avsAddr, optedInfo)
+	if err != nil {
+		return err
+	}
+	eventType := operatortypes.EventTypeOperatorReactivated
+	if belowMin {
+		eventType = operatortypes.EventTypeOperatorDeactivated
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(operatortypes.AttributeKeyOperator, operator),
+			sdk.NewAttribute(operatortypes.AttributeKeyAVSAddress, avsAddr),
+			sdk.NewAttribute(operatortypes.AttributeKeySelfUSDValue, selfUSDValue.String()),
+			sdk.NewAttribute(operatortypes.AttributeKeyMinSelfDelegation, minSelfDelegation.String()),
+		),
+	)
+	return nil
+}
+
+// EndBlock : update the price breakers with the latest prices
+func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	k.UpdatePriceBreakers(ctx)
 	return []abci.ValidatorUpdate{}
 }
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	delegationtypes "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/ExocoreNetwork/exocore/x/operator/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpdateOperatorCommission schedules an update of the operator's commission rate, which is
+// applied at the end of the current epoch of the dogfood AVS, since the commission is used
+// to distribute the rewards of the dogfood validators. The new rate is validated against the
+// latest commission, including a scheduled one, so the rate can be changed at most once within
+// 24 hours and by at most the max change rate each time. A scheduled update is replaced by
+// the new one.
+func (k *Keeper) UpdateOperatorCommission(ctx sdk.Context, operator string, newRate sdk.Dec) error {
+	info, err := k.OperatorInfo(ctx, operator)
+	if err != nil {
+		if errors.Is(err, types.ErrNoKeyInTheStore) {
+			return errorsmod.Wrapf(delegationtypes.ErrOperatorNotExist, "UpdateOperatorCommission: operator is %s", operator)
+		}
+		return err
+	}
+	commission := info.Commission
+	if pending, found := k.GetPendingCommissionUpdate(ctx, operator); found {
+		commission = pending.Commission
+	}
+	if err := commission.ValidateNewRate(newRate, ctx.BlockTime()); err != nil {
+		return err
+	}
+	commission.Rate = newRate
+	commission.UpdateTime = ctx.BlockTime()
+
+	dogfoodAVSAddr := avstypes.GenerateAVSAddr(avstypes.ChainIDWithoutRevision(ctx.ChainID()))
+	epochInfo, err := k.avsKeeper.GetAVSEpochInfo(ctx, dogfoodAVSAddr)
+	if err != nil {
+		return err
+	}
+	k.setPendingCommissionUpdate(ctx, &types.PendingCommissionUpdate{
+		OperatorAddress: operator,
+		Commission:      commission,
+		EpochIdentifier: epochInfo.Identifier,
+	})
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCommissionUpdateScheduled,
+			sdk.NewAttribute(types.AttributeKeyOperator, operator),
+			sdk.NewAttribute(types.AttributeKeyCommissionRate, newRate.String()),
+			sdk.NewAttribute(types.AttributeKeyEpochID, epochInfo.Identifier),
+		),
+	)
+	return nil
+}
+
+// ApplyPendingCommissionUpdates applies the commission updates scheduled with the epoch
+// identifier. It is called at the end of each epoch.
+func (k *Keeper) ApplyPendingCommissionUpdates(ctx sdk.Context, epochIdentifier string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingCommissionUpdate)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	// collect the updates first to avoid modifying the store during the iteration
+	updates := make([]types.PendingCommissionUpdate, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var update types.PendingCommissionUpdate
+		k.cdc.MustUnmarshal(iterator.Value(), &update)
+		if update.EpochIdentifier == epochIdentifier {
+			updates = append(updates, update)
+		}
+	}
+	iterator.Close()
+
+	for i := range updates {
+		update := updates[i]
+		k.deletePendingCommissionUpdate(ctx, update.OperatorAddress)
+		info, err := k.OperatorInfo(ctx, update.OperatorAddress)
+		if err != nil {
+			k.Logger(ctx).Error(
+				"ApplyPendingCommissionUpdates: failed to get the operator info",
+				"operator", update.OperatorAddress, "error", err,
+			)
+			continue
+		}
+		info.Commission = update.Commission
+		// #nosec G703 // the address is validated before the update is stored
+		opAccAddr, _ := sdk.AccAddressFromBech32(update.OperatorAddress)
+		k.setOperatorInfo(ctx, opAccAddr, info)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCommissionUpdated,
+				sdk.NewAttribute(types.AttributeKeyOperator, update.OperatorAddress),
+				sdk.NewAttribute(types.AttributeKeyCommissionRate, update.Commission.Rate.String()),
+			),
+		)
+	}
+}
+
+// setPendingCommissionUpdate stores the pending commission update, the key is the operator
+// address.
+func (k *Keeper) setPendingCommissionUpdate(ctx sdk.Context, update *types.PendingCommissionUpdate) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingCommissionUpdate)
+	store.Set([]byte(update.OperatorAddress), k.cdc.MustMarshal(update))
+}
+
+// deletePendingCommissionUpdate removes the pending commission update of the operator.
+func (k *Keeper) deletePendingCommissionUpdate(ctx sdk.Context, operator string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingCommissionUpdate)
+	store.Delete([]byte(operator))
+}
+
+// GetPendingCommissionUpdate returns the pending commission update of the operator and
+// whether it's found.
+func (k *Keeper) GetPendingCommissionUpdate(ctx sdk.Context, operator string) (*types.PendingCommissionUpdate, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingCommissionUpdate)
+	value := store.Get([]byte(operator))
+	if value == nil {
+		return nil, false
+	}
+	ret := types.PendingCommissionUpdate{}
+	k.cdc.MustUnmarshal(value, &ret)
+	return &ret, true
+}
+
+// SetAllPendingCommissionUpdates sets all the pending commission updates.
+func (k *Keeper) SetAllPendingCommissionUpdates(ctx sdk.Context, updates []types.PendingCommissionUpdate) {
+	for i := range updates {
+		k.setPendingCommissionUpdate(ctx, &updates[i])
+	}
+}
+
+// GetAllPendingCommissionUpdates returns all the pending commission updates.
+func (k *Keeper) GetAllPendingCommissionUpdates(ctx sdk.Context) []types.PendingCommissionUpdate {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingCommissionUpdate)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	ret := make([]types.PendingCommissionUpdate, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var update types.PendingCommissionUpdate
+		k.cdc.MustUnmarshal(iterator.Value(), &update)
+		ret = append(ret, update)
+	}
+	return ret
+}
@@ -2,13 +2,11 @@ package keeper
 
 import (
 	sdkmath "cosmossdk.io/math"
+	operatortypes "github.com/ExocoreNetwork/exocore/x/operator/types"
 )
 
 // CalculateUSDValue assetUSDValue = (assetAmount*price)/(10^(asset.decimal+priceDecimal))
+// It's kept for the callers of the keeper package, see operatortypes.CalculateUSDValue.
 func CalculateUSDValue(assetAmount sdkmath.Int, price sdkmath.Int, assetDecimal uint32, priceDecimal uint8) sdkmath.LegacyDec {
-	assetValue := assetAmount.Mul(price)
-	assetValueDec := sdkmath.LegacyNewDecFromBigInt(assetValue.BigInt())
-	// #nosec G115
-	divisor := sdkmath.NewIntWithDecimal(1, int(assetDecimal)+int(priceDecimal))
-	return assetValueDec.QuoInt(divisor)
+	return operatortypes.CalculateUSDValue(assetAmount, price, assetDecimal, priceDecimal)
 }
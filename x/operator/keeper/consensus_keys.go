@@ -353,9 +353,9 @@ func (k Keeper) GetActiveOperatorsForChainID(
 	operatorsAddr, pks := k.GetOperatorsForChainID(ctx, chainID)
 	activeOperator := make([]sdk.AccAddress, 0)
 	activePks := make([]keytypes.WrappedConsKey, 0)
-	// check if the operator is active
+	// check if the operator is active and meets the minimum self delegation
 	for i, operator := range operatorsAddr {
-		if k.IsActive(ctx, operator, avsAddrString) {
+		if k.IsEligible(ctx, operator, avsAddrString) {
 			activeOperator = append(activeOperator, operator)
 			activePks = append(activePks, pks[i])
 		} else {
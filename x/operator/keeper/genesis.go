@@ -9,6 +9,9 @@ import (
 )
 
 func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) []abci.ValidatorUpdate {
+	if err := k.SetParams(ctx, &state.Params); err != nil {
+		panic(errorsmod.Wrap(err, "failed to set the params"))
+	}
 	for i := range state.Operators {
 		op := state.Operators[i] // avoid implicit memory aliasing
 		if op.OperatorInfo.EarningsAddr == "" {
@@ -53,12 +56,21 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) []abci.Va
 	if err != nil {
 		panic(errorsmod.Wrap(err, "failed to set all key removals for operators"))
 	}
+	k.SetAllPendingSlashes(ctx, state.PendingSlashes)
+	k.SetAllVotingPowerSnapshots(ctx, state.VotingPowerSnapshots)
+	k.SetAllPriceBreakerStates(ctx, state.PriceBreakerStates)
+	err = k.SetAllUnbondingMaturities(ctx, state.UnbondingMaturities)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "failed to set all unbonding maturities"))
+	}
+	k.SetAllPendingCommissionUpdates(ctx, state.PendingCommissionUpdates)
 	return []abci.ValidatorUpdate{}
 }
 
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	res := types.GenesisState{}
 	var err error
+	res.Params = k.GetParams(ctx)
 	res.Operators = k.AllOperators(ctx)
 
 	res.OperatorRecords, err = k.GetAllOperatorConsKeyRecords(ctx)
@@ -96,5 +108,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(errorsmod.Wrap(err, "failed to get all key removals for operators").Error())
 	}
 
+	res.PendingSlashes = k.GetAllPendingSlashes(ctx)
+	res.VotingPowerSnapshots = k.GetAllVotingPowerSnapshots(ctx)
+	res.PriceBreakerStates = k.GetAllPriceBreakerStates(ctx)
+	res.UnbondingMaturities = k.GetAllUnbondingMaturities(ctx)
+	res.PendingCommissionUpdates = k.GetAllPendingCommissionUpdates(ctx)
+
 	return &res
 }
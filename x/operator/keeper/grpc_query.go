@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
 
 	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
 
@@ -253,3 +256,111 @@ func (k *Keeper) QueryOptInfo(goCtx context.Context, req *types.QueryOptInfoRequ
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	return k.GetOptedInfo(ctx, req.OperatorAddr, req.AvsAddress)
 }
+
+// QuerySelfDelegationStatus queries whether the self delegation of the operator meets the
+// minimum self delegation of the AVS.
+func (k *Keeper) QuerySelfDelegationStatus(goCtx context.Context, req *types.QuerySelfDelegationStatusRequest) (*types.QuerySelfDelegationStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	optedInfo, err := k.GetOptedInfo(ctx, req.OperatorAddr, req.AvsAddress)
+	if err != nil {
+		return nil, err
+	}
+	usdValues, err := k.GetOperatorOptedUSDValue(ctx, req.AvsAddress, req.OperatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	minSelfDelegation, err := k.avsKeeper.GetAVSMinimumSelfDelegation(ctx, req.AvsAddress)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySelfDelegationStatusResponse{
+		SelfUSDValue:           usdValues.SelfUSDValue,
+		MinSelfDelegation:      minSelfDelegation,
+		BelowMinSelfDelegation: optedInfo.BelowMinSelfDelegation,
+	}, nil
+}
+
+// QueryPendingSlashes returns the slashes waiting for the end of the veto window, the result
+// is filtered by the AVS if its address is provided.
+func (k *Keeper) QueryPendingSlashes(goCtx context.Context, req *types.QueryPendingSlashesRequest) (*types.QueryPendingSlashesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	res := make([]types.PendingSlash, 0)
+
+	pendingPrefix := types.KeyPrefixPendingSlash
+	if req.AvsAddress != "" {
+		pendingPrefix = types.AppendMany(pendingPrefix, assetstype.GetJoinedStoreKeyForPrefix(req.AvsAddress))
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), pendingPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		ret := types.PendingSlash{}
+		// don't use MustUnmarshal to not panic for queries
+		if err := ret.Unmarshal(value); err != nil {
+			return err
+		}
+		res = append(res, ret)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPendingSlashesResponse{
+		PendingSlashes: res,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// QueryPriceBreakers returns the states of the price breakers, the result is filtered by the
+// asset if its ID is provided.
+func (k *Keeper) QueryPriceBreakers(goCtx context.Context, req *types.QueryPriceBreakersRequest) (*types.QueryPriceBreakersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.AssetID == "" {
+		return &types.QueryPriceBreakersResponse{States: k.GetAllPriceBreakerStates(ctx)}, nil
+	}
+	state, found := k.GetPriceBreakerState(ctx, req.AssetID)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrNoKeyInTheStore, "QueryPriceBreakers: no price breaker for the asset %s", req.AssetID)
+	}
+	return &types.QueryPriceBreakersResponse{States: []types.PriceBreakerState{*state}}, nil
+}
+
+// QueryUnbondingMaturity returns the maturity of the undelegation with the provided record key.
+// If the record key isn't provided, it returns the maturity of an undelegation started from the
+// operator at the current block.
+func (k *Keeper) QueryUnbondingMaturity(goCtx context.Context, req *types.QueryUnbondingMaturityRequest) (*types.QueryUnbondingMaturityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	res := &types.QueryUnbondingMaturityResponse{}
+	if req.RecordKey != "" {
+		records, err := k.delegationKeeper.GetUndelegationRecords(ctx, []string{req.RecordKey})
+		if err != nil {
+			return nil, err
+		}
+		res.CompleteBlockNumber = records[0].CompleteBlockNumber
+		res.Maturity, _ = k.GetUnbondingMaturity(ctx, false, req.RecordKey)
+	} else {
+		operator, err := sdk.AccAddressFromBech32(req.Operator)
+		if err != nil {
+			return nil, assetstype.ErrInvalidOperatorAddr
+		}
+		// #nosec G115
+		res.CompleteBlockNumber = k.GetUnbondingExpirationBlockNumber(ctx, operator, uint64(ctx.BlockHeight()))
+		res.Maturity, err = k.ComputeUnbondingMaturity(ctx, operator)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if res.Maturity == nil {
+		res.Reason = "no opted-in AVS holds the undelegation, it completes at the complete block number"
+	} else {
+		res.Reason = fmt.Sprintf(
+			"the AVS %s has the longest unbonding period of %d %s epochs, the undelegation is held until the end of epoch %d and completes at or after the complete block number",
+			res.Maturity.AVSAddress, res.Maturity.UnbondingPeriod, res.Maturity.EpochIdentifier, res.Maturity.MaturityEpoch,
+		)
+	}
+	return res, nil
+}
+
+// Params returns the parameters of the operator module.
+func (k *Keeper) Params(goCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	delegationtypes "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DelegationHooksWrapper is the wrapper structure that implements the delegation hooks for the
+// operator keeper.
+type DelegationHooksWrapper struct {
+	keeper *Keeper
+}
+
+// Interface guard
+var _ delegationtypes.DelegationHooks = DelegationHooksWrapper{}
+
+// DelegationHooks returns the delegation hooks wrapper. It follows the "accept interfaces,
+// return concretes" pattern.
+func (k *Keeper) DelegationHooks() DelegationHooksWrapper {
+	return DelegationHooksWrapper{k}
+}
+
+// AfterDelegation is called after a delegation is made.
+func (wrapper DelegationHooksWrapper) AfterDelegation(
+	sdk.Context, sdk.AccAddress, string, string,
+) error {
+	// the voting power is updated at the end of the epochs of the AVSs.
+	return nil
+}
+
+// AfterUndelegationStarted is called after an undelegation is started. The undelegation is
+// held until the unbonding periods of the AVSs the operator is opted into elapse, so that
+// the staker can't escape from the slashing window of the AVSs.
+func (wrapper DelegationHooksWrapper) AfterUndelegationStarted(
+	ctx sdk.Context, operator sdk.AccAddress, recordKey []byte,
+) error {
+	return wrapper.keeper.holdUnbonding(ctx, operator, recordKey, false)
+}
+
+// AfterRedelegationStarted is called after a redelegation is started. Similar to the
+// undelegation, the redelegated amount remains slashable by the source operator until the
+// unbonding periods of its AVSs elapse.
+func (wrapper DelegationHooksWrapper) AfterRedelegationStarted(
+	ctx sdk.Context, srcOperator sdk.AccAddress, recordKey []byte,
+) error {
+	return wrapper.keeper.holdUnbonding(ctx, srcOperator, recordKey, true)
+}
+
+// AfterUndelegationCanceled is called before a canceled undelegation record is deleted. The
+// unbonding maturity of the record is removed, since there is nothing left to hold.
+func (wrapper DelegationHooksWrapper) AfterUndelegationCanceled(
+	ctx sdk.Context, _ sdk.AccAddress, recordKey []byte,
+) error {
+	if maturity, found := wrapper.keeper.GetUnbondingMaturity(ctx, false, string(recordKey)); found {
+		wrapper.keeper.deleteUnbondingMaturity(ctx, maturity)
+	}
+	return nil
+}
+
+// AfterDelegationSlashed is called after the delegated amount of a staker is slashed outside
+// the epoch-based slashing flow. The USD values of the operator are decreased immediately for
+// the AVSs supporting the asset, instead of waiting for the end of their epochs.
+func (wrapper DelegationHooksWrapper) AfterDelegationSlashed(
+	ctx sdk.Context, operator sdk.AccAddress, stakerID, assetID string, amount sdkmath.Int,
+) error {
+	return wrapper.keeper.decreaseUSDValueForSlash(ctx, operator.String(), stakerID, assetID, amount)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
 	epochstypes "github.com/ExocoreNetwork/exocore/x/epochs/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -24,10 +25,20 @@ func (k *Keeper) EpochsHooks() EpochsHooksWrapper {
 func (wrapper EpochsHooksWrapper) AfterEpochEnd(
 	ctx sdk.Context, epochIdentifier string, epochNumber int64,
 ) {
+	// execute the pending slashes whose veto window ends with this epoch before updating
+	// the voting power, so that the slashed assets are reflected in the new voting power.
+	wrapper.keeper.ExecutePendingSlashes(ctx, epochIdentifier, epochNumber)
+	// release the undelegations and redelegations after the pending slashes are executed,
+	// since they might be slashed by these slashes.
+	wrapper.keeper.ReleaseMatureUnbondings(ctx, epochIdentifier, epochNumber)
+	// apply the commission updates scheduled during this epoch.
+	wrapper.keeper.ApplyPendingCommissionUpdates(ctx, epochIdentifier)
+
 	// get all the avs address bypass the epoch end
 	// update the assets' share when their prices change
 	// todo: need to consider the calling order
 	avsList := wrapper.keeper.avsKeeper.GetEpochEndAVSs(ctx, epochIdentifier, epochNumber)
+	dogfoodAVSAddr := avstypes.GenerateAVSAddr(avstypes.ChainIDWithoutRevision(ctx.ChainID()))
 	for _, avs := range avsList {
 		// avs address should be hex
 		err := wrapper.keeper.UpdateVotingPower(ctx, avs)
@@ -36,6 +47,15 @@ func (wrapper EpochsHooksWrapper) AfterEpochEnd(
 			// Handle the error gracefully, continue to the next AVS
 			continue
 		}
+		// the historical voting power of dogfood is provided by the slashing module,
+		// so the snapshot is only taken for the other AVSs.
+		if avs == dogfoodAVSAddr {
+			continue
+		}
+		err = wrapper.keeper.SnapshotVotingPower(ctx, avs, epochIdentifier, epochNumber)
+		if err != nil {
+			ctx.Logger().Error("Failed to take the voting power snapshot", "avs", avs, "error", err)
+		}
 	}
 }
 
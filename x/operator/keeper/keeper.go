@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	sdkmath "cosmossdk.io/math"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -23,6 +24,10 @@ type Keeper struct {
 
 	hooks       operatortypes.OperatorHooks // set separately via call to SetHooks
 	slashKeeper operatortypes.SlashKeeper   // for jailing and unjailing check TODO(mm)
+
+	// authority is the address capable of executing a MsgUpdateParams message and vetoing
+	// the pending slashes. Typically, this should be the x/gov module account.
+	authority string
 }
 
 func NewKeeper(
@@ -33,7 +38,12 @@ func NewKeeper(
 	oracleKeeper operatortypes.OracleKeeper,
 	avsKeeper operatortypes.AVSKeeper,
 	slashKeeper operatortypes.SlashKeeper,
+	authority string,
 ) Keeper {
+	// ensure authority is a valid bech32 address
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("authority address %s is invalid: %s", authority, err))
+	}
 	return Keeper{
 		storeKey:         storeKey,
 		cdc:              cdc,
@@ -42,6 +52,7 @@ func NewKeeper(
 		oracleKeeper:     oracleKeeper,
 		avsKeeper:        avsKeeper,
 		slashKeeper:      slashKeeper,
+		authority:        authority,
 	}
 }
 
@@ -49,8 +60,30 @@ func (k *Keeper) OracleInterface() operatortypes.OracleKeeper {
 	return k.oracleKeeper
 }
 
-func (k Keeper) GetUnbondingExpirationBlockNumber(_ sdk.Context, _ sdk.AccAddress, startHeight uint64) uint64 {
-	return startHeight + operatortypes.UnbondingExpiration
+// GetUnbondingExpirationBlockNumber returns the block number at which an unbonding started
+// from the operator at the provided height is expected to be completed. If the operator is
+// opted into AVSs with unbonding periods, the time left until the end of the maturity epoch is
+// converted into blocks by MaxExpectedBlockInterval. The estimation is a lower bound, and the
+// unbonding is further held until the maturity epoch ends, see ComputeUnbondingMaturity.
+func (k Keeper) GetUnbondingExpirationBlockNumber(ctx sdk.Context, operator sdk.AccAddress, startHeight uint64) uint64 {
+	expiration := startHeight + operatortypes.UnbondingExpiration
+	maturity, endTime, err := k.computeUnbondingMaturity(ctx, operator)
+	if err != nil {
+		k.Logger(ctx).Error(
+			"failed to compute the unbonding maturity, the default expiration is used",
+			"operator", operator, "error", err,
+		)
+		return expiration
+	}
+	if maturity == nil || !endTime.After(ctx.BlockTime()) {
+		return expiration
+	}
+	// #nosec G115
+	blocks := uint64(endTime.Sub(ctx.BlockTime()) / operatortypes.MaxExpectedBlockInterval)
+	if startHeight+blocks > expiration {
+		return startHeight + blocks
+	}
+	return expiration
 }
 
 // OperatorKeeper interface will be implemented by deposit keeper
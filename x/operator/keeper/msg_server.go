@@ -2,11 +2,13 @@ package keeper
 
 import (
 	context "context"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	keytypes "github.com/ExocoreNetwork/exocore/types/keys"
 	"github.com/ExocoreNetwork/exocore/x/operator/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 type MsgServerImpl struct {
@@ -102,3 +104,47 @@ func (msgServer *MsgServerImpl) SetConsKey(goCtx context.Context, req *types.Set
 	}
 	return &types.SetConsKeyResponse{}, nil
 }
+
+// VetoSlash is an implementation of the msg server for the operator module.
+func (msgServer *MsgServerImpl) VetoSlash(goCtx context.Context, req *types.MsgVetoSlash) (*types.MsgVetoSlashResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// the AVS address is stored in lowercase
+	err := msgServer.keeper.VetoSlash(ctx, req.FromAddress, req.OperatorAddress, strings.ToLower(req.AVSAddress), req.SlashID)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgVetoSlashResponse{}, nil
+}
+
+// UpdateParams updates the params of the operator module, it must be signed by the authority.
+func (msgServer *MsgServerImpl) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if msgServer.keeper.authority != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf(
+			"invalid authority; expected %s, got %s",
+			msgServer.keeper.authority, req.Authority,
+		)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := msgServer.keeper.SetParams(ctx, &req.Params); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// UpdateOperatorCommission is an implementation of the msg server for the operator module.
+func (msgServer *MsgServerImpl) UpdateOperatorCommission(goCtx context.Context, req *types.MsgUpdateOperatorCommission) (*types.MsgUpdateOperatorCommissionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := msgServer.keeper.UpdateOperatorCommission(ctx, req.FromAddress, req.CommissionRate); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateOperatorCommissionResponse{}, nil
+}
+
+// EditOperator is an implementation of the msg server for the operator module.
+func (msgServer *MsgServerImpl) EditOperator(goCtx context.Context, req *types.MsgEditOperator) (*types.MsgEditOperatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := msgServer.keeper.EditOperator(ctx, req); err != nil {
+		return nil, err
+	}
+	return &types.MsgEditOperatorResponse{}, nil
+}
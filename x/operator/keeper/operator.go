@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
@@ -9,15 +10,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
+	delegationtypes "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	operatortypes "github.com/ExocoreNetwork/exocore/x/operator/types"
 )
 
 // SetOperatorInfo is used to store the operator's information on the chain.
-// There is no current way implemented to delete an operator's registration or edit it.
-// TODO: implement operator edit function, which should allow editing:
-// approve address?
-// commission, subject to limits and once within 24 hours.
-// client chain earnings addresses (maybe append only?)
+// There is no current way implemented to delete an operator's registration. The registered
+// information can be edited by `EditOperator` and the commission rate can be updated by
+// `UpdateOperatorCommission`.
 func (k *Keeper) SetOperatorInfo(
 	ctx sdk.Context, addr string, info *operatortypes.OperatorInfo,
 ) (err error) {
@@ -27,7 +27,6 @@ func (k *Keeper) SetOperatorInfo(
 		return errorsmod.Wrap(err, "SetOperatorInfo: error occurred when parse acc address from Bech32")
 	}
 	// if already registered, this request should go to EditOperator.
-	// TODO: EditOperator needs to be implemented.
 	if k.IsOperator(ctx, opAccAddr) {
 		return errorsmod.Wrap(
 			operatortypes.ErrOperatorAlreadyExists,
@@ -54,9 +53,74 @@ func (k *Keeper) SetOperatorInfo(
 		}
 	}
 
+	k.setOperatorInfo(ctx, opAccAddr, info)
+	return nil
+}
+
+func (k *Keeper) setOperatorInfo(ctx sdk.Context, opAccAddr sdk.AccAddress, info *operatortypes.OperatorInfo) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), operatortypes.KeyPrefixOperatorInfo)
 	bz := k.cdc.MustMarshal(info)
 	store.Set(opAccAddr, bz)
+}
+
+// EditOperator edits the earnings address, the meta info and the client chain earnings
+// addresses of a registered operator. The empty fields of the request are left unchanged,
+// and each provided client chain earnings address replaces the one of the same client chain.
+func (k *Keeper) EditOperator(ctx sdk.Context, req *operatortypes.MsgEditOperator) error {
+	opAccAddr, err := sdk.AccAddressFromBech32(req.FromAddress)
+	if err != nil {
+		return errorsmod.Wrap(err, "EditOperator: error occurred when parse acc address from Bech32")
+	}
+	info, err := k.OperatorInfo(ctx, req.FromAddress)
+	if err != nil {
+		if errors.Is(err, operatortypes.ErrNoKeyInTheStore) {
+			return errorsmod.Wrapf(delegationtypes.ErrOperatorNotExist, "EditOperator: operator is %s", req.FromAddress)
+		}
+		return err
+	}
+	if req.EarningsAddr != "" {
+		info.EarningsAddr = req.EarningsAddr
+	}
+	if req.OperatorMetaInfo != "" {
+		info.OperatorMetaInfo = req.OperatorMetaInfo
+	}
+	if req.ClientChainEarningsAddr != nil {
+		if info.ClientChainEarningsAddr == nil {
+			info.ClientChainEarningsAddr = &operatortypes.ClientChainEarningAddrList{}
+		}
+		for _, data := range req.ClientChainEarningsAddr.EarningInfoList {
+			if !k.assetsKeeper.ClientChainExists(ctx, data.LzClientChainID) {
+				return errorsmod.Wrapf(
+					operatortypes.ErrParameterInvalid,
+					"EditOperator: client chain not found, %d", data.LzClientChainID,
+				)
+			}
+			replaced := false
+			for _, existing := range info.ClientChainEarningsAddr.EarningInfoList {
+				if existing.LzClientChainID == data.LzClientChainID {
+					existing.ClientChainEarningAddr = data.ClientChainEarningAddr
+					replaced = true
+					break
+				}
+			}
+			if !replaced {
+				info.ClientChainEarningsAddr.EarningInfoList = append(
+					info.ClientChainEarningsAddr.EarningInfoList,
+					&operatortypes.ClientChainEarningAddrInfo{
+						LzClientChainID:        data.LzClientChainID,
+						ClientChainEarningAddr: data.ClientChainEarningAddr,
+					},
+				)
+			}
+		}
+	}
+	k.setOperatorInfo(ctx, opAccAddr, info)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			operatortypes.EventTypeEditOperator,
+			sdk.NewAttribute(operatortypes.AttributeKeyOperator, req.FromAddress),
+		),
+	)
 	return nil
 }
 
@@ -179,6 +243,21 @@ func (k *Keeper) IsActive(ctx sdk.Context, operatorAddr sdk.AccAddress, avsAddr
 	return true
 }
 
+// IsEligible returns whether the operator is active for the AVS and its self delegation meets
+// the minimum self delegation of the AVS. Unlike IsActive, which gates the operations of the
+// operator, it determines whether the operator is included in the validator set, the task
+// power and the reward distribution of the AVS.
+func (k *Keeper) IsEligible(ctx sdk.Context, operatorAddr sdk.AccAddress, avsAddr string) bool {
+	if !k.IsActive(ctx, operatorAddr, avsAddr) {
+		return false
+	}
+	optedInfo, err := k.GetOptedInfo(ctx, operatorAddr.String(), avsAddr)
+	if err != nil {
+		return false
+	}
+	return !optedInfo.BelowMinSelfDelegation
+}
+
 func (k *Keeper) GetOptedInAVSForOperator(ctx sdk.Context, operatorAddr string) ([]string, error) {
 	// get all opted-in info
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), operatortypes.KeyPrefixOperatorOptedAVSInfo)
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/math"
 
@@ -49,6 +50,96 @@ func (suite *OperatorTestSuite) TestAllOperators() {
 	suite.Contains(getOperators, operatorDetail)
 }
 
+func (suite *OperatorTestSuite) TestUpdateOperatorCommission() {
+	info := &operatortype.OperatorInfo{
+		EarningsAddr:     suite.AccAddress.String(),
+		OperatorMetaInfo: "test operator",
+		Commission: stakingtypes.NewCommission(
+			math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(1, 1),
+		),
+	}
+	err := suite.App.OperatorKeeper.SetOperatorInfo(suite.Ctx, suite.AccAddress.String(), info)
+	suite.NoError(err)
+	operator := suite.AccAddress.String()
+
+	// the commission can't be updated within 24 hours
+	err = suite.App.OperatorKeeper.UpdateOperatorCommission(suite.Ctx, operator, math.LegacyNewDecWithPrec(2, 1))
+	suite.ErrorIs(err, stakingtypes.ErrCommissionUpdateTime)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(25 * time.Hour))
+	// the change can't exceed the max change rate
+	err = suite.App.OperatorKeeper.UpdateOperatorCommission(suite.Ctx, operator, math.LegacyNewDecWithPrec(3, 1))
+	suite.ErrorIs(err, stakingtypes.ErrCommissionGTMaxChangeRate)
+	// the rate can't exceed the max rate
+	err = suite.App.OperatorKeeper.UpdateOperatorCommission(suite.Ctx, operator, math.LegacyNewDecWithPrec(6, 1))
+	suite.ErrorIs(err, stakingtypes.ErrCommissionGTMaxRate)
+
+	newRate := math.LegacyNewDecWithPrec(2, 1)
+	err = suite.App.OperatorKeeper.UpdateOperatorCommission(suite.Ctx, operator, newRate)
+	suite.NoError(err)
+	pending, found := suite.App.OperatorKeeper.GetPendingCommissionUpdate(suite.Ctx, operator)
+	suite.True(found)
+	suite.Equal(newRate, pending.Commission.Rate)
+	suite.NotEmpty(pending.EpochIdentifier)
+	// another update within 24 hours of the scheduled one is rejected
+	err = suite.App.OperatorKeeper.UpdateOperatorCommission(suite.Ctx, operator, math.LegacyNewDecWithPrec(15, 2))
+	suite.ErrorIs(err, stakingtypes.ErrCommissionUpdateTime)
+
+	// the update takes effect at the end of the epoch
+	getInfo, err := suite.App.OperatorKeeper.QueryOperatorInfo(suite.Ctx, &operatortype.GetOperatorInfoReq{OperatorAddr: operator})
+	suite.NoError(err)
+	suite.Equal(info.Commission.Rate, getInfo.Commission.Rate)
+	suite.App.OperatorKeeper.ApplyPendingCommissionUpdates(suite.Ctx, pending.EpochIdentifier+"-other")
+	_, found = suite.App.OperatorKeeper.GetPendingCommissionUpdate(suite.Ctx, operator)
+	suite.True(found)
+	suite.App.OperatorKeeper.ApplyPendingCommissionUpdates(suite.Ctx, pending.EpochIdentifier)
+	getInfo, err = suite.App.OperatorKeeper.QueryOperatorInfo(suite.Ctx, &operatortype.GetOperatorInfoReq{OperatorAddr: operator})
+	suite.NoError(err)
+	suite.Equal(newRate, getInfo.Commission.Rate)
+	suite.Equal(suite.Ctx.BlockTime(), getInfo.Commission.UpdateTime)
+	_, found = suite.App.OperatorKeeper.GetPendingCommissionUpdate(suite.Ctx, operator)
+	suite.False(found)
+}
+
+func (suite *OperatorTestSuite) TestEditOperator() {
+	info := &operatortype.OperatorInfo{
+		EarningsAddr:     suite.AccAddress.String(),
+		OperatorMetaInfo: "test operator",
+		ClientChainEarningsAddr: &operatortype.ClientChainEarningAddrList{
+			EarningInfoList: []*operatortype.ClientChainEarningAddrInfo{
+				{LzClientChainID: 101, ClientChainEarningAddr: "0x1f9840a85d5af5bf1d1762f925bdaddc4201f984"},
+			},
+		},
+		Commission: stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()),
+	}
+	err := suite.App.OperatorKeeper.SetOperatorInfo(suite.Ctx, suite.AccAddress.String(), info)
+	suite.NoError(err)
+
+	msg := &operatortype.MsgEditOperator{
+		FromAddress:      suite.AccAddress.String(),
+		OperatorMetaInfo: "new operator",
+		ClientChainEarningsAddr: &operatortype.ClientChainEarningAddrList{
+			EarningInfoList: []*operatortype.ClientChainEarningAddrInfo{
+				{LzClientChainID: 101, ClientChainEarningAddr: "0x3e108c058e8066da635321dc3018294ca82ddedf"},
+			},
+		},
+	}
+	suite.NoError(msg.ValidateBasic())
+	err = suite.App.OperatorKeeper.EditOperator(suite.Ctx, msg)
+	suite.NoError(err)
+	getInfo, err := suite.App.OperatorKeeper.QueryOperatorInfo(suite.Ctx, &operatortype.GetOperatorInfoReq{OperatorAddr: suite.AccAddress.String()})
+	suite.NoError(err)
+	suite.Equal("new operator", getInfo.OperatorMetaInfo)
+	suite.Equal(info.EarningsAddr, getInfo.EarningsAddr)
+	suite.Len(getInfo.ClientChainEarningsAddr.EarningInfoList, 1)
+	suite.Equal("0x3e108c058e8066da635321dc3018294ca82ddedf", getInfo.ClientChainEarningsAddr.EarningInfoList[0].ClientChainEarningAddr)
+
+	// the client chain must exist
+	msg.ClientChainEarningsAddr.EarningInfoList[0].LzClientChainID = 1
+	err = suite.App.OperatorKeeper.EditOperator(suite.Ctx, msg)
+	suite.ErrorIs(err, operatortype.ErrParameterInvalid)
+}
+
 // TODO: enable this test when editing operator is implemented. allow for querying
 // of the old commission against the new one.
 // func (suite *OperatorTestSuite) TestHistoricalOperatorInfo() {
@@ -64,6 +64,13 @@ func (k *Keeper) GetOperatorSlashInfo(ctx sdk.Context, avsAddr, operatorAddr, sl
 	return &operatorSlashInfo, nil
 }
 
+// setOperatorSlashInfo overwrites the stored slash info, it's used to record the execution
+// result or the veto of a pending slash.
+func (k *Keeper) setOperatorSlashInfo(ctx sdk.Context, operatorAddr, avsAddr, slashID string, slashInfo *operatortypes.OperatorSlashInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), operatortypes.KeyPrefixOperatorSlashInfo)
+	store.Set(assetstype.GetJoinedStoreKey(operatorAddr, avsAddr, slashID), k.cdc.MustMarshal(slashInfo))
+}
+
 // AllOperatorSlashInfo return all slash information for the specified operator and AVS
 func (k *Keeper) AllOperatorSlashInfo(ctx sdk.Context, avsAddr, operatorAddr string) (map[string]*operatortypes.OperatorSlashInfo, error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), operatortypes.KeyPrefixOperatorSlashInfo)
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"github.com/ExocoreNetwork/exocore/x/operator/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParams returns the parameters of the module, the default parameters are returned if
+// they haven't been set.
+func (k *Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParams)
+	value := store.Get(types.ParamsKey)
+	if value == nil {
+		return types.DefaultParams()
+	}
+	ret := types.Params{}
+	k.cdc.MustUnmarshal(value, &ret)
+	return ret
+}
+
+// SetParams sets the parameters of the module.
+func (k *Keeper) SetParams(ctx sdk.Context, params *types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParams)
+	store.Set(types.ParamsKey, k.cdc.MustMarshal(params))
+	return nil
+}
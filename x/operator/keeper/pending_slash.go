@@ -0,0 +1,248 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
+	"github.com/ExocoreNetwork/exocore/x/operator/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setPendingSlash stores the pending slash, the key is AVSAddr + '/' + operator + '/' + slashID.
+// The slash is also indexed by the epoch in which it should be executed.
+func (k *Keeper) setPendingSlash(ctx sdk.Context, pending *types.PendingSlash) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSlash)
+	key := assetstype.GetJoinedStoreKey(pending.AVSAddress, pending.OperatorAddress, pending.SlashID)
+	store.Set(key, k.cdc.MustMarshal(pending))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSlashByEpoch)
+	indexStore.Set(types.KeyForPendingSlashByEpoch(
+		pending.EpochIdentifier, pending.ExecutionEpoch,
+		pending.AVSAddress, pending.OperatorAddress, pending.SlashID,
+	), key)
+}
+
+// deletePendingSlash removes the pending slash and its index from the store.
+func (k *Keeper) deletePendingSlash(ctx sdk.Context, pending *types.PendingSlash) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSlash)
+	store.Delete(assetstype.GetJoinedStoreKey(pending.AVSAddress, pending.OperatorAddress, pending.SlashID))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSlashByEpoch)
+	indexStore.Delete(types.KeyForPendingSlashByEpoch(
+		pending.EpochIdentifier, pending.ExecutionEpoch,
+		pending.AVSAddress, pending.OperatorAddress, pending.SlashID,
+	))
+}
+
+// GetPendingSlash returns the pending slash and whether it's found.
+func (k *Keeper) GetPendingSlash(ctx sdk.Context, avsAddr, operatorAddr, slashID string) (*types.PendingSlash, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSlash)
+	value := store.Get(assetstype.GetJoinedStoreKey(avsAddr, operatorAddr, slashID))
+	if value == nil {
+		return nil, false
+	}
+	ret := types.PendingSlash{}
+	k.cdc.MustUnmarshal(value, &ret)
+	return &ret, true
+}
+
+// SetAllPendingSlashes sets all the pending slashes.
+func (k *Keeper) SetAllPendingSlashes(ctx sdk.Context, pendingSlashes []types.PendingSlash) {
+	for i := range pendingSlashes {
+		k.setPendingSlash(ctx, &pendingSlashes[i])
+	}
+}
+
+// GetAllPendingSlashes returns all the pending slashes.
+func (k *Keeper) GetAllPendingSlashes(ctx sdk.Context) []types.PendingSlash {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSlash)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	ret := make([]types.PendingSlash, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var pending types.PendingSlash
+		k.cdc.MustUnmarshal(iterator.Value(), &pending)
+		ret = append(ret, pending)
+	}
+	return ret
+}
+
+// isVetoableSlash returns whether the slash should wait for the veto window before being
+// executed. Only the non-instantaneous slashes submitted by the AVSs other than dogfood
+// can be vetoed.
+func (k *Keeper) isVetoableSlash(ctx sdk.Context, parameter *types.SlashInputInfo) bool {
+	return !parameter.IsDogFood &&
+		parameter.SlashType == uint32(types.SlashType_SLASH_TYPE_NO_INSTANTANEOUS_SLASH) &&
+		k.GetParams(ctx).SlashVetoEpochs > 0
+}
+
+// queueSlash stores the slash information without the execution result and queues the slash
+// until the end of the veto window, which is counted in the epochs of the AVS.
+func (k *Keeper) queueSlash(ctx sdk.Context, parameter *types.SlashInputInfo, slashInfo types.OperatorSlashInfo) error {
+	epochInfo, err := k.avsKeeper.GetAVSEpochInfo(ctx, parameter.AVSAddr)
+	if err != nil {
+		return err
+	}
+	operator := parameter.Operator.String()
+	err = k.UpdateOperatorSlashInfo(ctx, operator, parameter.AVSAddr, parameter.SlashID, slashInfo)
+	if err != nil {
+		return err
+	}
+	pending := types.PendingSlash{
+		OperatorAddress: operator,
+		AVSAddress:      parameter.AVSAddr,
+		SlashID:         parameter.SlashID,
+		EpochIdentifier: epochInfo.Identifier,
+		// #nosec G115
+		ExecutionEpoch: epochInfo.CurrentEpoch + int64(k.GetParams(ctx).SlashVetoEpochs),
+	}
+	k.setPendingSlash(ctx, &pending)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashQueued,
+			sdk.NewAttribute(types.AttributeKeyOperator, operator),
+			sdk.NewAttribute(types.AttributeKeyAVSAddress, parameter.AVSAddr),
+			sdk.NewAttribute(types.AttributeKeySlashID, parameter.SlashID),
+			sdk.NewAttribute(types.AttributeKeyExecutionEpoch, fmt.Sprintf("%d", pending.ExecutionEpoch)),
+		),
+	)
+	return nil
+}
+
+// executePendingSlash slashes the assets according to the stored slash information and
+// records the execution result.
+func (k *Keeper) executePendingSlash(ctx sdk.Context, pending *types.PendingSlash) error {
+	slashInfo, err := k.GetOperatorSlashInfo(ctx, pending.AVSAddress, pending.OperatorAddress, pending.SlashID)
+	if err != nil {
+		return err
+	}
+	operator, err := sdk.AccAddressFromBech32(pending.OperatorAddress)
+	if err != nil {
+		return assetstype.ErrInvalidOperatorAddr
+	}
+	power, err := k.GetHistoricalVotingPower(ctx, pending.AVSAddress, pending.OperatorAddress, slashInfo.EventHeight)
+	if err != nil {
+		return err
+	}
+	parameter := &types.SlashInputInfo{
+		IsDogFood:        false,
+		Power:            power,
+		SlashType:        slashInfo.SlashType,
+		Operator:         operator,
+		AVSAddr:          pending.AVSAddress,
+		SlashContract:    slashInfo.SlashContract,
+		SlashID:          pending.SlashID,
+		SlashEventHeight: slashInfo.EventHeight,
+		SlashProportion:  slashInfo.SlashProportion,
+	}
+	executionInfo, err := k.SlashAssets(ctx, parameter)
+	if err != nil {
+		return err
+	}
+	slashInfo.ExecutionInfo = executionInfo
+	k.setOperatorSlashInfo(ctx, pending.OperatorAddress, pending.AVSAddress, pending.SlashID, slashInfo)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashExecuted,
+			sdk.NewAttribute(types.AttributeKeyOperator, pending.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyAVSAddress, pending.AVSAddress),
+			sdk.NewAttribute(types.AttributeKeySlashID, pending.SlashID),
+		),
+	)
+	return nil
+}
+
+// ExecutePendingSlashes executes the pending slashes whose veto window ends with the
+// specified epoch. Only the index of the epoch identifier up to the epoch number is iterated.
+// Each slash is executed in a cache context and removed from the queue only if it succeeds,
+// otherwise it's retried at the end of the next epoch.
+func (k *Keeper) ExecutePendingSlashes(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSlash)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSlashByEpoch)
+	iterator := indexStore.Iterator(
+		types.PendingSlashEpochPrefix(epochIdentifier, 0),
+		types.PendingSlashEpochPrefix(epochIdentifier, epochNumber+1),
+	)
+	// collect the due slashes first, since the store can't be modified during iteration.
+	dueSlashes := make([]types.PendingSlash, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var pending types.PendingSlash
+		k.cdc.MustUnmarshal(store.Get(iterator.Value()), &pending)
+		dueSlashes = append(dueSlashes, pending)
+	}
+	iterator.Close()
+
+	for i := range dueSlashes {
+		pending := dueSlashes[i]
+		cc, writeFunc := ctx.CacheContext()
+		err := k.executePendingSlash(cc, &pending)
+		if err == nil {
+			k.deletePendingSlash(cc, &pending)
+			writeFunc()
+			continue
+		}
+		k.Logger(ctx).Error(
+			"failed to execute the pending slash",
+			"operator", pending.OperatorAddress,
+			"avs", pending.AVSAddress,
+			"slashID", pending.SlashID,
+			"error", err,
+		)
+		// the slash is kept pending, so that it can still be executed or vetoed later.
+		k.deletePendingSlash(ctx, &pending)
+		pending.ExecutionEpoch = epochNumber + 1
+		pending.FailedAttempts++
+		k.setPendingSlash(ctx, &pending)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSlashFailed,
+				sdk.NewAttribute(types.AttributeKeyOperator, pending.OperatorAddress),
+				sdk.NewAttribute(types.AttributeKeyAVSAddress, pending.AVSAddress),
+				sdk.NewAttribute(types.AttributeKeySlashID, pending.SlashID),
+				sdk.NewAttribute(types.AttributeKeyExecutionEpoch, fmt.Sprintf("%d", pending.ExecutionEpoch)),
+				sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+			),
+		)
+	}
+}
+
+// VetoSlash vetoes a pending slash. The slash can be vetoed by the module authority or the
+// members of the veto committee designated by the AVS.
+func (k *Keeper) VetoSlash(ctx sdk.Context, from, operatorAddr, avsAddr, slashID string) error {
+	pending, found := k.GetPendingSlash(ctx, avsAddr, operatorAddr, slashID)
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrSlashNotPending,
+			"operator:%s avs:%s slashID:%s", operatorAddr, avsAddr, slashID,
+		)
+	}
+	if from != k.authority {
+		isMember, err := k.avsKeeper.IsAVSVetoCommitteeMember(ctx, avsAddr, from)
+		if err != nil {
+			return err
+		}
+		if !isMember {
+			return errorsmod.Wrapf(types.ErrUnauthorizedVeto, "from:%s avs:%s", from, avsAddr)
+		}
+	}
+	slashInfo, err := k.GetOperatorSlashInfo(ctx, avsAddr, operatorAddr, slashID)
+	if err != nil {
+		return err
+	}
+	slashInfo.IsVetoed = true
+	k.setOperatorSlashInfo(ctx, operatorAddr, avsAddr, slashID, slashInfo)
+	k.deletePendingSlash(ctx, pending)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashVetoed,
+			sdk.NewAttribute(types.AttributeKeyOperator, operatorAddr),
+			sdk.NewAttribute(types.AttributeKeyAVSAddress, avsAddr),
+			sdk.NewAttribute(types.AttributeKeySlashID, slashID),
+			sdk.NewAttribute(types.AttributeKeyVetoedBy, from),
+		),
+	)
+	return nil
+}
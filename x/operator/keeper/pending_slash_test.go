@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	epochstypes "github.com/ExocoreNetwork/exocore/x/epochs/types"
+	"github.com/ExocoreNetwork/exocore/x/operator/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (suite *OperatorTestSuite) submitNonInstantSlash(slashID string) *types.PendingSlash {
+	err := suite.App.OperatorKeeper.Slash(suite.Ctx, &types.SlashInputInfo{
+		IsDogFood:        false,
+		Power:            0,
+		SlashType:        uint32(types.SlashType_SLASH_TYPE_NO_INSTANTANEOUS_SLASH),
+		Operator:         suite.operatorAddr,
+		AVSAddr:          suite.avsAddr,
+		SlashID:          slashID,
+		SlashEventHeight: suite.Ctx.BlockHeight(),
+		SlashProportion:  sdkmath.LegacyNewDecWithPrec(1, 1),
+	})
+	suite.NoError(err)
+	pending, found := suite.App.OperatorKeeper.GetPendingSlash(suite.Ctx, suite.avsAddr, suite.operatorAddr.String(), slashID)
+	suite.True(found)
+	return pending
+}
+
+func (suite *OperatorTestSuite) TestPendingSlash() {
+	suite.prepare()
+	suite.prepareAvs([]string{"0xdac17f958d2ee523a2206206994597c13d831ec7_0x65"})
+	err := suite.App.OperatorKeeper.OptIn(suite.Ctx, suite.operatorAddr, suite.avsAddr)
+	suite.NoError(err)
+	// take the voting power snapshot at the end of the epoch
+	suite.CommitAfter(time.Hour + time.Nanosecond)
+
+	// the non-instantaneous slash is queued without being executed
+	pending := suite.submitNonInstantSlash("slash1")
+	epochInfo, found := suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, epochstypes.HourEpochID)
+	suite.True(found)
+	suite.Equal(epochstypes.HourEpochID, pending.EpochIdentifier)
+	suite.Equal(epochInfo.CurrentEpoch+int64(types.DefaultSlashVetoEpochs), pending.ExecutionEpoch)
+	slashInfo, err := suite.App.OperatorKeeper.GetOperatorSlashInfo(suite.Ctx, suite.avsAddr, suite.operatorAddr.String(), "slash1")
+	suite.NoError(err)
+	suite.Nil(slashInfo.ExecutionInfo)
+
+	// only the authority or the veto committee of the AVS can veto the slash
+	err = suite.App.OperatorKeeper.VetoSlash(suite.Ctx, suite.operatorAddr.String(), suite.operatorAddr.String(), suite.avsAddr, "slash1")
+	suite.ErrorIs(err, types.ErrUnauthorizedVeto)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	err = suite.App.OperatorKeeper.VetoSlash(suite.Ctx, authority, suite.operatorAddr.String(), suite.avsAddr, "slash1")
+	suite.NoError(err)
+	slashInfo, err = suite.App.OperatorKeeper.GetOperatorSlashInfo(suite.Ctx, suite.avsAddr, suite.operatorAddr.String(), "slash1")
+	suite.NoError(err)
+	suite.True(slashInfo.IsVetoed)
+	suite.Nil(slashInfo.ExecutionInfo)
+	_, found = suite.App.OperatorKeeper.GetPendingSlash(suite.Ctx, suite.avsAddr, suite.operatorAddr.String(), "slash1")
+	suite.False(found)
+	err = suite.App.OperatorKeeper.VetoSlash(suite.Ctx, authority, suite.operatorAddr.String(), suite.avsAddr, "slash1")
+	suite.ErrorIs(err, types.ErrSlashNotPending)
+
+	// the slash isn't executed before the end of the veto window
+	pending = suite.submitNonInstantSlash("slash2")
+	suite.App.OperatorKeeper.ExecutePendingSlashes(suite.Ctx, pending.EpochIdentifier, pending.ExecutionEpoch-1)
+	_, found = suite.App.OperatorKeeper.GetPendingSlash(suite.Ctx, suite.avsAddr, suite.operatorAddr.String(), "slash2")
+	suite.True(found)
+	suite.Len(suite.App.OperatorKeeper.GetAllPendingSlashes(suite.Ctx), 1)
+
+	// the slash is executed at the end of the veto window
+	suite.App.OperatorKeeper.ExecutePendingSlashes(suite.Ctx, pending.EpochIdentifier, pending.ExecutionEpoch)
+	_, found = suite.App.OperatorKeeper.GetPendingSlash(suite.Ctx, suite.avsAddr, suite.operatorAddr.String(), "slash2")
+	suite.False(found)
+	slashInfo, err = suite.App.OperatorKeeper.GetOperatorSlashInfo(suite.Ctx, suite.avsAddr, suite.operatorAddr.String(), "slash2")
+	suite.NoError(err)
+	suite.False(slashInfo.IsVetoed)
+	suite.NotNil(slashInfo.ExecutionInfo)
+	suite.Len(suite.App.OperatorKeeper.GetAllPendingSlashes(suite.Ctx), 0)
+}
+
+func (suite *OperatorTestSuite) TestPendingSlashRetry() {
+	suite.prepare()
+	suite.prepareAvs([]string{"0xdac17f958d2ee523a2206206994597c13d831ec7_0x65"})
+	err := suite.App.OperatorKeeper.OptIn(suite.Ctx, suite.operatorAddr, suite.avsAddr)
+	suite.NoError(err)
+	// take the voting power snapshot at the end of the epoch
+	suite.CommitAfter(time.Hour + time.Nanosecond)
+	pending := suite.submitNonInstantSlash("slash")
+
+	// the execution fails without the voting power snapshot
+	snapshots := suite.App.OperatorKeeper.GetAllVotingPowerSnapshots(suite.Ctx)
+	suite.NotEmpty(snapshots)
+	store := prefix.NewStore(suite.Ctx.KVStore(suite.App.GetKey(types.StoreKey)), types.KeyPrefixVotingPowerSnapshot)
+	for _, snapshot := range snapshots {
+		store.Delete(types.KeyForVotingPowerSnapshot(snapshot.AVSAddress, snapshot.Height))
+	}
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	suite.App.OperatorKeeper.ExecutePendingSlashes(suite.Ctx, pending.EpochIdentifier, pending.ExecutionEpoch)
+
+	// the slash is kept pending until the next epoch instead of being dropped
+	retried, found := suite.App.OperatorKeeper.GetPendingSlash(suite.Ctx, suite.avsAddr, suite.operatorAddr.String(), "slash")
+	suite.True(found)
+	suite.Equal(pending.ExecutionEpoch+1, retried.ExecutionEpoch)
+	suite.Equal(uint32(1), retried.FailedAttempts)
+	suite.Len(suite.App.OperatorKeeper.GetAllPendingSlashes(suite.Ctx), 1)
+	slashInfo, err := suite.App.OperatorKeeper.GetOperatorSlashInfo(suite.Ctx, suite.avsAddr, suite.operatorAddr.String(), "slash")
+	suite.NoError(err)
+	suite.Nil(slashInfo.ExecutionInfo)
+	failedEvents := 0
+	for _, event := range suite.Ctx.EventManager().Events() {
+		if event.Type == types.EventTypeSlashFailed {
+			failedEvents++
+		}
+	}
+	suite.Equal(1, failedEvents)
+
+	// the slash is executed once the snapshot is available
+	suite.App.OperatorKeeper.SetAllVotingPowerSnapshots(suite.Ctx, snapshots)
+	suite.App.OperatorKeeper.ExecutePendingSlashes(suite.Ctx, retried.EpochIdentifier, retried.ExecutionEpoch)
+	_, found = suite.App.OperatorKeeper.GetPendingSlash(suite.Ctx, suite.avsAddr, suite.operatorAddr.String(), "slash")
+	suite.False(found)
+	slashInfo, err = suite.App.OperatorKeeper.GetOperatorSlashInfo(suite.Ctx, suite.avsAddr, suite.operatorAddr.String(), "slash")
+	suite.NoError(err)
+	suite.NotNil(slashInfo.ExecutionInfo)
+}
+
+func (suite *OperatorTestSuite) TestVetoSlashByCommittee() {
+	suite.prepare()
+	suite.prepareAvs([]string{"0xdac17f958d2ee523a2206206994597c13d831ec7_0x65"})
+	err := suite.App.OperatorKeeper.OptIn(suite.Ctx, suite.operatorAddr, suite.avsAddr)
+	suite.NoError(err)
+	suite.CommitAfter(time.Hour + time.Nanosecond)
+
+	owner := sdk.AccAddress([]byte("avsOwnerAddress_____")).String()
+	member := sdk.AccAddress([]byte("vetoCommitteeMember_")).String()
+	avsInfo, err := suite.App.AVSManagerKeeper.GetAVSInfo(suite.Ctx, suite.avsAddr)
+	suite.NoError(err)
+	avsInfo.Info.AvsOwnerAddress = []string{owner}
+	err = suite.App.AVSManagerKeeper.SetAVSInfo(suite.Ctx, avsInfo.Info)
+	suite.NoError(err)
+
+	// only the owners can designate the committee, and they can't be members of it
+	err = suite.App.AVSManagerKeeper.SetAVSVetoCommittee(suite.Ctx, suite.avsAddr, member, []string{member})
+	suite.ErrorIs(err, avstypes.ErrCallerAddressUnauthorized)
+	err = suite.App.AVSManagerKeeper.SetAVSVetoCommittee(suite.Ctx, suite.avsAddr, owner, []string{owner})
+	suite.ErrorIs(err, avstypes.ErrInvalidVetoCommittee)
+	err = suite.App.AVSManagerKeeper.SetAVSVetoCommittee(suite.Ctx, suite.avsAddr, owner, []string{member})
+	suite.NoError(err)
+
+	// the owner, which submits the slashes, can't veto them
+	suite.submitNonInstantSlash("slash1")
+	err = suite.App.OperatorKeeper.VetoSlash(suite.Ctx, owner, suite.operatorAddr.String(), suite.avsAddr, "slash1")
+	suite.ErrorIs(err, types.ErrUnauthorizedVeto)
+	err = suite.App.OperatorKeeper.VetoSlash(suite.Ctx, member, suite.operatorAddr.String(), suite.avsAddr, "slash1")
+	suite.NoError(err)
+	slashInfo, err := suite.App.OperatorKeeper.GetOperatorSlashInfo(suite.Ctx, suite.avsAddr, suite.operatorAddr.String(), "slash1")
+	suite.NoError(err)
+	suite.True(slashInfo.IsVetoed)
+	suite.Len(suite.App.OperatorKeeper.GetAllPendingSlashes(suite.Ctx), 0)
+}
+
+func (suite *OperatorTestSuite) TestSlashWithoutVetoWindow() {
+	suite.prepare()
+	suite.prepareAvs([]string{"0xdac17f958d2ee523a2206206994597c13d831ec7_0x65"})
+	err := suite.App.OperatorKeeper.OptIn(suite.Ctx, suite.operatorAddr, suite.avsAddr)
+	suite.NoError(err)
+	// take the voting power snapshot at the end of the epoch
+	suite.CommitAfter(time.Hour + time.Nanosecond)
+
+	err = suite.App.OperatorKeeper.SetParams(suite.Ctx, &types.Params{SlashVetoEpochs: 0})
+	suite.NoError(err)
+	err = suite.App.OperatorKeeper.Slash(suite.Ctx, &types.SlashInputInfo{
+		SlashType:        uint32(types.SlashType_SLASH_TYPE_NO_INSTANTANEOUS_SLASH),
+		Operator:         suite.operatorAddr,
+		AVSAddr:          suite.avsAddr,
+		SlashID:          "slash",
+		SlashEventHeight: suite.Ctx.BlockHeight(),
+		SlashProportion:  sdkmath.LegacyNewDecWithPrec(1, 1),
+	})
+	suite.NoError(err)
+	_, found := suite.App.OperatorKeeper.GetPendingSlash(suite.Ctx, suite.avsAddr, suite.operatorAddr.String(), "slash")
+	suite.False(found)
+	slashInfo, err := suite.App.OperatorKeeper.GetOperatorSlashInfo(suite.Ctx, suite.avsAddr, suite.operatorAddr.String(), "slash")
+	suite.NoError(err)
+	suite.NotNil(slashInfo.ExecutionInfo)
+}
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+
+	"github.com/ExocoreNetwork/exocore/x/operator/types"
+	oracletypes "github.com/ExocoreNetwork/exocore/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setPriceBreakerState stores the state of the price breaker, the key is the asset ID.
+func (k *Keeper) setPriceBreakerState(ctx sdk.Context, state *types.PriceBreakerState) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPriceBreakerState)
+	store.Set([]byte(state.AssetID), k.cdc.MustMarshal(state))
+}
+
+// GetPriceBreakerState returns the state of the price breaker of the asset and whether it's found.
+func (k *Keeper) GetPriceBreakerState(ctx sdk.Context, assetID string) (*types.PriceBreakerState, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPriceBreakerState)
+	value := store.Get([]byte(assetID))
+	if value == nil {
+		return nil, false
+	}
+	ret := types.PriceBreakerState{}
+	k.cdc.MustUnmarshal(value, &ret)
+	return &ret, true
+}
+
+// SetAllPriceBreakerStates sets the states of the price breakers.
+func (k *Keeper) SetAllPriceBreakerStates(ctx sdk.Context, states []types.PriceBreakerState) {
+	for i := range states {
+		k.setPriceBreakerState(ctx, &states[i])
+	}
+}
+
+// GetAllPriceBreakerStates returns the states of all the price breakers.
+func (k *Keeper) GetAllPriceBreakerStates(ctx sdk.Context) []types.PriceBreakerState {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPriceBreakerState)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	ret := make([]types.PriceBreakerState, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var state types.PriceBreakerState
+		k.cdc.MustUnmarshal(iterator.Value(), &state)
+		ret = append(ret, state)
+	}
+	return ret
+}
+
+// GetTrippedAssets returns the sorted IDs of the assets whose price breaker is tripped among
+// the input assets.
+func (k *Keeper) GetTrippedAssets(ctx sdk.Context, assets map[string]interface{}) []string {
+	var tripped []string
+	for assetID := range assets {
+		state, found := k.GetPriceBreakerState(ctx, assetID)
+		if found && state.Tripped {
+			tripped = append(tripped, assetID)
+		}
+	}
+	sort.Strings(tripped)
+	return tripped
+}
+
+// UpdatePriceBreakers checks the latest prices of the guarded assets at the end of each block.
+// The breaker of an asset is tripped if the price changes more than the max deviation in a new
+// round, and it's reset once a new round is within the deviation. It's also tripped if there
+// is no new round for more than the max staleness blocks, and it's reset by the next round.
+// The states of the assets without a price guard are removed.
+func (k *Keeper) UpdatePriceBreakers(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	for _, state := range k.GetAllPriceBreakerStates(ctx) {
+		if _, found := params.GetPriceGuard(state.AssetID); !found {
+			store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPriceBreakerState)
+			store.Delete([]byte(state.AssetID))
+		}
+	}
+
+	height := ctx.BlockHeight()
+	for _, guard := range params.PriceGuards {
+		price, err := k.oracleKeeper.GetSpecifiedAssetsPrice(ctx, guard.AssetID)
+		// the price without a valid round is regarded as no new round
+		hasNewPrice := err == nil
+		if err != nil && !errors.Is(err, oracletypes.ErrGetPriceRoundNotFound) {
+			ctx.Logger().Error("UpdatePriceBreakers fail to get the price", "assetID", guard.AssetID, "error", err)
+			continue
+		}
+		state, found := k.GetPriceBreakerState(ctx, guard.AssetID)
+		if !found {
+			if !hasNewPrice {
+				continue
+			}
+			k.setPriceBreakerState(ctx, &types.PriceBreakerState{
+				AssetID:      guard.AssetID,
+				RoundID:      price.RoundID,
+				Price:        price.Value,
+				Decimal:      uint32(price.Decimal),
+				UpdateHeight: height,
+			})
+			continue
+		}
+
+		deviated := state.Tripped && state.Reason == types.PriceBreakerReasonDeviation
+		if hasNewPrice && price.RoundID != state.RoundID {
+			decimal := uint32(price.Decimal)
+			changed := !price.Value.Equal(state.Price) || decimal != state.Decimal
+			deviated = changed && guard.IsDeviated(state.Price, state.Decimal, price.Value, decimal)
+			if changed {
+				state.Price = price.Value
+				state.Decimal = decimal
+			}
+			// a new round refreshes the price even if the value is unchanged, which is expected
+			// for the stable assets.
+			state.RoundID = price.RoundID
+			state.UpdateHeight = height
+		}
+		reason := ""
+		if deviated {
+			reason = types.PriceBreakerReasonDeviation
+		} else if guard.IsStale(state.UpdateHeight, height) {
+			reason = types.PriceBreakerReasonStale
+		}
+
+		tripped := reason != ""
+		if tripped != state.Tripped || reason != state.Reason {
+			eventType := types.EventTypePriceBreakerReset
+			if tripped {
+				eventType = types.EventTypePriceBreakerTripped
+				ctx.Logger().Info("the price breaker is tripped", "assetID", guard.AssetID, "reason", reason, "roundID", state.RoundID)
+			}
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					eventType,
+					sdk.NewAttribute(types.AttributeKeyAssetID, guard.AssetID),
+					sdk.NewAttribute(types.AttributeKeyRoundID, strconv.FormatUint(state.RoundID, 10)),
+					sdk.NewAttribute(types.AttributeKeyReason, reason),
+				),
+			)
+		}
+		state.Tripped = tripped
+		state.Reason = reason
+		k.setPriceBreakerState(ctx, state)
+	}
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	operatortypes "github.com/ExocoreNetwork/exocore/x/operator/types"
+	oracletypes "github.com/ExocoreNetwork/exocore/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *OperatorTestSuite) setOraclePrice(roundID uint64, price string, decimal int32) {
+	suite.App.OracleKeeper.SetPrices(suite.Ctx, oracletypes.Prices{
+		TokenID:     1,
+		NextRoundID: roundID + 1,
+		PriceList: []*oracletypes.PriceTimeRound{
+			{Price: price, Decimal: decimal, RoundID: roundID},
+		},
+	})
+}
+
+func (suite *OperatorTestSuite) TestPriceBreaker() {
+	assetID := "0xdac17f958d2ee523a2206206994597c13d831ec7_0x65"
+	params := suite.App.OperatorKeeper.GetParams(suite.Ctx)
+	params.PriceGuards = []operatortypes.PriceGuard{
+		{
+			AssetID:            assetID,
+			MaxStalenessBlocks: 10,
+			MaxDeviation:       sdk.NewDecWithPrec(1, 1),
+		},
+	}
+	err := suite.App.OperatorKeeper.SetParams(suite.Ctx, &params)
+	suite.NoError(err)
+	assertState := func(roundID uint64, tripped bool, reason string) {
+		state, found := suite.App.OperatorKeeper.GetPriceBreakerState(suite.Ctx, assetID)
+		suite.True(found)
+		suite.Equal(roundID, state.RoundID)
+		suite.Equal(tripped, state.Tripped)
+		suite.Equal(reason, state.Reason)
+	}
+
+	// the state is initialized with the price in genesis
+	suite.App.OperatorKeeper.UpdatePriceBreakers(suite.Ctx)
+	assertState(1, false, "")
+
+	// the breaker is tripped by a price deviating too much in a new round
+	suite.setOraclePrice(2, "2", 0)
+	suite.App.OperatorKeeper.UpdatePriceBreakers(suite.Ctx)
+	assertState(2, true, operatortypes.PriceBreakerReasonDeviation)
+
+	// the voting power of the AVS supporting the asset is frozen
+	avsAddr := avstypes.GenerateAVSAddr(avstypes.ChainIDWithoutRevision(suite.Ctx.ChainID()))
+	assets, err := suite.App.AVSManagerKeeper.GetAVSSupportedAssets(suite.Ctx, avsAddr)
+	suite.NoError(err)
+	suite.Contains(assets, assetID)
+	suite.Equal([]string{assetID}, suite.App.OperatorKeeper.GetTrippedAssets(suite.Ctx, assets))
+	err = suite.App.OperatorKeeper.SetAVSUSDValue(suite.Ctx, avsAddr, sdkmath.LegacyNewDec(12345))
+	suite.NoError(err)
+	err = suite.App.OperatorKeeper.UpdateVotingPower(suite.Ctx, avsAddr)
+	suite.NoError(err)
+	usdValue, err := suite.App.OperatorKeeper.GetAVSUSDValue(suite.Ctx, avsAddr)
+	suite.NoError(err)
+	suite.Equal(sdkmath.LegacyNewDec(12345), usdValue)
+
+	// the breaker is reset by a new round within the deviation
+	suite.setOraclePrice(3, "21", 1)
+	suite.App.OperatorKeeper.UpdatePriceBreakers(suite.Ctx)
+	assertState(3, false, "")
+
+	// a new round with the unchanged price isn't stale
+	suite.setOraclePrice(4, "21", 1)
+	suite.Ctx = suite.Ctx.WithBlockHeight(suite.Ctx.BlockHeight() + 11)
+	suite.App.OperatorKeeper.UpdatePriceBreakers(suite.Ctx)
+	assertState(4, false, "")
+
+	// the breaker is tripped if there's no new round for too many blocks
+	suite.Ctx = suite.Ctx.WithBlockHeight(suite.Ctx.BlockHeight() + 11)
+	suite.App.OperatorKeeper.UpdatePriceBreakers(suite.Ctx)
+	assertState(4, true, operatortypes.PriceBreakerReasonStale)
+
+	// the states are removed along with the price guards
+	params.PriceGuards = nil
+	err = suite.App.OperatorKeeper.SetParams(suite.Ctx, &params)
+	suite.NoError(err)
+	suite.App.OperatorKeeper.UpdatePriceBreakers(suite.Ctx)
+	suite.Empty(suite.App.OperatorKeeper.GetAllPriceBreakerStates(suite.Ctx))
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"strings"
 
 	"github.com/ExocoreNetwork/exocore/utils"
@@ -14,6 +15,7 @@ import (
 	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
 	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	"github.com/ExocoreNetwork/exocore/x/operator/types"
+	oracletype "github.com/ExocoreNetwork/exocore/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -58,10 +60,10 @@ func (k *Keeper) CheckSlashParameter(ctx sdk.Context, parameter *types.SlashInpu
 			return errorsmod.Wrapf(types.ErrInvalidSlashPower, "slash for dogfood, the power is:%v", parameter.Power)
 		}
 	} else {
+		// the historical voting power of the other AVSs is loaded from the snapshot
 		if parameter.Power != 0 {
 			return errorsmod.Wrapf(types.ErrInvalidSlashPower, "slash for other AVSs, the power is:%v", parameter.Power)
 		}
-		// todo: get the historical voting power from the snapshot for the other AVSs
 	}
 	return nil
 }
@@ -79,15 +81,37 @@ func (k *Keeper) SlashAssets(ctx sdk.Context, parameter *types.SlashInputInfo) (
 	if err != nil {
 		return nil, err
 	}
-	// calculate the new slash proportion
-	newSlashProportion := slashUSDValue.Quo(stakingInfo.StakingAndWaitUnbonding)
-	newSlashProportion = sdkmath.LegacyMinDec(sdkmath.LegacyNewDec(1), newSlashProportion)
+	// the assets redelegated away after the slash event are still slashable, so they're
+	// counted in the value from which the proportion is calculated.
+	var redelegations []delegationtype.RedelegationRecord
+	// #nosec G701
+	heightFilter := uint64(parameter.SlashEventHeight)
+	slashableValue := stakingInfo.StakingAndWaitUnbonding
+	if parameter.SlashEventHeight < ctx.BlockHeight() {
+		redelegations, err = k.delegationKeeper.GetRedelegationsBySrcOperator(ctx, parameter.Operator.String(), &heightFilter)
+		if err != nil {
+			return nil, err
+		}
+		redelegatedValue, err := k.calculateUSDValueForRedelegations(ctx, redelegations)
+		if err != nil {
+			return nil, err
+		}
+		slashableValue = slashableValue.Add(redelegatedValue)
+	}
+	// calculate the new slash proportion, there is nothing to slash if the operator
+	// doesn't have any assets.
+	newSlashProportion := sdkmath.LegacyZeroDec()
+	if slashableValue.IsPositive() {
+		newSlashProportion = slashUSDValue.Quo(slashableValue)
+		newSlashProportion = sdkmath.LegacyMinDec(sdkmath.LegacyNewDec(1), newSlashProportion)
+	}
 
 	executionInfo := &types.SlashExecutionInfo{
 		SlashProportion:    newSlashProportion,
 		SlashValue:         slashUSDValue,
 		SlashUndelegations: make([]types.SlashFromUndelegation, 0),
 		SlashAssetsPool:    make([]types.SlashFromAssetsPool, 0),
+		SlashRedelegations: make([]types.SlashFromRedelegation, 0),
 	}
 	// slash from the unbonding stakers
 	if parameter.SlashEventHeight < ctx.BlockHeight() {
@@ -99,12 +123,26 @@ func (k *Keeper) SlashAssets(ctx sdk.Context, parameter *types.SlashInputInfo) (
 			}
 			return nil
 		}
-		// #nosec G701
-		heightFilter := uint64(parameter.SlashEventHeight)
 		err = k.delegationKeeper.IterateUndelegationsByOperator(ctx, parameter.Operator.String(), &heightFilter, true, opFunc)
 		if err != nil {
 			return nil, err
 		}
+
+		// the assets redelegated away after the slash event are slashed from the destination operators
+		for i := range redelegations {
+			slashAmount, err := k.delegationKeeper.SlashRedelegation(ctx, &redelegations[i], newSlashProportion)
+			if err != nil {
+				return nil, err
+			}
+			if slashAmount.IsPositive() {
+				executionInfo.SlashRedelegations = append(executionInfo.SlashRedelegations, types.SlashFromRedelegation{
+					StakerID:        redelegations[i].StakerID,
+					AssetID:         redelegations[i].AssetID,
+					DstOperatorAddr: redelegations[i].DstOperatorAddr,
+					Amount:          slashAmount,
+				})
+			}
+		}
 	}
 
 	// slash from the assets pool of the operator
@@ -149,6 +187,30 @@ func (k *Keeper) SlashAssets(ctx sdk.Context, parameter *types.SlashInputInfo) (
 	return executionInfo, nil
 }
 
+// calculateUSDValueForRedelegations returns the USD value of the slashable amounts of the
+// redelegation records.
+func (k *Keeper) calculateUSDValueForRedelegations(
+	ctx sdk.Context, redelegations []delegationtype.RedelegationRecord,
+) (sdkmath.LegacyDec, error) {
+	ret := sdkmath.LegacyZeroDec()
+	for i := range redelegations {
+		record := redelegations[i]
+		if !record.SlashableAmount.IsPositive() {
+			continue
+		}
+		price, err := k.oracleKeeper.GetSpecifiedAssetsPrice(ctx, record.AssetID)
+		if err != nil && !errors.Is(err, oracletype.ErrGetPriceRoundNotFound) {
+			return sdkmath.LegacyZeroDec(), err
+		}
+		assetInfo, err := k.assetsKeeper.GetStakingAssetInfo(ctx, record.AssetID)
+		if err != nil {
+			return sdkmath.LegacyZeroDec(), err
+		}
+		ret = ret.Add(CalculateUSDValue(record.SlashableAmount, price.Value, assetInfo.AssetBasicInfo.Decimals, price.Decimal))
+	}
+	return ret, nil
+}
+
 // Slash performs all slash events and stores the execution result
 func (k *Keeper) Slash(ctx sdk.Context, parameter *types.SlashInputInfo) error {
 	err := k.CheckSlashParameter(ctx, parameter)
@@ -156,15 +218,15 @@ func (k *Keeper) Slash(ctx sdk.Context, parameter *types.SlashInputInfo) error {
 		return err
 	}
 
-	// slash assets according to the input information
-	// using cache context to ensure the atomicity of slash execution.
-	cc, writeFunc := ctx.CacheContext()
-	executionInfo, err := k.SlashAssets(cc, parameter)
-	if err != nil {
-		return err
+	// use the voting power in effect at the slash event height for the other AVSs,
+	// so that the slash is proportional to the stake securing the AVS at that time.
+	if !parameter.IsDogFood {
+		parameter.Power, err = k.GetHistoricalVotingPower(ctx, parameter.AVSAddr, parameter.Operator.String(), parameter.SlashEventHeight)
+		if err != nil {
+			return err
+		}
 	}
-	writeFunc()
-	// store the slash information
+
 	height := ctx.BlockHeight()
 	slashInfo := types.OperatorSlashInfo{
 		SlashType:       parameter.SlashType,
@@ -172,8 +234,22 @@ func (k *Keeper) Slash(ctx sdk.Context, parameter *types.SlashInputInfo) error {
 		SubmittedHeight: height,
 		EventHeight:     parameter.SlashEventHeight,
 		SlashProportion: parameter.SlashProportion,
-		ExecutionInfo:   executionInfo,
 	}
+	// the non-instantaneous slash is queued until the veto window ends
+	if k.isVetoableSlash(ctx, parameter) {
+		return k.queueSlash(ctx, parameter, slashInfo)
+	}
+
+	// slash assets according to the input information
+	// using cache context to ensure the atomicity of slash execution.
+	cc, writeFunc := ctx.CacheContext()
+	executionInfo, err := k.SlashAssets(cc, parameter)
+	if err != nil {
+		return err
+	}
+	writeFunc()
+	// store the slash information
+	slashInfo.ExecutionInfo = executionInfo
 	err = k.UpdateOperatorSlashInfo(ctx, parameter.Operator.String(), parameter.AVSAddr, parameter.SlashID, slashInfo)
 	if err != nil {
 		return err
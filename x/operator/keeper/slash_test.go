@@ -5,9 +5,11 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	"github.com/ExocoreNetwork/exocore/x/operator/keeper"
 	"github.com/ExocoreNetwork/exocore/x/operator/types"
 	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -98,3 +100,87 @@ func (suite *OperatorTestSuite) TestSlashWithInfractionReason() {
 	suite.NoError(err)
 	suite.Equal(0, len(undelegations))
 }
+
+func (suite *OperatorTestSuite) TestSlashAssetsWithRedelegation() {
+	suite.prepareOperator()
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	amount := sdkmath.NewIntWithDecimal(100, 6)
+	suite.prepareDeposit(usdtAddress, amount)
+	suite.prepareDelegation(true, usdtAddress, amount)
+	dstOperator := sdk.AccAddress(common.HexToAddress("0x3e108c058e8066DA635321Dc3018294cA82ddEdf").Bytes())
+	_, err := s.OperatorMsgServer.RegisterOperator(s.Ctx, &types.RegisterOperatorReq{
+		FromAddress: dstOperator.String(),
+		Info: &types.OperatorInfo{
+			EarningsAddr: dstOperator.String(),
+		},
+	})
+	suite.NoError(err)
+	stakingInfo, err := suite.App.OperatorKeeper.CalculateUSDValueForOperator(suite.Ctx, true, suite.operatorAddr.String(), nil, nil, nil)
+	suite.NoError(err)
+	eventHeight := suite.Ctx.BlockHeight()
+	suite.NextBlock()
+
+	// 40% of the delegation is redelegated after the slash event
+	redelegatedAmount := sdkmath.NewIntWithDecimal(40, 6)
+	err = suite.App.DelegationKeeper.Redelegate(suite.Ctx, &delegationtype.RedelegationParams{
+		ClientChainID:      suite.clientChainLzID,
+		AssetsAddress:      usdtAddress[:],
+		StakerAddress:      suite.Address[:],
+		SrcOperatorAddress: suite.operatorAddr,
+		DstOperatorAddress: dstOperator,
+		OpAmount:           redelegatedAmount,
+		LzNonce:            1,
+		TxHash:             common.HexToHash("0x24c4a315d757249c12a7a1d7b6fb96261d49deee26f06a3e1787d008b445c3ad"),
+	})
+	suite.NoError(err)
+
+	// the redelegated amount is counted in the slashable value, so the proportion is the
+	// same as the one at the slash event.
+	slashProportion := sdkmath.LegacyNewDecWithPrec(1, 1)
+	executionInfo, err := suite.App.OperatorKeeper.SlashAssets(suite.Ctx, &types.SlashInputInfo{
+		Power:            stakingInfo.StakingAndWaitUnbonding.TruncateInt64(),
+		Operator:         suite.operatorAddr,
+		AVSAddr:          common.BytesToAddress([]byte("avsTestAddr")).String(),
+		SlashEventHeight: eventHeight,
+		SlashProportion:  slashProportion,
+	})
+	suite.NoError(err)
+	suite.Equal(slashProportion, executionInfo.SlashProportion)
+	suite.Equal(slashProportion.MulInt(amount.Sub(redelegatedAmount)).TruncateInt(), executionInfo.SlashAssetsPool[0].Amount)
+	redelegationSlash := slashProportion.MulInt(redelegatedAmount).TruncateInt()
+	suite.Equal(redelegationSlash, executionInfo.SlashRedelegations[0].Amount)
+
+	// the hooks are notified of the slash on the destination operator
+	info, found := suite.App.RewardKeeper.GetStakerRewardStartingInfo(suite.Ctx, suite.stakerID, suite.assetID, dstOperator.String())
+	suite.True(found)
+	suite.Equal(sdkmath.LegacyNewDecFromInt(redelegatedAmount.Sub(redelegationSlash)), info.Share)
+}
+
+func (suite *OperatorTestSuite) TestSlashAssetsToZero() {
+	suite.prepareOperator()
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	amount := sdkmath.NewIntWithDecimal(100, 6)
+	suite.prepareDeposit(usdtAddress, amount)
+	suite.prepareDelegation(true, usdtAddress, amount)
+	_, found := suite.App.DistrKeeper.GetStakerStartingInfo(suite.Ctx, suite.stakerID, suite.operatorAddr, suite.assetID)
+	suite.True(found)
+	stakingInfo, err := suite.App.OperatorKeeper.CalculateUSDValueForOperator(suite.Ctx, true, suite.operatorAddr.String(), nil, nil, nil)
+	suite.NoError(err)
+
+	// the whole asset pool is slashed, which clears the shares of the stakers
+	_, err = suite.App.OperatorKeeper.SlashAssets(suite.Ctx, &types.SlashInputInfo{
+		Power:            stakingInfo.StakingAndWaitUnbonding.TruncateInt64() + 1,
+		Operator:         suite.operatorAddr,
+		SlashEventHeight: suite.Ctx.BlockHeight(),
+		SlashProportion:  sdkmath.LegacyOneDec(),
+	})
+	suite.NoError(err)
+	delegation, err := suite.App.DelegationKeeper.GetSingleDelegationInfo(suite.Ctx, suite.stakerID, suite.assetID, suite.operatorAddr.String())
+	suite.NoError(err)
+	suite.True(delegation.UndelegatableShare.IsZero())
+
+	// the hooks are notified, so the rewards of the cleared share are settled
+	_, found = suite.App.DistrKeeper.GetStakerStartingInfo(suite.Ctx, suite.stakerID, suite.operatorAddr, suite.assetID)
+	suite.False(found)
+	suite.True(suite.App.DistrKeeper.GetOperatorAssetTotalStake(suite.Ctx, suite.operatorAddr, suite.assetID).IsZero())
+}
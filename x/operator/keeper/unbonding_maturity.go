@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/ExocoreNetwork/exocore/x/operator/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ComputeUnbondingMaturity returns the maturity of an unbonding started from the operator at
+// the current block. Among the AVSs the operator is opted into, the one whose unbonding period
+// elapses last determines the maturity, which is aligned to the end of its epoch. The AVSs of
+// the subscriber chains are skipped, since their unbonding is held by the dogfood module. It
+// returns nil if none of the AVSs has an unbonding period.
+func (k *Keeper) ComputeUnbondingMaturity(ctx sdk.Context, operator sdk.AccAddress) (*types.UnbondingMaturity, error) {
+	maturity, _, err := k.computeUnbondingMaturity(ctx, operator)
+	return maturity, err
+}
+
+// computeUnbondingMaturity returns the unbonding maturity along with the time at which the
+// maturity epoch ends.
+func (k *Keeper) computeUnbondingMaturity(ctx sdk.Context, operator sdk.AccAddress) (*types.UnbondingMaturity, time.Time, error) {
+	avsList, err := k.GetOptedInAVSForOperator(ctx, operator.String())
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	var ret *types.UnbondingMaturity
+	var latestEndTime time.Time
+	for _, avsAddr := range avsList {
+		if !k.IsOptedIn(ctx, operator.String(), avsAddr) {
+			continue
+		}
+		if _, isChainAVS := k.avsKeeper.GetChainIDByAVSAddr(ctx, avsAddr); isChainAVS {
+			continue
+		}
+		unbondingPeriod, err := k.avsKeeper.GetAVSUnbondingPeriod(ctx, avsAddr)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		if unbondingPeriod == 0 {
+			continue
+		}
+		epochInfo, err := k.avsKeeper.GetAVSEpochInfo(ctx, avsAddr)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		// the unbonding is released at the end of the epoch which is `unbondingPeriod`
+		// epochs after the current one.
+		// #nosec G115
+		endTime := epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration * time.Duration(unbondingPeriod+1))
+		if ret != nil && !endTime.After(latestEndTime) {
+			continue
+		}
+		latestEndTime = endTime
+		ret = &types.UnbondingMaturity{
+			OperatorAddress: operator.String(),
+			AVSAddress:      avsAddr,
+			EpochIdentifier: epochInfo.Identifier,
+			UnbondingPeriod: unbondingPeriod,
+			// #nosec G115
+			MaturityEpoch: epochInfo.CurrentEpoch + int64(unbondingPeriod),
+		}
+	}
+	return ret, latestEndTime, nil
+}
+
+// holdUnbonding holds the undelegation or redelegation record until the unbonding periods of
+// the AVSs the operator is opted into elapse.
+func (k *Keeper) holdUnbonding(ctx sdk.Context, operator sdk.AccAddress, recordKey []byte, isRedelegation bool) error {
+	maturity, err := k.ComputeUnbondingMaturity(ctx, operator)
+	if err != nil {
+		return err
+	}
+	if maturity == nil {
+		return nil
+	}
+	maturity.RecordKey = string(recordKey)
+	maturity.IsRedelegation = isRedelegation
+	k.setUnbondingMaturity(ctx, maturity)
+	if isRedelegation {
+		return k.delegationKeeper.IncrementRedelegationHoldCount(ctx, recordKey)
+	}
+	return k.delegationKeeper.IncrementUndelegationHoldCount(ctx, recordKey)
+}
+
+// ReleaseMatureUnbondings releases the holds of the undelegations and redelegations whose
+// maturity epoch ends with this epoch, after which they can be completed by the delegation
+// module.
+func (k *Keeper) ReleaseMatureUnbondings(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUnbondingMaturity)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUnbondingMaturityByEpoch)
+	startKey := types.UnbondingMaturityEpochPrefix(epochIdentifier, 0)
+	iterator := indexStore.Iterator(startKey, types.UnbondingMaturityEpochPrefix(epochIdentifier, epochNumber+1))
+	// collect the mature records first, since the store can't be modified during iteration.
+	matured := make([]types.UnbondingMaturity, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var maturity types.UnbondingMaturity
+		// the key of the maturity follows the epoch prefix, which has a fixed length.
+		k.cdc.MustUnmarshal(store.Get(iterator.Key()[len(startKey):]), &maturity)
+		matured = append(matured, maturity)
+	}
+	iterator.Close()
+
+	for i := range matured {
+		maturity := matured[i]
+		k.deleteUnbondingMaturity(ctx, &maturity)
+		var err error
+		if maturity.IsRedelegation {
+			err = k.delegationKeeper.DecrementRedelegationHoldCount(ctx, []byte(maturity.RecordKey))
+		} else {
+			err = k.delegationKeeper.DecrementUndelegationHoldCount(ctx, []byte(maturity.RecordKey))
+		}
+		if err != nil {
+			k.Logger(ctx).Error(
+				"failed to release the unbonding hold",
+				"recordKey", maturity.RecordKey,
+				"isRedelegation", maturity.IsRedelegation,
+				"error", err,
+			)
+		}
+	}
+}
+
+// setUnbondingMaturity stores the unbonding maturity, the key is recordType + '/' + recordKey.
+// It's also indexed by the maturity epoch to be released at the end of that epoch.
+func (k *Keeper) setUnbondingMaturity(ctx sdk.Context, maturity *types.UnbondingMaturity) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUnbondingMaturity)
+	store.Set(types.GetUnbondingMaturityKey(maturity.IsRedelegation, maturity.RecordKey), k.cdc.MustMarshal(maturity))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUnbondingMaturityByEpoch)
+	indexStore.Set(types.KeyForUnbondingMaturityByEpoch(
+		maturity.EpochIdentifier, maturity.MaturityEpoch, maturity.IsRedelegation, maturity.RecordKey,
+	), []byte{})
+}
+
+// deleteUnbondingMaturity deletes the unbonding maturity and its index without releasing the
+// hold of the record.
+func (k *Keeper) deleteUnbondingMaturity(ctx sdk.Context, maturity *types.UnbondingMaturity) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUnbondingMaturity)
+	store.Delete(types.GetUnbondingMaturityKey(maturity.IsRedelegation, maturity.RecordKey))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUnbondingMaturityByEpoch)
+	indexStore.Delete(types.KeyForUnbondingMaturityByEpoch(
+		maturity.EpochIdentifier, maturity.MaturityEpoch, maturity.IsRedelegation, maturity.RecordKey,
+	))
+}
+
+// GetUnbondingMaturity returns the unbonding maturity of the record and whether it's found.
+func (k *Keeper) GetUnbondingMaturity(ctx sdk.Context, isRedelegation bool, recordKey string) (*types.UnbondingMaturity, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUnbondingMaturity)
+	value := store.Get(types.GetUnbondingMaturityKey(isRedelegation, recordKey))
+	if value == nil {
+		return nil, false
+	}
+	ret := types.UnbondingMaturity{}
+	k.cdc.MustUnmarshal(value, &ret)
+	return &ret, true
+}
+
+// SetAllUnbondingMaturities sets all the unbonding maturities and restores the corresponding
+// holds.
+func (k *Keeper) SetAllUnbondingMaturities(ctx sdk.Context, maturities []types.UnbondingMaturity) error {
+	for i := range maturities {
+		maturity := &maturities[i]
+		k.setUnbondingMaturity(ctx, maturity)
+		var err error
+		if maturity.IsRedelegation {
+			err = k.delegationKeeper.IncrementRedelegationHoldCount(ctx, []byte(maturity.RecordKey))
+		} else {
+			err = k.delegationKeeper.IncrementUndelegationHoldCount(ctx, []byte(maturity.RecordKey))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetAllUnbondingMaturities returns all the unbonding maturities.
+func (k *Keeper) GetAllUnbondingMaturities(ctx sdk.Context) []types.UnbondingMaturity {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUnbondingMaturity)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	ret := make([]types.UnbondingMaturity, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var maturity types.UnbondingMaturity
+		k.cdc.MustUnmarshal(iterator.Value(), &maturity)
+		ret = append(ret, maturity)
+	}
+	return ret
+}
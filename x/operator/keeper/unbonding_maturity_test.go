@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	avskeeper "github.com/ExocoreNetwork/exocore/x/avs/keeper"
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	epochstypes "github.com/ExocoreNetwork/exocore/x/epochs/types"
+	"github.com/ExocoreNetwork/exocore/x/operator/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (suite *OperatorTestSuite) TestUnbondingMaturity() {
+	suite.prepare()
+	err := suite.App.AVSManagerKeeper.UpdateAVSInfo(suite.Ctx, &avstypes.AVSRegisterOrDeregisterParams{
+		Action:          avskeeper.RegisterAction,
+		EpochIdentifier: epochstypes.HourEpochID,
+		AvsAddress:      suite.avsAddr,
+		AssetID:         []string{suite.assetID},
+		UnbondingPeriod: 2,
+	})
+	suite.NoError(err)
+
+	// no AVS holds the undelegation before opting in
+	maturity, err := suite.App.OperatorKeeper.ComputeUnbondingMaturity(suite.Ctx, suite.operatorAddr)
+	suite.NoError(err)
+	suite.Nil(maturity)
+
+	err = suite.App.OperatorKeeper.OptIn(suite.Ctx, suite.operatorAddr, suite.avsAddr)
+	suite.NoError(err)
+	epochInfo, found := suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, epochstypes.HourEpochID)
+	suite.True(found)
+	maturity, err = suite.App.OperatorKeeper.ComputeUnbondingMaturity(suite.Ctx, suite.operatorAddr)
+	suite.NoError(err)
+	suite.Equal(&types.UnbondingMaturity{
+		OperatorAddress: suite.operatorAddr.String(),
+		AVSAddress:      suite.avsAddr,
+		EpochIdentifier: epochstypes.HourEpochID,
+		UnbondingPeriod: 2,
+		MaturityEpoch:   epochInfo.CurrentEpoch + 2,
+	}, maturity)
+
+	// the undelegation is held until the end of the maturity epoch
+	suite.prepareDelegation(false, suite.assetAddr, sdkmath.NewInt(10))
+	// #nosec G115
+	recordKey := delegationtype.GetUndelegationRecordKey(
+		uint64(suite.Ctx.BlockHeight()), 0,
+		common.HexToHash("0x24c4a315d757249c12a7a1d7b6fb96261d49deee26f06a3e1787d008b445c3ac").String(),
+		suite.operatorAddr.String(),
+	)
+	suite.Equal(uint64(1), suite.App.DelegationKeeper.GetUndelegationHoldCount(suite.Ctx, recordKey))
+	res, err := suite.App.OperatorKeeper.QueryUnbondingMaturity(suite.Ctx, &types.QueryUnbondingMaturityRequest{
+		RecordKey: string(recordKey),
+	})
+	suite.NoError(err)
+	maturity.RecordKey = string(recordKey)
+	suite.Equal(maturity, res.Maturity)
+	// the completion height is estimated from the end time of the maturity epoch
+	endTime := epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration * 3)
+	// #nosec G115
+	completeHeight := uint64(suite.Ctx.BlockHeight()) + uint64(endTime.Sub(suite.Ctx.BlockTime())/types.MaxExpectedBlockInterval)
+	suite.Equal(completeHeight, res.CompleteBlockNumber)
+	suite.NotEmpty(res.Reason)
+
+	// the record reaching the completion height is moved to the held records
+	// #nosec G115
+	ctx := suite.Ctx.WithBlockHeight(int64(completeHeight))
+	suite.App.DelegationKeeper.EndBlock(ctx, abci.RequestEndBlock{})
+	records, err := suite.App.DelegationKeeper.GetPendingUndelegationRecords(ctx, completeHeight)
+	suite.NoError(err)
+	suite.Empty(records)
+	_, err = suite.App.DelegationKeeper.GetUndelegationRecords(ctx, []string{string(recordKey)})
+	suite.NoError(err)
+
+	// it's scheduled at the current height once released at the end of the maturity epoch
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	suite.App.OperatorKeeper.ReleaseMatureUnbondings(ctx, epochstypes.HourEpochID, maturity.MaturityEpoch-1)
+	suite.Equal(uint64(1), suite.App.DelegationKeeper.GetUndelegationHoldCount(ctx, recordKey))
+	suite.App.OperatorKeeper.ReleaseMatureUnbondings(ctx, epochstypes.HourEpochID, maturity.MaturityEpoch)
+	suite.Equal(uint64(0), suite.App.DelegationKeeper.GetUndelegationHoldCount(ctx, recordKey))
+	// #nosec G115
+	records, err = suite.App.DelegationKeeper.GetPendingUndelegationRecords(ctx, uint64(ctx.BlockHeight()))
+	suite.NoError(err)
+	suite.Len(records, 1)
+	suite.Equal(string(recordKey), string(delegationtype.GetUndelegationRecordKey(
+		records[0].BlockNumber, records[0].LzTxNonce, records[0].TxHash, records[0].OperatorAddr,
+	)))
+	suite.App.DelegationKeeper.EndBlock(ctx, abci.RequestEndBlock{})
+	_, err = suite.App.DelegationKeeper.GetUndelegationRecords(ctx, []string{string(recordKey)})
+	suite.ErrorIs(err, delegationtype.ErrNoKeyInTheStore)
+	_, found = suite.App.OperatorKeeper.GetUnbondingMaturity(ctx, false, string(recordKey))
+	suite.False(found)
+	suite.Empty(suite.App.OperatorKeeper.GetAllUnbondingMaturities(ctx))
+}
@@ -438,7 +438,7 @@ func (k Keeper) GetOrCalculateOperatorUSDValues(
 }
 
 func (k *Keeper) CalculateUSDValueForStaker(ctx sdk.Context, stakerID, avsAddr string, operator sdk.AccAddress) (sdkmath.LegacyDec, error) {
-	if !k.IsActive(ctx, operator, avsAddr) {
+	if !k.IsEligible(ctx, operator, avsAddr) {
 		return sdkmath.LegacyNewDec(0), nil
 	}
 	optedUSDValues, err := k.GetOperatorOptedUSDValue(ctx, avsAddr, operator.String())
@@ -499,3 +499,89 @@ func (k *Keeper) CalculateUSDValueForStaker(ctx sdk.Context, stakerID, avsAddr s
 	}
 	return totalUSDValue, nil
 }
+
+// decreaseUSDValueForSlash decreases the USD values of the operator and the AVSs it has opted
+// into, according to the slashed amount of the staker's delegation. It's used when the
+// delegation is slashed outside the epoch-based slashing flow, for example, by a native token
+// balance drop on the client chain. The decreased values are clamped to the stored values,
+// because the USD values are only refreshed at the end of the epochs and might be stale.
+func (k *Keeper) decreaseUSDValueForSlash(
+	ctx sdk.Context, operator, stakerID, assetID string, amount sdkmath.Int,
+) error {
+	if !amount.IsPositive() {
+		return nil
+	}
+	avsList, err := k.GetOptedInAVSForOperator(ctx, operator)
+	if err != nil {
+		return err
+	}
+	if len(avsList) == 0 {
+		return nil
+	}
+	price, err := k.oracleKeeper.GetSpecifiedAssetsPrice(ctx, assetID)
+	if err != nil {
+		// the USD values will be refreshed at the end of the epoch if the price is unavailable.
+		k.Logger(ctx).Error("decreaseUSDValueForSlash: failed to get the price", "assetID", assetID, "error", err)
+		return nil
+	}
+	assetInfo, err := k.assetsKeeper.GetStakingAssetInfo(ctx, assetID)
+	if err != nil {
+		return err
+	}
+	slashedUSDValue := CalculateUSDValue(amount, price.Value, assetInfo.AssetBasicInfo.Decimals, price.Decimal)
+	if !slashedUSDValue.IsPositive() {
+		return nil
+	}
+	associatedOperator, err := k.delegationKeeper.GetAssociatedOperator(ctx, stakerID)
+	if err != nil {
+		return err
+	}
+	isSelfDelegation := associatedOperator == operator
+
+	for _, avsAddr := range avsList {
+		assets, err := k.avsKeeper.GetAVSSupportedAssets(ctx, avsAddr)
+		if err != nil {
+			return err
+		}
+		if _, ok := assets[assetID]; !ok {
+			continue
+		}
+		usdValues, err := k.GetOperatorOptedUSDValue(ctx, avsAddr, operator)
+		if err != nil {
+			if errors.Is(err, operatortypes.ErrNoKeyInTheStore) {
+				continue
+			}
+			return err
+		}
+		delta := operatortypes.DeltaOperatorUSDInfo{
+			SelfUSDValue:   sdkmath.LegacyZeroDec(),
+			TotalUSDValue:  sdkmath.LegacyMinDec(slashedUSDValue, usdValues.TotalUSDValue).Neg(),
+			ActiveUSDValue: sdkmath.LegacyZeroDec(),
+		}
+		if isSelfDelegation {
+			delta.SelfUSDValue = sdkmath.LegacyMinDec(slashedUSDValue, usdValues.SelfUSDValue).Neg()
+		}
+		if usdValues.ActiveUSDValue.IsPositive() {
+			delta.ActiveUSDValue = sdkmath.LegacyMinDec(slashedUSDValue, usdValues.ActiveUSDValue).Neg()
+		}
+		if err := k.UpdateOperatorUSDValue(ctx, avsAddr, operator, delta); err != nil {
+			return err
+		}
+		if delta.ActiveUSDValue.IsNegative() {
+			avsUSDValue, err := k.GetAVSUSDValue(ctx, avsAddr)
+			if err != nil {
+				if errors.Is(err, operatortypes.ErrNoKeyInTheStore) {
+					continue
+				}
+				return err
+			}
+			avsDelta := sdkmath.LegacyMinDec(delta.ActiveUSDValue.Neg(), avsUSDValue)
+			if avsDelta.IsPositive() {
+				if err := k.UpdateAVSUSDValue(ctx, avsAddr, avsDelta.Neg()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
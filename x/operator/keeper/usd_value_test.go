@@ -7,6 +7,8 @@ import (
 	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
 	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
 	operatorKeeper "github.com/ExocoreNetwork/exocore/x/operator/keeper"
+	operatorTypes "github.com/ExocoreNetwork/exocore/x/operator/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -176,3 +178,65 @@ func (suite *OperatorTestSuite) TestVotingPowerForDogFood() {
 		suite.Equal(initialPowers[i]+int64(addPower), update.Power)
 	}
 }
+
+func (suite *OperatorTestSuite) TestSelfDelegationStatus() {
+	suite.prepare()
+	suite.prepareAvs([]string{"0xdac17f958d2ee523a2206206994597c13d831ec7_0x65"})
+	err := suite.App.OperatorKeeper.OptIn(suite.Ctx, suite.operatorAddr, suite.avsAddr)
+	suite.NoError(err)
+	// the minimum self delegation of the AVS is zero, so the operator stays active
+	err = suite.App.OperatorKeeper.UpdateVotingPower(suite.Ctx, suite.avsAddr)
+	suite.NoError(err)
+	suite.True(suite.App.OperatorKeeper.IsEligible(suite.Ctx, suite.operatorAddr, suite.avsAddr))
+
+	setMinSelfDelegation := func(minSelfDelegation uint64) {
+		avsInfo, err := suite.App.AVSManagerKeeper.GetAVSInfo(suite.Ctx, suite.avsAddr)
+		suite.NoError(err)
+		avsInfo.Info.MinSelfDelegation = minSelfDelegation
+		err = suite.App.AVSManagerKeeper.SetAVSInfo(suite.Ctx, avsInfo.Info)
+		suite.NoError(err)
+	}
+	req := &operatorTypes.QuerySelfDelegationStatusRequest{
+		OperatorAVSAddress: &operatorTypes.OperatorAVSAddress{
+			OperatorAddr: suite.operatorAddr.String(),
+			AvsAddress:   suite.avsAddr,
+		},
+	}
+
+	// the operator hasn't self-delegated, so it's deactivated once a minimum is required
+	setMinSelfDelegation(10)
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	err = suite.App.OperatorKeeper.UpdateVotingPower(suite.Ctx, suite.avsAddr)
+	suite.NoError(err)
+	suite.False(suite.App.OperatorKeeper.IsEligible(suite.Ctx, suite.operatorAddr, suite.avsAddr))
+	suite.True(suite.hasEvent(operatorTypes.EventTypeOperatorDeactivated))
+	// the operator is still active, so it can opt out
+	suite.True(suite.App.OperatorKeeper.IsActive(suite.Ctx, suite.operatorAddr, suite.avsAddr))
+	cc, _ := suite.Ctx.CacheContext()
+	err = suite.App.OperatorKeeper.OptOut(cc, suite.operatorAddr, suite.avsAddr)
+	suite.NoError(err)
+	status, err := suite.App.OperatorKeeper.QuerySelfDelegationStatus(suite.Ctx, req)
+	suite.NoError(err)
+	suite.True(status.BelowMinSelfDelegation)
+	suite.Equal(sdkmath.LegacyNewDec(10), status.MinSelfDelegation)
+
+	// the operator is reactivated after the minimum is lowered
+	setMinSelfDelegation(0)
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	err = suite.App.OperatorKeeper.UpdateVotingPower(suite.Ctx, suite.avsAddr)
+	suite.NoError(err)
+	suite.True(suite.App.OperatorKeeper.IsEligible(suite.Ctx, suite.operatorAddr, suite.avsAddr))
+	suite.True(suite.hasEvent(operatorTypes.EventTypeOperatorReactivated))
+	status, err = suite.App.OperatorKeeper.QuerySelfDelegationStatus(suite.Ctx, req)
+	suite.NoError(err)
+	suite.False(status.BelowMinSelfDelegation)
+}
+
+func (suite *OperatorTestSuite) hasEvent(eventType string) bool {
+	for _, event := range suite.Ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
+	"github.com/ExocoreNetwork/exocore/x/operator/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setVotingPowerSnapshot stores the voting power snapshot, the key is AVSAddr + '/' + height
+func (k *Keeper) setVotingPowerSnapshot(ctx sdk.Context, snapshot *types.VotingPowerSnapshot) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVotingPowerSnapshot)
+	key := types.KeyForVotingPowerSnapshot(snapshot.AVSAddress, snapshot.Height)
+	store.Set(key, k.cdc.MustMarshal(snapshot))
+}
+
+// SnapshotVotingPower takes a snapshot of the active opted-in USD value of the operators
+// for the AVS. It's called at the end of the AVS epoch after the voting power is updated,
+// and the snapshots older than the retention period of the AVS are pruned.
+func (k *Keeper) SnapshotVotingPower(ctx sdk.Context, avsAddr, epochIdentifier string, epochNumber int64) error {
+	snapshot := types.VotingPowerSnapshot{
+		AVSAddress:           avsAddr,
+		Height:               ctx.BlockHeight(),
+		EpochIdentifier:      epochIdentifier,
+		EpochNumber:          epochNumber,
+		OperatorVotingPowers: make([]types.OperatorVotingPower, 0),
+	}
+	opFunc := func(operator string, optedUSDValues *types.OperatorOptedUSDValue) error {
+		if optedUSDValues.ActiveUSDValue.IsNil() || !optedUSDValues.ActiveUSDValue.IsPositive() {
+			return nil
+		}
+		snapshot.OperatorVotingPowers = append(snapshot.OperatorVotingPowers, types.OperatorVotingPower{
+			OperatorAddress: operator,
+			USDValue:        optedUSDValues.ActiveUSDValue,
+		})
+		return nil
+	}
+	err := k.IterateOperatorsForAVS(ctx, avsAddr, false, opFunc)
+	if err != nil {
+		return err
+	}
+	k.setVotingPowerSnapshot(ctx, &snapshot)
+	return k.pruneVotingPowerSnapshots(ctx, avsAddr, epochIdentifier, epochNumber)
+}
+
+// pruneVotingPowerSnapshots removes the snapshots which aren't in effect during the retention
+// period of the AVS. The retention period is the longer one of the unbonding period and the
+// period a task slash can take to be executed, i.e. the task challenge period plus the veto
+// epochs. The snapshots taken with another epoch identifier are removed as well, since their
+// epoch numbers can't be compared.
+func (k *Keeper) pruneVotingPowerSnapshots(ctx sdk.Context, avsAddr, epochIdentifier string, epochNumber int64) error {
+	retentionPeriod, err := k.avsKeeper.GetAVSUnbondingPeriod(ctx, avsAddr)
+	if err != nil {
+		return err
+	}
+	challengePeriod, err := k.avsKeeper.GetAVSMaxTaskChallengePeriod(ctx, avsAddr)
+	if err != nil {
+		return err
+	}
+	// the snapshot in effect at the slash event height is taken at the end of the previous
+	// epoch, so it's one epoch older than the event.
+	slashPeriod := challengePeriod + k.GetParams(ctx).SlashVetoEpochs + 1
+	if slashPeriod > retentionPeriod {
+		retentionPeriod = slashPeriod
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVotingPowerSnapshot)
+	iterator := sdk.KVStorePrefixIterator(store, assetstype.GetJoinedStoreKeyForPrefix(avsAddr))
+	// collect the keys first, since the store can't be modified during iteration.
+	prunedKeys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.VotingPowerSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		// #nosec G115
+		if snapshot.EpochIdentifier == epochIdentifier &&
+			snapshot.EpochNumber+int64(retentionPeriod) >= epochNumber {
+			break
+		}
+		prunedKeys = append(prunedKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range prunedKeys {
+		store.Delete(key)
+	}
+	return nil
+}
+
+// GetVotingPowerSnapshot returns the snapshot in effect at the height for the AVS, which is
+// the latest one taken at or before the height.
+func (k *Keeper) GetVotingPowerSnapshot(ctx sdk.Context, avsAddr string, height int64) (*types.VotingPowerSnapshot, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVotingPowerSnapshot)
+	iterator := store.ReverseIterator(
+		assetstype.GetJoinedStoreKeyForPrefix(avsAddr),
+		types.KeyForVotingPowerSnapshot(avsAddr, height+1),
+	)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return nil, errorsmod.Wrapf(types.ErrNoVotingPowerSnapshot, "avs:%s height:%d", avsAddr, height)
+	}
+	var snapshot types.VotingPowerSnapshot
+	k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+	return &snapshot, nil
+}
+
+// GetHistoricalVotingPower returns the voting power of the operator for the AVS at the
+// height, it's zero if the operator isn't active in the snapshot in effect at that height.
+func (k *Keeper) GetHistoricalVotingPower(ctx sdk.Context, avsAddr, operatorAddr string, height int64) (int64, error) {
+	snapshot, err := k.GetVotingPowerSnapshot(ctx, avsAddr, height)
+	if err != nil {
+		return 0, err
+	}
+	for _, power := range snapshot.OperatorVotingPowers {
+		if power.OperatorAddress == operatorAddr {
+			return power.USDValue.TruncateInt64(), nil
+		}
+	}
+	return 0, nil
+}
+
+// SetAllVotingPowerSnapshots sets all the voting power snapshots.
+func (k *Keeper) SetAllVotingPowerSnapshots(ctx sdk.Context, snapshots []types.VotingPowerSnapshot) {
+	for i := range snapshots {
+		k.setVotingPowerSnapshot(ctx, &snapshots[i])
+	}
+}
+
+// GetAllVotingPowerSnapshots returns all the voting power snapshots.
+func (k *Keeper) GetAllVotingPowerSnapshots(ctx sdk.Context) []types.VotingPowerSnapshot {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVotingPowerSnapshot)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	ret := make([]types.VotingPowerSnapshot, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.VotingPowerSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		ret = append(ret, snapshot)
+	}
+	return ret
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	epochstypes "github.com/ExocoreNetwork/exocore/x/epochs/types"
+	"github.com/ExocoreNetwork/exocore/x/operator/types"
+)
+
+func (suite *OperatorTestSuite) TestVotingPowerSnapshot() {
+	suite.prepare()
+	suite.prepareAvs([]string{"0xdac17f958d2ee523a2206206994597c13d831ec7_0x65"})
+	err := suite.App.OperatorKeeper.OptIn(suite.Ctx, suite.operatorAddr, suite.avsAddr)
+	suite.NoError(err)
+	optInHeight := suite.Ctx.BlockHeight()
+
+	// there isn't any snapshot before the end of the epoch
+	_, err = suite.App.OperatorKeeper.GetHistoricalVotingPower(suite.Ctx, suite.avsAddr, suite.operatorAddr.String(), optInHeight)
+	suite.ErrorIs(err, types.ErrNoVotingPowerSnapshot)
+	err = suite.App.OperatorKeeper.Slash(suite.Ctx, &types.SlashInputInfo{
+		SlashType:        uint32(types.SlashType_SLASH_TYPE_INSTANT_SLASH),
+		Operator:         suite.operatorAddr,
+		AVSAddr:          suite.avsAddr,
+		SlashID:          "slash",
+		SlashEventHeight: optInHeight,
+		SlashProportion:  sdkmath.LegacyNewDecWithPrec(1, 1),
+	})
+	suite.ErrorIs(err, types.ErrNoVotingPowerSnapshot)
+
+	// the snapshot is taken at the end of the epoch
+	suite.CommitAfter(time.Hour + time.Nanosecond)
+	snapshotHeight := suite.Ctx.BlockHeight()
+	snapshot, err := suite.App.OperatorKeeper.GetVotingPowerSnapshot(suite.Ctx, suite.avsAddr, snapshotHeight)
+	suite.NoError(err)
+	suite.Equal(snapshotHeight, snapshot.Height)
+	suite.Equal(epochstypes.HourEpochID, snapshot.EpochIdentifier)
+	optedUSDValues, err := suite.App.OperatorKeeper.GetOperatorOptedUSDValue(suite.Ctx, suite.avsAddr, suite.operatorAddr.String())
+	suite.NoError(err)
+	suite.Equal([]types.OperatorVotingPower{
+		{
+			OperatorAddress: suite.operatorAddr.String(),
+			USDValue:        optedUSDValues.ActiveUSDValue,
+		},
+	}, snapshot.OperatorVotingPowers)
+
+	// the snapshot is in effect until the next one
+	suite.NextBlock()
+	power, err := suite.App.OperatorKeeper.GetHistoricalVotingPower(suite.Ctx, suite.avsAddr, suite.operatorAddr.String(), suite.Ctx.BlockHeight())
+	suite.NoError(err)
+	suite.Equal(optedUSDValues.ActiveUSDValue.TruncateInt64(), power)
+	_, err = suite.App.OperatorKeeper.GetHistoricalVotingPower(suite.Ctx, suite.avsAddr, suite.operatorAddr.String(), snapshotHeight-1)
+	suite.ErrorIs(err, types.ErrNoVotingPowerSnapshot)
+
+	// the instant slash uses the historical voting power
+	err = suite.App.OperatorKeeper.Slash(suite.Ctx, &types.SlashInputInfo{
+		SlashType:        uint32(types.SlashType_SLASH_TYPE_INSTANT_SLASH),
+		Operator:         suite.operatorAddr,
+		AVSAddr:          suite.avsAddr,
+		SlashID:          "slash",
+		SlashEventHeight: snapshotHeight,
+		SlashProportion:  sdkmath.LegacyNewDecWithPrec(1, 1),
+	})
+	suite.NoError(err)
+	slashInfo, err := suite.App.OperatorKeeper.GetOperatorSlashInfo(suite.Ctx, suite.avsAddr, suite.operatorAddr.String(), "slash")
+	suite.NoError(err)
+	suite.Equal(sdkmath.LegacyNewDec(power).Mul(sdkmath.LegacyNewDecWithPrec(1, 1)), slashInfo.ExecutionInfo.SlashValue)
+}
+
+func (suite *OperatorTestSuite) TestVotingPowerSnapshotRetention() {
+	suite.prepare()
+	suite.prepareAvs([]string{"0xdac17f958d2ee523a2206206994597c13d831ec7_0x65"})
+	err := suite.App.OperatorKeeper.OptIn(suite.Ctx, suite.operatorAddr, suite.avsAddr)
+	suite.NoError(err)
+	// the unbonding period of the AVS is 0, but the task slashes can arrive after the
+	// challenge period and the veto epochs.
+	avsInfo, err := suite.App.AVSManagerKeeper.GetAVSInfo(suite.Ctx, suite.avsAddr)
+	suite.NoError(err)
+	avsInfo.Info.MaxTaskChallengePeriod = 2
+	err = suite.App.AVSManagerKeeper.SetAVSInfo(suite.Ctx, avsInfo.Info)
+	suite.NoError(err)
+	retentionPeriod := int(avsInfo.Info.MaxTaskChallengePeriod + suite.App.OperatorKeeper.GetParams(suite.Ctx).SlashVetoEpochs + 1)
+
+	suite.CommitAfter(time.Hour + time.Nanosecond)
+	snapshotHeight := suite.Ctx.BlockHeight()
+	for i := 0; i < retentionPeriod; i++ {
+		suite.CommitAfter(time.Hour + time.Nanosecond)
+	}
+	snapshot, err := suite.App.OperatorKeeper.GetVotingPowerSnapshot(suite.Ctx, suite.avsAddr, snapshotHeight)
+	suite.NoError(err)
+	suite.Equal(snapshotHeight, snapshot.Height)
+
+	// the late slash still finds the voting power at the event height
+	err = suite.App.OperatorKeeper.Slash(suite.Ctx, &types.SlashInputInfo{
+		SlashType:        uint32(types.SlashType_SLASH_TYPE_INSTANT_SLASH),
+		Operator:         suite.operatorAddr,
+		AVSAddr:          suite.avsAddr,
+		SlashID:          "late_slash",
+		SlashEventHeight: snapshotHeight,
+		SlashProportion:  sdkmath.LegacyNewDecWithPrec(1, 1),
+	})
+	suite.NoError(err)
+
+	// the snapshot is pruned after the retention period
+	suite.CommitAfter(time.Hour + time.Nanosecond)
+	_, err = suite.App.OperatorKeeper.GetVotingPowerSnapshot(suite.Ctx, suite.avsAddr, snapshotHeight)
+	suite.ErrorIs(err, types.ErrNoVotingPowerSnapshot)
+}
@@ -22,6 +22,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&OptIntoAVSReq{},
 		&OptOutOfAVSReq{},
 		&SetConsKeyReq{},
+		&MsgVetoSlash{},
+		&MsgUpdateParams{},
+		&MsgUpdateOperatorCommission{},
+		&MsgEditOperator{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -111,4 +111,24 @@ var (
 		ModuleName, 24,
 		"the operator USD value is less than the minimum self delegation",
 	)
+
+	ErrSlashNotPending = errorsmod.Register(
+		ModuleName, 25,
+		"the slash isn't pending",
+	)
+
+	ErrUnauthorizedVeto = errorsmod.Register(
+		ModuleName, 26,
+		"the caller isn't allowed to veto the slash",
+	)
+
+	ErrNoVotingPowerSnapshot = errorsmod.Register(
+		ModuleName, 27,
+		"there isn't any voting power snapshot for the slash event height",
+	)
+
+	ErrInvalidParams = errorsmod.Register(
+		ModuleName, 28,
+		"invalid params",
+	)
 )
@@ -0,0 +1,35 @@
+package types
+
+// x/operator events
+const (
+	EventTypeSlashQueued   = "slash_queued"
+	EventTypeSlashVetoed   = "slash_vetoed"
+	EventTypeSlashExecuted = "slash_executed"
+	EventTypeSlashFailed   = "slash_failed"
+
+	EventTypePriceBreakerTripped = "price_breaker_tripped"
+	EventTypePriceBreakerReset   = "price_breaker_reset"
+	EventTypeVotingPowerFrozen   = "voting_power_frozen"
+
+	EventTypeCommissionUpdateScheduled = "commission_update_scheduled"
+	EventTypeCommissionUpdated         = "commission_updated"
+	EventTypeEditOperator              = "edit_operator"
+
+	EventTypeOperatorDeactivated = "operator_deactivated"
+	EventTypeOperatorReactivated = "operator_reactivated"
+
+	AttributeKeyOperator       = "operator"
+	AttributeKeyAVSAddress     = "avs_address"
+	AttributeKeySlashID        = "slash_id"
+	AttributeKeyExecutionEpoch = "execution_epoch"
+	AttributeKeyVetoedBy       = "vetoed_by"
+	AttributeKeyAssetID        = "asset_id"
+	AttributeKeyRoundID        = "round_id"
+	AttributeKeyReason         = "reason"
+	AttributeKeyAssetIDs       = "asset_ids"
+	AttributeKeyCommissionRate = "commission_rate"
+	AttributeKeyEpochID        = "epoch_identifier"
+
+	AttributeKeySelfUSDValue      = "self_usd_value"
+	AttributeKeyMinSelfDelegation = "min_self_delegation"
+)
@@ -6,6 +6,7 @@ import (
 	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
 	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
 	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	epochstypes "github.com/ExocoreNetwork/exocore/x/epochs/types"
 	oracletype "github.com/ExocoreNetwork/exocore/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -34,6 +35,12 @@ type DelegationKeeper interface {
 	IterateUndelegationsByOperator(
 		ctx sdk.Context, operator string, heightFilter *uint64, isUpdate bool,
 		opFunc func(undelegation *delegationtype.UndelegationRecord) error) error
+	GetRedelegationsBySrcOperator(
+		ctx sdk.Context, srcOperator string, heightFilter *uint64,
+	) ([]delegationtype.RedelegationRecord, error)
+	SlashRedelegation(
+		ctx sdk.Context, record *delegationtype.RedelegationRecord, slashProportion sdkmath.LegacyDec,
+	) (sdkmath.Int, error)
 	HasStakerList(ctx sdk.Context, operator, assetID string) bool
 	GetStakersByOperator(
 		ctx sdk.Context, operator, assetID string,
@@ -44,6 +51,13 @@ type DelegationKeeper interface {
 	DeleteStakersListForOperator(ctx sdk.Context, operator, assetID string) error
 
 	IterateDelegationsForStaker(ctx sdk.Context, stakerID string, opFunc delegationkeeper.DelegationOpFunc) error
+
+	GetUndelegationRecords(ctx sdk.Context, singleRecordKeys []string) ([]*delegationtype.UndelegationRecord, error)
+	IncrementUndelegationHoldCount(ctx sdk.Context, recordKey []byte) error
+	DecrementUndelegationHoldCount(ctx sdk.Context, recordKey []byte) error
+	IncrementRedelegationHoldCount(ctx sdk.Context, recordKey []byte) error
+	DecrementRedelegationHoldCount(ctx sdk.Context, recordKey []byte) error
+	GetAssociatedOperator(ctx sdk.Context, stakerID string) (string, error)
 }
 
 type PriceChange struct {
@@ -110,6 +124,16 @@ type AVSKeeper interface {
 	// IsAVS returns true if the address is a registered AVS address.
 	IsAVS(ctx sdk.Context, addr string) (bool, error)
 	IsAVSByChainID(ctx sdk.Context, chainID string) (bool, string)
+	// GetAVSEpochInfo returns the current epoch info of the epoch identifier used by the AVS.
+	GetAVSEpochInfo(ctx sdk.Context, avsAddr string) (epochstypes.EpochInfo, error)
+	// IsAVSVetoCommitteeMember returns true if the address is a member of the veto committee
+	// designated by the AVS.
+	IsAVSVetoCommitteeMember(ctx sdk.Context, avsAddr, addr string) (bool, error)
+	// GetAVSUnbondingPeriod returns the unbonding period of the AVS, counted in its epochs.
+	GetAVSUnbondingPeriod(ctx sdk.Context, avsAddr string) (uint64, error)
+	// GetAVSMaxTaskChallengePeriod returns the longest period from the statistics of a task of
+	// the AVS to its settlement, counted in its epochs.
+	GetAVSMaxTaskChallengePeriod(ctx sdk.Context, avsAddr string) (uint64, error)
 }
 
 type SlashKeeper interface {
@@ -6,6 +6,7 @@ import (
 	keytypes "github.com/ExocoreNetwork/exocore/types/keys"
 	"github.com/ExocoreNetwork/exocore/utils"
 	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -19,22 +20,34 @@ func NewGenesisState(
 	slashStates []OperatorSlashState,
 	prevConsKeys []PrevConsKey,
 	operatorKeyRemovals []OperatorKeyRemoval,
+	params Params,
+	pendingSlashes []PendingSlash,
+	votingPowerSnapshots []VotingPowerSnapshot,
+	priceBreakerStates []PriceBreakerState,
+	unbondingMaturities []UnbondingMaturity,
+	pendingCommissionUpdates []PendingCommissionUpdate,
 ) *GenesisState {
 	return &GenesisState{
-		Operators:           operators,
-		OperatorRecords:     operatorConsKeys,
-		OptStates:           optStates,
-		OperatorUSDValues:   operatorUSDValues,
-		AVSUSDValues:        avsUSDValues,
-		SlashStates:         slashStates,
-		PreConsKeys:         prevConsKeys,
-		OperatorKeyRemovals: operatorKeyRemovals,
+		Operators:                operators,
+		OperatorRecords:          operatorConsKeys,
+		OptStates:                optStates,
+		OperatorUSDValues:        operatorUSDValues,
+		AVSUSDValues:             avsUSDValues,
+		SlashStates:              slashStates,
+		PreConsKeys:              prevConsKeys,
+		OperatorKeyRemovals:      operatorKeyRemovals,
+		Params:                   params,
+		PendingSlashes:           pendingSlashes,
+		VotingPowerSnapshots:     votingPowerSnapshots,
+		PriceBreakerStates:       priceBreakerStates,
+		UnbondingMaturities:      unbondingMaturities,
+		PendingCommissionUpdates: pendingCommissionUpdates,
 	}
 }
 
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
-	return NewGenesisState(nil, nil, nil, nil, nil, nil, nil, nil)
+	return NewGenesisState(nil, nil, nil, nil, nil, nil, nil, nil, DefaultParams(), nil, nil, nil, nil, nil)
 }
 
 // ValidateOperators rationale for the validation:
@@ -372,6 +385,10 @@ func (gs GenesisState) ValidateSlashStates(operators, avs map[string]struct{}) e
 			)
 		}
 
+		// the execution information is nil if the slash is pending or vetoed
+		if slash.Info.ExecutionInfo == nil {
+			return nil
+		}
 		// validate the slashing execution information
 		// the actual executed proportion and value might be zero because of the rounding in an extreme case
 		if slash.Info.ExecutionInfo.SlashProportion.IsNil() || slash.Info.ExecutionInfo.SlashProportion.IsNegative() {
@@ -425,6 +442,17 @@ func (gs GenesisState) ValidateSlashStates(operators, avs map[string]struct{}) e
 		if err != nil {
 			return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
 		}
+		// validate the slashing record regarding redelegation, a staker might have multiple
+		// redelegations to the same operator, so the duplicated records are allowed.
+		for _, slashFromRedelegation := range slash.Info.ExecutionInfo.SlashRedelegations {
+			if slashFromRedelegation.Amount.IsNil() || slashFromRedelegation.Amount.LTE(sdkmath.NewInt(0)) {
+				return errorsmod.Wrapf(
+					ErrInvalidGenesisData,
+					"invalid slashing amount from the redelegation, it's nil, zero, or negative: %+v",
+					slash,
+				)
+			}
+		}
 		return nil
 	}
 	seenFieldValueFunc := func(slash OperatorSlashState) (string, struct{}) {
@@ -511,6 +539,236 @@ func (gs GenesisState) ValidateOperatorKeyRemovals(operators map[string]struct{}
 	return nil
 }
 
+// ValidatePendingSlashes validates the pending slashes, each of them should have a slashing
+// record which hasn't been executed or vetoed.
+func (gs GenesisState) ValidatePendingSlashes(operators map[string]struct{}) error {
+	slashStates := make(map[string]OperatorSlashInfo, len(gs.SlashStates))
+	for _, slash := range gs.SlashStates {
+		slashStates[slash.Key] = slash.Info
+	}
+	validationFunc := func(_ int, pending PendingSlash) error {
+		if _, ok := operators[pending.OperatorAddress]; !ok {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"unknown operator address for the pending slash, %+v",
+				pending,
+			)
+		}
+		if !common.IsHexAddress(pending.AVSAddress) {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid AVS address for the pending slash, %+v",
+				pending,
+			)
+		}
+		if pending.EpochIdentifier == "" {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"empty epoch identifier for the pending slash, %+v",
+				pending,
+			)
+		}
+		key := string(assetstypes.GetJoinedStoreKey(pending.OperatorAddress, pending.AVSAddress, pending.SlashID))
+		info, ok := slashStates[key]
+		if !ok {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"no slashing record for the pending slash, %+v",
+				pending,
+			)
+		}
+		if info.IsVetoed || info.ExecutionInfo != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"the slashing record of the pending slash has been vetoed or executed, %+v",
+				pending,
+			)
+		}
+		return nil
+	}
+	seenFieldValueFunc := func(pending PendingSlash) (string, struct{}) {
+		return string(assetstypes.GetJoinedStoreKey(pending.AVSAddress, pending.OperatorAddress, pending.SlashID)), struct{}{}
+	}
+	_, err := utils.CommonValidation(gs.PendingSlashes, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+	}
+	return nil
+}
+
+// ValidateUnbondingMaturities validates the maturities of the held undelegations and
+// redelegations.
+func (gs GenesisState) ValidateUnbondingMaturities(operators map[string]struct{}) error {
+	validationFunc := func(_ int, maturity UnbondingMaturity) error {
+		if _, ok := operators[maturity.OperatorAddress]; !ok {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"unknown operator address for the unbonding maturity, %+v",
+				maturity,
+			)
+		}
+		if _, err := delegationtype.ParseUndelegationRecordKey([]byte(maturity.RecordKey)); err != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid record key for the unbonding maturity, %+v",
+				maturity,
+			)
+		}
+		if !common.IsHexAddress(maturity.AVSAddress) {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid AVS address for the unbonding maturity, %+v",
+				maturity,
+			)
+		}
+		if maturity.EpochIdentifier == "" || maturity.UnbondingPeriod == 0 {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"empty epoch identifier or unbonding period for the unbonding maturity, %+v",
+				maturity,
+			)
+		}
+		return nil
+	}
+	seenFieldValueFunc := func(maturity UnbondingMaturity) (string, struct{}) {
+		return string(GetUnbondingMaturityKey(maturity.IsRedelegation, maturity.RecordKey)), struct{}{}
+	}
+	_, err := utils.CommonValidation(gs.UnbondingMaturities, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+	}
+	return nil
+}
+
+// ValidatePendingCommissionUpdates validates the commission updates waiting for the end of
+// the epoch.
+func (gs GenesisState) ValidatePendingCommissionUpdates(operators map[string]struct{}) error {
+	validationFunc := func(_ int, update PendingCommissionUpdate) error {
+		if _, ok := operators[update.OperatorAddress]; !ok {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"unknown operator address for the pending commission update, %+v",
+				update,
+			)
+		}
+		if update.Commission.Rate.IsNil() ||
+			update.Commission.MaxRate.IsNil() ||
+			update.Commission.MaxChangeRate.IsNil() {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"missing commission for the pending commission update, %+v",
+				update,
+			)
+		}
+		if err := update.Commission.Validate(); err != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid commission for the pending commission update, %+v: %s",
+				update, err,
+			)
+		}
+		if update.EpochIdentifier == "" {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"empty epoch identifier for the pending commission update, %+v",
+				update,
+			)
+		}
+		return nil
+	}
+	seenFieldValueFunc := func(update PendingCommissionUpdate) (string, struct{}) {
+		return update.OperatorAddress, struct{}{}
+	}
+	_, err := utils.CommonValidation(gs.PendingCommissionUpdates, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+	}
+	return nil
+}
+
+// ValidateVotingPowerSnapshots validates the voting power snapshots of the AVSs.
+func (gs GenesisState) ValidateVotingPowerSnapshots(operators map[string]struct{}) error {
+	validationFunc := func(_ int, snapshot VotingPowerSnapshot) error {
+		if !common.IsHexAddress(snapshot.AVSAddress) {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid AVS address for the voting power snapshot, %s",
+				snapshot.AVSAddress,
+			)
+		}
+		if snapshot.Height <= 0 || snapshot.EpochIdentifier == "" {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid height or epoch identifier for the voting power snapshot, avs:%s height:%d identifier:%s",
+				snapshot.AVSAddress, snapshot.Height, snapshot.EpochIdentifier,
+			)
+		}
+		seenOperators := make(map[string]struct{}, len(snapshot.OperatorVotingPowers))
+		for _, power := range snapshot.OperatorVotingPowers {
+			if _, ok := operators[power.OperatorAddress]; !ok {
+				return errorsmod.Wrapf(
+					ErrInvalidGenesisData,
+					"unknown operator address for the voting power snapshot, avs:%s operator:%s",
+					snapshot.AVSAddress, power.OperatorAddress,
+				)
+			}
+			if _, ok := seenOperators[power.OperatorAddress]; ok {
+				return errorsmod.Wrapf(
+					ErrInvalidGenesisData,
+					"duplicate operator address for the voting power snapshot, avs:%s operator:%s",
+					snapshot.AVSAddress, power.OperatorAddress,
+				)
+			}
+			seenOperators[power.OperatorAddress] = struct{}{}
+			if power.USDValue.IsNil() || power.USDValue.IsNegative() {
+				return errorsmod.Wrapf(
+					ErrInvalidGenesisData,
+					"invalid USD value for the voting power snapshot, avs:%s operator:%s",
+					snapshot.AVSAddress, power.OperatorAddress,
+				)
+			}
+		}
+		return nil
+	}
+	seenFieldValueFunc := func(snapshot VotingPowerSnapshot) (string, struct{}) {
+		return string(KeyForVotingPowerSnapshot(snapshot.AVSAddress, snapshot.Height)), struct{}{}
+	}
+	_, err := utils.CommonValidation(gs.VotingPowerSnapshots, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+	}
+	return nil
+}
+
+// ValidatePriceBreakerStates validates the states of the price breakers.
+func (gs GenesisState) ValidatePriceBreakerStates() error {
+	validationFunc := func(_ int, state PriceBreakerState) error {
+		if _, _, err := assetstypes.ValidateID(state.AssetID, true, false); err != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid asset ID for the price breaker state, %s",
+				state.AssetID,
+			)
+		}
+		if state.Price.IsNil() || state.Price.IsNegative() || state.UpdateHeight < 0 {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid price or update height for the price breaker state, asset:%s",
+				state.AssetID,
+			)
+		}
+		return nil
+	}
+	seenFieldValueFunc := func(state PriceBreakerState) (string, struct{}) {
+		return state.AssetID, struct{}{}
+	}
+	_, err := utils.CommonValidation(gs.PriceBreakerStates, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+	}
+	return nil
+}
+
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
@@ -546,5 +804,25 @@ func (gs GenesisState) Validate() error {
 	if err != nil {
 		return err
 	}
-	return nil
+	err = gs.Params.Validate()
+	if err != nil {
+		return err
+	}
+	err = gs.ValidatePendingSlashes(operators)
+	if err != nil {
+		return err
+	}
+	err = gs.ValidateVotingPowerSnapshots(operators)
+	if err != nil {
+		return err
+	}
+	err = gs.ValidatePriceBreakerStates()
+	if err != nil {
+		return err
+	}
+	err = gs.ValidateUnbondingMaturities(operators)
+	if err != nil {
+		return err
+	}
+	return gs.ValidatePendingCommissionUpdates(operators)
 }
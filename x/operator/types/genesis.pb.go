@@ -50,6 +50,22 @@ type GenesisState struct {
 	// operator_key_removal is a list of operator with the given address
 	// is in the process of unbonding their key for the given chainID.
 	OperatorKeyRemovals []OperatorKeyRemoval `protobuf:"bytes,8,rep,name=operator_key_removals,json=operatorKeyRemovals,proto3" json:"operator_key_removals"`
+	// params is the parameters of the module.
+	Params Params `protobuf:"bytes,9,opt,name=params,proto3" json:"params"`
+	// pending_slashes is a list of the non-instantaneous slashes waiting for the end of
+	// their veto window.
+	PendingSlashes []PendingSlash `protobuf:"bytes,10,rep,name=pending_slashes,json=pendingSlashes,proto3" json:"pending_slashes"`
+	// voting_power_snapshots is a list of the voting power snapshots of the AVSs other
+	// than dogfood, which are used to slash the operators.
+	VotingPowerSnapshots []VotingPowerSnapshot `protobuf:"bytes,11,rep,name=voting_power_snapshots,json=votingPowerSnapshots,proto3" json:"voting_power_snapshots"`
+	// price_breaker_states is a list of the states of the price breakers of the assets.
+	PriceBreakerStates []PriceBreakerState `protobuf:"bytes,12,rep,name=price_breaker_states,json=priceBreakerStates,proto3" json:"price_breaker_states"`
+	// unbonding_maturities is a list of the undelegations and redelegations held until the
+	// unbonding periods of the AVSs elapse.
+	UnbondingMaturities []UnbondingMaturity `protobuf:"bytes,13,rep,name=unbonding_maturities,json=unbondingMaturities,proto3" json:"unbonding_maturities"`
+	// pending_commission_updates is a list of the commission updates waiting for the end of
+	// the epoch.
+	PendingCommissionUpdates []PendingCommissionUpdate `protobuf:"bytes,14,rep,name=pending_commission_updates,json=pendingCommissionUpdates,proto3" json:"pending_commission_updates"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -141,6 +157,48 @@ func (m *GenesisState) GetOperatorKeyRemovals() []OperatorKeyRemoval {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetPendingSlashes() []PendingSlash {
+	if m != nil {
+		return m.PendingSlashes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVotingPowerSnapshots() []VotingPowerSnapshot {
+	if m != nil {
+		return m.VotingPowerSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPriceBreakerStates() []PriceBreakerState {
+	if m != nil {
+		return m.PriceBreakerStates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnbondingMaturities() []UnbondingMaturity {
+	if m != nil {
+		return m.UnbondingMaturities
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingCommissionUpdates() []PendingCommissionUpdate {
+	if m != nil {
+		return m.PendingCommissionUpdates
+	}
+	return nil
+}
+
 // OperatorDetail is helper structure to store the operator information for the genesis state.
 // it's corresponding to the kvStore `KeyPrefixOperatorInfo`
 type OperatorDetail struct {
@@ -831,63 +889,75 @@ func init() {
 func init() { proto.RegisterFile("exocore/operator/v1/genesis.proto", fileDescriptor_bb7040bc6ae6ddee) }
 
 var fileDescriptor_bb7040bc6ae6ddee = []byte{
-	// 886 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x36, 0xe3, 0x1f, 0xc9, 0x23, 0xca, 0x71, 0x36, 0x31, 0xa0, 0x04, 0x85, 0xe4, 0x30, 0xa8,
-	0xeb, 0x16, 0x88, 0x84, 0xb8, 0xd7, 0xa2, 0xa9, 0x14, 0xb5, 0x81, 0x9a, 0xa0, 0x09, 0xa8, 0xc6,
-	0x87, 0xa6, 0x00, 0xc1, 0x88, 0x1b, 0x9b, 0x90, 0xcc, 0x25, 0x38, 0x2b, 0xd6, 0xea, 0xb5, 0xa7,
-	0xf6, 0xd2, 0x3e, 0x4c, 0x1f, 0x22, 0x40, 0x2f, 0x41, 0x4f, 0x45, 0x0f, 0x42, 0x21, 0xbf, 0x48,
-	0xb1, 0x3f, 0x24, 0x57, 0x0e, 0xa3, 0x24, 0x27, 0xed, 0xce, 0xcf, 0xf7, 0xcd, 0x8c, 0xbe, 0x21,
-	0x16, 0x6e, 0xd3, 0x73, 0x36, 0x62, 0x09, 0xed, 0xb0, 0x98, 0x26, 0x3e, 0x67, 0x49, 0x27, 0xbd,
-	0xd7, 0x39, 0xa1, 0x11, 0xc5, 0x10, 0xdb, 0x71, 0xc2, 0x38, 0x23, 0xd7, 0x75, 0x48, 0x3b, 0x0b,
-	0x69, 0xa7, 0xf7, 0x6e, 0xdd, 0x1c, 0x31, 0x3c, 0x63, 0xe8, 0xc9, 0x90, 0x8e, 0xba, 0xa8, 0xf8,
-	0x5b, 0x37, 0x4e, 0xd8, 0x09, 0x53, 0x76, 0x71, 0xd2, 0xd6, 0x8f, 0xca, 0x88, 0xf8, 0xb9, 0xf2,
-	0x3a, 0x7f, 0x6d, 0x82, 0xfd, 0x50, 0xb1, 0x0e, 0xb9, 0xcf, 0x29, 0x79, 0x08, 0xdb, 0x59, 0x20,
-	0x36, 0xac, 0xfd, 0xf5, 0xc3, 0xda, 0xd1, 0x9d, 0x76, 0x49, 0x21, 0xed, 0x27, 0xfa, 0xdc, 0xa7,
-	0xdc, 0x0f, 0x27, 0xbd, 0x8d, 0x57, 0xf3, 0xd6, 0x9a, 0x5b, 0xe4, 0x92, 0xe7, 0xb0, 0x9b, 0x5d,
-	0xbc, 0x84, 0x8e, 0x58, 0x12, 0x60, 0xe3, 0x8a, 0xc4, 0xfb, 0x6c, 0x25, 0xde, 0x03, 0x16, 0xe1,
-	0x23, 0x3a, 0x73, 0x65, 0x8a, 0x86, 0xbd, 0x9a, 0x05, 0x2a, 0x2b, 0x92, 0x3e, 0x00, 0x8b, 0xb9,
-	0x87, 0xa2, 0x64, 0x6c, 0xac, 0x4b, 0xd8, 0xd6, 0x5b, 0x60, 0x39, 0x0d, 0x64, 0x6b, 0x45, 0x89,
-	0x5c, 0xde, 0x91, 0xfc, 0x08, 0x3b, 0x7e, 0x8a, 0xde, 0x14, 0x03, 0x2f, 0xf5, 0x27, 0x53, 0x8a,
-	0x8d, 0x0d, 0x89, 0xb4, 0x5f, 0x8a, 0xd4, 0x3d, 0x1e, 0x3e, 0x1b, 0xf6, 0x8f, 0x45, 0x60, 0xef,
-	0x86, 0x80, 0x5a, 0xcc, 0x5b, 0xb6, 0x61, 0x44, 0xd7, 0xf6, 0x53, 0x7c, 0x86, 0x81, 0xba, 0x91,
-	0x18, 0xae, 0xe7, 0x03, 0x30, 0x28, 0x36, 0x25, 0xc5, 0xc7, 0x2b, 0x67, 0x90, 0xf3, 0xdc, 0xd4,
-	0x3c, 0xd7, 0x2e, 0x7b, 0xd0, 0xbd, 0x96, 0x25, 0x16, 0x8c, 0x4f, 0xc1, 0xc6, 0x89, 0x8f, 0xa7,
-	0xd9, 0x5c, 0xb6, 0x24, 0xd5, 0x27, 0x2b, 0xa9, 0x86, 0x22, 0xc1, 0x9c, 0x4f, 0x0d, 0x73, 0x0b,
-	0x92, 0x6f, 0xa1, 0x1e, 0x27, 0xd4, 0x1b, 0xb1, 0x08, 0xbd, 0x31, 0x9d, 0x61, 0xa3, 0xb2, 0x62,
-	0x40, 0x4f, 0x13, 0x9a, 0xea, 0x7f, 0x2f, 0xc3, 0x8a, 0x13, 0xaa, 0x2d, 0x48, 0x7c, 0xd8, 0xcb,
-	0xe7, 0x31, 0xa6, 0x33, 0x2f, 0xa1, 0x67, 0x2c, 0xf5, 0x27, 0xd8, 0xa8, 0xbe, 0x47, 0x99, 0x52,
-	0x11, 0x32, 0x5e, 0x43, 0xe7, 0xb3, 0x2d, 0x3c, 0xe8, 0xfc, 0x6a, 0xc1, 0xce, 0xb2, 0x2e, 0xc9,
-	0xa7, 0x86, 0x0c, 0xfd, 0x20, 0x48, 0x28, 0x0a, 0x59, 0x5b, 0x87, 0xdb, 0x85, 0xa8, 0xba, 0xca,
-	0x4c, 0x1e, 0x43, 0x3d, 0x0f, 0x0d, 0xa3, 0x97, 0xac, 0x71, 0x65, 0xdf, 0x3a, 0xac, 0x1d, 0xdd,
-	0x5e, 0x59, 0xd8, 0x20, 0x7a, 0xc9, 0x74, 0x49, 0x36, 0x33, 0x6c, 0x8e, 0x07, 0x50, 0x68, 0x8f,
-	0xec, 0xc2, 0xfa, 0x98, 0xce, 0x34, 0xb3, 0x38, 0x92, 0xfb, 0x50, 0x15, 0x12, 0x36, 0x88, 0x9a,
-	0x6f, 0x17, 0xb0, 0xc1, 0x52, 0x61, 0x31, 0x97, 0x04, 0x53, 0xa8, 0x19, 0xea, 0x23, 0x07, 0x50,
-	0x15, 0x62, 0x16, 0x3d, 0x2a, 0x9a, 0x5e, 0x6d, 0x31, 0x6f, 0x55, 0xba, 0xc7, 0x43, 0xd1, 0x9f,
-	0x5b, 0xf1, 0x53, 0x14, 0x07, 0xf2, 0x25, 0x6c, 0x4a, 0x25, 0x6a, 0x52, 0xa7, 0x94, 0xb4, 0x4f,
-	0x47, 0x12, 0xf5, 0x9b, 0x90, 0x4e, 0xb2, 0x25, 0x54, 0x69, 0xce, 0xef, 0x16, 0xec, 0x5e, 0x56,
-	0x63, 0x49, 0x7b, 0x21, 0x5c, 0x65, 0xa2, 0xf2, 0x42, 0xfa, 0x9a, 0x70, 0xf5, 0xf6, 0xcb, 0x6e,
-	0x73, 0xf9, 0xef, 0x69, 0xf9, 0xd7, 0x97, 0xcc, 0x6e, 0x5d, 0x22, 0x67, 0xba, 0x77, 0x4e, 0x81,
-	0xbc, 0xa9, 0xe6, 0x92, 0x92, 0xbe, 0x82, 0x0d, 0x63, 0xda, 0x07, 0xef, 0x5e, 0x0b, 0x63, 0xea,
-	0x32, 0xd3, 0xe9, 0x43, 0xcd, 0x10, 0x79, 0x09, 0xc5, 0x1d, 0xa8, 0x8b, 0x5d, 0xa1, 0x11, 0x4e,
-	0xe5, 0xc2, 0x48, 0xae, 0x6d, 0xd7, 0xce, 0x8d, 0x8f, 0xe8, 0xcc, 0x39, 0x28, 0xea, 0x2d, 0xc4,
-	0xfb, 0x26, 0x98, 0xf3, 0x8b, 0x05, 0x7b, 0xa5, 0x5f, 0xc5, 0x0f, 0x11, 0xf5, 0x7d, 0xd8, 0x1a,
-	0x9d, 0xfa, 0x61, 0x94, 0x7d, 0x7c, 0xcb, 0xd5, 0xfc, 0x40, 0x84, 0xa8, 0x8d, 0x41, 0xdd, 0xb1,
-	0x4e, 0x73, 0x9e, 0x83, 0x6d, 0x7a, 0x85, 0xce, 0xa4, 0xc7, 0x0b, 0x03, 0x53, 0x67, 0x32, 0x66,
-	0xd0, 0x77, 0x2b, 0xd2, 0x39, 0x08, 0xde, 0x6f, 0x14, 0xbf, 0x59, 0x60, 0x0f, 0xb9, 0x3f, 0xa6,
-	0x49, 0xde, 0xd9, 0x36, 0xca, 0x7b, 0x01, 0x6f, 0x2f, 0xe6, 0xad, 0xaa, 0x0a, 0x1a, 0xf4, 0xdd,
-	0xaa, 0x72, 0x0f, 0x02, 0xf2, 0x04, 0x76, 0x74, 0x68, 0xa0, 0x4a, 0xd3, 0x1d, 0x96, 0x2b, 0x5a,
-	0x01, 0x2c, 0xb7, 0x58, 0x47, 0xd3, 0xe8, 0xfc, 0x0c, 0xf5, 0xa5, 0x28, 0xb9, 0x52, 0x88, 0x94,
-	0x5f, 0x6a, 0xb5, 0x2b, 0x6c, 0xa2, 0x55, 0xe9, 0x1c, 0x04, 0xa4, 0x0b, 0x95, 0xe5, 0x12, 0xca,
-	0x87, 0x2c, 0xf3, 0x96, 0x2b, 0xc8, 0xf2, 0xc4, 0x56, 0xd9, 0xa6, 0xff, 0x43, 0xfe, 0xe2, 0xef,
-	0x61, 0xcb, 0x3f, 0x63, 0xd3, 0x88, 0xab, 0x11, 0xf7, 0xbe, 0x10, 0xd0, 0xff, 0xce, 0x5b, 0x07,
-	0x27, 0x21, 0x3f, 0x9d, 0xbe, 0x68, 0x8f, 0xd8, 0x99, 0x7e, 0x28, 0xe8, 0x9f, 0xbb, 0x18, 0x8c,
-	0x3b, 0x7c, 0x16, 0x53, 0x6c, 0x0f, 0x22, 0xfe, 0xf7, 0x9f, 0x77, 0x41, 0xbf, 0x23, 0x06, 0x11,
-	0x77, 0x35, 0x56, 0xef, 0xf1, 0xab, 0x45, 0xd3, 0x7a, 0xbd, 0x68, 0x5a, 0xff, 0x2d, 0x9a, 0xd6,
-	0x1f, 0x17, 0xcd, 0xb5, 0xd7, 0x17, 0xcd, 0xb5, 0x7f, 0x2e, 0x9a, 0x6b, 0x3f, 0x1c, 0x19, 0xb8,
-	0x5f, 0xab, 0x3e, 0xbf, 0xa3, 0xfc, 0x27, 0x96, 0x8c, 0x3b, 0xd9, 0x5b, 0xe3, 0xbc, 0x78, 0x6d,
-	0x48, 0x9e, 0x17, 0x5b, 0xf2, 0xb9, 0xf1, 0xf9, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc1, 0x55,
-	0xa8, 0x87, 0xf7, 0x08, 0x00, 0x00,
+	// 1084 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcd, 0x6e, 0x1b, 0x55,
+	0x14, 0x8e, 0xdb, 0x34, 0x8e, 0x8f, 0xc7, 0x49, 0x7a, 0x93, 0xa0, 0x69, 0x40, 0x76, 0x32, 0x15,
+	0x21, 0x20, 0x6a, 0xab, 0x61, 0x85, 0x84, 0x28, 0x71, 0x0c, 0x95, 0x69, 0xa1, 0x91, 0x4d, 0xb2,
+	0xa0, 0x88, 0xd1, 0xc4, 0x73, 0x9b, 0x8c, 0x1c, 0xcf, 0x1d, 0xcd, 0xb9, 0x33, 0x8d, 0xd9, 0xb2,
+	0x82, 0x0d, 0x3c, 0x0c, 0x0f, 0xd1, 0x65, 0xc5, 0x0a, 0xb1, 0x88, 0x90, 0xf3, 0x04, 0xbc, 0x01,
+	0xba, 0x3f, 0xf3, 0xe3, 0x64, 0x62, 0xda, 0x95, 0x67, 0xce, 0xcf, 0xf7, 0x9d, 0x73, 0xee, 0x99,
+	0xcf, 0x17, 0xb6, 0xe8, 0x39, 0x1b, 0xb0, 0x90, 0xb6, 0x58, 0x40, 0x43, 0x87, 0xb3, 0xb0, 0x15,
+	0x3f, 0x6c, 0x9d, 0x50, 0x9f, 0xa2, 0x87, 0xcd, 0x20, 0x64, 0x9c, 0x91, 0x55, 0x1d, 0xd2, 0x4c,
+	0x42, 0x9a, 0xf1, 0xc3, 0x8d, 0x7b, 0x03, 0x86, 0x23, 0x86, 0xb6, 0x0c, 0x69, 0xa9, 0x17, 0x15,
+	0xbf, 0xb1, 0x76, 0xc2, 0x4e, 0x98, 0xb2, 0x8b, 0x27, 0x6d, 0xdd, 0x2c, 0x22, 0x0a, 0x9c, 0xd0,
+	0x19, 0x25, 0x79, 0xef, 0x15, 0x45, 0xf0, 0x73, 0xe5, 0xb5, 0xfe, 0xad, 0x80, 0xf1, 0x58, 0xd5,
+	0xd5, 0xe7, 0x0e, 0xa7, 0xe4, 0x31, 0x54, 0x92, 0x40, 0x34, 0x4b, 0x9b, 0xb7, 0x77, 0xaa, 0xbb,
+	0xf7, 0x9b, 0x05, 0xa5, 0x36, 0x9f, 0xe9, 0xe7, 0x0e, 0xe5, 0x8e, 0x77, 0xd6, 0x9e, 0x7f, 0x75,
+	0xd1, 0x98, 0xeb, 0x65, 0xb9, 0xe4, 0x39, 0xac, 0x24, 0x2f, 0x76, 0x48, 0x07, 0x2c, 0x74, 0xd1,
+	0xbc, 0x25, 0xf1, 0x3e, 0x9a, 0x89, 0xb7, 0xcf, 0x7c, 0x7c, 0x42, 0xc7, 0x3d, 0x99, 0xa2, 0x61,
+	0x97, 0x93, 0x40, 0x65, 0x45, 0xd2, 0x01, 0x60, 0x01, 0xb7, 0x51, 0x94, 0x8c, 0xe6, 0x6d, 0x09,
+	0xdb, 0xb8, 0x01, 0x96, 0x53, 0x57, 0xb6, 0x96, 0x95, 0xc8, 0xe5, 0x3b, 0x92, 0x1f, 0x60, 0xc9,
+	0x89, 0xd1, 0x8e, 0xd0, 0xb5, 0x63, 0xe7, 0x2c, 0xa2, 0x68, 0xce, 0x4b, 0xa4, 0xcd, 0x42, 0xa4,
+	0xbd, 0xa3, 0xfe, 0x61, 0xbf, 0x73, 0x24, 0x02, 0xdb, 0x6b, 0x02, 0x6a, 0x72, 0xd1, 0x30, 0x72,
+	0x46, 0xec, 0x19, 0x4e, 0x8c, 0x87, 0xe8, 0xaa, 0x37, 0x12, 0xc0, 0x6a, 0x3a, 0x80, 0x1c, 0xc5,
+	0x1d, 0x49, 0xf1, 0xfe, 0xcc, 0x19, 0xa4, 0x3c, 0xf7, 0x34, 0xcf, 0xdd, 0xab, 0x1e, 0xec, 0xdd,
+	0x4d, 0x12, 0x33, 0xc6, 0x03, 0x30, 0xf0, 0xcc, 0xc1, 0xd3, 0x64, 0x2e, 0x0b, 0x92, 0xea, 0x83,
+	0x99, 0x54, 0x7d, 0x91, 0x90, 0x9f, 0x4f, 0x15, 0x53, 0x0b, 0x92, 0xaf, 0xa1, 0x16, 0x84, 0xd4,
+	0x1e, 0x30, 0x1f, 0xed, 0x21, 0x1d, 0xa3, 0x59, 0x9e, 0x31, 0xa0, 0x83, 0x90, 0xc6, 0xfa, 0xf4,
+	0x12, 0xac, 0x20, 0xa4, 0xda, 0x82, 0xc4, 0x81, 0xf5, 0x74, 0x1e, 0x43, 0x3a, 0xb6, 0x43, 0x3a,
+	0x62, 0xb1, 0x73, 0x86, 0xe6, 0xe2, 0x1b, 0x94, 0x29, 0x37, 0x42, 0xc6, 0x6b, 0xe8, 0x74, 0xb6,
+	0x99, 0x07, 0xc9, 0xa7, 0xb0, 0xa0, 0x76, 0xdf, 0xac, 0x6c, 0x96, 0x76, 0xaa, 0xbb, 0xef, 0x16,
+	0xd7, 0x29, 0x43, 0x34, 0x8e, 0x4e, 0x20, 0x07, 0xb0, 0x1c, 0x50, 0xdf, 0xf5, 0xfc, 0x13, 0x5b,
+	0x0e, 0x80, 0xa2, 0x09, 0xb2, 0xae, 0xad, 0x62, 0x0c, 0x15, 0x2b, 0xa7, 0xa7, 0x91, 0x96, 0x82,
+	0x9c, 0x8d, 0x22, 0x71, 0xe1, 0x9d, 0x98, 0x71, 0x01, 0x18, 0xb0, 0x97, 0x34, 0xb4, 0xd1, 0x77,
+	0x02, 0x3c, 0x65, 0x1c, 0xcd, 0xaa, 0x04, 0xde, 0x29, 0x04, 0x3e, 0x92, 0x29, 0x07, 0x22, 0xa3,
+	0xaf, 0x13, 0x34, 0xfe, 0x5a, 0x7c, 0xdd, 0x85, 0xe4, 0x47, 0x58, 0x0b, 0x42, 0x6f, 0x40, 0xed,
+	0xe3, 0x90, 0x3a, 0x43, 0x41, 0xa3, 0xce, 0xde, 0x90, 0x1c, 0xdb, 0x37, 0x1c, 0x94, 0x37, 0xa0,
+	0x6d, 0x15, 0x9f, 0x3f, 0x7a, 0x12, 0x5c, 0x75, 0x20, 0xb1, 0x61, 0x2d, 0xf2, 0x8f, 0x99, 0x9a,
+	0xcc, 0xc8, 0xe1, 0x51, 0xe8, 0x71, 0x8f, 0xa2, 0x59, 0x9b, 0x81, 0x7f, 0x98, 0x24, 0x7c, 0xa3,
+	0xe2, 0x93, 0x75, 0x58, 0x8d, 0xae, 0x38, 0x3c, 0xf9, 0x99, 0x6c, 0x24, 0x83, 0x1f, 0xb0, 0xd1,
+	0xc8, 0x43, 0xf4, 0x98, 0x6f, 0x47, 0x81, 0x2b, 0xdb, 0x58, 0x92, 0x34, 0x1f, 0xcf, 0x3a, 0x83,
+	0xfd, 0x34, 0xeb, 0x50, 0x26, 0x69, 0x32, 0x33, 0x28, 0x76, 0xa3, 0xf5, 0x4b, 0x09, 0x96, 0xa6,
+	0xd5, 0x8b, 0x7c, 0x98, 0x13, 0x2b, 0xc7, 0x75, 0x43, 0x8a, 0x42, 0xfc, 0x4a, 0x3b, 0x95, 0x4c,
+	0x7a, 0xf6, 0x94, 0x99, 0x3c, 0x85, 0x5a, 0x1a, 0xea, 0xf9, 0x2f, 0x98, 0x79, 0x4b, 0xae, 0xda,
+	0xd6, 0xcc, 0xf5, 0xed, 0xfa, 0x2f, 0x98, 0xae, 0xcb, 0x60, 0x39, 0x9b, 0x65, 0x03, 0x64, 0x0a,
+	0x45, 0x56, 0xe0, 0xf6, 0x90, 0x8e, 0x35, 0xb3, 0x78, 0x24, 0x8f, 0x60, 0x51, 0x08, 0x5d, 0x8e,
+	0xa8, 0x7e, 0xb3, 0xcc, 0xe5, 0x58, 0xca, 0x2c, 0xe0, 0x92, 0x20, 0x82, 0x6a, 0x4e, 0xa3, 0xc8,
+	0x36, 0x2c, 0x0a, 0xc9, 0x13, 0x3d, 0x2a, 0x9a, 0x76, 0x75, 0x72, 0xd1, 0x28, 0xef, 0x1d, 0xf5,
+	0x45, 0x7f, 0xbd, 0xb2, 0x13, 0xa3, 0x78, 0x20, 0x9f, 0xc3, 0x1d, 0xa9, 0x57, 0x9a, 0xd4, 0x2a,
+	0x24, 0xed, 0xd0, 0x81, 0x44, 0xfd, 0xca, 0xa3, 0x67, 0x89, 0x54, 0xab, 0x34, 0xeb, 0xb7, 0x12,
+	0xac, 0x5c, 0xd5, 0xac, 0x82, 0xf6, 0x3c, 0x58, 0x66, 0xa2, 0xf2, 0x4c, 0x20, 0x35, 0xe1, 0xec,
+	0xff, 0x08, 0xd9, 0x6d, 0x2a, 0x92, 0xeb, 0x5a, 0x24, 0x6b, 0x53, 0xe6, 0x5e, 0x4d, 0x22, 0x27,
+	0xea, 0x68, 0x9d, 0x02, 0xb9, 0xae, 0x79, 0x05, 0x25, 0x7d, 0x01, 0xf3, 0xb9, 0x69, 0x6f, 0xff,
+	0xbf, 0x78, 0xe6, 0xa6, 0x2e, 0x33, 0xad, 0x0e, 0x54, 0x73, 0x52, 0x58, 0x40, 0x71, 0x1f, 0x6a,
+	0x42, 0x51, 0xa9, 0x8f, 0x91, 0x94, 0x55, 0xc9, 0x55, 0xe9, 0x19, 0xa9, 0xf1, 0x09, 0x1d, 0x5b,
+	0xdb, 0x59, 0xbd, 0x99, 0xc4, 0x5d, 0x07, 0xb3, 0x7e, 0x2e, 0xc1, 0x7a, 0xe1, 0x7f, 0xe7, 0xdb,
+	0x2c, 0xf5, 0x23, 0x58, 0x18, 0x9c, 0x3a, 0x9e, 0x9f, 0xfc, 0x45, 0x17, 0x6f, 0xf3, 0xbe, 0x08,
+	0x51, 0x5f, 0x4c, 0x2a, 0x9f, 0x2a, 0xcd, 0x7a, 0x0e, 0x46, 0xde, 0x2b, 0xf6, 0x4c, 0x7a, 0x6c,
+	0xcf, 0xcd, 0xef, 0x99, 0x8c, 0xe9, 0x76, 0x7a, 0x65, 0xe9, 0xec, 0xba, 0x6f, 0x36, 0x8a, 0x5f,
+	0x4b, 0x60, 0xf4, 0xb9, 0x10, 0xa5, 0xb4, 0xb3, 0x0a, 0xca, 0xf7, 0x0c, 0xde, 0x98, 0x5c, 0x34,
+	0x16, 0x55, 0x50, 0xb7, 0xd3, 0x5b, 0x54, 0xee, 0xae, 0x4b, 0x9e, 0xc1, 0x92, 0x0e, 0x75, 0x55,
+	0x69, 0xba, 0xc3, 0xe2, 0x8d, 0x56, 0x00, 0xd3, 0x2d, 0xd6, 0x30, 0x6f, 0xb4, 0x7e, 0x82, 0xda,
+	0x54, 0x94, 0xfc, 0xa4, 0x10, 0x29, 0xbf, 0xd2, 0xea, 0x9e, 0xb0, 0x89, 0x56, 0xa5, 0xb3, 0xeb,
+	0x92, 0x3d, 0x28, 0x4f, 0x97, 0x50, 0x3c, 0x64, 0x99, 0x37, 0x5d, 0x41, 0x92, 0x27, 0xbe, 0x2a,
+	0x23, 0xef, 0x7f, 0x9b, 0x23, 0xfe, 0x0e, 0x16, 0x9c, 0x11, 0x8b, 0x7c, 0xae, 0x46, 0xdc, 0xfe,
+	0x4c, 0x40, 0xff, 0x7d, 0xd1, 0xd8, 0x3e, 0xf1, 0xf8, 0x69, 0x74, 0xdc, 0x1c, 0xb0, 0x91, 0xbe,
+	0x70, 0xea, 0x9f, 0x07, 0xe8, 0x0e, 0x5b, 0x7c, 0x1c, 0x50, 0x6c, 0x76, 0x7d, 0xfe, 0xe7, 0x1f,
+	0x0f, 0x40, 0xdf, 0x47, 0xbb, 0x3e, 0xef, 0x69, 0xac, 0xf6, 0xd3, 0x57, 0x93, 0x7a, 0xe9, 0xf5,
+	0xa4, 0x5e, 0xfa, 0x67, 0x52, 0x2f, 0xfd, 0x7e, 0x59, 0x9f, 0x7b, 0x7d, 0x59, 0x9f, 0xfb, 0xeb,
+	0xb2, 0x3e, 0xf7, 0xfd, 0x6e, 0x0e, 0xf7, 0x4b, 0xd5, 0xe7, 0xb7, 0x94, 0xbf, 0x64, 0xe1, 0xb0,
+	0x95, 0xdc, 0x48, 0xcf, 0xb3, 0x3b, 0xa9, 0xe4, 0x39, 0x5e, 0x90, 0x97, 0xd2, 0x4f, 0xfe, 0x0b,
+	0x00, 0x00, 0xff, 0xff, 0x74, 0xd6, 0x4b, 0xcd, 0x3f, 0x0b, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -910,6 +980,86 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingCommissionUpdates) > 0 {
+		for iNdEx := len(m.PendingCommissionUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingCommissionUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.UnbondingMaturities) > 0 {
+		for iNdEx := len(m.UnbondingMaturities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingMaturities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.PriceBreakerStates) > 0 {
+		for iNdEx := len(m.PriceBreakerStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceBreakerStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.VotingPowerSnapshots) > 0 {
+		for iNdEx := len(m.VotingPowerSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VotingPowerSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.PendingSlashes) > 0 {
+		for iNdEx := len(m.PendingSlashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSlashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	if len(m.OperatorKeyRemovals) > 0 {
 		for iNdEx := len(m.OperatorKeyRemovals) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1566,6 +1716,38 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingSlashes) > 0 {
+		for _, e := range m.PendingSlashes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VotingPowerSnapshots) > 0 {
+		for _, e := range m.VotingPowerSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceBreakerStates) > 0 {
+		for _, e := range m.PriceBreakerStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbondingMaturities) > 0 {
+		for _, e := range m.UnbondingMaturities {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingCommissionUpdates) > 0 {
+		for _, e := range m.PendingCommissionUpdates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2070,6 +2252,209 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSlashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSlashes = append(m.PendingSlashes, PendingSlash{})
+			if err := m.PendingSlashes[len(m.PendingSlashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPowerSnapshots = append(m.VotingPowerSnapshots, VotingPowerSnapshot{})
+			if err := m.VotingPowerSnapshots[len(m.VotingPowerSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceBreakerStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceBreakerStates = append(m.PriceBreakerStates, PriceBreakerState{})
+			if err := m.PriceBreakerStates[len(m.PriceBreakerStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingMaturities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingMaturities = append(m.UnbondingMaturities, UnbondingMaturity{})
+			if err := m.UnbondingMaturities[len(m.UnbondingMaturities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCommissionUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingCommissionUpdates = append(m.PendingCommissionUpdates, PendingCommissionUpdate{})
+			if err := m.PendingCommissionUpdates[len(m.PendingCommissionUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/ExocoreNetwork/exocore/utils"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -219,6 +220,71 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "invalid genesis state due to invalid AVS address in pending slash",
+			genState: &types.GenesisState{
+				Operators: []types.OperatorDetail{
+					{
+						OperatorAddress: accAddress1.String(),
+					},
+				},
+				PendingSlashes: []types.PendingSlash{
+					{
+						OperatorAddress: accAddress1.String(),
+						AVSAddress:      "invalid",
+						SlashID:         "slash",
+						EpochIdentifier: "hour",
+						ExecutionEpoch:  2,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis state due to pending slash without slash record",
+			genState: &types.GenesisState{
+				Operators: []types.OperatorDetail{
+					{
+						OperatorAddress: accAddress1.String(),
+					},
+				},
+				PendingSlashes: []types.PendingSlash{
+					{
+						OperatorAddress: accAddress1.String(),
+						AVSAddress:      utiltx.GenerateAddress().String(),
+						SlashID:         "slash",
+						EpochIdentifier: "hour",
+						ExecutionEpoch:  2,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis state due to unknown operator in voting power snapshot",
+			genState: &types.GenesisState{
+				Operators: []types.OperatorDetail{
+					{
+						OperatorAddress: accAddress1.String(),
+					},
+				},
+				VotingPowerSnapshots: []types.VotingPowerSnapshot{
+					{
+						AVSAddress:      utiltx.GenerateAddress().String(),
+						Height:          10,
+						EpochIdentifier: "hour",
+						EpochNumber:     2,
+						OperatorVotingPowers: []types.OperatorVotingPower{
+							{
+								OperatorAddress: accAddress2.String(),
+								USDValue:        sdkmath.LegacyNewDec(1),
+							},
+						},
+					},
+				},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
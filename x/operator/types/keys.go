@@ -2,12 +2,14 @@ package types
 
 import (
 	"math"
+	"time"
 
 	"golang.org/x/xerrors"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 
+	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -28,6 +30,12 @@ const (
 
 	UnbondingExpiration = 10
 
+	// MaxExpectedBlockInterval is used to convert the unbonding periods of the AVSs into a
+	// number of blocks. Since it's longer than the usual block interval, the estimated
+	// completion height is a lower bound, and the operator module still holds the unbonding
+	// until the end of the maturity epoch.
+	MaxExpectedBlockInterval = 6 * time.Second
+
 	// AccAddressLength is used to parse the key, because the length isn't padded in the key
 	// This might be removed if the address length is padded in the key
 	AccAddressLength = 20
@@ -67,6 +75,22 @@ const (
 	// BytePrefixForOperatorKeyRemovalForChainID is the prefix to store that the operator with
 	// the given address is in the process of unbonding their key for the given chainID.
 	BytePrefixForOperatorKeyRemovalForChainID
+
+	prefixParams
+
+	prefixPendingSlash
+
+	prefixVotingPowerSnapshot
+
+	prefixPriceBreakerState
+
+	prefixUnbondingMaturity
+
+	prefixPendingCommissionUpdate
+
+	prefixPendingSlashByEpoch
+
+	prefixUnbondingMaturityByEpoch
 )
 
 var (
@@ -94,8 +118,101 @@ var (
 	// processedSlashHeight + '/' + assetID + '/' + stakerID -> SlashAmount
 	// processedSlashHeight + '/' + assetID + '/' + operatorAddr -> SlashAmount
 	KeyPrefixSlashAssetsState = []byte{prefixSlashAssetsState}
+
+	// KeyPrefixParams key-value: ParamsKey -> types.Params
+	KeyPrefixParams = []byte{prefixParams}
+	ParamsKey       = []byte("Params")
+
+	// KeyPrefixPendingSlash key-value:
+	// AVSAddr + '/' + operator + '/' + slashId -> PendingSlash
+	KeyPrefixPendingSlash = []byte{prefixPendingSlash}
+
+	// KeyPrefixVotingPowerSnapshot key-value:
+	// AVSAddr + '/' + height -> VotingPowerSnapshot
+	KeyPrefixVotingPowerSnapshot = []byte{prefixVotingPowerSnapshot}
+
+	// KeyPrefixPriceBreakerState key-value:
+	// assetID -> PriceBreakerState
+	KeyPrefixPriceBreakerState = []byte{prefixPriceBreakerState}
+
+	// KeyPrefixUnbondingMaturity key-value:
+	// recordType + '/' + recordKey -> UnbondingMaturity
+	KeyPrefixUnbondingMaturity = []byte{prefixUnbondingMaturity}
+
+	// KeyPrefixPendingCommissionUpdate key-value:
+	// operatorAddr -> PendingCommissionUpdate
+	KeyPrefixPendingCommissionUpdate = []byte{prefixPendingCommissionUpdate}
+
+	// KeyPrefixPendingSlashByEpoch key-value:
+	// epochIdentifier + '/' + executionEpoch + AVSAddr + '/' + operator + '/' + slashId -> nil
+	// it indexes the pending slashes by the epoch in which their veto window ends.
+	KeyPrefixPendingSlashByEpoch = []byte{prefixPendingSlashByEpoch}
+
+	// KeyPrefixUnbondingMaturityByEpoch key-value:
+	// epochIdentifier + '/' + maturityEpoch + recordType + '/' + recordKey -> nil
+	// it indexes the unbonding maturities by the epoch at the end of which they're released.
+	KeyPrefixUnbondingMaturityByEpoch = []byte{prefixUnbondingMaturityByEpoch}
 )
 
+const (
+	// UndelegationRecordType and RedelegationRecordType are used in the key of the unbonding
+	// maturity to distinguish the records, since their keys have the same format.
+	UndelegationRecordType = "undelegation"
+	RedelegationRecordType = "redelegation"
+)
+
+// GetUnbondingMaturityKey returns the key of the unbonding maturity for the record.
+func GetUnbondingMaturityKey(isRedelegation bool, recordKey string) []byte {
+	recordType := UndelegationRecordType
+	if isRedelegation {
+		recordType = RedelegationRecordType
+	}
+	return assetstypes.GetJoinedStoreKey(recordType, recordKey)
+}
+
+// epochPrefix returns the prefix of the indexes by epoch, the epoch is encoded in big endian
+// to iterate the entries in the order of the epochs.
+func epochPrefix(epochIdentifier string, epoch int64) []byte {
+	return AppendMany(
+		assetstypes.GetJoinedStoreKeyForPrefix(epochIdentifier),
+		// #nosec G115
+		sdk.Uint64ToBigEndian(uint64(epoch)),
+	)
+}
+
+// PendingSlashEpochPrefix returns the prefix of the pending slash index for the epoch.
+func PendingSlashEpochPrefix(epochIdentifier string, executionEpoch int64) []byte {
+	return epochPrefix(epochIdentifier, executionEpoch)
+}
+
+// KeyForPendingSlashByEpoch returns the key of the pending slash in the index by execution
+// epoch.
+func KeyForPendingSlashByEpoch(
+	epochIdentifier string, executionEpoch int64, avsAddr, operatorAddr, slashID string,
+) []byte {
+	return AppendMany(
+		PendingSlashEpochPrefix(epochIdentifier, executionEpoch),
+		assetstypes.GetJoinedStoreKey(avsAddr, operatorAddr, slashID),
+	)
+}
+
+// UnbondingMaturityEpochPrefix returns the prefix of the unbonding maturity index for the
+// epoch.
+func UnbondingMaturityEpochPrefix(epochIdentifier string, maturityEpoch int64) []byte {
+	return epochPrefix(epochIdentifier, maturityEpoch)
+}
+
+// KeyForUnbondingMaturityByEpoch returns the key of the unbonding maturity in the index by
+// maturity epoch. The suffix after the epoch prefix is the key of the unbonding maturity.
+func KeyForUnbondingMaturityByEpoch(
+	epochIdentifier string, maturityEpoch int64, isRedelegation bool, recordKey string,
+) []byte {
+	return AppendMany(
+		UnbondingMaturityEpochPrefix(epochIdentifier, maturityEpoch),
+		GetUnbondingMaturityKey(isRedelegation, recordKey),
+	)
+}
+
 // ModuleAddress is the native module address for EVM
 var ModuleAddress common.Address
 
@@ -241,3 +358,13 @@ func IterateOperatorsForAVSPrefix(avsAddr string) []byte {
 	tmp := append([]byte(avsAddr), '/')
 	return tmp
 }
+
+// KeyForVotingPowerSnapshot returns the key of the voting power snapshot taken at the height
+// for the AVS, the height is encoded in big endian to iterate the snapshots in order.
+func KeyForVotingPowerSnapshot(avsAddr string, height int64) []byte {
+	return AppendMany(
+		assetstypes.GetJoinedStoreKeyForPrefix(avsAddr),
+		// #nosec G115
+		sdk.Uint64ToBigEndian(uint64(height)),
+	)
+}
@@ -4,6 +4,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	keytypes "github.com/ExocoreNetwork/exocore/types/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -16,6 +17,14 @@ const (
 	TypeOptIntoAVSReq = "opt_into_avs"
 	// TypeOptOutOfAVSReq is the type for the OptOutOfAVSReq message.
 	TypeOptOutOfAVSReq = "opt_out_of_avs"
+	// TypeMsgVetoSlash is the type for the MsgVetoSlash message.
+	TypeMsgVetoSlash = "veto_slash"
+	// TypeMsgUpdateParams is the type for the MsgUpdateParams message.
+	TypeMsgUpdateParams = "update_params"
+	// TypeMsgUpdateOperatorCommission is the type for the MsgUpdateOperatorCommission message.
+	TypeMsgUpdateOperatorCommission = "update_operator_commission"
+	// TypeMsgEditOperator is the type for the MsgEditOperator message.
+	TypeMsgEditOperator = "edit_operator"
 )
 
 // interface guards
@@ -24,6 +33,10 @@ var (
 	_ sdk.Msg = &OptIntoAVSReq{}
 	_ sdk.Msg = &OptOutOfAVSReq{}
 	_ sdk.Msg = &SetConsKeyReq{}
+	_ sdk.Msg = &MsgVetoSlash{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgUpdateOperatorCommission{}
+	_ sdk.Msg = &MsgEditOperator{}
 )
 
 // GetSigners returns the expected signers for the message.
@@ -165,3 +178,167 @@ func (m *OptOutOfAVSReq) Type() string {
 func (m *OptOutOfAVSReq) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
 }
+
+// GetSigners returns the expected signers for the message.
+func (m *MsgVetoSlash) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgVetoSlash) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.OperatorAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid operator address")
+	}
+	if !common.IsHexAddress(m.AVSAddress) {
+		return errorsmod.Wrap(ErrParameterInvalid, "invalid AVS address")
+	}
+	if m.SlashID == "" {
+		return errorsmod.Wrap(ErrParameterInvalid, "slash ID is empty")
+	}
+	return nil
+}
+
+// Route returns the transaction route.
+func (m *MsgVetoSlash) Route() string {
+	return RouterKey
+}
+
+// Type returns the transaction type.
+func (m *MsgVetoSlash) Type() string {
+	return TypeMsgVetoSlash
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over.
+func (m *MsgVetoSlash) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners returns the expected signers for the message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	return m.Params.Validate()
+}
+
+// Route returns the transaction route.
+func (m *MsgUpdateParams) Route() string {
+	return RouterKey
+}
+
+// Type returns the transaction type.
+func (m *MsgUpdateParams) Type() string {
+	return TypeMsgUpdateParams
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over.
+func (m *MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners returns the expected signers for the message.
+func (m *MsgUpdateOperatorCommission) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateOperatorCommission) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	if m.CommissionRate.IsNil() {
+		return errorsmod.Wrap(ErrParameterInvalid, "commission rate is nil")
+	}
+	if m.CommissionRate.IsNegative() {
+		return stakingtypes.ErrCommissionNegative
+	}
+	if m.CommissionRate.GT(sdk.OneDec()) {
+		return stakingtypes.ErrCommissionHuge
+	}
+	return nil
+}
+
+// Route returns the transaction route.
+func (m *MsgUpdateOperatorCommission) Route() string {
+	return RouterKey
+}
+
+// Type returns the transaction type.
+func (m *MsgUpdateOperatorCommission) Type() string {
+	return TypeMsgUpdateOperatorCommission
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over.
+func (m *MsgUpdateOperatorCommission) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners returns the expected signers for the message.
+func (m *MsgEditOperator) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgEditOperator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	if m.EarningsAddr == "" && m.OperatorMetaInfo == "" &&
+		(m.ClientChainEarningsAddr == nil || len(m.ClientChainEarningsAddr.EarningInfoList) == 0) {
+		return errorsmod.Wrap(ErrParameterInvalid, "nothing to edit")
+	}
+	if m.EarningsAddr != "" {
+		if _, err := sdk.AccAddressFromBech32(m.EarningsAddr); err != nil {
+			return errorsmod.Wrap(err, "invalid earnings address")
+		}
+	}
+	if len(m.OperatorMetaInfo) > stakingtypes.MaxIdentityLength {
+		return errorsmod.Wrapf(
+			ErrParameterInvalid,
+			"operator meta info length exceeds %d", stakingtypes.MaxIdentityLength,
+		)
+	}
+	if m.ClientChainEarningsAddr != nil {
+		seen := make(map[uint64]struct{}, len(m.ClientChainEarningsAddr.EarningInfoList))
+		for _, data := range m.ClientChainEarningsAddr.EarningInfoList {
+			if data == nil || data.ClientChainEarningAddr == "" {
+				return errorsmod.Wrap(ErrParameterInvalid, "client chain earning address is empty")
+			}
+			if _, ok := seen[data.LzClientChainID]; ok {
+				return errorsmod.Wrapf(
+					ErrParameterInvalid,
+					"duplicate client chain earning address for the client chain %d", data.LzClientChainID,
+				)
+			}
+			seen[data.LzClientChainID] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// Route returns the transaction route.
+func (m *MsgEditOperator) Route() string {
+	return RouterKey
+}
+
+// Type returns the transaction type.
+func (m *MsgEditOperator) Type() string {
+	return TypeMsgEditOperator
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over.
+func (m *MsgEditOperator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
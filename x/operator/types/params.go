@@ -0,0 +1,80 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// PriceBreakerReasonStale indicates the price hasn't changed for too many blocks.
+	PriceBreakerReasonStale = "stale"
+	// PriceBreakerReasonDeviation indicates the price changed too much in the latest round.
+	PriceBreakerReasonDeviation = "deviation"
+)
+
+// DefaultSlashVetoEpochs is the default number of epochs for which a non-instantaneous slash
+// can be vetoed.
+const DefaultSlashVetoEpochs = uint64(2)
+
+// NewParams creates a new Params instance
+func NewParams(slashVetoEpochs uint64, priceGuards []PriceGuard) Params {
+	return Params{
+		SlashVetoEpochs: slashVetoEpochs,
+		PriceGuards:     priceGuards,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(DefaultSlashVetoEpochs, nil)
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	seen := make(map[string]struct{}, len(p.PriceGuards))
+	for _, guard := range p.PriceGuards {
+		if _, _, err := assetstypes.ValidateID(guard.AssetID, true, false); err != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid asset ID for the price guard, %s", guard.AssetID)
+		}
+		if _, ok := seen[guard.AssetID]; ok {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate asset ID for the price guard, %s", guard.AssetID)
+		}
+		seen[guard.AssetID] = struct{}{}
+		if guard.MaxDeviation.IsNil() || guard.MaxDeviation.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid max deviation for the price guard, %s", guard.AssetID)
+		}
+	}
+	return nil
+}
+
+// GetPriceGuard returns the price guard of the asset and whether it's found.
+func (p Params) GetPriceGuard(assetID string) (PriceGuard, bool) {
+	for _, guard := range p.PriceGuards {
+		if guard.AssetID == assetID {
+			return guard, true
+		}
+	}
+	return PriceGuard{}, false
+}
+
+// IsStale returns whether the price without a new round since the update height is stale.
+func (g PriceGuard) IsStale(updateHeight, height int64) bool {
+	// #nosec G115
+	return g.MaxStalenessBlocks > 0 && height-updateHeight > int64(g.MaxStalenessBlocks)
+}
+
+// IsDeviated returns whether the change from the previous price to the current price exceeds
+// the max deviation, the prices are compared after being scaled to the same decimal.
+func (g PriceGuard) IsDeviated(prevPrice sdk.Int, prevDecimal uint32, price sdk.Int, decimal uint32) bool {
+	if !g.MaxDeviation.IsPositive() || !prevPrice.IsPositive() {
+		return false
+	}
+	if prevDecimal < decimal {
+		prevPrice = prevPrice.Mul(sdkmath.NewIntWithDecimal(1, int(decimal-prevDecimal)))
+	} else if decimal < prevDecimal {
+		price = price.Mul(sdkmath.NewIntWithDecimal(1, int(prevDecimal-decimal)))
+	}
+	return sdk.NewDecFromInt(price.Sub(prevPrice).Abs()).QuoInt(prevPrice).GT(g.MaxDeviation)
+}
@@ -0,0 +1,642 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: exocore/operator/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the operator module.
+type Params struct {
+	// slash_veto_epochs is the number of epochs, counted with the epoch identifier of the AVS,
+	// for which a non-instantaneous slash is queued and can be vetoed before being executed.
+	// The slashes are executed immediately if it's zero.
+	SlashVetoEpochs uint64 `protobuf:"varint,1,opt,name=slash_veto_epochs,json=slashVetoEpochs,proto3" json:"slash_veto_epochs,omitempty"`
+	// price_guards is a list of the guards of the asset prices used to calculate the voting
+	// power. The voting power of the AVSs supporting an asset is frozen while the price
+	// breaker of the asset is tripped.
+	PriceGuards []PriceGuard `protobuf:"bytes,2,rep,name=price_guards,json=priceGuards,proto3" json:"price_guards"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06ea7ab479acde09, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetSlashVetoEpochs() uint64 {
+	if m != nil {
+		return m.SlashVetoEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetPriceGuards() []PriceGuard {
+	if m != nil {
+		return m.PriceGuards
+	}
+	return nil
+}
+
+// PriceGuard defines the conditions under which the price of an asset is regarded as invalid.
+type PriceGuard struct {
+	// asset_id is the ID of the asset.
+	AssetID string `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// max_staleness_blocks is the maximum number of blocks for which the oracle can go without
+	// a new price round of the asset. It's disabled if it's zero.
+	MaxStalenessBlocks uint64 `protobuf:"varint,2,opt,name=max_staleness_blocks,json=maxStalenessBlocks,proto3" json:"max_staleness_blocks,omitempty"`
+	// max_deviation is the maximum relative change of the price between two consecutive price
+	// rounds. It's disabled if it's zero.
+	MaxDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_deviation,json=maxDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_deviation"`
+}
+
+func (m *PriceGuard) Reset()         { *m = PriceGuard{} }
+func (m *PriceGuard) String() string { return proto.CompactTextString(m) }
+func (*PriceGuard) ProtoMessage()    {}
+func (*PriceGuard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06ea7ab479acde09, []int{1}
+}
+func (m *PriceGuard) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceGuard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceGuard.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceGuard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceGuard.Merge(m, src)
+}
+func (m *PriceGuard) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceGuard) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceGuard.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceGuard proto.InternalMessageInfo
+
+func (m *PriceGuard) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+func (m *PriceGuard) GetMaxStalenessBlocks() uint64 {
+	if m != nil {
+		return m.MaxStalenessBlocks
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "exocore.operator.v1.Params")
+	proto.RegisterType((*PriceGuard)(nil), "exocore.operator.v1.PriceGuard")
+}
+
+func init() { proto.RegisterFile("exocore/operator/v1/params.proto", fileDescriptor_06ea7ab479acde09) }
+
+var fileDescriptor_06ea7ab479acde09 = []byte{
+	// 383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xc1, 0xae, 0xd2, 0x40,
+	0x14, 0x86, 0x5b, 0x21, 0xa0, 0x03, 0xc6, 0x58, 0x59, 0x20, 0x8b, 0x96, 0xb0, 0x20, 0xc4, 0x84,
+	0x56, 0x70, 0xeb, 0xc6, 0xa6, 0x44, 0x49, 0x8c, 0x21, 0x35, 0x71, 0xe1, 0xa6, 0x19, 0xa6, 0x93,
+	0xd2, 0x40, 0x39, 0xcd, 0xcc, 0x50, 0xeb, 0xc6, 0x67, 0xf0, 0x61, 0x7c, 0x08, 0xdc, 0x11, 0x57,
+	0xe6, 0x2e, 0xc8, 0x4d, 0x79, 0x91, 0x9b, 0x99, 0xb6, 0x97, 0xbb, 0xb8, 0xab, 0xb6, 0xff, 0xff,
+	0xf5, 0x3f, 0x7f, 0xce, 0x41, 0x43, 0x9a, 0x03, 0x01, 0x46, 0x1d, 0x48, 0x29, 0xc3, 0x02, 0x98,
+	0x93, 0xcd, 0x9c, 0x14, 0x33, 0x9c, 0x70, 0x3b, 0x65, 0x20, 0xc0, 0x78, 0x55, 0x11, 0x76, 0x4d,
+	0xd8, 0xd9, 0x6c, 0xf0, 0x9a, 0x00, 0x4f, 0x80, 0x07, 0x0a, 0x71, 0xca, 0x8f, 0x92, 0x1f, 0xf4,
+	0x22, 0x88, 0xa0, 0xd4, 0xe5, 0x5b, 0xa9, 0x8e, 0x7e, 0xa1, 0xd6, 0x4a, 0xa5, 0x1a, 0x6f, 0xd0,
+	0x4b, 0xbe, 0xc3, 0x7c, 0x13, 0x64, 0x54, 0x40, 0x40, 0x53, 0x20, 0x1b, 0xde, 0xd7, 0x87, 0xfa,
+	0xa4, 0xe9, 0xbf, 0x50, 0xc6, 0x37, 0x2a, 0x60, 0xa1, 0x64, 0xe3, 0x13, 0xea, 0xa6, 0x2c, 0x26,
+	0x34, 0x88, 0x0e, 0x98, 0x85, 0xbc, 0xff, 0x64, 0xd8, 0x98, 0x74, 0xe6, 0x96, 0xfd, 0x48, 0x25,
+	0x7b, 0x25, 0xc1, 0x8f, 0x92, 0x73, 0x9b, 0xc7, 0xb3, 0xa5, 0xf9, 0x9d, 0xf4, 0x5e, 0xe1, 0xa3,
+	0xbf, 0x3a, 0x42, 0x57, 0xc2, 0x18, 0xa3, 0xa7, 0x98, 0x73, 0x2a, 0x82, 0x38, 0x54, 0xb3, 0x9f,
+	0xb9, 0x9d, 0xe2, 0x6c, 0xb5, 0x3f, 0x48, 0x6d, 0xe9, 0xf9, 0x6d, 0x65, 0x2e, 0x43, 0xe3, 0x2d,
+	0xea, 0x25, 0x38, 0x0f, 0xb8, 0xc0, 0x3b, 0xba, 0xa7, 0x9c, 0x07, 0xeb, 0x1d, 0x90, 0xad, 0x2c,
+	0x22, 0xfb, 0x1a, 0x09, 0xce, 0xbf, 0xd6, 0x96, 0xab, 0x1c, 0x03, 0xa3, 0xe7, 0xf2, 0x8f, 0x90,
+	0x66, 0x31, 0x16, 0x31, 0xec, 0xfb, 0x0d, 0x15, 0xff, 0x5e, 0x56, 0xba, 0x39, 0x5b, 0xe3, 0x28,
+	0x16, 0x9b, 0xc3, 0xda, 0x26, 0x90, 0x54, 0x6b, 0xab, 0x1e, 0x53, 0x1e, 0x6e, 0x1d, 0xf1, 0x33,
+	0xa5, 0xdc, 0xf6, 0x28, 0xf9, 0xf7, 0x67, 0x8a, 0xaa, 0xad, 0x7a, 0x94, 0xf8, 0xdd, 0x04, 0xe7,
+	0x5e, 0x9d, 0xe8, 0x7e, 0x3e, 0x16, 0xa6, 0x7e, 0x2a, 0x4c, 0xfd, 0xb6, 0x30, 0xf5, 0xdf, 0x17,
+	0x53, 0x3b, 0x5d, 0x4c, 0xed, 0xff, 0xc5, 0xd4, 0xbe, 0xcf, 0x1f, 0xa4, 0x2f, 0xca, 0x1d, 0x7d,
+	0xa1, 0xe2, 0x07, 0xb0, 0xad, 0x53, 0xdf, 0x39, 0xbf, 0x5e, 0x5a, 0x4d, 0x5b, 0xb7, 0xd4, 0x81,
+	0xde, 0xdd, 0x05, 0x00, 0x00, 0xff, 0xff, 0x30, 0xe4, 0x6d, 0x17, 0x0a, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceGuards) > 0 {
+		for iNdEx := len(m.PriceGuards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceGuards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.SlashVetoEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SlashVetoEpochs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceGuard) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceGuard) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceGuard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxDeviation.Size()
+		i -= size
+		if _, err := m.MaxDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MaxStalenessBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxStalenessBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashVetoEpochs != 0 {
+		n += 1 + sovParams(uint64(m.SlashVetoEpochs))
+	}
+	if len(m.PriceGuards) > 0 {
+		for _, e := range m.PriceGuards {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PriceGuard) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxStalenessBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MaxStalenessBlocks))
+	}
+	l = m.MaxDeviation.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashVetoEpochs", wireType)
+			}
+			m.SlashVetoEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashVetoEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceGuards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceGuards = append(m.PriceGuards, PriceGuard{})
+			if err := m.PriceGuards[len(m.PriceGuards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceGuard) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceGuard: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceGuard: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStalenessBlocks", wireType)
+			}
+			m.MaxStalenessBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStalenessBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
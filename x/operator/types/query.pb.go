@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -525,6 +526,413 @@ func (m *QueryOperatorSlashInfoResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryPendingSlashesRequest is the request to obtain the pending slashes.
+type QueryPendingSlashesRequest struct {
+	// avs_address is the AVS address, all pending slashes are returned if it's empty.
+	AvsAddress string `protobuf:"bytes,1,opt,name=avs_address,json=avsAddress,proto3" json:"avs_address,omitempty"`
+	// pagination related options.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingSlashesRequest) Reset()         { *m = QueryPendingSlashesRequest{} }
+func (m *QueryPendingSlashesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingSlashesRequest) ProtoMessage()    {}
+func (*QueryPendingSlashesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f91e795a3cecbdbf, []int{10}
+}
+func (m *QueryPendingSlashesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingSlashesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingSlashesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingSlashesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingSlashesRequest.Merge(m, src)
+}
+func (m *QueryPendingSlashesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingSlashesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingSlashesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingSlashesRequest proto.InternalMessageInfo
+
+func (m *QueryPendingSlashesRequest) GetAvsAddress() string {
+	if m != nil {
+		return m.AvsAddress
+	}
+	return ""
+}
+
+func (m *QueryPendingSlashesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingSlashesResponse is the response for QueryPendingSlashesRequest.
+type QueryPendingSlashesResponse struct {
+	// pending_slashes is a list of the pending slashes.
+	PendingSlashes []PendingSlash `protobuf:"bytes,1,rep,name=pending_slashes,json=pendingSlashes,proto3" json:"pending_slashes"`
+	// pagination related response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingSlashesResponse) Reset()         { *m = QueryPendingSlashesResponse{} }
+func (m *QueryPendingSlashesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingSlashesResponse) ProtoMessage()    {}
+func (*QueryPendingSlashesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f91e795a3cecbdbf, []int{11}
+}
+func (m *QueryPendingSlashesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingSlashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingSlashesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingSlashesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingSlashesResponse.Merge(m, src)
+}
+func (m *QueryPendingSlashesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingSlashesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingSlashesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingSlashesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingSlashesResponse) GetPendingSlashes() []PendingSlash {
+	if m != nil {
+		return m.PendingSlashes
+	}
+	return nil
+}
+
+func (m *QueryPendingSlashesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPriceBreakersRequest is the request to obtain the states of the price breakers.
+type QueryPriceBreakersRequest struct {
+	// asset_id is the ID of the asset, all states are returned if it's empty.
+	AssetID string `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+}
+
+func (m *QueryPriceBreakersRequest) Reset()         { *m = QueryPriceBreakersRequest{} }
+func (m *QueryPriceBreakersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceBreakersRequest) ProtoMessage()    {}
+func (*QueryPriceBreakersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f91e795a3cecbdbf, []int{12}
+}
+func (m *QueryPriceBreakersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceBreakersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceBreakersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceBreakersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceBreakersRequest.Merge(m, src)
+}
+func (m *QueryPriceBreakersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceBreakersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceBreakersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceBreakersRequest proto.InternalMessageInfo
+
+func (m *QueryPriceBreakersRequest) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+// QueryPriceBreakersResponse is the response for QueryPriceBreakersRequest.
+type QueryPriceBreakersResponse struct {
+	// states is a list of the states of the price breakers.
+	States []PriceBreakerState `protobuf:"bytes,1,rep,name=states,proto3" json:"states"`
+}
+
+func (m *QueryPriceBreakersResponse) Reset()         { *m = QueryPriceBreakersResponse{} }
+func (m *QueryPriceBreakersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceBreakersResponse) ProtoMessage()    {}
+func (*QueryPriceBreakersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f91e795a3cecbdbf, []int{13}
+}
+func (m *QueryPriceBreakersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceBreakersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceBreakersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceBreakersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceBreakersResponse.Merge(m, src)
+}
+func (m *QueryPriceBreakersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceBreakersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceBreakersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceBreakersResponse proto.InternalMessageInfo
+
+func (m *QueryPriceBreakersResponse) GetStates() []PriceBreakerState {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+// QueryUnbondingMaturityRequest is the request to obtain the maturity of an undelegation.
+type QueryUnbondingMaturityRequest struct {
+	// operator is the operator address, the maturity of an undelegation started from the
+	// operator at the current block is computed if the record key isn't provided.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// record_key is the key of the undelegation record.
+	RecordKey string `protobuf:"bytes,2,opt,name=record_key,json=recordKey,proto3" json:"record_key,omitempty"`
+}
+
+func (m *QueryUnbondingMaturityRequest) Reset()         { *m = QueryUnbondingMaturityRequest{} }
+func (m *QueryUnbondingMaturityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingMaturityRequest) ProtoMessage()    {}
+func (*QueryUnbondingMaturityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f91e795a3cecbdbf, []int{14}
+}
+func (m *QueryUnbondingMaturityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingMaturityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingMaturityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondingMaturityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingMaturityRequest.Merge(m, src)
+}
+func (m *QueryUnbondingMaturityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingMaturityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingMaturityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondingMaturityRequest proto.InternalMessageInfo
+
+func (m *QueryUnbondingMaturityRequest) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *QueryUnbondingMaturityRequest) GetRecordKey() string {
+	if m != nil {
+		return m.RecordKey
+	}
+	return ""
+}
+
+// QueryUnbondingMaturityResponse is the response for QueryUnbondingMaturityRequest.
+type QueryUnbondingMaturityResponse struct {
+	// complete_block_number is the earliest block at the end of which the undelegation
+	// can be completed.
+	CompleteBlockNumber uint64 `protobuf:"varint,1,opt,name=complete_block_number,json=completeBlockNumber,proto3" json:"complete_block_number,omitempty"`
+	// maturity is the epoch-based maturity determined by the opted-in AVSs, it's nil if
+	// none of them holds the undelegation.
+	Maturity *UnbondingMaturity `protobuf:"bytes,2,opt,name=maturity,proto3" json:"maturity,omitempty"`
+	// reason explains how the maturity is determined.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *QueryUnbondingMaturityResponse) Reset()         { *m = QueryUnbondingMaturityResponse{} }
+func (m *QueryUnbondingMaturityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingMaturityResponse) ProtoMessage()    {}
+func (*QueryUnbondingMaturityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f91e795a3cecbdbf, []int{15}
+}
+func (m *QueryUnbondingMaturityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingMaturityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingMaturityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondingMaturityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingMaturityResponse.Merge(m, src)
+}
+func (m *QueryUnbondingMaturityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingMaturityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingMaturityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondingMaturityResponse proto.InternalMessageInfo
+
+func (m *QueryUnbondingMaturityResponse) GetCompleteBlockNumber() uint64 {
+	if m != nil {
+		return m.CompleteBlockNumber
+	}
+	return 0
+}
+
+func (m *QueryUnbondingMaturityResponse) GetMaturity() *UnbondingMaturity {
+	if m != nil {
+		return m.Maturity
+	}
+	return nil
+}
+
+func (m *QueryUnbondingMaturityResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// QueryParamsRequest is the request to obtain the parameters of the module.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f91e795a3cecbdbf, []int{16}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response for QueryParamsRequest.
+type QueryParamsResponse struct {
+	// params is the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f91e795a3cecbdbf, []int{17}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 // QueryOperatorConsKeyRequest is the request to obtain the consensus public key of the operator
 type QueryOperatorConsKeyRequest struct {
 	// operator_acc_addr is the operator account address.
@@ -539,7 +947,7 @@ func (m *QueryOperatorConsKeyRequest) Reset()         { *m = QueryOperatorConsKe
 func (m *QueryOperatorConsKeyRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryOperatorConsKeyRequest) ProtoMessage()    {}
 func (*QueryOperatorConsKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f91e795a3cecbdbf, []int{10}
+	return fileDescriptor_f91e795a3cecbdbf, []int{18}
 }
 func (m *QueryOperatorConsKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -594,7 +1002,7 @@ func (m *QueryOperatorConsKeyResponse) Reset()         { *m = QueryOperatorConsK
 func (m *QueryOperatorConsKeyResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOperatorConsKeyResponse) ProtoMessage()    {}
 func (*QueryOperatorConsKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f91e795a3cecbdbf, []int{11}
+	return fileDescriptor_f91e795a3cecbdbf, []int{19}
 }
 func (m *QueryOperatorConsKeyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -652,7 +1060,7 @@ func (m *QueryOperatorConsAddressRequest) Reset()         { *m = QueryOperatorCo
 func (m *QueryOperatorConsAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryOperatorConsAddressRequest) ProtoMessage()    {}
 func (*QueryOperatorConsAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f91e795a3cecbdbf, []int{12}
+	return fileDescriptor_f91e795a3cecbdbf, []int{20}
 }
 func (m *QueryOperatorConsAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -708,7 +1116,7 @@ func (m *QueryOperatorConsAddressResponse) Reset()         { *m = QueryOperatorC
 func (m *QueryOperatorConsAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOperatorConsAddressResponse) ProtoMessage()    {}
 func (*QueryOperatorConsAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f91e795a3cecbdbf, []int{13}
+	return fileDescriptor_f91e795a3cecbdbf, []int{21}
 }
 func (m *QueryOperatorConsAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -768,7 +1176,7 @@ func (m *QueryAllOperatorConsKeysByChainIDRequest) Reset() {
 func (m *QueryAllOperatorConsKeysByChainIDRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllOperatorConsKeysByChainIDRequest) ProtoMessage()    {}
 func (*QueryAllOperatorConsKeysByChainIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f91e795a3cecbdbf, []int{14}
+	return fileDescriptor_f91e795a3cecbdbf, []int{22}
 }
 func (m *QueryAllOperatorConsKeysByChainIDRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,7 +1236,7 @@ func (m *QueryAllOperatorConsKeysByChainIDResponse) String() string {
 }
 func (*QueryAllOperatorConsKeysByChainIDResponse) ProtoMessage() {}
 func (*QueryAllOperatorConsKeysByChainIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f91e795a3cecbdbf, []int{15}
+	return fileDescriptor_f91e795a3cecbdbf, []int{23}
 }
 func (m *QueryAllOperatorConsKeysByChainIDResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -886,7 +1294,7 @@ func (m *OperatorConsKeyPair) Reset()         { *m = OperatorConsKeyPair{} }
 func (m *OperatorConsKeyPair) String() string { return proto.CompactTextString(m) }
 func (*OperatorConsKeyPair) ProtoMessage()    {}
 func (*OperatorConsKeyPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f91e795a3cecbdbf, []int{16}
+	return fileDescriptor_f91e795a3cecbdbf, []int{24}
 }
 func (m *OperatorConsKeyPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -955,7 +1363,7 @@ func (m *QueryAllOperatorConsAddrsByChainIDRequest) String() string {
 }
 func (*QueryAllOperatorConsAddrsByChainIDRequest) ProtoMessage() {}
 func (*QueryAllOperatorConsAddrsByChainIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f91e795a3cecbdbf, []int{17}
+	return fileDescriptor_f91e795a3cecbdbf, []int{25}
 }
 func (m *QueryAllOperatorConsAddrsByChainIDRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1015,7 +1423,7 @@ func (m *QueryAllOperatorConsAddrsByChainIDResponse) String() string {
 }
 func (*QueryAllOperatorConsAddrsByChainIDResponse) ProtoMessage() {}
 func (*QueryAllOperatorConsAddrsByChainIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f91e795a3cecbdbf, []int{18}
+	return fileDescriptor_f91e795a3cecbdbf, []int{26}
 }
 func (m *QueryAllOperatorConsAddrsByChainIDResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1073,7 +1481,7 @@ func (m *OperatorConsAddrPair) Reset()         { *m = OperatorConsAddrPair{} }
 func (m *OperatorConsAddrPair) String() string { return proto.CompactTextString(m) }
 func (*OperatorConsAddrPair) ProtoMessage()    {}
 func (*OperatorConsAddrPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f91e795a3cecbdbf, []int{19}
+	return fileDescriptor_f91e795a3cecbdbf, []int{27}
 }
 func (m *OperatorConsAddrPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1135,7 +1543,7 @@ func (m *QueryAllOperatorsByOptInAVSRequest) Reset()         { *m = QueryAllOper
 func (m *QueryAllOperatorsByOptInAVSRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllOperatorsByOptInAVSRequest) ProtoMessage()    {}
 func (*QueryAllOperatorsByOptInAVSRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f91e795a3cecbdbf, []int{20}
+	return fileDescriptor_f91e795a3cecbdbf, []int{28}
 }
 func (m *QueryAllOperatorsByOptInAVSRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1183,7 +1591,7 @@ func (m *QueryAllOperatorsByOptInAVSResponse) Reset()         { *m = QueryAllOpe
 func (m *QueryAllOperatorsByOptInAVSResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllOperatorsByOptInAVSResponse) ProtoMessage()    {}
 func (*QueryAllOperatorsByOptInAVSResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f91e795a3cecbdbf, []int{21}
+	return fileDescriptor_f91e795a3cecbdbf, []int{29}
 }
 func (m *QueryAllOperatorsByOptInAVSResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1230,7 +1638,7 @@ func (m *QueryAllAVSsByOperatorRequest) Reset()         { *m = QueryAllAVSsByOpe
 func (m *QueryAllAVSsByOperatorRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllAVSsByOperatorRequest) ProtoMessage()    {}
 func (*QueryAllAVSsByOperatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f91e795a3cecbdbf, []int{22}
+	return fileDescriptor_f91e795a3cecbdbf, []int{30}
 }
 func (m *QueryAllAVSsByOperatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1277,7 +1685,7 @@ func (m *QueryAllAVSsByOperatorResponse) Reset()         { *m = QueryAllAVSsByOp
 func (m *QueryAllAVSsByOperatorResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllAVSsByOperatorResponse) ProtoMessage()    {}
 func (*QueryAllAVSsByOperatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f91e795a3cecbdbf, []int{23}
+	return fileDescriptor_f91e795a3cecbdbf, []int{31}
 }
 func (m *QueryAllAVSsByOperatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1324,7 +1732,7 @@ func (m *QueryOptInfoRequest) Reset()         { *m = QueryOptInfoRequest{} }
 func (m *QueryOptInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryOptInfoRequest) ProtoMessage()    {}
 func (*QueryOptInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f91e795a3cecbdbf, []int{24}
+	return fileDescriptor_f91e795a3cecbdbf, []int{32}
 }
 func (m *QueryOptInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1353,6 +1761,98 @@ func (m *QueryOptInfoRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryOptInfoRequest proto.InternalMessageInfo
 
+// QuerySelfDelegationStatusRequest is the request to obtain the self delegation status of the
+// specified operator and AVS.
+type QuerySelfDelegationStatusRequest struct {
+	// operator_and_avs is the operator and AVS address
+	*OperatorAVSAddress `protobuf:"bytes,1,opt,name=operator_and_avs,json=operatorAndAvs,proto3,embedded=operator_and_avs" json:"operator_and_avs,omitempty"`
+}
+
+func (m *QuerySelfDelegationStatusRequest) Reset()         { *m = QuerySelfDelegationStatusRequest{} }
+func (m *QuerySelfDelegationStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySelfDelegationStatusRequest) ProtoMessage()    {}
+func (*QuerySelfDelegationStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f91e795a3cecbdbf, []int{33}
+}
+func (m *QuerySelfDelegationStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySelfDelegationStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySelfDelegationStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySelfDelegationStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySelfDelegationStatusRequest.Merge(m, src)
+}
+func (m *QuerySelfDelegationStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySelfDelegationStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySelfDelegationStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySelfDelegationStatusRequest proto.InternalMessageInfo
+
+// QuerySelfDelegationStatusResponse is the response to QuerySelfDelegationStatusRequest.
+type QuerySelfDelegationStatusResponse struct {
+	// self_usd_value is the self-delegated USD value of the operator calculated at the end of
+	// the last epoch of the AVS.
+	SelfUSDValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=self_usd_value,json=selfUsdValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"self_usd_value"`
+	// min_self_delegation is the minimum self delegation of the AVS in USD.
+	MinSelfDelegation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_self_delegation"`
+	// below_min_self_delegation indicates whether the operator is excluded from the validator
+	// set, the task power and the rewards of the AVS because of the insufficient self delegation.
+	BelowMinSelfDelegation bool `protobuf:"varint,3,opt,name=below_min_self_delegation,json=belowMinSelfDelegation,proto3" json:"below_min_self_delegation,omitempty"`
+}
+
+func (m *QuerySelfDelegationStatusResponse) Reset()         { *m = QuerySelfDelegationStatusResponse{} }
+func (m *QuerySelfDelegationStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySelfDelegationStatusResponse) ProtoMessage()    {}
+func (*QuerySelfDelegationStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f91e795a3cecbdbf, []int{34}
+}
+func (m *QuerySelfDelegationStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySelfDelegationStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySelfDelegationStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySelfDelegationStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySelfDelegationStatusResponse.Merge(m, src)
+}
+func (m *QuerySelfDelegationStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySelfDelegationStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySelfDelegationStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySelfDelegationStatusResponse proto.InternalMessageInfo
+
+func (m *QuerySelfDelegationStatusResponse) GetBelowMinSelfDelegation() bool {
+	if m != nil {
+		return m.BelowMinSelfDelegation
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*GetOperatorInfoReq)(nil), "exocore.operator.v1.GetOperatorInfoReq")
 	proto.RegisterType((*QueryAllOperatorsRequest)(nil), "exocore.operator.v1.QueryAllOperatorsRequest")
@@ -1364,6 +1864,14 @@ func init() {
 	proto.RegisterType((*QueryOperatorSlashInfoRequest)(nil), "exocore.operator.v1.QueryOperatorSlashInfoRequest")
 	proto.RegisterType((*OperatorSlashInfoByID)(nil), "exocore.operator.v1.OperatorSlashInfoByID")
 	proto.RegisterType((*QueryOperatorSlashInfoResponse)(nil), "exocore.operator.v1.QueryOperatorSlashInfoResponse")
+	proto.RegisterType((*QueryPendingSlashesRequest)(nil), "exocore.operator.v1.QueryPendingSlashesRequest")
+	proto.RegisterType((*QueryPendingSlashesResponse)(nil), "exocore.operator.v1.QueryPendingSlashesResponse")
+	proto.RegisterType((*QueryPriceBreakersRequest)(nil), "exocore.operator.v1.QueryPriceBreakersRequest")
+	proto.RegisterType((*QueryPriceBreakersResponse)(nil), "exocore.operator.v1.QueryPriceBreakersResponse")
+	proto.RegisterType((*QueryUnbondingMaturityRequest)(nil), "exocore.operator.v1.QueryUnbondingMaturityRequest")
+	proto.RegisterType((*QueryUnbondingMaturityResponse)(nil), "exocore.operator.v1.QueryUnbondingMaturityResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "exocore.operator.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "exocore.operator.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryOperatorConsKeyRequest)(nil), "exocore.operator.v1.QueryOperatorConsKeyRequest")
 	proto.RegisterType((*QueryOperatorConsKeyResponse)(nil), "exocore.operator.v1.QueryOperatorConsKeyResponse")
 	proto.RegisterType((*QueryOperatorConsAddressRequest)(nil), "exocore.operator.v1.QueryOperatorConsAddressRequest")
@@ -1379,106 +1887,142 @@ func init() {
 	proto.RegisterType((*QueryAllAVSsByOperatorRequest)(nil), "exocore.operator.v1.QueryAllAVSsByOperatorRequest")
 	proto.RegisterType((*QueryAllAVSsByOperatorResponse)(nil), "exocore.operator.v1.QueryAllAVSsByOperatorResponse")
 	proto.RegisterType((*QueryOptInfoRequest)(nil), "exocore.operator.v1.QueryOptInfoRequest")
+	proto.RegisterType((*QuerySelfDelegationStatusRequest)(nil), "exocore.operator.v1.QuerySelfDelegationStatusRequest")
+	proto.RegisterType((*QuerySelfDelegationStatusResponse)(nil), "exocore.operator.v1.QuerySelfDelegationStatusResponse")
 }
 
 func init() { proto.RegisterFile("exocore/operator/v1/query.proto", fileDescriptor_f91e795a3cecbdbf) }
 
 var fileDescriptor_f91e795a3cecbdbf = []byte{
-	// 1493 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x6f, 0xdc, 0xc4,
-	0x1b, 0x8e, 0xd3, 0xf6, 0xd7, 0xec, 0xa4, 0xed, 0x2f, 0x9d, 0xa4, 0x90, 0xba, 0xed, 0x6e, 0xeb,
-	0x42, 0x9b, 0x46, 0xad, 0x4d, 0xd2, 0xb4, 0xa0, 0x86, 0x82, 0x76, 0x9b, 0xb6, 0x4a, 0x5b, 0x91,
-	0xe0, 0x15, 0x29, 0x20, 0xc1, 0xca, 0xb1, 0xa7, 0x1b, 0x53, 0xc7, 0xb3, 0xf5, 0xcc, 0x6e, 0xbb,
-	0x8a, 0x82, 0x10, 0x27, 0xb8, 0x20, 0x44, 0x8f, 0x08, 0x3e, 0x05, 0x12, 0x07, 0x0e, 0x70, 0xcc,
-	0x81, 0x43, 0x80, 0x0b, 0xa7, 0x80, 0x12, 0x3e, 0x01, 0x07, 0xce, 0xc8, 0xe3, 0x19, 0xaf, 0xbd,
-	0xb6, 0xf7, 0x4f, 0x1a, 0x7a, 0x5b, 0xdb, 0xf3, 0xce, 0xfb, 0x3c, 0xef, 0xbf, 0x79, 0x66, 0x41,
-	0x01, 0x3d, 0xc1, 0x26, 0xf6, 0x90, 0x86, 0x6b, 0xc8, 0x33, 0x28, 0xf6, 0xb4, 0xc6, 0x94, 0xf6,
-	0xa8, 0x8e, 0xbc, 0xa6, 0x5a, 0xf3, 0x30, 0xc5, 0x70, 0x94, 0x2f, 0x50, 0xc5, 0x02, 0xb5, 0x31,
-	0x25, 0x4f, 0x9a, 0x98, 0xac, 0x62, 0xa2, 0x2d, 0x1b, 0x04, 0x05, 0xab, 0xb5, 0xc6, 0xd4, 0x32,
-	0xa2, 0xc6, 0x94, 0x56, 0x33, 0xaa, 0xb6, 0x6b, 0x50, 0x1b, 0xbb, 0xc1, 0x06, 0xf2, 0xf1, 0x60,
-	0x6d, 0x85, 0x3d, 0x69, 0xc1, 0x03, 0xff, 0x74, 0x32, 0xcd, 0x39, 0x7d, 0xc2, 0xbf, 0x8e, 0x55,
-	0x71, 0x15, 0x07, 0x56, 0xfe, 0x2f, 0x61, 0x53, 0xc5, 0xb8, 0xea, 0x20, 0xcd, 0xa8, 0xd9, 0x9a,
-	0xe1, 0xba, 0x98, 0x32, 0x5f, 0xe1, 0x8e, 0x14, 0xb9, 0x16, 0xf2, 0x56, 0x6d, 0x97, 0x6a, 0xa6,
-	0xd7, 0xac, 0x51, 0xac, 0x3d, 0x44, 0x4d, 0xfe, 0x55, 0x29, 0x03, 0x78, 0x1b, 0xd1, 0x05, 0xee,
-	0x6c, 0xde, 0x7d, 0x80, 0x75, 0xf4, 0x08, 0x5e, 0x07, 0x87, 0x85, 0xff, 0x8a, 0x61, 0x59, 0xde,
-	0xb8, 0x74, 0x5a, 0x9a, 0xc8, 0x95, 0xc6, 0x7f, 0xfd, 0xee, 0xd2, 0x18, 0x87, 0x5b, 0xb4, 0x2c,
-	0x0f, 0x11, 0x52, 0xa6, 0x9e, 0xed, 0x56, 0xf5, 0x43, 0x62, 0xb9, 0xff, 0x5a, 0x59, 0x06, 0xe3,
-	0x6f, 0xfb, 0x11, 0x28, 0x3a, 0x8e, 0xd8, 0x99, 0xe8, 0xe8, 0x51, 0x1d, 0x11, 0x0a, 0x6f, 0x01,
-	0xd0, 0x8a, 0x07, 0xdb, 0x77, 0x78, 0xfa, 0x9c, 0xca, 0x37, 0xf5, 0x83, 0xa7, 0x06, 0xa1, 0xe6,
-	0xc1, 0x53, 0x17, 0x8d, 0x2a, 0xe2, 0xb6, 0x7a, 0xc4, 0x52, 0xf9, 0x4a, 0x02, 0xc7, 0x53, 0x9c,
-	0x90, 0x1a, 0x76, 0x09, 0x82, 0x17, 0x01, 0x6c, 0x11, 0x30, 0x4d, 0x46, 0x82, 0x8c, 0x4b, 0xa7,
-	0xf7, 0x4d, 0xe4, 0xf4, 0x91, 0x10, 0xab, 0x69, 0xfa, 0x70, 0x09, 0xbc, 0x1d, 0xc3, 0x34, 0xc8,
-	0x30, 0x9d, 0xef, 0x8a, 0x29, 0x70, 0x15, 0x03, 0x45, 0x01, 0x14, 0x58, 0x8a, 0x4b, 0x65, 0x1e,
-	0xa2, 0x67, 0x8c, 0x26, 0x2c, 0x80, 0x61, 0xa3, 0x41, 0x98, 0x25, 0x22, 0x84, 0xc1, 0xcb, 0xe9,
-	0xc0, 0x68, 0x10, 0x6e, 0xa4, 0x3c, 0x06, 0x27, 0x59, 0x24, 0x84, 0xeb, 0x77, 0xca, 0x73, 0x4b,
-	0x86, 0x53, 0x17, 0x61, 0x83, 0xf7, 0xc1, 0x48, 0xcb, 0xbf, 0x6b, 0x55, 0x8c, 0x06, 0xe1, 0x81,
-	0x3f, 0xaf, 0xa6, 0x94, 0xb2, 0x9a, 0xa4, 0x50, 0xda, 0xbf, 0xb9, 0x55, 0x90, 0xf4, 0x23, 0x21,
-	0x2e, 0xd7, 0x2a, 0x36, 0x88, 0xd2, 0x04, 0xa7, 0x32, 0x1c, 0xf3, 0x34, 0xbc, 0x0b, 0x40, 0x9d,
-	0x58, 0x95, 0x86, 0xff, 0x52, 0xf8, 0x9c, 0xec, 0xe8, 0x73, 0xa1, 0x46, 0x91, 0x25, 0xf6, 0x29,
-	0x1d, 0xde, 0xde, 0x2a, 0xe4, 0xc4, 0x13, 0xd1, 0x73, 0x75, 0x62, 0x05, 0x3f, 0x95, 0x3b, 0xe0,
-	0xc5, 0x20, 0xfb, 0x4b, 0xe5, 0x76, 0xba, 0x5a, 0x3c, 0x5e, 0x41, 0xb0, 0x8f, 0x6c, 0x6f, 0x15,
-	0x40, 0x8b, 0x50, 0x2c, 0x7e, 0x3f, 0x49, 0x6d, 0x3c, 0xca, 0x8e, 0x41, 0x56, 0x78, 0x2f, 0xfc,
-	0xa7, 0x11, 0x6c, 0xeb, 0x86, 0xc1, 0x5d, 0x77, 0xc3, 0x1a, 0x38, 0x96, 0x00, 0x5f, 0x6a, 0xce,
-	0xcf, 0xc1, 0x73, 0x60, 0x88, 0xf8, 0x2f, 0x2a, 0xb6, 0xc5, 0x23, 0x31, 0xbc, 0xbd, 0x55, 0x38,
-	0x18, 0x2c, 0x9a, 0xd3, 0x0f, 0xb2, 0x8f, 0xf3, 0x16, 0xbc, 0x06, 0xf6, 0xdb, 0xee, 0x03, 0x1c,
-	0x42, 0xe8, 0xc4, 0xaa, 0x15, 0x1e, 0x66, 0xa3, 0xfc, 0x20, 0x81, 0x7c, 0x56, 0xfc, 0x78, 0x21,
-	0x2c, 0x82, 0x23, 0x86, 0xe3, 0x54, 0x38, 0x14, 0xdf, 0x91, 0xdf, 0x8b, 0xdd, 0x8a, 0x21, 0x46,
-	0x45, 0x3f, 0x64, 0x38, 0x4e, 0xf8, 0x66, 0xef, 0x7a, 0xb6, 0x02, 0x4e, 0xc4, 0xc0, 0xdf, 0xc0,
-	0x2e, 0xb9, 0x8b, 0x9a, 0x22, 0xf5, 0x93, 0xe0, 0x68, 0x62, 0x92, 0x04, 0x91, 0xd4, 0xff, 0xdf,
-	0x36, 0x48, 0xe0, 0x18, 0x38, 0x60, 0xae, 0x18, 0xb6, 0xcb, 0x7b, 0x34, 0x78, 0x50, 0x3e, 0x91,
-	0xda, 0xfa, 0x33, 0xf4, 0xc0, 0x83, 0x53, 0x04, 0xa0, 0x56, 0x5f, 0x76, 0x6c, 0xb3, 0xf2, 0x10,
-	0x35, 0x79, 0x5d, 0x9d, 0x54, 0x5b, 0x63, 0x5b, 0x0d, 0xc6, 0xb6, 0xba, 0xc8, 0x16, 0xdd, 0x45,
-	0xcd, 0xd2, 0xfe, 0x8d, 0xad, 0xc2, 0x80, 0x9e, 0xab, 0x89, 0x17, 0xf0, 0x14, 0x00, 0xb8, 0x46,
-	0x6d, 0xb7, 0x5a, 0xc1, 0x75, 0xca, 0xdc, 0x0f, 0xe9, 0xb9, 0xe0, 0xcd, 0x42, 0x9d, 0x2a, 0x26,
-	0x28, 0x24, 0x10, 0x88, 0x4e, 0xd8, 0x33, 0x9e, 0x1f, 0x82, 0xd3, 0xd9, 0x4e, 0x38, 0xd5, 0x13,
-	0x20, 0x67, 0x62, 0x97, 0x44, 0x77, 0x1f, 0x32, 0xf9, 0xba, 0x6e, 0x24, 0x3e, 0x93, 0xc0, 0x44,
-	0xfb, 0xc4, 0xe7, 0xa1, 0x24, 0xa5, 0xe6, 0x0d, 0x1f, 0xc3, 0xfc, 0x9c, 0xa0, 0x13, 0x42, 0x94,
-	0x22, 0x10, 0xf7, 0xac, 0xdd, 0x7e, 0x96, 0xc0, 0x85, 0x1e, 0xa0, 0x70, 0xd2, 0x4b, 0x91, 0xc3,
-	0x88, 0xb1, 0xf7, 0xcf, 0x5f, 0xde, 0x00, 0x13, 0x1d, 0x1b, 0x80, 0xef, 0xb9, 0x68, 0xd8, 0x5e,
-	0xeb, 0xd8, 0x12, 0x8e, 0xf6, 0xae, 0x05, 0xbe, 0x91, 0xc0, 0x68, 0x8a, 0xcb, 0xbe, 0x6a, 0x62,
-	0x36, 0x56, 0xc4, 0x83, 0xdd, 0x8b, 0x38, 0xbb, 0x7c, 0xf7, 0xb5, 0x67, 0xfe, 0xf3, 0x8c, 0x70,
-	0xb3, 0xd3, 0xfb, 0x39, 0xa7, 0x7e, 0x53, 0x02, 0x93, 0xbd, 0x60, 0xe1, 0xb9, 0x7f, 0x0f, 0x8c,
-	0xc6, 0x73, 0xdf, 0x52, 0x22, 0xc3, 0xd3, 0x17, 0xba, 0x26, 0xdf, 0xdf, 0x95, 0x65, 0xff, 0x28,
-	0x6e, 0xf7, 0xb5, 0x77, 0xe9, 0xff, 0x18, 0x8c, 0xa5, 0xf9, 0xec, 0x2b, 0xfd, 0xb1, 0xc6, 0x1e,
-	0xec, 0xd8, 0xd8, 0x89, 0xf4, 0x5e, 0x05, 0x4a, 0x42, 0xc9, 0x95, 0x9a, 0x0b, 0x35, 0x3a, 0xef,
-	0x16, 0x97, 0xca, 0x22, 0xad, 0x23, 0x60, 0x9f, 0x38, 0x76, 0x73, 0xba, 0xff, 0x53, 0xb9, 0x03,
-	0xce, 0x76, 0xb4, 0xe3, 0x29, 0x38, 0x1b, 0x91, 0x5f, 0x8e, 0x4d, 0x28, 0x97, 0x81, 0xa1, 0xc8,
-	0xba, 0x67, 0x13, 0xaa, 0xcc, 0x72, 0x09, 0x50, 0x74, 0x9c, 0xe2, 0x52, 0x99, 0x6d, 0x13, 0x7c,
-	0x15, 0xee, 0x65, 0x30, 0x24, 0x0c, 0xc4, 0xe0, 0x12, 0xcf, 0xca, 0x2c, 0x3f, 0xff, 0x52, 0x8c,
-	0x39, 0x86, 0xe3, 0x60, 0xc8, 0xd7, 0x24, 0x11, 0xf7, 0x07, 0x8d, 0x06, 0x61, 0x9e, 0x5d, 0x30,
-	0xca, 0xc7, 0x26, 0x7d, 0x1e, 0x92, 0x63, 0xfa, 0x8b, 0x51, 0x70, 0x80, 0x39, 0x84, 0x5f, 0x4b,
-	0xe0, 0x68, 0x6c, 0x62, 0xb3, 0x83, 0x35, 0x7d, 0xfb, 0xe4, 0x25, 0x41, 0x3e, 0xd3, 0x11, 0x87,
-	0xbf, 0x4a, 0xb9, 0xf6, 0xe9, 0x6f, 0x7f, 0x3d, 0x1d, 0x9c, 0x81, 0xd3, 0x5a, 0xda, 0xb5, 0x26,
-	0xe4, 0xe7, 0x0b, 0x02, 0x6d, 0x2d, 0xa6, 0x91, 0xd7, 0xe1, 0xb7, 0x02, 0x5d, 0x34, 0xbd, 0xf0,
-	0x52, 0xaa, 0xd3, 0xac, 0xdb, 0x86, 0xac, 0xf6, 0xba, 0x3c, 0xc8, 0x93, 0x32, 0xc9, 0x00, 0xbf,
-	0x04, 0x95, 0x54, 0xc0, 0xbe, 0x84, 0xc1, 0x21, 0x94, 0x5f, 0xda, 0x65, 0x0f, 0x1f, 0x9d, 0xb7,
-	0xb0, 0xc7, 0xa7, 0x00, 0x7c, 0x25, 0xdb, 0x7d, 0xba, 0xdc, 0x90, 0xa7, 0xfa, 0xb0, 0xe0, 0x98,
-	0xef, 0x30, 0xcc, 0x73, 0xb0, 0xd4, 0x39, 0xc8, 0xe2, 0xe4, 0x89, 0x06, 0x9a, 0x37, 0xf5, 0xba,
-	0xb6, 0xc6, 0x86, 0xe4, 0x3a, 0xdc, 0x92, 0x78, 0x2f, 0xa6, 0x1c, 0xe2, 0x11, 0x5e, 0x33, 0xbd,
-	0xa1, 0x8c, 0x4b, 0x0c, 0xf9, 0x4a, 0x9f, 0x56, 0x9c, 0xdf, 0x5d, 0xc6, 0xef, 0x26, 0xbc, 0xd1,
-	0x03, 0x3f, 0x9f, 0x4d, 0x47, 0x82, 0x7f, 0x48, 0xe0, 0x4c, 0xd7, 0x93, 0x1b, 0x5e, 0xef, 0xa9,
-	0x6c, 0xb2, 0xc4, 0x87, 0xfc, 0xc6, 0x6e, 0xcd, 0x39, 0xe3, 0x59, 0xc6, 0xf8, 0x0a, 0xbc, 0xdc,
-	0xb5, 0x0a, 0x5b, 0x7a, 0x22, 0x64, 0xf8, 0xb7, 0x04, 0x8e, 0xa5, 0xde, 0xca, 0x60, 0x0f, 0xb5,
-	0xd5, 0x76, 0x97, 0x92, 0xa7, 0xfb, 0x31, 0xe1, 0xe8, 0x3d, 0x86, 0xde, 0x81, 0x1f, 0xa5, 0xa2,
-	0x4f, 0xb5, 0x8d, 0xa6, 0x2c, 0x98, 0x75, 0x6a, 0x7c, 0x1a, 0xa4, 0x2c, 0x88, 0xdc, 0xf1, 0xd6,
-	0xe1, 0x53, 0x09, 0x8c, 0xb4, 0xdf, 0x07, 0xe1, 0xc5, 0x0e, 0x69, 0x48, 0x5c, 0x1b, 0x65, 0x25,
-	0x75, 0xf5, 0x1c, 0x32, 0xd9, 0xaa, 0x5b, 0x36, 0x72, 0x2c, 0xe5, 0x12, 0xa3, 0x76, 0x1e, 0xbe,
-	0x9c, 0x4d, 0x2d, 0x0a, 0xe0, 0x1f, 0x09, 0xbc, 0x90, 0x7e, 0x31, 0x82, 0x3d, 0x04, 0xb6, 0xfd,
-	0x16, 0x2a, 0x5f, 0xee, 0xcb, 0x86, 0x67, 0x83, 0x30, 0xc8, 0xab, 0xf0, 0x61, 0xf7, 0x6c, 0x84,
-	0xc6, 0xcf, 0x9c, 0x8e, 0x1d, 0x29, 0x79, 0xa4, 0x27, 0x45, 0x12, 0xec, 0xbd, 0x4f, 0x52, 0x95,
-	0x9e, 0xfc, 0xe6, 0xae, 0xed, 0x79, 0x70, 0x5e, 0x67, 0xc1, 0xb9, 0x0a, 0x67, 0x7a, 0x6c, 0x34,
-	0x26, 0xde, 0xc2, 0x4e, 0xdb, 0x90, 0x5a, 0xa2, 0x21, 0x3c, 0x4a, 0xee, 0xdb, 0x74, 0x45, 0x48,
-	0x10, 0xf8, 0x6a, 0x6f, 0xc7, 0x4f, 0x42, 0xec, 0xc8, 0xaf, 0xf5, 0x6f, 0xc8, 0x29, 0xcd, 0x30,
-	0x4a, 0x2a, 0xbc, 0x98, 0x31, 0x2d, 0xa9, 0x16, 0x13, 0x43, 0xda, 0x9a, 0xd1, 0x20, 0xeb, 0xf0,
-	0x7b, 0x51, 0xa9, 0x09, 0x09, 0xd3, 0xa9, 0x52, 0xb3, 0xc4, 0x52, 0xa7, 0x4a, 0xcd, 0xd4, 0x48,
-	0x3d, 0x20, 0x17, 0x12, 0xaa, 0x55, 0x7e, 0xeb, 0xf0, 0x47, 0x09, 0x1c, 0x8a, 0xea, 0x27, 0x38,
-	0xd1, 0xa9, 0x4b, 0xa2, 0x12, 0x4b, 0xce, 0x67, 0x08, 0x18, 0x8a, 0x2c, 0xa6, 0x5e, 0x10, 0x03,
-	0x54, 0x81, 0x1f, 0x64, 0x01, 0x4a, 0x08, 0x97, 0xdd, 0x34, 0x4b, 0xe9, 0xde, 0xc6, 0x76, 0x5e,
-	0xda, 0xdc, 0xce, 0x4b, 0x7f, 0x6e, 0xe7, 0xa5, 0x2f, 0x77, 0xf2, 0x03, 0x9b, 0x3b, 0xf9, 0x81,
-	0xdf, 0x77, 0xf2, 0x03, 0xef, 0x4f, 0x57, 0x6d, 0xba, 0x52, 0x5f, 0x56, 0x4d, 0xbc, 0xaa, 0xdd,
-	0x0c, 0x20, 0xbc, 0x85, 0xe8, 0x63, 0xec, 0xb5, 0x9a, 0xf9, 0x49, 0x0b, 0x13, 0x6d, 0xd6, 0x10,
-	0x59, 0xfe, 0x1f, 0xfb, 0x5f, 0xf7, 0xf2, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x78, 0xe6,
-	0x95, 0xc6, 0x16, 0x00, 0x00,
+	// 2046 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcf, 0x6f, 0x1c, 0x49,
+	0x15, 0x4e, 0x3b, 0x59, 0xc7, 0x53, 0xf1, 0x7a, 0x93, 0xb2, 0x13, 0x9c, 0x4e, 0xe2, 0x49, 0x3a,
+	0x90, 0x38, 0x26, 0x9e, 0x8e, 0x1d, 0x6f, 0x60, 0x37, 0xbb, 0xa0, 0x99, 0xcc, 0x66, 0xe5, 0x24,
+	0xbb, 0x31, 0x33, 0x8a, 0x17, 0x90, 0x60, 0xd4, 0xd3, 0x5d, 0x99, 0x34, 0xee, 0xe9, 0x9e, 0x74,
+	0xd5, 0x4c, 0x32, 0xb2, 0x8c, 0x56, 0x48, 0x48, 0x70, 0x43, 0xec, 0x11, 0xc1, 0x81, 0x03, 0x07,
+	0x4e, 0x1c, 0x56, 0x80, 0xc4, 0x81, 0x3d, 0xe6, 0xc0, 0x21, 0x2c, 0x17, 0xc4, 0xc1, 0x20, 0x87,
+	0xbf, 0x80, 0x03, 0x57, 0x50, 0x57, 0xbf, 0xea, 0xe9, 0xdf, 0x33, 0xe3, 0x38, 0x7b, 0xf2, 0x74,
+	0x77, 0xbd, 0x7a, 0xdf, 0xf7, 0xde, 0xab, 0x57, 0xef, 0x3d, 0xa3, 0x22, 0x79, 0xea, 0xe8, 0x8e,
+	0x4b, 0x54, 0xa7, 0x43, 0x5c, 0x8d, 0x39, 0xae, 0xda, 0x5b, 0x51, 0x1f, 0x77, 0x89, 0xdb, 0x2f,
+	0x75, 0x5c, 0x87, 0x39, 0x78, 0x16, 0x16, 0x94, 0xc4, 0x82, 0x52, 0x6f, 0x45, 0x5e, 0xd2, 0x1d,
+	0xda, 0x76, 0xa8, 0xda, 0xd4, 0x28, 0xf1, 0x57, 0xab, 0xbd, 0x95, 0x26, 0x61, 0xda, 0x8a, 0xda,
+	0xd1, 0x5a, 0xa6, 0xad, 0x31, 0xd3, 0xb1, 0xfd, 0x0d, 0xe4, 0xd3, 0xfe, 0xda, 0x06, 0x7f, 0x52,
+	0xfd, 0x07, 0xf8, 0x74, 0x3e, 0x4d, 0x79, 0x47, 0x73, 0xb5, 0xb6, 0x58, 0x71, 0x36, 0x6d, 0x05,
+	0x7b, 0x0a, 0x5f, 0xe7, 0x5a, 0x4e, 0xcb, 0xf1, 0xf7, 0xf5, 0x7e, 0x09, 0x99, 0x96, 0xe3, 0xb4,
+	0x2c, 0xa2, 0x6a, 0x1d, 0x53, 0xd5, 0x6c, 0xdb, 0x61, 0x1c, 0x4d, 0xb0, 0x23, 0x23, 0xb6, 0x41,
+	0xdc, 0xb6, 0x69, 0x33, 0x55, 0x77, 0xfb, 0x1d, 0xe6, 0xa8, 0x5b, 0xa4, 0x0f, 0x5f, 0x95, 0x3a,
+	0xc2, 0xef, 0x13, 0x76, 0x1f, 0x94, 0xad, 0xdb, 0x0f, 0x9d, 0x1a, 0x79, 0x8c, 0xdf, 0x45, 0xaf,
+	0x0b, 0xfd, 0x0d, 0xcd, 0x30, 0xdc, 0x79, 0xe9, 0xbc, 0xb4, 0x58, 0xa8, 0xcc, 0x7f, 0xfe, 0xe9,
+	0xf2, 0x1c, 0x10, 0x2a, 0x1b, 0x86, 0x4b, 0x28, 0xad, 0x33, 0xd7, 0xb4, 0x5b, 0xb5, 0x69, 0xb1,
+	0xdc, 0x7b, 0xad, 0x34, 0xd1, 0xfc, 0xb7, 0x3c, 0x1b, 0x95, 0x2d, 0x4b, 0xec, 0x4c, 0x6b, 0xe4,
+	0x71, 0x97, 0x50, 0x86, 0x6f, 0x23, 0x34, 0xb0, 0x18, 0xdf, 0xf7, 0xd8, 0xea, 0xa5, 0x12, 0x6c,
+	0xea, 0x99, 0xb7, 0xe4, 0x3b, 0x03, 0xcc, 0x5b, 0xda, 0xd0, 0x5a, 0x04, 0x64, 0x6b, 0x21, 0x49,
+	0xe5, 0xe7, 0x12, 0x3a, 0x9d, 0xa2, 0x84, 0x76, 0x1c, 0x9b, 0x12, 0x7c, 0x15, 0xe1, 0x01, 0x01,
+	0x5d, 0xe7, 0x24, 0xe8, 0xbc, 0x74, 0xfe, 0xf0, 0x62, 0xa1, 0x76, 0x3c, 0xc0, 0xaa, 0xeb, 0x1e,
+	0x5c, 0x8a, 0xdf, 0x8f, 0x60, 0x9a, 0xe0, 0x98, 0x2e, 0x0f, 0xc5, 0xe4, 0xab, 0x8a, 0x80, 0x62,
+	0x08, 0x0b, 0x2c, 0xe5, 0xcd, 0x3a, 0x98, 0xe8, 0x25, 0xad, 0x89, 0x8b, 0xe8, 0x98, 0xd6, 0xa3,
+	0x5c, 0x92, 0x50, 0xca, 0xe1, 0x15, 0x6a, 0x48, 0xeb, 0x51, 0x10, 0x52, 0x9e, 0xa0, 0xb3, 0xdc,
+	0x12, 0x42, 0xf5, 0x83, 0x7a, 0x75, 0x53, 0xb3, 0xba, 0xc2, 0x6c, 0xf8, 0x23, 0x74, 0x7c, 0xa0,
+	0xdf, 0x36, 0x1a, 0x5a, 0x8f, 0x82, 0xe1, 0x2f, 0x97, 0x52, 0x82, 0xbd, 0x94, 0xa4, 0x50, 0x39,
+	0xf2, 0x7c, 0xb7, 0x28, 0xd5, 0x66, 0x02, 0x5c, 0xb6, 0x51, 0xee, 0x51, 0xa5, 0x8f, 0xce, 0x65,
+	0x28, 0x06, 0x37, 0x7c, 0x1b, 0xa1, 0x2e, 0x35, 0x1a, 0x3d, 0xef, 0xa5, 0xd0, 0xb9, 0x94, 0xab,
+	0xf3, 0x7e, 0x87, 0x11, 0x43, 0xec, 0x53, 0x79, 0x7d, 0x6f, 0xb7, 0x58, 0x10, 0x4f, 0xb4, 0x56,
+	0xe8, 0x52, 0xc3, 0xff, 0xa9, 0xdc, 0x41, 0x5f, 0xf2, 0xbd, 0xbf, 0x59, 0x8f, 0xd3, 0x55, 0xa3,
+	0xf6, 0xf2, 0x8d, 0x3d, 0xb3, 0xb7, 0x5b, 0x44, 0x03, 0x42, 0x11, 0xfb, 0x7d, 0x26, 0xc5, 0x78,
+	0xd4, 0x2d, 0x8d, 0x3e, 0x82, 0xb3, 0xf0, 0x4a, 0x2d, 0x18, 0x3b, 0x0d, 0x13, 0xfb, 0x3e, 0x0d,
+	0xdb, 0xe8, 0x64, 0x02, 0x7c, 0xa5, 0xbf, 0x5e, 0xc5, 0x97, 0xd0, 0x14, 0xf5, 0x5e, 0x34, 0x4c,
+	0x03, 0x2c, 0x71, 0x6c, 0x6f, 0xb7, 0x78, 0xd4, 0x5f, 0x54, 0xad, 0x1d, 0xe5, 0x1f, 0xd7, 0x0d,
+	0xfc, 0x36, 0x3a, 0x62, 0xda, 0x0f, 0x9d, 0x00, 0x42, 0x1e, 0xab, 0x81, 0x79, 0xb8, 0x8c, 0xf2,
+	0x27, 0x09, 0x2d, 0x64, 0xd9, 0x0f, 0x02, 0x61, 0x03, 0xcd, 0x68, 0x96, 0xd5, 0x00, 0x28, 0x9e,
+	0x22, 0xef, 0x2c, 0x0e, 0x0b, 0x86, 0x08, 0x95, 0xda, 0xb4, 0x66, 0x59, 0xc1, 0x9b, 0x83, 0x3b,
+	0xb3, 0x3f, 0x96, 0x90, 0xcc, 0xd1, 0x6f, 0x10, 0xdb, 0x30, 0xed, 0x16, 0x57, 0x41, 0x82, 0x7c,
+	0x55, 0x4c, 0x89, 0xa6, 0x70, 0xf4, 0x1c, 0x98, 0x0b, 0xff, 0x28, 0xa1, 0x33, 0xa9, 0x38, 0x02,
+	0x13, 0xbe, 0xd1, 0xf1, 0xbf, 0xf8, 0x66, 0x24, 0x14, 0x6c, 0x78, 0x21, 0xd5, 0x86, 0xe1, 0x5d,
+	0x2a, 0x47, 0x9e, 0xed, 0x16, 0x0f, 0xd5, 0x66, 0x3a, 0x91, 0x9d, 0x0f, 0xce, 0x84, 0xb7, 0x20,
+	0x15, 0x6f, 0xb8, 0xa6, 0x4e, 0x2a, 0x2e, 0xd1, 0xb6, 0xc8, 0x20, 0xe1, 0x5f, 0x42, 0x53, 0x1a,
+	0xa5, 0x84, 0xc5, 0x22, 0xb0, 0xec, 0xbd, 0xf3, 0x22, 0x90, 0x7f, 0x5c, 0x37, 0x94, 0xa6, 0x70,
+	0x43, 0x74, 0x13, 0x60, 0x5f, 0x45, 0x93, 0x94, 0x69, 0x2c, 0x20, 0x9d, 0x1e, 0xa1, 0x61, 0xd9,
+	0xba, 0xb7, 0x1c, 0x98, 0x83, 0xac, 0xc2, 0xe0, 0xa0, 0x3f, 0xb0, 0x9b, 0x0e, 0x37, 0xc5, 0x07,
+	0x1a, 0xeb, 0xba, 0x26, 0xeb, 0x0b, 0xb0, 0x6b, 0x68, 0x4a, 0xec, 0x37, 0x34, 0x4b, 0x07, 0x2b,
+	0xf1, 0x39, 0x84, 0x5c, 0xa2, 0x3b, 0xae, 0xd1, 0xd8, 0x22, 0x7d, 0x48, 0xd0, 0x05, 0xff, 0xcd,
+	0x5d, 0xd2, 0x57, 0x7e, 0x27, 0xce, 0x47, 0x8a, 0x5a, 0xa0, 0xb7, 0x8a, 0x4e, 0xea, 0x4e, 0xbb,
+	0x63, 0x11, 0x46, 0x1a, 0x4d, 0xcb, 0xd1, 0xb7, 0x1a, 0x76, 0xb7, 0xdd, 0x24, 0x3e, 0x88, 0x23,
+	0xb5, 0x59, 0xf1, 0xb1, 0xe2, 0x7d, 0xfb, 0x90, 0x7f, 0xc2, 0x15, 0x34, 0xd5, 0x86, 0x7d, 0x72,
+	0x8f, 0x6d, 0x52, 0x6b, 0x20, 0x87, 0x4f, 0xa1, 0x49, 0x97, 0x68, 0xd4, 0xb1, 0xe7, 0x0f, 0x73,
+	0xd4, 0xf0, 0xa4, 0xcc, 0x21, 0xec, 0x3b, 0x83, 0xd7, 0x26, 0x60, 0x1d, 0x65, 0x03, 0xcd, 0x46,
+	0xde, 0x02, 0xf8, 0xb7, 0xd0, 0xa4, 0x5f, 0xc3, 0x40, 0x4e, 0x3c, 0x93, 0xee, 0x1b, 0xbe, 0x44,
+	0x38, 0xc4, 0x17, 0x50, 0x1a, 0x10, 0xf3, 0xe2, 0xc4, 0xdf, 0x72, 0x6c, 0x7a, 0x97, 0x04, 0xee,
+	0x58, 0x42, 0x27, 0x12, 0xd7, 0x38, 0x1c, 0xc1, 0x37, 0x62, 0xb7, 0x38, 0x9e, 0x43, 0xaf, 0xe9,
+	0x8f, 0x34, 0xd3, 0x06, 0xfb, 0xfb, 0x0f, 0xca, 0xc7, 0x52, 0xec, 0x72, 0x0c, 0x34, 0x00, 0xf8,
+	0x32, 0x42, 0x9d, 0x6e, 0xd3, 0x32, 0x75, 0xee, 0x3b, 0x9f, 0xc0, 0xd9, 0xd2, 0xa0, 0x66, 0x2a,
+	0xf9, 0x35, 0x53, 0x69, 0x83, 0x2f, 0xba, 0x4b, 0xfa, 0xc0, 0xa0, 0xd0, 0x11, 0x2f, 0x3c, 0xf7,
+	0x3b, 0x1d, 0xe6, 0x1d, 0x4c, 0xa7, 0xcb, 0xb8, 0xfa, 0xa9, 0x5a, 0xc1, 0x7f, 0x73, 0xbf, 0xcb,
+	0x14, 0x1d, 0x15, 0x13, 0x08, 0xc4, 0x35, 0x74, 0x60, 0x3c, 0xbf, 0x8f, 0xce, 0x67, 0x2b, 0x01,
+	0xaa, 0x67, 0x50, 0x41, 0x77, 0x6c, 0x1a, 0xde, 0x7d, 0x4a, 0x87, 0x75, 0xc3, 0x48, 0xfc, 0x44,
+	0x42, 0x8b, 0xf1, 0x72, 0x0b, 0x4c, 0x49, 0x2b, 0xfd, 0x5b, 0x1e, 0x86, 0xf5, 0xaa, 0xa0, 0x13,
+	0x40, 0x94, 0x42, 0x10, 0x0f, 0x2c, 0x51, 0xfe, 0x45, 0x42, 0x57, 0x46, 0x80, 0x02, 0xa4, 0x37,
+	0x43, 0x95, 0x20, 0x67, 0xef, 0x15, 0xbf, 0x90, 0x44, 0x16, 0x73, 0x6f, 0x1f, 0xd8, 0x73, 0x43,
+	0x33, 0xdd, 0x41, 0xcd, 0x28, 0x14, 0x1d, 0x5c, 0xf2, 0xfc, 0xa5, 0x84, 0x66, 0x53, 0x54, 0x8e,
+	0x15, 0x13, 0x37, 0x23, 0x41, 0x3c, 0x31, 0x3c, 0x88, 0xb3, 0xc3, 0xf7, 0x70, 0xdc, 0xf3, 0x3f,
+	0xcd, 0x30, 0x37, 0x2f, 0x9d, 0xbf, 0x60, 0xd7, 0x3f, 0x97, 0xd0, 0xd2, 0x28, 0x58, 0xc0, 0xf7,
+	0xdf, 0x41, 0xb3, 0x51, 0xdf, 0x0f, 0xda, 0x80, 0x63, 0xab, 0x57, 0x86, 0x3a, 0xdf, 0xdb, 0x95,
+	0x7b, 0xff, 0x84, 0x13, 0xd7, 0x75, 0x70, 0xee, 0xff, 0x21, 0x9a, 0x4b, 0xd3, 0x39, 0x96, 0xfb,
+	0x23, 0x07, 0x7b, 0x22, 0xf7, 0x60, 0x27, 0xdc, 0x7b, 0x03, 0x29, 0x89, 0x36, 0xaa, 0xd2, 0xbf,
+	0xdf, 0x61, 0xeb, 0x76, 0x79, 0xb3, 0x2e, 0xdc, 0x7a, 0x1c, 0x1d, 0x16, 0x35, 0x6f, 0xa1, 0xe6,
+	0xfd, 0x54, 0xee, 0xa0, 0x8b, 0xb9, 0x72, 0xe0, 0x82, 0x8b, 0xa1, 0xde, 0xc7, 0x32, 0x29, 0x83,
+	0x1e, 0x2c, 0xe8, 0x70, 0xee, 0x99, 0x94, 0x29, 0x37, 0xe1, 0x5a, 0x2e, 0x5b, 0x56, 0x79, 0xb3,
+	0xce, 0xb7, 0xf1, 0xbf, 0x0a, 0xf5, 0x72, 0xfc, 0x5a, 0x1e, 0x5c, 0xbe, 0xca, 0x4d, 0xb8, 0x5c,
+	0x53, 0x84, 0x01, 0xc3, 0x69, 0x34, 0xe5, 0x95, 0x70, 0x21, 0xf5, 0x47, 0xb5, 0x1e, 0xe5, 0x9a,
+	0x6d, 0xb8, 0xd1, 0x38, 0xee, 0x57, 0x5f, 0xef, 0x2b, 0xdb, 0x90, 0xa6, 0xeb, 0xc4, 0x7a, 0x58,
+	0x25, 0x16, 0x69, 0xf1, 0x20, 0xf0, 0x4a, 0x95, 0x2e, 0x7d, 0xe5, 0xca, 0x3f, 0x9b, 0x40, 0x17,
+	0x72, 0xb4, 0x83, 0xb5, 0x5c, 0x34, 0x43, 0x89, 0xf5, 0xb0, 0x11, 0x34, 0x6e, 0x50, 0x08, 0xdd,
+	0xf3, 0xae, 0xbd, 0x7f, 0xec, 0x16, 0x2f, 0xb5, 0x4c, 0xf6, 0xa8, 0xdb, 0x2c, 0xe9, 0x4e, 0x1b,
+	0x86, 0x1b, 0xf0, 0x67, 0x99, 0x1a, 0x5b, 0x2a, 0xeb, 0x77, 0x08, 0x2d, 0x55, 0x89, 0xbe, 0xb7,
+	0x5b, 0x9c, 0xf6, 0xf4, 0x88, 0xee, 0xec, 0xf3, 0x4f, 0x97, 0x11, 0x1c, 0x8f, 0x2a, 0xd1, 0x6b,
+	0xd3, 0x9e, 0x8e, 0x07, 0xd0, 0xce, 0x61, 0x0b, 0xcd, 0xb6, 0x4d, 0xbb, 0xc1, 0xf5, 0x1a, 0x01,
+	0x30, 0x3f, 0x94, 0x2b, 0xef, 0x8c, 0xa7, 0x38, 0xa6, 0xe8, 0x44, 0xdb, 0xb4, 0xa3, 0x7c, 0xf1,
+	0x5b, 0xe8, 0x74, 0x93, 0x58, 0xce, 0x93, 0x46, 0x9a, 0x4e, 0xff, 0x80, 0x9c, 0xe2, 0x0b, 0x3e,
+	0x88, 0x8b, 0xae, 0xfe, 0x5a, 0x46, 0xaf, 0x71, 0x13, 0xe2, 0x5f, 0x48, 0xe8, 0x44, 0xe4, 0xc6,
+	0xe5, 0x5d, 0x49, 0xba, 0x87, 0x92, 0x13, 0x16, 0xf9, 0x42, 0xae, 0x2b, 0xbd, 0x55, 0xca, 0xdb,
+	0x3f, 0xfa, 0xdb, 0xbf, 0x3f, 0x99, 0x58, 0xc3, 0xab, 0x6a, 0xda, 0x4c, 0x28, 0x08, 0x11, 0xaf,
+	0x9b, 0x52, 0xb7, 0x23, 0x03, 0x86, 0x1d, 0xfc, 0x2b, 0x81, 0x2e, 0x7c, 0x3c, 0xf1, 0x72, 0xaa,
+	0xd2, 0xac, 0x51, 0x8d, 0x5c, 0x1a, 0x75, 0xb9, 0x1f, 0x39, 0xca, 0x12, 0x07, 0xfc, 0x65, 0xac,
+	0xa4, 0x02, 0xf6, 0xfa, 0x3f, 0x27, 0x80, 0xf2, 0xd7, 0x78, 0xcf, 0x08, 0x57, 0xdf, 0x6d, 0xc7,
+	0x85, 0x2c, 0x8e, 0xaf, 0x65, 0xab, 0x4f, 0x2f, 0x17, 0xe5, 0x95, 0x31, 0x24, 0x00, 0xf3, 0x1d,
+	0x8e, 0xb9, 0x8a, 0x2b, 0xf9, 0x46, 0x16, 0x95, 0x43, 0xd8, 0xd0, 0x90, 0x94, 0x77, 0xd4, 0x6d,
+	0x7e, 0xc9, 0xed, 0xe0, 0x5d, 0x09, 0x72, 0x69, 0x4a, 0x11, 0x16, 0xe2, 0xb5, 0x36, 0x1a, 0xca,
+	0x68, 0x89, 0x28, 0xbf, 0x39, 0xa6, 0x14, 0xf0, 0xbb, 0xcb, 0xf9, 0xbd, 0x87, 0x6f, 0x8d, 0xc0,
+	0xcf, 0x63, 0x93, 0x4b, 0xf0, 0x9f, 0x12, 0x24, 0x90, 0xbc, 0xca, 0x0b, 0xbf, 0x3b, 0x52, 0xd8,
+	0x64, 0x15, 0x8f, 0xf2, 0x37, 0xf6, 0x2b, 0x0e, 0x8c, 0x6f, 0x72, 0xc6, 0x6f, 0xe2, 0xeb, 0x43,
+	0xa3, 0x70, 0x50, 0x0f, 0x06, 0x0c, 0xff, 0x23, 0xa1, 0x93, 0xa9, 0x23, 0x2d, 0x3c, 0x42, 0x6c,
+	0xc5, 0x06, 0x51, 0xf2, 0xea, 0x38, 0x22, 0x80, 0xde, 0xe5, 0xe8, 0x2d, 0xfc, 0x83, 0x54, 0xf4,
+	0xa9, 0xb2, 0x61, 0x97, 0xf9, 0xd7, 0x45, 0x29, 0x9a, 0x0d, 0x52, 0x16, 0x84, 0x46, 0x1a, 0x3b,
+	0xf8, 0x13, 0x09, 0x1d, 0x8f, 0x0f, 0xd3, 0xf0, 0xd5, 0x1c, 0x37, 0x24, 0x66, 0x6e, 0xb2, 0x92,
+	0xba, 0xba, 0x4a, 0x74, 0xbe, 0xea, 0xb6, 0x49, 0x2c, 0x43, 0x59, 0xe6, 0xd4, 0x2e, 0xe3, 0xaf,
+	0x64, 0x53, 0x0b, 0x03, 0xf8, 0xaf, 0x84, 0x4e, 0xa5, 0x4f, 0x95, 0xf0, 0x08, 0x86, 0x8d, 0x8f,
+	0xf0, 0xe4, 0xeb, 0x63, 0xc9, 0x80, 0x37, 0x28, 0x87, 0xdc, 0xc6, 0x5b, 0xc3, 0xbd, 0x11, 0x08,
+	0xbf, 0xb4, 0x3b, 0x7e, 0x2b, 0x89, 0x36, 0x3b, 0x3a, 0xae, 0x51, 0xb3, 0x19, 0xa4, 0x8e, 0xae,
+	0xe4, 0x6b, 0xa3, 0x0b, 0x00, 0xdf, 0x6b, 0x9c, 0xef, 0x12, 0x5e, 0xcc, 0xe6, 0x1b, 0x03, 0xf5,
+	0x1b, 0x49, 0x4c, 0x0a, 0xc2, 0x63, 0x1b, 0x9c, 0x73, 0x75, 0xa4, 0x0d, 0x89, 0x64, 0x75, 0xe4,
+	0xf5, 0x80, 0x54, 0xe5, 0x48, 0xaf, 0xe0, 0xcb, 0x39, 0x48, 0x23, 0x88, 0x7e, 0x2f, 0xc2, 0x29,
+	0x31, 0x0e, 0xc9, 0x0b, 0xa7, 0xac, 0x41, 0x51, 0x5e, 0x38, 0x65, 0x4e, 0x79, 0x94, 0xeb, 0x1c,
+	0xf4, 0x32, 0xfe, 0x6a, 0x36, 0xe8, 0x24, 0xba, 0x8f, 0x25, 0x34, 0xe9, 0xcf, 0x4e, 0x32, 0xaa,
+	0x8b, 0xe4, 0xa0, 0x46, 0x5e, 0x1c, 0xbe, 0x10, 0x20, 0x5d, 0xe4, 0x90, 0xce, 0xe1, 0x33, 0xa9,
+	0x90, 0x40, 0xef, 0x0b, 0x29, 0xd9, 0x24, 0x24, 0xdb, 0x2e, 0x3c, 0x7a, 0xe6, 0x4e, 0xed, 0x1d,
+	0xe5, 0x6f, 0xee, 0x5b, 0x1e, 0xc8, 0xbc, 0xc3, 0xc9, 0xdc, 0xc0, 0x6b, 0x23, 0xa6, 0x7e, 0xde,
+	0x0e, 0x06, 0xb9, 0xff, 0x99, 0x34, 0x68, 0x43, 0x82, 0xe2, 0xe6, 0x23, 0x93, 0x3d, 0x12, 0x4d,
+	0x0d, 0xfe, 0xda, 0x68, 0x05, 0x51, 0xa2, 0x7d, 0x92, 0xbf, 0x3e, 0xbe, 0x20, 0x50, 0x5a, 0xe3,
+	0x94, 0x4a, 0xf8, 0x6a, 0xc6, 0xfd, 0xcd, 0xd4, 0x48, 0x7b, 0xa5, 0x6e, 0x6b, 0x3d, 0xba, 0x83,
+	0xff, 0x20, 0x82, 0x3d, 0xd1, 0x14, 0xe5, 0x05, 0x7b, 0x56, 0xfb, 0x95, 0x17, 0xec, 0x99, 0x5d,
+	0xd7, 0x08, 0xc8, 0x45, 0x53, 0x36, 0x48, 0x88, 0x3b, 0xf8, 0xcf, 0x12, 0x9a, 0x0e, 0x77, 0x64,
+	0x78, 0x31, 0x2f, 0x6f, 0x87, 0x9b, 0x36, 0x79, 0x21, 0xa3, 0xa4, 0x66, 0xc4, 0xe0, 0xf5, 0x34,
+	0xe1, 0x80, 0x1a, 0xf8, 0x7b, 0x59, 0x80, 0x12, 0xa5, 0xf4, 0xbe, 0xd2, 0xf7, 0xff, 0xc4, 0x3f,
+	0x26, 0xd3, 0xba, 0x2c, 0x9c, 0x53, 0xc6, 0xe5, 0xf4, 0x84, 0xf2, 0x8d, 0x71, 0xc5, 0x46, 0xba,
+	0xc0, 0x62, 0xbd, 0x4f, 0x83, 0x72, 0xe1, 0x97, 0xb5, 0x40, 0xe5, 0xde, 0xb3, 0xbd, 0x05, 0xe9,
+	0xf9, 0xde, 0x82, 0xf4, 0xaf, 0xbd, 0x05, 0xe9, 0x67, 0x2f, 0x16, 0x0e, 0x3d, 0x7f, 0xb1, 0x70,
+	0xe8, 0xef, 0x2f, 0x16, 0x0e, 0x7d, 0x77, 0x35, 0xd4, 0xc2, 0xbd, 0xe7, 0x03, 0xfa, 0x90, 0xb0,
+	0x27, 0x8e, 0x3b, 0xc0, 0xf7, 0x74, 0x80, 0x90, 0xb7, 0x74, 0xcd, 0x49, 0xfe, 0x8f, 0xea, 0xeb,
+	0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x48, 0xd4, 0x9e, 0x0b, 0xb9, 0x1f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1510,6 +2054,16 @@ type QueryClient interface {
 	QueryAVSUSDValue(ctx context.Context, in *QueryAVSUSDValueRequest, opts ...grpc.CallOption) (*DecValueField, error)
 	// QueryOperatorSlashInfo queries the slash information for the specified operator and AVS
 	QueryOperatorSlashInfo(ctx context.Context, in *QueryOperatorSlashInfoRequest, opts ...grpc.CallOption) (*QueryOperatorSlashInfoResponse, error)
+	// QueryPendingSlashes queries the non-instantaneous slashes waiting for the end of their
+	// veto window.
+	QueryPendingSlashes(ctx context.Context, in *QueryPendingSlashesRequest, opts ...grpc.CallOption) (*QueryPendingSlashesResponse, error)
+	// QueryPriceBreakers queries the states of the price breakers of the assets.
+	QueryPriceBreakers(ctx context.Context, in *QueryPriceBreakersRequest, opts ...grpc.CallOption) (*QueryPriceBreakersResponse, error)
+	// QueryUnbondingMaturity queries the maturity of an undelegation, which is determined by
+	// the unbonding periods of the AVSs the operator is opted into.
+	QueryUnbondingMaturity(ctx context.Context, in *QueryUnbondingMaturityRequest, opts ...grpc.CallOption) (*QueryUnbondingMaturityResponse, error)
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// QueryAllOperatorConsAddrsByChainID queries all operators and their consensus addresses
 	// for a specific chain ID
 	QueryAllOperatorConsAddrsByChainID(ctx context.Context, in *QueryAllOperatorConsAddrsByChainIDRequest, opts ...grpc.CallOption) (*QueryAllOperatorConsAddrsByChainIDResponse, error)
@@ -1519,6 +2073,9 @@ type QueryClient interface {
 	QueryAllAVSsByOperator(ctx context.Context, in *QueryAllAVSsByOperatorRequest, opts ...grpc.CallOption) (*QueryAllAVSsByOperatorResponse, error)
 	// QueryOptInfo queries specified opted information.
 	QueryOptInfo(ctx context.Context, in *QueryOptInfoRequest, opts ...grpc.CallOption) (*OptedInfo, error)
+	// QuerySelfDelegationStatus queries whether the self delegation of the operator meets the
+	// minimum self delegation of the AVS.
+	QuerySelfDelegationStatus(ctx context.Context, in *QuerySelfDelegationStatusRequest, opts ...grpc.CallOption) (*QuerySelfDelegationStatusResponse, error)
 }
 
 type queryClient struct {
@@ -1601,6 +2158,42 @@ func (c *queryClient) QueryOperatorSlashInfo(ctx context.Context, in *QueryOpera
 	return out, nil
 }
 
+func (c *queryClient) QueryPendingSlashes(ctx context.Context, in *QueryPendingSlashesRequest, opts ...grpc.CallOption) (*QueryPendingSlashesResponse, error) {
+	out := new(QueryPendingSlashesResponse)
+	err := c.cc.Invoke(ctx, "/exocore.operator.v1.Query/QueryPendingSlashes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryPriceBreakers(ctx context.Context, in *QueryPriceBreakersRequest, opts ...grpc.CallOption) (*QueryPriceBreakersResponse, error) {
+	out := new(QueryPriceBreakersResponse)
+	err := c.cc.Invoke(ctx, "/exocore.operator.v1.Query/QueryPriceBreakers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryUnbondingMaturity(ctx context.Context, in *QueryUnbondingMaturityRequest, opts ...grpc.CallOption) (*QueryUnbondingMaturityResponse, error) {
+	out := new(QueryUnbondingMaturityResponse)
+	err := c.cc.Invoke(ctx, "/exocore.operator.v1.Query/QueryUnbondingMaturity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/exocore.operator.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) QueryAllOperatorConsAddrsByChainID(ctx context.Context, in *QueryAllOperatorConsAddrsByChainIDRequest, opts ...grpc.CallOption) (*QueryAllOperatorConsAddrsByChainIDResponse, error) {
 	out := new(QueryAllOperatorConsAddrsByChainIDResponse)
 	err := c.cc.Invoke(ctx, "/exocore.operator.v1.Query/QueryAllOperatorConsAddrsByChainID", in, out, opts...)
@@ -1637,6 +2230,15 @@ func (c *queryClient) QueryOptInfo(ctx context.Context, in *QueryOptInfoRequest,
 	return out, nil
 }
 
+func (c *queryClient) QuerySelfDelegationStatus(ctx context.Context, in *QuerySelfDelegationStatusRequest, opts ...grpc.CallOption) (*QuerySelfDelegationStatusResponse, error) {
+	out := new(QuerySelfDelegationStatusResponse)
+	err := c.cc.Invoke(ctx, "/exocore.operator.v1.Query/QuerySelfDelegationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// QueryOperatorInfo queries the operator information.
@@ -1656,6 +2258,16 @@ type QueryServer interface {
 	QueryAVSUSDValue(context.Context, *QueryAVSUSDValueRequest) (*DecValueField, error)
 	// QueryOperatorSlashInfo queries the slash information for the specified operator and AVS
 	QueryOperatorSlashInfo(context.Context, *QueryOperatorSlashInfoRequest) (*QueryOperatorSlashInfoResponse, error)
+	// QueryPendingSlashes queries the non-instantaneous slashes waiting for the end of their
+	// veto window.
+	QueryPendingSlashes(context.Context, *QueryPendingSlashesRequest) (*QueryPendingSlashesResponse, error)
+	// QueryPriceBreakers queries the states of the price breakers of the assets.
+	QueryPriceBreakers(context.Context, *QueryPriceBreakersRequest) (*QueryPriceBreakersResponse, error)
+	// QueryUnbondingMaturity queries the maturity of an undelegation, which is determined by
+	// the unbonding periods of the AVSs the operator is opted into.
+	QueryUnbondingMaturity(context.Context, *QueryUnbondingMaturityRequest) (*QueryUnbondingMaturityResponse, error)
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// QueryAllOperatorConsAddrsByChainID queries all operators and their consensus addresses
 	// for a specific chain ID
 	QueryAllOperatorConsAddrsByChainID(context.Context, *QueryAllOperatorConsAddrsByChainIDRequest) (*QueryAllOperatorConsAddrsByChainIDResponse, error)
@@ -1665,6 +2277,9 @@ type QueryServer interface {
 	QueryAllAVSsByOperator(context.Context, *QueryAllAVSsByOperatorRequest) (*QueryAllAVSsByOperatorResponse, error)
 	// QueryOptInfo queries specified opted information.
 	QueryOptInfo(context.Context, *QueryOptInfoRequest) (*OptedInfo, error)
+	// QuerySelfDelegationStatus queries whether the self delegation of the operator meets the
+	// minimum self delegation of the AVS.
+	QuerySelfDelegationStatus(context.Context, *QuerySelfDelegationStatusRequest) (*QuerySelfDelegationStatusResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1695,6 +2310,18 @@ func (*UnimplementedQueryServer) QueryAVSUSDValue(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) QueryOperatorSlashInfo(ctx context.Context, req *QueryOperatorSlashInfoRequest) (*QueryOperatorSlashInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryOperatorSlashInfo not implemented")
 }
+func (*UnimplementedQueryServer) QueryPendingSlashes(ctx context.Context, req *QueryPendingSlashesRequest) (*QueryPendingSlashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPendingSlashes not implemented")
+}
+func (*UnimplementedQueryServer) QueryPriceBreakers(ctx context.Context, req *QueryPriceBreakersRequest) (*QueryPriceBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPriceBreakers not implemented")
+}
+func (*UnimplementedQueryServer) QueryUnbondingMaturity(ctx context.Context, req *QueryUnbondingMaturityRequest) (*QueryUnbondingMaturityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryUnbondingMaturity not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (*UnimplementedQueryServer) QueryAllOperatorConsAddrsByChainID(ctx context.Context, req *QueryAllOperatorConsAddrsByChainIDRequest) (*QueryAllOperatorConsAddrsByChainIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryAllOperatorConsAddrsByChainID not implemented")
 }
@@ -1707,6 +2334,9 @@ func (*UnimplementedQueryServer) QueryAllAVSsByOperator(ctx context.Context, req
 func (*UnimplementedQueryServer) QueryOptInfo(ctx context.Context, req *QueryOptInfoRequest) (*OptedInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryOptInfo not implemented")
 }
+func (*UnimplementedQueryServer) QuerySelfDelegationStatus(ctx context.Context, req *QuerySelfDelegationStatusRequest) (*QuerySelfDelegationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySelfDelegationStatus not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1856,6 +2486,78 @@ func _Query_QueryOperatorSlashInfo_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueryPendingSlashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingSlashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPendingSlashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.operator.v1.Query/QueryPendingSlashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPendingSlashes(ctx, req.(*QueryPendingSlashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPriceBreakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceBreakersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPriceBreakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.operator.v1.Query/QueryPriceBreakers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPriceBreakers(ctx, req.(*QueryPriceBreakersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryUnbondingMaturity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingMaturityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryUnbondingMaturity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.operator.v1.Query/QueryUnbondingMaturity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryUnbondingMaturity(ctx, req.(*QueryUnbondingMaturityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.operator.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_QueryAllOperatorConsAddrsByChainID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAllOperatorConsAddrsByChainIDRequest)
 	if err := dec(in); err != nil {
@@ -1928,6 +2630,24 @@ func _Query_QueryOptInfo_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QuerySelfDelegationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySelfDelegationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySelfDelegationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.operator.v1.Query/QuerySelfDelegationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySelfDelegationStatus(ctx, req.(*QuerySelfDelegationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "exocore.operator.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1964,6 +2684,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryOperatorSlashInfo",
 			Handler:    _Query_QueryOperatorSlashInfo_Handler,
 		},
+		{
+			MethodName: "QueryPendingSlashes",
+			Handler:    _Query_QueryPendingSlashes_Handler,
+		},
+		{
+			MethodName: "QueryPriceBreakers",
+			Handler:    _Query_QueryPriceBreakers_Handler,
+		},
+		{
+			MethodName: "QueryUnbondingMaturity",
+			Handler:    _Query_QueryUnbondingMaturity_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 		{
 			MethodName: "QueryAllOperatorConsAddrsByChainID",
 			Handler:    _Query_QueryAllOperatorConsAddrsByChainID_Handler,
@@ -1980,6 +2716,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryOptInfo",
 			Handler:    _Query_QueryOptInfo_Handler,
 		},
+		{
+			MethodName: "QuerySelfDelegationStatus",
+			Handler:    _Query_QuerySelfDelegationStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "exocore/operator/v1/query.proto",
@@ -2369,7 +3109,7 @@ func (m *QueryOperatorSlashInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryOperatorConsKeyRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingSlashesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2379,34 +3119,39 @@ func (m *QueryOperatorConsKeyRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryOperatorConsKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingSlashesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOperatorConsKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingSlashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Chain) > 0 {
-		i -= len(m.Chain)
-		copy(dAtA[i:], m.Chain)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.OperatorAccAddr) > 0 {
-		i -= len(m.OperatorAccAddr)
-		copy(dAtA[i:], m.OperatorAccAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAccAddr)))
+	if len(m.AvsAddress) > 0 {
+		i -= len(m.AvsAddress)
+		copy(dAtA[i:], m.AvsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AvsAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryOperatorConsKeyResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingSlashesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2416,40 +3161,46 @@ func (m *QueryOperatorConsKeyResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryOperatorConsKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingSlashesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOperatorConsKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingSlashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.OptingOut {
-		i--
-		if m.OptingOut {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	{
-		size, err := m.PublicKey.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.PendingSlashes) > 0 {
+		for iNdEx := len(m.PendingSlashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSlashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryOperatorConsAddressRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPriceBreakersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2459,34 +3210,27 @@ func (m *QueryOperatorConsAddressRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryOperatorConsAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPriceBreakersRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOperatorConsAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPriceBreakersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Chain) > 0 {
-		i -= len(m.Chain)
-		copy(dAtA[i:], m.Chain)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.OperatorAccAddr) > 0 {
-		i -= len(m.OperatorAccAddr)
-		copy(dAtA[i:], m.OperatorAccAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAccAddr)))
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetID)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryOperatorConsAddressResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPriceBreakersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2496,37 +3240,34 @@ func (m *QueryOperatorConsAddressResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryOperatorConsAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPriceBreakersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOperatorConsAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPriceBreakersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.OptingOut {
-		i--
-		if m.OptingOut {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ConsAddr) > 0 {
-		i -= len(m.ConsAddr)
-		copy(dAtA[i:], m.ConsAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddr)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.States) > 0 {
+		for iNdEx := len(m.States) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.States[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllOperatorConsKeysByChainIDRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryUnbondingMaturityRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2536,39 +3277,34 @@ func (m *QueryAllOperatorConsKeysByChainIDRequest) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllOperatorConsKeysByChainIDRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryUnbondingMaturityRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllOperatorConsKeysByChainIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryUnbondingMaturityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.RecordKey) > 0 {
+		i -= len(m.RecordKey)
+		copy(dAtA[i:], m.RecordKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordKey)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Chain) > 0 {
-		i -= len(m.Chain)
-		copy(dAtA[i:], m.Chain)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllOperatorConsKeysByChainIDResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryUnbondingMaturityResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2578,19 +3314,26 @@ func (m *QueryAllOperatorConsKeysByChainIDResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllOperatorConsKeysByChainIDResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryUnbondingMaturityResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllOperatorConsKeysByChainIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryUnbondingMaturityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Maturity != nil {
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Maturity.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2600,24 +3343,15 @@ func (m *QueryAllOperatorConsKeysByChainIDResponse) MarshalToSizedBuffer(dAtA []
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.OperatorConsKeys) > 0 {
-		for iNdEx := len(m.OperatorConsKeys) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OperatorConsKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.CompleteBlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CompleteBlockNumber))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OperatorConsKeyPair) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2627,49 +3361,20 @@ func (m *OperatorConsKeyPair) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OperatorConsKeyPair) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OperatorConsKeyPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.OptingOut {
-		i--
-		if m.OptingOut {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.PublicKey != nil {
-		{
-			size, err := m.PublicKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.OperatorAccAddr) > 0 {
-		i -= len(m.OperatorAccAddr)
-		copy(dAtA[i:], m.OperatorAccAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAccAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllOperatorConsAddrsByChainIDRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2679,39 +3384,30 @@ func (m *QueryAllOperatorConsAddrsByChainIDRequest) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllOperatorConsAddrsByChainIDRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllOperatorConsAddrsByChainIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Chain) > 0 {
-		i -= len(m.Chain)
-		copy(dAtA[i:], m.Chain)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllOperatorConsAddrsByChainIDResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryOperatorConsKeyRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2721,46 +3417,34 @@ func (m *QueryAllOperatorConsAddrsByChainIDResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllOperatorConsAddrsByChainIDResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryOperatorConsKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllOperatorConsAddrsByChainIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOperatorConsKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.OperatorConsAddrs) > 0 {
-		for iNdEx := len(m.OperatorConsAddrs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OperatorConsAddrs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.OperatorAccAddr) > 0 {
+		i -= len(m.OperatorAccAddr)
+		copy(dAtA[i:], m.OperatorAccAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAccAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OperatorConsAddrPair) Marshal() (dAtA []byte, err error) {
+func (m *QueryOperatorConsKeyResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2770,12 +3454,12 @@ func (m *OperatorConsAddrPair) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OperatorConsAddrPair) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryOperatorConsKeyResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OperatorConsAddrPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOperatorConsKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2788,26 +3472,22 @@ func (m *OperatorConsAddrPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ConsAddr) > 0 {
-		i -= len(m.ConsAddr)
-		copy(dAtA[i:], m.ConsAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddr)))
-		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.OperatorAccAddr) > 0 {
-		i -= len(m.OperatorAccAddr)
-		copy(dAtA[i:], m.OperatorAccAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAccAddr)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size, err := m.PublicKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllOperatorsByOptInAVSRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryOperatorConsAddressRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2817,27 +3497,34 @@ func (m *QueryAllOperatorsByOptInAVSRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllOperatorsByOptInAVSRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryOperatorConsAddressRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllOperatorsByOptInAVSRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOperatorConsAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Avs) > 0 {
-		i -= len(m.Avs)
-		copy(dAtA[i:], m.Avs)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Avs)))
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAccAddr) > 0 {
+		i -= len(m.OperatorAccAddr)
+		copy(dAtA[i:], m.OperatorAccAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAccAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllOperatorsByOptInAVSResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryOperatorConsAddressResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2847,29 +3534,37 @@ func (m *QueryAllOperatorsByOptInAVSResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllOperatorsByOptInAVSResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryOperatorConsAddressResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllOperatorsByOptInAVSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOperatorConsAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.OperatorList) > 0 {
-		for iNdEx := len(m.OperatorList) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.OperatorList[iNdEx])
-			copy(dAtA[i:], m.OperatorList[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorList[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	if m.OptingOut {
+		i--
+		if m.OptingOut {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsAddr) > 0 {
+		i -= len(m.ConsAddr)
+		copy(dAtA[i:], m.ConsAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllAVSsByOperatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllOperatorConsKeysByChainIDRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2879,27 +3574,39 @@ func (m *QueryAllAVSsByOperatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllAVSsByOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllOperatorConsKeysByChainIDRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllAVSsByOperatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllOperatorConsKeysByChainIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryAllAVSsByOperatorResponse) Marshal() (dAtA []byte, err error) {
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllOperatorConsKeysByChainIDResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2909,21 +3616,38 @@ func (m *QueryAllAVSsByOperatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllAVSsByOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllOperatorConsKeysByChainIDResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllAVSsByOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllOperatorConsKeysByChainIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AvsList) > 0 {
-		for iNdEx := len(m.AvsList) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AvsList[iNdEx])
-			copy(dAtA[i:], m.AvsList[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.AvsList[iNdEx])))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorConsKeys) > 0 {
+		for iNdEx := len(m.OperatorConsKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorConsKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2931,7 +3655,7 @@ func (m *QueryAllAVSsByOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryOptInfoRequest) Marshal() (dAtA []byte, err error) {
+func (m *OperatorConsKeyPair) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2941,19 +3665,29 @@ func (m *QueryOptInfoRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryOptInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *OperatorConsKeyPair) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOptInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OperatorConsKeyPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.OperatorAVSAddress != nil {
+	if m.OptingOut {
+		i--
+		if m.OptingOut {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PublicKey != nil {
 		{
-			size, err := m.OperatorAVSAddress.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PublicKey.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2961,306 +3695,525 @@ func (m *QueryOptInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAccAddr) > 0 {
+		i -= len(m.OperatorAccAddr)
+		copy(dAtA[i:], m.OperatorAccAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAccAddr)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAllOperatorConsAddrsByChainIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GetOperatorInfoReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OperatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryAllOperatorConsAddrsByChainIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllOperatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllOperatorConsAddrsByChainIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllOperatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllOperatorConsAddrsByChainIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllOperatorConsAddrsByChainIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllOperatorConsAddrsByChainIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.OperatorAccAddrs) > 0 {
-		for _, s := range m.OperatorAccAddrs {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.OperatorConsAddrs) > 0 {
+		for iNdEx := len(m.OperatorConsAddrs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorConsAddrs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OperatorAVSAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OperatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.AvsAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *OperatorConsAddrPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOperatorUSDValueRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OperatorAVSAddress != nil {
-		l = m.OperatorAVSAddress.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *OperatorConsAddrPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOperatorUSDValueResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *OperatorConsAddrPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.USDValues != nil {
-		l = m.USDValues.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.OptingOut {
+		i--
+		if m.OptingOut {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if len(m.ConsAddr) > 0 {
+		i -= len(m.ConsAddr)
+		copy(dAtA[i:], m.ConsAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAccAddr) > 0 {
+		i -= len(m.OperatorAccAddr)
+		copy(dAtA[i:], m.OperatorAccAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAccAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAVSUSDValueRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AVSAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllOperatorsByOptInAVSRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOperatorSlashInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OperatorAVSAddress != nil {
-		l = m.OperatorAVSAddress.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAllOperatorsByOptInAVSRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OperatorSlashInfoByID) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllOperatorsByOptInAVSRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SlashID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Avs) > 0 {
+		i -= len(m.Avs)
+		copy(dAtA[i:], m.Avs)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Avs)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOperatorSlashInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllOperatorsByOptInAVSResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllOperatorsByOptInAVSResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllOperatorsByOptInAVSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.AllSlashInfo) > 0 {
-		for _, e := range m.AllSlashInfo {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.OperatorList) > 0 {
+		for iNdEx := len(m.OperatorList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OperatorList[iNdEx])
+			copy(dAtA[i:], m.OperatorList[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorList[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOperatorConsKeyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OperatorAccAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Chain)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllAVSsByOperatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOperatorConsKeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.PublicKey.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.OptingOut {
-		n += 2
-	}
-	return n
+func (m *QueryAllAVSsByOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOperatorConsAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllAVSsByOperatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OperatorAccAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Chain)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOperatorConsAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.OptingOut {
-		n += 2
+func (m *QueryAllAVSsByOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllOperatorConsKeysByChainIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllAVSsByOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAVSsByOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Chain)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.AvsList) > 0 {
+		for iNdEx := len(m.AvsList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AvsList[iNdEx])
+			copy(dAtA[i:], m.AvsList[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AvsList[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllOperatorConsKeysByChainIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOptInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOptInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOptInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.OperatorConsKeys) > 0 {
-		for _, e := range m.OperatorConsKeys {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.OperatorAVSAddress != nil {
+		{
+			size, err := m.OperatorAVSAddress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OperatorConsKeyPair) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySelfDelegationStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySelfDelegationStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySelfDelegationStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OperatorAccAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.OperatorAVSAddress != nil {
+		{
+			size, err := m.OperatorAVSAddress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.PublicKey != nil {
-		l = m.PublicKey.Size()
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySelfDelegationStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySelfDelegationStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySelfDelegationStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BelowMinSelfDelegation {
+		i--
+		if m.BelowMinSelfDelegation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MinSelfDelegation.Size()
+		i -= size
+		if _, err := m.MinSelfDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SelfUSDValue.Size()
+		i -= size
+		if _, err := m.SelfUSDValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetOperatorInfoReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddr)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.OptingOut {
-		n += 2
+	return n
+}
+
+func (m *QueryAllOperatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryAllOperatorConsAddrsByChainIDRequest) Size() (n int) {
+func (m *QueryAllOperatorsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Chain)
+	if len(m.OperatorAccAddrs) > 0 {
+		for _, s := range m.OperatorAccAddrs {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OperatorAVSAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AvsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorUSDValueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperatorAVSAddress != nil {
+		l = m.OperatorAVSAddress.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorUSDValueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.USDValues != nil {
+		l = m.USDValues.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAVSUSDValueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AVSAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	return n
+}
+
+func (m *QueryOperatorSlashInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperatorAVSAddress != nil {
+		l = m.OperatorAVSAddress.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
@@ -3268,14 +4221,31 @@ func (m *QueryAllOperatorConsAddrsByChainIDRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryAllOperatorConsAddrsByChainIDResponse) Size() (n int) {
+func (m *OperatorSlashInfoByID) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.OperatorConsAddrs) > 0 {
-		for _, e := range m.OperatorConsAddrs {
+	l = len(m.SlashID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorSlashInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllSlashInfo) > 0 {
+		for _, e := range m.AllSlashInfo {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -3287,55 +4257,71 @@ func (m *QueryAllOperatorConsAddrsByChainIDResponse) Size() (n int) {
 	return n
 }
 
-func (m *OperatorConsAddrPair) Size() (n int) {
+func (m *QueryPendingSlashesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.OperatorAccAddr)
+	l = len(m.AvsAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.ConsAddr)
-	if l > 0 {
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.OptingOut {
-		n += 2
+	return n
+}
+
+func (m *QueryPendingSlashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingSlashes) > 0 {
+		for _, e := range m.PendingSlashes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryAllOperatorsByOptInAVSRequest) Size() (n int) {
+func (m *QueryPriceBreakersRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Avs)
+	l = len(m.AssetID)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryAllOperatorsByOptInAVSResponse) Size() (n int) {
+func (m *QueryPriceBreakersResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.OperatorList) > 0 {
-		for _, s := range m.OperatorList {
-			l = len(s)
+	if len(m.States) > 0 {
+		for _, e := range m.States {
+			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *QueryAllAVSsByOperatorRequest) Size() (n int) {
+func (m *QueryUnbondingMaturityRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3345,44 +4331,906 @@ func (m *QueryAllAVSsByOperatorRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.RecordKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryAllAVSsByOperatorResponse) Size() (n int) {
+func (m *QueryUnbondingMaturityResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.AvsList) > 0 {
-		for _, s := range m.AvsList {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if m.CompleteBlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.CompleteBlockNumber))
+	}
+	if m.Maturity != nil {
+		l = m.Maturity.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOperatorConsKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAccAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorConsKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PublicKey.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.OptingOut {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryOperatorConsAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAccAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorConsAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OptingOut {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAllOperatorConsKeysByChainIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllOperatorConsKeysByChainIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OperatorConsKeys) > 0 {
+		for _, e := range m.OperatorConsKeys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OperatorConsKeyPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAccAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PublicKey != nil {
+		l = m.PublicKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OptingOut {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAllOperatorConsAddrsByChainIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllOperatorConsAddrsByChainIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OperatorConsAddrs) > 0 {
+		for _, e := range m.OperatorConsAddrs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OperatorConsAddrPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAccAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConsAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OptingOut {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAllOperatorsByOptInAVSRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Avs)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllOperatorsByOptInAVSResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OperatorList) > 0 {
+		for _, s := range m.OperatorList {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllAVSsByOperatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAVSsByOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AvsList) > 0 {
+		for _, s := range m.AvsList {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOptInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperatorAVSAddress != nil {
+		l = m.OperatorAVSAddress.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySelfDelegationStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperatorAVSAddress != nil {
+		l = m.OperatorAVSAddress.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySelfDelegationStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SelfUSDValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MinSelfDelegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.BelowMinSelfDelegation {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetOperatorInfoReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetOperatorInfoReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetOperatorInfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllOperatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllOperatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllOperatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllOperatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllOperatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllOperatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAccAddrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAccAddrs = append(m.OperatorAccAddrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorAVSAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorAVSAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorAVSAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorUSDValueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorUSDValueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorUSDValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAVSAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OperatorAVSAddress == nil {
+				m.OperatorAVSAddress = &OperatorAVSAddress{}
+			}
+			if err := m.OperatorAVSAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorUSDValueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorUSDValueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorUSDValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field USDValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.USDValues == nil {
+				m.USDValues = &OperatorOptedUSDValue{}
+			}
+			if err := m.USDValues.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *QueryOptInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OperatorAVSAddress != nil {
-		l = m.OperatorAVSAddress.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GetOperatorInfoReq) Unmarshal(dAtA []byte) error {
+func (m *QueryAVSUSDValueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3405,15 +5253,15 @@ func (m *GetOperatorInfoReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetOperatorInfoReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAVSUSDValueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetOperatorInfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAVSUSDValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AVSAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3441,7 +5289,7 @@ func (m *GetOperatorInfoReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			m.AVSAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3464,7 +5312,7 @@ func (m *GetOperatorInfoReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllOperatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOperatorSlashInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3487,13 +5335,49 @@ func (m *QueryAllOperatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllOperatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperatorSlashInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllOperatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperatorSlashInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAVSAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OperatorAVSAddress == nil {
+				m.OperatorAVSAddress = &OperatorAVSAddress{}
+			}
+			if err := m.OperatorAVSAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3550,7 +5434,125 @@ func (m *QueryAllOperatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllOperatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *OperatorSlashInfoByID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorSlashInfoByID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorSlashInfoByID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &OperatorSlashInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorSlashInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3573,17 +5575,17 @@ func (m *QueryAllOperatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllOperatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperatorSlashInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllOperatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperatorSlashInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAccAddrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllSlashInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3593,23 +5595,25 @@ func (m *QueryAllOperatorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAccAddrs = append(m.OperatorAccAddrs, string(dAtA[iNdEx:postIndex]))
+			m.AllSlashInfo = append(m.AllSlashInfo, &OperatorSlashInfoByID{})
+			if err := m.AllSlashInfo[len(m.AllSlashInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3668,7 +5672,7 @@ func (m *QueryAllOperatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperatorAVSAddress) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingSlashesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3691,15 +5695,15 @@ func (m *OperatorAVSAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperatorAVSAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingSlashesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperatorAVSAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingSlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AvsAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3727,13 +5731,13 @@ func (m *OperatorAVSAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			m.AvsAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvsAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3743,23 +5747,27 @@ func (m *OperatorAVSAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AvsAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3782,7 +5790,7 @@ func (m *OperatorAVSAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperatorUSDValueRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingSlashesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3805,15 +5813,15 @@ func (m *QueryOperatorUSDValueRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorUSDValueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingSlashesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorUSDValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingSlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAVSAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSlashes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3840,10 +5848,44 @@ func (m *QueryOperatorUSDValueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OperatorAVSAddress == nil {
-				m.OperatorAVSAddress = &OperatorAVSAddress{}
+			m.PendingSlashes = append(m.PendingSlashes, PendingSlash{})
+			if err := m.PendingSlashes[len(m.PendingSlashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.OperatorAVSAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3868,7 +5910,7 @@ func (m *QueryOperatorUSDValueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperatorUSDValueResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceBreakersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3891,17 +5933,17 @@ func (m *QueryOperatorUSDValueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorUSDValueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceBreakersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorUSDValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceBreakersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field USDValues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3911,27 +5953,23 @@ func (m *QueryOperatorUSDValueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.USDValues == nil {
-				m.USDValues = &OperatorOptedUSDValue{}
-			}
-			if err := m.USDValues.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3954,7 +5992,7 @@ func (m *QueryOperatorUSDValueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAVSUSDValueRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceBreakersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3977,17 +6015,17 @@ func (m *QueryAVSUSDValueRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAVSUSDValueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceBreakersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAVSUSDValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceBreakersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AVSAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3997,23 +6035,25 @@ func (m *QueryAVSUSDValueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AVSAddress = string(dAtA[iNdEx:postIndex])
+			m.States = append(m.States, PriceBreakerState{})
+			if err := m.States[len(m.States)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4036,7 +6076,7 @@ func (m *QueryAVSUSDValueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperatorSlashInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondingMaturityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4059,17 +6099,17 @@ func (m *QueryOperatorSlashInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorSlashInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondingMaturityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorSlashInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondingMaturityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAVSAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4079,33 +6119,29 @@ func (m *QueryOperatorSlashInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OperatorAVSAddress == nil {
-				m.OperatorAVSAddress = &OperatorAVSAddress{}
-			}
-			if err := m.OperatorAVSAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4115,27 +6151,23 @@ func (m *QueryOperatorSlashInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RecordKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4158,7 +6190,7 @@ func (m *QueryOperatorSlashInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperatorSlashInfoByID) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondingMaturityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4181,17 +6213,36 @@ func (m *OperatorSlashInfoByID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperatorSlashInfoByID: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondingMaturityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperatorSlashInfoByID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondingMaturityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompleteBlockNumber", wireType)
+			}
+			m.CompleteBlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompleteBlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Maturity", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4201,29 +6252,33 @@ func (m *OperatorSlashInfoByID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashID = string(dAtA[iNdEx:postIndex])
+			if m.Maturity == nil {
+				m.Maturity = &UnbondingMaturity{}
+			}
+			if err := m.Maturity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4233,28 +6288,74 @@ func (m *OperatorSlashInfoByID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Info == nil {
-				m.Info = &OperatorSlashInfo{}
-			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4276,7 +6377,7 @@ func (m *OperatorSlashInfoByID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperatorSlashInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4299,49 +6400,15 @@ func (m *QueryOperatorSlashInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorSlashInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorSlashInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllSlashInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AllSlashInfo = append(m.AllSlashInfo, &OperatorSlashInfoByID{})
-			if err := m.AllSlashInfo[len(m.AllSlashInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4368,10 +6435,7 @@ func (m *QueryOperatorSlashInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5991,6 +8055,230 @@ func (m *QueryOptInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySelfDelegationStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySelfDelegationStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySelfDelegationStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAVSAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OperatorAVSAddress == nil {
+				m.OperatorAVSAddress = &OperatorAVSAddress{}
+			}
+			if err := m.OperatorAVSAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySelfDelegationStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySelfDelegationStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySelfDelegationStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfUSDValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SelfUSDValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BelowMinSelfDelegation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BelowMinSelfDelegation = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
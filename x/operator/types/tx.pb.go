@@ -348,6 +348,11 @@ type OptedInfo struct {
 	OptedOutHeight uint64 `protobuf:"varint,3,opt,name=opted_out_height,json=optedOutHeight,proto3" json:"opted_out_height,omitempty"`
 	// jailed defined whether the operator has been jailed from bonded status or not.
 	Jailed bool `protobuf:"varint,4,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// below_min_self_delegation indicates whether the operator is excluded from the validator
+	// set, the task power and the rewards of the AVS because its self-delegated USD value is
+	// below the minimum self delegation of the AVS. The operator remains active, so it can still
+	// opt out or set its key. It's updated at the end of each epoch of the AVS.
+	BelowMinSelfDelegation bool `protobuf:"varint,5,opt,name=below_min_self_delegation,json=belowMinSelfDelegation,proto3" json:"below_min_self_delegation,omitempty"`
 }
 
 func (m *OptedInfo) Reset()         { *m = OptedInfo{} }
@@ -411,6 +416,13 @@ func (m *OptedInfo) GetJailed() bool {
 	return false
 }
 
+func (m *OptedInfo) GetBelowMinSelfDelegation() bool {
+	if m != nil {
+		return m.BelowMinSelfDelegation
+	}
+	return false
+}
+
 // OptedInAssetState is the state of opted-in asset
 type OptedInAssetState struct {
 	// amount of the opted-in asset
@@ -509,6 +521,72 @@ func (m *SlashFromUndelegation) GetAssetID() string {
 	return ""
 }
 
+// SlashFromRedelegation records the slash detail from the redelegation
+type SlashFromRedelegation struct {
+	// staker_id is the staker id.
+	StakerID string `protobuf:"bytes,1,opt,name=staker_id,json=stakerId,proto3" json:"staker_id,omitempty"`
+	// asset_id is the asset id.
+	AssetID string `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// dst_operator_addr is the destination operator of the redelegation.
+	DstOperatorAddr string `protobuf:"bytes,3,opt,name=dst_operator_addr,json=dstOperatorAddr,proto3" json:"dst_operator_addr,omitempty"`
+	// amount is the slashed amount from the redelegation.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *SlashFromRedelegation) Reset()         { *m = SlashFromRedelegation{} }
+func (m *SlashFromRedelegation) String() string { return proto.CompactTextString(m) }
+func (*SlashFromRedelegation) ProtoMessage()    {}
+func (*SlashFromRedelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b229d5663e4df167, []int{8}
+}
+func (m *SlashFromRedelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashFromRedelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashFromRedelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashFromRedelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashFromRedelegation.Merge(m, src)
+}
+func (m *SlashFromRedelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashFromRedelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashFromRedelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashFromRedelegation proto.InternalMessageInfo
+
+func (m *SlashFromRedelegation) GetStakerID() string {
+	if m != nil {
+		return m.StakerID
+	}
+	return ""
+}
+
+func (m *SlashFromRedelegation) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+func (m *SlashFromRedelegation) GetDstOperatorAddr() string {
+	if m != nil {
+		return m.DstOperatorAddr
+	}
+	return ""
+}
+
 // SlashFromAssetsPool records the slash detail from the operator assets pool
 type SlashFromAssetsPool struct {
 	// asset_id is the asset id.
@@ -521,7 +599,7 @@ func (m *SlashFromAssetsPool) Reset()         { *m = SlashFromAssetsPool{} }
 func (m *SlashFromAssetsPool) String() string { return proto.CompactTextString(m) }
 func (*SlashFromAssetsPool) ProtoMessage()    {}
 func (*SlashFromAssetsPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b229d5663e4df167, []int{8}
+	return fileDescriptor_b229d5663e4df167, []int{9}
 }
 func (m *SlashFromAssetsPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -567,13 +645,15 @@ type SlashExecutionInfo struct {
 	SlashUndelegations []SlashFromUndelegation `protobuf:"bytes,3,rep,name=slash_undelegations,json=slashUndelegations,proto3" json:"slash_undelegations"`
 	// SlashFromAssetsPool records all slash info related to the assets pool
 	SlashAssetsPool []SlashFromAssetsPool `protobuf:"bytes,4,rep,name=slash_assets_pool,json=slashAssetsPool,proto3" json:"slash_assets_pool"`
+	// SlashRedelegations records all slash info related to the redelegation
+	SlashRedelegations []SlashFromRedelegation `protobuf:"bytes,5,rep,name=slash_redelegations,json=slashRedelegations,proto3" json:"slash_redelegations"`
 }
 
 func (m *SlashExecutionInfo) Reset()         { *m = SlashExecutionInfo{} }
 func (m *SlashExecutionInfo) String() string { return proto.CompactTextString(m) }
 func (*SlashExecutionInfo) ProtoMessage()    {}
 func (*SlashExecutionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b229d5663e4df167, []int{9}
+	return fileDescriptor_b229d5663e4df167, []int{10}
 }
 func (m *SlashExecutionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -616,6 +696,13 @@ func (m *SlashExecutionInfo) GetSlashAssetsPool() []SlashFromAssetsPool {
 	return nil
 }
 
+func (m *SlashExecutionInfo) GetSlashRedelegations() []SlashFromRedelegation {
+	if m != nil {
+		return m.SlashRedelegations
+	}
+	return nil
+}
+
 // OperatorSlashInfo is the slash info of operator
 type OperatorSlashInfo struct {
 	// slash_contract is the address of slash contract
@@ -638,7 +725,7 @@ func (m *OperatorSlashInfo) Reset()         { *m = OperatorSlashInfo{} }
 func (m *OperatorSlashInfo) String() string { return proto.CompactTextString(m) }
 func (*OperatorSlashInfo) ProtoMessage()    {}
 func (*OperatorSlashInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b229d5663e4df167, []int{10}
+	return fileDescriptor_b229d5663e4df167, []int{11}
 }
 func (m *OperatorSlashInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -709,26 +796,37 @@ func (m *OperatorSlashInfo) GetExecutionInfo() *SlashExecutionInfo {
 	return nil
 }
 
-// RegisterOperatorReq is the request to register a new operator.
-type RegisterOperatorReq struct {
-	// from_address is the address of the operator (sdk.AccAddress).
-	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
-	// info is the operator info.
-	Info *OperatorInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
-}
-
-func (m *RegisterOperatorReq) Reset()         { *m = RegisterOperatorReq{} }
-func (m *RegisterOperatorReq) String() string { return proto.CompactTextString(m) }
-func (*RegisterOperatorReq) ProtoMessage()    {}
-func (*RegisterOperatorReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b229d5663e4df167, []int{11}
+// PendingSlash is a non-instantaneous slash which is waiting for the end of its veto window.
+// The details of the slash are stored as the OperatorSlashInfo with the same key.
+type PendingSlash struct {
+	// operator_address is the address of the slashed operator.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// avs_address is the address of the AVS which submits the slash.
+	AVSAddress string `protobuf:"bytes,2,opt,name=avs_address,json=avsAddress,proto3" json:"avs_address,omitempty"`
+	// slash_id is the ID of the slash.
+	SlashID string `protobuf:"bytes,3,opt,name=slash_id,json=slashId,proto3" json:"slash_id,omitempty"`
+	// epoch_identifier is the epoch identifier of the AVS.
+	EpochIdentifier string `protobuf:"bytes,4,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// execution_epoch is the epoch at the end of which the slash is executed
+	// if it isn't vetoed.
+	ExecutionEpoch int64 `protobuf:"varint,5,opt,name=execution_epoch,json=executionEpoch,proto3" json:"execution_epoch,omitempty"`
+	// failed_attempts is the number of the failed executions, the slash is retried
+	// at the end of the next epoch after each failure.
+	FailedAttempts uint32 `protobuf:"varint,6,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
+}
+
+func (m *PendingSlash) Reset()         { *m = PendingSlash{} }
+func (m *PendingSlash) String() string { return proto.CompactTextString(m) }
+func (*PendingSlash) ProtoMessage()    {}
+func (*PendingSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b229d5663e4df167, []int{12}
 }
-func (m *RegisterOperatorReq) XXX_Unmarshal(b []byte) error {
+func (m *PendingSlash) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RegisterOperatorReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PendingSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RegisterOperatorReq.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PendingSlash.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -738,34 +836,91 @@ func (m *RegisterOperatorReq) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *RegisterOperatorReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterOperatorReq.Merge(m, src)
+func (m *PendingSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSlash.Merge(m, src)
 }
-func (m *RegisterOperatorReq) XXX_Size() int {
+func (m *PendingSlash) XXX_Size() int {
 	return m.Size()
 }
-func (m *RegisterOperatorReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterOperatorReq.DiscardUnknown(m)
+func (m *PendingSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSlash.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegisterOperatorReq proto.InternalMessageInfo
+var xxx_messageInfo_PendingSlash proto.InternalMessageInfo
 
-// RegisterOperatorResponse is the response to a register operator request.
-type RegisterOperatorResponse struct {
+func (m *PendingSlash) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
 }
 
-func (m *RegisterOperatorResponse) Reset()         { *m = RegisterOperatorResponse{} }
-func (m *RegisterOperatorResponse) String() string { return proto.CompactTextString(m) }
-func (*RegisterOperatorResponse) ProtoMessage()    {}
-func (*RegisterOperatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b229d5663e4df167, []int{12}
+func (m *PendingSlash) GetAVSAddress() string {
+	if m != nil {
+		return m.AVSAddress
+	}
+	return ""
 }
-func (m *RegisterOperatorResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *PendingSlash) GetSlashID() string {
+	if m != nil {
+		return m.SlashID
+	}
+	return ""
+}
+
+func (m *PendingSlash) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *PendingSlash) GetExecutionEpoch() int64 {
+	if m != nil {
+		return m.ExecutionEpoch
+	}
+	return 0
+}
+
+func (m *PendingSlash) GetFailedAttempts() uint32 {
+	if m != nil {
+		return m.FailedAttempts
+	}
+	return 0
+}
+
+// UnbondingMaturity is the maturity of an undelegation or a redelegation, which is held until
+// the longest unbonding period among the AVSs the operator is opted into elapses.
+type UnbondingMaturity struct {
+	// record_key is the key of the undelegation or redelegation record.
+	RecordKey string `protobuf:"bytes,1,opt,name=record_key,json=recordKey,proto3" json:"record_key,omitempty"`
+	// is_redelegation indicates whether the record is a redelegation.
+	IsRedelegation bool `protobuf:"varint,2,opt,name=is_redelegation,json=isRedelegation,proto3" json:"is_redelegation,omitempty"`
+	// operator_address is the address of the operator the asset is unbonded from.
+	OperatorAddress string `protobuf:"bytes,3,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// avs_address is the address of the AVS with the longest unbonding period.
+	AVSAddress string `protobuf:"bytes,4,opt,name=avs_address,json=avsAddress,proto3" json:"avs_address,omitempty"`
+	// epoch_identifier is the epoch identifier of the AVS.
+	EpochIdentifier string `protobuf:"bytes,5,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// unbonding_period is the unbonding period of the AVS, counted in its epochs.
+	UnbondingPeriod uint64 `protobuf:"varint,6,opt,name=unbonding_period,json=unbondingPeriod,proto3" json:"unbonding_period,omitempty"`
+	// maturity_epoch is the epoch at the end of which the record is released.
+	MaturityEpoch int64 `protobuf:"varint,7,opt,name=maturity_epoch,json=maturityEpoch,proto3" json:"maturity_epoch,omitempty"`
+}
+
+func (m *UnbondingMaturity) Reset()         { *m = UnbondingMaturity{} }
+func (m *UnbondingMaturity) String() string { return proto.CompactTextString(m) }
+func (*UnbondingMaturity) ProtoMessage()    {}
+func (*UnbondingMaturity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b229d5663e4df167, []int{13}
+}
+func (m *UnbondingMaturity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RegisterOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnbondingMaturity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RegisterOperatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnbondingMaturity.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -775,79 +930,90 @@ func (m *RegisterOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *RegisterOperatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterOperatorResponse.Merge(m, src)
+func (m *UnbondingMaturity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingMaturity.Merge(m, src)
 }
-func (m *RegisterOperatorResponse) XXX_Size() int {
+func (m *UnbondingMaturity) XXX_Size() int {
 	return m.Size()
 }
-func (m *RegisterOperatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterOperatorResponse.DiscardUnknown(m)
+func (m *UnbondingMaturity) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingMaturity.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegisterOperatorResponse proto.InternalMessageInfo
+var xxx_messageInfo_UnbondingMaturity proto.InternalMessageInfo
 
-// OptIntoAVSReq is the request to opt into an AVS.
-type OptIntoAVSReq struct {
-	// from_address is the address of the operator (sdk.AccAddress).
-	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
-	// avs_address is the address of the AVS.
-	AvsAddress string `protobuf:"bytes,2,opt,name=avs_address,json=avsAddress,proto3" json:"avs_address,omitempty"`
-	// public_key_json is an optional parameter to provide the consensus key.
-	// the key is provided in the JSON format and not the hex format.
-	// TODO: allow providing a BLS key here, depending on the AVS.
-	PublicKeyJSON string `protobuf:"bytes,3,opt,name=public_key_json,json=publicKeyJson,proto3" json:"public_key_json,omitempty"`
+func (m *UnbondingMaturity) GetRecordKey() string {
+	if m != nil {
+		return m.RecordKey
+	}
+	return ""
 }
 
-func (m *OptIntoAVSReq) Reset()         { *m = OptIntoAVSReq{} }
-func (m *OptIntoAVSReq) String() string { return proto.CompactTextString(m) }
-func (*OptIntoAVSReq) ProtoMessage()    {}
-func (*OptIntoAVSReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b229d5663e4df167, []int{13}
-}
-func (m *OptIntoAVSReq) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *UnbondingMaturity) GetIsRedelegation() bool {
+	if m != nil {
+		return m.IsRedelegation
+	}
+	return false
 }
-func (m *OptIntoAVSReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_OptIntoAVSReq.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *UnbondingMaturity) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
 	}
+	return ""
 }
-func (m *OptIntoAVSReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OptIntoAVSReq.Merge(m, src)
+
+func (m *UnbondingMaturity) GetAVSAddress() string {
+	if m != nil {
+		return m.AVSAddress
+	}
+	return ""
 }
-func (m *OptIntoAVSReq) XXX_Size() int {
-	return m.Size()
+
+func (m *UnbondingMaturity) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
 }
-func (m *OptIntoAVSReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_OptIntoAVSReq.DiscardUnknown(m)
+
+func (m *UnbondingMaturity) GetUnbondingPeriod() uint64 {
+	if m != nil {
+		return m.UnbondingPeriod
+	}
+	return 0
 }
 
-var xxx_messageInfo_OptIntoAVSReq proto.InternalMessageInfo
+func (m *UnbondingMaturity) GetMaturityEpoch() int64 {
+	if m != nil {
+		return m.MaturityEpoch
+	}
+	return 0
+}
 
-// OptIntoAVSResponse is the response to a opt into an AVS request.
-type OptIntoAVSResponse struct {
+// PendingCommissionUpdate is a commission update of an operator, which is applied at the end
+// of the current epoch of the dogfood AVS.
+type PendingCommissionUpdate struct {
+	// operator_address is the address of the operator.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// commission is the new commission, whose update time is the time of the request.
+	Commission types.Commission `protobuf:"bytes,2,opt,name=commission,proto3" json:"commission"`
+	// epoch_identifier is the epoch identifier at the end of which the update is applied.
+	EpochIdentifier string `protobuf:"bytes,3,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
 }
 
-func (m *OptIntoAVSResponse) Reset()         { *m = OptIntoAVSResponse{} }
-func (m *OptIntoAVSResponse) String() string { return proto.CompactTextString(m) }
-func (*OptIntoAVSResponse) ProtoMessage()    {}
-func (*OptIntoAVSResponse) Descriptor() ([]byte, []int) {
+func (m *PendingCommissionUpdate) Reset()         { *m = PendingCommissionUpdate{} }
+func (m *PendingCommissionUpdate) String() string { return proto.CompactTextString(m) }
+func (*PendingCommissionUpdate) ProtoMessage()    {}
+func (*PendingCommissionUpdate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b229d5663e4df167, []int{14}
 }
-func (m *OptIntoAVSResponse) XXX_Unmarshal(b []byte) error {
+func (m *PendingCommissionUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OptIntoAVSResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PendingCommissionUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OptIntoAVSResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PendingCommissionUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -857,38 +1023,60 @@ func (m *OptIntoAVSResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *OptIntoAVSResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OptIntoAVSResponse.Merge(m, src)
+func (m *PendingCommissionUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingCommissionUpdate.Merge(m, src)
 }
-func (m *OptIntoAVSResponse) XXX_Size() int {
+func (m *PendingCommissionUpdate) XXX_Size() int {
 	return m.Size()
 }
-func (m *OptIntoAVSResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_OptIntoAVSResponse.DiscardUnknown(m)
+func (m *PendingCommissionUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingCommissionUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OptIntoAVSResponse proto.InternalMessageInfo
+var xxx_messageInfo_PendingCommissionUpdate proto.InternalMessageInfo
 
-// OptOutOfAVSReq is the request to opt out from an AVS.
-type OptOutOfAVSReq struct {
-	// from_address is the address of the operator (sdk.AccAddress).
-	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
-	// avs_address is the address of the AVS.
-	AvsAddress string `protobuf:"bytes,2,opt,name=avs_address,json=avsAddress,proto3" json:"avs_address,omitempty"`
+func (m *PendingCommissionUpdate) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
 }
 
-func (m *OptOutOfAVSReq) Reset()         { *m = OptOutOfAVSReq{} }
-func (m *OptOutOfAVSReq) String() string { return proto.CompactTextString(m) }
-func (*OptOutOfAVSReq) ProtoMessage()    {}
-func (*OptOutOfAVSReq) Descriptor() ([]byte, []int) {
+func (m *PendingCommissionUpdate) GetCommission() types.Commission {
+	if m != nil {
+		return m.Commission
+	}
+	return types.Commission{}
+}
+
+func (m *PendingCommissionUpdate) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+// OperatorVotingPower is the voting power of an operator in a snapshot.
+type OperatorVotingPower struct {
+	// operator_address is the address of the operator.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// usd_value is the active opted-in USD value of the operator, which is used as
+	// the voting power.
+	USDValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=usd_value,json=usdValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"usd_value"`
+}
+
+func (m *OperatorVotingPower) Reset()         { *m = OperatorVotingPower{} }
+func (m *OperatorVotingPower) String() string { return proto.CompactTextString(m) }
+func (*OperatorVotingPower) ProtoMessage()    {}
+func (*OperatorVotingPower) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b229d5663e4df167, []int{15}
 }
-func (m *OptOutOfAVSReq) XXX_Unmarshal(b []byte) error {
+func (m *OperatorVotingPower) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OptOutOfAVSReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OperatorVotingPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OptOutOfAVSReq.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OperatorVotingPower.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -898,34 +1086,52 @@ func (m *OptOutOfAVSReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *OptOutOfAVSReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OptOutOfAVSReq.Merge(m, src)
+func (m *OperatorVotingPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorVotingPower.Merge(m, src)
 }
-func (m *OptOutOfAVSReq) XXX_Size() int {
+func (m *OperatorVotingPower) XXX_Size() int {
 	return m.Size()
 }
-func (m *OptOutOfAVSReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_OptOutOfAVSReq.DiscardUnknown(m)
+func (m *OperatorVotingPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorVotingPower.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OptOutOfAVSReq proto.InternalMessageInfo
+var xxx_messageInfo_OperatorVotingPower proto.InternalMessageInfo
 
-// OptOutOfAVSResponse is the response to a opt out of an AVS request.
-type OptOutOfAVSResponse struct {
+func (m *OperatorVotingPower) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
 }
 
-func (m *OptOutOfAVSResponse) Reset()         { *m = OptOutOfAVSResponse{} }
-func (m *OptOutOfAVSResponse) String() string { return proto.CompactTextString(m) }
-func (*OptOutOfAVSResponse) ProtoMessage()    {}
-func (*OptOutOfAVSResponse) Descriptor() ([]byte, []int) {
+// VotingPowerSnapshot is the voting power of the operators opted into an AVS, it's taken
+// at the end of each epoch of the AVS and is in effect until the next snapshot.
+type VotingPowerSnapshot struct {
+	// avs_address is the address of the AVS.
+	AVSAddress string `protobuf:"bytes,1,opt,name=avs_address,json=avsAddress,proto3" json:"avs_address,omitempty"`
+	// height is the block height at which the snapshot is taken.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// epoch_identifier is the epoch identifier of the AVS.
+	EpochIdentifier string `protobuf:"bytes,3,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// epoch_number is the number of the epoch at the end of which the snapshot is taken.
+	EpochNumber int64 `protobuf:"varint,4,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// operator_voting_powers is the voting power of each operator opted into the AVS.
+	OperatorVotingPowers []OperatorVotingPower `protobuf:"bytes,5,rep,name=operator_voting_powers,json=operatorVotingPowers,proto3" json:"operator_voting_powers"`
+}
+
+func (m *VotingPowerSnapshot) Reset()         { *m = VotingPowerSnapshot{} }
+func (m *VotingPowerSnapshot) String() string { return proto.CompactTextString(m) }
+func (*VotingPowerSnapshot) ProtoMessage()    {}
+func (*VotingPowerSnapshot) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b229d5663e4df167, []int{16}
 }
-func (m *OptOutOfAVSResponse) XXX_Unmarshal(b []byte) error {
+func (m *VotingPowerSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OptOutOfAVSResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *VotingPowerSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OptOutOfAVSResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_VotingPowerSnapshot.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -935,44 +1141,84 @@ func (m *OptOutOfAVSResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *OptOutOfAVSResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OptOutOfAVSResponse.Merge(m, src)
+func (m *VotingPowerSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingPowerSnapshot.Merge(m, src)
 }
-func (m *OptOutOfAVSResponse) XXX_Size() int {
+func (m *VotingPowerSnapshot) XXX_Size() int {
 	return m.Size()
 }
-func (m *OptOutOfAVSResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_OptOutOfAVSResponse.DiscardUnknown(m)
+func (m *VotingPowerSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingPowerSnapshot.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OptOutOfAVSResponse proto.InternalMessageInfo
+var xxx_messageInfo_VotingPowerSnapshot proto.InternalMessageInfo
 
-// SetConsKeyReq is the request to set the operator's consensus key for an AVS.
-type SetConsKeyReq struct {
-	// address is the operator address
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// avs_address is the address of the AVS.
-	AvsAddress string `protobuf:"bytes,2,opt,name=avs_address,json=avsAddress,proto3" json:"avs_address,omitempty"`
-	// public_key_json is the consensus public key of the operator in the JSON format, for example:
-	// `{"@type":"/cosmos.crypto.ed25519.PubKey","key":"Ui5Gf1+mtWUdH8u3xlmzdKID+F3PK0sfXZ73GZ6q6is="}`
-	// there is no need to check for knowledge of the corresponding private key since this is ED25519
-	// and not BLS key, where a rogue key attack can take place. however, we should still check for
-	// overlap with another operator's key.
-	PublicKeyJSON string `protobuf:"bytes,3,opt,name=public_key_json,json=publicKeyJson,proto3" json:"public_key_json,omitempty"`
+func (m *VotingPowerSnapshot) GetAVSAddress() string {
+	if m != nil {
+		return m.AVSAddress
+	}
+	return ""
 }
 
-func (m *SetConsKeyReq) Reset()         { *m = SetConsKeyReq{} }
-func (m *SetConsKeyReq) String() string { return proto.CompactTextString(m) }
-func (*SetConsKeyReq) ProtoMessage()    {}
-func (*SetConsKeyReq) Descriptor() ([]byte, []int) {
+func (m *VotingPowerSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *VotingPowerSnapshot) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *VotingPowerSnapshot) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *VotingPowerSnapshot) GetOperatorVotingPowers() []OperatorVotingPower {
+	if m != nil {
+		return m.OperatorVotingPowers
+	}
+	return nil
+}
+
+// PriceBreakerState is the state of the price breaker of an asset, which is updated with the
+// price rounds of the oracle module.
+type PriceBreakerState struct {
+	// asset_id is the ID of the asset.
+	AssetID string `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// round_id is the latest price round observed.
+	RoundID uint64 `protobuf:"varint,2,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	// price is the price of the latest round observed.
+	Price github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"price"`
+	// decimal is the decimal of the price.
+	Decimal uint32 `protobuf:"varint,4,opt,name=decimal,proto3" json:"decimal,omitempty"`
+	// update_height is the block height at which the last new price round is observed.
+	UpdateHeight int64 `protobuf:"varint,5,opt,name=update_height,json=updateHeight,proto3" json:"update_height,omitempty"`
+	// tripped indicates whether the breaker is tripped.
+	Tripped bool `protobuf:"varint,6,opt,name=tripped,proto3" json:"tripped,omitempty"`
+	// reason is the reason why the breaker is tripped.
+	Reason string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *PriceBreakerState) Reset()         { *m = PriceBreakerState{} }
+func (m *PriceBreakerState) String() string { return proto.CompactTextString(m) }
+func (*PriceBreakerState) ProtoMessage()    {}
+func (*PriceBreakerState) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b229d5663e4df167, []int{17}
 }
-func (m *SetConsKeyReq) XXX_Unmarshal(b []byte) error {
+func (m *PriceBreakerState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SetConsKeyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PriceBreakerState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SetConsKeyReq.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PriceBreakerState.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -982,34 +1228,85 @@ func (m *SetConsKeyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *SetConsKeyReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetConsKeyReq.Merge(m, src)
+func (m *PriceBreakerState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceBreakerState.Merge(m, src)
 }
-func (m *SetConsKeyReq) XXX_Size() int {
+func (m *PriceBreakerState) XXX_Size() int {
 	return m.Size()
 }
-func (m *SetConsKeyReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetConsKeyReq.DiscardUnknown(m)
+func (m *PriceBreakerState) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceBreakerState.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetConsKeyReq proto.InternalMessageInfo
+var xxx_messageInfo_PriceBreakerState proto.InternalMessageInfo
 
-// SetConsKeyResponse is the response to SetConsKeyReq.
-type SetConsKeyResponse struct {
+func (m *PriceBreakerState) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
 }
 
-func (m *SetConsKeyResponse) Reset()         { *m = SetConsKeyResponse{} }
-func (m *SetConsKeyResponse) String() string { return proto.CompactTextString(m) }
-func (*SetConsKeyResponse) ProtoMessage()    {}
-func (*SetConsKeyResponse) Descriptor() ([]byte, []int) {
+func (m *PriceBreakerState) GetRoundID() uint64 {
+	if m != nil {
+		return m.RoundID
+	}
+	return 0
+}
+
+func (m *PriceBreakerState) GetDecimal() uint32 {
+	if m != nil {
+		return m.Decimal
+	}
+	return 0
+}
+
+func (m *PriceBreakerState) GetUpdateHeight() int64 {
+	if m != nil {
+		return m.UpdateHeight
+	}
+	return 0
+}
+
+func (m *PriceBreakerState) GetTripped() bool {
+	if m != nil {
+		return m.Tripped
+	}
+	return false
+}
+
+func (m *PriceBreakerState) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgVetoSlash is the request to veto a pending slash, it can be sent by the governance
+// authority or a member of the veto committee designated by the AVS in its info.
+type MsgVetoSlash struct {
+	// from_address is the address of the governance authority or a veto committee member.
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// operator_address is the address of the slashed operator.
+	OperatorAddress string `protobuf:"bytes,2,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// avs_address is the address of the AVS which submits the slash.
+	AVSAddress string `protobuf:"bytes,3,opt,name=avs_address,json=avsAddress,proto3" json:"avs_address,omitempty"`
+	// slash_id is the ID of the slash to be vetoed.
+	SlashID string `protobuf:"bytes,4,opt,name=slash_id,json=slashId,proto3" json:"slash_id,omitempty"`
+}
+
+func (m *MsgVetoSlash) Reset()         { *m = MsgVetoSlash{} }
+func (m *MsgVetoSlash) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoSlash) ProtoMessage()    {}
+func (*MsgVetoSlash) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b229d5663e4df167, []int{18}
 }
-func (m *SetConsKeyResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgVetoSlash) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SetConsKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgVetoSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SetConsKeyResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgVetoSlash.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1019,1492 +1316,5214 @@ func (m *SetConsKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *SetConsKeyResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetConsKeyResponse.Merge(m, src)
+func (m *MsgVetoSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoSlash.Merge(m, src)
 }
-func (m *SetConsKeyResponse) XXX_Size() int {
+func (m *MsgVetoSlash) XXX_Size() int {
 	return m.Size()
 }
-func (m *SetConsKeyResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetConsKeyResponse.DiscardUnknown(m)
+func (m *MsgVetoSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoSlash.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetConsKeyResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgVetoSlash proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("exocore.operator.v1.SlashType", SlashType_name, SlashType_value)
-	proto.RegisterType((*DecValueField)(nil), "exocore.operator.v1.DecValueField")
-	proto.RegisterType((*OperatorOptedUSDValue)(nil), "exocore.operator.v1.OperatorOptedUSDValue")
-	proto.RegisterType((*ClientChainEarningAddrList)(nil), "exocore.operator.v1.ClientChainEarningAddrList")
-	proto.RegisterType((*ClientChainEarningAddrInfo)(nil), "exocore.operator.v1.ClientChainEarningAddrInfo")
-	proto.RegisterType((*OperatorInfo)(nil), "exocore.operator.v1.OperatorInfo")
-	proto.RegisterType((*OptedInfo)(nil), "exocore.operator.v1.OptedInfo")
-	proto.RegisterType((*OptedInAssetState)(nil), "exocore.operator.v1.OptedInAssetState")
-	proto.RegisterType((*SlashFromUndelegation)(nil), "exocore.operator.v1.SlashFromUndelegation")
-	proto.RegisterType((*SlashFromAssetsPool)(nil), "exocore.operator.v1.SlashFromAssetsPool")
-	proto.RegisterType((*SlashExecutionInfo)(nil), "exocore.operator.v1.SlashExecutionInfo")
-	proto.RegisterType((*OperatorSlashInfo)(nil), "exocore.operator.v1.OperatorSlashInfo")
-	proto.RegisterType((*RegisterOperatorReq)(nil), "exocore.operator.v1.RegisterOperatorReq")
-	proto.RegisterType((*RegisterOperatorResponse)(nil), "exocore.operator.v1.RegisterOperatorResponse")
-	proto.RegisterType((*OptIntoAVSReq)(nil), "exocore.operator.v1.OptIntoAVSReq")
-	proto.RegisterType((*OptIntoAVSResponse)(nil), "exocore.operator.v1.OptIntoAVSResponse")
-	proto.RegisterType((*OptOutOfAVSReq)(nil), "exocore.operator.v1.OptOutOfAVSReq")
-	proto.RegisterType((*OptOutOfAVSResponse)(nil), "exocore.operator.v1.OptOutOfAVSResponse")
-	proto.RegisterType((*SetConsKeyReq)(nil), "exocore.operator.v1.SetConsKeyReq")
-	proto.RegisterType((*SetConsKeyResponse)(nil), "exocore.operator.v1.SetConsKeyResponse")
+func (m *MsgVetoSlash) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("exocore/operator/v1/tx.proto", fileDescriptor_b229d5663e4df167) }
-
-var fileDescriptor_b229d5663e4df167 = []byte{
-	// 1545 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcb, 0x4f, 0x1b, 0xd7,
-	0x1a, 0x67, 0x6c, 0x5e, 0xfe, 0x6c, 0x63, 0x33, 0xe4, 0xe1, 0xf8, 0xe6, 0xe2, 0x30, 0xb9, 0x21,
-	0x84, 0x7b, 0xb1, 0x05, 0xb9, 0xb9, 0x52, 0x72, 0xef, 0xe2, 0x1a, 0x0c, 0x8a, 0x1b, 0xb0, 0xd1,
-	0x0c, 0x44, 0x6a, 0xaa, 0x6a, 0x34, 0x8c, 0x0f, 0x66, 0xc2, 0x78, 0xce, 0x74, 0xce, 0xb1, 0x0b,
-	0x59, 0x55, 0x59, 0x45, 0x55, 0x17, 0x95, 0xb2, 0xaa, 0xda, 0x45, 0x56, 0x55, 0x97, 0x59, 0x64,
-	0x5b, 0xb5, 0xdd, 0x65, 0x19, 0xa5, 0x5d, 0x54, 0x5d, 0xd0, 0x8a, 0x54, 0x4a, 0xff, 0x88, 0x56,
-	0xaa, 0xce, 0x99, 0x33, 0x66, 0x9c, 0xd8, 0x24, 0x28, 0xa4, 0x1b, 0xe0, 0x7c, 0xaf, 0xdf, 0xef,
-	0x7b, 0x9c, 0xef, 0xd8, 0xc0, 0x59, 0xb4, 0x83, 0x4d, 0xec, 0xa1, 0x02, 0x76, 0x91, 0x67, 0x50,
-	0xec, 0x15, 0x5a, 0xb3, 0x05, 0xba, 0x93, 0x77, 0x3d, 0x4c, 0xb1, 0x3c, 0x26, 0xb4, 0xf9, 0x40,
-	0x9b, 0x6f, 0xcd, 0x66, 0x47, 0x8d, 0x86, 0xe5, 0xe0, 0x02, 0xff, 0xe9, 0xdb, 0x65, 0x4f, 0x9b,
-	0x98, 0x34, 0x30, 0x29, 0x34, 0x48, 0x9d, 0xf9, 0x37, 0x48, 0x5d, 0x28, 0xfe, 0x21, 0x14, 0x84,
-	0x1a, 0xdb, 0x96, 0xc3, 0x94, 0x1b, 0x88, 0x1a, 0xb3, 0xc1, 0x59, 0x58, 0x9d, 0xf1, 0xad, 0x74,
-	0x7e, 0x2a, 0xf8, 0x07, 0xa1, 0x3a, 0x51, 0xc7, 0x75, 0xec, 0xcb, 0xd9, 0x5f, 0x42, 0x7a, 0xb6,
-	0x8e, 0x71, 0xdd, 0x46, 0x05, 0xc3, 0xb5, 0x0a, 0x86, 0xe3, 0x60, 0x6a, 0x50, 0x0b, 0x3b, 0xc2,
-	0x47, 0x41, 0x90, 0x2c, 0x21, 0xf3, 0xa6, 0x61, 0x37, 0xd1, 0x92, 0x85, 0xec, 0x9a, 0xbc, 0x06,
-	0x83, 0x46, 0x03, 0x37, 0x1d, 0x9a, 0x91, 0xce, 0x49, 0x53, 0xb1, 0xf9, 0xff, 0x3d, 0xde, 0xcb,
-	0xf5, 0xfd, 0xb4, 0x97, 0x9b, 0xac, 0x5b, 0x74, 0xab, 0xb9, 0x91, 0x37, 0x71, 0x43, 0xa0, 0x8a,
-	0x5f, 0x33, 0xa4, 0xb6, 0x5d, 0xa0, 0xbb, 0x2e, 0x22, 0xf9, 0x12, 0x32, 0x9f, 0x3e, 0x9a, 0x01,
-	0x41, 0xaa, 0x84, 0x4c, 0x55, 0xc4, 0x52, 0x7e, 0x8f, 0xc0, 0xc9, 0xaa, 0xa8, 0x4b, 0xd5, 0xa5,
-	0xa8, 0xb6, 0xae, 0x95, 0x38, 0xa8, 0xec, 0xc1, 0x08, 0x41, 0xf6, 0xa6, 0xde, 0x24, 0x35, 0xbd,
-	0xc5, 0x24, 0x02, 0x77, 0xf9, 0x68, 0xb8, 0xfb, 0x7b, 0xb9, 0x84, 0x86, 0xec, 0xcd, 0x20, 0xee,
-	0x0b, 0x3c, 0x12, 0x0c, 0x63, 0x9d, 0xd4, 0x7c, 0xcc, 0x26, 0xa4, 0x28, 0xa6, 0x86, 0x1d, 0x02,
-	0x8d, 0x70, 0xd0, 0x95, 0x23, 0x83, 0x26, 0xd7, 0x58, 0xa0, 0x1e, 0xa8, 0x49, 0x8e, 0xd2, 0x86,
-	0xdd, 0x81, 0xb4, 0x61, 0x52, 0xab, 0x85, 0x42, 0xb8, 0x51, 0x8e, 0x5b, 0x39, 0x32, 0xee, 0x48,
-	0x91, 0x47, 0xea, 0x01, 0x3c, 0xe2, 0xe3, 0x04, 0xc8, 0xca, 0x2e, 0x64, 0x17, 0x6c, 0x0b, 0x39,
-	0x74, 0x61, 0xcb, 0xb0, 0x9c, 0x45, 0xc3, 0x73, 0x2c, 0xa7, 0x5e, 0xac, 0xd5, 0xbc, 0x65, 0x8b,
-	0x50, 0xf9, 0x3d, 0x18, 0x45, 0xbe, 0x48, 0xb7, 0x9c, 0x4d, 0xac, 0xdb, 0x16, 0x61, 0xdd, 0x8f,
-	0x4e, 0xc5, 0xe7, 0x0a, 0xf9, 0x2e, 0x53, 0x9d, 0xef, 0x1e, 0xab, 0xec, 0x6c, 0x62, 0x35, 0x25,
-	0x22, 0xb1, 0x03, 0x0b, 0xae, 0x7c, 0x26, 0xf5, 0xc2, 0x66, 0x26, 0xf2, 0xff, 0x41, 0xb6, 0xef,
-	0xe8, 0x26, 0x37, 0xd0, 0x4d, 0x66, 0xa1, 0x5b, 0x35, 0x3e, 0x02, 0xfd, 0xf3, 0x63, 0xfb, 0x7b,
-	0xb9, 0xd4, 0xf2, 0x9d, 0x90, 0x77, 0xb9, 0xa4, 0xa6, 0xec, 0x0e, 0x41, 0x4d, 0xbe, 0x0a, 0x67,
-	0x3a, 0xdc, 0x83, 0x54, 0x8c, 0x5a, 0xcd, 0xf3, 0xdb, 0xaa, 0x9e, 0x32, 0xbb, 0x12, 0x50, 0xbe,
-	0x8b, 0x40, 0x22, 0x98, 0x4a, 0xce, 0xe6, 0x3c, 0x24, 0x85, 0x3b, 0xf1, 0xfd, 0xf9, 0x2c, 0xaa,
-	0x89, 0x40, 0xc8, 0xbc, 0xe4, 0x09, 0x48, 0x18, 0xae, 0xeb, 0xe1, 0x16, 0x0a, 0x63, 0xc4, 0x85,
-	0x8c, 0x9b, 0xfc, 0x0b, 0xe4, 0xa0, 0x5e, 0x7a, 0x03, 0x51, 0x83, 0xd7, 0xd5, 0xef, 0xb5, 0x9a,
-	0x0e, 0x34, 0x2b, 0x88, 0x1a, 0x1c, 0xd5, 0x86, 0x6c, 0xb7, 0x0c, 0x04, 0x85, 0xfe, 0x73, 0xd2,
-	0x11, 0x1b, 0xc1, 0xea, 0xae, 0x9e, 0x7e, 0x39, 0x67, 0x9f, 0xfe, 0x0a, 0x80, 0x89, 0x1b, 0x0d,
-	0x8b, 0x10, 0x0b, 0x3b, 0x99, 0x01, 0x1e, 0x5d, 0xc9, 0x8b, 0xe1, 0x09, 0x76, 0x8d, 0xd8, 0x3d,
-	0xf9, 0x85, 0xb6, 0xe5, 0x7c, 0x8c, 0xcd, 0xe8, 0x57, 0xcf, 0x1f, 0x4e, 0x4b, 0x6a, 0x28, 0x80,
-	0xf2, 0x85, 0x04, 0x31, 0x7e, 0xa3, 0x79, 0x2a, 0x17, 0x60, 0x84, 0xd8, 0x06, 0xd9, 0xd2, 0x4d,
-	0xec, 0x50, 0xcf, 0x30, 0xc5, 0x16, 0x51, 0x93, 0x5c, 0xba, 0x20, 0x84, 0xf2, 0x24, 0xa4, 0x30,
-	0xf3, 0xd1, 0x2d, 0x47, 0xdf, 0x42, 0x56, 0x7d, 0x8b, 0xf2, 0x2a, 0xf6, 0xab, 0x49, 0xec, 0x87,
-	0xba, 0xce, 0x85, 0xf2, 0x14, 0xa4, 0x7d, 0x3b, 0xdc, 0xa4, 0x81, 0x61, 0x94, 0x1b, 0x8e, 0x70,
-	0x79, 0xb5, 0x49, 0x85, 0xe5, 0x29, 0x18, 0xbc, 0x6d, 0x58, 0x36, 0xaa, 0xf1, 0x7a, 0x0d, 0xab,
-	0xe2, 0xa4, 0x7c, 0x2d, 0xc1, 0xa8, 0xa0, 0x57, 0x24, 0x04, 0x51, 0x8d, 0x1a, 0x14, 0xbd, 0xd1,
-	0x92, 0x2b, 0x3b, 0x34, 0x74, 0xdb, 0xca, 0x0e, 0x0d, 0x96, 0x9c, 0xac, 0xc2, 0x40, 0x78, 0x99,
-	0xbc, 0xd9, 0xe6, 0xf4, 0x43, 0x29, 0xdf, 0x4a, 0x70, 0x52, 0x63, 0xb5, 0x5b, 0xf2, 0x70, 0x63,
-	0xdd, 0xa9, 0x21, 0x1b, 0xd5, 0xf9, 0x02, 0x97, 0x2f, 0x41, 0x8c, 0x75, 0x0b, 0x79, 0xc1, 0x85,
-	0x89, 0xcd, 0x27, 0xf6, 0xf7, 0x72, 0xc3, 0x1a, 0x17, 0x96, 0x4b, 0xea, 0xb0, 0xaf, 0x2e, 0xd7,
-	0xe4, 0x49, 0x18, 0x36, 0x58, 0xf2, 0xcc, 0xd2, 0xe7, 0x16, 0xdf, 0xdf, 0xcb, 0x0d, 0xf1, 0x82,
-	0x94, 0x4b, 0xea, 0x10, 0x57, 0x96, 0xc3, 0xbb, 0x3f, 0x7a, 0x7c, 0x65, 0x51, 0xee, 0x4b, 0x30,
-	0xd6, 0x4e, 0x81, 0x63, 0x92, 0x55, 0x8c, 0xed, 0x0e, 0x56, 0xd2, 0x6b, 0xb1, 0x8a, 0x1c, 0x23,
-	0xab, 0xcf, 0xa3, 0x20, 0x73, 0x56, 0x8b, 0x3b, 0xc8, 0x6c, 0xb2, 0x8a, 0xf2, 0x01, 0xae, 0x43,
-	0xda, 0x1f, 0x60, 0xd7, 0xc3, 0x2e, 0xf6, 0x98, 0xfc, 0x58, 0x1e, 0xc2, 0x14, 0x8f, 0xba, 0xda,
-	0x0e, 0x2a, 0xbf, 0x0f, 0x71, 0x1f, 0xe8, 0xf8, 0x46, 0x06, 0x78, 0x40, 0xff, 0xad, 0x31, 0x60,
-	0xcc, 0x0f, 0xdf, 0x0c, 0xcd, 0x0c, 0xc9, 0x44, 0xf9, 0x56, 0x9f, 0xee, 0xba, 0x4c, 0xba, 0x8e,
-	0xd9, 0x7c, 0x3f, 0xa3, 0xa4, 0xca, 0x3c, 0x58, 0x58, 0x41, 0xe4, 0x5b, 0x30, 0xea, 0x43, 0xf0,
-	0x46, 0x11, 0xdd, 0xc5, 0xd8, 0xce, 0xf4, 0x73, 0x80, 0xa9, 0xc3, 0x01, 0x0e, 0x86, 0x40, 0x84,
-	0xf7, 0xab, 0x73, 0x20, 0x56, 0xfe, 0x88, 0xb0, 0x6b, 0xeb, 0xbb, 0x72, 0xb7, 0xa3, 0x6c, 0x97,
-	0x4b, 0x90, 0x26, 0xcd, 0x8d, 0x86, 0x45, 0xd9, 0xe6, 0x08, 0xad, 0x97, 0xa8, 0x9a, 0x6a, 0xcb,
-	0xc5, 0xda, 0x98, 0x80, 0x04, 0x6a, 0xb1, 0xcd, 0x1b, 0x5a, 0x2e, 0x51, 0x35, 0xce, 0x65, 0xc2,
-	0xe4, 0x6f, 0x10, 0xb3, 0x88, 0xde, 0x42, 0x14, 0xb7, 0x97, 0xcb, 0xb0, 0x45, 0x6e, 0xf2, 0x73,
-	0xd7, 0x71, 0x19, 0x78, 0x1b, 0xe3, 0xf2, 0x77, 0xf0, 0xbb, 0xab, 0x33, 0x8f, 0xcc, 0xe0, 0x39,
-	0x69, 0x2a, 0xa9, 0xc6, 0xb8, 0x64, 0x6d, 0xd7, 0x45, 0x72, 0x05, 0x46, 0x50, 0x30, 0xc7, 0xfe,
-	0x63, 0x33, 0xc4, 0x17, 0xfb, 0xc5, 0xde, 0x8d, 0xe8, 0x98, 0x7b, 0x35, 0x89, 0xc2, 0x47, 0xe5,
-	0x1b, 0x09, 0xc6, 0x54, 0x54, 0xb7, 0x08, 0x45, 0x5e, 0xd0, 0x07, 0x15, 0x7d, 0x20, 0xff, 0x17,
-	0x12, 0x9b, 0x1e, 0x6e, 0xf0, 0x97, 0x09, 0x11, 0x22, 0xae, 0x46, 0xe6, 0xe9, 0xa3, 0x99, 0x13,
-	0x82, 0x7d, 0xd1, 0xd7, 0x68, 0xd4, 0xb3, 0x9c, 0xba, 0x1a, 0x67, 0xd6, 0x42, 0x24, 0x5f, 0x81,
-	0x7e, 0x4e, 0x2d, 0xc2, 0xa9, 0x4d, 0x74, 0xa5, 0x16, 0x7e, 0x8e, 0x55, 0x6e, 0x7e, 0xed, 0xdf,
-	0xf7, 0x1e, 0xe4, 0xfa, 0x7e, 0x7b, 0x90, 0xeb, 0xbb, 0xfb, 0xfc, 0xe1, 0x74, 0x7c, 0xe9, 0x20,
-	0xe0, 0xc7, 0xcf, 0x1f, 0x4e, 0x9f, 0x0e, 0x15, 0x33, 0xec, 0xab, 0x64, 0x21, 0xf3, 0x72, 0x02,
-	0xc4, 0xc5, 0x0e, 0x41, 0xca, 0xcf, 0x12, 0x24, 0xab, 0x2e, 0x2d, 0x3b, 0x14, 0x17, 0x6f, 0x6a,
-	0x6f, 0x9c, 0x57, 0x0e, 0xe2, 0x46, 0x8b, 0xb4, 0x7d, 0xfd, 0xcf, 0x03, 0x60, 0xb4, 0x48, 0x60,
-	0x70, 0x15, 0x52, 0x6e, 0x73, 0xc3, 0xb6, 0x4c, 0x7d, 0x1b, 0xed, 0xea, 0xb7, 0x09, 0x76, 0xc4,
-	0x82, 0x1d, 0x65, 0x9f, 0x20, 0x57, 0xb9, 0xea, 0x06, 0xda, 0x7d, 0x47, 0xab, 0x56, 0xd4, 0xa4,
-	0xdb, 0x3e, 0x12, 0xec, 0x5c, 0xbb, 0x72, 0x58, 0xf2, 0x99, 0x8e, 0xe4, 0x43, 0xf9, 0x28, 0x27,
-	0x40, 0x0e, 0x0b, 0x44, 0xde, 0x5f, 0x4a, 0x30, 0x52, 0x75, 0x69, 0xb5, 0x49, 0xab, 0x9b, 0x7f,
-	0x45, 0xe2, 0xd7, 0xfe, 0x73, 0x18, 0xfb, 0x33, 0x9d, 0xec, 0x43, 0xac, 0x94, 0x93, 0x30, 0xd6,
-	0x21, 0x11, 0xfc, 0x9f, 0x4a, 0x90, 0xd4, 0x10, 0x5d, 0xc0, 0x0e, 0xb9, 0x81, 0x76, 0x19, 0xfd,
-	0x39, 0x18, 0x7a, 0x5d, 0xe6, 0x81, 0xe1, 0x5b, 0x6d, 0xd7, 0x6c, 0x38, 0xe1, 0x00, 0xf1, 0xc5,
-	0x56, 0x75, 0xa4, 0xc0, 0x5a, 0x15, 0x16, 0xf8, 0xa9, 0x4e, 0xdb, 0x10, 0xd3, 0xda, 0xb7, 0x3b,
-	0x0b, 0xa7, 0xb4, 0xe5, 0xa2, 0x76, 0x5d, 0x5f, 0x7b, 0x77, 0x75, 0x51, 0x5f, 0xaf, 0x68, 0xab,
-	0x8b, 0x0b, 0xe5, 0xa5, 0xf2, 0x62, 0x29, 0xdd, 0x27, 0x9f, 0x85, 0x4c, 0x48, 0x57, 0xae, 0x68,
-	0x6b, 0xc5, 0xca, 0x9a, 0xce, 0x45, 0x69, 0x49, 0xbe, 0x00, 0x13, 0x21, 0x6d, 0xa5, 0x1a, 0x18,
-	0x14, 0x2b, 0x8b, 0xd5, 0x75, 0x4d, 0x98, 0x45, 0xe6, 0x7e, 0xe8, 0x87, 0xe8, 0x0a, 0xa9, 0xcb,
-	0x0f, 0x24, 0x48, 0xbf, 0x78, 0x6b, 0xe4, 0xee, 0xcb, 0xbc, 0xcb, 0x76, 0xc8, 0xce, 0xbc, 0xa6,
-	0xa5, 0x68, 0xe7, 0xe5, 0xbb, 0xdf, 0xff, 0x7a, 0x3f, 0x32, 0xa3, 0xfc, 0xb3, 0xd0, 0xfd, 0x8b,
-	0x75, 0xa1, 0xdb, 0x06, 0xba, 0x27, 0x01, 0x1c, 0xd4, 0x4b, 0x56, 0xba, 0x2f, 0xb8, 0x70, 0x85,
-	0xb3, 0x17, 0x5f, 0x69, 0x23, 0x08, 0xcd, 0x70, 0x42, 0x17, 0x95, 0x0b, 0xbd, 0x08, 0x75, 0x0e,
-	0x1f, 0xa3, 0x72, 0x70, 0xcb, 0x7a, 0x50, 0xe9, 0xb8, 0x97, 0x3d, 0xa8, 0x74, 0xb9, 0xaa, 0xaf,
-	0xa4, 0xd2, 0xb9, 0xbf, 0x3e, 0x91, 0x20, 0x1e, 0xba, 0x31, 0xf2, 0xf9, 0x5e, 0x38, 0xa1, 0x5b,
-	0x96, 0x9d, 0x7a, 0xb5, 0x91, 0x60, 0x93, 0xe7, 0x6c, 0xa6, 0x94, 0xc9, 0x43, 0xd8, 0x84, 0x23,
-	0x0f, 0x7c, 0xc4, 0xbe, 0x27, 0xcc, 0x2f, 0x3f, 0xde, 0x1f, 0x97, 0x9e, 0xec, 0x8f, 0x4b, 0xbf,
-	0xec, 0x8f, 0x4b, 0x9f, 0x3e, 0x1b, 0xef, 0x7b, 0xf2, 0x6c, 0xbc, 0xef, 0xc7, 0x67, 0xe3, 0x7d,
-	0xb7, 0xe6, 0x42, 0xaf, 0xe2, 0xa2, 0x1f, 0xb2, 0x82, 0xe8, 0x87, 0xd8, 0xdb, 0x6e, 0x23, 0xec,
-	0x1c, 0x60, 0xf0, 0x57, 0x72, 0x63, 0x90, 0xff, 0xc7, 0xe2, 0xf2, 0x9f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x0c, 0x47, 0x83, 0x3c, 0x87, 0x11, 0x00, 0x00,
+func (m *MsgVetoSlash) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// RegisterOperator registers a new operator.
-	RegisterOperator(ctx context.Context, in *RegisterOperatorReq, opts ...grpc.CallOption) (*RegisterOperatorResponse, error)
-	// SetConsKey sets the operator's consensus key for an AVS. To do this, the operator
-	// must have previously opted into the AVS.
-	SetConsKey(ctx context.Context, in *SetConsKeyReq, opts ...grpc.CallOption) (*SetConsKeyResponse, error)
-	// OptIntoAVS opts an operator into an AVS.
-	OptIntoAVS(ctx context.Context, in *OptIntoAVSReq, opts ...grpc.CallOption) (*OptIntoAVSResponse, error)
-	// OptOutOfAVS opts an operator out of an AVS.
-	OptOutOfAVS(ctx context.Context, in *OptOutOfAVSReq, opts ...grpc.CallOption) (*OptOutOfAVSResponse, error)
+func (m *MsgVetoSlash) GetAVSAddress() string {
+	if m != nil {
+		return m.AVSAddress
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgVetoSlash) GetSlashID() string {
+	if m != nil {
+		return m.SlashID
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgVetoSlashResponse is the response to MsgVetoSlash.
+type MsgVetoSlashResponse struct {
 }
 
-func (c *msgClient) RegisterOperator(ctx context.Context, in *RegisterOperatorReq, opts ...grpc.CallOption) (*RegisterOperatorResponse, error) {
-	out := new(RegisterOperatorResponse)
-	err := c.cc.Invoke(ctx, "/exocore.operator.v1.Msg/RegisterOperator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgVetoSlashResponse) Reset()         { *m = MsgVetoSlashResponse{} }
+func (m *MsgVetoSlashResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoSlashResponse) ProtoMessage()    {}
+func (*MsgVetoSlashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b229d5663e4df167, []int{19}
+}
+func (m *MsgVetoSlashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoSlashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoSlashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgVetoSlashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoSlashResponse.Merge(m, src)
+}
+func (m *MsgVetoSlashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoSlashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoSlashResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) SetConsKey(ctx context.Context, in *SetConsKeyReq, opts ...grpc.CallOption) (*SetConsKeyResponse, error) {
-	out := new(SetConsKeyResponse)
-	err := c.cc.Invoke(ctx, "/exocore.operator.v1.Msg/SetConsKey", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgVetoSlashResponse proto.InternalMessageInfo
+
+// MsgUpdateOperatorCommission is the request to update the commission rate of an operator.
+// The rate can be changed once within 24 hours, and the change is subject to the max rate and
+// the max change rate of the commission. It takes effect at the end of the current epoch.
+type MsgUpdateOperatorCommission struct {
+	// from_address is the address of the operator.
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// commission_rate is the new commission rate.
+	CommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate"`
 }
 
-func (c *msgClient) OptIntoAVS(ctx context.Context, in *OptIntoAVSReq, opts ...grpc.CallOption) (*OptIntoAVSResponse, error) {
-	out := new(OptIntoAVSResponse)
-	err := c.cc.Invoke(ctx, "/exocore.operator.v1.Msg/OptIntoAVS", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateOperatorCommission) Reset()         { *m = MsgUpdateOperatorCommission{} }
+func (m *MsgUpdateOperatorCommission) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateOperatorCommission) ProtoMessage()    {}
+func (*MsgUpdateOperatorCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b229d5663e4df167, []int{20}
+}
+func (m *MsgUpdateOperatorCommission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateOperatorCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateOperatorCommission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateOperatorCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateOperatorCommission.Merge(m, src)
+}
+func (m *MsgUpdateOperatorCommission) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateOperatorCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateOperatorCommission.DiscardUnknown(m)
 }
 
-func (c *msgClient) OptOutOfAVS(ctx context.Context, in *OptOutOfAVSReq, opts ...grpc.CallOption) (*OptOutOfAVSResponse, error) {
-	out := new(OptOutOfAVSResponse)
-	err := c.cc.Invoke(ctx, "/exocore.operator.v1.Msg/OptOutOfAVS", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateOperatorCommission proto.InternalMessageInfo
+
+func (m *MsgUpdateOperatorCommission) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// RegisterOperator registers a new operator.
-	RegisterOperator(context.Context, *RegisterOperatorReq) (*RegisterOperatorResponse, error)
-	// SetConsKey sets the operator's consensus key for an AVS. To do this, the operator
-	// must have previously opted into the AVS.
-	SetConsKey(context.Context, *SetConsKeyReq) (*SetConsKeyResponse, error)
-	// OptIntoAVS opts an operator into an AVS.
-	OptIntoAVS(context.Context, *OptIntoAVSReq) (*OptIntoAVSResponse, error)
-	// OptOutOfAVS opts an operator out of an AVS.
-	OptOutOfAVS(context.Context, *OptOutOfAVSReq) (*OptOutOfAVSResponse, error)
+// MsgUpdateOperatorCommissionResponse is the response to MsgUpdateOperatorCommission.
+type MsgUpdateOperatorCommissionResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgUpdateOperatorCommissionResponse) Reset()         { *m = MsgUpdateOperatorCommissionResponse{} }
+func (m *MsgUpdateOperatorCommissionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateOperatorCommissionResponse) ProtoMessage()    {}
+func (*MsgUpdateOperatorCommissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b229d5663e4df167, []int{21}
 }
-
-func (*UnimplementedMsgServer) RegisterOperator(ctx context.Context, req *RegisterOperatorReq) (*RegisterOperatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterOperator not implemented")
+func (m *MsgUpdateOperatorCommissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SetConsKey(ctx context.Context, req *SetConsKeyReq) (*SetConsKeyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetConsKey not implemented")
+func (m *MsgUpdateOperatorCommissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateOperatorCommissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) OptIntoAVS(ctx context.Context, req *OptIntoAVSReq) (*OptIntoAVSResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OptIntoAVS not implemented")
+func (m *MsgUpdateOperatorCommissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateOperatorCommissionResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) OptOutOfAVS(ctx context.Context, req *OptOutOfAVSReq) (*OptOutOfAVSResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OptOutOfAVS not implemented")
+func (m *MsgUpdateOperatorCommissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateOperatorCommissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateOperatorCommissionResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgUpdateOperatorCommissionResponse proto.InternalMessageInfo
+
+// MsgEditOperator is the request to edit the information of an operator. The empty fields
+// are left unchanged.
+type MsgEditOperator struct {
+	// from_address is the address of the operator.
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// earnings_addr is the new earnings address.
+	EarningsAddr string `protobuf:"bytes,2,opt,name=earnings_addr,json=earningsAddr,proto3" json:"earnings_addr,omitempty"`
+	// operator_meta_info is the new operator meta info.
+	OperatorMetaInfo string `protobuf:"bytes,3,opt,name=operator_meta_info,json=operatorMetaInfo,proto3" json:"operator_meta_info,omitempty"`
+	// client_chain_earnings_addr is the list of the client chain earning addresses to be set,
+	// the address of a client chain that isn't in the list is left unchanged.
+	ClientChainEarningsAddr *ClientChainEarningAddrList `protobuf:"bytes,4,opt,name=client_chain_earnings_addr,json=clientChainEarningsAddr,proto3" json:"client_chain_earnings_addr,omitempty"`
 }
 
-func _Msg_RegisterOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterOperatorReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterOperator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/exocore.operator.v1.Msg/RegisterOperator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterOperator(ctx, req.(*RegisterOperatorReq))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgEditOperator) Reset()         { *m = MsgEditOperator{} }
+func (m *MsgEditOperator) String() string { return proto.CompactTextString(m) }
+func (*MsgEditOperator) ProtoMessage()    {}
+func (*MsgEditOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b229d5663e4df167, []int{22}
 }
-
-func _Msg_SetConsKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetConsKeyReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetConsKey(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/exocore.operator.v1.Msg/SetConsKey",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetConsKey(ctx, req.(*SetConsKeyReq))
+func (m *MsgEditOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEditOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEditOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgEditOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEditOperator.Merge(m, src)
+}
+func (m *MsgEditOperator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEditOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEditOperator.DiscardUnknown(m)
 }
 
-func _Msg_OptIntoAVS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OptIntoAVSReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).OptIntoAVS(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/exocore.operator.v1.Msg/OptIntoAVS",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).OptIntoAVS(ctx, req.(*OptIntoAVSReq))
+var xxx_messageInfo_MsgEditOperator proto.InternalMessageInfo
+
+func (m *MsgEditOperator) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_OptOutOfAVS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OptOutOfAVSReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).OptOutOfAVS(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/exocore.operator.v1.Msg/OptOutOfAVS",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).OptOutOfAVS(ctx, req.(*OptOutOfAVSReq))
+func (m *MsgEditOperator) GetEarningsAddr() string {
+	if m != nil {
+		return m.EarningsAddr
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "exocore.operator.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterOperator",
-			Handler:    _Msg_RegisterOperator_Handler,
-		},
-		{
-			MethodName: "SetConsKey",
-			Handler:    _Msg_SetConsKey_Handler,
-		},
-		{
-			MethodName: "OptIntoAVS",
-			Handler:    _Msg_OptIntoAVS_Handler,
-		},
-		{
-			MethodName: "OptOutOfAVS",
-			Handler:    _Msg_OptOutOfAVS_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "exocore/operator/v1/tx.proto",
+func (m *MsgEditOperator) GetOperatorMetaInfo() string {
+	if m != nil {
+		return m.OperatorMetaInfo
+	}
+	return ""
 }
 
-func (m *DecValueField) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgEditOperator) GetClientChainEarningsAddr() *ClientChainEarningAddrList {
+	if m != nil {
+		return m.ClientChainEarningsAddr
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DecValueField) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgEditOperatorResponse is the response to MsgEditOperator.
+type MsgEditOperatorResponse struct {
 }
 
-func (m *DecValueField) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgEditOperatorResponse) Reset()         { *m = MsgEditOperatorResponse{} }
+func (m *MsgEditOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEditOperatorResponse) ProtoMessage()    {}
+func (*MsgEditOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b229d5663e4df167, []int{23}
+}
+func (m *MsgEditOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEditOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEditOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *OperatorOptedUSDValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgEditOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEditOperatorResponse.Merge(m, src)
+}
+func (m *MsgEditOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEditOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEditOperatorResponse.DiscardUnknown(m)
 }
 
-func (m *OperatorOptedUSDValue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgEditOperatorResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the request to update the parameters of the module.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/operator parameters to update.
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *OperatorOptedUSDValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ActiveUSDValue.Size()
-		i -= size
-		if _, err := m.ActiveUSDValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.TotalUSDValue.Size()
-		i -= size
-		if _, err := m.TotalUSDValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.SelfUSDValue.Size()
-		i -= size
-		if _, err := m.SelfUSDValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b229d5663e4df167, []int{24}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *ClientChainEarningAddrList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
 }
-
-func (m *ClientChainEarningAddrList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *ClientChainEarningAddrList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EarningInfoList) > 0 {
-		for iNdEx := len(m.EarningInfoList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EarningInfoList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ClientChainEarningAddrInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *ClientChainEarningAddrInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateParamsResponse is the response to MsgUpdateParams.
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *ClientChainEarningAddrInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClientChainEarningAddr) > 0 {
-		i -= len(m.ClientChainEarningAddr)
-		copy(dAtA[i:], m.ClientChainEarningAddr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientChainEarningAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.LzClientChainID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LzClientChainID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *OperatorInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b229d5663e4df167, []int{25}
 }
-
-func (m *OperatorInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *OperatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.ClientChainEarningsAddr != nil {
-		{
-			size, err := m.ClientChainEarningsAddr.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.OperatorMetaInfo) > 0 {
-		i -= len(m.OperatorMetaInfo)
-		copy(dAtA[i:], m.OperatorMetaInfo)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorMetaInfo)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ApproveAddr) > 0 {
-		i -= len(m.ApproveAddr)
-		copy(dAtA[i:], m.ApproveAddr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ApproveAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.EarningsAddr) > 0 {
-		i -= len(m.EarningsAddr)
-		copy(dAtA[i:], m.EarningsAddr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EarningsAddr)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *OptedInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *OptedInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// RegisterOperatorReq is the request to register a new operator.
+type RegisterOperatorReq struct {
+	// from_address is the address of the operator (sdk.AccAddress).
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// info is the operator info.
+	Info *OperatorInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
 }
 
-func (m *OptedInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Jailed {
-		i--
-		if m.Jailed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *RegisterOperatorReq) Reset()         { *m = RegisterOperatorReq{} }
+func (m *RegisterOperatorReq) String() string { return proto.CompactTextString(m) }
+func (*RegisterOperatorReq) ProtoMessage()    {}
+func (*RegisterOperatorReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b229d5663e4df167, []int{26}
+}
+func (m *RegisterOperatorReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterOperatorReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterOperatorReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.OptedOutHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OptedOutHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.OptedInHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OptedInHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.SlashContract) > 0 {
-		i -= len(m.SlashContract)
-		copy(dAtA[i:], m.SlashContract)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SlashContract)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *OptedInAssetState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *RegisterOperatorReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterOperatorReq.Merge(m, src)
+}
+func (m *RegisterOperatorReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterOperatorReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterOperatorReq.DiscardUnknown(m)
 }
 
-func (m *OptedInAssetState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_RegisterOperatorReq proto.InternalMessageInfo
+
+// RegisterOperatorResponse is the response to a register operator request.
+type RegisterOperatorResponse struct {
 }
 
-func (m *OptedInAssetState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Value.Size()
-		i -= size
-		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *RegisterOperatorResponse) Reset()         { *m = RegisterOperatorResponse{} }
+func (m *RegisterOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterOperatorResponse) ProtoMessage()    {}
+func (*RegisterOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b229d5663e4df167, []int{27}
+}
+func (m *RegisterOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *SlashFromUndelegation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *RegisterOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterOperatorResponse.Merge(m, src)
+}
+func (m *RegisterOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterOperatorResponse.DiscardUnknown(m)
 }
 
-func (m *SlashFromUndelegation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_RegisterOperatorResponse proto.InternalMessageInfo
+
+// OptIntoAVSReq is the request to opt into an AVS.
+type OptIntoAVSReq struct {
+	// from_address is the address of the operator (sdk.AccAddress).
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// avs_address is the address of the AVS.
+	AvsAddress string `protobuf:"bytes,2,opt,name=avs_address,json=avsAddress,proto3" json:"avs_address,omitempty"`
+	// public_key_json is an optional parameter to provide the consensus key.
+	// the key is provided in the JSON format and not the hex format.
+	// TODO: allow providing a BLS key here, depending on the AVS.
+	PublicKeyJSON string `protobuf:"bytes,3,opt,name=public_key_json,json=publicKeyJson,proto3" json:"public_key_json,omitempty"`
 }
 
-func (m *SlashFromUndelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *OptIntoAVSReq) Reset()         { *m = OptIntoAVSReq{} }
+func (m *OptIntoAVSReq) String() string { return proto.CompactTextString(m) }
+func (*OptIntoAVSReq) ProtoMessage()    {}
+func (*OptIntoAVSReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b229d5663e4df167, []int{28}
+}
+func (m *OptIntoAVSReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OptIntoAVSReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OptIntoAVSReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.AssetID) > 0 {
-		i -= len(m.AssetID)
-		copy(dAtA[i:], m.AssetID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.StakerID) > 0 {
-		i -= len(m.StakerID)
-		copy(dAtA[i:], m.StakerID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerID)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *SlashFromAssetsPool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *OptIntoAVSReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptIntoAVSReq.Merge(m, src)
+}
+func (m *OptIntoAVSReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *OptIntoAVSReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptIntoAVSReq.DiscardUnknown(m)
 }
 
-func (m *SlashFromAssetsPool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_OptIntoAVSReq proto.InternalMessageInfo
+
+// OptIntoAVSResponse is the response to a opt into an AVS request.
+type OptIntoAVSResponse struct {
 }
 
-func (m *SlashFromAssetsPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *OptIntoAVSResponse) Reset()         { *m = OptIntoAVSResponse{} }
+func (m *OptIntoAVSResponse) String() string { return proto.CompactTextString(m) }
+func (*OptIntoAVSResponse) ProtoMessage()    {}
+func (*OptIntoAVSResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b229d5663e4df167, []int{29}
+}
+func (m *OptIntoAVSResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OptIntoAVSResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OptIntoAVSResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.AssetID) > 0 {
-		i -= len(m.AssetID)
-		copy(dAtA[i:], m.AssetID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *SlashExecutionInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *OptIntoAVSResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptIntoAVSResponse.Merge(m, src)
+}
+func (m *OptIntoAVSResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OptIntoAVSResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptIntoAVSResponse.DiscardUnknown(m)
 }
 
-func (m *SlashExecutionInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_OptIntoAVSResponse proto.InternalMessageInfo
+
+// OptOutOfAVSReq is the request to opt out from an AVS.
+type OptOutOfAVSReq struct {
+	// from_address is the address of the operator (sdk.AccAddress).
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// avs_address is the address of the AVS.
+	AvsAddress string `protobuf:"bytes,2,opt,name=avs_address,json=avsAddress,proto3" json:"avs_address,omitempty"`
 }
 
-func (m *SlashExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SlashAssetsPool) > 0 {
-		for iNdEx := len(m.SlashAssetsPool) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SlashAssetsPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.SlashUndelegations) > 0 {
-		for iNdEx := len(m.SlashUndelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SlashUndelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	{
-		size := m.SlashValue.Size()
-		i -= size
-		if _, err := m.SlashValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.SlashProportion.Size()
-		i -= size
-		if _, err := m.SlashProportion.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *OptOutOfAVSReq) Reset()         { *m = OptOutOfAVSReq{} }
+func (m *OptOutOfAVSReq) String() string { return proto.CompactTextString(m) }
+func (*OptOutOfAVSReq) ProtoMessage()    {}
+func (*OptOutOfAVSReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b229d5663e4df167, []int{30}
+}
+func (m *OptOutOfAVSReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OptOutOfAVSReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OptOutOfAVSReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *OperatorSlashInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *OptOutOfAVSReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptOutOfAVSReq.Merge(m, src)
+}
+func (m *OptOutOfAVSReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *OptOutOfAVSReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptOutOfAVSReq.DiscardUnknown(m)
 }
 
-func (m *OperatorSlashInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_OptOutOfAVSReq proto.InternalMessageInfo
+
+// OptOutOfAVSResponse is the response to a opt out of an AVS request.
+type OptOutOfAVSResponse struct {
 }
 
-func (m *OperatorSlashInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExecutionInfo != nil {
-		{
-			size, err := m.ExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *OptOutOfAVSResponse) Reset()         { *m = OptOutOfAVSResponse{} }
+func (m *OptOutOfAVSResponse) String() string { return proto.CompactTextString(m) }
+func (*OptOutOfAVSResponse) ProtoMessage()    {}
+func (*OptOutOfAVSResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b229d5663e4df167, []int{31}
+}
+func (m *OptOutOfAVSResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OptOutOfAVSResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OptOutOfAVSResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x3a
+		return b[:n], nil
 	}
-	if m.SlashType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SlashType))
-		i--
-		dAtA[i] = 0x30
-	}
-	{
-		size := m.SlashProportion.Size()
-		i -= size
-		if _, err := m.SlashProportion.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *OptOutOfAVSResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptOutOfAVSResponse.Merge(m, src)
+}
+func (m *OptOutOfAVSResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OptOutOfAVSResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptOutOfAVSResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OptOutOfAVSResponse proto.InternalMessageInfo
+
+// SetConsKeyReq is the request to set the operator's consensus key for an AVS.
+type SetConsKeyReq struct {
+	// address is the operator address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// avs_address is the address of the AVS.
+	AvsAddress string `protobuf:"bytes,2,opt,name=avs_address,json=avsAddress,proto3" json:"avs_address,omitempty"`
+	// public_key_json is the consensus public key of the operator in the JSON format, for example:
+	// `{"@type":"/cosmos.crypto.ed25519.PubKey","key":"Ui5Gf1+mtWUdH8u3xlmzdKID+F3PK0sfXZ73GZ6q6is="}`
+	// there is no need to check for knowledge of the corresponding private key since this is ED25519
+	// and not BLS key, where a rogue key attack can take place. however, we should still check for
+	// overlap with another operator's key.
+	PublicKeyJSON string `protobuf:"bytes,3,opt,name=public_key_json,json=publicKeyJson,proto3" json:"public_key_json,omitempty"`
+}
+
+func (m *SetConsKeyReq) Reset()         { *m = SetConsKeyReq{} }
+func (m *SetConsKeyReq) String() string { return proto.CompactTextString(m) }
+func (*SetConsKeyReq) ProtoMessage()    {}
+func (*SetConsKeyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b229d5663e4df167, []int{32}
+}
+func (m *SetConsKeyReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetConsKeyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetConsKeyReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	if m.IsVetoed {
-		i--
-		if m.IsVetoed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+}
+func (m *SetConsKeyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetConsKeyReq.Merge(m, src)
+}
+func (m *SetConsKeyReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetConsKeyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetConsKeyReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetConsKeyReq proto.InternalMessageInfo
+
+// SetConsKeyResponse is the response to SetConsKeyReq.
+type SetConsKeyResponse struct {
+}
+
+func (m *SetConsKeyResponse) Reset()         { *m = SetConsKeyResponse{} }
+func (m *SetConsKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*SetConsKeyResponse) ProtoMessage()    {}
+func (*SetConsKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b229d5663e4df167, []int{33}
+}
+func (m *SetConsKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetConsKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetConsKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.EventHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EventHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.SubmittedHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SubmittedHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.SlashContract) > 0 {
-		i -= len(m.SlashContract)
-		copy(dAtA[i:], m.SlashContract)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SlashContract)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SetConsKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetConsKeyResponse.Merge(m, src)
+}
+func (m *SetConsKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetConsKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetConsKeyResponse.DiscardUnknown(m)
 }
 
-func (m *RegisterOperatorReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_SetConsKeyResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("exocore.operator.v1.SlashType", SlashType_name, SlashType_value)
+	proto.RegisterType((*DecValueField)(nil), "exocore.operator.v1.DecValueField")
+	proto.RegisterType((*OperatorOptedUSDValue)(nil), "exocore.operator.v1.OperatorOptedUSDValue")
+	proto.RegisterType((*ClientChainEarningAddrList)(nil), "exocore.operator.v1.ClientChainEarningAddrList")
+	proto.RegisterType((*ClientChainEarningAddrInfo)(nil), "exocore.operator.v1.ClientChainEarningAddrInfo")
+	proto.RegisterType((*OperatorInfo)(nil), "exocore.operator.v1.OperatorInfo")
+	proto.RegisterType((*OptedInfo)(nil), "exocore.operator.v1.OptedInfo")
+	proto.RegisterType((*OptedInAssetState)(nil), "exocore.operator.v1.OptedInAssetState")
+	proto.RegisterType((*SlashFromUndelegation)(nil), "exocore.operator.v1.SlashFromUndelegation")
+	proto.RegisterType((*SlashFromRedelegation)(nil), "exocore.operator.v1.SlashFromRedelegation")
+	proto.RegisterType((*SlashFromAssetsPool)(nil), "exocore.operator.v1.SlashFromAssetsPool")
+	proto.RegisterType((*SlashExecutionInfo)(nil), "exocore.operator.v1.SlashExecutionInfo")
+	proto.RegisterType((*OperatorSlashInfo)(nil), "exocore.operator.v1.OperatorSlashInfo")
+	proto.RegisterType((*PendingSlash)(nil), "exocore.operator.v1.PendingSlash")
+	proto.RegisterType((*UnbondingMaturity)(nil), "exocore.operator.v1.UnbondingMaturity")
+	proto.RegisterType((*PendingCommissionUpdate)(nil), "exocore.operator.v1.PendingCommissionUpdate")
+	proto.RegisterType((*OperatorVotingPower)(nil), "exocore.operator.v1.OperatorVotingPower")
+	proto.RegisterType((*VotingPowerSnapshot)(nil), "exocore.operator.v1.VotingPowerSnapshot")
+	proto.RegisterType((*PriceBreakerState)(nil), "exocore.operator.v1.PriceBreakerState")
+	proto.RegisterType((*MsgVetoSlash)(nil), "exocore.operator.v1.MsgVetoSlash")
+	proto.RegisterType((*MsgVetoSlashResponse)(nil), "exocore.operator.v1.MsgVetoSlashResponse")
+	proto.RegisterType((*MsgUpdateOperatorCommission)(nil), "exocore.operator.v1.MsgUpdateOperatorCommission")
+	proto.RegisterType((*MsgUpdateOperatorCommissionResponse)(nil), "exocore.operator.v1.MsgUpdateOperatorCommissionResponse")
+	proto.RegisterType((*MsgEditOperator)(nil), "exocore.operator.v1.MsgEditOperator")
+	proto.RegisterType((*MsgEditOperatorResponse)(nil), "exocore.operator.v1.MsgEditOperatorResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "exocore.operator.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "exocore.operator.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*RegisterOperatorReq)(nil), "exocore.operator.v1.RegisterOperatorReq")
+	proto.RegisterType((*RegisterOperatorResponse)(nil), "exocore.operator.v1.RegisterOperatorResponse")
+	proto.RegisterType((*OptIntoAVSReq)(nil), "exocore.operator.v1.OptIntoAVSReq")
+	proto.RegisterType((*OptIntoAVSResponse)(nil), "exocore.operator.v1.OptIntoAVSResponse")
+	proto.RegisterType((*OptOutOfAVSReq)(nil), "exocore.operator.v1.OptOutOfAVSReq")
+	proto.RegisterType((*OptOutOfAVSResponse)(nil), "exocore.operator.v1.OptOutOfAVSResponse")
+	proto.RegisterType((*SetConsKeyReq)(nil), "exocore.operator.v1.SetConsKeyReq")
+	proto.RegisterType((*SetConsKeyResponse)(nil), "exocore.operator.v1.SetConsKeyResponse")
 }
 
-func (m *RegisterOperatorReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("exocore/operator/v1/tx.proto", fileDescriptor_b229d5663e4df167) }
+
+var fileDescriptor_b229d5663e4df167 = []byte{
+	// 2394 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcb, 0x6f, 0x1b, 0xc9,
+	0xd1, 0xd7, 0x90, 0x94, 0x44, 0x96, 0xf8, 0x90, 0x46, 0xb6, 0x4c, 0xd3, 0xfe, 0x24, 0x7b, 0x6c,
+	0x4b, 0xb2, 0x3e, 0x4b, 0x5c, 0xdb, 0xf1, 0x62, 0xad, 0xdd, 0x43, 0xf4, 0x32, 0xcc, 0xd8, 0x7a,
+	0x60, 0x28, 0x19, 0xc8, 0x06, 0xc1, 0x60, 0xc4, 0x69, 0x51, 0xb3, 0x26, 0xa7, 0x27, 0xd3, 0x4d,
+	0xd9, 0xda, 0x53, 0xb0, 0x97, 0x2c, 0x82, 0x1c, 0x82, 0xf8, 0x92, 0x5c, 0x02, 0x9f, 0x82, 0xcd,
+	0xcd, 0x87, 0xbd, 0xe6, 0x61, 0xe4, 0xb2, 0xa7, 0x60, 0xe1, 0x1c, 0xb2, 0xc8, 0x41, 0x09, 0xe4,
+	0x00, 0xce, 0x21, 0x7f, 0x42, 0x5e, 0xe8, 0xc7, 0x0c, 0x67, 0xe4, 0xa1, 0x1e, 0xa0, 0x36, 0xc8,
+	0x65, 0xd7, 0x5d, 0x5d, 0x5d, 0xf5, 0xab, 0x5f, 0x57, 0x55, 0x17, 0x47, 0x70, 0x11, 0x3d, 0xc5,
+	0x35, 0xec, 0xa1, 0x32, 0x76, 0x91, 0x67, 0x52, 0xec, 0x95, 0x77, 0x6e, 0x96, 0xe9, 0xd3, 0x19,
+	0xd7, 0xc3, 0x14, 0xab, 0xc3, 0x72, 0x77, 0xc6, 0xdf, 0x9d, 0xd9, 0xb9, 0x59, 0x1a, 0x32, 0x9b,
+	0xb6, 0x83, 0xcb, 0xfc, 0xbf, 0x42, 0xaf, 0x74, 0xae, 0x86, 0x49, 0x13, 0x93, 0x72, 0x93, 0xd4,
+	0xd9, 0xf9, 0x26, 0xa9, 0xcb, 0x8d, 0xab, 0x72, 0x83, 0x50, 0xf3, 0xb1, 0xed, 0xb0, 0xcd, 0x4d,
+	0x44, 0xcd, 0x9b, 0xfe, 0x5a, 0x6a, 0x9d, 0x17, 0x5a, 0x06, 0x5f, 0x95, 0xc5, 0x42, 0x6e, 0x5d,
+	0x8a, 0xc3, 0xe7, 0x9a, 0x9e, 0xd9, 0xf4, 0x35, 0xce, 0xd4, 0x71, 0x1d, 0x8b, 0x93, 0xec, 0x5f,
+	0x52, 0x7a, 0xb1, 0x8e, 0x71, 0xbd, 0x81, 0xca, 0xa6, 0x6b, 0x97, 0x4d, 0xc7, 0xc1, 0xd4, 0xa4,
+	0x36, 0x76, 0xe4, 0x19, 0x0d, 0x41, 0x6e, 0x11, 0xd5, 0x1e, 0x99, 0x8d, 0x16, 0xba, 0x67, 0xa3,
+	0x86, 0xa5, 0xae, 0x43, 0x9f, 0xd9, 0xc4, 0x2d, 0x87, 0x16, 0x95, 0x4b, 0xca, 0x64, 0x66, 0xfe,
+	0x83, 0x2f, 0xf6, 0xc6, 0x7a, 0xfe, 0xb4, 0x37, 0x36, 0x5e, 0xb7, 0xe9, 0x76, 0x6b, 0x73, 0xa6,
+	0x86, 0x9b, 0x12, 0x97, 0xfc, 0xdf, 0x34, 0xb1, 0x1e, 0x97, 0xe9, 0xae, 0x8b, 0xc8, 0xcc, 0x22,
+	0xaa, 0xbd, 0xfa, 0x7c, 0x1a, 0x24, 0xec, 0x45, 0x54, 0xd3, 0xa5, 0x2d, 0xed, 0x1f, 0x09, 0x38,
+	0xbb, 0x2a, 0x71, 0xaf, 0xba, 0x14, 0x59, 0x1b, 0xd5, 0x45, 0xee, 0x54, 0xf5, 0x20, 0x4f, 0x50,
+	0x63, 0xcb, 0x68, 0x11, 0xcb, 0xd8, 0x61, 0x12, 0xe9, 0xf7, 0xe1, 0xc9, 0xfc, 0xee, 0xef, 0x8d,
+	0x65, 0xab, 0xa8, 0xb1, 0xe5, 0xdb, 0x3d, 0x80, 0x23, 0xcb, 0x7c, 0x6c, 0x10, 0x4b, 0xf8, 0x6c,
+	0x41, 0x81, 0x62, 0x6a, 0x36, 0x42, 0x4e, 0x13, 0xdc, 0xe9, 0xf2, 0x89, 0x9d, 0xe6, 0xd6, 0x99,
+	0xa1, 0x0e, 0x5e, 0x73, 0xdc, 0x4b, 0xe0, 0xf6, 0x29, 0x0c, 0x9a, 0x35, 0x6a, 0xef, 0xa0, 0x90,
+	0xdf, 0x24, 0xf7, 0xbb, 0x72, 0x62, 0xbf, 0xf9, 0x39, 0x6e, 0xa9, 0x83, 0xe3, 0xbc, 0xf0, 0xe3,
+	0x7b, 0xd6, 0x76, 0xa1, 0xb4, 0xd0, 0xb0, 0x91, 0x43, 0x17, 0xb6, 0x4d, 0xdb, 0x59, 0x32, 0x3d,
+	0xc7, 0x76, 0xea, 0x73, 0x96, 0xe5, 0x3d, 0xb4, 0x09, 0x55, 0xbf, 0x03, 0x43, 0x48, 0x88, 0x0c,
+	0xdb, 0xd9, 0xc2, 0x46, 0xc3, 0x26, 0xec, 0xf6, 0x93, 0x93, 0x03, 0xb7, 0xca, 0x33, 0x31, 0x79,
+	0x3f, 0x13, 0x6f, 0xab, 0xe2, 0x6c, 0x61, 0xbd, 0x20, 0x2d, 0xb1, 0x05, 0x33, 0xae, 0xfd, 0x4c,
+	0xe9, 0xe4, 0x9b, 0xa9, 0xa8, 0xdf, 0x04, 0xb5, 0xf1, 0xb1, 0x51, 0xe3, 0x0a, 0x46, 0x8d, 0x69,
+	0x18, 0xb6, 0xc5, 0x53, 0x20, 0x35, 0x3f, 0xbc, 0xbf, 0x37, 0x56, 0x78, 0xf8, 0x71, 0xe8, 0x74,
+	0x65, 0x51, 0x2f, 0x34, 0x22, 0x02, 0x4b, 0xbd, 0x0b, 0xe7, 0x23, 0xc7, 0xfd, 0x50, 0x4c, 0xcb,
+	0xf2, 0xc4, 0xb5, 0xea, 0x23, 0xb5, 0x58, 0x00, 0xda, 0xcb, 0x04, 0x64, 0xfd, 0xac, 0xe4, 0x68,
+	0xae, 0x40, 0x4e, 0x1e, 0x27, 0xe2, 0x3c, 0xcf, 0x45, 0x3d, 0xeb, 0x0b, 0xd9, 0x29, 0xf5, 0x32,
+	0x64, 0x4d, 0xd7, 0xf5, 0xf0, 0x0e, 0x0a, 0xfb, 0x18, 0x90, 0x32, 0xae, 0x72, 0x03, 0x54, 0x9f,
+	0x2f, 0xa3, 0x89, 0xa8, 0xc9, 0x79, 0x15, 0x77, 0xad, 0x0f, 0xfa, 0x3b, 0xcb, 0x88, 0x9a, 0xdc,
+	0x6b, 0x03, 0x4a, 0x71, 0x11, 0x48, 0x08, 0xa9, 0x4b, 0xca, 0x09, 0x2f, 0x82, 0xf1, 0xae, 0x9f,
+	0x7b, 0x3b, 0x66, 0x01, 0x7f, 0x19, 0xa0, 0x86, 0x9b, 0x4d, 0x9b, 0x10, 0x1b, 0x3b, 0xc5, 0x5e,
+	0x6e, 0x5d, 0x9b, 0x91, 0xc9, 0xe3, 0x77, 0x23, 0xd9, 0x9d, 0x66, 0x16, 0x02, 0xcd, 0xf9, 0x0c,
+	0xcb, 0xd1, 0xcf, 0xde, 0xbc, 0x98, 0x52, 0xf4, 0x90, 0x01, 0xed, 0x8f, 0x0a, 0x64, 0x78, 0x45,
+	0xf3, 0x50, 0xae, 0x41, 0x9e, 0x34, 0x4c, 0xb2, 0x6d, 0xd4, 0xb0, 0x43, 0x3d, 0xb3, 0x26, 0xbb,
+	0x88, 0x9e, 0xe3, 0xd2, 0x05, 0x29, 0x54, 0xc7, 0xa1, 0x80, 0xd9, 0x19, 0xc3, 0x76, 0x8c, 0x6d,
+	0x64, 0xd7, 0xb7, 0x29, 0x67, 0x31, 0xa5, 0xe7, 0xb0, 0x30, 0x75, 0x9f, 0x0b, 0xd5, 0x49, 0x18,
+	0x14, 0x7a, 0xb8, 0x45, 0x7d, 0xc5, 0x24, 0x57, 0xcc, 0x73, 0xf9, 0x6a, 0x8b, 0x4a, 0xcd, 0x11,
+	0xe8, 0xfb, 0xc8, 0xb4, 0x1b, 0xc8, 0xe2, 0x7c, 0xa5, 0x75, 0xb9, 0x62, 0xd9, 0xb1, 0x89, 0x1a,
+	0xf8, 0x89, 0xd1, 0xb4, 0x1d, 0x83, 0x37, 0x1a, 0x0b, 0x35, 0x50, 0xdd, 0xa4, 0x7e, 0xf0, 0x69,
+	0x7d, 0x84, 0x2b, 0x2c, 0xdb, 0x0e, 0xeb, 0x1f, 0x8b, 0xc1, 0xae, 0xf6, 0x2b, 0x05, 0x86, 0x64,
+	0x64, 0x73, 0x84, 0x20, 0x5a, 0xa5, 0x26, 0x45, 0x5d, 0xf5, 0xc7, 0x8a, 0x43, 0x43, 0x85, 0x5a,
+	0x71, 0xa8, 0xdf, 0x1f, 0x55, 0x1d, 0x7a, 0xc3, 0x7d, 0xa8, 0xbb, 0xa6, 0x2b, 0x4c, 0x69, 0xbf,
+	0x55, 0xe0, 0x6c, 0x95, 0xd1, 0x7e, 0xcf, 0xc3, 0xcd, 0x0d, 0xa7, 0x1d, 0xb7, 0x7a, 0x1d, 0x32,
+	0xec, 0xa2, 0x91, 0xe7, 0xd7, 0x5a, 0x66, 0x3e, 0xbb, 0xbf, 0x37, 0x96, 0xae, 0x72, 0x61, 0x65,
+	0x51, 0x4f, 0x8b, 0xed, 0x8a, 0xa5, 0x8e, 0x43, 0xda, 0x64, 0xc1, 0x33, 0x4d, 0x81, 0x6d, 0x60,
+	0x7f, 0x6f, 0xac, 0x9f, 0x13, 0x52, 0x59, 0xd4, 0xfb, 0xf9, 0x66, 0x25, 0xfc, 0x6c, 0x24, 0x4f,
+	0x8f, 0x16, 0xed, 0xef, 0xe1, 0x10, 0x74, 0xf4, 0xf5, 0x86, 0x30, 0x05, 0x43, 0x16, 0xa1, 0x46,
+	0x50, 0xb8, 0xbc, 0xfa, 0x44, 0xcd, 0x16, 0x2c, 0x42, 0xfd, 0x46, 0xc1, 0x8b, 0xa8, 0x1d, 0x6e,
+	0xea, 0x14, 0xc3, 0x7d, 0xa6, 0xc0, 0x70, 0x10, 0x2e, 0xc7, 0x47, 0xd6, 0x30, 0x6e, 0x44, 0x22,
+	0x50, 0x8e, 0x75, 0x09, 0x89, 0x53, 0x44, 0xf5, 0xaf, 0x24, 0xa8, 0x1c, 0xd5, 0xd2, 0x53, 0x54,
+	0x6b, 0x31, 0xf6, 0x79, 0xa9, 0xd7, 0x61, 0x50, 0x94, 0xba, 0xeb, 0x61, 0x17, 0x7b, 0xbc, 0xa0,
+	0x4e, 0x63, 0x64, 0x28, 0x70, 0xab, 0x6b, 0x81, 0x51, 0xf5, 0xbb, 0x30, 0x20, 0x1c, 0x9d, 0x5e,
+	0x85, 0x00, 0x37, 0x28, 0x5e, 0x65, 0x13, 0x86, 0x85, 0xf9, 0x56, 0xa8, 0x44, 0x48, 0x31, 0xc9,
+	0xdf, 0xbf, 0xa9, 0xd8, 0xb6, 0x1b, 0x5b, 0x55, 0xf3, 0x29, 0x06, 0x49, 0x57, 0xb9, 0xb1, 0xf0,
+	0x06, 0x51, 0x3f, 0x84, 0x21, 0xe1, 0x82, 0x5f, 0x14, 0x31, 0x5c, 0x8c, 0x1b, 0xc5, 0x14, 0x77,
+	0x30, 0x79, 0xb8, 0x83, 0x76, 0x12, 0x48, 0xf3, 0x82, 0x9d, 0xb6, 0xb8, 0x0d, 0xdf, 0x43, 0x61,
+	0xf8, 0xbd, 0xc7, 0x81, 0x1f, 0xae, 0xa8, 0x08, 0xfc, 0xf0, 0x06, 0xd1, 0xfe, 0x99, 0x60, 0x8d,
+	0x50, 0x9c, 0xe7, 0x67, 0x4f, 0xd2, 0xea, 0xaf, 0xc3, 0x20, 0x69, 0x6d, 0x36, 0x6d, 0xca, 0xda,
+	0x78, 0xa8, 0xd7, 0x27, 0xf5, 0x42, 0x20, 0x97, 0x3d, 0xfc, 0x32, 0x64, 0xd1, 0x0e, 0x7b, 0x06,
+	0x43, 0x9d, 0x3e, 0xa9, 0x0f, 0x70, 0x99, 0x54, 0xb9, 0x00, 0x19, 0x9b, 0x18, 0x3b, 0x88, 0xe2,
+	0xa0, 0xd3, 0xa7, 0x6d, 0xf2, 0x88, 0xaf, 0x63, 0x33, 0xb2, 0xf7, 0xeb, 0xc8, 0xc8, 0xff, 0x03,
+	0x91, 0x40, 0x06, 0x3b, 0x51, 0xec, 0xbb, 0xa4, 0x4c, 0xe6, 0xf4, 0x0c, 0x97, 0xac, 0xef, 0xba,
+	0x48, 0x5d, 0x81, 0x3c, 0xf2, 0x4b, 0x45, 0xbc, 0xfc, 0xfd, 0xfc, 0x95, 0x9d, 0xe8, 0x7c, 0x1b,
+	0x91, 0xd2, 0xd2, 0x73, 0x28, 0xbc, 0xd4, 0x7e, 0x99, 0x80, 0xec, 0x1a, 0x72, 0x2c, 0xdb, 0xa9,
+	0x73, 0x65, 0x75, 0x01, 0x06, 0x23, 0x5d, 0x0a, 0x11, 0x22, 0x4b, 0xaf, 0xf8, 0xea, 0xf3, 0xe9,
+	0x33, 0x12, 0xfa, 0x9c, 0xd8, 0xa9, 0x52, 0xcf, 0x76, 0xea, 0x7a, 0x01, 0x87, 0xfa, 0x17, 0x22,
+	0x44, 0x2d, 0xc3, 0x80, 0xb9, 0x43, 0x82, 0xf3, 0xa2, 0xac, 0xf2, 0xfb, 0x7b, 0x63, 0x30, 0xf7,
+	0xa8, 0x2a, 0x95, 0x74, 0x30, 0x77, 0x88, 0x7f, 0x60, 0x1c, 0xd2, 0x22, 0x6a, 0xdb, 0x2a, 0x26,
+	0xdb, 0x5d, 0x48, 0x64, 0xc4, 0xa2, 0xde, 0xcf, 0x37, 0x2b, 0x16, 0xbb, 0x71, 0xe4, 0xe2, 0x1a,
+	0xd3, 0x43, 0x0e, 0xb5, 0xb7, 0x6c, 0x24, 0x86, 0x98, 0x8c, 0x5e, 0xe0, 0xf2, 0x4a, 0x20, 0x56,
+	0x27, 0xa0, 0xd0, 0x66, 0x8a, 0x6f, 0xf2, 0x0b, 0x4b, 0xea, 0x6d, 0x02, 0x97, 0x98, 0x94, 0x29,
+	0x6e, 0xf1, 0x07, 0xdd, 0x30, 0x29, 0x45, 0x4d, 0x97, 0x12, 0x49, 0x7b, 0x5e, 0x88, 0xe7, 0xa4,
+	0x54, 0xfb, 0x7d, 0x02, 0x86, 0x36, 0x9c, 0x4d, 0xcc, 0xd9, 0x5a, 0x36, 0x69, 0xcb, 0xb3, 0xe9,
+	0x2e, 0xbb, 0x30, 0x0f, 0xd5, 0xb0, 0x67, 0x19, 0x8f, 0xd1, 0xae, 0xcc, 0xd3, 0x8c, 0x90, 0x3c,
+	0x40, 0xbb, 0xcc, 0xba, 0x4d, 0x22, 0x05, 0xc4, 0xe9, 0x48, 0xeb, 0x79, 0x9b, 0x44, 0x5e, 0x9d,
+	0x38, 0xe2, 0x93, 0x5d, 0x12, 0x9f, 0x3a, 0x92, 0xf8, 0x38, 0x42, 0x7b, 0xe3, 0x09, 0xbd, 0x0e,
+	0x83, 0x2d, 0x3f, 0x7a, 0xc3, 0x45, 0x9e, 0x8d, 0x2d, 0x4e, 0x54, 0x4a, 0x2f, 0x04, 0xf2, 0x35,
+	0x2e, 0x66, 0xf5, 0xdb, 0x94, 0xfc, 0x48, 0xea, 0xfb, 0x39, 0xf5, 0x39, 0x5f, 0xca, 0x99, 0xd7,
+	0xbe, 0x52, 0xe0, 0x9c, 0x4c, 0xbe, 0xf6, 0x30, 0xb8, 0xe1, 0x5a, 0x6c, 0x16, 0x3a, 0x95, 0x3c,
+	0x8c, 0xce, 0xa3, 0x89, 0x2e, 0xe7, 0xd1, 0x58, 0xb2, 0x92, 0xb1, 0x64, 0x69, 0x2f, 0x15, 0x18,
+	0xf6, 0xfb, 0xda, 0x23, 0x4c, 0x19, 0x35, 0xf8, 0x09, 0xf2, 0x4e, 0x27, 0x2c, 0x04, 0x99, 0x83,
+	0xbf, 0x2e, 0xef, 0x9f, 0xf8, 0x57, 0x5e, 0xba, 0xc3, 0xef, 0xbb, 0x74, 0xcb, 0xff, 0x65, 0xf7,
+	0x93, 0x04, 0x0c, 0x87, 0xb0, 0x57, 0x1d, 0xd3, 0x25, 0xdb, 0x98, 0x1e, 0x4c, 0x32, 0xe5, 0xc8,
+	0x24, 0x1b, 0x81, 0xbe, 0x48, 0x77, 0x96, 0xab, 0x13, 0xf0, 0xc9, 0xfb, 0x37, 0x57, 0x75, 0x5a,
+	0xcd, 0x4d, 0x59, 0xf4, 0xac, 0x7f, 0x33, 0xd9, 0x0a, 0x17, 0xa9, 0x16, 0x8c, 0x04, 0xd4, 0xee,
+	0x70, 0xd8, 0x86, 0xcb, 0x70, 0xfb, 0x0f, 0x56, 0xfc, 0x73, 0x18, 0x73, 0x49, 0xf2, 0xb9, 0x3a,
+	0x83, 0xdf, 0xde, 0x22, 0xda, 0x67, 0x09, 0x18, 0x5a, 0xf3, 0xec, 0x1a, 0x9a, 0xf7, 0x10, 0x1b,
+	0x02, 0xc5, 0xe4, 0x7e, 0xdc, 0x29, 0x6a, 0x1c, 0xd2, 0x1e, 0x6e, 0x39, 0x96, 0x3f, 0x2f, 0xa6,
+	0x84, 0x9e, 0xce, 0x64, 0x4c, 0x8f, 0x6f, 0x56, 0x2c, 0x36, 0xb3, 0xbb, 0xcc, 0xc9, 0xa9, 0x4c,
+	0xbc, 0xc2, 0x94, 0x5a, 0x84, 0x7e, 0x0b, 0xd5, 0xec, 0xa6, 0xd9, 0xe0, 0xec, 0xe5, 0x74, 0x7f,
+	0xc9, 0x7e, 0x9a, 0xb6, 0x78, 0xd5, 0xf9, 0xaf, 0xa3, 0x68, 0x94, 0x59, 0x21, 0x94, 0xcf, 0x63,
+	0x11, 0xfa, 0xa9, 0x67, 0xbb, 0x2e, 0x12, 0x55, 0x9f, 0xd6, 0xfd, 0x25, 0xbb, 0x5e, 0x0f, 0x99,
+	0x04, 0x3b, 0xbc, 0xca, 0x33, 0xba, 0x5c, 0x69, 0x3f, 0x4d, 0x40, 0x76, 0x99, 0xd4, 0xd9, 0x0b,
+	0x2a, 0xde, 0x96, 0xf7, 0x21, 0xbb, 0xe5, 0xe1, 0xe6, 0xb1, 0x13, 0x7f, 0x80, 0x69, 0xfb, 0x49,
+	0x14, 0x57, 0x39, 0x89, 0x2e, 0xfb, 0x63, 0xf2, 0x44, 0x0f, 0x53, 0xaa, 0xf3, 0xc3, 0x34, 0x3b,
+	0xfd, 0xc9, 0x9b, 0x17, 0x53, 0x91, 0xe8, 0x7e, 0xf8, 0xe6, 0xc5, 0xd4, 0xb9, 0xd0, 0xed, 0x84,
+	0x99, 0xd0, 0x46, 0xe0, 0x4c, 0x78, 0xad, 0x23, 0xe2, 0x62, 0x87, 0x20, 0xed, 0xdf, 0x0a, 0x5c,
+	0x58, 0x26, 0x75, 0xd1, 0x03, 0xfd, 0xd4, 0x6c, 0x37, 0xa6, 0xee, 0x18, 0x44, 0x50, 0x68, 0x37,
+	0x33, 0xc3, 0x33, 0xe9, 0xe9, 0x0c, 0xbc, 0xf9, 0xb6, 0x51, 0xdd, 0xa4, 0x68, 0xf6, 0x83, 0x58,
+	0x2a, 0xc6, 0xa3, 0x54, 0x74, 0x8a, 0x50, 0xbb, 0x06, 0x57, 0x0e, 0xd9, 0x0e, 0x88, 0x7a, 0x99,
+	0x80, 0xc2, 0x32, 0xa9, 0x2f, 0x59, 0x76, 0xf0, 0xe3, 0xa9, 0x3b, 0x72, 0xde, 0xfa, 0x3c, 0x93,
+	0x88, 0xf9, 0x3c, 0xf3, 0x3f, 0xfc, 0xed, 0x65, 0xf6, 0x9d, 0x58, 0xda, 0x4b, 0x51, 0xda, 0xc3,
+	0x7c, 0x69, 0xe7, 0xe1, 0xdc, 0x01, 0x51, 0x40, 0xef, 0xaf, 0x15, 0x28, 0x04, 0xd7, 0xb0, 0xc6,
+	0x3f, 0x04, 0xab, 0xef, 0x42, 0xc6, 0x6c, 0xd1, 0x6d, 0xcc, 0xde, 0xef, 0x23, 0xb9, 0x6d, 0xab,
+	0xaa, 0x77, 0xa1, 0x4f, 0x7c, 0x4a, 0x96, 0x0f, 0xf0, 0x85, 0xd8, 0x90, 0x85, 0x13, 0xd9, 0x7a,
+	0xe5, 0x81, 0xd9, 0xf7, 0x58, 0x4c, 0x6d, 0x53, 0x2c, 0xa0, 0x6b, 0xa1, 0x80, 0x9e, 0xb6, 0xbf,
+	0x57, 0x1f, 0x00, 0x2b, 0x63, 0x0b, 0x8b, 0x82, 0xd8, 0x7e, 0xa3, 0xc0, 0xb0, 0x8e, 0xea, 0x36,
+	0xa1, 0xc8, 0x6b, 0x07, 0xfe, 0xbd, 0xee, 0xd2, 0xe7, 0x0e, 0xa4, 0x78, 0x2e, 0x88, 0x10, 0x2f,
+	0x1f, 0xfa, 0xd4, 0xf0, 0x39, 0x9c, 0xab, 0xcf, 0x7e, 0xe3, 0xd3, 0xe7, 0x63, 0x3d, 0x7f, 0x7b,
+	0x3e, 0xd6, 0xc3, 0x02, 0x1d, 0xb8, 0xd7, 0x36, 0x78, 0xb0, 0x7b, 0x84, 0xcf, 0x6a, 0x25, 0x28,
+	0xbe, 0x1d, 0x80, 0x8c, 0xee, 0xcf, 0x0a, 0xe4, 0x56, 0x5d, 0x5a, 0x71, 0x28, 0x9e, 0x7b, 0x54,
+	0xed, 0x3a, 0xae, 0xb1, 0x98, 0x49, 0x3e, 0xd2, 0x20, 0xef, 0x42, 0xc1, 0x6d, 0x6d, 0x36, 0xec,
+	0x1a, 0x1b, 0x7f, 0x8d, 0x8f, 0xd8, 0x2b, 0x20, 0xba, 0xea, 0x10, 0xfb, 0x82, 0xbd, 0xc6, 0xb7,
+	0x1e, 0xa0, 0xdd, 0x6f, 0x55, 0x57, 0x57, 0xf4, 0x9c, 0x1b, 0x2c, 0x09, 0x76, 0x66, 0xef, 0x1c,
+	0x16, 0x7c, 0x31, 0x12, 0x7c, 0x28, 0x1e, 0xed, 0x0c, 0xa8, 0x61, 0x81, 0x8c, 0xfb, 0x17, 0x0a,
+	0xe4, 0x57, 0x5d, 0xba, 0xda, 0xa2, 0xab, 0x5b, 0xff, 0x8d, 0xc0, 0x67, 0xdf, 0x3d, 0x0c, 0xfd,
+	0xf9, 0x28, 0xfa, 0x10, 0x2a, 0xed, 0x2c, 0x0c, 0x47, 0x24, 0x12, 0xff, 0x2b, 0x05, 0x72, 0x55,
+	0x44, 0x17, 0xb0, 0x43, 0x1e, 0xa0, 0x5d, 0x06, 0xff, 0x16, 0xf4, 0x1f, 0x17, 0x79, 0xbf, 0x79,
+	0x4c, 0xd4, 0xdd, 0x5c, 0xd7, 0xcd, 0x70, 0xc0, 0xfd, 0x66, 0xfc, 0x55, 0x45, 0x42, 0x60, 0x57,
+	0x15, 0x16, 0x88, 0x50, 0xa7, 0x1a, 0x90, 0xa9, 0x06, 0x3f, 0x68, 0x4b, 0x30, 0x52, 0x7d, 0x38,
+	0x57, 0xbd, 0x6f, 0xac, 0x7f, 0x7b, 0x6d, 0xc9, 0xd8, 0x58, 0xa9, 0xae, 0x2d, 0x2d, 0x54, 0xee,
+	0x55, 0x96, 0x16, 0x07, 0x7b, 0xd4, 0x8b, 0x50, 0x0c, 0xed, 0x55, 0x56, 0xaa, 0xeb, 0x73, 0x2b,
+	0xeb, 0x06, 0x17, 0x0d, 0x2a, 0xea, 0x35, 0xb8, 0x1c, 0xda, 0x5d, 0x59, 0xf5, 0x15, 0xe6, 0x56,
+	0x96, 0x56, 0x37, 0xaa, 0x52, 0x2d, 0x71, 0xeb, 0xe7, 0x19, 0x48, 0x2e, 0x93, 0xba, 0xfa, 0x5c,
+	0x81, 0xc1, 0x83, 0x55, 0xa3, 0xc6, 0xcf, 0x84, 0x31, 0xdd, 0xa1, 0x34, 0x7d, 0x4c, 0x4d, 0x79,
+	0x9d, 0xb7, 0x3f, 0xf9, 0xc3, 0x5f, 0x9f, 0x25, 0xa6, 0xb5, 0xff, 0x2f, 0xc7, 0xff, 0xe9, 0xaf,
+	0x1c, 0xd7, 0x81, 0x3e, 0x55, 0x00, 0xda, 0x7c, 0xa9, 0x5a, 0xfc, 0x6f, 0xfa, 0x30, 0xc3, 0xa5,
+	0x89, 0x23, 0x75, 0x24, 0xa0, 0x69, 0x0e, 0x68, 0x42, 0xbb, 0xd6, 0x09, 0x50, 0x34, 0xf9, 0x18,
+	0x94, 0x76, 0x95, 0x75, 0x80, 0x12, 0xa9, 0xcb, 0xd2, 0xc4, 0x91, 0x3a, 0xc7, 0x85, 0x12, 0xed,
+	0x5f, 0x3f, 0x52, 0x60, 0x20, 0x54, 0x31, 0xea, 0x95, 0x4e, 0x7e, 0x42, 0x55, 0x56, 0x9a, 0x3c,
+	0x5a, 0x49, 0xa2, 0x99, 0xe1, 0x68, 0x26, 0xb5, 0xf1, 0x43, 0xd0, 0x84, 0xbb, 0xca, 0x0f, 0x14,
+	0xc8, 0xb4, 0x47, 0xda, 0xf8, 0x4e, 0x1f, 0x9e, 0xed, 0x4a, 0xd7, 0x8f, 0x54, 0x09, 0xb0, 0xdc,
+	0xe0, 0x58, 0xc6, 0xb5, 0xab, 0x9d, 0xb0, 0x84, 0x4f, 0xa9, 0xbf, 0x53, 0xa0, 0xd8, 0x71, 0x52,
+	0x7c, 0xa7, 0x93, 0xd7, 0x4e, 0x27, 0x4a, 0xef, 0x9d, 0xf4, 0x44, 0x00, 0xfb, 0x7d, 0x0e, 0xfb,
+	0x8e, 0x76, 0xfb, 0x10, 0xd8, 0x1d, 0x81, 0x3e, 0x53, 0x20, 0x1b, 0x19, 0xe3, 0xae, 0x76, 0xc2,
+	0x11, 0xd6, 0x2a, 0xdd, 0x38, 0x8e, 0x56, 0x80, 0xb0, 0xcc, 0x11, 0x5e, 0xd7, 0x26, 0x0e, 0x41,
+	0x18, 0x01, 0xb1, 0x09, 0xd9, 0xc8, 0xf0, 0x73, 0xf5, 0x70, 0x72, 0x84, 0x56, 0xe9, 0xc6, 0x71,
+	0xb4, 0x7c, 0x50, 0xa5, 0xde, 0xef, 0xb3, 0x2f, 0x0c, 0xf3, 0x0f, 0xbf, 0xd8, 0x1f, 0x55, 0xbe,
+	0xdc, 0x1f, 0x55, 0xfe, 0xb2, 0x3f, 0xaa, 0xfc, 0xf8, 0xf5, 0x68, 0xcf, 0x97, 0xaf, 0x47, 0x7b,
+	0xbe, 0x7a, 0x3d, 0xda, 0xf3, 0xe1, 0xad, 0xd0, 0x3c, 0xbe, 0x24, 0x0c, 0xaf, 0x20, 0xfa, 0x04,
+	0x7b, 0x8f, 0x83, 0x30, 0x42, 0x23, 0x10, 0x9f, 0xcf, 0x37, 0xfb, 0xf8, 0xdf, 0xde, 0x6f, 0xff,
+	0x67, 0x00, 0x49, 0x83, 0x57, 0x5a, 0x73, 0x20, 0x00, 0x00,
 }
 
-func (m *RegisterOperatorReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Info != nil {
-		{
-			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// RegisterOperator registers a new operator.
+	RegisterOperator(ctx context.Context, in *RegisterOperatorReq, opts ...grpc.CallOption) (*RegisterOperatorResponse, error)
+	// SetConsKey sets the operator's consensus key for an AVS. To do this, the operator
+	// must have previously opted into the AVS.
+	SetConsKey(ctx context.Context, in *SetConsKeyReq, opts ...grpc.CallOption) (*SetConsKeyResponse, error)
+	// OptIntoAVS opts an operator into an AVS.
+	OptIntoAVS(ctx context.Context, in *OptIntoAVSReq, opts ...grpc.CallOption) (*OptIntoAVSResponse, error)
+	// OptOutOfAVS opts an operator out of an AVS.
+	OptOutOfAVS(ctx context.Context, in *OptOutOfAVSReq, opts ...grpc.CallOption) (*OptOutOfAVSResponse, error)
+	// VetoSlash vetoes a pending slash, which cancels its execution.
+	VetoSlash(ctx context.Context, in *MsgVetoSlash, opts ...grpc.CallOption) (*MsgVetoSlashResponse, error)
+	// UpdateOperatorCommission schedules an update of the operator's commission rate.
+	UpdateOperatorCommission(ctx context.Context, in *MsgUpdateOperatorCommission, opts ...grpc.CallOption) (*MsgUpdateOperatorCommissionResponse, error)
+	// EditOperator edits the meta info and the earnings addresses of the operator.
+	EditOperator(ctx context.Context, in *MsgEditOperator, opts ...grpc.CallOption) (*MsgEditOperatorResponse, error)
+	// UpdateParams updates the parameters of the module through the governance.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
-func (m *RegisterOperatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RegisterOperator(ctx context.Context, in *RegisterOperatorReq, opts ...grpc.CallOption) (*RegisterOperatorResponse, error) {
+	out := new(RegisterOperatorResponse)
+	err := c.cc.Invoke(ctx, "/exocore.operator.v1.Msg/RegisterOperator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RegisterOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetConsKey(ctx context.Context, in *SetConsKeyReq, opts ...grpc.CallOption) (*SetConsKeyResponse, error) {
+	out := new(SetConsKeyResponse)
+	err := c.cc.Invoke(ctx, "/exocore.operator.v1.Msg/SetConsKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *RegisterOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) OptIntoAVS(ctx context.Context, in *OptIntoAVSReq, opts ...grpc.CallOption) (*OptIntoAVSResponse, error) {
+	out := new(OptIntoAVSResponse)
+	err := c.cc.Invoke(ctx, "/exocore.operator.v1.Msg/OptIntoAVS", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *OptIntoAVSReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) OptOutOfAVS(ctx context.Context, in *OptOutOfAVSReq, opts ...grpc.CallOption) (*OptOutOfAVSResponse, error) {
+	out := new(OptOutOfAVSResponse)
+	err := c.cc.Invoke(ctx, "/exocore.operator.v1.Msg/OptOutOfAVS", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *OptIntoAVSReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) VetoSlash(ctx context.Context, in *MsgVetoSlash, opts ...grpc.CallOption) (*MsgVetoSlashResponse, error) {
+	out := new(MsgVetoSlashResponse)
+	err := c.cc.Invoke(ctx, "/exocore.operator.v1.Msg/VetoSlash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *OptIntoAVSReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKeyJSON) > 0 {
-		i -= len(m.PublicKeyJSON)
-		copy(dAtA[i:], m.PublicKeyJSON)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKeyJSON)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.AvsAddress) > 0 {
-		i -= len(m.AvsAddress)
-		copy(dAtA[i:], m.AvsAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AvsAddress)))
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) UpdateOperatorCommission(ctx context.Context, in *MsgUpdateOperatorCommission, opts ...grpc.CallOption) (*MsgUpdateOperatorCommissionResponse, error) {
+	out := new(MsgUpdateOperatorCommissionResponse)
+	err := c.cc.Invoke(ctx, "/exocore.operator.v1.Msg/UpdateOperatorCommission", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) EditOperator(ctx context.Context, in *MsgEditOperator, opts ...grpc.CallOption) (*MsgEditOperatorResponse, error) {
+	out := new(MsgEditOperatorResponse)
+	err := c.cc.Invoke(ctx, "/exocore.operator.v1.Msg/EditOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *OptIntoAVSResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/exocore.operator.v1.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *OptIntoAVSResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// RegisterOperator registers a new operator.
+	RegisterOperator(context.Context, *RegisterOperatorReq) (*RegisterOperatorResponse, error)
+	// SetConsKey sets the operator's consensus key for an AVS. To do this, the operator
+	// must have previously opted into the AVS.
+	SetConsKey(context.Context, *SetConsKeyReq) (*SetConsKeyResponse, error)
+	// OptIntoAVS opts an operator into an AVS.
+	OptIntoAVS(context.Context, *OptIntoAVSReq) (*OptIntoAVSResponse, error)
+	// OptOutOfAVS opts an operator out of an AVS.
+	OptOutOfAVS(context.Context, *OptOutOfAVSReq) (*OptOutOfAVSResponse, error)
+	// VetoSlash vetoes a pending slash, which cancels its execution.
+	VetoSlash(context.Context, *MsgVetoSlash) (*MsgVetoSlashResponse, error)
+	// UpdateOperatorCommission schedules an update of the operator's commission rate.
+	UpdateOperatorCommission(context.Context, *MsgUpdateOperatorCommission) (*MsgUpdateOperatorCommissionResponse, error)
+	// EditOperator edits the meta info and the earnings addresses of the operator.
+	EditOperator(context.Context, *MsgEditOperator) (*MsgEditOperatorResponse, error)
+	// UpdateParams updates the parameters of the module through the governance.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
-func (m *OptIntoAVSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *OptOutOfAVSReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) RegisterOperator(ctx context.Context, req *RegisterOperatorReq) (*RegisterOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterOperator not implemented")
+}
+func (*UnimplementedMsgServer) SetConsKey(ctx context.Context, req *SetConsKeyReq) (*SetConsKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConsKey not implemented")
+}
+func (*UnimplementedMsgServer) OptIntoAVS(ctx context.Context, req *OptIntoAVSReq) (*OptIntoAVSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OptIntoAVS not implemented")
+}
+func (*UnimplementedMsgServer) OptOutOfAVS(ctx context.Context, req *OptOutOfAVSReq) (*OptOutOfAVSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OptOutOfAVS not implemented")
+}
+func (*UnimplementedMsgServer) VetoSlash(ctx context.Context, req *MsgVetoSlash) (*MsgVetoSlashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VetoSlash not implemented")
+}
+func (*UnimplementedMsgServer) UpdateOperatorCommission(ctx context.Context, req *MsgUpdateOperatorCommission) (*MsgUpdateOperatorCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOperatorCommission not implemented")
+}
+func (*UnimplementedMsgServer) EditOperator(ctx context.Context, req *MsgEditOperator) (*MsgEditOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditOperator not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
 
-func (m *OptOutOfAVSReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *OptOutOfAVSReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AvsAddress) > 0 {
-		i -= len(m.AvsAddress)
-		copy(dAtA[i:], m.AvsAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AvsAddress)))
-		i--
-		dAtA[i] = 0x12
+func _Msg_RegisterOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterOperatorReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterOperator(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.operator.v1.Msg/RegisterOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterOperator(ctx, req.(*RegisterOperatorReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OptOutOfAVSResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SetConsKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConsKeyReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *OptOutOfAVSResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).SetConsKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.operator.v1.Msg/SetConsKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetConsKey(ctx, req.(*SetConsKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OptOutOfAVSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_OptIntoAVS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OptIntoAVSReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OptIntoAVS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.operator.v1.Msg/OptIntoAVS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OptIntoAVS(ctx, req.(*OptIntoAVSReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SetConsKeyReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_OptOutOfAVS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OptOutOfAVSReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).OptOutOfAVS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.operator.v1.Msg/OptOutOfAVS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OptOutOfAVS(ctx, req.(*OptOutOfAVSReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SetConsKeyReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_VetoSlash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVetoSlash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VetoSlash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.operator.v1.Msg/VetoSlash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VetoSlash(ctx, req.(*MsgVetoSlash))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SetConsKeyReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKeyJSON) > 0 {
-		i -= len(m.PublicKeyJSON)
-		copy(dAtA[i:], m.PublicKeyJSON)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKeyJSON)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_UpdateOperatorCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateOperatorCommission)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.AvsAddress) > 0 {
-		i -= len(m.AvsAddress)
-		copy(dAtA[i:], m.AvsAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AvsAddress)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateOperatorCommission(ctx, in)
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.operator.v1.Msg/UpdateOperatorCommission",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateOperatorCommission(ctx, req.(*MsgUpdateOperatorCommission))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SetConsKeyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_EditOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEditOperator)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).EditOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.operator.v1.Msg/EditOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EditOperator(ctx, req.(*MsgEditOperator))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SetConsKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.operator.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "exocore.operator.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterOperator",
+			Handler:    _Msg_RegisterOperator_Handler,
+		},
+		{
+			MethodName: "SetConsKey",
+			Handler:    _Msg_SetConsKey_Handler,
+		},
+		{
+			MethodName: "OptIntoAVS",
+			Handler:    _Msg_OptIntoAVS_Handler,
+		},
+		{
+			MethodName: "OptOutOfAVS",
+			Handler:    _Msg_OptOutOfAVS_Handler,
+		},
+		{
+			MethodName: "VetoSlash",
+			Handler:    _Msg_VetoSlash_Handler,
+		},
+		{
+			MethodName: "UpdateOperatorCommission",
+			Handler:    _Msg_UpdateOperatorCommission_Handler,
+		},
+		{
+			MethodName: "EditOperator",
+			Handler:    _Msg_EditOperator_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "exocore/operator/v1/tx.proto",
+}
+
+func (m *DecValueField) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecValueField) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetConsKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DecValueField) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *OperatorOptedUSDValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *DecValueField) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+
+func (m *OperatorOptedUSDValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OperatorOptedUSDValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *OperatorOptedUSDValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.SelfUSDValue.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.TotalUSDValue.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ActiveUSDValue.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	{
+		size := m.ActiveUSDValue.Size()
+		i -= size
+		if _, err := m.ActiveUSDValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalUSDValue.Size()
+		i -= size
+		if _, err := m.TotalUSDValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SelfUSDValue.Size()
+		i -= size
+		if _, err := m.SelfUSDValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ClientChainEarningAddrList) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClientChainEarningAddrList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientChainEarningAddrList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientChainEarningAddrList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.EarningInfoList) > 0 {
-		for _, e := range m.EarningInfoList {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.EarningInfoList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EarningInfoList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ClientChainEarningAddrInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClientChainEarningAddrInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientChainEarningAddrInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientChainEarningAddrInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LzClientChainID != 0 {
-		n += 1 + sovTx(uint64(m.LzClientChainID))
+	if len(m.ClientChainEarningAddr) > 0 {
+		i -= len(m.ClientChainEarningAddr)
+		copy(dAtA[i:], m.ClientChainEarningAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientChainEarningAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ClientChainEarningAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.LzClientChainID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LzClientChainID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OperatorInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OperatorInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.EarningsAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ApproveAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OperatorMetaInfo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.ClientChainEarningsAddr != nil {
-		l = m.ClientChainEarningsAddr.Size()
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if m.ClientChainEarningsAddr != nil {
+		{
+			size, err := m.ClientChainEarningsAddr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = m.Commission.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.OperatorMetaInfo) > 0 {
+		i -= len(m.OperatorMetaInfo)
+		copy(dAtA[i:], m.OperatorMetaInfo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorMetaInfo)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ApproveAddr) > 0 {
+		i -= len(m.ApproveAddr)
+		copy(dAtA[i:], m.ApproveAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ApproveAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EarningsAddr) > 0 {
+		i -= len(m.EarningsAddr)
+		copy(dAtA[i:], m.EarningsAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EarningsAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OptedInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OptedInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OptedInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptedInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SlashContract)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.BelowMinSelfDelegation {
+		i--
+		if m.BelowMinSelfDelegation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.OptedInHeight != 0 {
-		n += 1 + sovTx(uint64(m.OptedInHeight))
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.OptedOutHeight != 0 {
-		n += 1 + sovTx(uint64(m.OptedOutHeight))
+		i = encodeVarintTx(dAtA, i, uint64(m.OptedOutHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Jailed {
-		n += 2
+	if m.OptedInHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OptedInHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.SlashContract) > 0 {
+		i -= len(m.SlashContract)
+		copy(dAtA[i:], m.SlashContract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SlashContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OptedInAssetState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OptedInAssetState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Value.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SlashFromUndelegation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *OptedInAssetState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptedInAssetState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.StakerID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.AssetID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *SlashFromAssetsPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AssetID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *SlashFromUndelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SlashExecutionInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SlashFromUndelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashFromUndelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.SlashProportion.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.SlashValue.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.SlashUndelegations) > 0 {
-		for _, e := range m.SlashUndelegations {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.SlashAssetsPool) > 0 {
-		for _, e := range m.SlashAssetsPool {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OperatorSlashInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SlashFromRedelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashFromRedelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashFromRedelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SlashContract)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.SubmittedHeight != 0 {
-		n += 1 + sovTx(uint64(m.SubmittedHeight))
+	i--
+	dAtA[i] = 0x22
+	if len(m.DstOperatorAddr) > 0 {
+		i -= len(m.DstOperatorAddr)
+		copy(dAtA[i:], m.DstOperatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DstOperatorAddr)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.EventHeight != 0 {
-		n += 1 + sovTx(uint64(m.EventHeight))
-	}
-	if m.IsVetoed {
-		n += 2
-	}
-	l = m.SlashProportion.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.SlashType != 0 {
-		n += 1 + sovTx(uint64(m.SlashType))
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ExecutionInfo != nil {
-		l = m.ExecutionInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RegisterOperatorReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SlashFromAssetsPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashFromAssetsPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashFromAssetsPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RegisterOperatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SlashExecutionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *OptIntoAVSReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SlashExecutionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.SlashRedelegations) > 0 {
+		for iNdEx := len(m.SlashRedelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRedelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.AvsAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.SlashAssetsPool) > 0 {
+		for iNdEx := len(m.SlashAssetsPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashAssetsPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.PublicKeyJSON)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.SlashUndelegations) > 0 {
+		for iNdEx := len(m.SlashUndelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashUndelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	return n
+	{
+		size := m.SlashValue.Size()
+		i -= size
+		if _, err := m.SlashValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SlashProportion.Size()
+		i -= size
+		if _, err := m.SlashProportion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *OptIntoAVSResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OperatorSlashInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *OptOutOfAVSReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *OperatorSlashInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorSlashInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ExecutionInfo != nil {
+		{
+			size, err := m.ExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.AvsAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.SlashType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SlashType))
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
-}
-
-func (m *OptOutOfAVSResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size := m.SlashProportion.Size()
+		i -= size
+		if _, err := m.SlashProportion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *SetConsKeyReq) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x2a
+	if m.IsVetoed {
+		i--
+		if m.IsVetoed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.EventHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EventHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.AvsAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.SubmittedHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubmittedHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.PublicKeyJSON)
-	if l > 0 {
+	if len(m.SlashContract) > 0 {
+		i -= len(m.SlashContract)
+		copy(dAtA[i:], m.SlashContract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SlashContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedAttempts != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FailedAttempts))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExecutionEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecutionEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SlashID) > 0 {
+		i -= len(m.SlashID)
+		copy(dAtA[i:], m.SlashID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SlashID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AVSAddress) > 0 {
+		i -= len(m.AVSAddress)
+		copy(dAtA[i:], m.AVSAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AVSAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnbondingMaturity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingMaturity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingMaturity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaturityEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaturityEpoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.UnbondingPeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingPeriod))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AVSAddress) > 0 {
+		i -= len(m.AVSAddress)
+		copy(dAtA[i:], m.AVSAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AVSAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.IsRedelegation {
+		i--
+		if m.IsRedelegation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RecordKey) > 0 {
+		i -= len(m.RecordKey)
+		copy(dAtA[i:], m.RecordKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingCommissionUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingCommissionUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingCommissionUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorVotingPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorVotingPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorVotingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.USDValue.Size()
+		i -= size
+		if _, err := m.USDValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotingPowerSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotingPowerSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingPowerSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorVotingPowers) > 0 {
+		for iNdEx := len(m.OperatorVotingPowers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorVotingPowers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AVSAddress) > 0 {
+		i -= len(m.AVSAddress)
+		copy(dAtA[i:], m.AVSAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AVSAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceBreakerState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceBreakerState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceBreakerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Tripped {
+		i--
+		if m.Tripped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UpdateHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpdateHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Decimal != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Decimal))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.RoundID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RoundID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlashID) > 0 {
+		i -= len(m.SlashID)
+		copy(dAtA[i:], m.SlashID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SlashID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AVSAddress) > 0 {
+		i -= len(m.AVSAddress)
+		copy(dAtA[i:], m.AVSAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AVSAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoSlashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoSlashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoSlashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateOperatorCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateOperatorCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateOperatorCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateOperatorCommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateOperatorCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateOperatorCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEditOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClientChainEarningsAddr != nil {
+		{
+			size, err := m.ClientChainEarningsAddr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OperatorMetaInfo) > 0 {
+		i -= len(m.OperatorMetaInfo)
+		copy(dAtA[i:], m.OperatorMetaInfo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorMetaInfo)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EarningsAddr) > 0 {
+		i -= len(m.EarningsAddr)
+		copy(dAtA[i:], m.EarningsAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EarningsAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEditOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterOperatorReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterOperatorReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterOperatorReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Info != nil {
+		{
+			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *OptIntoAVSReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptIntoAVSReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptIntoAVSReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKeyJSON) > 0 {
+		i -= len(m.PublicKeyJSON)
+		copy(dAtA[i:], m.PublicKeyJSON)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKeyJSON)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AvsAddress) > 0 {
+		i -= len(m.AvsAddress)
+		copy(dAtA[i:], m.AvsAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AvsAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OptIntoAVSResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptIntoAVSResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptIntoAVSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *OptOutOfAVSReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptOutOfAVSReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptOutOfAVSReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AvsAddress) > 0 {
+		i -= len(m.AvsAddress)
+		copy(dAtA[i:], m.AvsAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AvsAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OptOutOfAVSResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptOutOfAVSResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptOutOfAVSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SetConsKeyReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetConsKeyReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetConsKeyReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKeyJSON) > 0 {
+		i -= len(m.PublicKeyJSON)
+		copy(dAtA[i:], m.PublicKeyJSON)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKeyJSON)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AvsAddress) > 0 {
+		i -= len(m.AvsAddress)
+		copy(dAtA[i:], m.AvsAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AvsAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetConsKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetConsKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetConsKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DecValueField) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *OperatorOptedUSDValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SelfUSDValue.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TotalUSDValue.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ActiveUSDValue.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *ClientChainEarningAddrList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EarningInfoList) > 0 {
+		for _, e := range m.EarningInfoList {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClientChainEarningAddrInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LzClientChainID != 0 {
+		n += 1 + sovTx(uint64(m.LzClientChainID))
+	}
+	l = len(m.ClientChainEarningAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *OperatorInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EarningsAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ApproveAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorMetaInfo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ClientChainEarningsAddr != nil {
+		l = m.ClientChainEarningsAddr.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Commission.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *OptedInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SlashContract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OptedInHeight != 0 {
+		n += 1 + sovTx(uint64(m.OptedInHeight))
+	}
+	if m.OptedOutHeight != 0 {
+		n += 1 + sovTx(uint64(m.OptedOutHeight))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.BelowMinSelfDelegation {
+		n += 2
+	}
+	return n
+}
+
+func (m *OptedInAssetState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Value.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *SlashFromUndelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *SlashFromRedelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DstOperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *SlashFromAssetsPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *SlashExecutionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SlashProportion.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.SlashValue.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.SlashUndelegations) > 0 {
+		for _, e := range m.SlashUndelegations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.SlashAssetsPool) > 0 {
+		for _, e := range m.SlashAssetsPool {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.SlashRedelegations) > 0 {
+		for _, e := range m.SlashRedelegations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OperatorSlashInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SlashContract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SubmittedHeight != 0 {
+		n += 1 + sovTx(uint64(m.SubmittedHeight))
+	}
+	if m.EventHeight != 0 {
+		n += 1 + sovTx(uint64(m.EventHeight))
+	}
+	if m.IsVetoed {
+		n += 2
+	}
+	l = m.SlashProportion.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SlashType != 0 {
+		n += 1 + sovTx(uint64(m.SlashType))
+	}
+	if m.ExecutionInfo != nil {
+		l = m.ExecutionInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AVSAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SlashID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExecutionEpoch != 0 {
+		n += 1 + sovTx(uint64(m.ExecutionEpoch))
+	}
+	if m.FailedAttempts != 0 {
+		n += 1 + sovTx(uint64(m.FailedAttempts))
+	}
+	return n
+}
+
+func (m *UnbondingMaturity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IsRedelegation {
+		n += 2
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AVSAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingPeriod != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingPeriod))
+	}
+	if m.MaturityEpoch != 0 {
+		n += 1 + sovTx(uint64(m.MaturityEpoch))
+	}
+	return n
+}
+
+func (m *PendingCommissionUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Commission.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *OperatorVotingPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.USDValue.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *VotingPowerSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AVSAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovTx(uint64(m.EpochNumber))
+	}
+	if len(m.OperatorVotingPowers) > 0 {
+		for _, e := range m.OperatorVotingPowers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PriceBreakerState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RoundID != 0 {
+		n += 1 + sovTx(uint64(m.RoundID))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Decimal != 0 {
+		n += 1 + sovTx(uint64(m.Decimal))
+	}
+	if m.UpdateHeight != 0 {
+		n += 1 + sovTx(uint64(m.UpdateHeight))
+	}
+	if m.Tripped {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVetoSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AVSAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SlashID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVetoSlashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateOperatorCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateOperatorCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEditOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EarningsAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorMetaInfo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ClientChainEarningsAddr != nil {
+		l = m.ClientChainEarningsAddr.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEditOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RegisterOperatorReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *OptIntoAVSReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AvsAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PublicKeyJSON)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *OptIntoAVSResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *OptOutOfAVSReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AvsAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *OptOutOfAVSResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SetConsKeyReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AvsAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PublicKeyJSON)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *SetConsKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DecValueField) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecValueField: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecValueField: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorOptedUSDValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorOptedUSDValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorOptedUSDValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfUSDValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SelfUSDValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUSDValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalUSDValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveUSDValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActiveUSDValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientChainEarningAddrList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientChainEarningAddrList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientChainEarningAddrList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarningInfoList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EarningInfoList = append(m.EarningInfoList, &ClientChainEarningAddrInfo{})
+			if err := m.EarningInfoList[len(m.EarningInfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientChainEarningAddrInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientChainEarningAddrInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientChainEarningAddrInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LzClientChainID", wireType)
+			}
+			m.LzClientChainID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LzClientChainID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientChainEarningAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientChainEarningAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarningsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EarningsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproveAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApproveAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorMetaInfo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorMetaInfo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientChainEarningsAddr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientChainEarningsAddr == nil {
+				m.ClientChainEarningsAddr = &ClientChainEarningAddrList{}
+			}
+			if err := m.ClientChainEarningsAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OptedInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptedInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptedInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptedInHeight", wireType)
+			}
+			m.OptedInHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OptedInHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptedOutHeight", wireType)
+			}
+			m.OptedOutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OptedOutHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BelowMinSelfDelegation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BelowMinSelfDelegation = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OptedInAssetState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptedInAssetState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptedInAssetState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashFromUndelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashFromUndelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashFromUndelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashFromRedelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashFromRedelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashFromRedelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstOperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstOperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashFromAssetsPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashFromAssetsPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashFromAssetsPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashExecutionInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashExecutionInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashExecutionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashProportion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashProportion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashUndelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashUndelegations = append(m.SlashUndelegations, SlashFromUndelegation{})
+			if err := m.SlashUndelegations[len(m.SlashUndelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAssetsPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashAssetsPool = append(m.SlashAssetsPool, SlashFromAssetsPool{})
+			if err := m.SlashAssetsPool[len(m.SlashAssetsPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRedelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRedelegations = append(m.SlashRedelegations, SlashFromRedelegation{})
+			if err := m.SlashRedelegations[len(m.SlashRedelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorSlashInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorSlashInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorSlashInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedHeight", wireType)
+			}
+			m.SubmittedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmittedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventHeight", wireType)
+			}
+			m.EventHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsVetoed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsVetoed = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashProportion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashProportion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashType", wireType)
+			}
+			m.SlashType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionInfo == nil {
+				m.ExecutionInfo = &SlashExecutionInfo{}
+			}
+			if err := m.ExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *SetConsKeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *DecValueField) Unmarshal(dAtA []byte) error {
+func (m *PendingSlash) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2527,15 +6546,111 @@ func (m *DecValueField) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecValueField: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingSlash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecValueField: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingSlash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AVSAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AVSAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2552,21 +6667,57 @@ func (m *DecValueField) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionEpoch", wireType)
+			}
+			m.ExecutionEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAttempts", wireType)
+			}
+			m.FailedAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2588,7 +6739,7 @@ func (m *DecValueField) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperatorOptedUSDValue) Unmarshal(dAtA []byte) error {
+func (m *UnbondingMaturity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2611,15 +6762,15 @@ func (m *OperatorOptedUSDValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperatorOptedUSDValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingMaturity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperatorOptedUSDValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingMaturity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SelfUSDValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2647,13 +6798,31 @@ func (m *OperatorOptedUSDValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SelfUSDValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RecordKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRedelegation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsRedelegation = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalUSDValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2681,13 +6850,11 @@ func (m *OperatorOptedUSDValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalUSDValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveUSDValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AVSAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2715,10 +6882,78 @@ func (m *OperatorOptedUSDValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ActiveUSDValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AVSAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			m.UnbondingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaturityEpoch", wireType)
+			}
+			m.MaturityEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaturityEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2740,7 +6975,7 @@ func (m *OperatorOptedUSDValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClientChainEarningAddrList) Unmarshal(dAtA []byte) error {
+func (m *PendingCommissionUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2763,15 +6998,47 @@ func (m *ClientChainEarningAddrList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClientChainEarningAddrList: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingCommissionUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClientChainEarningAddrList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingCommissionUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EarningInfoList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2798,11 +7065,42 @@ func (m *ClientChainEarningAddrList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EarningInfoList = append(m.EarningInfoList, &ClientChainEarningAddrInfo{})
-			if err := m.EarningInfoList[len(m.EarningInfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2824,7 +7122,7 @@ func (m *ClientChainEarningAddrList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClientChainEarningAddrInfo) Unmarshal(dAtA []byte) error {
+func (m *OperatorVotingPower) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2847,17 +7145,17 @@ func (m *ClientChainEarningAddrInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClientChainEarningAddrInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperatorVotingPower: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClientChainEarningAddrInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperatorVotingPower: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LzClientChainID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
-			m.LzClientChainID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2867,14 +7165,27 @@ func (m *ClientChainEarningAddrInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LzClientChainID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientChainEarningAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field USDValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2902,7 +7213,9 @@ func (m *ClientChainEarningAddrInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientChainEarningAddr = string(dAtA[iNdEx:postIndex])
+			if err := m.USDValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2925,7 +7238,7 @@ func (m *ClientChainEarningAddrInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperatorInfo) Unmarshal(dAtA []byte) error {
+func (m *VotingPowerSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2948,15 +7261,15 @@ func (m *OperatorInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperatorInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: VotingPowerSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VotingPowerSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EarningsAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AVSAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2984,13 +7297,13 @@ func (m *OperatorInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EarningsAddr = string(dAtA[iNdEx:postIndex])
+			m.AVSAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApproveAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3000,27 +7313,14 @@ func (m *OperatorInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ApproveAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorMetaInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3048,13 +7348,13 @@ func (m *OperatorInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorMetaInfo = string(dAtA[iNdEx:postIndex])
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientChainEarningsAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
 			}
-			var msglen int
+			m.EpochNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3064,31 +7364,14 @@ func (m *OperatorInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EpochNumber |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ClientChainEarningsAddr == nil {
-				m.ClientChainEarningsAddr = &ClientChainEarningAddrList{}
-			}
-			if err := m.ClientChainEarningsAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorVotingPowers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3115,7 +7398,8 @@ func (m *OperatorInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OperatorVotingPowers = append(m.OperatorVotingPowers, OperatorVotingPower{})
+			if err := m.OperatorVotingPowers[len(m.OperatorVotingPowers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3140,7 +7424,7 @@ func (m *OperatorInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OptedInfo) Unmarshal(dAtA []byte) error {
+func (m *PriceBreakerState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3163,15 +7447,15 @@ func (m *OptedInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OptedInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceBreakerState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OptedInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceBreakerState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3199,13 +7483,13 @@ func (m *OptedInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashContract = string(dAtA[iNdEx:postIndex])
+			m.AssetID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptedInHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundID", wireType)
 			}
-			m.OptedInHeight = 0
+			m.RoundID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3215,16 +7499,16 @@ func (m *OptedInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OptedInHeight |= uint64(b&0x7F) << shift
+				m.RoundID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptedOutHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			m.OptedOutHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3234,16 +7518,31 @@ func (m *OptedInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OptedOutHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimal", wireType)
 			}
-			var v int
+			m.Decimal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3253,67 +7552,16 @@ func (m *OptedInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Decimal |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Jailed = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OptedInAssetState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OptedInAssetState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OptedInAssetState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateHeight", wireType)
 			}
-			var stringLen uint64
+			m.UpdateHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3323,29 +7571,34 @@ func (m *OptedInAssetState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UpdateHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tripped", wireType)
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+			m.Tripped = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3373,9 +7626,7 @@ func (m *OptedInAssetState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3398,7 +7649,7 @@ func (m *OptedInAssetState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SlashFromUndelegation) Unmarshal(dAtA []byte) error {
+func (m *MsgVetoSlash) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3421,15 +7672,15 @@ func (m *SlashFromUndelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SlashFromUndelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVetoSlash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SlashFromUndelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVetoSlash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3457,11 +7708,11 @@ func (m *SlashFromUndelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakerID = string(dAtA[iNdEx:postIndex])
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3489,11 +7740,11 @@ func (m *SlashFromUndelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetID = string(dAtA[iNdEx:postIndex])
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AVSAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3521,9 +7772,39 @@ func (m *SlashFromUndelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AVSAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.SlashID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3546,7 +7827,7 @@ func (m *SlashFromUndelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SlashFromAssetsPool) Unmarshal(dAtA []byte) error {
+func (m *MsgVetoSlashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3569,15 +7850,65 @@ func (m *SlashFromAssetsPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SlashFromAssetsPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVetoSlashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SlashFromAssetsPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVetoSlashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateOperatorCommission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateOperatorCommission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateOperatorCommission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3605,11 +7936,11 @@ func (m *SlashFromAssetsPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetID = string(dAtA[iNdEx:postIndex])
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3637,10 +7968,60 @@ func (m *SlashFromAssetsPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateOperatorCommissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateOperatorCommissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateOperatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3662,7 +8043,7 @@ func (m *SlashFromAssetsPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SlashExecutionInfo) Unmarshal(dAtA []byte) error {
+func (m *MsgEditOperator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3685,15 +8066,15 @@ func (m *SlashExecutionInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SlashExecutionInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEditOperator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SlashExecutionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEditOperator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashProportion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3721,13 +8102,11 @@ func (m *SlashExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashProportion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EarningsAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3755,15 +8134,13 @@ func (m *SlashExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EarningsAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashUndelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorMetaInfo", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3773,29 +8150,27 @@ func (m *SlashExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashUndelegations = append(m.SlashUndelegations, SlashFromUndelegation{})
-			if err := m.SlashUndelegations[len(m.SlashUndelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OperatorMetaInfo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashAssetsPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientChainEarningsAddr", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3822,8 +8197,10 @@ func (m *SlashExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashAssetsPool = append(m.SlashAssetsPool, SlashFromAssetsPool{})
-			if err := m.SlashAssetsPool[len(m.SlashAssetsPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ClientChainEarningsAddr == nil {
+				m.ClientChainEarningsAddr = &ClientChainEarningAddrList{}
+			}
+			if err := m.ClientChainEarningsAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3848,7 +8225,7 @@ func (m *SlashExecutionInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperatorSlashInfo) Unmarshal(dAtA []byte) error {
+func (m *MsgEditOperatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3871,105 +8248,65 @@ func (m *OperatorSlashInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperatorSlashInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEditOperatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperatorSlashInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEditOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashContract", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashContract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedHeight", wireType)
-			}
-			m.SubmittedHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SubmittedHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventHeight", wireType)
-			}
-			m.EventHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EventHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsVetoed", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.IsVetoed = bool(v != 0)
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashProportion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3997,32 +8334,11 @@ func (m *OperatorSlashInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashProportion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashType", wireType)
-			}
-			m.SlashType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlashType |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4049,10 +8365,7 @@ func (m *OperatorSlashInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutionInfo == nil {
-				m.ExecutionInfo = &SlashExecutionInfo{}
-			}
-			if err := m.ExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4077,6 +8390,56 @@ func (m *OperatorSlashInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RegisterOperatorReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
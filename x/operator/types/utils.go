@@ -1,6 +1,7 @@
 package types
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -25,3 +26,12 @@ func ChainIDWithLenKey(chainID string) []byte {
 		[]byte(chainID),
 	)
 }
+
+// CalculateUSDValue assetUSDValue = (assetAmount*price)/(10^(asset.decimal+priceDecimal))
+func CalculateUSDValue(assetAmount sdkmath.Int, price sdkmath.Int, assetDecimal uint32, priceDecimal uint8) sdkmath.LegacyDec {
+	assetValue := assetAmount.Mul(price)
+	assetValueDec := sdkmath.LegacyNewDecFromBigInt(assetValue.BigInt())
+	// #nosec G115
+	divisor := sdkmath.NewIntWithDecimal(1, int(assetDecimal)+int(priceDecimal))
+	return assetValueDec.QuoInt(divisor)
+}
@@ -40,6 +40,15 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.StakerInfosAssets {
 		k.SetStakerInfos(ctx, elem.AssetId, elem.StakerInfos)
 	}
+	// Set the liveness of the validators
+	for _, elem := range genState.ValidatorReportInfos {
+		k.SetValidatorReportInfo(ctx, elem)
+	}
+	for _, elem := range genState.ValidatorMissedRounds {
+		for _, index := range elem.MissedRounds {
+			k.SetValidatorMissedRound(ctx, elem.Address, index, true)
+		}
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 }
@@ -70,6 +79,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	// TODO: export stakerListAssets, and stakerInfosAssets
 	genesis.StakerInfosAssets = k.GetAllStakerInfosAssets(ctx)
 	genesis.StakerListAssets = k.GetAllStakerListAssets(ctx)
+	genesis.ValidatorReportInfos = k.GetAllValidatorReportInfos(ctx)
+	genesis.ValidatorMissedRounds = k.GetAllValidatorMissedRounds(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
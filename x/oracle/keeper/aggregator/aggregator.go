@@ -46,6 +46,8 @@ type aggregator struct {
 	// sourceId->roundId used to track the confirmed DS roundId
 	// updated by calculator, detId use string
 	dsPrices map[uint64]string
+	// consensus mode of the tokenFeeder, which decides how the final price is aggregated
+	mode types.ConsensusMode
 }
 
 func (agg *aggregator) copy4CheckTx() *aggregator {
@@ -56,6 +58,7 @@ func (agg *aggregator) copy4CheckTx() *aggregator {
 
 		reports:  make([]*reportPrice, 0, len(agg.reports)),
 		dsPrices: make(map[uint64]string),
+		mode:     agg.mode,
 	}
 	for k, v := range agg.dsPrices {
 		ret.dsPrices[k] = v
@@ -179,23 +182,32 @@ func (agg *aggregator) aggregate() *big.Int {
 		// check if IVA all reached consensus
 		if len(agg.dsPrices) > 0 {
 			validatorPrices := make([]*big.Int, 0, len(agg.reports))
+			validatorPowers := make([]*big.Int, 0, len(agg.reports))
 			// do the aggregation to find out the 'final price'
 			for _, validatorReport := range agg.reports {
 				validatorPrices = append(validatorPrices, validatorReport.aggregate())
+				validatorPowers = append(validatorPowers, validatorReport.power)
 			}
 			// vTmp := bigIntList(validatorPrices)
-			agg.finalPrice = common.BigIntList(validatorPrices).Median()
+			if agg.mode == types.ConsensusModeWeightedMedian {
+				agg.finalPrice = common.WeightedMedian(validatorPrices, validatorPowers)
+			} else {
+				// the price of each round is the median for TWAP mode as well, the average
+				// is calculated with the stored prices when the price is read
+				agg.finalPrice = common.BigIntList(validatorPrices).Median()
+			}
 			// clear relative aggregator for this feeder, all the aggregator,calculator, filter can be removed since this round has been sealed
 		}
 	}
 	return agg.finalPrice
 }
 
-func newAggregator(validatorSetLength int, totalPower *big.Int) *aggregator {
+func newAggregator(validatorSetLength int, totalPower *big.Int, mode types.ConsensusMode) *aggregator {
 	return &aggregator{
 		reports:     make([]*reportPrice, 0, validatorSetLength),
 		reportPower: big.NewInt(0),
 		dsPrices:    make(map[uint64]string),
 		totalPower:  totalPower,
+		mode:        mode,
 	}
 }
@@ -4,12 +4,13 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ExocoreNetwork/exocore/x/oracle/types"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
 func TestAggregator(t *testing.T) {
 	Convey("fill prices into aggregator", t, func() {
-		a := newAggregator(5, big.NewInt(4))
+		a := newAggregator(5, big.NewInt(4), types.ConsensusModeASAP)
 		// a.fillPrice(pS1, "v1", one) //v1:{1, 2}
 
 		Convey("fill v1's report", func() {
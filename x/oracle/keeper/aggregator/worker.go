@@ -57,7 +57,7 @@ func newWorker(feederID uint64, agc *AggregatorContext) *worker {
 	return &worker{
 		f:       newFilter(int(common.MaxNonce), int(common.MaxDetID)),
 		c:       newCalculator(len(agc.validatorsPower), agc.totalPower),
-		a:       newAggregator(len(agc.validatorsPower), agc.totalPower),
+		a:       newAggregator(len(agc.validatorsPower), agc.totalPower, agc.params.GetTokenFeeder(feederID).GetModeWithDefault(common.Mode)),
 		decimal: agc.params.GetTokenInfo(feederID).Decimal,
 		ctx:     agc,
 	}
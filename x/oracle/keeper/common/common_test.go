@@ -1,6 +1,7 @@
 package common
 
 import (
+	"math/big"
 	"testing"
 
 	"cosmossdk.io/math"
@@ -27,3 +28,25 @@ func TestMock(t *testing.T) {
 		Convey("GetLastTotalPower", func() { So(x, ShouldResemble, math.NewInt(99)) })
 	})
 }
+
+func TestWeightedMedian(t *testing.T) {
+	Convey("weighted median of prices", t, func() {
+		prices := []*big.Int{big.NewInt(10), big.NewInt(30), big.NewInt(20)}
+		Convey("equal powers", func() {
+			powers := []*big.Int{big.NewInt(1), big.NewInt(1), big.NewInt(1)}
+			So(WeightedMedian(prices, powers), ShouldResemble, big.NewInt(20))
+		})
+		Convey("one price has the majority of power", func() {
+			powers := []*big.Int{big.NewInt(1), big.NewInt(5), big.NewInt(1)}
+			So(WeightedMedian(prices, powers), ShouldResemble, big.NewInt(30))
+		})
+		Convey("accumulated power equals half of the total power", func() {
+			powers := []*big.Int{big.NewInt(2), big.NewInt(1), big.NewInt(1)}
+			So(WeightedMedian(prices, powers), ShouldResemble, big.NewInt(15))
+		})
+		Convey("invalid input", func() {
+			So(WeightedMedian(prices, []*big.Int{big.NewInt(1)}), ShouldBeNil)
+			So(WeightedMedian(prices, []*big.Int{big.NewInt(0), big.NewInt(0), big.NewInt(0)}), ShouldBeNil)
+		})
+	})
+}
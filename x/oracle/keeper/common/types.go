@@ -93,3 +93,40 @@ func (b BigIntList) Median() *big.Int {
 	}
 	return new(big.Int).Div(new(big.Int).Add(b[l/2], b[l/2-1]), big.NewInt(2))
 }
+
+// WeightedMedian returns the median of the prices weighted by the corresponding powers, the
+// two middle prices are averaged when the accumulated power equals exactly half of the total
+// power, which is consistent with Median when all the powers are equal. It returns nil if
+// the lengths mismatch or the total power is not positive.
+func WeightedMedian(prices, powers []*big.Int) *big.Int {
+	if len(prices) == 0 || len(prices) != len(powers) {
+		return nil
+	}
+	indexes := make([]int, len(prices))
+	totalPower := big.NewInt(0)
+	for i := range prices {
+		indexes[i] = i
+		totalPower.Add(totalPower, powers[i])
+	}
+	if totalPower.Sign() <= 0 {
+		return nil
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return prices[indexes[i]].Cmp(prices[indexes[j]]) < 0
+	})
+	accumulated := big.NewInt(0)
+	doubled := new(big.Int)
+	for i, idx := range indexes {
+		accumulated.Add(accumulated, powers[idx])
+		switch doubled.Lsh(accumulated, 1).Cmp(totalPower) {
+		case 1:
+			return prices[idx]
+		case 0:
+			if i+1 < len(indexes) {
+				return new(big.Int).Div(new(big.Int).Add(prices[idx], prices[indexes[i+1]]), big.NewInt(2))
+			}
+			return prices[idx]
+		}
+	}
+	return prices[indexes[len(indexes)-1]]
+}
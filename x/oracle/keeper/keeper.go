@@ -25,6 +25,7 @@ type (
 		common.KeeperDogfood
 		delegationKeeper types.DelegationKeeper
 		assetsKeeper     types.AssetsKeeper
+		operatorKeeper   types.OperatorKeeper
 	}
 )
 
@@ -38,6 +39,7 @@ func NewKeeper(
 	sKeeper common.KeeperDogfood,
 	delegationKeeper types.DelegationKeeper,
 	assetsKeeper types.AssetsKeeper,
+	operatorKeeper types.OperatorKeeper,
 	authority string,
 ) Keeper {
 	// ensure authority is a valid bech32 address
@@ -57,6 +59,7 @@ func NewKeeper(
 		KeeperDogfood:    sKeeper,
 		delegationKeeper: delegationKeeper,
 		assetsKeeper:     assetsKeeper,
+		operatorKeeper:   operatorKeeper,
 		authority:        authority,
 	}
 }
@@ -58,6 +58,9 @@ func (ms msgServer) CreatePrice(goCtx context.Context, msg *types.MsgCreatePrice
 		} else {
 			logger.Info("final price aggregation done", "feederID", msg.FeederID, "roundID", newItem.PriceTR.RoundID, "price", newItem.PriceTR.Price)
 		}
+		// record the liveness of the validators before the nonces of this round are removed, the
+		// round is closed by the quorum, so the validators which haven't reported aren't missed.
+		ms.Keeper.UpdateValidatorsReportInfo(ctx, msg.FeederID, agc.GetValidatorPowers(), true)
 		ms.Keeper.RemoveNonceWithFeederIDForValidators(ctx, msg.FeederID, agc.GetValidators())
 
 		decimalStr := strconv.FormatInt(int64(newItem.PriceTR.Decimal), 10)
@@ -54,6 +54,10 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 	if p, err = p.UpdateMaxPriceCount(msg.Params.MaxSizePrices); err != nil {
 		return nil, err
 	}
+	// update slashing params
+	if p, err = p.UpdateSlashingParams(msg.Params.Slashing); err != nil {
+		return nil, err
+	}
 	// udpate tokenFeeders
 	for _, tokenFeeder := range msg.Params.TokenFeeders {
 		if p, err = p.UpdateTokenFeeder(tokenFeeder, height); err != nil {
@@ -66,6 +70,9 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 	}
 	// set updated new params
 	ms.SetParams(ctx, p)
+	if msg.Params.Slashing != nil {
+		ms.ResetValidatorsReportInfo(ctx)
+	}
 	_ = GetAggregatorContext(ctx, ms.Keeper)
 	cs.AddCache(cache.ItemP(p))
 	return &types.MsgUpdateParamsResponse{}, nil
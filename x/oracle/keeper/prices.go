@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/binary"
+	"math/big"
 
 	sdkmath "cosmossdk.io/math"
 	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
@@ -66,7 +67,7 @@ func (k Keeper) GetSpecifiedAssetsPrice(ctx sdk.Context, assetID string) (types.
 	if tokenID == 0 {
 		return types.Price{}, types.ErrGetPriceAssetNotFound.Wrapf("assetID does not exist in oracle %s", assetID)
 	}
-	price, found := k.GetPriceTRLatest(ctx, uint64(tokenID))
+	price, found := k.getPriceTRByMode(ctx, p, uint64(tokenID))
 	if !found {
 		return types.Price{
 			Value:   sdkmath.NewInt(types.DefaultPriceValue),
@@ -84,6 +85,7 @@ func (k Keeper) GetSpecifiedAssetsPrice(ctx sdk.Context, assetID string) (types.
 	return types.Price{
 		Value:   v,
 		Decimal: uint8(price.Decimal), // #nosec G115
+		RoundID: price.RoundID,
 	}, nil
 }
 
@@ -114,7 +116,7 @@ func (k Keeper) GetMultipleAssetsPrices(ctx sdk.Context, assets map[string]inter
 			prices = nil
 			break
 		}
-		price, found := k.GetPriceTRLatest(ctx, uint64(tokenID))
+		price, found := k.getPriceTRByMode(ctx, p, uint64(tokenID))
 		if !found {
 			info = info + assetID + " "
 			prices[assetID] = types.Price{
@@ -135,6 +137,7 @@ func (k Keeper) GetMultipleAssetsPrices(ctx sdk.Context, assets map[string]inter
 			prices[assetID] = types.Price{
 				Value:   v,
 				Decimal: uint8(price.Decimal), // #nosec G115
+				RoundID: price.RoundID,
 			}
 		}
 	}
@@ -281,6 +284,49 @@ func (k Keeper) GetPriceTRLatest(ctx sdk.Context, tokenID uint64) (price types.P
 	return
 }
 
+// getPriceTRByMode gets the price of the specific tokenID provided to the other modules, it's
+// the latest price or the time-weighted average price of the latest rounds according to the
+// consensus mode of the tokenFeeder
+func (k Keeper) getPriceTRByMode(ctx sdk.Context, p types.Params, tokenID uint64) (price types.PriceTimeRound, found bool) {
+	price, found = k.GetPriceTRLatest(ctx, tokenID)
+	if !found {
+		return
+	}
+	feeder := p.GetLatestTokenFeederByTokenID(tokenID)
+	if feeder == nil || feeder.GetModeWithDefault(p.Mode) != types.ConsensusModeTWAP || feeder.TWAPRounds <= 1 {
+		return
+	}
+	price = k.GetPriceTRTWAP(ctx, tokenID, price, feeder.TWAPRounds)
+	return
+}
+
+// GetPriceTRTWAP gets the time-weighted average price of the latest rounds of the specific
+// tokenID. Every round lasts for the interval of the tokenFeeder, and the round without a
+// consensused price takes the price of the previous round, so the prices of the rounds are
+// weighted equally. The rounds pruned from the store or with a different decimal from the
+// latest round are skipped.
+func (k Keeper) GetPriceTRTWAP(ctx sdk.Context, tokenID uint64, latest types.PriceTimeRound, rounds uint64) types.PriceTimeRound {
+	sum := big.NewInt(0)
+	count := int64(0)
+	for i := uint64(0); i < rounds && latest.RoundID > i; i++ {
+		pTR, found := k.GetPriceTRRoundID(ctx, tokenID, latest.RoundID-i)
+		if !found || pTR.Decimal != latest.Decimal {
+			continue
+		}
+		v, ok := new(big.Int).SetString(pTR.Price, 10)
+		if !ok || v.Sign() <= 0 {
+			continue
+		}
+		sum.Add(sum, v)
+		count++
+	}
+	if count == 0 {
+		return latest
+	}
+	latest.Price = sum.Div(sum, big.NewInt(count)).String()
+	return latest
+}
+
 // GetNextRoundID gets the next round id of a token
 func (k Keeper) GetNextRoundID(ctx sdk.Context, tokenID uint64) (nextRoundID uint64) {
 	nextRoundID = 1
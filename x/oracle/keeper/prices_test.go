@@ -59,6 +59,7 @@ func TestPricesGetMultiAssets(t *testing.T) {
 	expectedPrices["0x0b34c4d876cd569129cf56bafabb3f9e97a4ff42_0x9ce1"] = types.Price{
 		Value:   v,
 		Decimal: uint8(testdata.PTR5.Decimal),
+		RoundID: testdata.PTR5.RoundID,
 	}
 	require.NoError(t, err)
 	require.Equal(t, expectedPrices, prices)
@@ -68,6 +69,36 @@ func TestPricesGetMultiAssets(t *testing.T) {
 	require.ErrorIs(t, err, types.ErrGetPriceAssetNotFound.Wrapf("assetID does not exist in oracle %s", "unexistsAsset"))
 }
 
+func TestPricesGetTWAP(t *testing.T) {
+	keeper.ResetAggregatorContext()
+	k, ctx := keepertest.OracleKeeper(t)
+	k.SetPrices(ctx, testdata.P1)
+	assetID := "0x0b34c4d876cd569129cf56bafabb3f9e97a4ff42_0x9ce1"
+
+	p := k.GetParams(ctx)
+	p.TokenFeeders[1].Mode = types.ConsensusModeTWAP
+	p.TokenFeeders[1].TWAPRounds = 3
+	require.NoError(t, p.Validate())
+	k.SetParams(ctx, p)
+
+	// the average of the latest 3 rounds: (117+129+121)/3
+	price, err := k.GetSpecifiedAssetsPrice(ctx, assetID)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(122), price.Value)
+	require.Equal(t, uint8(testdata.PTR5.Decimal), price.Decimal)
+
+	prices, err := k.GetMultipleAssetsPrices(ctx, map[string]interface{}{assetID: nil})
+	require.NoError(t, err)
+	require.Equal(t, price, prices[assetID])
+
+	// twapRounds must be set for TWAP mode only
+	p.TokenFeeders[1].TWAPRounds = 0
+	require.ErrorIs(t, p.Validate(), types.ErrInvalidParams)
+	p.TokenFeeders[1].Mode = types.ConsensusModeWeightedMedian
+	p.TokenFeeders[1].TWAPRounds = 3
+	require.ErrorIs(t, p.Validate(), types.ErrInvalidParams)
+}
+
 func TestPricesRemove(t *testing.T) {
 	keeper, ctx := keepertest.OracleKeeper(t)
 	items := createNPrices(keeper, ctx, 10)
@@ -0,0 +1,219 @@
+package keeper
+
+import (
+	"math/big"
+	"sort"
+	"strconv"
+
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	"github.com/ExocoreNetwork/exocore/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SetValidatorReportInfo set the liveness info of a specific validator
+func (k Keeper) SetValidatorReportInfo(ctx sdk.Context, info types.ValidatorReportInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValidatorReportInfoKeyPrefix))
+	bz := k.cdc.MustMarshal(&info)
+	store.Set(types.ValidatorReportInfoKey(info.Address), bz)
+}
+
+// GetValidatorReportInfo returns the liveness info of a specific validator
+func (k Keeper) GetValidatorReportInfo(ctx sdk.Context, validator string) (info types.ValidatorReportInfo, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValidatorReportInfoKeyPrefix))
+	bz := store.Get(types.ValidatorReportInfoKey(validator))
+	if bz == nil {
+		return info, false
+	}
+	k.cdc.MustUnmarshal(bz, &info)
+	return info, true
+}
+
+// GetAllValidatorReportInfos returns the liveness info of all the validators
+func (k Keeper) GetAllValidatorReportInfos(ctx sdk.Context) (list []types.ValidatorReportInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValidatorReportInfoKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.ValidatorReportInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &info)
+		list = append(list, info)
+	}
+	return list
+}
+
+// SetValidatorMissedRound sets whether a validator missed the round at the index of the window
+func (k Keeper) SetValidatorMissedRound(ctx sdk.Context, validator string, index uint64, missed bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValidatorMissedRoundsKeyPrefix))
+	if missed {
+		store.Set(types.ValidatorMissedRoundsKey(validator, index), []byte{1})
+	} else {
+		store.Delete(types.ValidatorMissedRoundsKey(validator, index))
+	}
+}
+
+// GetValidatorMissedRound returns whether a validator missed the round at the index of the window
+func (k Keeper) GetValidatorMissedRound(ctx sdk.Context, validator string, index uint64) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValidatorMissedRoundsKeyPrefix))
+	return store.Has(types.ValidatorMissedRoundsKey(validator, index))
+}
+
+// ClearValidatorMissedRounds removes all the missed rounds of a validator in the window
+func (k Keeper) ClearValidatorMissedRounds(ctx sdk.Context, validator string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValidatorMissedRoundsKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorMissedRoundsPrefix(validator))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAllValidatorMissedRounds returns the missed rounds of all the validators
+func (k Keeper) GetAllValidatorMissedRounds(ctx sdk.Context) (list []types.ValidatorMissedRounds) {
+	for _, info := range k.GetAllValidatorReportInfos(ctx) {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValidatorMissedRoundsKeyPrefix))
+		prefixLen := len(types.ValidatorMissedRoundsPrefix(info.Address))
+		iterator := sdk.KVStorePrefixIterator(store, types.ValidatorMissedRoundsPrefix(info.Address))
+		missedRounds := types.ValidatorMissedRounds{Address: info.Address}
+		for ; iterator.Valid(); iterator.Next() {
+			missedRounds.MissedRounds = append(missedRounds.MissedRounds, sdk.BigEndianToUint64(iterator.Key()[prefixLen:]))
+		}
+		iterator.Close()
+		if len(missedRounds.MissedRounds) > 0 {
+			list = append(list, missedRounds)
+		}
+	}
+	return list
+}
+
+// UpdateValidatorsReportInfo records whether each validator reported its price in the round of
+// the feederID which is going to be closed, it must be called before the nonces of the round
+// are removed. The validator whose nonce for the feederID is increased reported the price,
+// the validator without nonce for the feederID is skipped, since its round has been handled.
+// A round closed by the quorum before the end of its window doesn't count as missed for the
+// validators which haven't reported yet, since they could still submit in the window, so
+// only the reports are recorded for it.
+func (k Keeper) UpdateValidatorsReportInfo(ctx sdk.Context, feederID uint64, validatorPowers map[string]*big.Int, closedByQuorum bool) {
+	slashing := k.GetParams(ctx).Slashing
+	// the liveness tracking is disabled
+	if slashing == nil {
+		return
+	}
+	validators := make([]string, 0, len(validatorPowers))
+	for validator := range validatorPowers {
+		validators = append(validators, validator)
+	}
+	// sort the validators to keep the order of the slashes deterministic
+	sort.Strings(validators)
+	for _, validator := range validators {
+		nonce, found := k.GetNonce(ctx, validator)
+		if !found {
+			continue
+		}
+		for _, n := range nonce.NonceList {
+			if n.FeederID == feederID {
+				reported := n.Value > 0
+				if reported || !closedByQuorum {
+					k.handleValidatorReport(ctx, validator, reported, validatorPowers[validator].Int64(), slashing)
+				}
+				break
+			}
+		}
+	}
+}
+
+// handleValidatorReport updates the missed rounds window of the validator, and jails and slashes
+// the validator if it has missed too many rounds in the window. The punishment is only checked
+// for a missed round, which is only recorded in the EndBlock, so a validator is never punished
+// in the price submission of another one.
+func (k Keeper) handleValidatorReport(ctx sdk.Context, validator string, reported bool, power int64, slashing *types.SlashingParams) {
+	logger := k.Logger(ctx)
+	consAddr, err := sdk.ConsAddressFromBech32(validator)
+	if err != nil {
+		logger.Error("invalid validator consensus address", "validator", validator, "error", err)
+		return
+	}
+	chainID := avstypes.ChainIDWithoutRevision(ctx.ChainID())
+	// the jailed validator is not tracked until it's unjailed
+	if k.operatorKeeper.IsOperatorJailedForChainID(ctx, consAddr, chainID) {
+		return
+	}
+
+	info, found := k.GetValidatorReportInfo(ctx, validator)
+	if !found {
+		info = types.ValidatorReportInfo{
+			Address:     validator,
+			StartHeight: ctx.BlockHeight(),
+		}
+	}
+	// #nosec G115 // window is validated to be positive
+	window := uint64(slashing.ReportedRoundsWindow)
+	index := info.IndexOffset % window
+	info.IndexOffset++
+
+	// update the missed rounds window and the counter
+	previous := k.GetValidatorMissedRound(ctx, validator, index)
+	missed := !reported
+	switch {
+	case !previous && missed:
+		k.SetValidatorMissedRound(ctx, validator, index, true)
+		info.MissedRoundsCounter++
+	case previous && !missed:
+		k.SetValidatorMissedRound(ctx, validator, index, false)
+		info.MissedRoundsCounter--
+	}
+
+	// #nosec G115 // minReported is in the range of [0, window]
+	maxMissed := window - uint64(slashing.MinReportedRoundsPerWindow())
+	// the validator is punished only after a whole window has been counted
+	if missed && info.IndexOffset >= window && info.MissedRoundsCounter > maxMissed {
+		found, operatorAddr := k.operatorKeeper.GetOperatorAddressForChainIDAndConsAddr(ctx, chainID, consAddr)
+		if !found {
+			logger.Error("couldn't find operator by consensus address and chainID", "validator", validator, "chainID", chainID)
+		} else {
+			logger.Info(
+				"validator missed too many price rounds, jail and slash it",
+				"validator", validator,
+				"missedRounds", info.MissedRoundsCounter,
+				"window", window,
+			)
+			slashAmount := k.operatorKeeper.SlashWithInfractionReason(
+				ctx, operatorAddr, ctx.BlockHeight(), power,
+				slashing.SlashFractionMiss, stakingtypes.Infraction_INFRACTION_UNSPECIFIED,
+			)
+			k.operatorKeeper.Jail(ctx, consAddr, chainID)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeOracleLiveness,
+				sdk.NewAttribute(types.AttributeKeyValidatorKey, validator),
+				sdk.NewAttribute(types.AttributeKeyMissedRounds, strconv.FormatUint(info.MissedRoundsCounter, 10)),
+				sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+				sdk.NewAttribute(types.AttributeKeySlashAmount, slashAmount.String()),
+			))
+		}
+		// reset the window, so the validator starts over after unjailed
+		info.StartHeight = ctx.BlockHeight()
+		info.IndexOffset = 0
+		info.MissedRoundsCounter = 0
+		k.ClearValidatorMissedRounds(ctx, validator)
+	}
+	k.SetValidatorReportInfo(ctx, info)
+}
+
+// ResetValidatorsReportInfo resets the missed rounds window of all the validators, it's called
+// when the slashing params are updated, since the indexes and the counters of the windows are
+// based on the previous window size.
+func (k Keeper) ResetValidatorsReportInfo(ctx sdk.Context) {
+	for _, info := range k.GetAllValidatorReportInfos(ctx) {
+		k.ClearValidatorMissedRounds(ctx, info.Address)
+		info.StartHeight = ctx.BlockHeight()
+		info.IndexOffset = 0
+		info.MissedRoundsCounter = 0
+		k.SetValidatorReportInfo(ctx, info)
+	}
+}
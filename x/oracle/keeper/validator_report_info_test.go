@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	"github.com/ExocoreNetwork/exocore/x/oracle/keeper"
+	"github.com/ExocoreNetwork/exocore/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (suite *KeeperSuite) TestUpdateValidatorsReportInfo() {
+	k := suite.App.OracleKeeper
+	p := k.GetParams(suite.Ctx)
+	p.Slashing = &types.SlashingParams{
+		ReportedRoundsWindow: 4,
+		MinReportedPerWindow: sdk.NewDecWithPrec(5, 1),
+		SlashFractionMiss:    sdk.NewDecWithPrec(1, 2),
+	}
+	k.SetParams(suite.Ctx, p)
+
+	chainID := avstypes.ChainIDWithoutRevision(suite.Ctx.ChainID())
+	missedConsAddr := sdk.ConsAddress(suite.ValSet.Validators[0].Address)
+	reportedConsAddr := sdk.ConsAddress(suite.ValSet.Validators[1].Address)
+	missedValidator := missedConsAddr.String()
+	reportedValidator := reportedConsAddr.String()
+	powers := map[string]*big.Int{
+		missedValidator:   big.NewInt(1),
+		reportedValidator: big.NewInt(1),
+	}
+	feederID := uint64(1)
+	round := func() {
+		k.SetNonce(suite.Ctx, types.ValidatorNonce{Validator: missedValidator, NonceList: []*types.Nonce{{FeederID: feederID, Value: 0}}})
+		k.SetNonce(suite.Ctx, types.ValidatorNonce{Validator: reportedValidator, NonceList: []*types.Nonce{{FeederID: feederID, Value: 1}}})
+		k.UpdateValidatorsReportInfo(suite.Ctx, feederID, powers, false)
+		k.RemoveNonceWithFeederIDForValidators(suite.Ctx, feederID, []string{missedValidator, reportedValidator})
+	}
+
+	// the round closed by the quorum isn't counted as missed for the validator not reported yet
+	k.SetNonce(suite.Ctx, types.ValidatorNonce{Validator: missedValidator, NonceList: []*types.Nonce{{FeederID: feederID, Value: 0}}})
+	k.SetNonce(suite.Ctx, types.ValidatorNonce{Validator: reportedValidator, NonceList: []*types.Nonce{{FeederID: feederID, Value: 1}}})
+	k.UpdateValidatorsReportInfo(suite.Ctx, feederID, powers, true)
+	k.RemoveNonceWithFeederIDForValidators(suite.Ctx, feederID, []string{missedValidator, reportedValidator})
+	_, found := k.GetValidatorReportInfo(suite.Ctx, missedValidator)
+	suite.Require().False(found)
+	info, found := k.GetValidatorReportInfo(suite.Ctx, reportedValidator)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), info.IndexOffset)
+
+	// the validator isn't punished before a whole window is counted
+	for i := 0; i < 3; i++ {
+		round()
+	}
+	info, found = k.GetValidatorReportInfo(suite.Ctx, missedValidator)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(3), info.IndexOffset)
+	suite.Require().Equal(uint64(3), info.MissedRoundsCounter)
+	suite.Require().True(k.GetValidatorMissedRound(suite.Ctx, missedValidator, 2))
+	suite.Require().False(suite.App.OperatorKeeper.IsOperatorJailedForChainID(suite.Ctx, missedConsAddr, chainID))
+
+	// the validator missing more than half of the rounds in the window is jailed and the window is reset
+	round()
+	suite.Require().True(suite.App.OperatorKeeper.IsOperatorJailedForChainID(suite.Ctx, missedConsAddr, chainID))
+	info, found = k.GetValidatorReportInfo(suite.Ctx, missedValidator)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(0), info.IndexOffset)
+	suite.Require().Equal(uint64(0), info.MissedRoundsCounter)
+	suite.Require().Empty(k.GetAllValidatorMissedRounds(suite.Ctx))
+
+	// the validator reporting prices is not punished
+	info, found = k.GetValidatorReportInfo(suite.Ctx, reportedValidator)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(5), info.IndexOffset)
+	suite.Require().Equal(uint64(0), info.MissedRoundsCounter)
+	suite.Require().False(suite.App.OperatorKeeper.IsOperatorJailedForChainID(suite.Ctx, reportedConsAddr, chainID))
+
+	// the jailed validator isn't tracked
+	round()
+	info, _ = k.GetValidatorReportInfo(suite.Ctx, missedValidator)
+	suite.Require().Equal(uint64(0), info.IndexOffset)
+}
+
+func (suite *KeeperSuite) TestValidatorsReportInfoWithSlashingParamsUpdate() {
+	k := suite.App.OracleKeeper
+	p := k.GetParams(suite.Ctx)
+	p.Slashing = &types.SlashingParams{
+		ReportedRoundsWindow: 4,
+		MinReportedPerWindow: sdk.NewDecWithPrec(5, 1),
+		SlashFractionMiss:    sdk.NewDecWithPrec(1, 2),
+	}
+	k.SetParams(suite.Ctx, p)
+
+	chainID := avstypes.ChainIDWithoutRevision(suite.Ctx.ChainID())
+	consAddr := sdk.ConsAddress(suite.ValSet.Validators[0].Address)
+	validator := consAddr.String()
+	powers := map[string]*big.Int{validator: big.NewInt(1)}
+	feederID := uint64(1)
+	round := func(value uint32, closedByQuorum bool) {
+		k.SetNonce(suite.Ctx, types.ValidatorNonce{Validator: validator, NonceList: []*types.Nonce{{FeederID: feederID, Value: value}}})
+		k.UpdateValidatorsReportInfo(suite.Ctx, feederID, powers, closedByQuorum)
+		k.RemoveNonceWithFeederIDForValidators(suite.Ctx, feederID, []string{validator})
+	}
+	for i := 0; i < 3; i++ {
+		round(0, false)
+	}
+
+	// the validator reporting its price isn't punished in the price submission, even if the
+	// counter of its window exceeds the limit of a smaller window
+	p.Slashing.ReportedRoundsWindow = 2
+	k.SetParams(suite.Ctx, p)
+	round(1, true)
+	suite.Require().False(suite.App.OperatorKeeper.IsOperatorJailedForChainID(suite.Ctx, consAddr, chainID))
+
+	// the windows are reset once the slashing params are updated
+	_, err := keeper.NewMsgServerImpl(k).UpdateParams(suite.Ctx, &types.MsgUpdateParams{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Params: types.Params{
+			Slashing: &types.SlashingParams{
+				ReportedRoundsWindow: 8,
+				MinReportedPerWindow: sdk.NewDecWithPrec(5, 1),
+				SlashFractionMiss:    sdk.NewDecWithPrec(1, 2),
+			},
+		},
+	})
+	suite.Require().NoError(err)
+	info, found := k.GetValidatorReportInfo(suite.Ctx, validator)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(0), info.IndexOffset)
+	suite.Require().Equal(uint64(0), info.MissedRoundsCounter)
+	suite.Require().Equal(suite.Ctx.BlockHeight(), info.StartHeight)
+	suite.Require().Empty(k.GetAllValidatorMissedRounds(suite.Ctx))
+}
@@ -189,6 +189,10 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 	// TODO: for v1 use mode==1, just check the failed feeders
 	_, failed, sealed := agc.SealRound(ctx, forceSeal)
 	for _, feederID := range sealed {
+		// the rounds force sealed for the validator set change are not counted for the liveness
+		if !forceSeal {
+			am.keeper.UpdateValidatorsReportInfo(ctx, feederID, agc.GetValidatorPowers(), false)
+		}
 		am.keeper.RemoveNonceWithFeederIDForValidators(ctx, feederID, agc.GetValidators())
 	}
 	// append new round with previous price for fail-seal token
@@ -15,6 +15,7 @@ const (
 	getPriceFailedRoundNotFound
 	updateNativeTokenVirtualPriceFail
 	nstAssetNotSurpported
+	invalidOracleInfo
 )
 
 // x/oracle module sentinel errors
@@ -27,4 +28,5 @@ var (
 	ErrGetPriceRoundNotFound             = sdkerrors.Register(ModuleName, getPriceFailedRoundNotFound, "get price failed for round not found")
 	ErrUpdateNativeTokenVirtualPriceFail = sdkerrors.Register(ModuleName, updateNativeTokenVirtualPriceFail, "update native token balance change failed")
 	ErrNSTAssetNotSurpported             = sdkerrors.Register(ModuleName, nstAssetNotSurpported, "nstAsset not supported")
+	ErrInvalidOracleInfo                 = sdkerrors.Register(ModuleName, invalidOracleInfo, "oracle info is invalid, need at least three fields: token.Name, Chain.Name, token.Decimal")
 )
@@ -1,7 +1,8 @@
 package types
 
 const (
-	EventTypeCreatePrice = "create_price"
+	EventTypeCreatePrice    = "create_price"
+	EventTypeOracleLiveness = "oracle_liveness"
 
 	AttributeKeyFeederID          = "feeder_id"
 	AttributeKeyTokenID           = "token_id"
@@ -14,6 +15,10 @@ const (
 	AttributeKeyFeederIDs         = "feeder_ids"
 	AttributeKeyNativeTokenUpdate = "native_token_update"
 	AttributeKeyNativeTokenChange = "native_token_change"
+	AttributeKeyValidatorKey      = "validator"
+	AttributeKeyMissedRounds      = "missed_rounds"
+	AttributeKeyHeight            = "height"
+	AttributeKeySlashAmount       = "slash_amount"
 
 	AttributeValuePriceUpdatedSuccess  = "success"
 	AttributeValueParamsUpdatedSuccess = "success"
@@ -4,6 +4,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -26,3 +27,15 @@ type DelegationKeeper interface {
 type AssetsKeeper interface {
 	GetAssetsDecimal(ctx sdk.Context, assets map[string]interface{}) (decimals map[string]uint32, err error)
 }
+
+// OperatorKeeper defines the expected interfaces needed to jail and slash the validators which
+// miss too many price rounds
+type OperatorKeeper interface {
+	IsOperatorJailedForChainID(ctx sdk.Context, consAddr sdk.ConsAddress, chainID string) bool
+	Jail(ctx sdk.Context, consAddr sdk.ConsAddress, chainID string)
+	GetOperatorAddressForChainIDAndConsAddr(ctx sdk.Context, chainID string, consAddr sdk.ConsAddress) (bool, sdk.AccAddress)
+	SlashWithInfractionReason(
+		ctx sdk.Context, addr sdk.AccAddress, infractionHeight, power int64,
+		slashFactor sdk.Dec, infraction stakingtypes.Infraction,
+	) sdkmath.Int
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultIndex is the default global index
@@ -10,15 +12,17 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		PricesList:           []Prices{},
-		ValidatorUpdateBlock: nil,
-		IndexRecentParams:    nil,
-		IndexRecentMsg:       nil,
-		RecentMsgList:        []RecentMsg{},
-		RecentParamsList:     []RecentParams{},
-		Params:               DefaultParams(),
-		StakerInfosAssets:    []StakerInfosAssets{},
-		StakerListAssets:     []StakerListAssets{},
+		PricesList:            []Prices{},
+		ValidatorUpdateBlock:  nil,
+		IndexRecentParams:     nil,
+		IndexRecentMsg:        nil,
+		RecentMsgList:         []RecentMsg{},
+		RecentParamsList:      []RecentParams{},
+		Params:                DefaultParams(),
+		StakerInfosAssets:     []StakerInfosAssets{},
+		StakerListAssets:      []StakerListAssets{},
+		ValidatorReportInfos:  []ValidatorReportInfo{},
+		ValidatorMissedRounds: []ValidatorMissedRounds{},
 		// this line is used by starport scaffolding # genesis/types/default
 	}
 }
@@ -102,6 +106,39 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("assetID %s in stakerInfosAssets not found in stakerLisetAssets", stakerInfosAsset.AssetId)
 		}
 	}
+	// Check for the liveness of the validators
+	reportInfoIndexMap := make(map[string]ValidatorReportInfo)
+	for _, elem := range gs.ValidatorReportInfos {
+		if _, err := sdk.ConsAddressFromBech32(elem.Address); err != nil {
+			return fmt.Errorf("invalid validator address %s in validatorReportInfos: %w", elem.Address, err)
+		}
+		if _, ok := reportInfoIndexMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated validator %s in validatorReportInfos", elem.Address)
+		}
+		reportInfoIndexMap[elem.Address] = elem
+	}
+	missedRoundsIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ValidatorMissedRounds {
+		info, ok := reportInfoIndexMap[elem.Address]
+		if !ok {
+			return fmt.Errorf("validator %s in validatorMissedRounds not found in validatorReportInfos", elem.Address)
+		}
+		if _, ok := missedRoundsIndexMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated validator %s in validatorMissedRounds", elem.Address)
+		}
+		missedRoundsIndexMap[elem.Address] = struct{}{}
+		if uint64(len(elem.MissedRounds)) != info.MissedRoundsCounter {
+			return fmt.Errorf("missed rounds of validator %s not match the counter %d", elem.Address, info.MissedRoundsCounter)
+		}
+		if gs.Params.Slashing != nil {
+			for _, index := range elem.MissedRounds {
+				// #nosec G115 // window is validated to be positive
+				if index >= uint64(gs.Params.Slashing.ReportedRoundsWindow) {
+					return fmt.Errorf("missed round index %d of validator %s exceeds the window", index, elem.Address)
+				}
+			}
+		}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -44,6 +44,10 @@ type GenesisState struct {
 	StakerInfosAssets []StakerInfosAssets `protobuf:"bytes,8,rep,name=staker_infos_assets,json=stakerInfosAssets,proto3" json:"staker_infos_assets"`
 	// stakerList for each nst token
 	StakerListAssets []StakerListAssets `protobuf:"bytes,9,rep,name=staker_list_assets,json=stakerListAssets,proto3" json:"staker_list_assets"`
+	// liveness of the validators reporting prices
+	ValidatorReportInfos []ValidatorReportInfo `protobuf:"bytes,10,rep,name=validator_report_infos,json=validatorReportInfos,proto3" json:"validator_report_infos"`
+	// missed rounds of the validators in the window
+	ValidatorMissedRounds []ValidatorMissedRounds `protobuf:"bytes,11,rep,name=validator_missed_rounds,json=validatorMissedRounds,proto3" json:"validator_missed_rounds"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -142,6 +146,20 @@ func (m *GenesisState) GetStakerListAssets() []StakerListAssets {
 	return nil
 }
 
+func (m *GenesisState) GetValidatorReportInfos() []ValidatorReportInfo {
+	if m != nil {
+		return m.ValidatorReportInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorMissedRounds() []ValidatorMissedRounds {
+	if m != nil {
+		return m.ValidatorMissedRounds
+	}
+	return nil
+}
+
 // stakerInfosAssets bond stakerinfos to their related assets id
 type StakerInfosAssets struct {
 	// asset_id tells the assetid which the stakerInfos belong to
@@ -261,42 +279,47 @@ func init() {
 func init() { proto.RegisterFile("exocore/oracle/v1/genesis.proto", fileDescriptor_6b68ac5b0c7f4305) }
 
 var fileDescriptor_6b68ac5b0c7f4305 = []byte{
-	// 555 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x5b, 0x6f, 0xd3, 0x30,
-	0x14, 0x80, 0xdb, 0xdd, 0xe7, 0x0e, 0x68, 0xbd, 0x09, 0x65, 0x15, 0x4b, 0x47, 0x19, 0x62, 0x12,
-	0x52, 0xc2, 0xe0, 0x81, 0x37, 0x34, 0x2a, 0x21, 0xd4, 0x71, 0x11, 0x4a, 0xb9, 0x48, 0x93, 0x50,
-	0xe4, 0xa6, 0x26, 0x58, 0x6d, 0xe3, 0xca, 0xf6, 0x4a, 0xf9, 0x17, 0xfc, 0xac, 0x3d, 0xee, 0x91,
-	0x27, 0x04, 0xed, 0x1f, 0x41, 0x39, 0x76, 0x58, 0x73, 0x69, 0x79, 0x4b, 0x8e, 0xbf, 0xf3, 0xf9,
-	0x9c, 0x63, 0xcb, 0xa8, 0x41, 0x27, 0x3c, 0xe0, 0x82, 0xba, 0x5c, 0x90, 0x60, 0x40, 0xdd, 0xf1,
-	0x89, 0x1b, 0xd2, 0x88, 0x4a, 0x26, 0x9d, 0x91, 0xe0, 0x8a, 0xe3, 0x9a, 0x01, 0x1c, 0x0d, 0x38,
-	0xe3, 0x93, 0xfa, 0x71, 0x3e, 0x87, 0x45, 0x3d, 0x3a, 0xf1, 0x05, 0x0d, 0x68, 0xa4, 0xfc, 0xa1,
-	0x0c, 0x75, 0x72, 0xfd, 0xe1, 0x7f, 0xc8, 0x11, 0x11, 0x64, 0x68, 0x76, 0xaa, 0x1f, 0xe5, 0xe1,
-	0x88, 0x28, 0x36, 0xa6, 0xbe, 0xe2, 0x7d, 0x1a, 0x19, 0xca, 0xce, 0x53, 0x29, 0x4b, 0xd1, 0xba,
-	0x60, 0x01, 0x4d, 0xd6, 0x9b, 0xf9, 0xf5, 0x5c, 0xd9, 0xf7, 0x17, 0x32, 0xa9, 0xad, 0x9c, 0x3c,
-	0x36, 0x26, 0x03, 0xd6, 0x23, 0x8a, 0x0b, 0xff, 0x62, 0xd4, 0x23, 0x8a, 0xfa, 0xdd, 0x01, 0x0f,
-	0xfa, 0x86, 0xdf, 0x0b, 0x79, 0xc8, 0xe1, 0xd3, 0x8d, 0xbf, 0x74, 0xb4, 0xf9, 0x67, 0x1d, 0xed,
-	0xbc, 0xd4, 0x23, 0xef, 0x28, 0xa2, 0x28, 0x7e, 0x8a, 0x36, 0xf4, 0x36, 0x56, 0xf9, 0xb0, 0x7c,
-	0x5c, 0x79, 0xbc, 0xef, 0xe4, 0x8e, 0xc0, 0x79, 0x07, 0x40, 0x6b, 0xed, 0xf2, 0x57, 0xa3, 0xe4,
-	0x19, 0x1c, 0x9f, 0xa2, 0x8a, 0x6e, 0xd5, 0x1f, 0x30, 0xa9, 0xac, 0x95, 0xc3, 0xd5, 0x45, 0xd9,
-	0x40, 0x99, 0x6c, 0xa4, 0x73, 0x5e, 0x33, 0xa9, 0xf0, 0x67, 0x74, 0xbb, 0xb8, 0x03, 0x6b, 0x15,
-	0x4a, 0x79, 0x50, 0x20, 0xfb, 0x98, 0x24, 0x7c, 0x00, 0xbe, 0x15, 0xe3, 0xde, 0xde, 0xb8, 0x20,
-	0x8a, 0xdf, 0xa3, 0xdd, 0x82, 0xe3, 0xb7, 0xd6, 0xc0, 0x7d, 0x54, 0xe0, 0x6e, 0xc7, 0xb4, 0x07,
-	0xb0, 0xee, 0xd8, 0xab, 0xb1, 0x6c, 0x08, 0xbf, 0x42, 0xd5, 0xec, 0xf5, 0xb3, 0xd6, 0x41, 0x79,
-	0x77, 0xb9, 0xf2, 0x8d, 0x0c, 0xbd, 0x9b, 0x2c, 0xf5, 0x8f, 0xcf, 0xd0, 0xad, 0x6b, 0x8d, 0x9e,
-	0xe3, 0x06, 0xcc, 0xf1, 0x4e, 0x81, 0xeb, 0x5f, 0x9a, 0x19, 0xe5, 0x0d, 0x91, 0x04, 0x60, 0x9a,
-	0x1d, 0x84, 0x53, 0x8d, 0x6a, 0xdd, 0x26, 0xe8, 0x1a, 0x0b, 0x75, 0xa9, 0xa3, 0xad, 0x8a, 0xb9,
-	0x18, 0x48, 0xcf, 0xd1, 0xae, 0x54, 0xa4, 0x4f, 0x85, 0xcf, 0xa2, 0x2f, 0x5c, 0xfa, 0x44, 0x4a,
-	0xaa, 0xa4, 0xb5, 0x05, 0xd6, 0xa2, 0x19, 0x76, 0x80, 0x6e, 0xc7, 0xf0, 0x73, 0x60, 0x8d, 0xba,
-	0x26, 0xb3, 0x0b, 0xf8, 0x13, 0xc2, 0xc6, 0x1d, 0x57, 0x9a, 0xa8, 0xb7, 0x41, 0x7d, 0x6f, 0xa1,
-	0x3a, 0x2e, 0x2b, 0x65, 0xae, 0xca, 0x4c, 0xbc, 0x39, 0x42, 0xb5, 0x5c, 0x19, 0x78, 0x1f, 0x6d,
-	0xc1, 0x0e, 0x3e, 0xeb, 0xc1, 0x4d, 0xdf, 0xf6, 0x36, 0xe1, 0xbf, 0xdd, 0xc3, 0xa7, 0x68, 0x67,
-	0xbe, 0x49, 0x73, 0x95, 0x0f, 0x96, 0x76, 0xe7, 0x55, 0xe6, 0x1a, 0x6a, 0x0e, 0x51, 0x35, 0x5b,
-	0xdd, 0xb2, 0x0d, 0x9f, 0xa1, 0xca, 0x5c, 0xe7, 0xd6, 0x0a, 0x5c, 0x9f, 0x83, 0xa5, 0x2d, 0x7b,
-	0xe8, 0xba, 0xcd, 0xd6, 0xd9, 0xe5, 0xd4, 0x2e, 0x5f, 0x4d, 0xed, 0xf2, 0xef, 0xa9, 0x5d, 0xfe,
-	0x31, 0xb3, 0x4b, 0x57, 0x33, 0xbb, 0xf4, 0x73, 0x66, 0x97, 0xce, 0x1f, 0x85, 0x4c, 0x7d, 0xbd,
-	0xe8, 0x3a, 0x01, 0x1f, 0xba, 0x2f, 0xb4, 0xee, 0x2d, 0x55, 0xdf, 0xb8, 0xe8, 0xbb, 0xc9, 0xf3,
-	0x31, 0x49, 0x1e, 0x10, 0xf5, 0x7d, 0x44, 0x65, 0x77, 0x03, 0xde, 0x85, 0x27, 0x7f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x17, 0x12, 0x12, 0xc9, 0x9b, 0x05, 0x00, 0x00,
+	// 629 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0x93, 0xb6, 0xbf, 0xfe, 0xd9, 0xf4, 0x07, 0xc9, 0xb6, 0x80, 0x5b, 0x51, 0xb7, 0x84,
+	0x02, 0x95, 0x10, 0x36, 0x85, 0x03, 0x37, 0x54, 0x2a, 0x21, 0xd4, 0x42, 0x11, 0x72, 0xf8, 0x23,
+	0x55, 0x42, 0x96, 0x63, 0x6f, 0xcd, 0x2a, 0x89, 0xd7, 0xda, 0xdd, 0x98, 0xf0, 0x16, 0xbc, 0x08,
+	0xef, 0xd1, 0x63, 0x8f, 0x9c, 0x10, 0x4a, 0x5e, 0x04, 0x79, 0xbc, 0x26, 0x76, 0xec, 0x98, 0x9b,
+	0x3d, 0xf3, 0x99, 0xef, 0xcc, 0xce, 0xcc, 0x2e, 0xda, 0x25, 0x23, 0xe6, 0x32, 0x4e, 0x4c, 0xc6,
+	0x1d, 0xb7, 0x4f, 0xcc, 0xe8, 0xd0, 0xf4, 0x49, 0x40, 0x04, 0x15, 0x46, 0xc8, 0x99, 0x64, 0xb8,
+	0xa5, 0x00, 0x23, 0x01, 0x8c, 0xe8, 0x70, 0xfb, 0xa0, 0x18, 0x43, 0x03, 0x8f, 0x8c, 0x6c, 0x4e,
+	0x5c, 0x12, 0x48, 0x7b, 0x20, 0xfc, 0x24, 0x78, 0xfb, 0xe1, 0x3f, 0xc8, 0xd0, 0xe1, 0xce, 0x40,
+	0x65, 0xda, 0xde, 0x2f, 0xc2, 0x81, 0x23, 0x69, 0x44, 0x6c, 0xc9, 0x7a, 0x24, 0x50, 0x94, 0x5e,
+	0xa4, 0x72, 0x2a, 0x65, 0x7e, 0x4e, 0x5d, 0x92, 0xfa, 0xdb, 0x45, 0x7f, 0xa1, 0xec, 0x7b, 0x73,
+	0x99, 0x5c, 0xaa, 0x47, 0x45, 0x2c, 0x72, 0xfa, 0xd4, 0x73, 0x24, 0xe3, 0x36, 0x27, 0x21, 0xe3,
+	0xd2, 0xa6, 0xc1, 0x05, 0x53, 0xb8, 0x51, 0x85, 0x0f, 0x43, 0xcf, 0x91, 0xc4, 0xee, 0xf6, 0x99,
+	0xdb, 0x53, 0xfc, 0xa6, 0xcf, 0x7c, 0x06, 0x9f, 0x66, 0xfc, 0x95, 0x58, 0xdb, 0x3f, 0x56, 0xd0,
+	0xfa, 0xab, 0x64, 0x42, 0x1d, 0xe9, 0x48, 0x82, 0x9f, 0xa1, 0xe5, 0xa4, 0x2a, 0xad, 0xbe, 0x57,
+	0x3f, 0x68, 0x3c, 0xd9, 0x32, 0x0a, 0x13, 0x33, 0xde, 0x01, 0x70, 0xbc, 0x74, 0xf9, 0x6b, 0xb7,
+	0x66, 0x29, 0x1c, 0x1f, 0xa1, 0x46, 0xd2, 0x19, 0xbb, 0x4f, 0x85, 0xd4, 0x16, 0xf6, 0x16, 0xe7,
+	0x45, 0x03, 0xa5, 0xa2, 0x51, 0x12, 0xf3, 0x86, 0x0a, 0x89, 0x3f, 0xa3, 0x9b, 0xe5, 0x27, 0xd0,
+	0x16, 0xa1, 0x94, 0x07, 0x25, 0x62, 0x1f, 0xd3, 0x80, 0x0f, 0xc0, 0x1f, 0xc7, 0xb8, 0xb5, 0x19,
+	0x95, 0x58, 0xf1, 0x7b, 0xb4, 0x51, 0xb2, 0x2d, 0xda, 0x12, 0x68, 0xef, 0x97, 0x68, 0x9f, 0xc4,
+	0xb4, 0x05, 0x70, 0x72, 0x62, 0xab, 0x45, 0x67, 0x4d, 0xf8, 0x35, 0x6a, 0xce, 0x6e, 0xab, 0xf6,
+	0x1f, 0x48, 0xde, 0xa9, 0x96, 0x3c, 0x13, 0xbe, 0x75, 0x8d, 0xe6, 0xfe, 0xf1, 0x29, 0xba, 0x3e,
+	0x95, 0x49, 0xfa, 0xb8, 0x0c, 0x7d, 0xbc, 0x5d, 0xa2, 0xf5, 0x37, 0x4c, 0xb5, 0xf2, 0x7f, 0x9e,
+	0x1a, 0xa0, 0x9b, 0x1d, 0x84, 0x73, 0x07, 0x4d, 0xe4, 0x56, 0x40, 0x6e, 0x77, 0xae, 0x5c, 0x6e,
+	0xb4, 0x4d, 0x9e, 0xb1, 0x81, 0xe8, 0x39, 0xda, 0x10, 0xd2, 0xe9, 0x11, 0x0e, 0x9b, 0x28, 0x6c,
+	0x47, 0x08, 0x22, 0x85, 0xb6, 0x0a, 0xaa, 0x65, 0x3d, 0xec, 0x00, 0x7d, 0x12, 0xc3, 0x2f, 0x80,
+	0x55, 0xd2, 0x2d, 0x31, 0xeb, 0xc0, 0x9f, 0x10, 0x56, 0xda, 0x71, 0xa5, 0xa9, 0xf4, 0x1a, 0x48,
+	0xdf, 0x9d, 0x2b, 0x1d, 0x97, 0x95, 0x53, 0x6e, 0x8a, 0x19, 0x3b, 0xee, 0x66, 0xf7, 0x2a, 0x73,
+	0x91, 0x84, 0x86, 0x40, 0xfc, 0x7e, 0xd5, 0x5e, 0x59, 0xc0, 0xc7, 0x75, 0x2a, 0xfd, 0xe9, 0x72,
+	0x4d, 0x5d, 0x02, 0x5f, 0xa0, 0x5b, 0xd3, 0x1c, 0x03, 0x2a, 0x04, 0xf1, 0x6c, 0xce, 0x86, 0x81,
+	0x27, 0xb4, 0x06, 0x24, 0x39, 0xa8, 0x4a, 0x72, 0x06, 0x01, 0x16, 0xf0, 0x2a, 0xcd, 0x8d, 0xa8,
+	0xcc, 0xd9, 0x0e, 0x51, 0xab, 0xd0, 0x52, 0xbc, 0x85, 0x56, 0xa1, 0x5b, 0x36, 0xf5, 0xe0, 0xd6,
+	0xae, 0x59, 0x2b, 0xf0, 0x7f, 0xe2, 0xe1, 0x23, 0xb4, 0x9e, 0x1d, 0x98, 0xba, 0x96, 0x3b, 0x95,
+	0x93, 0xb2, 0x1a, 0x99, 0xe1, 0xb4, 0x07, 0xa8, 0x39, 0xdb, 0xe9, 0xaa, 0x84, 0xcf, 0x51, 0x23,
+	0x33, 0x45, 0x6d, 0x01, 0xae, 0xc2, 0x4e, 0xe5, 0xf8, 0x2c, 0x34, 0x1d, 0xd9, 0xf1, 0xe9, 0xe5,
+	0x58, 0xaf, 0x5f, 0x8d, 0xf5, 0xfa, 0xef, 0xb1, 0x5e, 0xff, 0x3e, 0xd1, 0x6b, 0x57, 0x13, 0xbd,
+	0xf6, 0x73, 0xa2, 0xd7, 0xce, 0x1f, 0xfb, 0x54, 0x7e, 0x19, 0x76, 0x0d, 0x97, 0x0d, 0xcc, 0x97,
+	0x89, 0xdc, 0x5b, 0x22, 0xbf, 0x32, 0xde, 0x33, 0xd3, 0xa7, 0x70, 0x94, 0x3e, 0x86, 0xf2, 0x5b,
+	0x48, 0x44, 0x77, 0x19, 0xde, 0xb8, 0xa7, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x69, 0x30, 0x34,
+	0xf1, 0x96, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -319,6 +342,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorMissedRounds) > 0 {
+		for iNdEx := len(m.ValidatorMissedRounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorMissedRounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ValidatorReportInfos) > 0 {
+		for iNdEx := len(m.ValidatorReportInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorReportInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.StakerListAssets) > 0 {
 		for iNdEx := len(m.StakerListAssets) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -585,6 +636,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ValidatorReportInfos) > 0 {
+		for _, e := range m.ValidatorReportInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorMissedRounds) > 0 {
+		for _, e := range m.ValidatorMissedRounds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -970,6 +1033,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorReportInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorReportInfos = append(m.ValidatorReportInfos, ValidatorReportInfo{})
+			if err := m.ValidatorReportInfos[len(m.ValidatorReportInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorMissedRounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorMissedRounds = append(m.ValidatorMissedRounds, ValidatorMissedRounds{})
+			if err := m.ValidatorMissedRounds[len(m.ValidatorMissedRounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,43 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// ValidatorReportInfoKeyPrefix is used as a prefix for storing the liveness of the validators.
+	ValidatorReportInfoKeyPrefix = "ValidatorReportInfo/value/"
+	// ValidatorMissedRoundsKeyPrefix is used as a prefix for storing the missed rounds in the window.
+	ValidatorMissedRoundsKeyPrefix = "ValidatorMissedRounds/value/"
+)
+
+func ValidatorReportInfoKey(
+	validator string,
+) []byte {
+	var key []byte
+
+	key = append(key, validator...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// ValidatorMissedRoundsPrefix returns the prefix of the missed rounds of a specific validator.
+func ValidatorMissedRoundsPrefix(
+	validator string,
+) []byte {
+	var key []byte
+
+	key = append(key, validator...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// ValidatorMissedRoundsKey returns the key of the missed round of a specific validator at the index of the window.
+func ValidatorMissedRoundsKey(
+	validator string,
+	index uint64,
+) []byte {
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, index)
+	return append(ValidatorMissedRoundsPrefix(validator), indexBytes...)
+}
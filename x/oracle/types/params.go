@@ -4,6 +4,7 @@ import (
 	"errors"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
@@ -103,6 +104,19 @@ func DefaultParams() Params {
 		Mode:          ConsensusModeASAP,
 		MaxDetId:      5,
 		MaxSizePrices: 100,
+		Slashing:      DefaultSlashingParams(),
+	}
+}
+
+// DefaultSlashingParams returns the default params for the liveness tracking of the validators
+func DefaultSlashingParams() *SlashingParams {
+	return &SlashingParams{
+		// count the missed rounds in the latest 100 rounds
+		ReportedRoundsWindow: 100,
+		// a validator should report at least half of the rounds in the window
+		MinReportedPerWindow: sdk.NewDecWithPrec(5, 1),
+		// 0.01% of the stake is slashed when a validator misses too many rounds
+		SlashFractionMiss: sdk.NewDecWithPrec(1, 4),
 	}
 }
 
@@ -129,6 +143,13 @@ func (p Params) Validate() error {
 		return ErrInvalidParams.Wrapf("invalid maxNonce/maxDetID/Threshold/Mode/MaxSizePrices: %d, %d, %d, %d, %d, %d", p.MaxNonce, p.MaxDetId, p.ThresholdA, p.ThresholdB, p.Mode, p.MaxSizePrices)
 	}
 
+	// validate slashing params, nil means the liveness tracking is disabled
+	if p.Slashing != nil {
+		if err := p.Slashing.validate(); err != nil {
+			return err
+		}
+	}
+
 	// validate tokenFeeders
 	feeders := make(map[uint64]*TokenFeeder)
 	for fID, feeder := range p.TokenFeeders {
@@ -143,6 +164,10 @@ func (p Params) Validate() error {
 		if feeder.EndBlock > 0 && (feeder.EndBlock-feeder.StartBaseBlock)%feeder.Interval < uint64(p.MaxNonce) {
 			return ErrInvalidParams.Wrap("invalid tokenFeeder, invalid EndBlock")
 		}
+		// TWAP should be calculated with the prices kept in the store
+		if feeder.TWAPRounds > uint64(p.MaxSizePrices) {
+			return ErrInvalidParams.Wrapf("invalid tokenFeeder, twapRounds: %d exceeds maxSizePrices: %d", feeder.TWAPRounds, p.MaxSizePrices)
+		}
 		// Interval should be long enough, make it more than twice pricing window of one round
 		if feeder.Interval < 2*uint64(p.MaxNonce) {
 			return ErrInvalidParams.Wrap("invalid tokenFeeder, invalid interval")
@@ -334,6 +359,18 @@ func (p Params) UpdateMaxPriceCount(count int32) (Params, error) {
 	return p, nil
 }
 
+// UpdateSlashingParams updates the params for the liveness tracking of the validators, nil means no update
+func (p Params) UpdateSlashingParams(slashing *SlashingParams) (Params, error) {
+	if slashing == nil {
+		return p, nil
+	}
+	if err := slashing.validate(); err != nil {
+		return p, err
+	}
+	p.Slashing = slashing
+	return p, nil
+}
+
 // UpdateTokenFeeder updates tokenfeeder info, validation first
 func (p Params) UpdateTokenFeeder(tf *TokenFeeder, currentHeight uint64) (Params, error) {
 	tfIDs := p.GetFeederIDsByTokenID(tf.TokenID)
@@ -347,7 +384,7 @@ func (p Params) UpdateTokenFeeder(tf *TokenFeeder, currentHeight uint64) (Params
 
 	// latest feeder is not started yet
 	if tokenFeeder.StartBaseBlock > currentHeight {
-		// fields can be modified: startBaseBlock, interval, endBlock
+		// fields can be modified: startBaseBlock, interval, endBlock, mode
 		update := false
 		if tf.StartBaseBlock > 0 {
 			// Set startBlock to some height in history is not allowed
@@ -361,6 +398,11 @@ func (p Params) UpdateTokenFeeder(tf *TokenFeeder, currentHeight uint64) (Params
 			tokenFeeder.Interval = tf.Interval
 			update = true
 		}
+		if tf.Mode != ConsensusModeUnspecified {
+			tokenFeeder.Mode = tf.Mode
+			tokenFeeder.TWAPRounds = tf.TWAPRounds
+			update = true
+		}
 		if tf.EndBlock > 0 {
 			// EndBlock must be set to some height in the future
 			if tf.EndBlock <= currentHeight {
@@ -481,9 +523,31 @@ func (f TokenFeeder) validate() error {
 		return ErrInvalidParams.Wrapf("invalid TokenFeeder, invalid EndBlock to be set, startBaseBlock: %d, endBlock: %d", f.StartBaseBlock, f.EndBlock)
 	}
 
+	// TWAPRounds must be set for the TWAP mode only
+	switch f.Mode {
+	case ConsensusModeUnspecified, ConsensusModeASAP, ConsensusModeWeightedMedian:
+		if f.TWAPRounds > 0 {
+			return ErrInvalidParams.Wrapf("invalid TokenFeeder, twapRounds is set for mode: %s", f.Mode)
+		}
+	case ConsensusModeTWAP:
+		if f.TWAPRounds < 1 {
+			return ErrInvalidParams.Wrap("invalid TokenFeeder, twapRounds is not set for TWAP mode")
+		}
+	default:
+		return ErrInvalidParams.Wrapf("invalid TokenFeeder, invalid mode: %d", f.Mode)
+	}
+
 	return nil
 }
 
+// GetModeWithDefault returns the consensus mode of the tokenFeeder, the defaultMode is used if it's not specified
+func (f TokenFeeder) GetModeWithDefault(defaultMode ConsensusMode) ConsensusMode {
+	if f.Mode == ConsensusModeUnspecified {
+		return defaultMode
+	}
+	return f.Mode
+}
+
 func (p Params) GetTokenIDFromAssetID(assetID string) int {
 	for id, token := range p.Tokens {
 		assetIDs := strings.Split(token.AssetID, ",")
@@ -524,6 +588,15 @@ func (p Params) GetTokenFeeder(feederID uint64) *TokenFeeder {
 	return nil
 }
 
+// GetLatestTokenFeederByTokenID returns the latest tokenFeeder of the specified token, nil if not found
+func (p Params) GetLatestTokenFeederByTokenID(tokenID uint64) *TokenFeeder {
+	feederIDs := p.GetFeederIDsByTokenID(tokenID)
+	if len(feederIDs) == 0 {
+		return nil
+	}
+	return p.TokenFeeders[feederIDs[len(feederIDs)-1]]
+}
+
 func (p Params) GetTokenInfo(feederID uint64) *Token {
 	for k, v := range p.TokenFeeders {
 		if uint64(k) == feederID {
@@ -586,3 +659,21 @@ func (p Params) CheckDecimal(feederID uint64, decimal int32) bool {
 	token := p.Tokens[feeder.TokenID]
 	return token.Decimal == decimal
 }
+
+func (s *SlashingParams) validate() error {
+	if s.ReportedRoundsWindow < 1 {
+		return ErrInvalidParams.Wrapf("invalid slashing params, reportedRoundsWindow: %d", s.ReportedRoundsWindow)
+	}
+	if s.MinReportedPerWindow.IsNil() || s.MinReportedPerWindow.IsNegative() || s.MinReportedPerWindow.GT(sdk.OneDec()) {
+		return ErrInvalidParams.Wrapf("invalid slashing params, minReportedPerWindow: %s", s.MinReportedPerWindow)
+	}
+	if s.SlashFractionMiss.IsNil() || s.SlashFractionMiss.IsNegative() || s.SlashFractionMiss.GT(sdk.OneDec()) {
+		return ErrInvalidParams.Wrapf("invalid slashing params, slashFractionMiss: %s", s.SlashFractionMiss)
+	}
+	return nil
+}
+
+// MinReportedRoundsPerWindow returns the minimum count of the rounds reported by a validator in the window
+func (s *SlashingParams) MinReportedRoundsPerWindow() int64 {
+	return s.MinReportedPerWindow.MulInt64(s.ReportedRoundsWindow).RoundInt64()
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -23,35 +24,6 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// ConsensusMode defines the consensus mode for the prices.
-type ConsensusMode int32
-
-const (
-	// CONSENSUS_MODE_UNSPECIFIED defines an invalid mode.
-	ConsensusModeUnspecified ConsensusMode = 0
-	// CONSENSUS_MODE_ASAP defines the mode to get final price immediately when the voting power
-	// exceeds the threshold.
-	ConsensusModeASAP ConsensusMode = 1
-)
-
-var ConsensusMode_name = map[int32]string{
-	0: "CONSENSUS_MODE_UNSPECIFIED",
-	1: "CONSENSUS_MODE_ASAP",
-}
-
-var ConsensusMode_value = map[string]int32{
-	"CONSENSUS_MODE_UNSPECIFIED": 0,
-	"CONSENSUS_MODE_ASAP":        1,
-}
-
-func (x ConsensusMode) String() string {
-	return proto.EnumName(ConsensusMode_name, int32(x))
-}
-
-func (ConsensusMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_72f39bba4594b794, []int{0}
-}
-
 // Params defines the parameters for the module.
 type Params struct {
 	// chains represents the blockchains info
@@ -76,6 +48,8 @@ type Params struct {
 	MaxDetId int32 `protobuf:"varint,10,opt,name=max_det_id,json=maxDetId,proto3" json:"max_det_id,omitempty"`
 	// for each token, only keep max_size_prices round of prices
 	MaxSizePrices int32 `protobuf:"varint,11,opt,name=max_size_prices,json=maxSizePrices,proto3" json:"max_size_prices,omitempty"`
+	// params for the liveness tracking of the validators reporting prices
+	Slashing *SlashingParams `protobuf:"bytes,12,opt,name=slashing,proto3" json:"slashing,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -187,47 +161,113 @@ func (m *Params) GetMaxSizePrices() int32 {
 	return 0
 }
 
+func (m *Params) GetSlashing() *SlashingParams {
+	if m != nil {
+		return m.Slashing
+	}
+	return nil
+}
+
+// SlashingParams defines the params for the liveness tracking of the validators reporting
+// prices, the validator missing too many rounds in the window is jailed and slashed.
+type SlashingParams struct {
+	// reported_rounds_window is the count of the latest rounds in which the missed rounds of
+	// each validator are counted
+	ReportedRoundsWindow int64 `protobuf:"varint,1,opt,name=reported_rounds_window,json=reportedRoundsWindow,proto3" json:"reported_rounds_window,omitempty"`
+	// min_reported_per_window is the minimum ratio of the rounds reported by a validator in
+	// the window
+	MinReportedPerWindow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_reported_per_window,json=minReportedPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_reported_per_window"`
+	// slash_fraction_miss is the fraction of the stake slashed when a validator misses too many
+	// rounds
+	SlashFractionMiss github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=slash_fraction_miss,json=slashFractionMiss,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_miss"`
+}
+
+func (m *SlashingParams) Reset()         { *m = SlashingParams{} }
+func (m *SlashingParams) String() string { return proto.CompactTextString(m) }
+func (*SlashingParams) ProtoMessage()    {}
+func (*SlashingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72f39bba4594b794, []int{1}
+}
+func (m *SlashingParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashingParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashingParams.Merge(m, src)
+}
+func (m *SlashingParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashingParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashingParams proto.InternalMessageInfo
+
+func (m *SlashingParams) GetReportedRoundsWindow() int64 {
+	if m != nil {
+		return m.ReportedRoundsWindow
+	}
+	return 0
+}
+
 func init() {
-	proto.RegisterEnum("exocore.oracle.v1.ConsensusMode", ConsensusMode_name, ConsensusMode_value)
 	proto.RegisterType((*Params)(nil), "exocore.oracle.v1.Params")
+	proto.RegisterType((*SlashingParams)(nil), "exocore.oracle.v1.SlashingParams")
 }
 
 func init() { proto.RegisterFile("exocore/oracle/v1/params.proto", fileDescriptor_72f39bba4594b794) }
 
 var fileDescriptor_72f39bba4594b794 = []byte{
-	// 511 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x6d, 0xf2, 0xa3, 0xed, 0x85, 0x40, 0x7b, 0x80, 0x74, 0x98, 0xe0, 0x5a, 0x08, 0xa1,
-	0x88, 0xc1, 0x6e, 0x1b, 0x26, 0xc4, 0x92, 0x26, 0xa9, 0x14, 0xa4, 0xa6, 0x91, 0x4d, 0x16, 0x16,
-	0xcb, 0xb1, 0x5f, 0x12, 0xab, 0xb1, 0x2f, 0xba, 0x73, 0x8a, 0xe9, 0xca, 0x82, 0x3a, 0x31, 0xb2,
-	0x54, 0x42, 0xe2, 0x9f, 0x61, 0xec, 0xc8, 0x88, 0x92, 0x7f, 0x83, 0x01, 0xf9, 0xec, 0x94, 0xa6,
-	0x49, 0xb7, 0xbb, 0xf7, 0xfd, 0x7c, 0xf4, 0x9e, 0x9e, 0x1e, 0x52, 0x21, 0xa6, 0x2e, 0x65, 0x60,
-	0x50, 0xe6, 0xb8, 0x63, 0x30, 0xce, 0xf6, 0x8d, 0x89, 0xc3, 0x9c, 0x80, 0xeb, 0x13, 0x46, 0x23,
-	0x8a, 0x77, 0xb2, 0x5c, 0x4f, 0x73, 0xfd, 0x6c, 0x5f, 0xa9, 0xac, 0x2a, 0x7e, 0x38, 0xa0, 0xa9,
-	0xa0, 0xbc, 0x5c, 0x4d, 0x23, 0x7a, 0x0a, 0xa1, 0x3d, 0x00, 0xf0, 0x80, 0x65, 0xd4, 0xe3, 0x21,
-	0x1d, 0x52, 0xf1, 0x34, 0x92, 0x57, 0x5a, 0x7d, 0xf1, 0x37, 0x87, 0x8a, 0x5d, 0xd1, 0x1d, 0xef,
-	0xa1, 0xa2, 0x3b, 0x72, 0xfc, 0x90, 0x13, 0x59, 0xcb, 0x55, 0x4b, 0x07, 0x44, 0x5f, 0x19, 0x44,
-	0x6f, 0x24, 0x80, 0x99, 0x71, 0x89, 0x21, 0x1a, 0x71, 0x72, 0xef, 0x4e, 0xe3, 0x43, 0x02, 0x98,
-	0x19, 0x87, 0x6b, 0x68, 0x83, 0xd3, 0x29, 0x73, 0x81, 0x93, 0x9c, 0x50, 0x9e, 0xae, 0x51, 0x2c,
-	0x41, 0x98, 0x0b, 0x12, 0xd7, 0x50, 0x81, 0x4d, 0xc7, 0xc0, 0x49, 0x5e, 0x28, 0xcf, 0xd7, 0x28,
-	0xe6, 0x74, 0x0c, 0x99, 0x96, 0xb2, 0xb8, 0x81, 0xca, 0x37, 0x97, 0xc0, 0x49, 0x41, 0xc8, 0xea,
-	0x5d, 0x23, 0x1e, 0x09, 0xcc, 0xbc, 0x1f, 0xfd, 0xff, 0x70, 0xfc, 0x0c, 0x6d, 0x05, 0x4e, 0x6c,
-	0x87, 0x34, 0x74, 0x81, 0x14, 0x35, 0xb9, 0x5a, 0x30, 0x37, 0x03, 0x27, 0xee, 0x24, 0x7f, 0xbc,
-	0x8b, 0x4a, 0xd1, 0x88, 0x01, 0x1f, 0xd1, 0xb1, 0x67, 0x3b, 0x64, 0x43, 0xc4, 0xe8, 0xba, 0x54,
-	0x5f, 0x06, 0xfa, 0x64, 0xf3, 0x16, 0x70, 0x88, 0xdf, 0xa0, 0x7c, 0x40, 0x3d, 0x20, 0x5b, 0x9a,
-	0x5c, 0x7d, 0x70, 0xa0, 0xad, 0xdb, 0x37, 0x0d, 0x39, 0x84, 0x7c, 0xca, 0x8f, 0xa9, 0x07, 0xa6,
-	0xa0, 0x71, 0x05, 0xa1, 0x64, 0x28, 0x0f, 0x22, 0xdb, 0xf7, 0x08, 0xba, 0x9e, 0xaa, 0x09, 0x51,
-	0xdb, 0xc3, 0xaf, 0xd0, 0xc3, 0x24, 0xe5, 0xfe, 0x39, 0xd8, 0x13, 0xe6, 0x27, 0x9b, 0x2e, 0x09,
-	0xa4, 0x1c, 0x38, 0xb1, 0xe5, 0x9f, 0x43, 0x57, 0x14, 0xdf, 0xe6, 0xbf, 0xff, 0xd8, 0x95, 0x5e,
-	0x7f, 0x91, 0x51, 0x79, 0xa9, 0x07, 0x7e, 0x87, 0x94, 0xc6, 0x49, 0xc7, 0x6a, 0x75, 0xac, 0x9e,
-	0x65, 0x1f, 0x9f, 0x34, 0x5b, 0x76, 0xaf, 0x63, 0x75, 0x5b, 0x8d, 0xf6, 0x51, 0xbb, 0xd5, 0xdc,
-	0x96, 0x94, 0xca, 0xc5, 0xa5, 0x46, 0x96, 0x94, 0x5e, 0xc8, 0x27, 0xe0, 0xfa, 0x03, 0x1f, 0x3c,
-	0xac, 0xa3, 0x47, 0xb7, 0xec, 0xba, 0x55, 0xef, 0x6e, 0xcb, 0xca, 0x93, 0x8b, 0x4b, 0x6d, 0x67,
-	0x49, 0x4b, 0x02, 0x25, 0xff, 0xf5, 0xa7, 0x2a, 0x1d, 0xbe, 0xff, 0x35, 0x53, 0xe5, 0xab, 0x99,
-	0x2a, 0xff, 0x99, 0xa9, 0xf2, 0xb7, 0xb9, 0x2a, 0x5d, 0xcd, 0x55, 0xe9, 0xf7, 0x5c, 0x95, 0x3e,
-	0xee, 0x0d, 0xfd, 0x68, 0x34, 0xed, 0xeb, 0x2e, 0x0d, 0x8c, 0x56, 0xba, 0x9d, 0x0e, 0x44, 0x9f,
-	0x28, 0x3b, 0x35, 0x16, 0x47, 0x1f, 0x2f, 0xce, 0x3e, 0xfa, 0x3c, 0x01, 0xde, 0x2f, 0x8a, 0xbb,
-	0xae, 0xfd, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xf5, 0xbe, 0x10, 0x4f, 0x66, 0x03, 0x00, 0x00,
+	// 584 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0xe3, 0x36, 0x4d, 0xdb, 0x4d, 0xdb, 0x9f, 0xba, 0xbf, 0x0a, 0x96, 0x52, 0x1c, 0x53,
+	0xa1, 0xca, 0x17, 0xec, 0xfe, 0x3b, 0x21, 0x71, 0x20, 0x2d, 0x95, 0x40, 0x6a, 0x15, 0x6d, 0x91,
+	0x90, 0x38, 0x60, 0x39, 0xf6, 0x24, 0x59, 0x25, 0xde, 0xb5, 0x76, 0xed, 0x26, 0xf4, 0x1d, 0x90,
+	0x38, 0x72, 0xe4, 0x3d, 0x78, 0x81, 0x1e, 0x7b, 0x44, 0x1c, 0x2a, 0x94, 0xbc, 0x08, 0xf2, 0xda,
+	0x09, 0x2d, 0x49, 0x2f, 0x9c, 0xb2, 0x3b, 0xdf, 0xcf, 0x77, 0x66, 0x32, 0xde, 0x41, 0x26, 0x0c,
+	0x44, 0x20, 0x24, 0xb8, 0x42, 0xfa, 0x41, 0x0f, 0xdc, 0x8b, 0x3d, 0x37, 0xf6, 0xa5, 0x1f, 0x29,
+	0x27, 0x96, 0x22, 0x11, 0x78, 0xbd, 0xd0, 0x9d, 0x5c, 0x77, 0x2e, 0xf6, 0x36, 0xb7, 0xa6, 0x2d,
+	0x8c, 0xb7, 0x44, 0x6e, 0xd8, 0x7c, 0x36, 0xad, 0x26, 0xa2, 0x0b, 0xdc, 0x6b, 0x01, 0x84, 0x20,
+	0x0b, 0x6a, 0xa3, 0x2d, 0xda, 0x42, 0x1f, 0xdd, 0xec, 0x94, 0x47, 0xb7, 0xbf, 0x97, 0x51, 0xa5,
+	0xa1, 0xab, 0xe3, 0x5d, 0x54, 0x09, 0x3a, 0x3e, 0xe3, 0x8a, 0x18, 0xd6, 0xbc, 0x5d, 0xdd, 0x27,
+	0xce, 0x54, 0x23, 0xce, 0x51, 0x06, 0xd0, 0x82, 0xcb, 0x1c, 0xba, 0x90, 0x22, 0x73, 0xf7, 0x3a,
+	0xde, 0x65, 0x00, 0x2d, 0x38, 0x7c, 0x80, 0x16, 0x95, 0x48, 0x65, 0x00, 0x8a, 0xcc, 0x6b, 0xcb,
+	0xa3, 0x19, 0x96, 0x73, 0x4d, 0xd0, 0x31, 0x89, 0x0f, 0xd0, 0x82, 0x4c, 0x7b, 0xa0, 0x48, 0x59,
+	0x5b, 0x9e, 0xcc, 0xb0, 0xd0, 0xb4, 0x07, 0x85, 0x2d, 0x67, 0xf1, 0x11, 0x5a, 0xbd, 0x3d, 0x04,
+	0x45, 0x16, 0xb4, 0xd9, 0xbc, 0xaf, 0xc5, 0x13, 0x8d, 0xd1, 0x95, 0xe4, 0xcf, 0x45, 0xe1, 0xc7,
+	0x68, 0x39, 0xf2, 0x07, 0x1e, 0x17, 0x3c, 0x00, 0x52, 0xb1, 0x0c, 0x7b, 0x81, 0x2e, 0x45, 0xfe,
+	0xe0, 0x2c, 0xbb, 0xe3, 0x1a, 0xaa, 0x26, 0x1d, 0x09, 0xaa, 0x23, 0x7a, 0xa1, 0xe7, 0x93, 0x45,
+	0x2d, 0xa3, 0x49, 0xe8, 0xd5, 0x5d, 0xa0, 0x49, 0x96, 0xfe, 0x02, 0xea, 0xf8, 0x10, 0x95, 0x23,
+	0x11, 0x02, 0x59, 0xb6, 0x0c, 0x7b, 0x6d, 0xdf, 0x9a, 0x35, 0x6f, 0xc1, 0x15, 0x70, 0x95, 0xaa,
+	0x53, 0x11, 0x02, 0xd5, 0x34, 0xde, 0x42, 0x28, 0x6b, 0x2a, 0x84, 0xc4, 0x63, 0x21, 0x41, 0x93,
+	0xae, 0x8e, 0x21, 0x79, 0x13, 0xe2, 0x1d, 0xf4, 0x5f, 0xa6, 0x2a, 0x76, 0x09, 0x5e, 0x2c, 0x59,
+	0x36, 0xe9, 0xaa, 0x46, 0x56, 0x23, 0x7f, 0x70, 0xce, 0x2e, 0xa1, 0xa1, 0x83, 0xf8, 0x25, 0x5a,
+	0x52, 0x3d, 0x5f, 0x75, 0x18, 0x6f, 0x93, 0x15, 0xcb, 0xb0, 0xab, 0xfb, 0x4f, 0x67, 0x7d, 0x8a,
+	0x02, 0xc9, 0x9f, 0x08, 0x9d, 0x58, 0x5e, 0x94, 0xbf, 0x7e, 0xab, 0x95, 0xb6, 0x3f, 0xcf, 0xa1,
+	0xb5, 0xbb, 0x08, 0x3e, 0x44, 0x0f, 0x24, 0xc4, 0x42, 0x26, 0x10, 0x7a, 0x52, 0xa4, 0x3c, 0x54,
+	0x5e, 0x9f, 0xf1, 0x50, 0xf4, 0x89, 0x61, 0x19, 0xf6, 0x3c, 0xdd, 0x18, 0xab, 0x54, 0x8b, 0xef,
+	0xb5, 0x86, 0x01, 0x3d, 0x8c, 0x18, 0xf7, 0x26, 0xce, 0x18, 0xe4, 0xd8, 0x36, 0x67, 0x19, 0xf6,
+	0x72, 0xdd, 0xb9, 0xba, 0xa9, 0x95, 0x7e, 0xde, 0xd4, 0x76, 0xda, 0x2c, 0xe9, 0xa4, 0x4d, 0x27,
+	0x10, 0x91, 0x1b, 0x08, 0x15, 0x09, 0x55, 0xfc, 0x3c, 0x57, 0x61, 0xd7, 0x4d, 0x3e, 0xc5, 0xa0,
+	0x9c, 0x63, 0x08, 0xe8, 0x46, 0xc4, 0x38, 0x2d, 0xb2, 0x35, 0x40, 0x16, 0x65, 0x3e, 0xa2, 0xff,
+	0xf5, 0x3f, 0xf0, 0x5a, 0xd2, 0x0f, 0x12, 0x26, 0xb8, 0x17, 0x31, 0x95, 0x3d, 0xc5, 0x7f, 0x29,
+	0xb1, 0xae, 0x53, 0x9d, 0x14, 0x99, 0x4e, 0x99, 0x52, 0xf5, 0xb7, 0x57, 0x43, 0xd3, 0xb8, 0x1e,
+	0x9a, 0xc6, 0xaf, 0xa1, 0x69, 0x7c, 0x19, 0x99, 0xa5, 0xeb, 0x91, 0x59, 0xfa, 0x31, 0x32, 0x4b,
+	0x1f, 0x76, 0x6f, 0x25, 0x7d, 0x9d, 0x8f, 0xf9, 0x0c, 0x92, 0xbe, 0x90, 0x5d, 0x77, 0xbc, 0xbd,
+	0x83, 0xf1, 0xfe, 0xea, 0x12, 0xcd, 0x8a, 0x5e, 0xd0, 0x83, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff,
+	0x22, 0x22, 0xeb, 0xb8, 0x2f, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -250,6 +290,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Slashing != nil {
+		{
+			size, err := m.Slashing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.MaxSizePrices != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MaxSizePrices))
 		i--
@@ -353,6 +405,54 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SlashingParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashingParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlashFractionMiss.Size()
+		i -= size
+		if _, err := m.SlashFractionMiss.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinReportedPerWindow.Size()
+		i -= size
+		if _, err := m.MinReportedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ReportedRoundsWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReportedRoundsWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -418,6 +518,26 @@ func (m *Params) Size() (n int) {
 	if m.MaxSizePrices != 0 {
 		n += 1 + sovParams(uint64(m.MaxSizePrices))
 	}
+	if m.Slashing != nil {
+		l = m.Slashing.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *SlashingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReportedRoundsWindow != 0 {
+		n += 1 + sovParams(uint64(m.ReportedRoundsWindow))
+	}
+	l = m.MinReportedPerWindow.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SlashFractionMiss.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -740,6 +860,179 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Slashing == nil {
+				m.Slashing = &SlashingParams{}
+			}
+			if err := m.Slashing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashingParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashingParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportedRoundsWindow", wireType)
+			}
+			m.ReportedRoundsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportedRoundsWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReportedPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinReportedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionMiss", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionMiss.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
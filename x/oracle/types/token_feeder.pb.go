@@ -23,6 +23,47 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ConsensusMode defines the consensus mode for the prices.
+type ConsensusMode int32
+
+const (
+	// CONSENSUS_MODE_UNSPECIFIED defines an invalid mode.
+	ConsensusModeUnspecified ConsensusMode = 0
+	// CONSENSUS_MODE_ASAP defines the mode to get final price immediately when the voting power
+	// exceeds the threshold.
+	ConsensusModeASAP ConsensusMode = 1
+	// CONSENSUS_MODE_WEIGHTED_MEDIAN defines the mode to get final price as soon as the voting
+	// power exceeds the threshold, the final price is the median of the prices submitted by
+	// the validators weighted by their voting power.
+	ConsensusModeWeightedMedian ConsensusMode = 2
+	// CONSENSUS_MODE_TWAP defines the mode to get final price as soon as the voting power
+	// exceeds the threshold like CONSENSUS_MODE_ASAP, and the price provided to the other
+	// modules is the time-weighted average of the last twap_rounds rounds.
+	ConsensusModeTWAP ConsensusMode = 3
+)
+
+var ConsensusMode_name = map[int32]string{
+	0: "CONSENSUS_MODE_UNSPECIFIED",
+	1: "CONSENSUS_MODE_ASAP",
+	2: "CONSENSUS_MODE_WEIGHTED_MEDIAN",
+	3: "CONSENSUS_MODE_TWAP",
+}
+
+var ConsensusMode_value = map[string]int32{
+	"CONSENSUS_MODE_UNSPECIFIED":     0,
+	"CONSENSUS_MODE_ASAP":            1,
+	"CONSENSUS_MODE_WEIGHTED_MEDIAN": 2,
+	"CONSENSUS_MODE_TWAP":            3,
+}
+
+func (x ConsensusMode) String() string {
+	return proto.EnumName(ConsensusMode_name, int32(x))
+}
+
+func (ConsensusMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cda9f9d49dac740c, []int{0}
+}
+
 // n out of m required source
 type NOMSource struct {
 	// required source set, refer to params.sourceList, 1st set to 0 means all valid sources
@@ -154,6 +195,12 @@ type TokenFeeder struct {
 	// this is set by updateParams, and the EndRoundID will be update by related. excluded,
 	// will not work if current height >=EndBlock
 	EndBlock uint64 `protobuf:"varint,6,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+	// mode is the consensus mode of the token feeder, CONSENSUS_MODE_UNSPECIFIED means the mode
+	// of the params is used.
+	Mode ConsensusMode `protobuf:"varint,7,opt,name=mode,proto3,enum=exocore.oracle.v1.ConsensusMode" json:"mode,omitempty"`
+	// twap_rounds is the count of the latest rounds used to calculate the time-weighted average
+	// price, it's only used by CONSENSUS_MODE_TWAP.
+	TWAPRounds uint64 `protobuf:"varint,8,opt,name=twap_rounds,json=twapRounds,proto3" json:"twap_rounds,omitempty"`
 }
 
 func (m *TokenFeeder) Reset()         { *m = TokenFeeder{} }
@@ -231,7 +278,22 @@ func (m *TokenFeeder) GetEndBlock() uint64 {
 	return 0
 }
 
+func (m *TokenFeeder) GetMode() ConsensusMode {
+	if m != nil {
+		return m.Mode
+	}
+	return ConsensusModeUnspecified
+}
+
+func (m *TokenFeeder) GetTWAPRounds() uint64 {
+	if m != nil {
+		return m.TWAPRounds
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("exocore.oracle.v1.ConsensusMode", ConsensusMode_name, ConsensusMode_value)
 	proto.RegisterType((*NOMSource)(nil), "exocore.oracle.v1.NOMSource")
 	proto.RegisterType((*RuleSource)(nil), "exocore.oracle.v1.RuleSource")
 	proto.RegisterType((*TokenFeeder)(nil), "exocore.oracle.v1.TokenFeeder")
@@ -242,33 +304,45 @@ func init() {
 }
 
 var fileDescriptor_cda9f9d49dac740c = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0x63, 0x12, 0x92, 0x7a, 0x52, 0x50, 0x59, 0x71, 0xb0, 0x0a, 0xb2, 0xab, 0x80, 0x50,
-	0x0e, 0xc8, 0xa6, 0x20, 0xf1, 0x00, 0x96, 0x41, 0x32, 0x12, 0x45, 0xda, 0x70, 0xe2, 0x62, 0xd9,
-	0xde, 0x21, 0x98, 0xd8, 0xde, 0x6a, 0x77, 0x1d, 0xca, 0x5b, 0xf0, 0x58, 0x1c, 0x7b, 0xe4, 0x64,
-	0x21, 0xe7, 0x19, 0xb8, 0xa3, 0xdd, 0x6d, 0xc2, 0x81, 0x5b, 0x6f, 0x3b, 0xfb, 0x7f, 0x33, 0xfa,
-	0xe7, 0xd7, 0xc0, 0x53, 0xbc, 0xe2, 0x25, 0x17, 0x18, 0x71, 0x91, 0x97, 0x35, 0x46, 0xdb, 0xf3,
-	0x48, 0xf1, 0x0d, 0xb6, 0xd9, 0x67, 0x44, 0x86, 0x22, 0xbc, 0x14, 0x5c, 0x71, 0xf2, 0xe0, 0x86,
-	0x0a, 0x2d, 0x15, 0x6e, 0xcf, 0x4f, 0x1f, 0xae, 0xf9, 0x9a, 0x1b, 0x35, 0xd2, 0x2f, 0x0b, 0x2e,
-	0x56, 0xe0, 0x5e, 0x7c, 0x78, 0xbf, 0xe2, 0x9d, 0x28, 0x91, 0x3c, 0x07, 0x90, 0xe6, 0x95, 0x55,
-	0x4c, 0x7a, 0xce, 0xd9, 0x78, 0x39, 0x89, 0xef, 0x0d, 0x7d, 0xe0, 0x5a, 0x3d, 0x4d, 0x24, 0x75,
-	0x2d, 0x90, 0x32, 0x49, 0x3c, 0x98, 0x35, 0x55, 0x5b, 0x35, 0x5d, 0xe3, 0xdd, 0x39, 0x73, 0x96,
-	0x13, 0xba, 0x2f, 0x17, 0x5f, 0x01, 0x68, 0x57, 0xe3, 0xad, 0xa6, 0x86, 0x30, 0x6e, 0xb9, 0x9d,
-	0x38, 0x7f, 0xf9, 0x38, 0xfc, 0x6f, 0x8f, 0xf0, 0x60, 0x97, 0x6a, 0x70, 0xf1, 0xc7, 0x81, 0xf9,
-	0x47, 0x1d, 0xc0, 0x5b, 0xb3, 0x3f, 0x79, 0x06, 0x47, 0x36, 0x8f, 0x8a, 0x79, 0x8e, 0xb6, 0x15,
-	0xcf, 0x87, 0x3e, 0x98, 0x19, 0x24, 0x4d, 0xe8, 0xcc, 0x88, 0x29, 0x23, 0x4f, 0x60, 0x26, 0xba,
-	0x5a, 0x7b, 0xb2, 0xee, 0x63, 0x18, 0xfa, 0x60, 0xaa, 0x6d, 0xa7, 0x09, 0x9d, 0x6a, 0x29, 0x65,
-	0xe4, 0x35, 0xdc, 0x97, 0x2a, 0x17, 0x2a, 0x13, 0xbc, 0x6b, 0x99, 0x66, 0xc7, 0x86, 0x3d, 0x19,
-	0xfa, 0xe0, 0x78, 0xa5, 0x15, 0xaa, 0x85, 0x34, 0xa1, 0xc7, 0xf2, 0x5f, 0xc5, 0xc8, 0x12, 0x4e,
-	0x6c, 0x5f, 0x91, 0x4b, 0xcc, 0x8a, 0x9a, 0x97, 0x1b, 0x6f, 0x62, 0x32, 0xb2, 0xf3, 0xe2, 0x5c,
-	0x62, 0xac, 0x7f, 0xc9, 0x29, 0x1c, 0x55, 0xad, 0x42, 0xb1, 0xcd, 0x6b, 0xef, 0xae, 0x21, 0x0e,
-	0x35, 0x79, 0x04, 0x2e, 0xb6, 0xec, 0xa6, 0x7d, 0x6a, 0x45, 0x6c, 0x99, 0x69, 0x8c, 0xdf, 0xfd,
-	0x1c, 0x7c, 0xe7, 0x7a, 0xf0, 0x9d, 0xdf, 0x83, 0xef, 0xfc, 0xd8, 0xf9, 0xa3, 0xeb, 0x9d, 0x3f,
-	0xfa, 0xb5, 0xf3, 0x47, 0x9f, 0x5e, 0xac, 0x2b, 0xf5, 0xa5, 0x2b, 0xc2, 0x92, 0x37, 0xd1, 0x1b,
-	0x1b, 0xdf, 0x05, 0xaa, 0x6f, 0x5c, 0x6c, 0xa2, 0xfd, 0xed, 0x5c, 0xed, 0xaf, 0x47, 0x7d, 0xbf,
-	0x44, 0x59, 0x4c, 0xcd, 0x2d, 0xbc, 0xfa, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x71, 0x5f, 0xa6,
-	0x5c, 0x02, 0x00, 0x00,
+	// 594 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xe3, 0x26, 0x24, 0xcd, 0xa6, 0xad, 0xd2, 0x05, 0x24, 0xcb, 0xad, 0x1c, 0xab, 0x20,
+	0x14, 0x21, 0x64, 0xd3, 0x82, 0x38, 0x71, 0x49, 0x62, 0x17, 0x8c, 0x94, 0xb4, 0xb2, 0x5b, 0x55,
+	0xe2, 0x62, 0x39, 0xde, 0x69, 0x6a, 0x9a, 0x78, 0x23, 0xaf, 0xdd, 0x96, 0x37, 0x40, 0x3d, 0xf1,
+	0x02, 0x3d, 0xf1, 0x32, 0x1c, 0x7b, 0xe4, 0x80, 0x22, 0xe4, 0xbe, 0x04, 0x47, 0xb4, 0xbb, 0x6d,
+	0x51, 0x4a, 0x4f, 0xdc, 0x76, 0xfc, 0x7f, 0xff, 0xf8, 0x9f, 0x91, 0x06, 0x3d, 0x85, 0x33, 0x1a,
+	0xd1, 0x14, 0x2c, 0x9a, 0x86, 0xd1, 0x18, 0xac, 0x93, 0x4d, 0x2b, 0xa3, 0xc7, 0x90, 0x04, 0x87,
+	0x00, 0x04, 0x52, 0x73, 0x9a, 0xd2, 0x8c, 0xe2, 0xd5, 0x6b, 0xca, 0x94, 0x94, 0x79, 0xb2, 0xa9,
+	0x3d, 0x1a, 0xd1, 0x11, 0x15, 0xaa, 0xc5, 0x5f, 0x12, 0xdc, 0xf0, 0x51, 0x7d, 0xb0, 0xd3, 0xf7,
+	0x69, 0x9e, 0x46, 0x80, 0x5f, 0x20, 0xc4, 0xc4, 0x2b, 0x88, 0x09, 0x53, 0x15, 0xa3, 0xdc, 0xae,
+	0x74, 0x97, 0x8b, 0x59, 0xab, 0x2e, 0x75, 0xd7, 0x66, 0x5e, 0x5d, 0x02, 0x2e, 0x61, 0x58, 0x45,
+	0xb5, 0x49, 0x9c, 0xc4, 0x93, 0x7c, 0xa2, 0x2e, 0x18, 0x4a, 0xbb, 0xe2, 0xdd, 0x94, 0x1b, 0x9f,
+	0x10, 0xf2, 0xf2, 0x31, 0xfc, 0x57, 0x57, 0x13, 0x95, 0x13, 0x2a, 0x3b, 0x36, 0xb6, 0xd6, 0xcd,
+	0x7f, 0xe6, 0x30, 0x6f, 0xe3, 0x7a, 0x1c, 0xdc, 0xf8, 0xb9, 0x80, 0x1a, 0x7b, 0x7c, 0x01, 0xdb,
+	0x62, 0x7e, 0xfc, 0x0c, 0x2d, 0xca, 0x7d, 0xc4, 0x44, 0x55, 0x78, 0xac, 0x6e, 0xa3, 0x98, 0xb5,
+	0x6a, 0x02, 0x71, 0x6d, 0xaf, 0x26, 0x44, 0x97, 0xe0, 0x27, 0xa8, 0x96, 0xe6, 0x63, 0x9e, 0x49,
+	0xa6, 0xef, 0xa2, 0x62, 0xd6, 0xaa, 0xf2, 0xd8, 0xae, 0xed, 0x55, 0xb9, 0xe4, 0x12, 0xfc, 0x06,
+	0xad, 0xb0, 0x2c, 0x4c, 0xb3, 0x20, 0xa5, 0x79, 0x42, 0x38, 0x5b, 0x16, 0x6c, 0xb3, 0x98, 0xb5,
+	0x96, 0x7c, 0xae, 0x78, 0x5c, 0x70, 0x6d, 0x6f, 0x89, 0xfd, 0xad, 0x08, 0x6e, 0xa3, 0xa6, 0xf4,
+	0x0d, 0x43, 0x06, 0xc1, 0x70, 0x4c, 0xa3, 0x63, 0xb5, 0x22, 0x76, 0x24, 0xfb, 0x75, 0x43, 0x06,
+	0x5d, 0xfe, 0x15, 0x6b, 0x68, 0x31, 0x4e, 0x32, 0x48, 0x4f, 0xc2, 0xb1, 0xfa, 0x40, 0x10, 0xb7,
+	0x35, 0x5e, 0x43, 0x75, 0x48, 0xc8, 0xb5, 0xbd, 0x2a, 0x45, 0x48, 0x88, 0x34, 0xbe, 0x46, 0x95,
+	0x09, 0x25, 0xa0, 0xd6, 0x0c, 0xa5, 0xbd, 0xb2, 0x65, 0xdc, 0xb3, 0xa8, 0x1e, 0x4d, 0x18, 0x24,
+	0x2c, 0x67, 0x7d, 0x4a, 0xc0, 0x13, 0x34, 0xb6, 0x50, 0x23, 0x3b, 0x0d, 0xa7, 0x72, 0x1e, 0xa6,
+	0x2e, 0x8a, 0x69, 0x56, 0x8a, 0x59, 0x0b, 0xed, 0x1d, 0x74, 0x76, 0x45, 0x7c, 0xe6, 0x21, 0x8e,
+	0xc8, 0xf7, 0xf3, 0xdf, 0x0a, 0x5a, 0x9e, 0x6b, 0x84, 0xdf, 0x22, 0xad, 0xb7, 0x33, 0xf0, 0x9d,
+	0x81, 0xbf, 0xef, 0x07, 0xfd, 0x1d, 0xdb, 0x09, 0xf6, 0x07, 0xfe, 0xae, 0xd3, 0x73, 0xb7, 0x5d,
+	0xc7, 0x6e, 0x96, 0xb4, 0xf5, 0xf3, 0x0b, 0x43, 0x9d, 0xb3, 0xec, 0x27, 0x6c, 0x0a, 0x51, 0x7c,
+	0x18, 0x03, 0xc1, 0x26, 0x7a, 0x78, 0xc7, 0xdd, 0xf1, 0x3b, 0xbb, 0x4d, 0x45, 0x7b, 0x7c, 0x7e,
+	0x61, 0xac, 0xce, 0xd9, 0xb8, 0x80, 0x7b, 0x48, 0xbf, 0xc3, 0x1f, 0x38, 0xee, 0xbb, 0xf7, 0x7b,
+	0x8e, 0x1d, 0xf4, 0x1d, 0xdb, 0xed, 0x0c, 0x9a, 0x0b, 0x5a, 0xeb, 0xfc, 0xc2, 0x58, 0x9b, 0xb3,
+	0x1e, 0x40, 0x3c, 0x3a, 0xca, 0x80, 0xf4, 0x81, 0xc4, 0x61, 0x72, 0xcf, 0x4f, 0xf9, 0xb4, 0xcd,
+	0xf2, 0x3d, 0x3f, 0xe5, 0x82, 0x56, 0xf9, 0xf2, 0x4d, 0x2f, 0x75, 0x3f, 0x7c, 0x2f, 0x74, 0xe5,
+	0xb2, 0xd0, 0x95, 0x5f, 0x85, 0xae, 0x7c, 0xbd, 0xd2, 0x4b, 0x97, 0x57, 0x7a, 0xe9, 0xc7, 0x95,
+	0x5e, 0xfa, 0xf8, 0x72, 0x14, 0x67, 0x47, 0xf9, 0xd0, 0x8c, 0xe8, 0xc4, 0x72, 0xe4, 0xde, 0x07,
+	0x90, 0x9d, 0xd2, 0xf4, 0xd8, 0xba, 0xb9, 0xce, 0xb3, 0x9b, 0xfb, 0xcc, 0x3e, 0x4f, 0x81, 0x0d,
+	0xab, 0xe2, 0xda, 0x5e, 0xfd, 0x09, 0x00, 0x00, 0xff, 0xff, 0xc0, 0x66, 0x04, 0x73, 0xbe, 0x03,
+	0x00, 0x00,
 }
 
 func (m *NOMSource) Marshal() (dAtA []byte, err error) {
@@ -390,6 +464,16 @@ func (m *TokenFeeder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TWAPRounds != 0 {
+		i = encodeVarintTokenFeeder(dAtA, i, uint64(m.TWAPRounds))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Mode != 0 {
+		i = encodeVarintTokenFeeder(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.EndBlock != 0 {
 		i = encodeVarintTokenFeeder(dAtA, i, uint64(m.EndBlock))
 		i--
@@ -497,6 +581,12 @@ func (m *TokenFeeder) Size() (n int) {
 	if m.EndBlock != 0 {
 		n += 1 + sovTokenFeeder(uint64(m.EndBlock))
 	}
+	if m.Mode != 0 {
+		n += 1 + sovTokenFeeder(uint64(m.Mode))
+	}
+	if m.TWAPRounds != 0 {
+		n += 1 + sovTokenFeeder(uint64(m.TWAPRounds))
+	}
 	return n
 }
 
@@ -956,6 +1046,44 @@ func (m *TokenFeeder) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenFeeder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= ConsensusMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TWAPRounds", wireType)
+			}
+			m.TWAPRounds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenFeeder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TWAPRounds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTokenFeeder(dAtA[iNdEx:])
@@ -2,10 +2,18 @@ package types
 
 import (
 	"encoding/binary"
+	"regexp"
+	"strings"
 
 	sdkmath "cosmossdk.io/math"
 )
 
+// oracleInfo: '[tokenName],[chainName],[tokenDecimal](,[interval],[contract](,[ChainDesc:{...}],[TokenDesc:{...}]))'
+var (
+	tokenDescMatcher = regexp.MustCompile(`TokenDesc:{(.+?)}`)
+	chainDescMatcher = regexp.MustCompile(`ChainDesc:{(.+?)}`)
+)
+
 type OracleInfo struct {
 	Chain struct {
 		Name string
@@ -31,9 +39,45 @@ type OracleInfo struct {
 	AssetID string `json:"asset_id"`
 }
 
+// ParseOracleInfo parses the oracle info from the comma separated string, at least the token
+// name, chain name and token decimal should be provided.
+func ParseOracleInfo(oracleInfoStr string) (OracleInfo, error) {
+	oracleInfo := OracleInfo{}
+	parsed := strings.Split(oracleInfoStr, ",")
+	l := len(parsed)
+	switch {
+	case l > 5:
+		joined := strings.Join(parsed[5:], "")
+		tokenDesc := tokenDescMatcher.FindStringSubmatch(joined)
+		chainDesc := chainDescMatcher.FindStringSubmatch(joined)
+		if len(tokenDesc) == 2 {
+			oracleInfo.Token.Desc = tokenDesc[1]
+		}
+		if len(chainDesc) == 2 {
+			oracleInfo.Chain.Desc = chainDesc[1]
+		}
+		fallthrough
+	case l >= 5:
+		oracleInfo.Token.Contract = parsed[4]
+		fallthrough
+	case l >= 4:
+		oracleInfo.Feeder.Interval = parsed[3]
+		fallthrough
+	case l >= 3:
+		oracleInfo.Token.Name = parsed[0]
+		oracleInfo.Chain.Name = parsed[1]
+		oracleInfo.Token.Decimal = parsed[2]
+	default:
+		return OracleInfo{}, ErrInvalidOracleInfo
+	}
+	return oracleInfo, nil
+}
+
 type Price struct {
 	Value   sdkmath.Int
 	Decimal uint8
+	// RoundID is the round of the price, it's zero for the default price
+	RoundID uint64
 }
 
 const (
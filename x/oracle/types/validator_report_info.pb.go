@@ -0,0 +1,718 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: exocore/oracle/v1/validator_report_info.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ValidatorReportInfo represents the liveness of a validator reporting prices
+type ValidatorReportInfo struct {
+	// address is the consensus address of the validator
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// start_height is the height from which the rounds are counted for the validator
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// index_offset is the count of the rounds counted since start_height, it's used to locate
+	// the index of the round in the missed rounds window
+	IndexOffset uint64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// missed_rounds_counter is the count of the missed rounds in the window
+	MissedRoundsCounter uint64 `protobuf:"varint,4,opt,name=missed_rounds_counter,json=missedRoundsCounter,proto3" json:"missed_rounds_counter,omitempty"`
+}
+
+func (m *ValidatorReportInfo) Reset()         { *m = ValidatorReportInfo{} }
+func (m *ValidatorReportInfo) String() string { return proto.CompactTextString(m) }
+func (*ValidatorReportInfo) ProtoMessage()    {}
+func (*ValidatorReportInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b1b51c67c9b0312, []int{0}
+}
+func (m *ValidatorReportInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorReportInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorReportInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorReportInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorReportInfo.Merge(m, src)
+}
+func (m *ValidatorReportInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorReportInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorReportInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorReportInfo proto.InternalMessageInfo
+
+func (m *ValidatorReportInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorReportInfo) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ValidatorReportInfo) GetIndexOffset() uint64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *ValidatorReportInfo) GetMissedRoundsCounter() uint64 {
+	if m != nil {
+		return m.MissedRoundsCounter
+	}
+	return 0
+}
+
+// ValidatorMissedRounds represents the missed rounds of a validator in the window
+type ValidatorMissedRounds struct {
+	// address is the consensus address of the validator
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// missed_rounds is the list of the indexes of the missed rounds in the window
+	MissedRounds []uint64 `protobuf:"varint,2,rep,packed,name=missed_rounds,json=missedRounds,proto3" json:"missed_rounds,omitempty"`
+}
+
+func (m *ValidatorMissedRounds) Reset()         { *m = ValidatorMissedRounds{} }
+func (m *ValidatorMissedRounds) String() string { return proto.CompactTextString(m) }
+func (*ValidatorMissedRounds) ProtoMessage()    {}
+func (*ValidatorMissedRounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b1b51c67c9b0312, []int{1}
+}
+func (m *ValidatorMissedRounds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorMissedRounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorMissedRounds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorMissedRounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorMissedRounds.Merge(m, src)
+}
+func (m *ValidatorMissedRounds) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorMissedRounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorMissedRounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorMissedRounds proto.InternalMessageInfo
+
+func (m *ValidatorMissedRounds) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorMissedRounds) GetMissedRounds() []uint64 {
+	if m != nil {
+		return m.MissedRounds
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ValidatorReportInfo)(nil), "exocore.oracle.v1.ValidatorReportInfo")
+	proto.RegisterType((*ValidatorMissedRounds)(nil), "exocore.oracle.v1.ValidatorMissedRounds")
+}
+
+func init() {
+	proto.RegisterFile("exocore/oracle/v1/validator_report_info.proto", fileDescriptor_5b1b51c67c9b0312)
+}
+
+var fileDescriptor_5b1b51c67c9b0312 = []byte{
+	// 300 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0x86, 0xeb, 0xb6, 0xba, 0x57, 0xb8, 0x65, 0x20, 0x55, 0x25, 0x4f, 0x56, 0x28, 0x4b, 0x16,
+	0x12, 0x0a, 0x6f, 0x00, 0x42, 0x02, 0x24, 0x40, 0xf2, 0xd0, 0x81, 0xc5, 0x4a, 0xe3, 0x93, 0xd6,
+	0xa2, 0xcd, 0xa9, 0x6c, 0xb7, 0x94, 0xb7, 0xe0, 0x35, 0x78, 0x13, 0xc6, 0x8e, 0x8c, 0xa8, 0x79,
+	0x11, 0x84, 0x43, 0x50, 0x59, 0x18, 0xfd, 0xff, 0xdf, 0xf1, 0x39, 0xfa, 0xe8, 0x31, 0xac, 0x31,
+	0x43, 0x03, 0x09, 0x9a, 0x34, 0x9b, 0x41, 0xb2, 0x1a, 0x26, 0xab, 0x74, 0xa6, 0x55, 0xea, 0xd0,
+	0x48, 0x03, 0x0b, 0x34, 0x4e, 0xea, 0x22, 0xc7, 0x78, 0x61, 0xd0, 0x61, 0x70, 0xf0, 0x8d, 0xc7,
+	0x15, 0x1e, 0xaf, 0x86, 0x83, 0x57, 0x42, 0x7b, 0xa3, 0x7a, 0x44, 0xf8, 0x89, 0xeb, 0x22, 0xc7,
+	0x80, 0xd1, 0xff, 0xa9, 0x52, 0x06, 0xac, 0x65, 0x24, 0x24, 0xd1, 0x9e, 0xa8, 0x9f, 0xc1, 0x21,
+	0xed, 0x5a, 0x97, 0x1a, 0x27, 0xa7, 0xa0, 0x27, 0x53, 0xc7, 0x9a, 0x21, 0x89, 0x5a, 0xa2, 0xe3,
+	0xb3, 0x2b, 0x1f, 0x7d, 0x21, 0xba, 0x50, 0xb0, 0x96, 0x98, 0xe7, 0x16, 0x1c, 0x6b, 0x85, 0x24,
+	0x6a, 0x8b, 0x8e, 0xcf, 0xee, 0x7d, 0x14, 0x9c, 0xd2, 0xfe, 0x5c, 0x5b, 0x0b, 0x4a, 0x1a, 0x5c,
+	0x16, 0xca, 0xca, 0x0c, 0x97, 0x85, 0x03, 0xc3, 0xda, 0x9e, 0xed, 0x55, 0xa5, 0xf0, 0xdd, 0x45,
+	0x55, 0x0d, 0x46, 0xb4, 0xff, 0x73, 0xea, 0xed, 0x4e, 0xff, 0xc7, 0xb1, 0x47, 0x74, 0xff, 0xd7,
+	0x1a, 0xd6, 0x0c, 0x5b, 0x51, 0x5b, 0x74, 0x77, 0xbf, 0x3f, 0xbf, 0x79, 0xdb, 0x72, 0xb2, 0xd9,
+	0x72, 0xf2, 0xb1, 0xe5, 0xe4, 0xa5, 0xe4, 0x8d, 0x4d, 0xc9, 0x1b, 0xef, 0x25, 0x6f, 0x3c, 0x9c,
+	0x4c, 0xb4, 0x9b, 0x2e, 0xc7, 0x71, 0x86, 0xf3, 0xe4, 0xb2, 0x72, 0x77, 0x07, 0xee, 0x09, 0xcd,
+	0x63, 0x52, 0x9b, 0x5f, 0xd7, 0xee, 0xdd, 0xf3, 0x02, 0xec, 0xf8, 0x9f, 0x37, 0x7d, 0xf6, 0x19,
+	0x00, 0x00, 0xff, 0xff, 0x73, 0x84, 0xb8, 0xc0, 0x9a, 0x01, 0x00, 0x00,
+}
+
+func (m *ValidatorReportInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorReportInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorReportInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedRoundsCounter != 0 {
+		i = encodeVarintValidatorReportInfo(dAtA, i, uint64(m.MissedRoundsCounter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintValidatorReportInfo(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintValidatorReportInfo(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintValidatorReportInfo(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorMissedRounds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorMissedRounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorMissedRounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissedRounds) > 0 {
+		dAtA2 := make([]byte, len(m.MissedRounds)*10)
+		var j1 int
+		for _, num := range m.MissedRounds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintValidatorReportInfo(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintValidatorReportInfo(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintValidatorReportInfo(dAtA []byte, offset int, v uint64) int {
+	offset -= sovValidatorReportInfo(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorReportInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovValidatorReportInfo(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovValidatorReportInfo(uint64(m.StartHeight))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovValidatorReportInfo(uint64(m.IndexOffset))
+	}
+	if m.MissedRoundsCounter != 0 {
+		n += 1 + sovValidatorReportInfo(uint64(m.MissedRoundsCounter))
+	}
+	return n
+}
+
+func (m *ValidatorMissedRounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovValidatorReportInfo(uint64(l))
+	}
+	if len(m.MissedRounds) > 0 {
+		l = 0
+		for _, e := range m.MissedRounds {
+			l += sovValidatorReportInfo(uint64(e))
+		}
+		n += 1 + sovValidatorReportInfo(uint64(l)) + l
+	}
+	return n
+}
+
+func sovValidatorReportInfo(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozValidatorReportInfo(x uint64) (n int) {
+	return sovValidatorReportInfo(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorReportInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorReportInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorReportInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorReportInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorReportInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorReportInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorReportInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorReportInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorReportInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedRoundsCounter", wireType)
+			}
+			m.MissedRoundsCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorReportInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedRoundsCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorReportInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorReportInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorMissedRounds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorReportInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorMissedRounds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorMissedRounds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorReportInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorReportInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorReportInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorReportInfo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MissedRounds = append(m.MissedRounds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorReportInfo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthValidatorReportInfo
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthValidatorReportInfo
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MissedRounds) == 0 {
+					m.MissedRounds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowValidatorReportInfo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MissedRounds = append(m.MissedRounds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedRounds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorReportInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorReportInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipValidatorReportInfo(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowValidatorReportInfo
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorReportInfo
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorReportInfo
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthValidatorReportInfo
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupValidatorReportInfo
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthValidatorReportInfo
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthValidatorReportInfo        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowValidatorReportInfo          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupValidatorReportInfo = fmt.Errorf("proto: unexpected end of group")
+)
@@ -25,6 +25,7 @@ func GetQueryCmd(_ string) *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryClaimableRewards())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/ExocoreNetwork/exocore/x/reward/types"
+)
+
+func CmdQueryClaimableRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claimable-rewards <stakerID> [assetID]",
+		Short: "shows the rewards that can be claimed by a staker, optionally filtered by the asset",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryClaimableRewardsRequest{
+				StakerId: strings.ToLower(args[0]),
+			}
+			if len(args) == 2 {
+				req.AssetId = strings.ToLower(args[1])
+			}
+			res, err := queryClient.ClaimableRewards(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -3,10 +3,13 @@ package cli
 import (
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
-	// "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
 	"github.com/ExocoreNetwork/exocore/x/reward/types"
 )
 
@@ -20,7 +23,40 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand()
+	cmd.AddCommand(
+		CmdFundAVSReward(),
+	)
+
+	return cmd
+}
+
+// CmdFundAVSReward moves the deposit of an AVS owner into the reward fund of the AVS
+func CmdFundAVSReward() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-avs-reward [avs-address] [asset-id] [amount]",
+		Short: "fund the rewards of an AVS with the deposit of the sender",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[2])
+			}
+			msg := &types.MsgFundAVSReward{
+				FromAddress: cliCtx.GetFromAddress().String(),
+				AvsAddress:  args[0],
+				AssetID:     args[1],
+				Amount:      amount,
+			}
+			// this calls ValidateBasic internally so we don't need to do that.
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
 
+	// transaction level flags from the SDK
+	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -45,7 +45,7 @@ func getRewardParamsFromEventLog(log *ethtypes.Log) (*RewardParams, error) {
 	readStart = readEnd
 	readEnd += types.GeneralAssetsAddrLength
 	r = bytes.NewReader(log.Data[readStart:readEnd])
-	var assetsAddress []byte
+	assetsAddress := make([]byte, types.GeneralAssetsAddrLength)
 	err = binary.Read(r, binary.BigEndian, assetsAddress)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "error occurred when binary read assets address")
@@ -54,7 +54,7 @@ func getRewardParamsFromEventLog(log *ethtypes.Log) (*RewardParams, error) {
 	readStart = readEnd
 	readEnd += types.GeneralClientChainAddrLength
 	r = bytes.NewReader(log.Data[readStart:readEnd])
-	var rewardAddr []byte
+	rewardAddr := make([]byte, types.GeneralClientChainAddrLength)
 	err = binary.Read(r, binary.BigEndian, rewardAddr)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "error occurred when binary read assets address")
@@ -104,6 +104,16 @@ func (k Keeper) PostTxProcessing(ctx sdk.Context, _ core.Message, receipt *ethty
 			return err
 		}
 		if rewardParams != nil {
+			// remove the padding of the addresses according to the actual address length
+			info, err := k.assetsKeeper.GetClientChainInfoByIndex(ctx, rewardParams.ClientChainLzID)
+			if err != nil {
+				return err
+			}
+			if info.AddressLength > types.GeneralClientChainAddrLength {
+				return errorsmod.Wrapf(rtypes.ErrInvalidEvmAddressFormat, "invalid address length:%d", info.AddressLength)
+			}
+			rewardParams.AssetsAddress = rewardParams.AssetsAddress[:info.AddressLength]
+			rewardParams.WithdrawRewardAddress = rewardParams.WithdrawRewardAddress[:info.AddressLength]
 			err = k.RewardForWithdraw(ctx, rewardParams)
 			if err != nil {
 				// todo: need to test if the changed storage state will be reverted if there is an error occurred
@@ -114,38 +124,59 @@ func (k Keeper) PostTxProcessing(ctx sdk.Context, _ core.Message, receipt *ethty
 	return nil
 }
 
-func (k Keeper) RewardForWithdraw(sdk.Context, *RewardParams) error {
-	// TODO: rewards aren't yet supported
-	// it is safe to return an error, since the precompile call will prevent an error
-	// if err != nil return false
-	// the false will ensure no unnecessary LZ messages are sent by the gateway
-	return rtypes.ErrNotSupportYet
-	// // check event parameter then execute RewardForWithdraw operation
-	// if event.OpAmount.IsNegative() {
-	// 	return errorsmod.Wrap(rtypes.ErrRewardAmountIsNegative, fmt.Sprintf("the amount is:%s", event.OpAmount))
-	// }
-	// stakeID, assetID := getStakeIDAndAssetID(event)
-	// // check is asset exist
-	// if !k.assetsKeeper.IsStakingAsset(ctx, assetID) {
-	// 	return errorsmod.Wrap(rtypes.ErrRewardAssetNotExist, fmt.Sprintf("the assetID is:%s", assetID))
-	// }
+// RewardForWithdraw handles the reward claim of a staker. It settles the rewards accumulated
+// by the staker in the reward pools, and debits the claimed amount from the staker's claimable
+// reward and from the escrow backing it, so that the gateway can release the reward on the
+// client chain. An error is returned if the claimable reward is insufficient, in which case
+// the precompile returns false and no LZ message is sent by the gateway.
+func (k Keeper) RewardForWithdraw(ctx sdk.Context, event *RewardParams) error {
+	// check event parameter then execute RewardForWithdraw operation
+	if event.OpAmount.IsNil() || event.OpAmount.IsNegative() {
+		return errorsmod.Wrapf(rtypes.ErrRewardAmountIsNegative, "the amount is:%s", event.OpAmount)
+	}
+	stakerID, assetID := types.GetStakerIDAndAssetID(event.ClientChainLzID, event.WithdrawRewardAddress, event.AssetsAddress)
+	// check is asset exist
+	if !k.assetsKeeper.IsStakingAsset(ctx, assetID) {
+		return errorsmod.Wrapf(rtypes.ErrRewardAssetNotExist, "the assetID is:%s", assetID)
+	}
+
+	// check the claimable reward, including the reward that hasn't been settled yet, and the
+	// escrow backing it before any state change, so that a failed claim doesn't leave a
+	// partial update.
+	claimable := k.GetStakerReward(ctx, stakerID, assetID).Add(k.GetPendingStakerReward(ctx, stakerID, assetID))
+	if claimable.LT(event.OpAmount) {
+		return errorsmod.Wrapf(
+			rtypes.ErrInsufficientReward,
+			"stakerID:%s assetID:%s claimable:%s amount:%s", stakerID, assetID, claimable, event.OpAmount,
+		)
+	}
+	if escrow := k.GetRewardEscrow(ctx, assetID); escrow.LT(event.OpAmount) {
+		return errorsmod.Wrapf(
+			rtypes.ErrInsufficientRewardFund,
+			"the claim isn't backed by the escrow, assetID:%s escrow:%s amount:%s", assetID, escrow, event.OpAmount,
+		)
+	}
 
-	// // TODO verify the reward amount is valid
-	// changeAmount := types.DeltaStakerSingleAsset{
-	// 	TotalDepositAmount: event.OpAmount,
-	// 	WithdrawableAmount: event.OpAmount,
-	// }
-	// // TODO: there should be a reward pool to be transferred from for native tokens' reward, don't update staker-asset-info, just transfer exo-native-token:pool->staker or handled by validators since the reward would be transferred to validators directly.
-	// if assetID != types.ExocoreAssetID {
-	// 	err := k.assetsKeeper.UpdateStakerAssetState(ctx, stakeID, assetID, changeAmount)
-	// 	if err != nil {
-	// 		return err
-	// 	}
-	// 	if err = k.assetsKeeper.UpdateStakingAssetTotalAmount(ctx, assetID, event.OpAmount); err != nil {
-	// 		return err
-	// 	}
-	// }
-	// return nil
+	if err := k.settleStakerRewardsForAsset(ctx, stakerID, assetID); err != nil {
+		return err
+	}
+	if err := k.releaseRewardEscrow(ctx, assetID, event.OpAmount); err != nil {
+		return err
+	}
+	remaining, err := k.SubStakerReward(ctx, stakerID, assetID, event.OpAmount)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			rtypes.EventTypeRewardClaimed,
+			sdk.NewAttribute(rtypes.AttributeKeyStakerID, stakerID),
+			sdk.NewAttribute(rtypes.AttributeKeyAssetID, assetID),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, event.OpAmount.String()),
+			sdk.NewAttribute(rtypes.AttributeKeyRemainingAmount, remaining.String()),
+		),
+	)
+	return nil
 }
 
 // WithdrawDelegationRewards is an implementation of a function in the distribution interface.
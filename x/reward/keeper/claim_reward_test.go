@@ -9,9 +9,6 @@ import (
 )
 
 func (suite *RewardTestSuite) TestClaimWithdrawRequest() {
-	_, err := suite.App.AssetsKeeper.GetAllStakingAssetsInfo(suite.Ctx)
-	suite.NoError(err)
-
 	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
 	usdcAddress := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
 	event := &keeper.RewardParams{
@@ -21,29 +18,42 @@ func (suite *RewardTestSuite) TestClaimWithdrawRequest() {
 		OpAmount:              sdkmath.NewInt(10),
 	}
 
-	// test the case that the deposit asset hasn't registered
+	// test the case that the reward asset hasn't registered
 	event.AssetsAddress = usdcAddress[:]
+	err := suite.App.RewardKeeper.RewardForWithdraw(suite.Ctx, event)
+	suite.ErrorContains(err, rewardtype.ErrRewardAssetNotExist.Error())
+
+	// test the case that the staker hasn't been rewarded
+	event.AssetsAddress = usdtAddress[:]
 	err = suite.App.RewardKeeper.RewardForWithdraw(suite.Ctx, event)
-	// suite.ErrorContains(err, rewardtype.ErrRewardAssetNotExist.Error())
-	suite.ErrorContains(err, rewardtype.ErrNotSupportYet.Error())
+	suite.ErrorContains(err, rewardtype.ErrInsufficientReward.Error())
+
+	// test the case that the reward isn't backed by the escrow
+	stakerID, assetID := types.GetStakerIDAndAssetID(event.ClientChainLzID, event.WithdrawRewardAddress, event.AssetsAddress)
+	err = suite.App.RewardKeeper.AddStakerReward(suite.Ctx, stakerID, assetID, sdkmath.NewInt(15))
+	suite.NoError(err)
+	err = suite.App.RewardKeeper.RewardForWithdraw(suite.Ctx, event)
+	suite.ErrorContains(err, rewardtype.ErrInsufficientRewardFund.Error())
 
 	// test the normal case
-	event.AssetsAddress = usdtAddress[:]
+	suite.App.RewardKeeper.SetAllRewardEscrows(suite.Ctx, []rewardtype.RewardEscrow{{AssetID: assetID, Amount: sdkmath.NewInt(15)}})
 	err = suite.App.RewardKeeper.RewardForWithdraw(suite.Ctx, event)
-	// suite.NoError(err)
-	suite.ErrorContains(err, rewardtype.ErrNotSupportYet.Error())
-
-	// check state after reward
-	// stakerID, assetID := types.GetStakerIDAndAssetID(event.ClientChainLzID, event.WithdrawRewardAddress, event.AssetsAddress)
-	// info, err := suite.App.AssetsKeeper.GetStakerSpecifiedAssetInfo(suite.Ctx, stakerID, assetID)
-	// suite.NoError(err)
-	// suite.Equal(types.StakerAssetInfo{
-	// 	TotalDepositAmount:        sdkmath.NewInt(10),
-	// 	WithdrawableAmount:        sdkmath.NewInt(10),
-	// 	PendingUndelegationAmount: sdkmath.NewInt(0),
-	// }, *info)
-
-	// assetInfo, err := suite.App.AssetsKeeper.GetStakingAssetInfo(suite.Ctx, assetID)
-	// suite.NoError(err)
-	// suite.Equal(sdkmath.NewInt(10).Add(assets[0].StakingTotalAmount), assetInfo.StakingTotalAmount)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(5), suite.App.RewardKeeper.GetRewardEscrow(suite.Ctx, assetID))
+	suite.Equal(sdkmath.NewInt(5), suite.App.RewardKeeper.GetStakerReward(suite.Ctx, stakerID, assetID))
+
+	// the remaining reward is insufficient, and the failed claim doesn't release the escrow
+	err = suite.App.RewardKeeper.RewardForWithdraw(suite.Ctx, event)
+	suite.ErrorContains(err, rewardtype.ErrInsufficientReward.Error())
+	suite.Equal(sdkmath.NewInt(5), suite.App.RewardKeeper.GetRewardEscrow(suite.Ctx, assetID))
+	suite.Equal(sdkmath.NewInt(5), suite.App.RewardKeeper.GetStakerReward(suite.Ctx, stakerID, assetID))
+
+	// claim all the remaining reward, the record should be removed
+	event.OpAmount = sdkmath.NewInt(5)
+	err = suite.App.RewardKeeper.RewardForWithdraw(suite.Ctx, event)
+	suite.NoError(err)
+	suite.True(suite.App.RewardKeeper.GetStakerReward(suite.Ctx, stakerID, assetID).IsZero())
+	rewards, err := suite.App.RewardKeeper.GetStakerRewards(suite.Ctx, stakerID)
+	suite.NoError(err)
+	suite.Empty(rewards)
 }
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/x/reward/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DistributeOperatorReward distributes the reward earned by the operator from the AVS to the
+// reward pool of the operator, so that it's shared pro-rata to the delegated share by the
+// stakers. The reward is paid from the reward fund of the AVS, and an error is returned if
+// the fund is insufficient. Nothing is distributed if no share is delegated to the operator.
+func (k Keeper) DistributeOperatorReward(ctx sdk.Context, avsAddr, operator, assetID string, amount sdkmath.Int) error {
+	if amount.IsNil() || amount.IsNegative() {
+		return errorsmod.Wrapf(types.ErrRewardAmountIsNegative, "the amount is:%s", amount)
+	}
+	if amount.IsZero() {
+		return nil
+	}
+	if !k.GetOperatorRewardPool(ctx, operator, assetID).TotalShare.IsPositive() {
+		return nil
+	}
+	if err := k.spendRewardFund(ctx, avsAddr, assetID, amount); err != nil {
+		return err
+	}
+	k.addOperatorReward(ctx, operator, assetID, amount)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewardCredited,
+			sdk.NewAttribute(types.AttributeKeyAVSAddress, avsAddr),
+			sdk.NewAttribute(types.AttributeKeyOperator, operator),
+			sdk.NewAttribute(types.AttributeKeyAssetID, assetID),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+	return nil
+}
+
+// DistributeAVSReward distributes the epoch reward of the AVS to the reward pools of the
+// operators opted into the AVS, pro-rata to the amount of each rewarded asset delegated to
+// them. The reward of an asset is capped by the reward fund of the AVS, and the remainder
+// caused by the truncation isn't distributed.
+func (k Keeper) DistributeAVSReward(ctx sdk.Context, avsAddr, epochIdentifier string, epochNumber int64) error {
+	avsInfo, err := k.avsKeeper.GetAVSInfo(ctx, avsAddr)
+	if err != nil {
+		return err
+	}
+	// #nosec G115
+	if epochNumber < int64(avsInfo.Info.StartingEpoch) {
+		// the AVS hasn't started yet
+		return nil
+	}
+	operators, err := k.avsKeeper.GetOptInOperators(ctx, avsAddr)
+	if err != nil {
+		return err
+	}
+	pool := k.getPool(ctx, types.ModuleName)
+	for _, assetID := range avsInfo.Info.AssetIDs {
+		rewardAmount := sdkmath.MinInt(
+			sdkmath.NewInt(avsInfo.Info.AssetRewardAmountEpochBasis[assetID]),
+			k.GetRewardFund(ctx, avsAddr, assetID),
+		)
+		if !rewardAmount.IsPositive() {
+			continue
+		}
+		amounts := make([]sdkmath.Int, len(operators))
+		total := sdkmath.ZeroInt()
+		for i, operator := range operators {
+			if amounts[i], err = k.operatorTrackedAmount(ctx, operator, assetID); err != nil {
+				return err
+			}
+			total = total.Add(amounts[i])
+		}
+		if total.IsZero() {
+			continue
+		}
+		distributed := sdkmath.ZeroInt()
+		for i, operator := range operators {
+			reward := rewardAmount.Mul(amounts[i]).Quo(total)
+			if reward.IsZero() || !k.addOperatorReward(ctx, operator, assetID, reward) {
+				continue
+			}
+			distributed = distributed.Add(reward)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeRewardCredited,
+					sdk.NewAttribute(types.AttributeKeyAVSAddress, avsAddr),
+					sdk.NewAttribute(types.AttributeKeyOperator, operator),
+					sdk.NewAttribute(types.AttributeKeyAssetID, assetID),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, reward.String()),
+					sdk.NewAttribute(types.AttributeKeyEpochIdentifier, epochIdentifier),
+					sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprintf("%d", epochNumber)),
+				),
+			)
+		}
+		if err := k.spendRewardFund(ctx, avsAddr, assetID, distributed); err != nil {
+			return err
+		}
+		// record the total distributed reward of the AVS in the pool, the asset symbol is
+		// used as the denomination if it's a valid one.
+		assetInfo, err := k.assetsKeeper.GetStakingAssetInfo(ctx, assetID)
+		if err != nil {
+			return err
+		}
+		if sdk.ValidateDenom(assetInfo.AssetBasicInfo.Symbol) == nil {
+			pool.AddReward(avsAddr, sdk.NewCoin(assetInfo.AssetBasicInfo.Symbol, distributed))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/utils"
+	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	"github.com/ExocoreNetwork/exocore/x/reward/keeper"
+	rewardtype "github.com/ExocoreNetwork/exocore/x/reward/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (suite *RewardTestSuite) TestDistributeAVSReward() {
+	avsAddr := avstypes.GenerateAVSAddr(avstypes.ChainIDWithoutRevision(utils.DefaultChainID))
+	avsInfo, err := suite.App.AVSManagerKeeper.GetAVSInfo(suite.Ctx, avsAddr)
+	suite.NoError(err)
+	assetID := suite.AssetIDs[0]
+	avsInfo.Info.AssetRewardAmountEpochBasis = map[string]int64{assetID: 2010}
+	avsInfo.Info.AvsOwnerAddress = []string{suite.Operators[0].String()}
+	err = suite.App.AVSManagerKeeper.SetAVSInfo(suite.Ctx, avsInfo.Info)
+	suite.NoError(err)
+
+	stakerIDs := make([]string, len(suite.Operators))
+	for i, operator := range suite.Operators {
+		stakerIDs[i], _ = assetstypes.GetStakerIDAndAssetIDFromStr(
+			suite.ClientChains[0].LayerZeroChainID,
+			common.Address(operator.Bytes()).String(), "",
+		)
+	}
+	checkClaimable := func(expected []sdkmath.Int) {
+		for i, stakerID := range stakerIDs {
+			res, err := suite.App.RewardKeeper.ClaimableRewards(suite.Ctx, &rewardtype.QueryClaimableRewardsRequest{StakerId: stakerID})
+			suite.NoError(err)
+			if expected[i].IsZero() {
+				suite.Empty(res.Rewards)
+				continue
+			}
+			suite.Equal([]rewardtype.StakerReward{{StakerID: stakerID, AssetID: assetID, Amount: expected[i]}}, res.Rewards)
+		}
+	}
+
+	// nothing is distributed without a reward fund
+	epochNumber := int64(avsInfo.Info.StartingEpoch)
+	err = suite.App.RewardKeeper.DistributeAVSReward(suite.Ctx, avsAddr, avsInfo.Info.EpochIdentifier, epochNumber)
+	suite.NoError(err)
+	checkClaimable([]sdkmath.Int{sdkmath.ZeroInt(), sdkmath.ZeroInt()})
+
+	// only the owner can fund the AVS, and the funded amount is deducted from its deposit
+	err = suite.App.RewardKeeper.FundAVSReward(suite.Ctx, suite.Operators[1], avsAddr, assetID, sdkmath.NewInt(3000))
+	suite.ErrorContains(err, rewardtype.ErrNotAVSOwner.Error())
+	depositBefore, err := suite.App.AssetsKeeper.GetStakerSpecifiedAssetInfo(suite.Ctx, stakerIDs[0], assetID)
+	suite.NoError(err)
+	err = suite.App.RewardKeeper.FundAVSReward(suite.Ctx, suite.Operators[0], avsAddr, assetID, sdkmath.NewInt(3000))
+	suite.NoError(err)
+	depositAfter, err := suite.App.AssetsKeeper.GetStakerSpecifiedAssetInfo(suite.Ctx, stakerIDs[0], assetID)
+	suite.NoError(err)
+	suite.Equal(depositBefore.WithdrawableAmount.SubRaw(3000), depositAfter.WithdrawableAmount)
+	suite.Equal(sdkmath.NewInt(3000), suite.App.RewardKeeper.GetRewardFund(suite.Ctx, avsAddr, assetID))
+
+	// the operators delegate to themselves in the genesis with the powers 101 and 100, the
+	// rewards are accumulated in the pools without being settled to the stakers.
+	err = suite.App.RewardKeeper.DistributeAVSReward(suite.Ctx, avsAddr, avsInfo.Info.EpochIdentifier, epochNumber)
+	suite.NoError(err)
+	checkClaimable([]sdkmath.Int{sdkmath.NewInt(1010), sdkmath.NewInt(1000)})
+	suite.True(suite.App.RewardKeeper.GetStakerReward(suite.Ctx, stakerIDs[0], assetID).IsZero())
+	suite.Equal(sdkmath.NewInt(990), suite.App.RewardKeeper.GetRewardFund(suite.Ctx, avsAddr, assetID))
+	suite.Equal(sdkmath.NewInt(2010), suite.App.RewardKeeper.GetRewardEscrow(suite.Ctx, assetID))
+
+	// the reward of the next epoch is capped by the remaining fund
+	err = suite.App.RewardKeeper.DistributeAVSReward(suite.Ctx, avsAddr, avsInfo.Info.EpochIdentifier, epochNumber+1)
+	suite.NoError(err)
+	// the ratio of the first operator is truncated, so its staker loses the dust
+	checkClaimable([]sdkmath.Int{sdkmath.NewInt(1506), sdkmath.NewInt(1492)})
+	suite.Equal(sdkmath.NewInt(1), suite.App.RewardKeeper.GetRewardFund(suite.Ctx, avsAddr, assetID))
+	suite.Equal(sdkmath.NewInt(2999), suite.App.RewardKeeper.GetRewardEscrow(suite.Ctx, assetID))
+
+	// the claim settles the pools of the staker and is paid from the escrow
+	err = suite.App.RewardKeeper.RewardForWithdraw(suite.Ctx, &keeper.RewardParams{
+		ClientChainLzID:       suite.ClientChains[0].LayerZeroChainID,
+		Action:                assetstypes.WithdrawReward,
+		AssetsAddress:         common.HexToAddress(suite.Assets[0].Address).Bytes(),
+		WithdrawRewardAddress: suite.Operators[0].Bytes(),
+		OpAmount:              sdkmath.NewInt(1500),
+	})
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(6), suite.App.RewardKeeper.GetStakerReward(suite.Ctx, stakerIDs[0], assetID))
+	suite.Equal(sdkmath.NewInt(1499), suite.App.RewardKeeper.GetRewardEscrow(suite.Ctx, assetID))
+	checkClaimable([]sdkmath.Int{sdkmath.NewInt(6), sdkmath.NewInt(1492)})
+
+	// the reward state survives an export and import of the genesis
+	genesis := suite.App.RewardKeeper.ExportGenesis(suite.Ctx)
+	suite.NoError(genesis.Validate())
+	suite.Len(genesis.RewardFunds, 1)
+	suite.Len(genesis.RewardEscrows, 1)
+	suite.Len(genesis.OperatorRewardPools, 2)
+	suite.Len(genesis.StartingInfos, 2)
+	suite.Len(genesis.Pools, 1)
+	app, ctx := suite.ExportAndImport()
+	suite.Equal(genesis, app.RewardKeeper.ExportGenesis(ctx))
+	suite.App, suite.Ctx = app, ctx
+	checkClaimable([]sdkmath.Int{sdkmath.NewInt(6), sdkmath.NewInt(1492)})
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ExocoreNetwork/exocore/x/reward/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+// Since this action typically occurs on chain starts, this function is allowed to panic.
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	if err := k.SetParams(ctx, &state.Params); err != nil {
+		panic(errorsmod.Wrap(err, "failed to set reward params"))
+	}
+	if err := k.SetAllStakerRewards(ctx, state.StakerRewards); err != nil {
+		panic(errorsmod.Wrap(err, "failed to set all staker rewards"))
+	}
+	k.SetAllRewardFunds(ctx, state.RewardFunds)
+	k.SetAllRewardEscrows(ctx, state.RewardEscrows)
+	k.SetAllOperatorRewardPools(ctx, state.OperatorRewardPools)
+	k.SetAllStakerRewardStartingInfos(ctx, state.StartingInfos)
+	k.SetAllPools(ctx, state.Pools)
+	// the delegations imported by the delegation module, which runs before this module, are
+	// tracked in the reward pools if they aren't tracked by the imported state.
+	if err := k.InitializeStakerRewardTracking(ctx); err != nil {
+		panic(errorsmod.Wrap(err, "failed to initialize the staker reward tracking"))
+	}
+}
+
+// ExportGenesis returns the module's exported genesis
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	res := types.GenesisState{}
+	// the params are only stored once set, so fall back to the default ones.
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		res.Params = types.DefaultParams()
+	} else {
+		res.Params = *params
+	}
+
+	res.StakerRewards, err = k.GetAllStakerRewards(ctx)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "failed to get all staker rewards").Error())
+	}
+	res.RewardFunds = k.GetAllRewardFunds(ctx)
+	res.RewardEscrows = k.GetAllRewardEscrows(ctx)
+	res.OperatorRewardPools = k.GetAllOperatorRewardPools(ctx)
+	res.StartingInfos = k.GetAllStakerRewardStartingInfos(ctx)
+	res.Pools = k.GetAllPools(ctx)
+	return &res
+}
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	epochstypes "github.com/ExocoreNetwork/exocore/x/epochs/types"
-	"github.com/ExocoreNetwork/exocore/x/reward/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -25,63 +24,28 @@ func (k *Keeper) EpochsHooks() EpochsHooksWrapper {
 func (wrapper EpochsHooksWrapper) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {
 }
 
-// AfterEpochEnd distribute the reward at the end of each epoch end
+// AfterEpochEnd credits the rewards of the AVSs whose epoch has ended to the stakers.
 func (wrapper EpochsHooksWrapper) AfterEpochEnd(
 	ctx sdk.Context, epochIdentifier string, epochNumber int64,
 ) {
-	expEpochIdentifier := wrapper.keeper.GetEpochIdentifier(ctx)
-	if epochIdentifier != expEpochIdentifier {
-		wrapper.keeper.Logger(ctx).Debug(
-			"epochIdentifier didn't equal to expEpochIdentifier",
-			"epochIdentifier", epochIdentifier,
-		)
-		return
-	}
-
 	// get all the avs address bypass the epoch end
 	epochEndAVS := wrapper.keeper.avsKeeper.GetEpochEndAVSs(ctx, epochIdentifier, epochNumber)
 
-	pool := wrapper.keeper.getPool(ctx, types.ModuleName)
-	// distribute the reward to the avs accordingly
-	ForEach(epochEndAVS, func(p string) {
-		avsInfo, err := pool.k.avsKeeper.GetAVSInfo(ctx, p)
-		if err != nil {
+	// distribute the reward to the stakers of each avs accordingly
+	ForEach(epochEndAVS, func(avsAddr string) {
+		// use a cached context to avoid a partial distribution of the AVS
+		cc, writeFunc := ctx.CacheContext()
+		if err := wrapper.keeper.DistributeAVSReward(cc, avsAddr, epochIdentifier, epochNumber); err != nil {
 			wrapper.keeper.Logger(ctx).Error(
-				"get avsInfo error",
-				"avsInfo err", err,
+				"failed to distribute the avs reward",
+				"avsAddr", avsAddr,
+				"epochIdentifier", epochIdentifier,
+				"epochNumber", epochNumber,
+				"error", err,
 			)
 			return
 		}
-		assetID := avsInfo.Info.AssetIDs
-		ownerAddress := avsInfo.Info.AvsOwnerAddress
-
-		for _, operator := range ownerAddress {
-			opAddr, err := sdk.AccAddressFromBech32(operator)
-			if err != nil {
-				wrapper.keeper.Logger(ctx).Error(
-					"get operatorInfo error",
-					"operatorInfo err", err,
-				)
-				return
-			}
-			for _, asset := range assetID {
-				assetInfo, err := wrapper.keeper.assetsKeeper.GetStakingAssetInfo(ctx, asset)
-				if err != nil {
-					wrapper.keeper.Logger(ctx).Error(
-						"get assetInfo error",
-						"assetInfo err", err,
-					)
-					return
-				}
-				if wrapper.keeper.assetsKeeper.IsOperatorAssetExist(ctx, opAddr, asset) {
-					coin := sdk.Coin{
-						Denom:  assetInfo.AssetBasicInfo.Symbol,
-						Amount: sdk.NewInt(avsInfo.Info.AssetRewardAmountEpochBasis[asset]),
-					}
-					pool.AddReward(p, coin)
-				}
-			}
-		}
+		writeFunc()
 	})
 }
 
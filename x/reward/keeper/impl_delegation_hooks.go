@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	delegationtypes "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DelegationHooksWrapper is the wrapper structure that implements the delegation hooks for the
+// reward keeper.
+type DelegationHooksWrapper struct {
+	keeper *Keeper
+}
+
+// Interface guard
+var _ delegationtypes.DelegationHooks = DelegationHooksWrapper{}
+
+// DelegationHooks returns the delegation hooks wrapper. It follows the "accept interfaces,
+// return concretes" pattern.
+func (k *Keeper) DelegationHooks() DelegationHooksWrapper {
+	return DelegationHooksWrapper{k}
+}
+
+// AfterDelegation is called after a delegation is made. The AVS rewards accumulated by the
+// previous share of the staker are settled, and the new share is tracked.
+func (wrapper DelegationHooksWrapper) AfterDelegation(
+	ctx sdk.Context, operator sdk.AccAddress, stakerID, assetID string,
+) error {
+	return wrapper.keeper.SettleStakerReward(ctx, stakerID, operator.String(), assetID)
+}
+
+// AfterUndelegationStarted is called after an undelegation is started. The undelegated share
+// stops earning the AVS rewards immediately.
+func (wrapper DelegationHooksWrapper) AfterUndelegationStarted(
+	ctx sdk.Context, operator sdk.AccAddress, recordKey []byte,
+) error {
+	records, err := wrapper.keeper.delegationKeeper.GetUndelegationRecords(ctx, []string{string(recordKey)})
+	if err != nil {
+		return err
+	}
+	return wrapper.keeper.SettleStakerReward(ctx, records[0].StakerID, operator.String(), records[0].AssetID)
+}
+
+// AfterRedelegationStarted is called after a redelegation is started. The redelegated share
+// stops earning the AVS rewards from the source operator immediately, while the destination
+// operator is settled by AfterDelegation.
+func (wrapper DelegationHooksWrapper) AfterRedelegationStarted(
+	ctx sdk.Context, srcOperator sdk.AccAddress, recordKey []byte,
+) error {
+	record, err := wrapper.keeper.delegationKeeper.GetRedelegationRecord(ctx, recordKey)
+	if err != nil {
+		return err
+	}
+	return wrapper.keeper.SettleStakerReward(ctx, record.StakerID, srcOperator.String(), record.AssetID)
+}
+
+// AfterUndelegationCanceled is called before a canceled undelegation record is deleted.
+func (wrapper DelegationHooksWrapper) AfterUndelegationCanceled(
+	sdk.Context, sdk.AccAddress, []byte,
+) error {
+	// the restored share is settled by AfterDelegation.
+	return nil
+}
+
+// AfterDelegationSlashed is called after the delegated amount of a staker is slashed outside
+// the epoch-based slashing flow. The share of the staker might be reduced, so its rewards are
+// settled.
+func (wrapper DelegationHooksWrapper) AfterDelegationSlashed(
+	ctx sdk.Context, operator sdk.AccAddress, stakerID, assetID string, _ sdkmath.Int,
+) error {
+	return wrapper.keeper.SettleStakerReward(ctx, stakerID, operator.String(), assetID)
+}
@@ -12,7 +12,6 @@ import (
 
 	"github.com/ExocoreNetwork/exocore/utils/key"
 	assetsKeeper "github.com/ExocoreNetwork/exocore/x/assets/keeper"
-	avsKeeper "github.com/ExocoreNetwork/exocore/x/avs/keeper"
 	"github.com/ExocoreNetwork/exocore/x/reward/types"
 )
 
@@ -26,10 +25,11 @@ type Keeper struct {
 	storeKey storetypes.StoreKey
 
 	// other keepers
-	assetsKeeper assetsKeeper.Keeper
-	banker       bankkeeper.Keeper
-	distributor  types.Distributor
-	avsKeeper    avsKeeper.Keeper
+	assetsKeeper     assetsKeeper.Keeper
+	banker           bankkeeper.Keeper
+	distributor      types.Distributor
+	avsKeeper        types.AVSKeeper
+	delegationKeeper types.DelegationKeeper
 
 	authority string
 }
@@ -38,7 +38,8 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
 	assetsKeeper assetsKeeper.Keeper,
-	avsKeeper avsKeeper.Keeper,
+	avsKeeper types.AVSKeeper,
+	delegationKeeper types.DelegationKeeper,
 	authority string,
 ) Keeper {
 	// ensure authority is a valid bech32 address
@@ -46,11 +47,12 @@ func NewKeeper(
 		panic(fmt.Sprintf("authority address %s is invalid: %s", authority, err))
 	}
 	return Keeper{
-		cdc:          cdc,
-		storeKey:     storeKey,
-		assetsKeeper: assetsKeeper,
-		avsKeeper:    avsKeeper,
-		authority:    authority,
+		cdc:              cdc,
+		storeKey:         storeKey,
+		assetsKeeper:     assetsKeeper,
+		avsKeeper:        avsKeeper,
+		delegationKeeper: delegationKeeper,
+		authority:        authority,
 	}
 }
 
@@ -64,23 +66,27 @@ func (k Keeper) setPool(ctx sdk.Context, pool types.Pool) {
 	store.Set(poolKey.Bytes(), k.cdc.MustMarshal(&pool))
 }
 
-// TODO: to be enabled later by avs
-// func (k Keeper) getPools(ctx sdk.Context) ([]types.Pool, error) {
-//	var pools []types.Pool
-//
-//	poolNamePrefix := utils.LowerCaseKey(poolNamePrefix)
-//	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRewardInfo)
-//	iter := sdk.KVStorePrefixIterator(store, append(poolNamePrefix.AsKey(), []byte(DefaultDelimiter)...))
-//	defer utils.CloseLogError(iter, k.Logger(ctx))
-//
-//	for ; iter.Valid(); iter.Next() {
-//		var pool types.Pool
-//		k.cdc.MustUnmarshal(iter.Value(), &pool)
-//		pools = append(pools, pool)
-//	}
-//
-//	return pools, nil
-//}
+// GetAllPools returns the reward records of all the pools.
+func (k Keeper) GetAllPools(ctx sdk.Context) []types.Pool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRewardInfo)
+	iter := sdk.KVStorePrefixIterator(store, key.FromStr(poolNamePrefix).Append(key.FromStr("")).Bytes())
+	defer iter.Close()
+
+	pools := make([]types.Pool, 0)
+	for ; iter.Valid(); iter.Next() {
+		var pool types.Pool
+		k.cdc.MustUnmarshal(iter.Value(), &pool)
+		pools = append(pools, pool)
+	}
+	return pools
+}
+
+// SetAllPools sets the reward records of all the pools.
+func (k Keeper) SetAllPools(ctx sdk.Context, pools []types.Pool) {
+	for i := range pools {
+		k.setPool(ctx, pools[i])
+	}
+}
 
 func (k Keeper) getPool(ctx sdk.Context, name string) *rewardRecord {
 	poolKey := key.FromStr(poolNamePrefix).Append(key.FromStr(name))
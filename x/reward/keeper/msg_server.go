@@ -13,6 +13,12 @@ type msgServer struct {
 	Keeper
 }
 
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
 func (k Keeper) UpdateParams(ctx context.Context, params *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	c := sdk.UnwrapSDKContext(ctx)
 	if utils.IsMainnet(c.ChainID()) && k.authority != params.Authority {
@@ -35,4 +41,17 @@ func (k Keeper) UpdateParams(ctx context.Context, params *types.MsgUpdateParams)
 	return nil, nil
 }
 
+// FundAVSReward moves the deposit of an AVS owner into the reward fund of the AVS.
+func (s msgServer) FundAVSReward(goCtx context.Context, msg *types.MsgFundAVSReward) (*types.MsgFundAVSRewardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	funder, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Keeper.FundAVSReward(ctx, funder, msg.AvsAddress, msg.AssetID, msg.Amount); err != nil {
+		return nil, err
+	}
+	return &types.MsgFundAVSRewardResponse{}, nil
+}
+
 var _ types.MsgServer = msgServer{}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	"github.com/ExocoreNetwork/exocore/x/reward/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ClaimableRewards returns the rewards that can be claimed by the staker, including the ones
+// accumulated in the reward pools that haven't been settled yet.
+func (k Keeper) ClaimableRewards(goCtx context.Context, req *types.QueryClaimableRewardsRequest) (*types.QueryClaimableRewardsResponse, error) {
+	if req == nil || req.StakerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	assetIDs := make([]string, 0)
+	if req.AssetId != "" {
+		assetIDs = append(assetIDs, req.AssetId)
+	} else {
+		// the assets are either settled to the staker or tracked in the reward pools.
+		rewards, err := k.GetStakerRewards(ctx, req.StakerId)
+		if err != nil {
+			return nil, err
+		}
+		for _, reward := range rewards {
+			assetIDs = append(assetIDs, reward.AssetID)
+		}
+		for _, info := range k.getStakerRewardStartingInfos(ctx, req.StakerId, "") {
+			if !slices.Contains(assetIDs, info.AssetID) {
+				assetIDs = append(assetIDs, info.AssetID)
+			}
+		}
+		sort.Strings(assetIDs)
+	}
+
+	res := &types.QueryClaimableRewardsResponse{Rewards: []types.StakerReward{}}
+	for _, assetID := range assetIDs {
+		amount := k.GetStakerReward(ctx, req.StakerId, assetID).Add(k.GetPendingStakerReward(ctx, req.StakerId, assetID))
+		if amount.IsPositive() {
+			res.Rewards = append(res.Rewards, types.StakerReward{
+				StakerID: req.StakerId,
+				AssetID:  assetID,
+				Amount:   amount,
+			})
+		}
+	}
+	return res, nil
+}
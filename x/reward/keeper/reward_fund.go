@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
+	"github.com/ExocoreNetwork/exocore/x/reward/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/exp/slices"
+)
+
+// GetRewardFund returns the undistributed balance funded by the AVS for the asset.
+func (k Keeper) GetRewardFund(ctx sdk.Context, avsAddr, assetID string) sdkmath.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRewardFund)
+	value := store.Get(assetstype.GetJoinedStoreKey(strings.ToLower(avsAddr), assetID))
+	if value == nil {
+		return sdkmath.ZeroInt()
+	}
+	fund := types.RewardFund{}
+	k.cdc.MustUnmarshal(value, &fund)
+	return fund.Amount
+}
+
+// setRewardFund stores the undistributed balance funded by the AVS for the asset, the record
+// is removed when the amount drops to zero.
+func (k Keeper) setRewardFund(ctx sdk.Context, avsAddr, assetID string, amount sdkmath.Int) {
+	avsAddr = strings.ToLower(avsAddr)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRewardFund)
+	key := assetstype.GetJoinedStoreKey(avsAddr, assetID)
+	if amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+	fund := types.RewardFund{
+		AvsAddress: avsAddr,
+		AssetID:    assetID,
+		Amount:     amount,
+	}
+	store.Set(key, k.cdc.MustMarshal(&fund))
+}
+
+// GetRewardEscrow returns the amount of the asset that has been distributed to the stakers
+// but hasn't been claimed yet.
+func (k Keeper) GetRewardEscrow(ctx sdk.Context, assetID string) sdkmath.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRewardEscrow)
+	value := store.Get([]byte(assetID))
+	if value == nil {
+		return sdkmath.ZeroInt()
+	}
+	escrow := types.RewardEscrow{}
+	k.cdc.MustUnmarshal(value, &escrow)
+	return escrow.Amount
+}
+
+// setRewardEscrow stores the escrowed amount of the asset, the record is removed when the
+// amount drops to zero.
+func (k Keeper) setRewardEscrow(ctx sdk.Context, assetID string, amount sdkmath.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRewardEscrow)
+	if amount.IsZero() {
+		store.Delete([]byte(assetID))
+		return
+	}
+	escrow := types.RewardEscrow{
+		AssetID: assetID,
+		Amount:  amount,
+	}
+	store.Set([]byte(assetID), k.cdc.MustMarshal(&escrow))
+}
+
+// FundAVSReward moves the deposit of an AVS owner into the reward fund of the AVS. The
+// deposit is identified by the staker ID derived from the owner's address and the client
+// chain of the asset, and the funded amount is no longer withdrawable by the owner. The
+// tokens stay in the gateway of the client chain until they are claimed by the stakers.
+func (k Keeper) FundAVSReward(ctx sdk.Context, funder sdk.AccAddress, avsAddr, assetID string, amount sdkmath.Int) error {
+	if amount.IsNil() || !amount.IsPositive() {
+		return errorsmod.Wrapf(types.ErrRewardAmountIsNegative, "the amount is:%s", amount)
+	}
+	avsInfo, err := k.avsKeeper.GetAVSInfo(ctx, avsAddr)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(avsInfo.Info.AvsOwnerAddress, funder.String()) {
+		return errorsmod.Wrapf(types.ErrNotAVSOwner, "avs:%s sender:%s", avsAddr, funder)
+	}
+	if !slices.Contains(avsInfo.Info.AssetIDs, assetID) {
+		return errorsmod.Wrapf(types.ErrAssetNotSupportedByAVS, "avs:%s assetID:%s", avsAddr, assetID)
+	}
+	_, lzID, err := assetstype.ParseID(assetID)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrRewardAssetNotExist, "invalid assetID:%s", assetID)
+	}
+	stakerID, _ := assetstype.GetStakerIDAndAssetIDFromStr(lzID, common.BytesToAddress(funder).String(), "")
+
+	// the funded amount is removed from the deposit of the owner and from the total amount
+	// of the asset, since it's no longer staked.
+	negAmount := amount.Neg()
+	if err := k.assetsKeeper.UpdateStakerAssetState(ctx, stakerID, assetID, assetstype.DeltaStakerSingleAsset{
+		TotalDepositAmount: negAmount,
+		WithdrawableAmount: negAmount,
+	}); err != nil {
+		return err
+	}
+	if err := k.assetsKeeper.UpdateStakingAssetTotalAmount(ctx, assetID, negAmount); err != nil {
+		return err
+	}
+	k.setRewardFund(ctx, avsAddr, assetID, k.GetRewardFund(ctx, avsAddr, assetID).Add(amount))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewardFunded,
+			sdk.NewAttribute(types.AttributeKeyAVSAddress, strings.ToLower(avsAddr)),
+			sdk.NewAttribute(types.AttributeKeyFunder, funder.String()),
+			sdk.NewAttribute(types.AttributeKeyStakerID, stakerID),
+			sdk.NewAttribute(types.AttributeKeyAssetID, assetID),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+	return nil
+}
+
+// spendRewardFund moves the amount from the reward fund of the AVS into the escrow of the
+// asset, after it has been distributed to the reward pools.
+func (k Keeper) spendRewardFund(ctx sdk.Context, avsAddr, assetID string, amount sdkmath.Int) error {
+	if amount.IsZero() {
+		return nil
+	}
+	fund := k.GetRewardFund(ctx, avsAddr, assetID)
+	if fund.LT(amount) {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientRewardFund,
+			"avs:%s assetID:%s fund:%s amount:%s", avsAddr, assetID, fund, amount,
+		)
+	}
+	k.setRewardFund(ctx, avsAddr, assetID, fund.Sub(amount))
+	k.setRewardEscrow(ctx, assetID, k.GetRewardEscrow(ctx, assetID).Add(amount))
+	return nil
+}
+
+// releaseRewardEscrow removes the claimed amount from the escrow of the asset. It returns an
+// error if the escrow doesn't back the claim.
+func (k Keeper) releaseRewardEscrow(ctx sdk.Context, assetID string, amount sdkmath.Int) error {
+	escrow := k.GetRewardEscrow(ctx, assetID)
+	if escrow.LT(amount) {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientRewardFund,
+			"the claim isn't backed by the escrow, assetID:%s escrow:%s amount:%s", assetID, escrow, amount,
+		)
+	}
+	k.setRewardEscrow(ctx, assetID, escrow.Sub(amount))
+	return nil
+}
+
+// SetAllRewardFunds sets the reward funds of all the AVSs.
+func (k Keeper) SetAllRewardFunds(ctx sdk.Context, funds []types.RewardFund) {
+	for i := range funds {
+		fund := funds[i]
+		k.setRewardFund(ctx, fund.AvsAddress, fund.AssetID, fund.Amount)
+	}
+}
+
+// GetAllRewardFunds returns the reward funds of all the AVSs.
+func (k Keeper) GetAllRewardFunds(ctx sdk.Context) []types.RewardFund {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRewardFund)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	ret := make([]types.RewardFund, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var fund types.RewardFund
+		k.cdc.MustUnmarshal(iterator.Value(), &fund)
+		ret = append(ret, fund)
+	}
+	return ret
+}
+
+// SetAllRewardEscrows sets the escrows of all the assets.
+func (k Keeper) SetAllRewardEscrows(ctx sdk.Context, escrows []types.RewardEscrow) {
+	for i := range escrows {
+		escrow := escrows[i]
+		k.setRewardEscrow(ctx, escrow.AssetID, escrow.Amount)
+	}
+}
+
+// GetAllRewardEscrows returns the escrows of all the assets.
+func (k Keeper) GetAllRewardEscrows(ctx sdk.Context) []types.RewardEscrow {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRewardEscrow)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	ret := make([]types.RewardEscrow, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var escrow types.RewardEscrow
+		k.cdc.MustUnmarshal(iterator.Value(), &escrow)
+		ret = append(ret, escrow)
+	}
+	return ret
+}
@@ -0,0 +1,245 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
+	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtypes "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/ExocoreNetwork/exocore/x/reward/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The rewards of the AVSs are accounted lazily in a pool per operator and asset. The pool
+// records the cumulative reward per delegated share, and the stakers record the ratio when
+// they were last settled. So the rewards of a staker are only computed when its share
+// changes or when it claims the rewards, without iterating over all of the stakers during
+// the distribution.
+
+// GetOperatorRewardPool returns the reward pool of the operator and asset, an empty pool is
+// returned if it doesn't exist.
+func (k Keeper) GetOperatorRewardPool(ctx sdk.Context, operator, assetID string) types.OperatorRewardPool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorRewardPool)
+	value := store.Get(assetstype.GetJoinedStoreKey(operator, assetID))
+	if value == nil {
+		return types.OperatorRewardPool{
+			Operator:              operator,
+			AssetID:               assetID,
+			CumulativeRewardRatio: sdkmath.LegacyZeroDec(),
+			TotalShare:            sdkmath.LegacyZeroDec(),
+		}
+	}
+	pool := types.OperatorRewardPool{}
+	k.cdc.MustUnmarshal(value, &pool)
+	return pool
+}
+
+// setOperatorRewardPool stores the reward pool of the operator and asset.
+func (k Keeper) setOperatorRewardPool(ctx sdk.Context, pool types.OperatorRewardPool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorRewardPool)
+	store.Set(assetstype.GetJoinedStoreKey(pool.Operator, pool.AssetID), k.cdc.MustMarshal(&pool))
+}
+
+// GetStakerRewardStartingInfo returns the starting info of the staker in the reward pool of
+// the operator and asset.
+func (k Keeper) GetStakerRewardStartingInfo(
+	ctx sdk.Context, stakerID, assetID, operator string,
+) (info types.StakerRewardStartingInfo, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStakerRewardStartingInfo)
+	value := store.Get(assetstype.GetJoinedStoreKey(stakerID, assetID, operator))
+	if value == nil {
+		return info, false
+	}
+	k.cdc.MustUnmarshal(value, &info)
+	return info, true
+}
+
+// setStakerRewardStartingInfo stores the starting info of the staker, the record is removed
+// when the tracked share drops to zero.
+func (k Keeper) setStakerRewardStartingInfo(ctx sdk.Context, info types.StakerRewardStartingInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStakerRewardStartingInfo)
+	key := assetstype.GetJoinedStoreKey(info.StakerID, info.AssetID, info.Operator)
+	if !info.Share.IsPositive() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(&info))
+}
+
+// getStakerRewardStartingInfos returns the starting infos of the staker for the asset in all
+// the reward pools it's tracked in. All the assets are returned if the assetID is empty.
+func (k Keeper) getStakerRewardStartingInfos(ctx sdk.Context, stakerID, assetID string) []types.StakerRewardStartingInfo {
+	iteratorPrefix := assetstype.GetJoinedStoreKeyForPrefix(stakerID)
+	if assetID != "" {
+		iteratorPrefix = assetstype.GetJoinedStoreKeyForPrefix(stakerID, assetID)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStakerRewardStartingInfo)
+	iterator := sdk.KVStorePrefixIterator(store, iteratorPrefix)
+	defer iterator.Close()
+
+	ret := make([]types.StakerRewardStartingInfo, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.StakerRewardStartingInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &info)
+		ret = append(ret, info)
+	}
+	return ret
+}
+
+// pendingStakerReward returns the reward accumulated by the starting info since it was last
+// settled.
+func pendingStakerReward(pool types.OperatorRewardPool, info types.StakerRewardStartingInfo) sdkmath.Int {
+	return info.Share.Mul(pool.CumulativeRewardRatio.Sub(info.RewardRatio)).TruncateInt()
+}
+
+// addOperatorReward adds the reward to the cumulative reward ratio of the pool. It returns
+// false if no share is tracked in the pool, in which case the reward isn't distributed.
+func (k Keeper) addOperatorReward(ctx sdk.Context, operator, assetID string, amount sdkmath.Int) bool {
+	pool := k.GetOperatorRewardPool(ctx, operator, assetID)
+	if !pool.TotalShare.IsPositive() {
+		return false
+	}
+	pool.CumulativeRewardRatio = pool.CumulativeRewardRatio.Add(sdkmath.LegacyNewDecFromInt(amount).QuoTruncate(pool.TotalShare))
+	k.setOperatorRewardPool(ctx, pool)
+	return true
+}
+
+// operatorTrackedAmount returns the amount of the asset delegated to the operator by the
+// stakers tracked in its reward pool.
+func (k Keeper) operatorTrackedAmount(ctx sdk.Context, operator, assetID string) (sdkmath.Int, error) {
+	pool := k.GetOperatorRewardPool(ctx, operator, assetID)
+	if !pool.TotalShare.IsPositive() {
+		return sdkmath.ZeroInt(), nil
+	}
+	opAccAddr, err := sdk.AccAddressFromBech32(operator)
+	if err != nil {
+		return sdkmath.ZeroInt(), errorsmod.Wrapf(err, "invalid operator address:%s", operator)
+	}
+	if !k.assetsKeeper.IsOperatorAssetExist(ctx, opAccAddr, assetID) {
+		return sdkmath.ZeroInt(), nil
+	}
+	operatorAsset, err := k.assetsKeeper.GetOperatorSpecifiedAssetInfo(ctx, opAccAddr, assetID)
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+	if !operatorAsset.TotalShare.IsPositive() {
+		return sdkmath.ZeroInt(), nil
+	}
+	// the tracked share can't exceed the total share of the operator, but the minimum is
+	// taken to be robust against the rounding.
+	return delegationkeeper.TokensFromShares(sdkmath.LegacyMinDec(pool.TotalShare, operatorAsset.TotalShare), operatorAsset.TotalShare, operatorAsset.TotalAmount)
+}
+
+// SettleStakerReward credits the staker with the reward accumulated by its delegation of the
+// asset to the operator, and starts tracking its current share in the reward pool. It must
+// be called whenever the delegated share of the staker changes.
+func (k Keeper) SettleStakerReward(ctx sdk.Context, stakerID, operator, assetID string) error {
+	pool := k.GetOperatorRewardPool(ctx, operator, assetID)
+	if info, found := k.GetStakerRewardStartingInfo(ctx, stakerID, assetID, operator); found {
+		if err := k.AddStakerReward(ctx, stakerID, assetID, pendingStakerReward(pool, info)); err != nil {
+			return err
+		}
+		pool.TotalShare = pool.TotalShare.Sub(info.Share)
+	}
+
+	share := sdkmath.LegacyZeroDec()
+	delegation, err := k.delegationKeeper.GetSingleDelegationInfo(ctx, stakerID, assetID, operator)
+	if err == nil {
+		share = delegation.UndelegatableShare
+	} else if !errorsmod.IsOf(err, delegationtypes.ErrNoKeyInTheStore) {
+		return err
+	}
+	k.setStakerRewardStartingInfo(ctx, types.StakerRewardStartingInfo{
+		StakerID:    stakerID,
+		Operator:    operator,
+		AssetID:     assetID,
+		RewardRatio: pool.CumulativeRewardRatio,
+		Share:       share,
+	})
+	pool.TotalShare = pool.TotalShare.Add(share)
+	k.setOperatorRewardPool(ctx, pool)
+	return nil
+}
+
+// settleStakerRewardsForAsset settles the rewards of the staker in all the reward pools of
+// the asset it's tracked in.
+func (k Keeper) settleStakerRewardsForAsset(ctx sdk.Context, stakerID, assetID string) error {
+	for _, info := range k.getStakerRewardStartingInfos(ctx, stakerID, assetID) {
+		if err := k.SettleStakerReward(ctx, stakerID, info.Operator, assetID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetPendingStakerReward returns the reward of the asset accumulated by the staker in the
+// reward pools, which hasn't been settled yet.
+func (k Keeper) GetPendingStakerReward(ctx sdk.Context, stakerID, assetID string) sdkmath.Int {
+	pending := sdkmath.ZeroInt()
+	for _, info := range k.getStakerRewardStartingInfos(ctx, stakerID, assetID) {
+		pool := k.GetOperatorRewardPool(ctx, info.Operator, assetID)
+		pending = pending.Add(pendingStakerReward(pool, info))
+	}
+	return pending
+}
+
+// InitializeStakerRewardTracking starts tracking the delegations that aren't tracked in the
+// reward pools yet. It's used by the genesis import, when the delegations are imported
+// without the reward state.
+func (k Keeper) InitializeStakerRewardTracking(ctx sdk.Context) error {
+	return k.delegationKeeper.IterateDelegations(ctx, nil,
+		func(keys *delegationtypes.SingleDelegationInfoReq, _ *delegationtypes.DelegationAmounts) (bool, error) {
+			if _, found := k.GetStakerRewardStartingInfo(ctx, keys.StakerID, keys.AssetID, keys.OperatorAddr); found {
+				return false, nil
+			}
+			if err := k.SettleStakerReward(ctx, keys.StakerID, keys.OperatorAddr, keys.AssetID); err != nil {
+				return true, err
+			}
+			return false, nil
+		})
+}
+
+// SetAllOperatorRewardPools sets the reward pools of all the operators.
+func (k Keeper) SetAllOperatorRewardPools(ctx sdk.Context, pools []types.OperatorRewardPool) {
+	for i := range pools {
+		k.setOperatorRewardPool(ctx, pools[i])
+	}
+}
+
+// GetAllOperatorRewardPools returns the reward pools of all the operators.
+func (k Keeper) GetAllOperatorRewardPools(ctx sdk.Context) []types.OperatorRewardPool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorRewardPool)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	ret := make([]types.OperatorRewardPool, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var pool types.OperatorRewardPool
+		k.cdc.MustUnmarshal(iterator.Value(), &pool)
+		ret = append(ret, pool)
+	}
+	return ret
+}
+
+// SetAllStakerRewardStartingInfos sets the starting infos of all the stakers.
+func (k Keeper) SetAllStakerRewardStartingInfos(ctx sdk.Context, infos []types.StakerRewardStartingInfo) {
+	for i := range infos {
+		k.setStakerRewardStartingInfo(ctx, infos[i])
+	}
+}
+
+// GetAllStakerRewardStartingInfos returns the starting infos of all the stakers.
+func (k Keeper) GetAllStakerRewardStartingInfos(ctx sdk.Context) []types.StakerRewardStartingInfo {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStakerRewardStartingInfo)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	ret := make([]types.StakerRewardStartingInfo, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.StakerRewardStartingInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &info)
+		ret = append(ret, info)
+	}
+	return ret
+}
@@ -16,12 +16,13 @@ type rewardRecord struct {
 	staker distrtypes.StakingKeeper
 }
 
-func newRewardRecord(ctx sdk.Context, k Keeper, banker bankkeeper.Keeper, distributor types.Distributor, _ types.Pool) *rewardRecord {
+func newRewardRecord(ctx sdk.Context, k Keeper, banker bankkeeper.Keeper, distributor types.Distributor, pool types.Pool) *rewardRecord {
 	return &rewardRecord{
 		ctx:         ctx,
 		k:           k,
 		banker:      banker,
 		distributor: distributor,
+		Pool:        pool,
 	}
 }
 
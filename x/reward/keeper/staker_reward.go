@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
+	"github.com/ExocoreNetwork/exocore/x/reward/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetStakerReward returns the reward that can be claimed by the staker for the asset.
+// It returns zero if the staker hasn't been rewarded with the asset.
+func (k Keeper) GetStakerReward(ctx sdk.Context, stakerID, assetID string) sdkmath.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStakerReward)
+	value := store.Get(assetstype.GetJoinedStoreKey(stakerID, assetID))
+	if value == nil {
+		return sdkmath.ZeroInt()
+	}
+	reward := types.StakerReward{}
+	k.cdc.MustUnmarshal(value, &reward)
+	return reward.Amount
+}
+
+// setStakerReward stores the claimable reward of the staker, the record is removed when
+// the amount drops to zero.
+func (k Keeper) setStakerReward(ctx sdk.Context, stakerID, assetID string, amount sdkmath.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStakerReward)
+	key := assetstype.GetJoinedStoreKey(stakerID, assetID)
+	if amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+	reward := types.StakerReward{
+		StakerID: stakerID,
+		AssetID:  assetID,
+		Amount:   amount,
+	}
+	store.Set(key, k.cdc.MustMarshal(&reward))
+}
+
+// AddStakerReward credits the staker with the reward amount of the asset.
+func (k Keeper) AddStakerReward(ctx sdk.Context, stakerID, assetID string, amount sdkmath.Int) error {
+	if amount.IsNil() || amount.IsNegative() {
+		return errorsmod.Wrapf(types.ErrRewardAmountIsNegative, "the amount is:%s", amount)
+	}
+	if amount.IsZero() {
+		return nil
+	}
+	k.setStakerReward(ctx, stakerID, assetID, k.GetStakerReward(ctx, stakerID, assetID).Add(amount))
+	return nil
+}
+
+// SubStakerReward debits the reward amount of the asset from the staker, it returns an
+// error if the claimable reward is insufficient.
+func (k Keeper) SubStakerReward(ctx sdk.Context, stakerID, assetID string, amount sdkmath.Int) (sdkmath.Int, error) {
+	if amount.IsNil() || amount.IsNegative() {
+		return sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrRewardAmountIsNegative, "the amount is:%s", amount)
+	}
+	claimable := k.GetStakerReward(ctx, stakerID, assetID)
+	if claimable.LT(amount) {
+		return sdkmath.ZeroInt(), errorsmod.Wrapf(
+			types.ErrInsufficientReward,
+			"stakerID:%s assetID:%s claimable:%s amount:%s", stakerID, assetID, claimable, amount,
+		)
+	}
+	remaining := claimable.Sub(amount)
+	k.setStakerReward(ctx, stakerID, assetID, remaining)
+	return remaining, nil
+}
+
+// GetStakerRewards returns all the claimable rewards of the staker.
+func (k Keeper) GetStakerRewards(ctx sdk.Context, stakerID string) ([]types.StakerReward, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStakerReward)
+	iterator := sdk.KVStorePrefixIterator(store, assetstype.GetJoinedStoreKeyForPrefix(stakerID))
+	defer iterator.Close()
+
+	ret := make([]types.StakerReward, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var reward types.StakerReward
+		k.cdc.MustUnmarshal(iterator.Value(), &reward)
+		ret = append(ret, reward)
+	}
+	return ret, nil
+}
+
+// SetAllStakerRewards sets the claimable rewards of all the stakers.
+func (k Keeper) SetAllStakerRewards(ctx sdk.Context, rewards []types.StakerReward) error {
+	for i := range rewards {
+		reward := rewards[i]
+		k.setStakerReward(ctx, reward.StakerID, reward.AssetID, reward.Amount)
+	}
+	return nil
+}
+
+// GetAllStakerRewards returns the claimable rewards of all the stakers.
+func (k Keeper) GetAllStakerRewards(ctx sdk.Context) ([]types.StakerReward, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStakerReward)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	ret := make([]types.StakerReward, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var reward types.StakerReward
+		k.cdc.MustUnmarshal(iterator.Value(), &reward)
+		ret = append(ret, reward)
+	}
+	return ret, nil
+}
@@ -23,7 +23,8 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "exocore/MsgUpdateParamsForReward"
+	updateParamsName  = "exocore/MsgUpdateParamsForReward"
+	fundAVSRewardName = "exocore/MsgFundAVSReward"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -37,6 +38,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgFundAVSReward{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -47,4 +49,5 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // Amino JSON serialization and EIP-712 compatibility.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgFundAVSReward{}, fundAVSRewardName, nil)
 }
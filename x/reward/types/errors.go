@@ -14,4 +14,9 @@ var (
 	ErrRewardAmountIsNegative   = errorsmod.Register(ModuleName, 5, "the reward amount is negative")
 	ErrRewardAssetNotExist      = errorsmod.Register(ModuleName, 6, "the reward asset doesn't exist")
 	ErrNotSupportYet            = errorsmod.Register(ModuleName, 7, "don't have supported it yet")
+	ErrInsufficientReward       = errorsmod.Register(ModuleName, 8, "the claimable reward is insufficient")
+	ErrInvalidGenesisData       = errorsmod.Register(ModuleName, 9, "the genesis data supplied is invalid")
+	ErrInsufficientRewardFund   = errorsmod.Register(ModuleName, 10, "the reward fund is insufficient")
+	ErrNotAVSOwner              = errorsmod.Register(ModuleName, 11, "the sender isn't an owner of the AVS")
+	ErrAssetNotSupportedByAVS   = errorsmod.Register(ModuleName, 12, "the asset isn't supported by the AVS")
 )
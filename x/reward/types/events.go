@@ -0,0 +1,20 @@
+package types
+
+// x/reward events
+const (
+	// EventTypeRewardCredited is emitted when a staker is credited with the reward of an AVS.
+	EventTypeRewardCredited = "reward_credited"
+	// EventTypeRewardClaimed is emitted when a staker claims its reward.
+	EventTypeRewardClaimed = "reward_claimed"
+	// EventTypeRewardFunded is emitted when an AVS owner funds the rewards of the AVS.
+	EventTypeRewardFunded = "reward_funded"
+
+	AttributeKeyStakerID        = "staker_id"
+	AttributeKeyAssetID         = "asset_id"
+	AttributeKeyAVSAddress      = "avs_address"
+	AttributeKeyOperator        = "operator"
+	AttributeKeyEpochIdentifier = "epoch_identifier"
+	AttributeKeyEpochNumber     = "epoch_number"
+	AttributeKeyRemainingAmount = "remaining_amount"
+	AttributeKeyFunder          = "funder"
+)
@@ -1,6 +1,9 @@
 package types
 
 import (
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtypes "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -24,3 +27,19 @@ type BankKeeper interface {
 type Distributor interface {
 	AllocateTokensToValidator(ctx sdk.Context, val stakingtypes.ValidatorI, tokens sdk.DecCoins)
 }
+
+// AVSKeeper defines the expected interface of the avs keeper used to distribute the rewards.
+type AVSKeeper interface {
+	GetEpochEndAVSs(ctx sdk.Context, epochIdentifier string, endingEpochNumber int64) []string
+	GetAVSInfo(ctx sdk.Context, addr string) (*avstypes.QueryAVSInfoResponse, error)
+	GetOptInOperators(ctx sdk.Context, avsAddr string) ([]string, error)
+}
+
+// DelegationKeeper defines the expected interface of the delegation keeper used to track the
+// delegated shares of the stakers to be rewarded.
+type DelegationKeeper interface {
+	GetSingleDelegationInfo(ctx sdk.Context, stakerID, assetID, operatorAddr string) (*delegationtypes.DelegationAmounts, error)
+	IterateDelegations(ctx sdk.Context, iteratorPrefix []byte, opFunc delegationkeeper.DelegationOpFunc) error
+	GetUndelegationRecords(ctx sdk.Context, singleRecordKeys []string) (record []*delegationtypes.UndelegationRecord, err error)
+	GetRedelegationRecord(ctx sdk.Context, recordKey []byte) (*delegationtypes.RedelegationRecord, error)
+}
@@ -1,24 +1,275 @@
 package types
 
 import (
-// this line is used by starport scaffolding # genesis/types/import
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/utils"
+	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	// this line is used by starport scaffolding # genesis/types/import
 )
 
 // DefaultIndex is the default global index
 const DefaultIndex uint64 = 1
 
+// NewGenesisState creates a new genesis state with the provided parameters and
+// staker rewards.
+func NewGenesisState(params Params, stakerRewards []StakerReward) *GenesisState {
+	return &GenesisState{
+		Params:        params,
+		StakerRewards: stakerRewards,
+	}
+}
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
-	return &GenesisState{
-		// this line is used by starport scaffolding # genesis/types/default
-		Params: DefaultParams(),
+	// this line is used by starport scaffolding # genesis/types/default
+	return NewGenesisState(DefaultParams(), nil)
+}
+
+// ValidateStakerRewards validates the rewards that haven't been claimed by the stakers.
+func (gs GenesisState) ValidateStakerRewards() error {
+	seenFieldValueFunc := func(reward StakerReward) (string, struct{}) {
+		return string(assetstypes.GetJoinedStoreKey(reward.StakerID, reward.AssetID)), struct{}{}
+	}
+	validationFunc := func(_ int, reward StakerReward) error {
+		_, stakerClientChainID, err := assetstypes.ValidateID(reward.StakerID, true, false)
+		if err != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid stakerID: %s", reward.StakerID,
+			)
+		}
+		_, assetClientChainID, err := assetstypes.ValidateID(reward.AssetID, true, false)
+		if err != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid assetID: %s", reward.AssetID,
+			)
+		}
+		if stakerClientChainID != assetClientChainID {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"the client chain layerZero IDs of the staker and asset are different, stakerID:%s assetID:%s",
+				reward.StakerID, reward.AssetID,
+			)
+		}
+		if reward.Amount.IsNil() || !reward.Amount.IsPositive() {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"the reward amount should be positive, stakerID:%s assetID:%s amount:%s",
+				reward.StakerID, reward.AssetID, reward.Amount,
+			)
+		}
+		return nil
+	}
+	_, err := utils.CommonValidation(gs.StakerRewards, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+	}
+	return nil
+}
+
+// ValidateRewardFunds validates the undistributed balances funded by the AVSs.
+func (gs GenesisState) ValidateRewardFunds() error {
+	seenFieldValueFunc := func(fund RewardFund) (string, struct{}) {
+		return string(assetstypes.GetJoinedStoreKey(fund.AvsAddress, fund.AssetID)), struct{}{}
+	}
+	validationFunc := func(_ int, fund RewardFund) error {
+		if !common.IsHexAddress(fund.AvsAddress) || strings.ToLower(fund.AvsAddress) != fund.AvsAddress {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid avs address: %s", fund.AvsAddress,
+			)
+		}
+		if _, _, err := assetstypes.ValidateID(fund.AssetID, true, false); err != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid assetID: %s", fund.AssetID,
+			)
+		}
+		if fund.Amount.IsNil() || !fund.Amount.IsPositive() {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"the fund amount should be positive, avs:%s assetID:%s amount:%s",
+				fund.AvsAddress, fund.AssetID, fund.Amount,
+			)
+		}
+		return nil
+	}
+	_, err := utils.CommonValidation(gs.RewardFunds, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+	}
+	return nil
+}
+
+// ValidateRewardEscrows validates the escrows of the assets, the settled rewards of the
+// stakers must be backed by them.
+func (gs GenesisState) ValidateRewardEscrows() error {
+	seenFieldValueFunc := func(escrow RewardEscrow) (string, sdkmath.Int) {
+		return escrow.AssetID, escrow.Amount
+	}
+	validationFunc := func(_ int, escrow RewardEscrow) error {
+		if _, _, err := assetstypes.ValidateID(escrow.AssetID, true, false); err != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid assetID: %s", escrow.AssetID,
+			)
+		}
+		if escrow.Amount.IsNil() || !escrow.Amount.IsPositive() {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"the escrow amount should be positive, assetID:%s amount:%s",
+				escrow.AssetID, escrow.Amount,
+			)
+		}
+		return nil
+	}
+	escrows, err := utils.CommonValidation(gs.RewardEscrows, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
 	}
+	settled := make(map[string]sdkmath.Int)
+	for _, reward := range gs.StakerRewards {
+		if amount, ok := settled[reward.AssetID]; ok {
+			settled[reward.AssetID] = amount.Add(reward.Amount)
+		} else {
+			settled[reward.AssetID] = reward.Amount
+		}
+	}
+	for assetID, amount := range settled {
+		escrow, ok := escrows[assetID]
+		if !ok || escrow.LT(amount) {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"the staker rewards aren't backed by the escrow, assetID:%s rewards:%s",
+				assetID, amount,
+			)
+		}
+	}
+	return nil
+}
+
+// ValidateOperatorRewardPools validates the reward pools of the operators.
+func (gs GenesisState) ValidateOperatorRewardPools() error {
+	seenFieldValueFunc := func(pool OperatorRewardPool) (string, struct{}) {
+		return string(assetstypes.GetJoinedStoreKey(pool.Operator, pool.AssetID)), struct{}{}
+	}
+	validationFunc := func(_ int, pool OperatorRewardPool) error {
+		if _, err := sdk.AccAddressFromBech32(pool.Operator); err != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid operator address: %s", pool.Operator,
+			)
+		}
+		if _, _, err := assetstypes.ValidateID(pool.AssetID, true, false); err != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid assetID: %s", pool.AssetID,
+			)
+		}
+		if pool.CumulativeRewardRatio.IsNil() || pool.CumulativeRewardRatio.IsNegative() ||
+			pool.TotalShare.IsNil() || pool.TotalShare.IsNegative() {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"the reward ratio and total share should be non-negative, operator:%s assetID:%s",
+				pool.Operator, pool.AssetID,
+			)
+		}
+		return nil
+	}
+	_, err := utils.CommonValidation(gs.OperatorRewardPools, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+	}
+	return nil
+}
+
+// ValidateStartingInfos validates the starting infos of the stakers, the sum of the tracked
+// shares must match the total share of each pool.
+func (gs GenesisState) ValidateStartingInfos() error {
+	pools := make(map[string]OperatorRewardPool, len(gs.OperatorRewardPools))
+	for _, pool := range gs.OperatorRewardPools {
+		pools[string(assetstypes.GetJoinedStoreKey(pool.Operator, pool.AssetID))] = pool
+	}
+	shares := make(map[string]sdkmath.LegacyDec, len(gs.OperatorRewardPools))
+	seenFieldValueFunc := func(info StakerRewardStartingInfo) (string, struct{}) {
+		return string(assetstypes.GetJoinedStoreKey(info.StakerID, info.AssetID, info.Operator)), struct{}{}
+	}
+	validationFunc := func(_ int, info StakerRewardStartingInfo) error {
+		poolKey := string(assetstypes.GetJoinedStoreKey(info.Operator, info.AssetID))
+		pool, ok := pools[poolKey]
+		if !ok {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"the reward pool of the starting info doesn't exist, operator:%s assetID:%s",
+				info.Operator, info.AssetID,
+			)
+		}
+		if _, _, err := assetstypes.ValidateID(info.StakerID, true, false); err != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid stakerID: %s", info.StakerID,
+			)
+		}
+		if info.Share.IsNil() || !info.Share.IsPositive() ||
+			info.RewardRatio.IsNil() || info.RewardRatio.IsNegative() ||
+			info.RewardRatio.GT(pool.CumulativeRewardRatio) {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid share or reward ratio, stakerID:%s operator:%s assetID:%s",
+				info.StakerID, info.Operator, info.AssetID,
+			)
+		}
+		if share, ok := shares[poolKey]; ok {
+			shares[poolKey] = share.Add(info.Share)
+		} else {
+			shares[poolKey] = info.Share
+		}
+		return nil
+	}
+	_, err := utils.CommonValidation(gs.StartingInfos, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+	}
+	for _, pool := range gs.OperatorRewardPools {
+		share, ok := shares[string(assetstypes.GetJoinedStoreKey(pool.Operator, pool.AssetID))]
+		if !ok {
+			share = sdkmath.LegacyZeroDec()
+		}
+		if !share.Equal(pool.TotalShare) {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"the total share of the pool mismatches the starting infos, operator:%s assetID:%s total:%s tracked:%s",
+				pool.Operator, pool.AssetID, pool.TotalShare, share,
+			)
+		}
+	}
+	return nil
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
-
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if err := gs.ValidateStakerRewards(); err != nil {
+		return err
+	}
+	if err := gs.ValidateRewardFunds(); err != nil {
+		return err
+	}
+	if err := gs.ValidateRewardEscrows(); err != nil {
+		return err
+	}
+	if err := gs.ValidateOperatorRewardPools(); err != nil {
+		return err
+	}
+	return gs.ValidateStartingInfos()
 }
@@ -27,6 +27,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params represents the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// staker_rewards is the list of rewards that haven't been claimed by the stakers.
+	StakerRewards []StakerReward `protobuf:"bytes,2,rep,name=staker_rewards,json=stakerRewards,proto3" json:"staker_rewards"`
+	// reward_funds is the list of the undistributed balances funded by the AVSs.
+	RewardFunds []RewardFund `protobuf:"bytes,3,rep,name=reward_funds,json=rewardFunds,proto3" json:"reward_funds"`
+	// reward_escrows is the list of the distributed but unclaimed amounts of each asset.
+	RewardEscrows []RewardEscrow `protobuf:"bytes,4,rep,name=reward_escrows,json=rewardEscrows,proto3" json:"reward_escrows"`
+	// operator_reward_pools is the list of the reward pools of the operators.
+	OperatorRewardPools []OperatorRewardPool `protobuf:"bytes,5,rep,name=operator_reward_pools,json=operatorRewardPools,proto3" json:"operator_reward_pools"`
+	// starting_infos is the list of the starting infos of the stakers in the reward pools.
+	StartingInfos []StakerRewardStartingInfo `protobuf:"bytes,6,rep,name=starting_infos,json=startingInfos,proto3" json:"starting_infos"`
+	// pools is the list of the reward records of the AVSs.
+	Pools []Pool `protobuf:"bytes,7,rep,name=pools,proto3" json:"pools"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +81,48 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetStakerRewards() []StakerReward {
+	if m != nil {
+		return m.StakerRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardFunds() []RewardFund {
+	if m != nil {
+		return m.RewardFunds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardEscrows() []RewardEscrow {
+	if m != nil {
+		return m.RewardEscrows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOperatorRewardPools() []OperatorRewardPool {
+	if m != nil {
+		return m.OperatorRewardPools
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStartingInfos() []StakerRewardStartingInfo {
+	if m != nil {
+		return m.StartingInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPools() []Pool {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "exocore.reward.v1.GenesisState")
 }
@@ -76,20 +130,32 @@ func init() {
 func init() { proto.RegisterFile("exocore/reward/v1/genesis.proto", fileDescriptor_f7e55bf6f1b349a7) }
 
 var fileDescriptor_f7e55bf6f1b349a7 = []byte{
-	// 201 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xad, 0xc8, 0x4f,
-	0xce, 0x2f, 0x4a, 0xd5, 0x2f, 0x4a, 0x2d, 0x4f, 0x2c, 0x4a, 0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f,
-	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0x2a, 0xd0,
-	0x83, 0x28, 0xd0, 0x2b, 0x33, 0x94, 0x92, 0xc3, 0xd4, 0x53, 0x90, 0x58, 0x94, 0x98, 0x0b, 0xd5,
-	0x22, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x66, 0xea, 0x83, 0x58, 0x10, 0x51, 0x25, 0x77, 0x2e,
-	0x1e, 0x77, 0x88, 0xc9, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0xe6, 0x5c, 0x6c, 0x10, 0x5d, 0x12,
-	0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x92, 0x7a, 0x18, 0x36, 0xe9, 0x05, 0x80, 0x15, 0x38, 0xb1,
-	0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x04, 0x55, 0xee, 0xe4, 0x75, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47,
-	0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d,
-	0xc7, 0x72, 0x0c, 0x51, 0x06, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa,
-	0xae, 0x10, 0xc3, 0xfc, 0x52, 0x4b, 0xca, 0xf3, 0x8b, 0xb2, 0xf5, 0x61, 0x4e, 0xae, 0x80, 0x39,
-	0xba, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x36, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x0f, 0x27, 0x2a, 0x83, 0x07, 0x01, 0x00, 0x00,
+	// 385 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4d, 0x4b, 0xf3, 0x40,
+	0x10, 0xc7, 0x93, 0xa7, 0x2f, 0x0f, 0xa4, 0x55, 0x30, 0x2a, 0xc6, 0x42, 0xd3, 0x22, 0x08, 0x05,
+	0x21, 0xb1, 0xed, 0xc1, 0x7b, 0xa1, 0x15, 0x45, 0xb4, 0xb4, 0x17, 0xf1, 0x12, 0xd2, 0x76, 0x1b,
+	0x43, 0xdb, 0x4c, 0xd8, 0xd9, 0xbe, 0xf8, 0x2d, 0xbc, 0xfb, 0x85, 0x7a, 0xec, 0xd1, 0x93, 0x48,
+	0xfb, 0x45, 0xc4, 0xdd, 0x4d, 0x2d, 0x24, 0xe2, 0x6d, 0x33, 0xfb, 0x9b, 0x5f, 0xfe, 0x99, 0x8c,
+	0x56, 0x22, 0x0b, 0xe8, 0x03, 0x25, 0x36, 0x25, 0x73, 0x97, 0x0e, 0xec, 0x59, 0xd5, 0xf6, 0x48,
+	0x40, 0xd0, 0x47, 0x2b, 0xa4, 0xc0, 0x40, 0x3f, 0x90, 0x80, 0x25, 0x00, 0x6b, 0x56, 0x2d, 0x98,
+	0xf1, 0x9e, 0xd0, 0xa5, 0xee, 0x44, 0xb6, 0x14, 0x8a, 0xf1, 0x7b, 0xf6, 0x12, 0x92, 0xe8, 0xfa,
+	0xc8, 0x03, 0x0f, 0xf8, 0xd1, 0xfe, 0x3e, 0x89, 0xea, 0xd9, 0x5b, 0x5a, 0xcb, 0x5f, 0x8b, 0x37,
+	0x77, 0x99, 0xcb, 0x88, 0x7e, 0xa5, 0x65, 0x85, 0xd5, 0x50, 0xcb, 0x6a, 0x25, 0x57, 0x3b, 0xb5,
+	0x62, 0x49, 0xac, 0x36, 0x07, 0x1a, 0xe9, 0xe5, 0x47, 0x49, 0xe9, 0x48, 0x5c, 0xbf, 0xd3, 0xf6,
+	0x91, 0xb9, 0x23, 0x42, 0x1d, 0x01, 0xa2, 0xf1, 0xaf, 0x9c, 0xaa, 0xe4, 0x6a, 0xa5, 0x04, 0x41,
+	0x97, 0x83, 0x1d, 0xfe, 0x2c, 0x35, 0x7b, 0xb8, 0x53, 0x43, 0xbd, 0xa5, 0xe5, 0x05, 0xee, 0x0c,
+	0xa7, 0xc1, 0x00, 0x8d, 0x14, 0x77, 0x15, 0x13, 0x5c, 0xa2, 0xa3, 0x35, 0x0d, 0x22, 0x53, 0x8e,
+	0x6e, 0x2b, 0x3c, 0x95, 0xf4, 0x10, 0xec, 0x53, 0x98, 0xa3, 0x91, 0xfe, 0x35, 0x95, 0x30, 0x35,
+	0x39, 0x17, 0xa5, 0xa2, 0x3b, 0x35, 0xd4, 0x1d, 0xed, 0x18, 0x42, 0x42, 0x5d, 0x06, 0xd1, 0x57,
+	0x3a, 0x21, 0xc0, 0x18, 0x8d, 0x0c, 0x97, 0x9e, 0x27, 0x48, 0x1f, 0x24, 0x2f, 0xe4, 0x6d, 0x80,
+	0xb1, 0x54, 0x1f, 0x42, 0xec, 0x06, 0xf5, 0x47, 0x3e, 0x44, 0xca, 0xfc, 0xc0, 0x73, 0xfc, 0x60,
+	0x08, 0x68, 0x64, 0xb9, 0xf9, 0xe2, 0x8f, 0x21, 0x76, 0x65, 0xd3, 0x4d, 0x30, 0x84, 0x9d, 0x81,
+	0x6e, 0x6b, 0xa8, 0xd7, 0xb5, 0x8c, 0x88, 0xfa, 0x9f, 0x0b, 0x4f, 0x92, 0x7e, 0xeb, 0x4f, 0x38,
+	0xc1, 0x36, 0x6e, 0x97, 0x6b, 0x53, 0x5d, 0xad, 0x4d, 0xf5, 0x73, 0x6d, 0xaa, 0xaf, 0x1b, 0x53,
+	0x59, 0x6d, 0x4c, 0xe5, 0x7d, 0x63, 0x2a, 0x4f, 0x97, 0x9e, 0xcf, 0x9e, 0xa7, 0x3d, 0xab, 0x0f,
+	0x13, 0xbb, 0x29, 0x4c, 0xf7, 0x84, 0xcd, 0x81, 0x8e, 0xec, 0x68, 0x0d, 0x17, 0xd1, 0x22, 0xf2,
+	0x2d, 0xec, 0x65, 0xf9, 0xc2, 0xd5, 0xbf, 0x06, 0x00, 0x1c, 0xb1, 0xfd, 0xd3, 0xfb, 0x02, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -112,6 +178,90 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.StartingInfos) > 0 {
+		for iNdEx := len(m.StartingInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StartingInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.OperatorRewardPools) > 0 {
+		for iNdEx := len(m.OperatorRewardPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorRewardPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RewardEscrows) > 0 {
+		for iNdEx := len(m.RewardEscrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardEscrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RewardFunds) > 0 {
+		for iNdEx := len(m.RewardFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.StakerRewards) > 0 {
+		for iNdEx := len(m.StakerRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakerRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -144,6 +294,42 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.StakerRewards) > 0 {
+		for _, e := range m.StakerRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardFunds) > 0 {
+		for _, e := range m.RewardFunds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardEscrows) > 0 {
+		for _, e := range m.RewardEscrows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OperatorRewardPools) > 0 {
+		for _, e := range m.OperatorRewardPools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StartingInfos) > 0 {
+		for _, e := range m.StartingInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -215,6 +401,210 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerRewards = append(m.StakerRewards, StakerReward{})
+			if err := m.StakerRewards[len(m.StakerRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardFunds = append(m.RewardFunds, RewardFund{})
+			if err := m.RewardFunds[len(m.RewardFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEscrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardEscrows = append(m.RewardEscrows, RewardEscrow{})
+			if err := m.RewardEscrows[len(m.RewardEscrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorRewardPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorRewardPools = append(m.OperatorRewardPools, OperatorRewardPool{})
+			if err := m.OperatorRewardPools[len(m.OperatorRewardPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartingInfos = append(m.StartingInfos, StakerRewardStartingInfo{})
+			if err := m.StartingInfos[len(m.StartingInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, Pool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
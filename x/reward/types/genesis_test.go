@@ -3,11 +3,45 @@ package types_test
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/ExocoreNetwork/exocore/x/reward/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	stakerID := "0x3e108c058e8066da635321dc3018294ca82ddedf_0x65"
+	assetID := "0xdac17f958d2ee523a2206206994597c13d831ec7_0x65"
+	reward := types.StakerReward{
+		StakerID: stakerID,
+		AssetID:  assetID,
+		Amount:   sdkmath.NewInt(10),
+	}
+	escrow := types.RewardEscrow{
+		AssetID: assetID,
+		Amount:  sdkmath.NewInt(10),
+	}
+	operator := sdk.AccAddress(common.HexToAddress("0x3e108c058e8066da635321dc3018294ca82ddedf").Bytes()).String()
+	pool := types.OperatorRewardPool{
+		Operator:              operator,
+		AssetID:               assetID,
+		CumulativeRewardRatio: sdkmath.LegacyNewDec(2),
+		TotalShare:            sdkmath.LegacyNewDec(100),
+	}
+	startingInfo := types.StakerRewardStartingInfo{
+		StakerID:    stakerID,
+		Operator:    operator,
+		AssetID:     assetID,
+		RewardRatio: sdkmath.LegacyNewDec(1),
+		Share:       sdkmath.LegacyNewDec(100),
+	}
+	withState := func(modify func(*types.GenesisState)) *types.GenesisState {
+		gs := types.NewGenesisState(types.DefaultParams(), []types.StakerReward{reward})
+		gs.RewardEscrows = []types.RewardEscrow{escrow}
+		modify(gs)
+		return gs
+	}
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -26,6 +60,107 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc:     "valid staker rewards",
+			genState: withState(func(*types.GenesisState) {}),
+			valid:    true,
+		},
+		{
+			desc:     "staker rewards not backed by the escrow",
+			genState: types.NewGenesisState(types.DefaultParams(), []types.StakerReward{reward}),
+			valid:    false,
+		},
+		{
+			desc: "valid reward funds and pools",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.RewardFunds = []types.RewardFund{{
+					AvsAddress: "0x3e108c058e8066da635321dc3018294ca82ddedf",
+					AssetID:    assetID,
+					Amount:     sdkmath.NewInt(5),
+				}}
+				gs.OperatorRewardPools = []types.OperatorRewardPool{pool}
+				gs.StartingInfos = []types.StakerRewardStartingInfo{startingInfo}
+			}),
+			valid: true,
+		},
+		{
+			desc: "uppercase avs address in the reward fund",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.RewardFunds = []types.RewardFund{{
+					AvsAddress: "0x3E108C058E8066DA635321DC3018294CA82DDEDF",
+					AssetID:    assetID,
+					Amount:     sdkmath.NewInt(5),
+				}}
+			}),
+			valid: false,
+		},
+		{
+			desc: "starting info without a pool",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.StartingInfos = []types.StakerRewardStartingInfo{startingInfo}
+			}),
+			valid: false,
+		},
+		{
+			desc: "mismatched total share of the pool",
+			genState: withState(func(gs *types.GenesisState) {
+				mismatched := pool
+				mismatched.TotalShare = sdkmath.LegacyNewDec(101)
+				gs.OperatorRewardPools = []types.OperatorRewardPool{mismatched}
+				gs.StartingInfos = []types.StakerRewardStartingInfo{startingInfo}
+			}),
+			valid: false,
+		},
+		{
+			desc: "starting ratio above the pool ratio",
+			genState: withState(func(gs *types.GenesisState) {
+				ahead := startingInfo
+				ahead.RewardRatio = sdkmath.LegacyNewDec(3)
+				gs.OperatorRewardPools = []types.OperatorRewardPool{pool}
+				gs.StartingInfos = []types.StakerRewardStartingInfo{ahead}
+			}),
+			valid: false,
+		},
+		{
+			desc: "duplicate staker rewards",
+			genState: types.NewGenesisState(
+				types.DefaultParams(), []types.StakerReward{reward, reward},
+			),
+			valid: false,
+		},
+		{
+			desc: "uppercase stakerID",
+			genState: types.NewGenesisState(
+				types.DefaultParams(), []types.StakerReward{{
+					StakerID: "0x3E108C058E8066DA635321DC3018294CA82DDEDF_0x65",
+					AssetID:  assetID,
+					Amount:   sdkmath.NewInt(10),
+				}},
+			),
+			valid: false,
+		},
+		{
+			desc: "mismatched client chains",
+			genState: types.NewGenesisState(
+				types.DefaultParams(), []types.StakerReward{{
+					StakerID: stakerID,
+					AssetID:  "0xdac17f958d2ee523a2206206994597c13d831ec7_0x66",
+					Amount:   sdkmath.NewInt(10),
+				}},
+			),
+			valid: false,
+		},
+		{
+			desc: "zero reward amount",
+			genState: types.NewGenesisState(
+				types.DefaultParams(), []types.StakerReward{{
+					StakerID: stakerID,
+					AssetID:  assetID,
+					Amount:   sdkmath.ZeroInt(),
+				}},
+			),
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -21,10 +21,26 @@ func KeyPrefix(p string) []byte {
 const (
 	prefixParams = iota + 1
 	rewardInfo
+	stakerReward
+	rewardFund
+	rewardEscrow
+	operatorRewardPool
+	stakerRewardStartingInfo
 )
 
 var (
 	KeyPrefixParams     = []byte{prefixParams}
 	KeyPrefixRewardInfo = []byte{rewardInfo}
-	ParamsKey           = []byte("Params")
+	// KeyPrefixStakerReward key-value: stakerID + '/' + assetID -> StakerReward
+	KeyPrefixStakerReward = []byte{stakerReward}
+	// KeyPrefixRewardFund key-value: avsAddr + '/' + assetID -> RewardFund
+	KeyPrefixRewardFund = []byte{rewardFund}
+	// KeyPrefixRewardEscrow key-value: assetID -> RewardEscrow
+	KeyPrefixRewardEscrow = []byte{rewardEscrow}
+	// KeyPrefixOperatorRewardPool key-value: operator + '/' + assetID -> OperatorRewardPool
+	KeyPrefixOperatorRewardPool = []byte{operatorRewardPool}
+	// KeyPrefixStakerRewardStartingInfo key-value:
+	// stakerID + '/' + assetID + '/' + operator -> StakerRewardStartingInfo
+	KeyPrefixStakerRewardStartingInfo = []byte{stakerRewardStartingInfo}
+	ParamsKey                         = []byte("Params")
 )
@@ -2,10 +2,15 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgFundAVSReward{}
+)
 
 // GetSigners returns the expected signers for a MsgUpdateParams message.
 func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
@@ -25,3 +30,31 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m *MsgUpdateParams) GetSignBytes() []byte {
 	return nil
 }
+
+// GetSigners returns the expected signers for a MsgFundAVSReward message.
+func (m *MsgFundAVSReward) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgFundAVSReward) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	if !common.IsHexAddress(m.AvsAddress) {
+		return errorsmod.Wrapf(ErrInvalidEvmAddressFormat, "invalid avs address:%s", m.AvsAddress)
+	}
+	if _, _, err := assetstypes.ValidateID(m.AssetID, true, false); err != nil {
+		return errorsmod.Wrapf(ErrRewardAssetNotExist, "invalid assetID:%s", m.AssetID)
+	}
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return errorsmod.Wrapf(ErrRewardAmountIsNegative, "the amount is:%s", m.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgFundAVSReward) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(m))
+}
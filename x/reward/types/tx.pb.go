@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -124,35 +125,153 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgFundAVSReward is the Msg/FundAVSReward request type. The funded amount is deducted
+// from the deposit of the sender on the client chain of the asset, whose staker ID is
+// derived from the sender's address.
+type MsgFundAVSReward struct {
+	// from_address is the address of an owner of the AVS.
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// avs_address is the address of the funded AVS.
+	AvsAddress string `protobuf:"bytes,2,opt,name=avs_address,json=avsAddress,proto3" json:"avs_address,omitempty"`
+	// asset_id is the identifier of the funded asset.
+	AssetID string `protobuf:"bytes,3,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// amount is the funded amount.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *MsgFundAVSReward) Reset()         { *m = MsgFundAVSReward{} }
+func (m *MsgFundAVSReward) String() string { return proto.CompactTextString(m) }
+func (*MsgFundAVSReward) ProtoMessage()    {}
+func (*MsgFundAVSReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2100375ea8623b3, []int{2}
+}
+func (m *MsgFundAVSReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundAVSReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundAVSReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundAVSReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundAVSReward.Merge(m, src)
+}
+func (m *MsgFundAVSReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundAVSReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundAVSReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundAVSReward proto.InternalMessageInfo
+
+func (m *MsgFundAVSReward) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgFundAVSReward) GetAvsAddress() string {
+	if m != nil {
+		return m.AvsAddress
+	}
+	return ""
+}
+
+func (m *MsgFundAVSReward) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+// MsgFundAVSRewardResponse is the Msg/FundAVSReward response type.
+type MsgFundAVSRewardResponse struct {
+}
+
+func (m *MsgFundAVSRewardResponse) Reset()         { *m = MsgFundAVSRewardResponse{} }
+func (m *MsgFundAVSRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundAVSRewardResponse) ProtoMessage()    {}
+func (*MsgFundAVSRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2100375ea8623b3, []int{3}
+}
+func (m *MsgFundAVSRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundAVSRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundAVSRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundAVSRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundAVSRewardResponse.Merge(m, src)
+}
+func (m *MsgFundAVSRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundAVSRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundAVSRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundAVSRewardResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "exocore.reward.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "exocore.reward.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgFundAVSReward)(nil), "exocore.reward.v1.MsgFundAVSReward")
+	proto.RegisterType((*MsgFundAVSRewardResponse)(nil), "exocore.reward.v1.MsgFundAVSRewardResponse")
 }
 
 func init() { proto.RegisterFile("exocore/reward/v1/tx.proto", fileDescriptor_e2100375ea8623b3) }
 
 var fileDescriptor_e2100375ea8623b3 = []byte{
-	// 319 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0xad, 0xc8, 0x4f,
-	0xce, 0x2f, 0x4a, 0xd5, 0x2f, 0x4a, 0x2d, 0x4f, 0x2c, 0x4a, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0xa9,
-	0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0xca, 0xe9, 0x41, 0xe4, 0xf4, 0xca, 0x0c,
-	0xa5, 0xc4, 0x93, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5, 0x73, 0x8b, 0xd3, 0x41, 0x4a, 0x73, 0x8b,
-	0xd3, 0x21, 0x6a, 0xa5, 0x24, 0x21, 0x12, 0xf1, 0x60, 0x9e, 0x3e, 0x84, 0x03, 0x95, 0x92, 0xc3,
-	0xb4, 0xa2, 0x20, 0xb1, 0x28, 0x31, 0x17, 0x26, 0x2f, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0xd1, 0x07,
-	0x62, 0x41, 0x44, 0x95, 0x26, 0x31, 0x72, 0xf1, 0xfb, 0x16, 0xa7, 0x87, 0x16, 0xa4, 0x24, 0x96,
-	0xa4, 0x06, 0x80, 0xd5, 0x0b, 0x99, 0x71, 0x71, 0x26, 0x96, 0x96, 0x64, 0xe4, 0x17, 0x65, 0x96,
-	0x54, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x3a, 0x49, 0x5c, 0xda, 0xa2, 0x2b, 0x02, 0xb5, 0xce,
-	0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x38, 0xb8, 0xa4, 0x28, 0x33, 0x2f, 0x3d, 0x08, 0xa1, 0x54,
-	0xc8, 0x9c, 0x8b, 0x0d, 0x62, 0xa3, 0x04, 0x93, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0xa4, 0x1e, 0x86,
-	0xcf, 0xf4, 0x20, 0x56, 0x38, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x04, 0x55, 0x6e, 0xc5, 0xd7,
-	0xf4, 0x7c, 0x83, 0x16, 0xc2, 0x20, 0x25, 0x49, 0x2e, 0x71, 0x34, 0x37, 0x05, 0xa5, 0x16, 0x17,
-	0xe4, 0xe7, 0x15, 0xa7, 0x1a, 0xa5, 0x72, 0x31, 0xfb, 0x16, 0xa7, 0x0b, 0xc5, 0x71, 0xf1, 0xa0,
-	0x38, 0x59, 0x09, 0x8b, 0x55, 0x68, 0x46, 0x48, 0x69, 0x11, 0x56, 0x03, 0xb3, 0xc6, 0xc9, 0xeb,
-	0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e,
-	0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x0c, 0xd2, 0x33, 0x4b, 0x32, 0x4a,
-	0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x5d, 0x21, 0xe6, 0xf9, 0xa5, 0x96, 0x94, 0xe7, 0x17, 0x65,
-	0xeb, 0xc3, 0x22, 0xa0, 0x02, 0x16, 0x05, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x90,
-	0x36, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x87, 0x13, 0x91, 0x8e, 0x04, 0x02, 0x00, 0x00,
+	// 487 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0xdb, 0x2a, 0x25, 0x93, 0xf2, 0x53, 0xab, 0x52, 0x1d, 0x1f, 0x9c, 0xca, 0x48, 0x55,
+	0x55, 0x14, 0x9b, 0x16, 0x09, 0xa4, 0xc2, 0x25, 0x11, 0x20, 0x05, 0x29, 0x08, 0xb9, 0xc0, 0x81,
+	0x03, 0xd1, 0x36, 0x5e, 0xb6, 0x51, 0x65, 0xaf, 0xb5, 0xb3, 0x49, 0xd3, 0x23, 0x3c, 0x01, 0xe2,
+	0x49, 0x38, 0xf4, 0x21, 0x72, 0xac, 0x7a, 0x42, 0x1c, 0x22, 0x94, 0x1c, 0x78, 0x0d, 0x64, 0xef,
+	0x9a, 0xe2, 0xb4, 0x52, 0x38, 0xd9, 0x3b, 0xdf, 0x37, 0xf3, 0xcd, 0x7c, 0xb3, 0x0b, 0x36, 0x1d,
+	0xf1, 0x1e, 0x17, 0xd4, 0x17, 0xf4, 0x94, 0x88, 0xd0, 0x1f, 0xee, 0xf9, 0x72, 0xe4, 0x25, 0x82,
+	0x4b, 0x6e, 0xae, 0x6b, 0xcc, 0x53, 0x98, 0x37, 0xdc, 0xb3, 0x37, 0x7b, 0x1c, 0x23, 0x8e, 0x7e,
+	0x84, 0x2c, 0xa5, 0x46, 0xc8, 0x14, 0xd7, 0xae, 0x29, 0xa0, 0x9b, 0x9d, 0x7c, 0x75, 0xd0, 0x90,
+	0x73, 0x5d, 0x22, 0x21, 0x82, 0x44, 0x39, 0xbe, 0xc1, 0x38, 0xe3, 0x2a, 0x2f, 0xfd, 0x53, 0x51,
+	0xf7, 0x9b, 0x01, 0x77, 0x3b, 0xc8, 0xde, 0x25, 0x21, 0x91, 0xf4, 0x4d, 0xc6, 0x37, 0x1f, 0x43,
+	0x85, 0x0c, 0xe4, 0x31, 0x17, 0x7d, 0x79, 0x66, 0x19, 0x5b, 0xc6, 0x4e, 0xa5, 0x65, 0x5d, 0x9e,
+	0x37, 0x36, 0xb4, 0x5c, 0x33, 0x0c, 0x05, 0x45, 0x3c, 0x94, 0xa2, 0x1f, 0xb3, 0xe0, 0x8a, 0x6a,
+	0x3e, 0x81, 0xb2, 0x52, 0xb4, 0x96, 0xb6, 0x8c, 0x9d, 0xea, 0x7e, 0xcd, 0xbb, 0x36, 0x99, 0xa7,
+	0x24, 0x5a, 0x2b, 0xe3, 0x49, 0xbd, 0x14, 0x68, 0xfa, 0xc1, 0x9d, 0x2f, 0xbf, 0xbf, 0xef, 0x5e,
+	0x15, 0x72, 0x6b, 0xb0, 0x39, 0xd7, 0x53, 0x40, 0x31, 0xe1, 0x31, 0x52, 0xf7, 0xf3, 0x12, 0xdc,
+	0xeb, 0x20, 0x7b, 0x39, 0x88, 0xc3, 0xe6, 0xfb, 0xc3, 0x20, 0xab, 0x6b, 0x3e, 0x85, 0xb5, 0x4f,
+	0x82, 0x47, 0x5d, 0xa2, 0x3a, 0x5b, 0xd8, 0x73, 0x35, 0x65, 0xeb, 0x90, 0x59, 0x87, 0x2a, 0x19,
+	0xe2, 0xdf, 0xdc, 0xb4, 0xf5, 0x4a, 0x00, 0x64, 0x88, 0x39, 0x61, 0x1b, 0x6e, 0x11, 0x44, 0x2a,
+	0xbb, 0xfd, 0xd0, 0x5a, 0xce, 0x2a, 0x57, 0xa7, 0x93, 0xfa, 0x6a, 0x33, 0x8d, 0xb5, 0x9f, 0x07,
+	0xab, 0x19, 0xd8, 0x0e, 0xcd, 0xb7, 0x50, 0x26, 0x11, 0x1f, 0xc4, 0xd2, 0x5a, 0xc9, 0x58, 0xcf,
+	0xd2, 0x19, 0x7f, 0x4e, 0xea, 0xdb, 0xac, 0x2f, 0x8f, 0x07, 0x47, 0x5e, 0x8f, 0x47, 0x7a, 0x63,
+	0xfa, 0xd3, 0xc0, 0xf0, 0xc4, 0x97, 0x67, 0x09, 0x45, 0xaf, 0x1d, 0xcb, 0xcb, 0xf3, 0x06, 0xe8,
+	0x6e, 0xdb, 0xb1, 0x0c, 0x74, 0xad, 0x83, 0xf5, 0xd4, 0x9b, 0xc2, 0x78, 0xae, 0x0d, 0xd6, 0xbc,
+	0x05, 0xb9, 0x3f, 0xfb, 0x63, 0x03, 0x96, 0x3b, 0xc8, 0xcc, 0x8f, 0xb0, 0x56, 0xd8, 0xa9, 0x7b,
+	0xc3, 0x2e, 0xe6, 0x3c, 0xb6, 0x77, 0x17, 0x73, 0x72, 0x1d, 0x93, 0xc0, 0xed, 0xe2, 0x0e, 0xee,
+	0xdf, 0x9c, 0x5c, 0x20, 0xd9, 0x0f, 0xfe, 0x83, 0x94, 0x4b, 0xb4, 0x5e, 0x8d, 0xa7, 0x8e, 0x71,
+	0x31, 0x75, 0x8c, 0x5f, 0x53, 0xc7, 0xf8, 0x3a, 0x73, 0x4a, 0x17, 0x33, 0xa7, 0xf4, 0x63, 0xe6,
+	0x94, 0x3e, 0x3c, 0xfc, 0xc7, 0xd1, 0x17, 0xaa, 0xe0, 0x6b, 0x2a, 0x4f, 0xb9, 0x38, 0xf1, 0xf3,
+	0x47, 0x30, 0xca, 0x9f, 0x41, 0xe6, 0xef, 0x51, 0x39, 0xbb, 0xed, 0x8f, 0xfe, 0x0c, 0x00, 0x15,
+	0xb3, 0x71, 0x04, 0x88, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -169,6 +288,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// UpdateParams updates the parameters for this module.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// FundAVSReward moves the deposit of an AVS owner into the reward fund of the AVS.
+	FundAVSReward(ctx context.Context, in *MsgFundAVSReward, opts ...grpc.CallOption) (*MsgFundAVSRewardResponse, error)
 }
 
 type msgClient struct {
@@ -188,10 +309,21 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) FundAVSReward(ctx context.Context, in *MsgFundAVSReward, opts ...grpc.CallOption) (*MsgFundAVSRewardResponse, error) {
+	out := new(MsgFundAVSRewardResponse)
+	err := c.cc.Invoke(ctx, "/exocore.reward.v1.Msg/FundAVSReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams updates the parameters for this module.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// FundAVSReward moves the deposit of an AVS owner into the reward fund of the AVS.
+	FundAVSReward(context.Context, *MsgFundAVSReward) (*MsgFundAVSRewardResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -201,6 +333,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) FundAVSReward(ctx context.Context, req *MsgFundAVSReward) (*MsgFundAVSRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundAVSReward not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -224,6 +359,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FundAVSReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundAVSReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundAVSReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.reward.v1.Msg/FundAVSReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundAVSReward(ctx, req.(*MsgFundAVSReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "exocore.reward.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -232,6 +385,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "FundAVSReward",
+			Handler:    _Msg_FundAVSReward_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "exocore/reward/v1/tx.proto",
@@ -300,6 +457,83 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgFundAVSReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundAVSReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundAVSReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AvsAddress) > 0 {
+		i -= len(m.AvsAddress)
+		copy(dAtA[i:], m.AvsAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AvsAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundAVSRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundAVSRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundAVSRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -335,6 +569,38 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgFundAVSReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AvsAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFundAVSRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -506,6 +772,236 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgFundAVSReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundAVSReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundAVSReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundAVSRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundAVSRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundAVSRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
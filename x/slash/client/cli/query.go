@@ -20,6 +20,8 @@ func GetQueryCmd(_ string) *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryOperatorFrozenStatus())
+	cmd.AddCommand(CmdQueryStakerFrozenStatus())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
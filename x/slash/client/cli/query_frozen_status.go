@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"github.com/ExocoreNetwork/exocore/x/slash/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryOperatorFrozenStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "operator-frozen-status <operatorAddress>",
+		Short: "shows whether the operator is frozen",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.OperatorFrozenStatus(cmd.Context(), &types.QueryOperatorFrozenStatusRequest{
+				OperatorAddress: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryStakerFrozenStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "staker-frozen-status <stakerID>",
+		Short: "shows whether the staker is frozen",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StakerFrozenStatus(cmd.Context(), &types.QueryStakerFrozenStatusRequest{
+				StakerId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -20,14 +20,6 @@ type SlashParams struct {
 	Proof                     []byte
 }
 
-// nolint: unused // This is to be implemented.
-type OperatorFrozenStatus struct {
-	// nolint: unused // This is to be implemented.
-	operatorAddress sdk.AccAddress
-	// nolint: unused // This is to be implemented.
-	status bool
-}
-
 // func (k Keeper) OptIntoSlashing(ctx sdk.Context, event *SlashParams) error {
 // 	//TODO implement me
 // 	panic("implement me")
@@ -67,21 +59,3 @@ func (k Keeper) Slash(_ sdk.Context, _ *SlashParams) error {
 		}*/
 	return nil
 }
-
-// func (k Keeper) FreezeOperator(ctx sdk.Context, event *SlashParams) error {
-// 	k.SetFrozenStatus(ctx, string(event.OperatorAddress), true)
-// 	return nil
-// }
-
-//	func (k Keeper) ResetFrozenStatus(ctx sdk.Context, event *SlashParams) error {
-//		k.SetFrozenStatus(ctx, string(event.OperatorAddress), true)
-//		return nil
-//	}
-// func (k Keeper) IsOperatorFrozen(ctx sdk.Context, event *SlashParams) (bool, error) {
-// 	return k.GetFrozenStatus(ctx, string(event.OperatorAddress))
-
-// }
-// func (k Keeper) OperatorAssetSlashedProportion(ctx sdk.Context, opAddr sdk.AccAddress, assetID string, startHeight, endHeight uint64) sdkmath.LegacyDec {
-// 	//TODO
-// 	return sdkmath.LegacyNewDec(3)
-// }
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ExocoreNetwork/exocore/x/slash/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+// Since this action typically occurs on chain starts, this function is allowed to panic.
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	if err := k.SetParams(ctx, &state.Params); err != nil {
+		panic(errorsmod.Wrap(err, "failed to set slash params"))
+	}
+	if err := k.SetAllFrozenOperators(ctx, state.FrozenOperators); err != nil {
+		panic(errorsmod.Wrap(err, "failed to set all frozen operators"))
+	}
+	if err := k.SetAllFrozenStakers(ctx, state.FrozenStakers); err != nil {
+		panic(errorsmod.Wrap(err, "failed to set all frozen stakers"))
+	}
+}
+
+// ExportGenesis returns the module's exported genesis
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	res := types.GenesisState{}
+	// the params are only stored once set, so fall back to the default ones.
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		res.Params = types.DefaultParams()
+	} else {
+		res.Params = *params
+	}
+
+	res.FrozenOperators, err = k.GetAllFrozenOperators(ctx)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "failed to get all frozen operators").Error())
+	}
+
+	res.FrozenStakers, err = k.GetAllFrozenStakers(ctx)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "failed to get all frozen stakers").Error())
+	}
+	return &res
+}
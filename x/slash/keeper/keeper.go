@@ -3,7 +3,6 @@ package keeper
 import (
 	"fmt"
 
-	sdkmath "cosmossdk.io/math"
 	"github.com/ExocoreNetwork/exocore/x/assets/keeper"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -20,7 +19,10 @@ type Keeper struct {
 	storeKey storetypes.StoreKey
 
 	// other keepers
-	assetsKeeper keeper.Keeper
+	assetsKeeper     keeper.Keeper
+	delegationKeeper types.DelegationKeeper
+	operatorKeeper   types.OperatorKeeper
+	avsKeeper        types.AVSKeeper
 
 	authority string
 }
@@ -29,6 +31,9 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
 	assetsKeeper keeper.Keeper,
+	delegationKeeper types.DelegationKeeper,
+	operatorKeeper types.OperatorKeeper,
+	avsKeeper types.AVSKeeper,
 	authority string,
 ) Keeper {
 	// ensure authority is a valid bech32 address
@@ -36,10 +41,13 @@ func NewKeeper(
 		panic(fmt.Sprintf("authority address %s is invalid: %s", authority, err))
 	}
 	return Keeper{
-		cdc:          cdc,
-		storeKey:     storeKey,
-		assetsKeeper: assetsKeeper,
-		authority:    authority,
+		cdc:              cdc,
+		storeKey:         storeKey,
+		assetsKeeper:     assetsKeeper,
+		delegationKeeper: delegationKeeper,
+		operatorKeeper:   operatorKeeper,
+		avsKeeper:        avsKeeper,
+		authority:        authority,
 	}
 }
 
@@ -51,10 +59,10 @@ type IEXOSlash interface {
 	PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error
 	OptIntoSlashing(ctx sdk.Context, event *SlashParams) error
 	Slash(ctx sdk.Context, event *SlashParams) error
-	FreezeOperator(ctx sdk.Context, event *SlashParams) error
-	ResetFrozenStatus(ctx sdk.Context, event *SlashParams) error
-	IsOperatorFrozen(ctx sdk.Context, event *SlashParams) (bool, error)
+	FreezeOperator(ctx sdk.Context, operator sdk.AccAddress, frozenBy, reason string) error
+	UnfreezeOperator(ctx sdk.Context, operator sdk.AccAddress, caller string) error
+	IsOperatorFrozen(ctx sdk.Context, operator sdk.AccAddress) bool
+	IsStakerFrozen(ctx sdk.Context, stakerID string) bool
 	SetParams(ctx sdk.Context, params *types.Params) error
 	GetParams(ctx sdk.Context) (*types.Params, error)
-	OperatorAssetSlashedProportion(ctx sdk.Context, opAddr sdk.AccAddress, assetID string, startHeight, endHeight uint64) sdkmath.LegacyDec
 }
@@ -28,5 +28,55 @@ func (k Keeper) UpdateParams(ctx context.Context, params *types.MsgUpdateParams)
 	if err != nil {
 		return nil, err
 	}
-	return nil, nil
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// Freeze freezes an operator or a staker, it can only be executed by the governance.
+func (k Keeper) Freeze(ctx context.Context, req *types.MsgFreeze) (*types.MsgFreezeResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	if k.authority != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf(
+			"invalid authority; expected %s, got %s",
+			k.authority, req.Authority,
+		)
+	}
+	if req.OperatorAddress != "" {
+		operator, err := sdk.AccAddressFromBech32(req.OperatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.FreezeOperator(c, operator, req.Authority, req.Reason); err != nil {
+			return nil, err
+		}
+		return &types.MsgFreezeResponse{}, nil
+	}
+	if err := k.FreezeStaker(c, req.StakerID, req.Authority, req.Reason); err != nil {
+		return nil, err
+	}
+	return &types.MsgFreezeResponse{}, nil
+}
+
+// Unfreeze unfreezes an operator or a staker, it can only be executed by the governance.
+func (k Keeper) Unfreeze(ctx context.Context, req *types.MsgUnfreeze) (*types.MsgUnfreezeResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	if k.authority != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf(
+			"invalid authority; expected %s, got %s",
+			k.authority, req.Authority,
+		)
+	}
+	if req.OperatorAddress != "" {
+		operator, err := sdk.AccAddressFromBech32(req.OperatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.UnfreezeOperator(c, operator, req.Authority); err != nil {
+			return nil, err
+		}
+		return &types.MsgUnfreezeResponse{}, nil
+	}
+	if err := k.UnfreezeStaker(c, req.StakerID, req.Authority); err != nil {
+		return nil, err
+	}
+	return &types.MsgUnfreezeResponse{}, nil
 }
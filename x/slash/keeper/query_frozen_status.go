@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ExocoreNetwork/exocore/x/slash/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) OperatorFrozenStatus(goCtx context.Context, req *types.QueryOperatorFrozenStatusRequest) (*types.QueryOperatorFrozenStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	operator, err := sdk.AccAddressFromBech32(req.OperatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	info, found := k.GetFrozenOperator(ctx, operator)
+	return &types.QueryOperatorFrozenStatusResponse{Frozen: found, Info: info}, nil
+}
+
+func (k Keeper) StakerFrozenStatus(goCtx context.Context, req *types.QueryStakerFrozenStatusRequest) (*types.QueryStakerFrozenStatusResponse, error) {
+	if req == nil || req.StakerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	info, _ := k.GetFrozenStaker(ctx, req.StakerId)
+	return &types.QueryStakerFrozenStatusResponse{
+		Frozen: k.IsStakerFrozen(ctx, req.StakerId),
+		Info:   info,
+	}, nil
+}
@@ -1,32 +1,256 @@
 package keeper
 
 import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	delegationtypes "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	"github.com/ExocoreNetwork/exocore/x/slash/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
-func (k Keeper) SetFrozenStatus(ctx sdk.Context, operatorAddr string, status bool) (err error) {
+// GetFrozenOperator returns the frozen record of the operator, the second return value is
+// false if the operator isn't frozen.
+func (k Keeper) GetFrozenOperator(ctx sdk.Context, operator sdk.AccAddress) (*types.FrozenOperator, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorInfo)
+	value := store.Get(operator)
+	if value == nil {
+		return nil, false
+	}
+	ret := types.FrozenOperator{}
+	k.cdc.MustUnmarshal(value, &ret)
+	return &ret, true
+}
+
+// IsOperatorFrozen returns true if the operator is frozen.
+func (k Keeper) IsOperatorFrozen(ctx sdk.Context, operator sdk.AccAddress) bool {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorInfo)
-	if status {
-		store.Set([]byte(operatorAddr), []byte("1"))
-		return nil
+	return store.Has(operator)
+}
+
+func (k Keeper) setFrozenOperator(ctx sdk.Context, info *types.FrozenOperator) error {
+	operator, err := sdk.AccAddressFromBech32(info.OperatorAddress)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid operator address:%s", info.OperatorAddress)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorInfo)
+	store.Set(operator, k.cdc.MustMarshal(info))
+	return nil
+}
+
+// FreezeOperator freezes the operator. The frozenBy is the governance authority or the AVS
+// which freezes the operator, only the same AVS or the governance authority can unfreeze it.
+func (k Keeper) FreezeOperator(ctx sdk.Context, operator sdk.AccAddress, frozenBy, reason string) error {
+	if !k.operatorKeeper.IsOperator(ctx, operator) {
+		return errorsmod.Wrapf(delegationtypes.ErrOperatorNotExist, "operator:%s", operator)
+	}
+	if k.IsOperatorFrozen(ctx, operator) {
+		return errorsmod.Wrapf(types.ErrAlreadyFrozen, "operator:%s", operator)
+	}
+	err := k.setFrozenOperator(ctx, &types.FrozenOperator{
+		OperatorAddress: operator.String(),
+		FrozenBy:        frozenBy,
+		Height:          ctx.BlockHeight(),
+		Reason:          reason,
+	})
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFreezeOperator,
+			sdk.NewAttribute(types.AttributeKeyOperator, operator.String()),
+			sdk.NewAttribute(types.AttributeKeyFrozenBy, frozenBy),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+	return nil
+}
+
+// UnfreezeOperator unfreezes the operator. The caller should be the governance authority or
+// the AVS which froze the operator.
+func (k Keeper) UnfreezeOperator(ctx sdk.Context, operator sdk.AccAddress, caller string) error {
+	info, found := k.GetFrozenOperator(ctx, operator)
+	if !found {
+		return errorsmod.Wrapf(types.ErrNotFrozen, "operator:%s", operator)
+	}
+	if caller != k.authority && !strings.EqualFold(caller, info.FrozenBy) {
+		return errorsmod.Wrapf(
+			types.ErrUnauthorizedAVS,
+			"operator:%s frozenBy:%s caller:%s", operator, info.FrozenBy, caller,
+		)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorInfo)
+	store.Delete(operator)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnfreezeOperator,
+			sdk.NewAttribute(types.AttributeKeyOperator, operator.String()),
+			sdk.NewAttribute(types.AttributeKeyAddress, caller),
+		),
+	)
+	return nil
+}
+
+// isOptedIntoAVS checks if the operator is opted into the AVS. The AVS address is checked in
+// both the checksum and lowercase formats, since the opt-in record is keyed by the address
+// provided at the time of the opt-in.
+func (k Keeper) isOptedIntoAVS(ctx sdk.Context, operator sdk.AccAddress, avsAddr common.Address) bool {
+	return k.operatorKeeper.IsOptedIn(ctx, operator.String(), avsAddr.String()) ||
+		k.operatorKeeper.IsOptedIn(ctx, operator.String(), strings.ToLower(avsAddr.String()))
+}
+
+// FreezeOperatorByAVS freezes an operator on behalf of an AVS, the operator must be opted into
+// the AVS.
+func (k Keeper) FreezeOperatorByAVS(ctx sdk.Context, avsAddr common.Address, operator sdk.AccAddress, reason string) error {
+	isAVS, err := k.avsKeeper.IsAVS(ctx, avsAddr.String())
+	if err != nil {
+		return err
+	}
+	if !isAVS {
+		return errorsmod.Wrapf(types.ErrUnauthorizedAVS, "the AVS isn't registered:%s", avsAddr)
+	}
+	if !k.isOptedIntoAVS(ctx, operator, avsAddr) {
+		return errorsmod.Wrapf(types.ErrUnauthorizedAVS, "the operator %s isn't opted into the AVS %s", operator, avsAddr)
+	}
+	return k.FreezeOperator(ctx, operator, avsAddr.String(), reason)
+}
+
+// UnfreezeOperatorByAVS unfreezes an operator on behalf of the AVS which froze it.
+func (k Keeper) UnfreezeOperatorByAVS(ctx sdk.Context, avsAddr common.Address, operator sdk.AccAddress) error {
+	return k.UnfreezeOperator(ctx, operator, avsAddr.String())
+}
+
+// GetFrozenStaker returns the frozen record of the staker, the second return value is false if
+// the staker itself isn't frozen.
+func (k Keeper) GetFrozenStaker(ctx sdk.Context, stakerID string) (*types.FrozenStaker, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFrozenStaker)
+	value := store.Get([]byte(stakerID))
+	if value == nil {
+		return nil, false
+	}
+	ret := types.FrozenStaker{}
+	k.cdc.MustUnmarshal(value, &ret)
+	return &ret, true
+}
+
+// IsStakerFrozen returns true if the staker is frozen, or it is associated with a frozen
+// operator. In the latter case, the staker's assets are treated as the operator's own stake,
+// so they can't be moved either.
+func (k Keeper) IsStakerFrozen(ctx sdk.Context, stakerID string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFrozenStaker)
+	if store.Has([]byte(stakerID)) {
+		return true
+	}
+	operator, err := k.delegationKeeper.GetAssociatedOperator(ctx, stakerID)
+	if err != nil || operator == "" {
+		return false
 	}
-	store.Set([]byte(operatorAddr), []byte("0"))
+	opAccAddr, err := sdk.AccAddressFromBech32(operator)
+	if err != nil {
+		return false
+	}
+	return k.IsOperatorFrozen(ctx, opAccAddr)
+}
+
+func (k Keeper) setFrozenStaker(ctx sdk.Context, info *types.FrozenStaker) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFrozenStaker)
+	store.Set([]byte(info.StakerID), k.cdc.MustMarshal(info))
+}
+
+// FreezeStaker freezes the staker.
+func (k Keeper) FreezeStaker(ctx sdk.Context, stakerID, frozenBy, reason string) error {
+	if _, found := k.GetFrozenStaker(ctx, stakerID); found {
+		return errorsmod.Wrapf(types.ErrAlreadyFrozen, "stakerID:%s", stakerID)
+	}
+	k.setFrozenStaker(ctx, &types.FrozenStaker{
+		StakerID: stakerID,
+		FrozenBy: frozenBy,
+		Height:   ctx.BlockHeight(),
+		Reason:   reason,
+	})
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFreezeStaker,
+			sdk.NewAttribute(types.AttributeKeyStakerID, stakerID),
+			sdk.NewAttribute(types.AttributeKeyFrozenBy, frozenBy),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
 	return nil
 }
 
-func (k Keeper) GetFrozenStatus(ctx sdk.Context, operatorAddr string) (bool, error) {
+// UnfreezeStaker unfreezes the staker, it can only be called by the governance or the one
+// who froze the staker.
+func (k Keeper) UnfreezeStaker(ctx sdk.Context, stakerID, caller string) error {
+	info, found := k.GetFrozenStaker(ctx, stakerID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrNotFrozen, "stakerID:%s", stakerID)
+	}
+	if caller != k.authority && !strings.EqualFold(caller, info.FrozenBy) {
+		return errorsmod.Wrapf(
+			types.ErrUnauthorizedAVS,
+			"stakerID:%s frozenBy:%s caller:%s", stakerID, info.FrozenBy, caller,
+		)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFrozenStaker)
+	store.Delete([]byte(stakerID))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnfreezeStaker,
+			sdk.NewAttribute(types.AttributeKeyStakerID, stakerID),
+			sdk.NewAttribute(types.AttributeKeyAddress, caller),
+		),
+	)
+	return nil
+}
+
+// SetAllFrozenOperators sets all the frozen operators.
+func (k Keeper) SetAllFrozenOperators(ctx sdk.Context, infos []types.FrozenOperator) error {
+	for i := range infos {
+		if err := k.setFrozenOperator(ctx, &infos[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetAllFrozenOperators returns all the frozen operators.
+func (k Keeper) GetAllFrozenOperators(ctx sdk.Context) ([]types.FrozenOperator, error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorInfo)
-	ifExist := store.Has(types.ParamsKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
 
-	if !ifExist {
-		return false, types.ErrNoOperatorStatusKey
+	ret := make([]types.FrozenOperator, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.FrozenOperator
+		k.cdc.MustUnmarshal(iterator.Value(), &info)
+		ret = append(ret, info)
 	}
-	value := store.Get([]byte(operatorAddr))
-	if string(value) == "1" {
-		return true, nil
+	return ret, nil
+}
+
+// SetAllFrozenStakers sets all the frozen stakers.
+func (k Keeper) SetAllFrozenStakers(ctx sdk.Context, infos []types.FrozenStaker) error {
+	for i := range infos {
+		k.setFrozenStaker(ctx, &infos[i])
 	}
+	return nil
+}
+
+// GetAllFrozenStakers returns all the frozen stakers.
+func (k Keeper) GetAllFrozenStakers(ctx sdk.Context) ([]types.FrozenStaker, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFrozenStaker)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
 
-	return false, nil
+	ret := make([]types.FrozenStaker, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.FrozenStaker
+		k.cdc.MustUnmarshal(iterator.Value(), &info)
+		ret = append(ret, info)
+	}
+	return ret, nil
 }
@@ -0,0 +1,195 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/utils"
+	assetskeeper "github.com/ExocoreNetwork/exocore/x/assets/keeper"
+	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	delegationtypes "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	slashtypes "github.com/ExocoreNetwork/exocore/x/slash/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (suite *SlashTestSuite) authority() string {
+	return authtypes.NewModuleAddress(govtypes.ModuleName).String()
+}
+
+func (suite *SlashTestSuite) TestFreezeOperatorByGov() {
+	operator := suite.Operators[0]
+	avsAddr := avstypes.GenerateAVSAddr(avstypes.ChainIDWithoutRevision(utils.DefaultChainID))
+
+	_, err := suite.App.ExoSlashKeeper.Freeze(suite.Ctx, &slashtypes.MsgFreeze{
+		Authority:       suite.AccAddress.String(),
+		OperatorAddress: operator.String(),
+	})
+	suite.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = suite.App.ExoSlashKeeper.Freeze(suite.Ctx, &slashtypes.MsgFreeze{
+		Authority:       suite.authority(),
+		OperatorAddress: operator.String(),
+		Reason:          "double signing",
+	})
+	suite.NoError(err)
+	suite.True(suite.App.ExoSlashKeeper.IsOperatorFrozen(suite.Ctx, operator))
+	suite.False(suite.App.ExoSlashKeeper.IsOperatorFrozen(suite.Ctx, suite.Operators[1]))
+
+	// the frozen operator can't be delegated to, undelegated from or opted out
+	params := &delegationtypes.DelegationOrUndelegationParams{
+		ClientChainID:   suite.ClientChains[0].LayerZeroChainID,
+		AssetsAddress:   common.HexToAddress(suite.Assets[0].Address).Bytes(),
+		OperatorAddress: operator,
+		StakerAddress:   suite.Address.Bytes(),
+		OpAmount:        sdkmath.NewInt(1),
+	}
+	err = suite.App.DelegationKeeper.DelegateTo(suite.Ctx, params)
+	suite.ErrorIs(err, delegationtypes.ErrOperatorIsFrozen)
+	err = suite.App.DelegationKeeper.UndelegateFrom(suite.Ctx, params)
+	suite.ErrorIs(err, delegationtypes.ErrOperatorIsFrozen)
+	err = suite.App.OperatorKeeper.OptOut(suite.Ctx, operator, avsAddr)
+	suite.ErrorIs(err, delegationtypes.ErrOperatorIsFrozen)
+
+	// freezing it twice isn't allowed
+	_, err = suite.App.ExoSlashKeeper.Freeze(suite.Ctx, &slashtypes.MsgFreeze{
+		Authority:       suite.authority(),
+		OperatorAddress: operator.String(),
+	})
+	suite.ErrorIs(err, slashtypes.ErrAlreadyFrozen)
+
+	res, err := suite.App.ExoSlashKeeper.OperatorFrozenStatus(suite.Ctx, &slashtypes.QueryOperatorFrozenStatusRequest{
+		OperatorAddress: operator.String(),
+	})
+	suite.NoError(err)
+	suite.True(res.Frozen)
+	suite.Equal(suite.authority(), res.Info.FrozenBy)
+	suite.Equal("double signing", res.Info.Reason)
+
+	// an AVS can't unfreeze the operator frozen by the governance
+	err = suite.App.ExoSlashKeeper.UnfreezeOperatorByAVS(suite.Ctx, common.HexToAddress(avsAddr), operator)
+	suite.ErrorIs(err, slashtypes.ErrUnauthorizedAVS)
+
+	_, err = suite.App.ExoSlashKeeper.Unfreeze(suite.Ctx, &slashtypes.MsgUnfreeze{
+		Authority:       suite.authority(),
+		OperatorAddress: operator.String(),
+	})
+	suite.NoError(err)
+	suite.False(suite.App.ExoSlashKeeper.IsOperatorFrozen(suite.Ctx, operator))
+
+	_, err = suite.App.ExoSlashKeeper.Unfreeze(suite.Ctx, &slashtypes.MsgUnfreeze{
+		Authority:       suite.authority(),
+		OperatorAddress: operator.String(),
+	})
+	suite.ErrorIs(err, slashtypes.ErrNotFrozen)
+}
+
+func (suite *SlashTestSuite) TestFreezeOperatorByAVS() {
+	operator := suite.Operators[0]
+	avsAddr := common.HexToAddress(avstypes.GenerateAVSAddr(avstypes.ChainIDWithoutRevision(utils.DefaultChainID)))
+
+	// the caller isn't a registered AVS
+	err := suite.App.ExoSlashKeeper.FreezeOperatorByAVS(suite.Ctx, suite.Address, operator, "")
+	suite.ErrorIs(err, slashtypes.ErrUnauthorizedAVS)
+
+	err = suite.App.ExoSlashKeeper.FreezeOperatorByAVS(suite.Ctx, avsAddr, operator, "invalid task response")
+	suite.NoError(err)
+	suite.True(suite.App.ExoSlashKeeper.IsOperatorFrozen(suite.Ctx, operator))
+
+	// only the AVS which froze the operator can unfreeze it
+	err = suite.App.ExoSlashKeeper.UnfreezeOperatorByAVS(suite.Ctx, suite.Address, operator)
+	suite.ErrorIs(err, slashtypes.ErrUnauthorizedAVS)
+	err = suite.App.ExoSlashKeeper.UnfreezeOperatorByAVS(suite.Ctx, avsAddr, operator)
+	suite.NoError(err)
+	suite.False(suite.App.ExoSlashKeeper.IsOperatorFrozen(suite.Ctx, operator))
+}
+
+func (suite *SlashTestSuite) TestFreezeStaker() {
+	depositAmount := sdkmath.NewInt(100)
+	withdrawParams := &assetskeeper.DepositWithdrawParams{
+		ClientChainLzID: suite.ClientChains[0].LayerZeroChainID,
+		Action:          assetstypes.DepositLST,
+		StakerAddress:   suite.Address.Bytes(),
+		AssetsAddress:   common.HexToAddress(suite.Assets[0].Address).Bytes(),
+		OpAmount:        depositAmount,
+	}
+	err := suite.App.AssetsKeeper.PerformDepositOrWithdraw(suite.Ctx, withdrawParams)
+	suite.NoError(err)
+	stakerID, _ := assetstypes.GetStakerIDAndAssetID(suite.ClientChains[0].LayerZeroChainID, suite.Address.Bytes(), nil)
+
+	_, err = suite.App.ExoSlashKeeper.Freeze(suite.Ctx, &slashtypes.MsgFreeze{
+		Authority: suite.authority(),
+		StakerID:  stakerID,
+	})
+	suite.NoError(err)
+	suite.True(suite.App.ExoSlashKeeper.IsStakerFrozen(suite.Ctx, stakerID))
+
+	withdrawParams.Action = assetstypes.WithdrawLST
+	err = suite.App.AssetsKeeper.PerformDepositOrWithdraw(suite.Ctx, withdrawParams)
+	suite.ErrorIs(err, assetstypes.ErrStakerIsFrozen)
+	err = suite.App.DelegationKeeper.DelegateTo(suite.Ctx, &delegationtypes.DelegationOrUndelegationParams{
+		ClientChainID:   suite.ClientChains[0].LayerZeroChainID,
+		AssetsAddress:   withdrawParams.AssetsAddress,
+		OperatorAddress: suite.Operators[0],
+		StakerAddress:   suite.Address.Bytes(),
+		OpAmount:        depositAmount,
+	})
+	suite.ErrorIs(err, delegationtypes.ErrStakerIsFrozen)
+
+	res, err := suite.App.ExoSlashKeeper.StakerFrozenStatus(suite.Ctx, &slashtypes.QueryStakerFrozenStatusRequest{
+		StakerId: stakerID,
+	})
+	suite.NoError(err)
+	suite.True(res.Frozen)
+
+	// only the governance or the one who froze the staker can unfreeze it
+	err = suite.App.ExoSlashKeeper.UnfreezeStaker(suite.Ctx, stakerID, suite.Address.String())
+	suite.ErrorIs(err, slashtypes.ErrUnauthorizedAVS)
+	_, err = suite.App.ExoSlashKeeper.Unfreeze(suite.Ctx, &slashtypes.MsgUnfreeze{
+		Authority: suite.authority(),
+		StakerID:  stakerID,
+	})
+	suite.NoError(err)
+	err = suite.App.AssetsKeeper.PerformDepositOrWithdraw(suite.Ctx, withdrawParams)
+	suite.NoError(err)
+}
+
+func (suite *SlashTestSuite) TestStakerFrozenWithAssociatedOperator() {
+	operator := suite.Operators[0]
+	stakerID, _ := assetstypes.GetStakerIDAndAssetID(suite.ClientChains[0].LayerZeroChainID, suite.Address.Bytes(), nil)
+	err := suite.App.DelegationKeeper.SetAssociatedOperator(suite.Ctx, stakerID, operator.String())
+	suite.NoError(err)
+	suite.False(suite.App.ExoSlashKeeper.IsStakerFrozen(suite.Ctx, stakerID))
+
+	err = suite.App.ExoSlashKeeper.FreezeOperator(suite.Ctx, operator, suite.authority(), "")
+	suite.NoError(err)
+	suite.True(suite.App.ExoSlashKeeper.IsStakerFrozen(suite.Ctx, stakerID))
+
+	// the staker can't escape the freezing by dissociating from the operator
+	err = suite.App.DelegationKeeper.DissociateOperatorFromStaker(suite.Ctx, suite.ClientChains[0].LayerZeroChainID, suite.Address.Bytes())
+	suite.ErrorIs(err, delegationtypes.ErrStakerIsFrozen)
+	err = suite.App.ExoSlashKeeper.UnfreezeOperator(suite.Ctx, operator, suite.authority())
+	suite.NoError(err)
+	err = suite.App.DelegationKeeper.DissociateOperatorFromStaker(suite.Ctx, suite.ClientChains[0].LayerZeroChainID, suite.Address.Bytes())
+	suite.NoError(err)
+}
+
+func (suite *SlashTestSuite) TestFrozenGenesis() {
+	err := suite.App.ExoSlashKeeper.FreezeOperator(suite.Ctx, suite.Operators[1], suite.authority(), "")
+	suite.NoError(err)
+	stakerID, _ := assetstypes.GetStakerIDAndAssetIDFromStr(suite.ClientChains[0].LayerZeroChainID, suite.StakerAddr, "")
+	err = suite.App.ExoSlashKeeper.FreezeStaker(suite.Ctx, stakerID, suite.authority(), "")
+	suite.NoError(err)
+
+	exported := suite.App.ExoSlashKeeper.ExportGenesis(suite.Ctx)
+	suite.NoError(exported.Validate())
+	suite.Len(exported.FrozenOperators, 1)
+	suite.Equal(suite.Operators[1].String(), exported.FrozenOperators[0].OperatorAddress)
+	suite.Len(exported.FrozenStakers, 1)
+
+	suite.SetupTest()
+	suite.App.ExoSlashKeeper.InitGenesis(suite.Ctx, *exported)
+	suite.Equal(exported, suite.App.ExoSlashKeeper.ExportGenesis(suite.Ctx))
+	suite.True(suite.App.ExoSlashKeeper.IsOperatorFrozen(suite.Ctx, sdk.MustAccAddressFromBech32(exported.FrozenOperators[0].OperatorAddress)))
+}
@@ -24,6 +24,8 @@ var (
 const (
 	// Amino names
 	updateParamsName = "exocore/MsgUpdateParamsForSlash"
+	freezeName       = "exocore/MsgFreeze"
+	unfreezeName     = "exocore/MsgUnfreeze"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -37,6 +39,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgFreeze{},
+		&MsgUnfreeze{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -47,4 +51,6 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // Amino JSON serialization and EIP-712 compatibility.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgFreeze{}, freezeName, nil)
+	cdc.RegisterConcrete(&MsgUnfreeze{}, unfreezeName, nil)
 }
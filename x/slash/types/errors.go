@@ -14,4 +14,9 @@ var (
 	ErrSlashAmountIsNegative    = errorsmod.Register(ModuleName, 5, "the slash amount is negative")
 	ErrSlashAssetNotExist       = errorsmod.Register(ModuleName, 6, "the slash asset doesn't exist")
 	ErrNoOperatorStatusKey      = errorsmod.Register(ModuleName, 7, "there is no stored key for slash OpratorStatus")
+	ErrAlreadyFrozen            = errorsmod.Register(ModuleName, 8, "the operator or staker has already been frozen")
+	ErrNotFrozen                = errorsmod.Register(ModuleName, 9, "the operator or staker isn't frozen")
+	ErrUnauthorizedAVS          = errorsmod.Register(ModuleName, 10, "the AVS isn't allowed to freeze or unfreeze the operator")
+	ErrInvalidFreezeTarget      = errorsmod.Register(ModuleName, 11, "exactly one of the operator and staker should be specified")
+	ErrInvalidGenesisData       = errorsmod.Register(ModuleName, 12, "the genesis data supplied is invalid")
 )
@@ -8,4 +8,13 @@ const (
 	AttributeKeyReason  = "reason"
 
 	AttributeValueDoubleSign = "double_sign"
+
+	EventTypeFreezeOperator   = "freeze_operator"
+	EventTypeUnfreezeOperator = "unfreeze_operator"
+	EventTypeFreezeStaker     = "freeze_staker"
+	EventTypeUnfreezeStaker   = "unfreeze_staker"
+
+	AttributeKeyOperator = "operator"
+	AttributeKeyStakerID = "staker_id"
+	AttributeKeyFrozenBy = "frozen_by"
 )
@@ -16,3 +16,20 @@ type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	// Methods imported from bank should be defined here
 }
+
+// DelegationKeeper defines the expected interface of the delegation keeper, it's used to find
+// the operator associated with a staker.
+type DelegationKeeper interface {
+	GetAssociatedOperator(ctx sdk.Context, stakerID string) (string, error)
+}
+
+// OperatorKeeper defines the expected interface of the operator keeper.
+type OperatorKeeper interface {
+	IsOperator(ctx sdk.Context, addr sdk.AccAddress) bool
+	IsOptedIn(ctx sdk.Context, operatorAddr, avsAddr string) bool
+}
+
+// AVSKeeper defines the expected interface of the avs keeper.
+type AVSKeeper interface {
+	IsAVS(ctx sdk.Context, addr string) (bool, error)
+}
@@ -1,24 +1,99 @@
 package types
 
 import (
-// this line is used by starport scaffolding # genesis/types/import
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ExocoreNetwork/exocore/utils"
+	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	// this line is used by starport scaffolding # genesis/types/import
 )
 
 // DefaultIndex is the default global index
 const DefaultIndex uint64 = 1
 
+// NewGenesisState creates a new genesis state with the provided parameters and
+// frozen records.
+func NewGenesisState(
+	params Params,
+	frozenOperators []FrozenOperator,
+	frozenStakers []FrozenStaker,
+) *GenesisState {
+	return &GenesisState{
+		Params:          params,
+		FrozenOperators: frozenOperators,
+		FrozenStakers:   frozenStakers,
+	}
+}
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
-	return &GenesisState{
-		// this line is used by starport scaffolding # genesis/types/default
-		Params: DefaultParams(),
+	// this line is used by starport scaffolding # genesis/types/default
+	return NewGenesisState(DefaultParams(), nil, nil)
+}
+
+// ValidateFrozenOperators validates the frozen operators.
+func (gs GenesisState) ValidateFrozenOperators() error {
+	seenFieldValueFunc := func(info FrozenOperator) (string, struct{}) {
+		return info.OperatorAddress, struct{}{}
+	}
+	validationFunc := func(_ int, info FrozenOperator) error {
+		if _, err := sdk.AccAddressFromBech32(info.OperatorAddress); err != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid frozen operator address %s: %s", info.OperatorAddress, err,
+			)
+		}
+		if info.FrozenBy == "" {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"empty frozen_by for the operator %s", info.OperatorAddress,
+			)
+		}
+		return nil
+	}
+	_, err := utils.CommonValidation(gs.FrozenOperators, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
 	}
+	return nil
+}
+
+// ValidateFrozenStakers validates the frozen stakers.
+func (gs GenesisState) ValidateFrozenStakers() error {
+	seenFieldValueFunc := func(info FrozenStaker) (string, struct{}) {
+		return info.StakerID, struct{}{}
+	}
+	validationFunc := func(_ int, info FrozenStaker) error {
+		if _, _, err := assetstypes.ValidateID(info.StakerID, true, false); err != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid frozen stakerID %s: %s", info.StakerID, err,
+			)
+		}
+		if info.FrozenBy == "" {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"empty frozen_by for the staker %s", info.StakerID,
+			)
+		}
+		return nil
+	}
+	_, err := utils.CommonValidation(gs.FrozenStakers, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+	}
+	return nil
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
-
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if err := gs.ValidateFrozenOperators(); err != nil {
+		return err
+	}
+	return gs.ValidateFrozenStakers()
 }
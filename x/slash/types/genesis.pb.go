@@ -27,6 +27,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// frozen_operators is the list of the frozen operators.
+	FrozenOperators []FrozenOperator `protobuf:"bytes,2,rep,name=frozen_operators,json=frozenOperators,proto3" json:"frozen_operators"`
+	// frozen_stakers is the list of the frozen stakers.
+	FrozenStakers []FrozenStaker `protobuf:"bytes,3,rep,name=frozen_stakers,json=frozenStakers,proto3" json:"frozen_stakers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +73,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetFrozenOperators() []FrozenOperator {
+	if m != nil {
+		return m.FrozenOperators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrozenStakers() []FrozenStaker {
+	if m != nil {
+		return m.FrozenStakers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "exocore.slash.v1.GenesisState")
 }
@@ -76,20 +94,25 @@ func init() {
 func init() { proto.RegisterFile("exocore/slash/v1/genesis.proto", fileDescriptor_05962c99dc81cce2) }
 
 var fileDescriptor_05962c99dc81cce2 = []byte{
-	// 199 bytes of a gzipped FileDescriptorProto
+	// 281 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xad, 0xc8, 0x4f,
 	0xce, 0x2f, 0x4a, 0xd5, 0x2f, 0xce, 0x49, 0x2c, 0xce, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd,
 	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xca, 0xeb, 0x81,
 	0xe5, 0xf5, 0xca, 0x0c, 0xa5, 0x64, 0x31, 0x74, 0x14, 0x24, 0x16, 0x25, 0xe6, 0x42, 0x35, 0x48,
-	0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x99, 0xfa, 0x20, 0x16, 0x44, 0x54, 0xc9, 0x8d, 0x8b, 0xc7,
-	0x1d, 0x62, 0x6e, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x19, 0x17, 0x1b, 0x44, 0x97, 0x04, 0xa3,
-	0x02, 0xa3, 0x06, 0xb7, 0x91, 0x84, 0x1e, 0xba, 0x3d, 0x7a, 0x01, 0x60, 0x79, 0x27, 0x96, 0x13,
-	0xf7, 0xe4, 0x19, 0x82, 0xa0, 0xaa, 0x9d, 0x3c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e,
-	0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58,
-	0x8e, 0x21, 0x4a, 0x3f, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xdf, 0x15,
-	0x62, 0x96, 0x5f, 0x6a, 0x49, 0x79, 0x7e, 0x51, 0xb6, 0x3e, 0xcc, 0xc1, 0x15, 0x50, 0x27, 0x97,
-	0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x5d, 0x66, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x5c,
-	0x72, 0x18, 0x08, 0x02, 0x01, 0x00, 0x00,
+	0xc9, 0x60, 0x48, 0x97, 0x54, 0x16, 0xa4, 0xc2, 0x64, 0x45, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c,
+	0x7d, 0x10, 0x0b, 0x22, 0xaa, 0xf4, 0x8c, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x6d, 0x70, 0x49, 0x62,
+	0x49, 0xaa, 0x90, 0x19, 0x17, 0x1b, 0xc4, 0x50, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x09,
+	0x3d, 0x74, 0x67, 0xe8, 0x05, 0x80, 0xe5, 0x9d, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xaa,
+	0x16, 0x0a, 0xe4, 0x12, 0x48, 0x2b, 0xca, 0xaf, 0x4a, 0xcd, 0x8b, 0xcf, 0x2f, 0x48, 0x2d, 0x4a,
+	0x2c, 0xc9, 0x2f, 0x2a, 0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xc0, 0x34, 0xc1, 0x0d,
+	0xac, 0xd2, 0x1f, 0xaa, 0x10, 0x6a, 0x12, 0x7f, 0x1a, 0x8a, 0x68, 0xb1, 0x90, 0x37, 0x17, 0x1f,
+	0xd4, 0xc8, 0xe2, 0x92, 0xc4, 0xec, 0xd4, 0xa2, 0x62, 0x09, 0x66, 0xb0, 0x81, 0x72, 0xb8, 0x0c,
+	0x0c, 0x06, 0x2b, 0x83, 0x1a, 0xc7, 0x9b, 0x86, 0x24, 0x56, 0xec, 0xe4, 0x79, 0xe2, 0x91, 0x1c,
+	0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1,
+	0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xfa, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9,
+	0xf9, 0xb9, 0xfa, 0xae, 0x10, 0x83, 0xfd, 0x52, 0x4b, 0xca, 0xf3, 0x8b, 0xb2, 0xf5, 0x61, 0x01,
+	0x5a, 0x01, 0x0d, 0x52, 0x70, 0x78, 0x26, 0xb1, 0x81, 0x83, 0xce, 0x18, 0x10, 0x00, 0x00, 0xff,
+	0xff, 0x57, 0xf0, 0x6d, 0x8d, 0xc1, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -112,6 +135,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FrozenStakers) > 0 {
+		for iNdEx := len(m.FrozenStakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenStakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FrozenOperators) > 0 {
+		for iNdEx := len(m.FrozenOperators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenOperators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -144,6 +195,18 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FrozenOperators) > 0 {
+		for _, e := range m.FrozenOperators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FrozenStakers) > 0 {
+		for _, e := range m.FrozenStakers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -215,6 +278,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenOperators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenOperators = append(m.FrozenOperators, FrozenOperator{})
+			if err := m.FrozenOperators[len(m.FrozenOperators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenStakers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenStakers = append(m.FrozenStakers, FrozenStaker{})
+			if err := m.FrozenStakers[len(m.FrozenStakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,11 +3,19 @@ package types_test
 import (
 	"testing"
 
+	utiltx "github.com/ExocoreNetwork/exocore/testutil/tx"
+	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
 	"github.com/ExocoreNetwork/exocore/x/slash/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	operator := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	stakerID, _ := assetstypes.GetStakerIDAndAssetIDFromStr(101, utiltx.GenerateAddress().String(), "")
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -26,6 +34,54 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "valid frozen records",
+			genState: types.NewGenesisState(
+				types.DefaultParams(),
+				[]types.FrozenOperator{{OperatorAddress: operator, FrozenBy: authority}},
+				[]types.FrozenStaker{{StakerID: stakerID, FrozenBy: authority}},
+			),
+			valid: true,
+		},
+		{
+			desc: "invalid frozen operator address",
+			genState: types.NewGenesisState(
+				types.DefaultParams(),
+				[]types.FrozenOperator{{OperatorAddress: "invalid", FrozenBy: authority}},
+				nil,
+			),
+			valid: false,
+		},
+		{
+			desc: "duplicate frozen operators",
+			genState: types.NewGenesisState(
+				types.DefaultParams(),
+				[]types.FrozenOperator{
+					{OperatorAddress: operator, FrozenBy: authority},
+					{OperatorAddress: operator, FrozenBy: authority},
+				},
+				nil,
+			),
+			valid: false,
+		},
+		{
+			desc: "empty frozen_by of the staker",
+			genState: types.NewGenesisState(
+				types.DefaultParams(),
+				nil,
+				[]types.FrozenStaker{{StakerID: stakerID}},
+			),
+			valid: false,
+		},
+		{
+			desc: "invalid frozen stakerID",
+			genState: types.NewGenesisState(
+				types.DefaultParams(),
+				nil,
+				[]types.FrozenStaker{{StakerID: "invalid", FrozenBy: authority}},
+			),
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
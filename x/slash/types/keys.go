@@ -21,11 +21,14 @@ func KeyPrefix(p string) []byte {
 const (
 	prefixParams       = 1
 	prefixOperatorInfo = 2
+	prefixFrozenStaker = 3
 )
 
 var (
 	KeyPrefixParams = []byte{prefixParams}
-	// KeyPrefixOperatorInfo key-value: operatorAddr->operatorInfo
+	// KeyPrefixOperatorInfo key-value: operatorAddr->FrozenOperator
 	KeyPrefixOperatorInfo = []byte{prefixOperatorInfo}
+	// KeyPrefixFrozenStaker key-value: stakerID->FrozenStaker
+	KeyPrefixFrozenStaker = []byte{prefixFrozenStaker}
 	ParamsKey             = []byte("Params")
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -25,3 +26,63 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m *MsgUpdateParams) GetSignBytes() []byte {
 	return nil
 }
+
+var (
+	_ sdk.Msg = &MsgFreeze{}
+	_ sdk.Msg = &MsgUnfreeze{}
+)
+
+// validateFreezeTarget checks that exactly one of the operator and staker is provided.
+func validateFreezeTarget(operatorAddress, stakerID string) error {
+	if (operatorAddress == "") == (stakerID == "") {
+		return ErrInvalidFreezeTarget
+	}
+	if operatorAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(operatorAddress); err != nil {
+			return errorsmod.Wrap(err, "invalid operator address")
+		}
+		return nil
+	}
+	if _, _, err := assetstypes.ValidateID(stakerID, true, false); err != nil {
+		return errorsmod.Wrap(err, "invalid stakerID")
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgFreeze message.
+func (m *MsgFreeze) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgFreeze) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	return validateFreezeTarget(m.OperatorAddress, m.StakerID)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgFreeze) GetSignBytes() []byte {
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgUnfreeze message.
+func (m *MsgUnfreeze) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUnfreeze) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	return validateFreezeTarget(m.OperatorAddress, m.StakerID)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgUnfreeze) GetSignBytes() []byte {
+	return nil
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -111,32 +112,254 @@ func (m *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
+// QueryOperatorFrozenStatusRequest is request type for the Query/OperatorFrozenStatus RPC method.
+type QueryOperatorFrozenStatusRequest struct {
+	// operator_address is the operator for which the query is made.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+}
+
+func (m *QueryOperatorFrozenStatusRequest) Reset()         { *m = QueryOperatorFrozenStatusRequest{} }
+func (m *QueryOperatorFrozenStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorFrozenStatusRequest) ProtoMessage()    {}
+func (*QueryOperatorFrozenStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07a035b9da23fe1a, []int{2}
+}
+func (m *QueryOperatorFrozenStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorFrozenStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorFrozenStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOperatorFrozenStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorFrozenStatusRequest.Merge(m, src)
+}
+func (m *QueryOperatorFrozenStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorFrozenStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorFrozenStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorFrozenStatusRequest proto.InternalMessageInfo
+
+func (m *QueryOperatorFrozenStatusRequest) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+// QueryOperatorFrozenStatusResponse is response type for the Query/OperatorFrozenStatus RPC method.
+type QueryOperatorFrozenStatusResponse struct {
+	// frozen is true if the operator is frozen.
+	Frozen bool `protobuf:"varint,1,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	// info is the record of the frozen operator, it's nil if the operator isn't frozen.
+	Info *FrozenOperator `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (m *QueryOperatorFrozenStatusResponse) Reset()         { *m = QueryOperatorFrozenStatusResponse{} }
+func (m *QueryOperatorFrozenStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorFrozenStatusResponse) ProtoMessage()    {}
+func (*QueryOperatorFrozenStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07a035b9da23fe1a, []int{3}
+}
+func (m *QueryOperatorFrozenStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorFrozenStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorFrozenStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOperatorFrozenStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorFrozenStatusResponse.Merge(m, src)
+}
+func (m *QueryOperatorFrozenStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorFrozenStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorFrozenStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorFrozenStatusResponse proto.InternalMessageInfo
+
+func (m *QueryOperatorFrozenStatusResponse) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+func (m *QueryOperatorFrozenStatusResponse) GetInfo() *FrozenOperator {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+// QueryStakerFrozenStatusRequest is request type for the Query/StakerFrozenStatus RPC method.
+type QueryStakerFrozenStatusRequest struct {
+	// Per https://github.com/gogo/protobuf/issues/331, grpc-gateway does not like custom names.
+	// staker_id is the staker for which the query is made.
+	StakerId string `protobuf:"bytes,1,opt,name=staker_id,json=stakerId,proto3" json:"staker_id,omitempty"`
+}
+
+func (m *QueryStakerFrozenStatusRequest) Reset()         { *m = QueryStakerFrozenStatusRequest{} }
+func (m *QueryStakerFrozenStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakerFrozenStatusRequest) ProtoMessage()    {}
+func (*QueryStakerFrozenStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07a035b9da23fe1a, []int{4}
+}
+func (m *QueryStakerFrozenStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakerFrozenStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakerFrozenStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakerFrozenStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakerFrozenStatusRequest.Merge(m, src)
+}
+func (m *QueryStakerFrozenStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakerFrozenStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakerFrozenStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakerFrozenStatusRequest proto.InternalMessageInfo
+
+func (m *QueryStakerFrozenStatusRequest) GetStakerId() string {
+	if m != nil {
+		return m.StakerId
+	}
+	return ""
+}
+
+// QueryStakerFrozenStatusResponse is response type for the Query/StakerFrozenStatus RPC method.
+type QueryStakerFrozenStatusResponse struct {
+	// frozen is true if the staker is frozen, or it's associated with a frozen operator.
+	Frozen bool `protobuf:"varint,1,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	// info is the record of the frozen staker, it's nil if the staker itself isn't frozen.
+	Info *FrozenStaker `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (m *QueryStakerFrozenStatusResponse) Reset()         { *m = QueryStakerFrozenStatusResponse{} }
+func (m *QueryStakerFrozenStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakerFrozenStatusResponse) ProtoMessage()    {}
+func (*QueryStakerFrozenStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07a035b9da23fe1a, []int{5}
+}
+func (m *QueryStakerFrozenStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakerFrozenStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakerFrozenStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakerFrozenStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakerFrozenStatusResponse.Merge(m, src)
+}
+func (m *QueryStakerFrozenStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakerFrozenStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakerFrozenStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakerFrozenStatusResponse proto.InternalMessageInfo
+
+func (m *QueryStakerFrozenStatusResponse) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+func (m *QueryStakerFrozenStatusResponse) GetInfo() *FrozenStaker {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "exocore.slash.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "exocore.slash.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryOperatorFrozenStatusRequest)(nil), "exocore.slash.v1.QueryOperatorFrozenStatusRequest")
+	proto.RegisterType((*QueryOperatorFrozenStatusResponse)(nil), "exocore.slash.v1.QueryOperatorFrozenStatusResponse")
+	proto.RegisterType((*QueryStakerFrozenStatusRequest)(nil), "exocore.slash.v1.QueryStakerFrozenStatusRequest")
+	proto.RegisterType((*QueryStakerFrozenStatusResponse)(nil), "exocore.slash.v1.QueryStakerFrozenStatusResponse")
 }
 
 func init() { proto.RegisterFile("exocore/slash/v1/query.proto", fileDescriptor_07a035b9da23fe1a) }
 
 var fileDescriptor_07a035b9da23fe1a = []byte{
-	// 260 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0xad, 0xc8, 0x4f,
-	0xce, 0x2f, 0x4a, 0xd5, 0x2f, 0xce, 0x49, 0x2c, 0xce, 0xd0, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d,
-	0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xca, 0xea, 0x81, 0x65, 0xf5,
-	0xca, 0x0c, 0xa5, 0x64, 0x31, 0xd4, 0x17, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x43, 0x34, 0x48, 0xc9,
-	0xa4, 0xe7, 0xe7, 0xa7, 0xe7, 0xa4, 0xea, 0x27, 0x16, 0x64, 0xea, 0x27, 0xe6, 0xe5, 0xe5, 0x97,
-	0x24, 0x96, 0x64, 0xe6, 0xe7, 0x41, 0x65, 0x95, 0x44, 0xb8, 0x84, 0x02, 0x41, 0xa6, 0x07, 0x80,
-	0xb5, 0x04, 0xa5, 0x16, 0x96, 0xa6, 0x16, 0x97, 0x28, 0xb9, 0x73, 0x09, 0xa3, 0x88, 0x16, 0x17,
-	0xe4, 0xe7, 0x15, 0xa7, 0x0a, 0x19, 0x70, 0xb1, 0x41, 0x8c, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0,
-	0x36, 0x92, 0xd0, 0x43, 0x77, 0x8c, 0x1e, 0x54, 0x07, 0x54, 0x9d, 0x51, 0x2d, 0x17, 0x2b, 0xd8,
-	0x20, 0xa1, 0x12, 0x2e, 0x36, 0x88, 0x94, 0x90, 0x0a, 0xa6, 0x26, 0x4c, 0x17, 0x48, 0xa9, 0x12,
-	0x50, 0x05, 0x71, 0x91, 0x92, 0x6c, 0xd3, 0xe5, 0x27, 0x93, 0x99, 0xc4, 0x85, 0x44, 0xf5, 0x51,
-	0x03, 0x01, 0x62, 0xbd, 0x93, 0xe7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78,
-	0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44,
-	0xe9, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xbb, 0x42, 0xb4, 0xfa,
-	0xa5, 0x96, 0x94, 0xe7, 0x17, 0x65, 0xc3, 0x4d, 0xaa, 0x80, 0x9a, 0x55, 0x52, 0x59, 0x90, 0x5a,
-	0x9c, 0xc4, 0x06, 0x0e, 0x2f, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe3, 0xae, 0xe4, 0xb8,
-	0x9e, 0x01, 0x00, 0x00,
+	// 512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x6f, 0x13, 0x31,
+	0x10, 0xcd, 0x16, 0x88, 0x5a, 0x73, 0xa0, 0x32, 0x01, 0xc2, 0x42, 0x4d, 0x58, 0x81, 0xc4, 0x85,
+	0x75, 0x93, 0x22, 0x81, 0x90, 0x90, 0xa0, 0x08, 0x50, 0x2f, 0x7c, 0x6c, 0x6e, 0x5c, 0x22, 0x37,
+	0x71, 0xb7, 0xab, 0x36, 0x3b, 0x1b, 0xdb, 0x29, 0x2d, 0xa8, 0x17, 0x7e, 0x01, 0x12, 0xff, 0x04,
+	0xf1, 0x07, 0xb8, 0x71, 0xac, 0xe0, 0xc2, 0x11, 0x25, 0xfc, 0x06, 0xce, 0x88, 0xb1, 0x13, 0x29,
+	0xbb, 0xdd, 0x56, 0x39, 0x7a, 0xde, 0xcc, 0x7b, 0x6f, 0xec, 0x27, 0x93, 0xeb, 0x72, 0x1f, 0xba,
+	0xa0, 0x24, 0xd7, 0xbb, 0x42, 0x6f, 0xf3, 0xbd, 0x26, 0x1f, 0x0c, 0xa5, 0x3a, 0x08, 0x33, 0x05,
+	0x06, 0xe8, 0xb2, 0x43, 0x43, 0x44, 0xc3, 0xbd, 0xa6, 0x7f, 0xb5, 0x0b, 0xba, 0x0f, 0xba, 0x83,
+	0x38, 0xb7, 0x07, 0xdb, 0xec, 0xaf, 0x14, 0xa8, 0x32, 0xa1, 0x44, 0x7f, 0x02, 0x17, 0x95, 0xcc,
+	0x41, 0x26, 0xa7, 0x68, 0x0c, 0x10, 0xef, 0x4a, 0x2e, 0xb2, 0x84, 0x8b, 0x34, 0x05, 0x23, 0x4c,
+	0x02, 0xa9, 0x43, 0x83, 0x1a, 0xa1, 0x6f, 0xfe, 0xdb, 0x7a, 0x8d, 0x84, 0x91, 0x1c, 0x0c, 0xa5,
+	0x36, 0xc1, 0x0b, 0x72, 0x71, 0xa6, 0xaa, 0x33, 0x48, 0xb5, 0xa4, 0xab, 0xa4, 0x6a, 0x85, 0xeb,
+	0x5e, 0xc3, 0xbb, 0x73, 0xbe, 0x55, 0x0f, 0xf3, 0x5b, 0x84, 0x6e, 0xc2, 0xf5, 0x05, 0x31, 0x69,
+	0x20, 0xd1, 0xab, 0x4c, 0x2a, 0x61, 0x40, 0x3d, 0x57, 0xf0, 0x5e, 0xa6, 0x6d, 0x23, 0xcc, 0x70,
+	0x22, 0x46, 0x9f, 0x92, 0x65, 0x70, 0x70, 0x47, 0xf4, 0x7a, 0x4a, 0x6a, 0xcb, 0xbf, 0xb4, 0x5e,
+	0xff, 0xf1, 0xf5, 0x6e, 0xcd, 0xdd, 0xc4, 0x13, 0x8b, 0xb4, 0x8d, 0x4a, 0xd2, 0x38, 0xba, 0x30,
+	0x99, 0x70, 0xe5, 0x60, 0x40, 0x6e, 0x9e, 0x20, 0xe4, 0xfc, 0x5f, 0x26, 0xd5, 0x2d, 0xac, 0x23,
+	0xff, 0x62, 0xe4, 0x4e, 0xf4, 0x1e, 0x39, 0x9b, 0xa4, 0x5b, 0x50, 0x5f, 0xc0, 0xad, 0x1a, 0xc5,
+	0xad, 0x2c, 0xdb, 0x84, 0x3b, 0xc2, 0xee, 0xe0, 0x11, 0x61, 0x28, 0xd9, 0x36, 0x62, 0x47, 0x1e,
+	0xbb, 0xd9, 0x35, 0xb2, 0xa4, 0x11, 0xec, 0x24, 0x3d, 0xbb, 0x52, 0xb4, 0x68, 0x0b, 0x1b, 0xbd,
+	0xa0, 0x4f, 0x6e, 0x94, 0x8e, 0x9f, 0xe2, 0xb7, 0x35, 0xe3, 0x97, 0x95, 0xf9, 0xb5, 0xcc, 0xd6,
+	0x6d, 0xeb, 0xef, 0x19, 0x72, 0x0e, 0xf5, 0xa8, 0x21, 0x55, 0xfb, 0x4a, 0xf4, 0x56, 0x71, 0xb2,
+	0x18, 0x06, 0xff, 0xf6, 0x29, 0x5d, 0xd6, 0x6c, 0xb0, 0xf2, 0xf1, 0xe7, 0x9f, 0xcf, 0x0b, 0x57,
+	0xe8, 0x25, 0x3e, 0x1b, 0x47, 0x9b, 0x04, 0xfa, 0xcd, 0x23, 0xb5, 0xe3, 0x1e, 0x87, 0xb6, 0x4a,
+	0xe8, 0x4f, 0x88, 0x8c, 0xbf, 0x36, 0xd7, 0x8c, 0x33, 0xf8, 0x18, 0x0d, 0x3e, 0xa4, 0x0f, 0x72,
+	0x06, 0xa7, 0xe1, 0xb3, 0xb7, 0xdb, 0xd1, 0x38, 0xc6, 0x3f, 0xe4, 0x43, 0x79, 0x48, 0xbf, 0x78,
+	0x84, 0x16, 0x9f, 0x8b, 0xae, 0x96, 0xb8, 0x29, 0x0d, 0x86, 0xdf, 0x9c, 0x63, 0xc2, 0xb9, 0xbf,
+	0x8f, 0xee, 0x9b, 0x94, 0xe7, 0xdc, 0xbb, 0x80, 0xe5, 0xbc, 0x4f, 0x63, 0x77, 0xb8, 0xbe, 0xf1,
+	0x7d, 0xc4, 0xbc, 0xa3, 0x11, 0xf3, 0x7e, 0x8f, 0x98, 0xf7, 0x69, 0xcc, 0x2a, 0x47, 0x63, 0x56,
+	0xf9, 0x35, 0x66, 0x95, 0xb7, 0x3c, 0x4e, 0xcc, 0xf6, 0x70, 0x33, 0xec, 0x42, 0x9f, 0x3f, 0xb3,
+	0xa4, 0x2f, 0xa5, 0x79, 0x07, 0x6a, 0x67, 0xaa, 0xb1, 0xef, 0x54, 0xf0, 0x43, 0xd9, 0xac, 0xe2,
+	0x9f, 0xb1, 0xf6, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x61, 0x78, 0x78, 0x1c, 0xdb, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -153,6 +376,11 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// OperatorFrozenStatus queries whether an operator is frozen.
+	OperatorFrozenStatus(ctx context.Context, in *QueryOperatorFrozenStatusRequest, opts ...grpc.CallOption) (*QueryOperatorFrozenStatusResponse, error)
+	// StakerFrozenStatus queries whether a staker is frozen, either by itself or by the
+	// operator it's associated with.
+	StakerFrozenStatus(ctx context.Context, in *QueryStakerFrozenStatusRequest, opts ...grpc.CallOption) (*QueryStakerFrozenStatusResponse, error)
 }
 
 type queryClient struct {
@@ -172,10 +400,33 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) OperatorFrozenStatus(ctx context.Context, in *QueryOperatorFrozenStatusRequest, opts ...grpc.CallOption) (*QueryOperatorFrozenStatusResponse, error) {
+	out := new(QueryOperatorFrozenStatusResponse)
+	err := c.cc.Invoke(ctx, "/exocore.slash.v1.Query/OperatorFrozenStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StakerFrozenStatus(ctx context.Context, in *QueryStakerFrozenStatusRequest, opts ...grpc.CallOption) (*QueryStakerFrozenStatusResponse, error) {
+	out := new(QueryStakerFrozenStatusResponse)
+	err := c.cc.Invoke(ctx, "/exocore.slash.v1.Query/StakerFrozenStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// OperatorFrozenStatus queries whether an operator is frozen.
+	OperatorFrozenStatus(context.Context, *QueryOperatorFrozenStatusRequest) (*QueryOperatorFrozenStatusResponse, error)
+	// StakerFrozenStatus queries whether a staker is frozen, either by itself or by the
+	// operator it's associated with.
+	StakerFrozenStatus(context.Context, *QueryStakerFrozenStatusRequest) (*QueryStakerFrozenStatusResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -185,6 +436,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) OperatorFrozenStatus(ctx context.Context, req *QueryOperatorFrozenStatusRequest) (*QueryOperatorFrozenStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorFrozenStatus not implemented")
+}
+func (*UnimplementedQueryServer) StakerFrozenStatus(ctx context.Context, req *QueryStakerFrozenStatusRequest) (*QueryStakerFrozenStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakerFrozenStatus not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -208,6 +465,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OperatorFrozenStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorFrozenStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorFrozenStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.slash.v1.Query/OperatorFrozenStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorFrozenStatus(ctx, req.(*QueryOperatorFrozenStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StakerFrozenStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakerFrozenStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StakerFrozenStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.slash.v1.Query/StakerFrozenStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakerFrozenStatus(ctx, req.(*QueryStakerFrozenStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "exocore.slash.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -216,6 +509,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "OperatorFrozenStatus",
+			Handler:    _Query_OperatorFrozenStatus_Handler,
+		},
+		{
+			MethodName: "StakerFrozenStatus",
+			Handler:    _Query_StakerFrozenStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "exocore/slash/v1/query.proto",
@@ -279,87 +580,295 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryOperatorFrozenStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryOperatorFrozenStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOperatorFrozenStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryOperatorFrozenStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryOperatorFrozenStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
+
+func (m *QueryOperatorFrozenStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Info != nil {
+		{
+			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+				return 0, err
 			}
-			iNdEx += skippy
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakerFrozenStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakerFrozenStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakerFrozenStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakerId) > 0 {
+		i -= len(m.StakerId)
+		copy(dAtA[i:], m.StakerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakerFrozenStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakerFrozenStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakerFrozenStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Info != nil {
+		{
+			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorFrozenStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorFrozenStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frozen {
+		n += 2
+	}
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakerFrozenStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakerFrozenStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frozen {
+		n += 2
+	}
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
 
@@ -454,6 +963,382 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryOperatorFrozenStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorFrozenStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorFrozenStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorFrozenStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorFrozenStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorFrozenStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &FrozenOperator{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakerFrozenStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakerFrozenStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakerFrozenStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakerFrozenStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakerFrozenStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakerFrozenStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &FrozenStaker{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0